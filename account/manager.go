@@ -301,6 +301,21 @@ func (m *Manager) SignBlock(addr *core.Address, block *core.Block) error {
 	return block.Sign(signature)
 }
 
+// SignBlockRandom sign the random of block with the vrf of the key
+func (m *Manager) SignBlockRandom(addr *core.Address, block *core.Block) error {
+	key, err := m.ks.GetUnlocked(addr.String())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"func":  "SignBlockRandom",
+			"err":   ErrBlockAddressLocked,
+			"block": block,
+		}).Error("block signer's address locked")
+		return err
+	}
+
+	return block.SignRandom(key.(keystore.PrivateKey))
+}
+
 // SignTransactionWithPassphrase sign transaction with the from passphrase
 func (m *Manager) SignTransactionWithPassphrase(addr *core.Address, tx *core.Transaction, passphrase []byte) error {
 	// check sign addr is tx's from addr
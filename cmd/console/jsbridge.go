@@ -26,12 +26,16 @@ import (
 
 	"bytes"
 	"io/ioutil"
+	"net"
 	"net/http"
 
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/robertkrimen/otto"
 )
 
+// unixScheme is the prefix of the hosts on a unix socket, e.g. unix:///data/neb.ipc.
+const unixScheme = "unix://"
+
 type jsBridge struct {
 
 	// js request host
@@ -46,7 +50,9 @@ type jsBridge struct {
 // newBirdge create a new jsbridge with given prompter and writer
 func newBirdge(config nebletpb.Config, prompter *terminalPrompter, writer io.Writer) *jsBridge {
 	bridge := &jsBridge{prompter: prompter, writer: writer}
-	if config.GetRpc() != nil {
+	if config.GetRpc() != nil && len(config.GetRpc().IpcPath) > 0 {
+		bridge.host = unixScheme + config.GetRpc().IpcPath
+	} else if config.GetRpc() != nil {
 		bridge.host = config.GetRpc().HttpListen[0]
 		if !strings.HasPrefix(bridge.host, "http") {
 			bridge.host = "http://" + bridge.host
@@ -98,6 +104,17 @@ func (b *jsBridge) request(call otto.FunctionCall) otto.Value {
 	}
 
 	url := b.host + api.String()
+	client := &http.Client{}
+	if strings.HasPrefix(b.host, unixScheme) {
+		// the host of the url is ignored, the requests go to the socket.
+		socket := strings.TrimPrefix(b.host, unixScheme)
+		url = "http://unix" + api.String()
+		client.Transport = &http.Transport{
+			Dial: func(network, addr string) (net.Conn, error) {
+				return net.Dial("unix", socket)
+			},
+		}
+	}
 	//fmt.Fprintln(b.writer, "request", url, method.String(), args)
 	// method only support upper case.
 	req, err := http.NewRequest(strings.ToUpper(method.String()), url, bytes.NewBuffer([]byte(args)))
@@ -106,7 +123,6 @@ func (b *jsBridge) request(call otto.FunctionCall) otto.Value {
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
 		return jsError(call.Otto, err)
@@ -87,6 +87,12 @@ var (
 		Usage: "chain signature ciphers, multi-value support.",
 	}
 
+	// ChainSyncModeFlag chain sync mode
+	ChainSyncModeFlag = cli.StringFlag{
+		Name:  "syncmode",
+		Usage: "chain sync mode, full or fast, fast needs the checkpoints of the config.",
+	}
+
 	// ChainFlags chain config list
 	ChainFlags = []cli.Flag{
 		ChainIDFlag,
@@ -94,6 +100,7 @@ var (
 		ChainKeyDirFlag,
 		ChainCoinbaseFlag,
 		ChainCipherFlag,
+		ChainSyncModeFlag,
 	}
 
 	// RPCListenFlag rpc listen
@@ -189,6 +196,9 @@ func chainConfig(ctx *cli.Context, cfg *nebletpb.ChainConfig) {
 	if ctx.GlobalIsSet(ChainCipherFlag.Name) {
 		cfg.SignatureCiphers = ctx.GlobalStringSlice(ChainCipherFlag.Name)
 	}
+	if ctx.GlobalIsSet(ChainSyncModeFlag.Name) {
+		cfg.SyncMode = ctx.GlobalString(ChainSyncModeFlag.Name)
+	}
 }
 
 func rpcConfig(ctx *cli.Context, cfg *nebletpb.RPCConfig) {
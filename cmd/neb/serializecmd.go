@@ -96,12 +96,15 @@ type contractJSON struct {
 }
 
 type candidateJSON struct {
-	Action string `json:"action"`
+	Action     string  `json:"action"`
+	Commission *uint32 `json:"commission"`
+	Signer     string  `json:"signer"`
 }
 
 type delegateJSON struct {
-	Action    string `json:"action"`
-	Delegatee string `json:"delegatee"`
+	Action     string                 `json:"action"`
+	Delegatee  string                 `json:"delegatee"`
+	Delegatees []*core.DelegateWeight `json:"delegatees"`
 }
 
 type blockHeaderJSON struct {
@@ -218,10 +221,15 @@ func parseTransaction(neb *neblet.Neblet, txJSON *txJSON) (*core.Transaction, er
 		payload, err = core.NewCallPayload(txJSON.Contract.Function, txJSON.Contract.Args).ToBytes()
 	} else if txJSON.Candidate != nil {
 		payloadType = core.TxPayloadCandidateType
-		payload, err = core.NewCandidatePayload(txJSON.Candidate.Action).ToBytes()
+		candidate := core.NewCandidatePayload(txJSON.Candidate.Action)
+		candidate.Commission = txJSON.Candidate.Commission
+		candidate.Signer = txJSON.Candidate.Signer
+		payload, err = candidate.ToBytes()
 	} else if txJSON.Delegate != nil {
 		payloadType = core.TxPayloadDelegateType
-		payload, err = core.NewDelegatePayload(txJSON.Delegate.Action, txJSON.Delegate.Delegatee).ToBytes()
+		delegate := core.NewDelegatePayload(txJSON.Delegate.Action, txJSON.Delegate.Delegatee)
+		delegate.Delegatees = txJSON.Delegate.Delegatees
+		payload, err = delegate.ToBytes()
 	} else {
 		payloadType = core.TxPayloadBinaryType
 	}
@@ -18,6 +18,21 @@
 
 package trie
 
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Errors
+var (
+	ErrUnrequestedNode = errors.New("trie node not requested")
+)
+
 // SyncTrie data from other servers
 // Sync whole trie to build snapshot
 func (t *Trie) SyncTrie(rootHash []byte) error {
@@ -29,3 +44,146 @@ func (t *Trie) SyncTrie(rootHash []byte) error {
 func (t *Trie) SyncPath(rootHash []byte, key []byte) error {
 	return nil
 }
+
+// LeafCallback return the roots of the tries a leaf value refers to, synced with the trie.
+type LeafCallback func(val []byte) [][]byte
+
+type syncRequest struct {
+	hash   []byte
+	onLeaf LeafCallback
+}
+
+// Syncer download tries node by node from their roots, each node verified by the hash its parent refers to it by,
+// and put them in the storage. The nodes already in the storage are walked locally,
+// so an interrupted sync is resumed.
+type Syncer struct {
+	storage storage.Storage
+	queue   []*syncRequest
+	// key: hex hash, value: the requests returned by Missing and not processed yet.
+	requested map[string]*syncRequest
+	// key: hex hash of the nodes scheduled.
+	scheduled map[string]bool
+	synced    int
+}
+
+// NewSyncer return a new Syncer putting the nodes in the storage.
+func NewSyncer(storage storage.Storage) *Syncer {
+	return &Syncer{
+		storage:   storage,
+		requested: make(map[string]*syncRequest),
+		scheduled: make(map[string]bool),
+	}
+}
+
+// AddRoot schedule the trie of the root, the leaf callback, if any, is called on the values of its leaves.
+func (s *Syncer) AddRoot(root []byte, onLeaf LeafCallback) {
+	s.schedule(root, onLeaf)
+}
+
+func (s *Syncer) schedule(hash []byte, onLeaf LeafCallback) {
+	if len(hash) == 0 {
+		return
+	}
+	key := byteutils.Hex(hash)
+	if s.scheduled[key] {
+		return
+	}
+	s.scheduled[key] = true
+	s.queue = append(s.queue, &syncRequest{hash: hash, onLeaf: onLeaf})
+}
+
+// Missing return at most max hashes of the nodes to download, which are not returned again
+// until they are processed or retried.
+func (s *Syncer) Missing(max int) [][]byte {
+	var hashes [][]byte
+	for len(s.queue) > 0 && len(hashes) < max {
+		req := s.queue[0]
+		s.queue = s.queue[1:]
+		if data, err := s.storage.Get(req.hash); err == nil {
+			if err := s.expand(req, data); err == nil {
+				continue
+			}
+		}
+		s.requested[byteutils.Hex(req.hash)] = req
+		hashes = append(hashes, req.hash)
+	}
+	return hashes
+}
+
+// Retry schedule again the hashes returned by Missing and not processed.
+func (s *Syncer) Retry(hashes [][]byte) {
+	for _, h := range hashes {
+		key := byteutils.Hex(h)
+		if req, ok := s.requested[key]; ok {
+			delete(s.requested, key)
+			s.queue = append(s.queue, req)
+		}
+	}
+}
+
+// Process verify the node downloaded is one requested, put it in the storage and schedule its children.
+func (s *Syncer) Process(data []byte) ([]byte, error) {
+	h := hash.Sha3256(data)
+	key := byteutils.Hex(h)
+	req, ok := s.requested[key]
+	if !ok {
+		return nil, ErrUnrequestedNode
+	}
+	if err := s.expand(req, data); err != nil {
+		return nil, err
+	}
+	if err := s.storage.Put(h, data); err != nil {
+		return nil, err
+	}
+	delete(s.requested, key)
+	s.synced++
+	return h, nil
+}
+
+// expand schedule the children of the node.
+func (s *Syncer) expand(req *syncRequest, data []byte) error {
+	pb := new(triepb.Node)
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return err
+	}
+	n := new(node)
+	if err := n.FromProto(pb); err != nil {
+		return err
+	}
+	flag, err := n.Type()
+	if err != nil {
+		return err
+	}
+	switch flag {
+	case branch:
+		for _, child := range n.Val {
+			s.schedule(child, req.onLeaf)
+		}
+	case ext:
+		s.schedule(n.Val[2], req.onLeaf)
+	case leaf:
+		if req.onLeaf != nil {
+			for _, root := range req.onLeaf(n.Val[2]) {
+				s.schedule(root, nil)
+			}
+		}
+	default:
+		return errors.New("unknown node type")
+	}
+	return nil
+}
+
+// Pending return the number of the nodes scheduled or requested and not processed yet.
+func (s *Syncer) Pending() int {
+	return len(s.queue) + len(s.requested)
+}
+
+// Synced return the number of the nodes downloaded.
+func (s *Syncer) Synced() int {
+	return s.synced
+}
+
+// Done return whether all the nodes of the tries are in the storage.
+func (s *Syncer) Done() bool {
+	return s.Pending() == 0
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncer(t *testing.T) {
+	remote, _ := storage.NewMemoryStorage()
+	local, _ := storage.NewMemoryStorage()
+
+	// the values of the trie are the roots of sub tries.
+	sub, _ := NewTrie(nil, remote)
+	sub.Put([]byte("sub"), []byte("value"))
+	tr, _ := NewTrie(nil, remote)
+	keys := [][]byte{[]byte("key1"), []byte("key2"), []byte("key12"), []byte("other")}
+	for _, k := range keys {
+		tr.Put(k, sub.RootHash())
+	}
+
+	s := NewSyncer(local)
+	s.AddRoot(tr.RootHash(), func(val []byte) [][]byte {
+		return [][]byte{val}
+	})
+	for !s.Done() {
+		hashes := s.Missing(2)
+		assert.True(t, len(hashes) > 0)
+		for _, h := range hashes {
+			data, err := remote.Get(h)
+			assert.Nil(t, err)
+			_, err = s.Process(data)
+			assert.Nil(t, err)
+		}
+	}
+	assert.True(t, s.Synced() > len(keys))
+
+	synced, err := NewTrie(tr.RootHash(), local)
+	assert.Nil(t, err)
+	for _, k := range keys {
+		v, err := synced.Get(k)
+		assert.Nil(t, err)
+		assert.Equal(t, sub.RootHash(), v)
+	}
+	subSynced, err := NewTrie(sub.RootHash(), local)
+	assert.Nil(t, err)
+	v, err := subSynced.Get([]byte("sub"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), v)
+
+	// the nodes not requested are refused.
+	_, err = s.Process([]byte("forged"))
+	assert.Equal(t, ErrUnrequestedNode, err)
+
+	// the nodes already in the storage are not requested again.
+	resumed := NewSyncer(local)
+	resumed.AddRoot(tr.RootHash(), nil)
+	assert.Equal(t, 0, len(resumed.Missing(10)))
+	assert.True(t, resumed.Done())
+}
@@ -0,0 +1,302 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// mintTick is the period the pending transactions are checked.
+	mintTick = 100 * time.Millisecond
+	// maxMintBackoff is the longest the miner waits after the pending transactions couldn't be packed.
+	maxMintBackoff = 5 * time.Second
+)
+
+// Errors in Dev Consensus
+var (
+	ErrInvalidBlockProposer = errors.New("invalid block proposer")
+	ErrCannotMintBlockNow   = errors.New("cannot mint block now, waiting for sync over")
+	ErrNoTransactionToMint  = errors.New("no transaction can be packed into block")
+)
+
+// Neblet interface breaks cycle import dependency and hides unused services.
+type Neblet interface {
+	Config() nebletpb.Config
+	BlockChain() *core.BlockChain
+	AccountManager() *account.Manager
+}
+
+// Dev is the consensus of a single node developer chain,
+// the miner seals a block as soon as there are pending transactions.
+type Dev struct {
+	quitCh chan bool
+
+	chain *core.BlockChain
+	am    *account.Manager
+
+	coinbase   *core.Address
+	miner      *core.Address
+	passphrase string
+
+	// seconds between empty blocks, 0 means no empty blocks.
+	blockInterval int64
+	txsPerBlock   int
+
+	canMining bool
+
+	// time of the last block minted.
+	last int64
+	// the pending transactions, e.g. the ones of future nonces given back to the pool,
+	// are not packed again before retryAt, the backoff doubling until a block is minted.
+	backoff time.Duration
+	retryAt time.Time
+}
+
+// NewDev create Dev instance.
+func NewDev(neblet Neblet) (*Dev, error) {
+	config := neblet.Config().Chain
+	coinbase, err := core.AddressParse(config.Coinbase)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"address": config.Coinbase,
+			"err":     err,
+		}).Error("Failed to parse coinbase address.")
+		return nil, err
+	}
+	miner, err := core.AddressParse(config.Miner)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"address": config.Miner,
+			"err":     err,
+		}).Error("Failed to parse miner address.")
+		return nil, err
+	}
+	return &Dev{
+		quitCh: make(chan bool, 5),
+
+		chain: neblet.BlockChain(),
+		am:    neblet.AccountManager(),
+
+		coinbase:   coinbase,
+		miner:      miner,
+		passphrase: config.Passphrase,
+
+		blockInterval: int64(config.DevBlockInterval),
+		txsPerBlock:   2000,
+
+		canMining: false,
+	}, nil
+}
+
+// Start start dev service.
+func (d *Dev) Start() {
+	go d.blockLoop()
+}
+
+// Stop stop dev service.
+func (d *Dev) Stop() {
+	d.quitCh <- true
+}
+
+// CanMining return if consensus can do mining now
+func (d *Dev) CanMining() bool {
+	return d.canMining
+}
+
+// SetCanMining set if consensus can do mining now
+func (d *Dev) SetCanMining(canMining bool) {
+	if canMining {
+		logging.CLog().Info("Start Dev Mining.")
+	} else {
+		logging.CLog().Info("Stop Dev Mining.")
+	}
+	d.canMining = canMining
+}
+
+// FastVerifyBlock verify the block is signed by the miner
+func (d *Dev) FastVerifyBlock(block *core.Block) error {
+	signature, err := crypto.NewSignature(keystore.Algorithm(block.Alg()))
+	if err != nil {
+		return err
+	}
+	pub, err := signature.RecoverPublic(block.Hash(), block.Signature())
+	if err != nil {
+		return err
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return err
+	}
+	addr, err := core.NewAddressFromPublicKey(pubdata)
+	if err != nil {
+		return err
+	}
+	if !d.miner.Equals(addr) {
+		logging.VLog().WithFields(logrus.Fields{
+			"recover address": addr.String(),
+			"block":           block,
+		}).Error("Failed to verify block's sign.")
+		return ErrInvalidBlockProposer
+	}
+	block.SetMiner(d.miner)
+	return nil
+}
+
+// VerifyBlock verify the block with its parent found
+func (d *Dev) VerifyBlock(block *core.Block, parent *core.Block) error {
+	return d.FastVerifyBlock(block)
+}
+
+// nextSlot returns the seconds from tail to the first slot not earlier than now.
+func nextSlot(intervals *core.BlockIntervals, tail int64, now int64) int64 {
+	slot := tail + intervals.At(tail)
+	if slot < now {
+		slot = now
+	}
+	interval := intervals.At(slot)
+	if offset := slot % interval; offset != 0 {
+		slot += interval - offset
+	}
+	return slot - tail
+}
+
+func (d *Dev) mintBlock(now int64, allowEmpty bool) error {
+	if !d.canMining {
+		return ErrCannotMintBlockNow
+	}
+
+	tail := d.chain.TailBlock()
+	context, err := tail.NextDynastyContext(nextSlot(d.chain.BlockIntervals(), tail.Timestamp(), now))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"err":  err,
+		}).Error("Failed to generate next dynasty context.")
+		return core.ErrGenerateNextDynastyContext
+	}
+	block, err := core.NewBlock(d.chain.ChainID(), d.coinbase, tail)
+	if err != nil {
+		return err
+	}
+	if err = block.LoadDynastyContext(context); err != nil {
+		return err
+	}
+	block.SetMiner(d.miner)
+
+	// the random is signed before packing txs, the contracts in the block use it as the seed.
+	if err = d.am.Unlock(d.miner, []byte(d.passphrase)); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"miner": d.miner.String(),
+			"err":   err,
+		}).Error("Failed to unlock the miner")
+		return err
+	}
+	if err = d.am.SignBlockRandom(d.miner, block); err != nil {
+		return err
+	}
+	block.CollectTransactions(d.txsPerBlock)
+	if len(block.Transactions()) == 0 && !allowEmpty {
+		return ErrNoTransactionToMint
+	}
+	if err = block.Seal(); err != nil {
+		block.ReturnTransactions()
+		return err
+	}
+	if err = d.am.SignBlock(d.miner, block); err != nil {
+		block.ReturnTransactions()
+		return err
+	}
+	if err = d.chain.BlockPool().Push(block); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":  tail,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to push new block")
+		return err
+	}
+	if err = d.chain.SetTailBlock(block); err != nil {
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"tail":  tail,
+		"block": block,
+		"txs":   len(block.Transactions()),
+	}).Info("Minted new dev block")
+	return nil
+}
+
+// tick mint a block if there are pending transactions, or an empty one if it's due.
+func (d *Dev) tick(now time.Time) {
+	due := d.blockInterval > 0 && now.Unix()-d.last >= d.blockInterval
+	pending := !d.chain.TransactionPool().Empty() && !now.Before(d.retryAt)
+	if !pending && !due {
+		return
+	}
+
+	err := d.mintBlock(now.Unix(), due)
+	switch err {
+	case nil:
+		d.last, d.backoff, d.retryAt = now.Unix(), 0, time.Time{}
+	case ErrNoTransactionToMint:
+		if d.backoff *= 2; d.backoff == 0 {
+			d.backoff = mintTick
+		}
+		if d.backoff > maxMintBackoff {
+			d.backoff = maxMintBackoff
+		}
+		d.retryAt = now.Add(d.backoff)
+	}
+}
+
+func (d *Dev) blockLoop() {
+	logging.CLog().Info("Launched Dev Mining.")
+
+	d.last = time.Now().Unix()
+	timeChan := time.NewTicker(mintTick).C
+	for {
+		select {
+		case now := <-timeChan:
+			d.tick(now)
+		case block := <-d.chain.BlockPool().ReceivedLinkedBlockCh():
+			if block.Height() > d.chain.TailBlock().Height() {
+				if err := d.chain.SetTailBlock(block); err != nil {
+					logging.VLog().WithFields(logrus.Fields{
+						"block": block,
+						"err":   err,
+					}).Error("Failed to set new tail block.")
+				}
+			}
+		case <-d.quitCh:
+			logging.CLog().Info("Shutdowned Dev Mining.")
+			return
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+type Neb struct {
+	config  nebletpb.Config
+	chain   *core.BlockChain
+	am      *account.Manager
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *core.EventEmitter
+}
+
+func mockNeb() *Neb {
+	storage, _ := storage.NewMemoryStorage()
+	genesisConf := MockGenesisConf()
+	neb := &Neb{
+		genesis: genesisConf,
+		storage: storage,
+		emitter: core.NewEventEmitter(1024),
+		config: nebletpb.Config{
+			Chain: &nebletpb.ChainConfig{
+				ChainId:    genesisConf.Meta.ChainId,
+				Keydir:     "keydir",
+				Coinbase:   "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+				Miner:      "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+				Passphrase: "passphrase",
+			},
+		},
+	}
+	neb.am = account.NewManager(neb)
+	neb.chain, _ = core.NewBlockChain(neb)
+	return neb
+}
+
+func (n *Neb) Config() nebletpb.Config {
+	return n.config
+}
+
+func (n *Neb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *Neb) AccountManager() *account.Manager {
+	return n.am
+}
+
+func (n *Neb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *Neb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *Neb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *Neb) StartSync() {}
+
+// MockGenesisConf return mock genesis conf
+func MockGenesisConf() *corepb.Genesis {
+	return &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{ChainId: 0},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{
+				Dynasty: []string{
+					"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+					"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+					"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+					"48f981ed38910f1232c1bab124f650c482a57271632db9e3",
+					"59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232",
+					"75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f",
+				},
+			},
+		},
+		TokenDistribution: []*corepb.GenesisTokenDistribution{
+			&corepb.GenesisTokenDistribution{
+				Address: "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+				Value:   "10000000000000000000000",
+			},
+		},
+	}
+}
+
+func mockDev(t *testing.T) *Dev {
+	d, err := NewDev(mockNeb())
+	assert.Nil(t, err)
+	d.chain.SetConsensusHandler(d)
+	d.SetCanMining(true)
+	return d
+}
+
+// pushTx sign a transfer of the miner with the nonce, and push it into the pool.
+func pushTx(t *testing.T, d *Dev, nonce uint64) *core.Transaction {
+	tx := core.NewTransaction(d.chain.ChainID(), d.miner, d.miner, util.NewUint128(), nonce, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, d.am.Unlock(d.miner, []byte(d.passphrase)))
+	assert.Nil(t, d.am.SignTransaction(d.miner, tx))
+	assert.Nil(t, d.chain.TransactionPool().Push(tx))
+	return tx
+}
+
+func TestNextSlot(t *testing.T) {
+	tail := core.BlockInterval
+	assert.Equal(t, nextSlot(core.DefaultBlockIntervals, tail, core.BlockInterval), core.BlockInterval)
+	assert.Equal(t, nextSlot(core.DefaultBlockIntervals, tail, core.BlockInterval+1), core.BlockInterval)
+	assert.Equal(t, nextSlot(core.DefaultBlockIntervals, tail, core.BlockInterval*3), core.BlockInterval*2)
+	assert.Equal(t, nextSlot(core.DefaultBlockIntervals, tail, core.BlockInterval*3+1), core.BlockInterval*3)
+}
+
+func TestDev_MintBlock(t *testing.T) {
+	d := mockDev(t)
+	now := time.Now().Unix()
+
+	d.SetCanMining(false)
+	assert.Equal(t, d.mintBlock(now, true), ErrCannotMintBlockNow)
+	d.SetCanMining(true)
+
+	// nothing to pack, the empty block is only minted when it's due.
+	assert.Equal(t, d.mintBlock(now, false), ErrNoTransactionToMint)
+	assert.Equal(t, d.chain.TailBlock().Height(), uint64(1))
+	assert.Nil(t, d.mintBlock(now, true))
+	assert.Equal(t, d.chain.TailBlock().Height(), uint64(2))
+	assert.Equal(t, len(d.chain.TailBlock().Transactions()), 0)
+
+	tx := pushTx(t, d, 1)
+	assert.Nil(t, d.mintBlock(now, false))
+	tail := d.chain.TailBlock()
+	assert.Equal(t, tail.Height(), uint64(3))
+	assert.Equal(t, len(tail.Transactions()), 1)
+	assert.Equal(t, tail.Transactions()[0].Hash(), tx.Hash())
+	assert.True(t, d.chain.TransactionPool().Empty())
+}
+
+func TestDev_Backoff(t *testing.T) {
+	d := mockDev(t)
+	now := time.Now()
+	d.last = now.Unix()
+
+	// the tx of a future nonce is given back to the pool, the miner backs off.
+	pushTx(t, d, 5)
+	d.tick(now)
+	assert.Equal(t, d.backoff, mintTick)
+	assert.Equal(t, d.retryAt, now.Add(mintTick))
+	assert.False(t, d.chain.TransactionPool().Empty())
+
+	// not retried before the backoff is over.
+	d.tick(now.Add(mintTick / 2))
+	assert.Equal(t, d.backoff, mintTick)
+
+	// each failed retry doubles the backoff, up to the max.
+	d.tick(d.retryAt)
+	assert.Equal(t, d.backoff, 2*mintTick)
+	for i := 0; i < 10; i++ {
+		d.tick(d.retryAt)
+	}
+	assert.Equal(t, d.backoff, maxMintBackoff)
+	assert.Equal(t, d.chain.TailBlock().Height(), uint64(1))
+
+	// the missing nonces arrive, the block is minted at the retry and the backoff reset.
+	for nonce := uint64(1); nonce < 5; nonce++ {
+		pushTx(t, d, nonce)
+	}
+	d.tick(d.retryAt)
+	assert.Equal(t, d.chain.TailBlock().Height(), uint64(2))
+	assert.Equal(t, len(d.chain.TailBlock().Transactions()), 5)
+	assert.Equal(t, d.backoff, time.Duration(0))
+	assert.True(t, d.retryAt.IsZero())
+}
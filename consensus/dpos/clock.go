@@ -0,0 +1,269 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// Clock parameters
+var (
+	// DefaultNTPServers are queried if no ntp server is configured.
+	DefaultNTPServers = []string{"pool.ntp.org"}
+
+	ClockCheckInterval = 10 * time.Minute
+	NTPQueryTimeout    = 5 * time.Second
+
+	// the drift exceeding the tolerance makes the slots of the proposer missed or rejected.
+	ClockDriftTolerance = time.Duration(core.AcceptedNetWorkDelay) * time.Second
+
+	// number of recent peer blocks to estimate the drift, and the least to judge.
+	PeerSampleSize    = 21
+	MinPeerSampleSize = 5
+)
+
+// Errors in clock monitor
+var (
+	ErrClockDrifted        = errors.New("local clock drifted beyond the tolerance")
+	ErrInvalidNTPResponse  = errors.New("invalid ntp response")
+	ErrNoNTPServerResponse = errors.New("no ntp server responded")
+)
+
+var (
+	ntpOffsetGauge  = metrics.GetOrRegisterGauge("neb.dpos.clock.ntp_offset", nil)
+	peerOffsetGauge = metrics.GetOrRegisterGauge("neb.dpos.clock.peer_offset", nil)
+)
+
+// seconds between 1900 (ntp epoch) and 1970 (unix epoch).
+const ntpEpochOffset = 2208988800
+
+// ClockMonitor tracks the offset of the local clock against ntp servers and
+// the timestamps of blocks minted by peers.
+type ClockMonitor struct {
+	servers   []string
+	tolerance time.Duration
+
+	mu          sync.RWMutex
+	ntpOffset   time.Duration
+	ntpValid    bool
+	peerOffsets []time.Duration
+	drifted     bool
+
+	quitCh chan bool
+}
+
+// NewClockMonitor create a clock monitor querying the servers.
+func NewClockMonitor(servers []string) *ClockMonitor {
+	if len(servers) == 0 {
+		servers = DefaultNTPServers
+	}
+	return &ClockMonitor{
+		servers:   servers,
+		tolerance: ClockDriftTolerance,
+		quitCh:    make(chan bool, 1),
+	}
+}
+
+// Start start checking the clock against ntp servers.
+func (c *ClockMonitor) Start() {
+	go c.loop()
+}
+
+// Stop stop checking the clock.
+func (c *ClockMonitor) Stop() {
+	c.quitCh <- true
+}
+
+func (c *ClockMonitor) loop() {
+	c.checkNTP()
+	ticker := time.NewTicker(ClockCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.checkNTP()
+		case <-c.quitCh:
+			return
+		}
+	}
+}
+
+// checkNTP updates the ntp offset with the median of the offsets reported by servers.
+func (c *ClockMonitor) checkNTP() {
+	offsets := []time.Duration{}
+	for _, server := range c.servers {
+		offset, err := queryNTP(server, NTPQueryTimeout)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"server": server,
+				"err":    err,
+			}).Debug("Failed to query ntp server.")
+			continue
+		}
+		offsets = append(offsets, offset)
+	}
+	if len(offsets) == 0 {
+		logging.VLog().WithFields(logrus.Fields{
+			"servers": c.servers,
+			"err":     ErrNoNTPServerResponse,
+		}).Warn("Failed to check the clock against ntp servers.")
+		return
+	}
+
+	offset := median(offsets)
+	ntpOffsetGauge.Update(int64(offset / time.Millisecond))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ntpOffset, c.ntpValid = offset, true
+	c.update()
+}
+
+// AddPeerSample records the offset of a block received from peers, it's minted at
+// the start of the slot, so the offset is the peer's clock ahead of local minus the delay.
+func (c *ClockMonitor) AddPeerSample(block *core.Block, now time.Time) {
+	offset := time.Unix(block.Timestamp(), 0).Sub(now)
+	// blocks synced or delayed by more than a slot tell nothing about the clock.
+	slot := time.Duration(block.BlockIntervalAt(block.Timestamp())) * time.Second
+	if offset < -slot || offset > slot {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.peerOffsets = append(c.peerOffsets, offset)
+	if len(c.peerOffsets) > PeerSampleSize {
+		c.peerOffsets = c.peerOffsets[len(c.peerOffsets)-PeerSampleSize:]
+	}
+	if len(c.peerOffsets) >= MinPeerSampleSize {
+		peerOffsetGauge.Update(int64(median(c.peerOffsets) / time.Millisecond))
+	}
+	c.update()
+}
+
+// Check returns ErrClockDrifted if the local clock drifts beyond the tolerance.
+func (c *ClockMonitor) Check() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.drifted {
+		return ErrClockDrifted
+	}
+	return nil
+}
+
+// update judges the drift and alerts on changes, must hold the lock.
+func (c *ClockMonitor) update() {
+	var offset time.Duration
+	drifted := false
+	if c.ntpValid && abs(c.ntpOffset) > c.tolerance {
+		offset, drifted = c.ntpOffset, true
+	}
+	if len(c.peerOffsets) >= MinPeerSampleSize {
+		if peer := median(c.peerOffsets); abs(peer) > c.tolerance && !drifted {
+			offset, drifted = peer, true
+		}
+	}
+	if drifted == c.drifted {
+		return
+	}
+	c.drifted = drifted
+
+	if drifted {
+		logging.CLog().WithFields(logrus.Fields{
+			"ntp":       c.ntpOffset,
+			"offset":    offset,
+			"tolerance": c.tolerance,
+		}).Error("Local clock drifted, stop proposing blocks until it's synchronized.")
+	} else {
+		logging.CLog().WithFields(logrus.Fields{
+			"ntp": c.ntpOffset,
+		}).Info("Local clock synchronized, resume proposing blocks.")
+	}
+}
+
+// queryNTP returns the offset of the server's clock ahead of the local clock.
+func queryNTP(server string, timeout time.Duration) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+	conn, err := net.DialTimeout("udp", server, timeout)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	// leap indicator 0, version 3, client mode.
+	req := make([]byte, 48)
+	req[0] = 0x1b
+	sent := time.Now()
+	if _, err := conn.Write(req); err != nil {
+		return 0, err
+	}
+	resp := make([]byte, 48)
+	n, err := conn.Read(resp)
+	if err != nil {
+		return 0, err
+	}
+	received := time.Now()
+	return parseNTPResponse(resp[:n], sent, received)
+}
+
+// parseNTPResponse computes the offset of the server with ((t2 - t1) + (t3 - t4)) / 2.
+func parseNTPResponse(resp []byte, sent, received time.Time) (time.Duration, error) {
+	// must be server mode and not a kiss-of-death packet.
+	if len(resp) < 48 || resp[0]&0x7 != 4 || resp[1] == 0 {
+		return 0, ErrInvalidNTPResponse
+	}
+	serverReceived := ntpTime(resp[32:40])
+	serverSent := ntpTime(resp[40:48])
+	return (serverReceived.Sub(sent) + serverSent.Sub(received)) / 2, nil
+}
+
+func ntpTime(data []byte) time.Time {
+	sec := int64(binary.BigEndian.Uint32(data[:4])) - ntpEpochOffset
+	nsec := int64(uint64(binary.BigEndian.Uint32(data[4:])) * 1e9 >> 32)
+	return time.Unix(sec, nsec)
+}
+
+func median(values []time.Duration) time.Duration {
+	sorted := make([]time.Duration, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[len(sorted)/2]
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func putNTPTime(data []byte, t time.Time) {
+	binary.BigEndian.PutUint32(data[:4], uint32(t.Unix()+ntpEpochOffset))
+	binary.BigEndian.PutUint32(data[4:], uint32((uint64(t.Nanosecond())<<32)/1e9))
+}
+
+func TestParseNTPResponse(t *testing.T) {
+	sent := time.Unix(1500000000, 0)
+	received := sent.Add(200 * time.Millisecond)
+	resp := make([]byte, 48)
+	resp[0], resp[1] = 0x1c, 2
+	// the server is 3 seconds ahead, and the delay is 100ms each way.
+	putNTPTime(resp[32:40], sent.Add(3*time.Second+100*time.Millisecond))
+	putNTPTime(resp[40:48], sent.Add(3*time.Second+100*time.Millisecond))
+
+	offset, err := parseNTPResponse(resp, sent, received)
+	assert.Nil(t, err)
+	assert.True(t, abs(offset-3*time.Second) < time.Millisecond)
+
+	_, err = parseNTPResponse(resp[:47], sent, received)
+	assert.Equal(t, err, ErrInvalidNTPResponse)
+	resp[1] = 0
+	_, err = parseNTPResponse(resp, sent, received)
+	assert.Equal(t, err, ErrInvalidNTPResponse)
+}
+
+func TestClockMonitor(t *testing.T) {
+	neb := mockNeb()
+	coinbase, err := core.AddressParse(neb.config.Chain.Coinbase)
+	assert.Nil(t, err)
+	block, err := core.NewBlock(neb.chain.ChainID(), coinbase, neb.chain.TailBlock())
+	assert.Nil(t, err)
+	block.SetTimestamp(1500000000)
+	slot := time.Unix(block.Timestamp(), 0)
+
+	clock := NewClockMonitor(nil)
+	assert.Equal(t, clock.servers, DefaultNTPServers)
+
+	// blocks arrive shortly after the slot.
+	for i := 0; i < PeerSampleSize; i++ {
+		clock.AddPeerSample(block, slot.Add(300*time.Millisecond))
+	}
+	assert.Nil(t, clock.Check())
+
+	// synced blocks are ignored.
+	for i := 0; i < PeerSampleSize; i++ {
+		clock.AddPeerSample(block, slot.Add(time.Hour))
+	}
+	assert.Nil(t, clock.Check())
+
+	// local clock runs 3 seconds ahead of peers.
+	for i := 0; i < PeerSampleSize/2+1; i++ {
+		clock.AddPeerSample(block, slot.Add(3*time.Second))
+	}
+	assert.Equal(t, clock.Check(), ErrClockDrifted)
+
+	for i := 0; i < PeerSampleSize/2+1; i++ {
+		clock.AddPeerSample(block, slot)
+	}
+	assert.Nil(t, clock.Check())
+
+	// ntp servers report the drift.
+	clock.mu.Lock()
+	clock.ntpOffset, clock.ntpValid = -3*time.Second, true
+	clock.update()
+	clock.mu.Unlock()
+	assert.Equal(t, clock.Check(), ErrClockDrifted)
+}
@@ -19,6 +19,7 @@
 package dpos
 
 import (
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -43,6 +44,7 @@ var (
 	ErrMissingConfigForDpos = errors.New("missing configuration for Dpos")
 	ErrInvalidBlockProposer = errors.New("invalid block proposer")
 	ErrCannotMintBlockNow   = errors.New("cannot mint block now, waiting for sync over")
+	ErrUnregisteredSigner   = errors.New("signer is not registered by the miner in the dynasty")
 )
 
 // Neblet interface breaks cycle import dependency and hides unused services.
@@ -61,15 +63,28 @@ type Dpos struct {
 	nm    p2p.Manager
 	am    *account.Manager
 
-	coinbase   *core.Address
-	miner      *core.Address
+	coinbase *core.Address
+	miner    *core.Address
+	// key signing the blocks of the miner, the miner's own key or the signing key it registered.
+	signer     *core.Address
 	passphrase string
 
-	blockInterval   int64
+	// signing key registered by the miner, switched to when the signer fails to sign blocks.
+	backupCoinbase   *core.Address
+	backupSigner     *core.Address
+	backupPassphrase string
+
 	dynastyInterval int64
 	txsPerBlock     int
 
+	// block assembled ahead of the coming slot of the miner.
+	pendingBlock *core.Block
+
+	clock *ClockMonitor
+
 	canMining bool
+	// observer never mines.
+	observer bool
 }
 
 // NewDpos create Dpos instance.
@@ -81,7 +96,6 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 		nm:    neblet.NetManager(),
 		am:    neblet.AccountManager(),
 
-		blockInterval:   core.BlockInterval,
 		dynastyInterval: core.DynastyInterval,
 		txsPerBlock:     2000,
 
@@ -89,6 +103,11 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 	}
 
 	config := neblet.Config().Chain
+	p.clock = NewClockMonitor(config.NtpServers)
+	if config.Observer || config.Relay {
+		p.observer = true
+		return p, nil
+	}
 	coinbase, err := core.AddressParse(config.Coinbase)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -107,17 +126,44 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 	}
 	p.coinbase = coinbase
 	p.miner = miner
+	p.signer = miner
 	p.passphrase = config.Passphrase
+
+	if len(config.BackupMiner) > 0 {
+		backupSigner, err := core.AddressParse(config.BackupMiner)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": config.BackupMiner,
+				"err":     err,
+			}).Error("Failed to parse backup miner address.")
+			return nil, err
+		}
+		backupCoinbase := coinbase
+		if len(config.BackupCoinbase) > 0 {
+			if backupCoinbase, err = core.AddressParse(config.BackupCoinbase); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"address": config.BackupCoinbase,
+					"err":     err,
+				}).Error("Failed to parse backup coinbase address.")
+				return nil, err
+			}
+		}
+		p.backupCoinbase = backupCoinbase
+		p.backupSigner = backupSigner
+		p.backupPassphrase = config.BackupPassphrase
+	}
 	return p, nil
 }
 
 // Start start pow service.
 func (p *Dpos) Start() {
+	p.clock.Start()
 	go p.blockLoop()
 }
 
 // Stop stop pow service.
 func (p *Dpos) Stop() {
+	p.clock.Stop()
 	p.quitCh <- true
 }
 
@@ -172,6 +218,10 @@ func (p *Dpos) CanMining() bool {
 
 // SetCanMining set if consensus can do mining now
 func (p *Dpos) SetCanMining(canMining bool) {
+	if canMining && p.observer {
+		logging.CLog().Info("Observer never mines.")
+		return
+	}
 	if canMining {
 		logging.CLog().Info("Start Dpos Mining.")
 	} else {
@@ -180,42 +230,52 @@ func (p *Dpos) SetCanMining(canMining bool) {
 	p.canMining = canMining
 }
 
-func verifyBlockSign(miner *core.Address, block *core.Block) error {
+func recoverBlockSigner(block *core.Block) (*core.Address, error) {
 	signature, err := crypto.NewSignature(keystore.Algorithm(block.Alg()))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	pub, err := signature.RecoverPublic(block.Hash(), block.Signature())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	pubdata, err := pub.Encoded()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	addr, err := core.NewAddressFromPublicKey(pubdata)
+	return core.NewAddressFromPublicKey(pubdata)
+}
+
+// verifyBlockSign verify the block is signed by the miner or the signing key it registered in the dynasty,
+// and return the key signing it.
+func verifyBlockSign(miner *core.Address, dynasty *trie.BatchTrie, block *core.Block) (*core.Address, error) {
+	addr, err := recoverBlockSigner(block)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	ok, err := core.CanSign(dynasty, miner.Bytes(), addr)
+	if err != nil {
+		return nil, err
 	}
-	if !miner.Equals(addr) {
+	if !ok {
 		logging.VLog().WithFields(logrus.Fields{
 			"recover address": addr.String(),
 			"block":           block,
 		}).Error("Failed to verify block's sign.")
-		return ErrInvalidBlockProposer
+		return nil, ErrInvalidBlockProposer
 	}
 	block.SetMiner(miner)
-	return nil
+	return addr, nil
 }
 
 // FastVerifyBlock verify the block before its parent found
 // can be verified if the block's dynasty == tail's dynasty
 // can be verified if the block's dynasty == tails's next dynasty
+// only the membership of the proposer is checked, the order of proposers depends on the parent
 func (p *Dpos) FastVerifyBlock(block *core.Block) error {
 	tail := p.chain.TailBlock()
 	// check timestamp
-	elapsedSecond := block.Timestamp() - tail.Timestamp()
-	if elapsedSecond%p.blockInterval != 0 {
+	if block.Timestamp()%p.chain.BlockIntervalAt(block.Timestamp()) != 0 {
 		return ErrInvalidBlockInterval
 	}
 	// check proposer
@@ -233,15 +293,27 @@ func (p *Dpos) FastVerifyBlock(block *core.Block) error {
 	if err != nil {
 		return err
 	}
-	proposer, err := core.FindProposer(block.Timestamp(), dynasty)
+	signer, err := recoverBlockSigner(block)
 	if err != nil {
 		return err
 	}
-	miner, err := core.AddressParseFromBytes(proposer)
+	delegatee, err := core.DelegateeOf(dynasty, signer)
+	if err != nil {
+		return err
+	}
+	if delegatee == nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"recover address": signer.String(),
+			"block":           block,
+		}).Error("Failed to verify block's sign.")
+		return ErrInvalidBlockProposer
+	}
+	miner, err := core.AddressParseFromBytes(delegatee)
 	if err != nil {
 		return err
 	}
-	return verifyBlockSign(miner, block)
+	block.SetMiner(miner)
+	return nil
 }
 
 // VerifyBlock verify the block with its parent found
@@ -251,7 +323,11 @@ func (p *Dpos) VerifyBlock(block *core.Block, parent *core.Block) error {
 	if err != nil {
 		return err
 	}
-	proposer, err := core.FindProposer(block.Timestamp(), dynasty)
+	seed, err := core.RoundSeed(parent, block.Timestamp())
+	if err != nil {
+		return err
+	}
+	proposer, err := core.FindProposer(block.Timestamp(), p.chain.BlockIntervalAt(block.Timestamp()), dynasty, seed)
 	if err != nil {
 		return err
 	}
@@ -259,11 +335,11 @@ func (p *Dpos) VerifyBlock(block *core.Block, parent *core.Block) error {
 	if err != nil {
 		return err
 	}
-	err = verifyBlockSign(miner, block)
+	signer, err := verifyBlockSign(miner, dynasty, block)
 	if err != nil {
 		return err
 	}
-	return nil
+	return block.VerifyRandom(parent, signer)
 }
 
 func (p *Dpos) mintBlock(now int64) error {
@@ -275,6 +351,15 @@ func (p *Dpos) mintBlock(now int64) error {
 		return ErrCannotMintBlockNow
 	}
 
+	// drifted clock makes the block missed or rejected
+	if err := p.clock.Check(); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"now": now,
+			"err": err,
+		}).Error("Refused to mint block with drifted clock.")
+		return err
+	}
+
 	// check proposer
 	tail := p.chain.TailBlock()
 	elapsedSecond := now - tail.Timestamp()
@@ -307,20 +392,30 @@ func (p *Dpos) mintBlock(now int64) error {
 		"actual":   p.coinbase.String(),
 	}).Info("My turn to mint block")
 
-	// mint new block
-	block, err := core.NewBlock(p.chain.ChainID(), p.coinbase, tail)
-	if err != nil {
+	// mint new block, reuse the block assembled before the slot if possible
+	block := p.takePendingBlock(tail, now)
+	if block == nil {
+		if block, err = p.newBlock(tail, context); err != nil {
+			return err
+		}
+	}
+	// the throttled packing stops in time to seal and broadcast the block in the slot.
+	block.CollectTransactionsBefore(p.txsPerBlock-len(block.Transactions()), core.PackingDeadline(now, p.chain.BlockIntervalAt(now)))
+	block.CollectEvidences(p.chain.EvidencePool())
+	// TODO: move passphrase from config to console
+	if err = p.am.Unlock(p.signer, []byte(p.passphrase)); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
-			"tail":     tail,
-			"coinbase": p.coinbase,
-			"chainid":  p.chain.ChainID(),
-			"err":      err,
-		}).Error("Failed to create new block")
+			"miner":  p.miner.String(),
+			"signer": p.signer.String(),
+			"err":    err,
+		}).Error("Failed to unlock the miner")
+		p.failover(err)
+		return err
+	}
+	// never sign two blocks in the same slot
+	if err = lockSign(p.chain.Storage(), p.signer, block); err != nil {
 		return err
 	}
-	block.LoadDynastyContext(context)
-	block.CollectTransactions(p.txsPerBlock)
-	block.SetMiner(p.miner)
 	if err = block.Seal(); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"block": block,
@@ -328,20 +423,14 @@ func (p *Dpos) mintBlock(now int64) error {
 		}).Error("Failed to seal new block")
 		return err
 	}
-	// TODO: move passphrase from config to console
-	if err = p.am.Unlock(p.miner, []byte(p.passphrase)); err != nil {
-		logging.VLog().WithFields(logrus.Fields{
-			"miner": p.miner.String(),
-			"err":   err,
-		}).Error("Failed to unlock the miner")
-		return err
-	}
-	if err = p.am.SignBlock(p.miner, block); err != nil {
+	if err = p.am.SignBlock(p.signer, block); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
-			"miner": p.miner.String(),
-			"block": block,
-			"err":   err,
+			"miner":  p.miner.String(),
+			"signer": p.signer.String(),
+			"block":  block,
+			"err":    err,
 		}).Error("Failed to sign new block")
+		p.failover(err)
 		return err
 	}
 	// broadcast it
@@ -362,6 +451,167 @@ func (p *Dpos) mintBlock(now int64) error {
 	return nil
 }
 
+// failover switches to the backup signer once the signer fails to sign blocks, the backup signs the
+// blocks of the miner in its slots, with the signing key the miner registered in the dynasty.
+func (p *Dpos) failover(cause error) {
+	if p.backupSigner == nil {
+		return
+	}
+	from, to := p.signer, p.backupSigner
+	p.coinbase, p.signer, p.passphrase = p.backupCoinbase, p.backupSigner, p.backupPassphrase
+	p.backupCoinbase, p.backupSigner, p.backupPassphrase = nil, nil, ""
+	// the random of the prepared block is signed by the failed signer.
+	p.discardPendingBlock()
+
+	logging.CLog().WithFields(logrus.Fields{
+		"miner":    p.miner.String(),
+		"from":     from.String(),
+		"to":       to.String(),
+		"coinbase": p.coinbase.String(),
+		"err":      cause,
+	}).Warn("Signer is unavailable, switched to the backup signer.")
+
+	data, err := json.Marshal(map[string]interface{}{
+		"miner":    p.miner.String(),
+		"from":     from.String(),
+		"to":       to.String(),
+		"coinbase": p.coinbase.String(),
+		"err":      cause.Error(),
+	})
+	if err != nil {
+		return
+	}
+	p.chain.EventEmitter().Trigger(&core.Event{
+		Topic: core.TopicMinerFailover,
+		Data:  string(data),
+	})
+}
+
+func (p *Dpos) newBlock(tail *core.Block, context *core.DynastyContext) (*core.Block, error) {
+	block, err := core.NewBlock(p.chain.ChainID(), p.coinbase, tail)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":     tail,
+			"coinbase": p.coinbase,
+			"chainid":  p.chain.ChainID(),
+			"err":      err,
+		}).Error("Failed to create new block")
+		return nil, err
+	}
+	if err = block.LoadDynastyContext(context); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"err":  err,
+		}).Error("Failed to load dynasty context into new block")
+		return nil, err
+	}
+	block.SetMiner(p.miner)
+	// a signer not registered in the dynasty signs blocks rejected by the others.
+	if !p.signer.Equals(p.miner) {
+		if ok, err := core.CanSign(context.DynastyTrie, p.miner.Bytes(), p.signer); err != nil || !ok {
+			logging.VLog().WithFields(logrus.Fields{
+				"miner":  p.miner.String(),
+				"signer": p.signer.String(),
+				"err":    err,
+			}).Error("Signer is not registered by the miner in the dynasty.")
+			return nil, ErrUnregisteredSigner
+		}
+	}
+	// the random is signed before packing txs, the contracts in the block use it as the seed.
+	if err = p.am.Unlock(p.signer, []byte(p.passphrase)); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"miner":  p.miner.String(),
+			"signer": p.signer.String(),
+			"err":    err,
+		}).Error("Failed to unlock the miner")
+		p.failover(err)
+		return nil, err
+	}
+	if err = p.am.SignBlockRandom(p.signer, block); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"miner":  p.miner.String(),
+			"signer": p.signer.String(),
+			"block":  block,
+			"err":    err,
+		}).Error("Failed to sign random of new block")
+		p.failover(err)
+		return nil, err
+	}
+	return block, nil
+}
+
+// prepareBlock assembles the block of the coming slot before the slot arrives,
+// so only sealing, signing and broadcasting are left in the slot.
+func (p *Dpos) prepareBlock(now int64) {
+	if !p.canMining {
+		p.discardPendingBlock()
+		return
+	}
+
+	tail := p.chain.TailBlock()
+	interval := p.chain.BlockIntervalAt(now)
+	slot := now - now%interval + interval
+	if slot <= tail.Timestamp() {
+		return
+	}
+	if p.pendingBlock != nil &&
+		(!p.pendingBlock.ParentHash().Equals(tail.Hash()) || p.pendingBlock.Timestamp() != slot) {
+		p.discardPendingBlock()
+	}
+
+	if p.pendingBlock == nil {
+		context, err := tail.NextDynastyContext(slot - tail.Timestamp())
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"tail": tail,
+				"slot": slot,
+				"err":  err,
+			}).Error("Failed to generate next dynasty context.")
+			return
+		}
+		if context.Proposer == nil || !context.Proposer.Equals(p.miner.Bytes()) {
+			return
+		}
+		block, err := p.newBlock(tail, context)
+		if err != nil {
+			return
+		}
+		p.pendingBlock = block
+		logging.VLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"slot": slot,
+		}).Info("Start to prepare block for the coming slot.")
+	}
+
+	// keep packing the txs arrived before the slot, without delaying the slot.
+	p.pendingBlock.CollectTransactionsBefore(p.txsPerBlock-len(p.pendingBlock.Transactions()), time.Unix(slot, 0))
+}
+
+// takePendingBlock returns the prepared block if it's built on tail for the slot.
+func (p *Dpos) takePendingBlock(tail *core.Block, slot int64) *core.Block {
+	block := p.pendingBlock
+	if block == nil {
+		return nil
+	}
+	if !block.ParentHash().Equals(tail.Hash()) || block.Timestamp() != slot {
+		p.discardPendingBlock()
+		return nil
+	}
+	p.pendingBlock = nil
+	return block
+}
+
+func (p *Dpos) discardPendingBlock() {
+	if p.pendingBlock == nil {
+		return
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"block": p.pendingBlock,
+	}).Info("Discard the prepared block.")
+	p.pendingBlock.ReturnTransactions()
+	p.pendingBlock = nil
+}
+
 func (p *Dpos) blockLoop() {
 	logging.CLog().Info("Launched Dpos Mining.")
 
@@ -369,8 +619,16 @@ func (p *Dpos) blockLoop() {
 	for {
 		select {
 		case now := <-timeChan:
-			p.mintBlock(now.Unix())
-		case <-p.chain.BlockPool().ReceivedLinkedBlockCh():
+			if now.Unix()%p.chain.BlockIntervalAt(now.Unix()) == 0 {
+				p.mintBlock(now.Unix())
+			} else {
+				p.prepareBlock(now.Unix())
+			}
+		case block := <-p.chain.BlockPool().ReceivedLinkedBlockCh():
+			// the blocks of our own tell nothing about the clock.
+			if block.Miner() == nil || p.miner == nil || !block.Miner().Equals(p.miner) {
+				p.clock.AddPeerSample(block, time.Now())
+			}
 			p.forkChoice()
 		case <-p.quitCh:
 			logging.CLog().Info("Shutdowned Dpos Mining.")
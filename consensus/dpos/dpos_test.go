@@ -34,6 +34,7 @@ import (
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/net/p2p"
 	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -138,6 +139,7 @@ func MockGenesisConf() *corepb.Genesis {
 				Dynasty: DefaultOpenDynasty,
 			},
 		},
+		RandomHeight: 1,
 		TokenDistribution: []*corepb.GenesisTokenDistribution{
 			&corepb.GenesisTokenDistribution{
 				Address: "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
@@ -200,6 +202,10 @@ func (n MockNetManager) BroadcastNetworkID([]byte) {}
 
 func (n MockNetManager) BuildData([]byte, string) []byte { return nil }
 
+func (n MockNetManager) ReportPeer(string, p2p.PeerMisbehavior) {}
+
+func (n MockNetManager) UpdatePeerHead(string, uint64, string) {}
+
 func TestDpos_New(t *testing.T) {
 	neb := mockNeb()
 	_, err := NewDpos(neb)
@@ -230,11 +236,12 @@ func TestDpos_VerifySign(t *testing.T) {
 	assert.Nil(t, err)
 	block.LoadDynastyContext(context)
 	block.SetMiner(coinbase)
-	block.Seal()
 	manager := account.NewManager(nil)
 	miner, err := core.AddressParseFromBytes(context.Proposer)
 	assert.Nil(t, err)
 	assert.Nil(t, manager.Unlock(miner, []byte("passphrase")))
+	assert.Nil(t, manager.SignBlockRandom(miner, block))
+	block.Seal()
 	assert.Nil(t, manager.SignBlock(miner, block))
 	assert.Nil(t, dpos.VerifyBlock(block, tail))
 
@@ -376,6 +383,19 @@ func TestFastVerifyBlock(t *testing.T) {
 	assert.Nil(t, dpos.FastVerifyBlock(block))
 }
 
+// findSlot returns the first slot after tail proposed by the miner, or by others.
+func findSlot(t *testing.T, tail *core.Block, miner *core.Address, mine bool) int64 {
+	for slot := tail.Timestamp() + core.BlockInterval; slot <= tail.Timestamp()+core.DynastyInterval; slot += core.BlockInterval {
+		context, err := tail.NextDynastyContext(slot - tail.Timestamp())
+		assert.Nil(t, err)
+		if context.Proposer.Equals(miner.Bytes()) == mine {
+			return slot
+		}
+	}
+	t.Fatal("no slot found")
+	return 0
+}
+
 func TestDpos_MintBlock(t *testing.T) {
 	dpos, err := NewDpos(mockNeb())
 	assert.Nil(t, err)
@@ -390,9 +410,128 @@ func TestDpos_MintBlock(t *testing.T) {
 	assert.Equal(t, dpos.mintBlock(0), ErrCannotMintBlockNow)
 
 	dpos.SetCanMining(true)
-	assert.Equal(t, dpos.mintBlock(core.BlockInterval), ErrInvalidBlockProposer)
+	tail := dpos.chain.TailBlock()
+	assert.Equal(t, dpos.mintBlock(findSlot(t, tail, coinbase, false)), ErrInvalidBlockProposer)
+
+	received = []byte{}
+	assert.Equal(t, dpos.mintBlock(findSlot(t, tail, coinbase, true)), nil)
+	assert.NotEqual(t, received, []byte{})
+}
+
+func TestDpos_PrepareBlock(t *testing.T) {
+	dpos, err := NewDpos(mockNeb())
+	assert.Nil(t, err)
+	var c MockConsensus
+	dpos.chain.SetConsensusHandler(c)
+
+	coinbase, err := core.AddressParse("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	assert.Nil(t, err)
+	manager := account.NewManager(nil)
+	assert.Nil(t, manager.Unlock(coinbase, []byte("passphrase")))
+
+	tail := dpos.chain.TailBlock()
+	mine, other := findSlot(t, tail, coinbase, true), findSlot(t, tail, coinbase, false)
+	dpos.prepareBlock(mine - 1)
+	assert.Nil(t, dpos.pendingBlock)
+
+	dpos.SetCanMining(true)
+	dpos.prepareBlock(other - 1)
+	assert.Nil(t, dpos.pendingBlock)
+
+	dpos.prepareBlock(mine - 1)
+	assert.NotNil(t, dpos.pendingBlock)
+	assert.Equal(t, dpos.pendingBlock.Timestamp(), mine)
+	pending := dpos.pendingBlock
 
 	received = []byte{}
-	assert.Equal(t, dpos.mintBlock(core.DynastyInterval), nil)
+	assert.Equal(t, dpos.mintBlock(mine), nil)
 	assert.NotEqual(t, received, []byte{})
+	assert.Nil(t, dpos.pendingBlock)
+	assert.True(t, pending.Sealed())
+}
+
+func TestDpos_Failover(t *testing.T) {
+	neb := mockNeb()
+	neb.config.Chain.BackupMiner = "98a3eed687640b75ec55bf5c9e284371bdcaeab943524d51"
+	neb.config.Chain.BackupPassphrase = "passphrase"
+	dpos, err := NewDpos(neb)
+	assert.Nil(t, err)
+	var c MockConsensus
+	dpos.chain.SetConsensusHandler(c)
+	dpos.SetCanMining(true)
+
+	ch := make(chan *core.Event, 1)
+	assert.Nil(t, neb.emitter.Register(core.TopicMinerFailover, ch))
+	neb.emitter.Start()
+	defer neb.emitter.Stop()
+
+	miner, err := core.AddressParse(neb.config.Chain.Miner)
+	assert.Nil(t, err)
+	backup, err := core.AddressParse(neb.config.Chain.BackupMiner)
+	assert.Nil(t, err)
+
+	// the miner registers the backup key, which signs for it from the dynasty elected after.
+	payload, err := core.NewCandidateSignerPayload(backup.String()).ToBytes()
+	assert.Nil(t, err)
+	tx := core.NewTransaction(dpos.chain.ChainID(), miner, miner, util.NewUint128(), 1, core.TxPayloadCandidateType, payload, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, dpos.am.Unlock(miner, []byte("passphrase")))
+	assert.Nil(t, dpos.am.SignTransaction(miner, tx))
+	assert.Nil(t, dpos.chain.TransactionPool().Push(tx))
+
+	tail := dpos.chain.TailBlock()
+	slot := findSlot(t, tail, miner, true)
+	context, err := tail.NextDynastyContext(slot - tail.Timestamp())
+	assert.Nil(t, err)
+	block, err := dpos.newBlock(tail, context)
+	assert.Nil(t, err)
+	block.CollectTransactions(1)
+	assert.Equal(t, 1, len(block.Transactions()))
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, dpos.am.SignBlock(miner, block))
+	assert.Nil(t, dpos.chain.BlockPool().Push(block))
+	dpos.forkChoice()
+	parent := dpos.chain.TailBlock()
+	assert.Equal(t, block.Hash(), parent.Hash())
+
+	// a slot of the miner in the dynasty after the next one.
+	slot = 0
+	for s := 2 * core.DynastyInterval; s < 3*core.DynastyInterval; s += core.BlockInterval {
+		context, err := parent.NextDynastyContext(s - parent.Timestamp())
+		assert.Nil(t, err)
+		if context.Proposer.Equals(miner.Bytes()) {
+			slot = s
+			break
+		}
+	}
+	assert.NotZero(t, slot)
+
+	// the miner's key can't be unlocked, switch to the backup key.
+	dpos.passphrase = "wrong passphrase"
+	assert.NotNil(t, dpos.mintBlock(slot))
+	assert.True(t, dpos.miner.Equals(miner))
+	assert.True(t, dpos.signer.Equals(backup))
+	assert.Nil(t, dpos.backupSigner)
+	select {
+	case e := <-ch:
+		assert.Equal(t, e.Topic, core.TopicMinerFailover)
+	case <-time.After(time.Second):
+		t.Error("missing failover event")
+	}
+
+	// the backup key mints in the miner's slot on its behalf.
+	received = []byte{}
+	assert.Nil(t, dpos.mintBlock(slot))
+	assert.NotEqual(t, received, []byte{})
+	dpos.forkChoice()
+	minted := dpos.chain.TailBlock()
+	assert.Equal(t, slot, minted.Timestamp())
+	assert.Nil(t, dpos.VerifyBlock(minted, parent))
+	assert.True(t, minted.Miner().Equals(miner))
+
+	// the others can't sign for the miner.
+	other, err := core.AddressParse("fc751b484bd5296f8d267a8537d33f25a848f7f7af8cfcf6")
+	assert.Nil(t, err)
+	assert.Nil(t, dpos.am.Unlock(other, []byte("passphrase")))
+	assert.Nil(t, dpos.am.SignBlock(other, minted))
+	assert.Equal(t, ErrInvalidBlockProposer, dpos.VerifyBlock(minted, parent))
 }
@@ -0,0 +1,92 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors in sign lock
+var (
+	ErrConflictingSign     = errors.New("refuse to sign a block conflicting with the signed ones")
+	ErrInvalidSignLockData = errors.New("invalid sign lock data")
+)
+
+// signLockPrefix prefixes the key of the last block signed by a miner in storage.
+var signLockPrefix = []byte("dpos_sign_lock_")
+
+// signLock is the height and slot of the last block signed by the miner, the
+// miner only signs blocks at greater heights in later slots, so a node restarting
+// in the slot it just signed never signs a second block. The lock is kept in the
+// storage of the node, it doesn't guard the same key running on two nodes.
+type signLock struct {
+	height uint64
+	slot   int64
+}
+
+func (l *signLock) toBytes() []byte {
+	return append(byteutils.FromUint64(l.height), byteutils.FromInt64(l.slot)...)
+}
+
+func (l *signLock) fromBytes(data []byte) error {
+	if len(data) != 16 {
+		return ErrInvalidSignLockData
+	}
+	l.height = byteutils.Uint64(data[:8])
+	l.slot = byteutils.Int64(data[8:])
+	return nil
+}
+
+func signLockKey(miner *core.Address) []byte {
+	return append(append([]byte{}, signLockPrefix...), miner.Bytes()...)
+}
+
+// lockSign persists the block as the last one signed by the miner before signing,
+// returns ErrConflictingSign if the miner has signed a block at the same or a greater height,
+// or in the same or a later slot.
+func lockSign(stor storage.Storage, miner *core.Address, block *core.Block) error {
+	key := signLockKey(miner)
+	data, err := stor.Get(key)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err == nil {
+		last := new(signLock)
+		if err := last.fromBytes(data); err != nil {
+			return err
+		}
+		if block.Height() <= last.height || block.Timestamp() <= last.slot {
+			logging.VLog().WithFields(logrus.Fields{
+				"miner":      miner.String(),
+				"block":      block,
+				"lastHeight": last.height,
+				"lastSlot":   last.slot,
+			}).Error("Refused to sign a block conflicting with the signed ones.")
+			return ErrConflictingSign
+		}
+	}
+	lock := &signLock{height: block.Height(), slot: block.Timestamp()}
+	return stor.Put(key, lock.toBytes())
+}
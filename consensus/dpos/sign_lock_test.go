@@ -0,0 +1,65 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockSign(t *testing.T) {
+	neb := mockNeb()
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	miner, err := core.AddressParse(neb.config.Chain.Miner)
+	assert.Nil(t, err)
+	other, err := core.AddressParse("2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8")
+	assert.Nil(t, err)
+
+	block, err := core.NewBlock(neb.chain.ChainID(), miner, neb.chain.TailBlock())
+	assert.Nil(t, err)
+	block.SetTimestamp(core.BlockInterval * 2)
+	assert.Nil(t, lockSign(stor, miner, block))
+
+	// the same slot and the earlier slots are refused.
+	assert.Equal(t, lockSign(stor, miner, block), ErrConflictingSign)
+	block.SetTimestamp(core.BlockInterval)
+	assert.Equal(t, lockSign(stor, miner, block), ErrConflictingSign)
+	assert.Nil(t, lockSign(stor, other, block))
+
+	// a later slot at the same height is refused too.
+	block.SetTimestamp(core.BlockInterval * 3)
+	assert.Equal(t, lockSign(stor, miner, block), ErrConflictingSign)
+
+	block, err = core.NewBlock(neb.chain.ChainID(), miner, block)
+	assert.Nil(t, err)
+	block.SetTimestamp(core.BlockInterval * 3)
+	assert.Nil(t, lockSign(stor, miner, block))
+
+	data, err := stor.Get(signLockKey(miner))
+	assert.Nil(t, err)
+	lock := new(signLock)
+	assert.Nil(t, lock.fromBytes(data))
+	assert.Equal(t, lock.height, block.Height())
+	assert.Equal(t, lock.slot, core.BlockInterval*3)
+	assert.Equal(t, lock.fromBytes(data[1:]), ErrInvalidSignLockData)
+}
@@ -0,0 +1,329 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+// The simulation runs a network of dpos nodes in process, with a virtual clock
+// driving the slots and an in-memory network delivering blocks synchronously.
+// Tests script delegate failures, partitions and double-signs between steps.
+
+// simNode is a delegate in the simulation.
+type simNode struct {
+	neb  *Neb
+	dpos *Dpos
+
+	online     bool
+	group      int
+	doubleSign bool
+}
+
+// simEndpoint delivers the broadcast blocks of a node to the reachable nodes.
+type simEndpoint struct {
+	MockNetManager
+	sim  *simulation
+	node *simNode
+}
+
+func (e *simEndpoint) Broadcast(name string, msg net.Serializable) {
+	if block, ok := msg.(*core.Block); ok && name == core.MessageTypeNewBlock {
+		e.sim.deliver(e.node, block)
+	}
+}
+
+func (e *simEndpoint) Relay(name string, msg net.Serializable) {
+	e.Broadcast(name, msg)
+}
+
+type simulation struct {
+	t     *testing.T
+	now   int64
+	nodes []*simNode
+}
+
+// newSimulation starts a network of the given delegates size, all in the genesis dynasty.
+func newSimulation(t *testing.T, size int) *simulation {
+	sim := &simulation{t: t}
+	dynasty := []string{}
+	for i := 0; i < size; i++ {
+		priv := secp256k1.GeneratePrivateKey()
+		pubdata, err := priv.PublicKey().Encoded()
+		assert.Nil(t, err)
+		addr, err := core.NewAddressFromPublicKey(pubdata)
+		assert.Nil(t, err)
+		assert.Nil(t, keystore.DefaultKS.SetKey(addr.String(), priv, []byte("passphrase")))
+		dynasty = append(dynasty, addr.String())
+	}
+	for _, miner := range dynasty {
+		stor, err := storage.NewMemoryStorage()
+		assert.Nil(t, err)
+		neb := &Neb{
+			genesis: &corepb.Genesis{
+				Meta: &corepb.GenesisMeta{ChainId: 0},
+				Consensus: &corepb.GenesisConsensus{
+					Dpos: &corepb.GenesisConsensusDpos{Dynasty: dynasty},
+				},
+				RandomHeight: 1,
+			},
+			storage: stor,
+			emitter: core.NewEventEmitter(1024),
+			config: nebletpb.Config{
+				Chain: &nebletpb.ChainConfig{
+					Coinbase:   miner,
+					Miner:      miner,
+					Passphrase: "passphrase",
+				},
+			},
+		}
+		node := &simNode{neb: neb, online: true}
+		neb.ns = &simEndpoint{sim: sim, node: node}
+		neb.am = account.NewManager(nil)
+		neb.chain, err = core.NewBlockChain(neb)
+		assert.Nil(t, err)
+		neb.chain.BlockPool().RegisterInNetwork(neb.ns)
+		neb.emitter.Start()
+
+		node.dpos, err = NewDpos(neb)
+		assert.Nil(t, err)
+		neb.chain.SetConsensusHandler(node.dpos)
+		node.dpos.SetCanMining(true)
+		sim.nodes = append(sim.nodes, node)
+	}
+	return sim
+}
+
+func (sim *simulation) stop() {
+	for _, node := range sim.nodes {
+		node.neb.emitter.Stop()
+	}
+}
+
+func (sim *simulation) reachable(a *simNode, b *simNode) bool {
+	return a != b && a.online && b.online && a.group == b.group
+}
+
+// deliver pushes the block into the reachable nodes.
+func (sim *simulation) deliver(from *simNode, block *core.Block) {
+	for _, node := range sim.nodes {
+		if sim.reachable(from, node) {
+			node.neb.chain.BlockPool().Push(block)
+		}
+	}
+}
+
+// settle lets every node choose its tail among the linked blocks.
+func (sim *simulation) settle() {
+	for _, node := range sim.nodes {
+		for done := false; !done; {
+			select {
+			case <-node.neb.chain.BlockPool().ReceivedLinkedBlockCh():
+				node.dpos.forkChoice()
+			default:
+				done = true
+			}
+		}
+	}
+}
+
+// step advances the virtual clock slot by slot, the proposer of each slot mints if online.
+func (sim *simulation) step(slots int) {
+	for i := 0; i < slots; i++ {
+		sim.now += core.BlockInterval
+		for _, node := range sim.nodes {
+			if !node.online {
+				continue
+			}
+			tail := node.neb.chain.TailBlock()
+			if err := node.dpos.mintBlock(sim.now); err != nil {
+				continue
+			}
+			if node.doubleSign {
+				sim.forge(node, tail)
+			}
+		}
+		sim.settle()
+	}
+}
+
+// forge signs another block in the same slot on parent and broadcasts it.
+func (sim *simulation) forge(node *simNode, parent *core.Block) {
+	context, err := parent.NextDynastyContext(sim.now - parent.Timestamp())
+	assert.Nil(sim.t, err)
+	block, err := node.dpos.newBlock(parent, context)
+	assert.Nil(sim.t, err)
+	block.SetNonce(1)
+	assert.Nil(sim.t, node.dpos.am.SignBlockRandom(node.dpos.miner, block))
+	assert.Nil(sim.t, block.Seal())
+	assert.Nil(sim.t, node.dpos.am.SignBlock(node.dpos.miner, block))
+	sim.deliver(node, block)
+}
+
+// partition splits the nodes into groups by index, blocks are only delivered within a group.
+func (sim *simulation) partition(groups ...[]int) {
+	for g, members := range groups {
+		for _, i := range members {
+			sim.nodes[i].group = g + 1
+		}
+	}
+}
+
+// heal merges all groups and syncs the chains between nodes.
+func (sim *simulation) heal() {
+	for _, node := range sim.nodes {
+		node.group = 0
+	}
+	sim.sync()
+}
+
+// setOnline takes the node down or brings it back and syncs it with others.
+func (sim *simulation) setOnline(i int, online bool) {
+	sim.nodes[i].online = online
+	if online {
+		sim.sync()
+	}
+}
+
+// sync pushes the chain of every node into the reachable nodes.
+func (sim *simulation) sync() {
+	for _, from := range sim.nodes {
+		if !from.online {
+			continue
+		}
+		for _, block := range sim.chain(from) {
+			sim.deliver(from, block)
+		}
+	}
+	sim.settle()
+}
+
+// chain returns the blocks from genesis to the tail of node, genesis excluded.
+func (sim *simulation) chain(node *simNode) []*core.Block {
+	blocks := []*core.Block{}
+	block := node.neb.chain.TailBlock()
+	for !core.CheckGenesisBlock(block) {
+		blocks = append([]*core.Block{block}, blocks...)
+		parent, err := block.ParentBlock()
+		assert.Nil(sim.t, err)
+		block = parent
+	}
+	return blocks
+}
+
+// converged returns whether the online nodes share the same tail.
+func (sim *simulation) converged() bool {
+	var tail *core.Block
+	for _, node := range sim.nodes {
+		if !node.online {
+			continue
+		}
+		if tail == nil {
+			tail = node.neb.chain.TailBlock()
+		} else if !tail.Hash().Equals(node.neb.chain.TailBlock().Hash()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (sim *simulation) tail(i int) *core.Block {
+	return sim.nodes[i].neb.chain.TailBlock()
+}
+
+func TestSimulation_Normal(t *testing.T) {
+	sim := newSimulation(t, core.DynastySize)
+	defer sim.stop()
+
+	sim.step(int(core.DynastyInterval / core.BlockInterval * 2))
+	assert.True(t, sim.converged())
+	assert.Equal(t, sim.tail(0).Height(), uint64(core.DynastyInterval/core.BlockInterval*2+1))
+	assert.Equal(t, sim.tail(0).Timestamp(), sim.now)
+}
+
+func TestSimulation_DelegateFailure(t *testing.T) {
+	sim := newSimulation(t, core.DynastySize)
+	defer sim.stop()
+
+	sim.step(core.DynastySize)
+	height := sim.tail(1).Height()
+
+	// the failed delegate misses its slot in every round, the others keep minting.
+	sim.setOnline(0, false)
+	sim.step(core.DynastySize * 2)
+	assert.True(t, sim.converged())
+	assert.True(t, sim.tail(1).Height() > height)
+	assert.True(t, sim.tail(1).Height() < height+core.DynastySize*2)
+	for _, block := range sim.chain(sim.nodes[1])[height-1:] {
+		assert.False(t, block.Coinbase().Equals(sim.nodes[0].dpos.coinbase))
+	}
+
+	// the delegate catches up after it recovers.
+	sim.setOnline(0, true)
+	assert.True(t, sim.converged())
+	sim.step(core.DynastySize)
+	assert.True(t, sim.converged())
+}
+
+func TestSimulation_Partition(t *testing.T) {
+	sim := newSimulation(t, core.DynastySize)
+	defer sim.stop()
+
+	sim.step(core.DynastySize)
+	sim.partition([]int{0, 1, 2, 3}, []int{4, 5})
+	sim.step(core.DynastySize * 2)
+	assert.False(t, sim.converged())
+	majority, minority := sim.tail(0), sim.tail(4)
+	assert.True(t, majority.Height() > minority.Height())
+
+	// the longer chain of majority wins after the partition heals.
+	sim.heal()
+	assert.True(t, sim.converged())
+	assert.Equal(t, sim.tail(4).Hash(), majority.Hash())
+	sim.step(core.DynastySize)
+	assert.True(t, sim.converged())
+}
+
+func TestSimulation_DoubleSign(t *testing.T) {
+	sim := newSimulation(t, core.DynastySize)
+	defer sim.stop()
+
+	sim.nodes[0].doubleSign = true
+	sim.step(core.DynastySize)
+	sim.nodes[0].doubleSign = false
+	sim.step(core.DynastySize)
+	assert.True(t, sim.converged())
+
+	// the evidence is packed into the chain by the honest delegates.
+	evidences := 0
+	for _, block := range sim.chain(sim.nodes[1]) {
+		evidences += len(block.Evidences())
+	}
+	assert.Equal(t, evidences, 1)
+}
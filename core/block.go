@@ -30,6 +30,7 @@ import (
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/sha3"
@@ -43,6 +44,9 @@ var (
 	// BlockHashLength define a const of the length of Hash of Block in byte.
 	BlockHashLength = 32
 
+	// BlockHashWindow is the number of recent blocks whose hashes are visible to contracts.
+	BlockHashWindow uint64 = 256
+
 	// BlockReward given to coinbase
 	// rule: 3% per year, 3,000,000. 1 block per 5 seconds
 	// value: 10^8 * 3% / (365*24*3600/5) * 10^18 ≈ 16 * 3% * 10*18 = 48 * 10^16
@@ -69,6 +73,12 @@ type BlockHeader struct {
 	// sign
 	alg  uint8
 	sign byteutils.Hash
+
+	// random proof of the proposer, seeding the proposers' order
+	random []byte
+
+	// bloom of the contract logs emitted in the block
+	bloom Bloom
 }
 
 // ToProto converts domain BlockHeader to proto BlockHeader
@@ -86,6 +96,8 @@ func (b *BlockHeader) ToProto() (proto.Message, error) {
 		ChainId:     b.chainID,
 		Alg:         uint32(b.alg),
 		Sign:        b.sign,
+		Random:      b.random,
+		Bloom:       b.bloom,
 	}, nil
 }
 
@@ -104,6 +116,8 @@ func (b *BlockHeader) FromProto(msg proto.Message) error {
 		b.chainID = msg.ChainId
 		b.alg = uint8(msg.Alg)
 		b.sign = msg.Sign
+		b.random = msg.Random
+		b.bloom = msg.Bloom
 		return nil
 	}
 	return errors.New("Protobuf message cannot be converted into BlockHeader")
@@ -113,6 +127,7 @@ func (b *BlockHeader) FromProto(msg proto.Message) error {
 type Block struct {
 	header       *BlockHeader
 	transactions Transactions
+	evidences    []*Evidence
 
 	sealed       bool
 	height       uint64
@@ -126,6 +141,16 @@ type Block struct {
 
 	storage      storage.Storage
 	eventEmitter *EventEmitter
+
+	// block intervals of the chain.
+	blockIntervals *BlockIntervals
+	// height the block reward is shared with the voters from, never if 0.
+	commissionHeight uint64
+	// height the blocks carry the VRF random of their proposer from, never if 0.
+	randomHeight uint64
+
+	// sandboxes discard their changes, their contracts run on the pooled engines.
+	sandboxed bool
 }
 
 // ToProto converts domain Block into proto Block
@@ -144,10 +169,23 @@ func (block *Block) ToProto() (proto.Message, error) {
 				return nil, errors.New("Protobuf message cannot be converted into Transaction")
 			}
 		}
+		var evidences []*corepb.Evidence
+		for _, v := range block.evidences {
+			evidence, err := v.ToProto()
+			if err != nil {
+				return nil, err
+			}
+			if evidence, ok := evidence.(*corepb.Evidence); ok {
+				evidences = append(evidences, evidence)
+			} else {
+				return nil, errors.New("Protobuf message cannot be converted into Evidence")
+			}
+		}
 		return &corepb.Block{
 			Header:       header,
 			Transactions: txs,
 			Height:       block.height,
+			Evidences:    evidences,
 		}, nil
 	}
 	return nil, errors.New("Protobuf message cannot be converted into BlockHeader")
@@ -167,6 +205,13 @@ func (block *Block) FromProto(msg proto.Message) error {
 			}
 			block.transactions = append(block.transactions, tx)
 		}
+		for _, v := range msg.Evidences {
+			evidence := new(Evidence)
+			if err := evidence.FromProto(v); err != nil {
+				return err
+			}
+			block.evidences = append(block.evidences, evidence)
+		}
 		block.height = msg.Height
 		return nil
 	}
@@ -220,11 +265,17 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 		sealed:       false,
 		storage:      parent.storage,
 		eventEmitter: parent.eventEmitter,
+
+		blockIntervals:   parent.blockIntervals,
+		commissionHeight: parent.commissionHeight,
+		randomHeight:     parent.randomHeight,
 	}
 
-	block.begin()
-	block.rewardCoinbase()
-	block.commit()
+	if !block.sharesReward() {
+		block.begin()
+		block.rewardCoinbase()
+		block.commit()
+	}
 
 	return block, nil
 }
@@ -317,6 +368,11 @@ func (block *Block) Storage() storage.Storage {
 	return block.storage
 }
 
+// Bloom return the bloom of contract logs in block.
+func (block *Block) Bloom() Bloom {
+	return block.header.bloom
+}
+
 // EventsRoot return events root hash.
 func (block *Block) EventsRoot() byteutils.Hash {
 	return block.header.eventsRoot
@@ -329,14 +385,19 @@ func (block *Block) DposContext() *corepb.DposContext {
 
 // DposContextHash hash dpos context
 func (block *Block) DposContextHash() byteutils.Hash {
+	return HashDposContext(block.header.dposContext)
+}
+
+// HashDposContext return the hash of dpos context.
+func HashDposContext(dposContext *corepb.DposContext) byteutils.Hash {
 	hasher := sha3.New256()
 
-	hasher.Write(block.header.dposContext.DynastyRoot)
-	hasher.Write(block.header.dposContext.NextDynastyRoot)
-	hasher.Write(block.header.dposContext.DelegateRoot)
-	hasher.Write(block.header.dposContext.VoteRoot)
-	hasher.Write(block.header.dposContext.CandidateRoot)
-	hasher.Write(block.header.dposContext.MintCntRoot)
+	hasher.Write(dposContext.DynastyRoot)
+	hasher.Write(dposContext.NextDynastyRoot)
+	hasher.Write(dposContext.DelegateRoot)
+	hasher.Write(dposContext.VoteRoot)
+	hasher.Write(dposContext.CandidateRoot)
+	hasher.Write(dposContext.MintCntRoot)
 
 	return hasher.Sum(nil)
 }
@@ -346,6 +407,11 @@ func (block *Block) ParentHash() byteutils.Hash {
 	return block.header.parentHash
 }
 
+// BlockIntervalAt returns the seconds between the blocks of the block's chain at the timestamp.
+func (block *Block) BlockIntervalAt(timestamp int64) int64 {
+	return block.blockIntervals.At(timestamp)
+}
+
 // ParentBlock return the parent block.
 func (block *Block) ParentBlock() (*Block, error) {
 	if block.parenetBlock != nil {
@@ -355,9 +421,32 @@ func (block *Block) ParentBlock() (*Block, error) {
 	if err != nil {
 		return nil, ErrMissingParentBlock
 	}
+	parentBlock.blockIntervals = block.blockIntervals
+	parentBlock.commissionHeight = block.commissionHeight
+	parentBlock.randomHeight = block.randomHeight
 	return parentBlock, nil
 }
 
+// AncestorHash return the hash of the ancestor at height, which must be one of the recent BlockHashWindow blocks.
+func (block *Block) AncestorHash(height uint64) (byteutils.Hash, error) {
+	if height >= block.height || height+BlockHashWindow < block.height {
+		return nil, ErrOutOfBlockHashWindow
+	}
+	hash := block.ParentHash()
+	for h := block.height - 1; h > height; h-- {
+		value, err := block.storage.Get(hash)
+		if err != nil {
+			return nil, ErrMissingParentBlock
+		}
+		pbBlock := new(corepb.Block)
+		if err := proto.Unmarshal(value, pbBlock); err != nil {
+			return nil, err
+		}
+		hash = pbBlock.Header.ParentHash
+	}
+	return hash, nil
+}
+
 // Height return height
 func (block *Block) Height() uint64 {
 	return block.height
@@ -373,6 +462,16 @@ func (block *Block) SetMiner(miner *Address) {
 	block.miner = miner
 }
 
+// Transactions returns block transactions.
+func (block *Block) Transactions() Transactions {
+	return block.transactions
+}
+
+// Evidences returns block evidences.
+func (block *Block) Evidences() []*Evidence {
+	return block.evidences
+}
+
 // VerifyAddress returns if the addr string is valid
 func (block *Block) VerifyAddress(str string) bool {
 	_, err := AddressParse(str)
@@ -410,6 +509,9 @@ func (block *Block) LinkParentBlock(parentBlock *Block) error {
 	block.storage = parentBlock.storage
 	block.height = parentBlock.height + 1
 	block.eventEmitter = parentBlock.eventEmitter
+	block.blockIntervals = parentBlock.blockIntervals
+	block.commissionHeight = parentBlock.commissionHeight
+	block.randomHeight = parentBlock.randomHeight
 
 	logging.VLog().WithFields(logrus.Fields{
 		"parent": parentBlock,
@@ -460,6 +562,12 @@ func (block *Block) ReturnTransactions() {
 
 // CollectTransactions and add them to block.
 func (block *Block) CollectTransactions(n int) {
+	block.CollectTransactionsBefore(n, time.Time{})
+}
+
+// CollectTransactionsBefore collect at most n txs, until the deadline if not zero, the txs left
+// are packed in the next blocks.
+func (block *Block) CollectTransactionsBefore(n int, deadline time.Time) {
 	if block.sealed {
 		logging.VLog().WithFields(logrus.Fields{
 			"block": block,
@@ -470,9 +578,21 @@ func (block *Block) CollectTransactions(n int) {
 	pool := block.txPool
 	var givebacks []*Transaction
 	for !pool.Empty() && n > 0 {
+		if !deadline.IsZero() && !time.Now().Before(deadline) {
+			logging.VLog().WithFields(logrus.Fields{
+				"block":    block,
+				"packed":   len(block.transactions),
+				"deadline": deadline,
+			}).Warn("Stopped packing txs at the deadline.")
+			break
+		}
 		tx := pool.Pop()
 		block.begin()
-		giveback, err := block.executeTransaction(tx)
+		var giveback bool
+		var err error
+		pool.throttle.Execute(func() {
+			giveback, err = block.executeTransaction(tx)
+		}, deadline)
 		if giveback {
 			givebacks = append(givebacks, tx)
 		}
@@ -519,6 +639,12 @@ func (block *Block) Seal() error {
 	}
 
 	block.begin()
+	if block.sharesReward() {
+		if err := block.distributeReward(); err != nil {
+			block.rollback()
+			return err
+		}
+	}
 	err := block.recordMintCnt()
 	if err != nil {
 		block.rollback()
@@ -529,6 +655,9 @@ func (block *Block) Seal() error {
 	block.header.stateRoot = block.accState.RootHash()
 	block.header.txsRoot = block.txsTrie.RootHash()
 	block.header.eventsRoot = block.eventsTrie.RootHash()
+	if block.header.bloom, err = block.computeBloom(); err != nil {
+		return err
+	}
 	if block.header.dposContext, err = block.dposContext.ToProto(); err != nil {
 		return err
 	}
@@ -579,12 +708,33 @@ func (block *Block) VerifyExecution(parent *Block, consensus Consensus) error {
 
 	// release all events
 	block.triggerEvent()
+	block.triggerDynastyEvent(parent)
 
 	return nil
 }
 
 func (block *Block) triggerEvent() {
+	for _, e := range block.chainEvents() {
+		block.eventEmitter.Trigger(e)
+	}
+}
 
+// triggerRevertEvent tells the subscribers the block is reverted from the canonical chain by a fork.
+func (block *Block) triggerRevertEvent() {
+	if block.eventEmitter == nil {
+		return
+	}
+	blockData, _ := json.Marshal(block)
+	block.eventEmitter.Trigger(&Event{
+		Topic: TopicRevertBlock,
+		Data:  string(blockData),
+	})
+}
+
+// chainEvents returns the events of the block in order: the events of each tx, of the evidences,
+// and the block linked at last.
+func (block *Block) chainEvents() []*Event {
+	var chainEvents []*Event
 	for _, v := range block.transactions {
 		var topic string
 		switch v.Type() {
@@ -592,34 +742,41 @@ func (block *Block) triggerEvent() {
 			topic = TopicSendTransaction
 		case TxPayloadDeployType:
 			topic = TopicDeploySmartContract
-		case TxPayloadCallType:
+		case TxPayloadCallType, TxPayloadMultiCallType:
 			topic = TopicCallSmartContract
+		case TxPayloadUpgradeType:
+			topic = TopicUpgradeSmartContract
+		case TxPayloadOracleType:
+			topic = TopicOracleResponse
 		case TxPayloadDelegateType:
 			topic = TopicDelegate
 		case TxPayloadCandidateType:
 			topic = TopicCandidate
 		}
-		data, err := json.Marshal(v)
-		event := &Event{
+		data, _ := json.Marshal(v)
+		chainEvents = append(chainEvents, &Event{
 			Topic: topic,
 			Data:  string(data),
-		}
-		block.eventEmitter.Trigger(event)
+		})
 
 		events, err := block.FetchEvents(v.hash)
-		if err != nil {
-			for _, e := range events {
-				block.eventEmitter.Trigger(e)
-			}
+		if err == nil {
+			chainEvents = append(chainEvents, events...)
+		}
+	}
+
+	for _, v := range block.evidences {
+		events, err := block.FetchEvents(v.Hash())
+		if err == nil {
+			chainEvents = append(chainEvents, events...)
 		}
 	}
 
 	blockData, _ := json.Marshal(block)
-	e := &Event{
+	return append(chainEvents, &Event{
 		Topic: TopicLinkBlock,
 		Data:  string(blockData),
-	}
-	block.eventEmitter.Trigger(e)
+	})
 }
 
 // VerifyIntegrity verify block's hash, txs' integrity and consensus acceptable.
@@ -684,6 +841,15 @@ func (block *Block) verifyState() error {
 		return ErrInvalidBlockEventsRoot
 	}
 
+	// verify logs bloom.
+	bloom, err := block.computeBloom()
+	if err != nil {
+		return err
+	}
+	if !byteutils.Equal(bloom, block.Bloom()) {
+		return ErrInvalidBlockBloom
+	}
+
 	// verify transaction root.
 	if !byteutils.Equal(block.dposContext.RootHash(), block.DposContextHash()) {
 		return ErrInvalidBlockDposContextRoot
@@ -694,7 +860,9 @@ func (block *Block) verifyState() error {
 
 // Execute block and return result.
 func (block *Block) execute() error {
-	block.rewardCoinbase()
+	if !block.sharesReward() {
+		block.rewardCoinbase()
+	}
 
 	for _, tx := range block.transactions {
 		start := time.Now().Unix()
@@ -712,6 +880,18 @@ func (block *Block) execute() error {
 		TxExecutedTimer.Update(time.Duration(end - start))
 	}
 
+	for _, evidence := range block.evidences {
+		if err := block.executeEvidence(evidence); err != nil {
+			return err
+		}
+	}
+
+	if block.sharesReward() {
+		if err := block.distributeReward(); err != nil {
+			return err
+		}
+	}
+
 	return block.recordMintCnt()
 }
 
@@ -796,6 +976,92 @@ func (block *Block) FetchEvents(txHash byteutils.Hash) ([]*Event, error) {
 	return events, nil
 }
 
+// FetchLogs fetch the logs emitted by contracts in tx.
+func (block *Block) FetchLogs(txHash byteutils.Hash) ([]*nvm.ContractLog, error) {
+	events, err := block.FetchEvents(txHash)
+	if err != nil {
+		return nil, err
+	}
+	logs := []*nvm.ContractLog{}
+	for _, event := range events {
+		if event.Topic != TopicContractLog {
+			continue
+		}
+		log := new(nvm.ContractLog)
+		if err := json.Unmarshal([]byte(event.Data), log); err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// FetchStateDiffs fetch the keys of contract storage written by tx.
+func (block *Block) FetchStateDiffs(txHash byteutils.Hash) ([]*nvm.StateDiff, error) {
+	events, err := block.FetchEvents(txHash)
+	if err != nil {
+		return nil, err
+	}
+	diffs := []*nvm.StateDiff{}
+	for _, event := range events {
+		if event.Topic != TopicContractStateDiff {
+			continue
+		}
+		diff := new(nvm.StateDiff)
+		if err := json.Unmarshal([]byte(event.Data), diff); err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+// FetchExecutionError fetch the error failing the execution of tx, empty if it succeeded.
+func (block *Block) FetchExecutionError(txHash byteutils.Hash) (string, error) {
+	events, err := block.FetchEvents(txHash)
+	if err != nil {
+		return "", err
+	}
+	for _, event := range events {
+		if event.Topic != TopicExecuteTxFailed {
+			continue
+		}
+		// the errors of old blocks were recorded as empty objects.
+		failed := new(struct {
+			Error interface{} `json:"error"`
+		})
+		if err := json.Unmarshal([]byte(event.Data), failed); err != nil {
+			return "", err
+		}
+		if msg, ok := failed.Error.(string); ok {
+			return msg, nil
+		}
+		return nvm.ErrExecutionFailed.Error(), nil
+	}
+	return "", nil
+}
+
+// computeBloom returns the bloom of the logs emitted by the block's transactions, nil if none.
+func (block *Block) computeBloom() (Bloom, error) {
+	var bloom Bloom
+	for _, tx := range block.transactions {
+		logs, err := block.FetchLogs(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range logs {
+			if bloom == nil {
+				bloom = NewBloom()
+			}
+			bloom.Add([]byte(log.Address))
+			for _, topic := range log.Topics {
+				bloom.Add([]byte(topic))
+			}
+		}
+	}
+	return bloom, nil
+}
+
 func (block *Block) recordMintCnt() error {
 	key := append(byteutils.FromInt64(block.Timestamp()/DynastyInterval), block.miner.Bytes()...)
 	bytes, err := block.dposContext.mintCntTrie.Get(key)
@@ -900,20 +1166,37 @@ func (block *Block) executeTransaction(tx *Transaction) (giveback bool, err erro
 
 // HashBlock return the hash of block.
 func HashBlock(block *Block) byteutils.Hash {
-	hasher := sha3.New256()
+	var txs, evidences []byteutils.Hash
+	for _, tx := range block.transactions {
+		txs = append(txs, tx.Hash())
+	}
+	for _, evidence := range block.evidences {
+		evidences = append(evidences, evidence.Hash())
+	}
+	return hashBlockHeader(block.header, txs, evidences)
+}
 
-	hasher.Write(block.ParentHash())
-	hasher.Write(block.StateRoot())
-	hasher.Write(block.TxsRoot())
-	hasher.Write(block.EventsRoot())
-	hasher.Write(block.DposContextHash())
-	hasher.Write(byteutils.FromUint64(block.header.nonce))
-	hasher.Write(block.header.coinbase.address)
-	hasher.Write(byteutils.FromInt64(block.header.timestamp))
-	hasher.Write(byteutils.FromUint32(block.header.chainID))
+// hashBlockHeader return the block hash made of the header and the hashes of the block's body.
+func hashBlockHeader(header *BlockHeader, txs []byteutils.Hash, evidences []byteutils.Hash) byteutils.Hash {
+	hasher := sha3.New256()
 
-	for _, tx := range block.transactions {
-		hasher.Write(tx.Hash())
+	hasher.Write(header.parentHash)
+	hasher.Write(header.stateRoot)
+	hasher.Write(header.txsRoot)
+	hasher.Write(header.eventsRoot)
+	hasher.Write(HashDposContext(header.dposContext))
+	hasher.Write(byteutils.FromUint64(header.nonce))
+	hasher.Write(header.coinbase.address)
+	hasher.Write(byteutils.FromInt64(header.timestamp))
+	hasher.Write(byteutils.FromUint32(header.chainID))
+	hasher.Write(header.random)
+	hasher.Write(header.bloom)
+
+	for _, tx := range txs {
+		hasher.Write(tx)
+	}
+	for _, evidence := range evidences {
+		hasher.Write(evidence)
 	}
 
 	return hasher.Sum(nil)
@@ -0,0 +1,313 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Compact block relay constants
+const (
+	// ShortIDLength is the length of the short id of a transaction, the prefix of its hash.
+	ShortIDLength = 8
+
+	// CompactBlockTimeout is how long a compact block waits for its missing transactions.
+	CompactBlockTimeout = 30 * time.Second
+)
+
+// compactBlock is a compact block waiting for its missing transactions.
+type compactBlock struct {
+	sender   string
+	pb       *corepb.CompactBlock
+	txs      []*Transaction
+	received time.Time
+}
+
+// CompactName return the message name of the compact block.
+func (block *Block) CompactName() string {
+	return MessageTypeCompactBlock
+}
+
+// CompactCapability return the capability of the peers accepting compact blocks.
+func (block *Block) CompactCapability() string {
+	return p2p.CapabilityCompact
+}
+
+// ToCompactProto converts domain Block into proto CompactBlock, giving its transactions by their short ids.
+func (block *Block) ToCompactProto() (proto.Message, error) {
+	header, _ := block.header.ToProto()
+	if header, ok := header.(*corepb.BlockHeader); ok {
+		var shortIDs [][]byte
+		for _, v := range block.transactions {
+			shortIDs = append(shortIDs, shortID(v.Hash()))
+		}
+		var evidences []*corepb.Evidence
+		for _, v := range block.evidences {
+			evidence, err := v.ToProto()
+			if err != nil {
+				return nil, err
+			}
+			if evidence, ok := evidence.(*corepb.Evidence); ok {
+				evidences = append(evidences, evidence)
+			} else {
+				return nil, errors.New("Protobuf message cannot be converted into Evidence")
+			}
+		}
+		return &corepb.CompactBlock{
+			Header:    header,
+			Height:    block.height,
+			Evidences: evidences,
+			ShortIds:  shortIDs,
+		}, nil
+	}
+	return nil, errors.New("Protobuf message cannot be converted into BlockHeader")
+}
+
+func shortID(hash byteutils.Hash) []byte {
+	if len(hash) < ShortIDLength {
+		return hash
+	}
+	return hash[:ShortIDLength]
+}
+
+// findByShortIDs return the transactions in pool with the short ids, nil for the missing or ambiguous ones.
+func (pool *TransactionPool) findByShortIDs(shortIDs [][]byte) []*Transaction {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	index := make(map[string]*Transaction, len(pool.all))
+	for _, tx := range pool.all {
+		id := string(shortID(tx.Hash()))
+		if _, ok := index[id]; ok {
+			index[id] = nil
+			continue
+		}
+		index[id] = tx
+	}
+
+	txs := make([]*Transaction, len(shortIDs))
+	for i, id := range shortIDs {
+		txs[i] = index[string(id)]
+	}
+	return txs
+}
+
+func (pool *BlockPool) handleCompactMessage(msg net.Message) {
+	pool.expireCompactBlocks()
+
+	switch msg.MessageType() {
+	case MessageTypeCompactBlock:
+		pool.handleCompactBlock(msg)
+	case MessageTypeGetBlockTxs:
+		pool.handleGetBlockTxs(msg)
+	case MessageTypeBlockTxs:
+		pool.handleBlockTxs(msg)
+	default:
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     "not compact block msg",
+		}).Warn("Received unregistered message.")
+	}
+}
+
+func (pool *BlockPool) handleCompactBlock(msg net.Message) {
+	pbCompact := new(corepb.CompactBlock)
+	if err := proto.Unmarshal(msg.Data().([]byte), pbCompact); err != nil || pbCompact.Header == nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to unmarshal data.")
+		pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+
+	hash := byteutils.Hash(pbCompact.Header.Hash)
+	if _, ok := pool.compactBlocks[hash.Hex()]; ok || pool.cache.Contains(hash.Hex()) || pool.bc.GetBlock(hash) != nil {
+		duplicatedBlockCounter.Inc(1)
+		return
+	}
+
+	cb := &compactBlock{
+		sender:   msg.MessageFrom(),
+		pb:       pbCompact,
+		txs:      pool.bc.txPool.findByShortIDs(pbCompact.ShortIds),
+		received: time.Now(),
+	}
+	var missing []uint32
+	for i, tx := range cb.txs {
+		if tx == nil {
+			missing = append(missing, uint32(i))
+		}
+	}
+	if len(missing) == 0 {
+		pool.reconstructBlock(cb)
+		return
+	}
+
+	data, err := proto.Marshal(&corepb.GetBlockTxs{Hash: hash, Indexes: missing})
+	if err != nil {
+		return
+	}
+	pool.compactBlocks[hash.Hex()] = cb
+	pool.nm.SendMsg(MessageTypeGetBlockTxs, data, cb.sender)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"hash":    hash.Hex(),
+		"txs":     len(cb.txs),
+		"missing": len(missing),
+		"from":    cb.sender,
+	}).Debug("Fetching the missing transactions of a compact block.")
+}
+
+func (pool *BlockPool) handleGetBlockTxs(msg net.Message) {
+	pbGet := new(corepb.GetBlockTxs)
+	if err := proto.Unmarshal(msg.Data().([]byte), pbGet); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to unmarshal data.")
+		pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+
+	var block *Block
+	if v, ok := pool.cache.Get(byteutils.Hash(pbGet.Hash).Hex()); ok {
+		block = v.(*linkedBlock).block
+	} else {
+		block = pool.bc.GetBlock(pbGet.Hash)
+	}
+	if block == nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"hash": byteutils.Hex(pbGet.Hash),
+		}).Debug("Failed to find the block asked for.")
+		return
+	}
+
+	var txs []*corepb.Transaction
+	for _, i := range pbGet.Indexes {
+		if int(i) >= len(block.transactions) {
+			pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+			return
+		}
+		tx, err := block.transactions[i].ToProto()
+		if err != nil {
+			return
+		}
+		txs = append(txs, tx.(*corepb.Transaction))
+	}
+
+	data, err := proto.Marshal(&corepb.BlockTxs{Hash: pbGet.Hash, Indexes: pbGet.Indexes, Transactions: txs})
+	if err != nil {
+		return
+	}
+	pool.nm.SendMsg(MessageTypeBlockTxs, data, msg.MessageFrom())
+}
+
+func (pool *BlockPool) handleBlockTxs(msg net.Message) {
+	pbTxs := new(corepb.BlockTxs)
+	if err := proto.Unmarshal(msg.Data().([]byte), pbTxs); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to unmarshal data.")
+		pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+
+	key := byteutils.Hash(pbTxs.Hash).Hex()
+	cb, ok := pool.compactBlocks[key]
+	if !ok || cb.sender != msg.MessageFrom() {
+		return
+	}
+
+	if len(pbTxs.Indexes) != len(pbTxs.Transactions) {
+		delete(pool.compactBlocks, key)
+		pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	for i, index := range pbTxs.Indexes {
+		tx := new(Transaction)
+		if int(index) >= len(cb.txs) || tx.FromProto(pbTxs.Transactions[i]) != nil ||
+			!byteutils.Equal(shortID(tx.Hash()), cb.pb.ShortIds[index]) {
+			delete(pool.compactBlocks, key)
+			pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+			return
+		}
+		cb.txs[index] = tx
+	}
+	for _, tx := range cb.txs {
+		if tx == nil {
+			return
+		}
+	}
+
+	delete(pool.compactBlocks, key)
+	pool.reconstructBlock(cb)
+}
+
+// reconstructBlock rebuild the full block from the compact one and accept it.
+func (pool *BlockPool) reconstructBlock(cb *compactBlock) {
+	pbBlock := &corepb.Block{
+		Header:    cb.pb.Header,
+		Height:    cb.pb.Height,
+		Evidences: cb.pb.Evidences,
+	}
+	for _, v := range cb.txs {
+		tx, err := v.ToProto()
+		if err != nil {
+			return
+		}
+		pbBlock.Transactions = append(pbBlock.Transactions, tx.(*corepb.Transaction))
+	}
+
+	block := new(Block)
+	if err := block.FromProto(pbBlock); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"hash": byteutils.Hex(cb.pb.Header.Hash),
+			"err":  err,
+		}).Error("Failed to reconstruct a compact block.")
+		pool.reportPeer(cb.sender, p2p.InvalidMessage)
+		return
+	}
+	pool.acceptBlock(cb.sender, MessageTypeNewBlock, block)
+}
+
+// expireCompactBlocks drop the compact blocks whose missing transactions never came,
+// the blocks will be downloaded as the parents of their children.
+func (pool *BlockPool) expireCompactBlocks() {
+	for k, cb := range pool.compactBlocks {
+		if time.Since(cb.received) > CompactBlockTimeout {
+			delete(pool.compactBlocks, k)
+			pool.reportPeer(cb.sender, p2p.Timeout)
+		}
+	}
+}
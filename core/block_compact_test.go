@@ -0,0 +1,97 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/net/messages"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindByShortIDs(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	from := mockAddress()
+	tx1 := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, []byte("1"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	tx2 := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 2, TxPayloadBinaryType, []byte("2"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	bc.txPool.all[tx1.Hash().Hex()] = tx1
+
+	txs := bc.txPool.findByShortIDs([][]byte{shortID(tx1.Hash()), shortID(tx2.Hash())})
+	assert.Equal(t, 2, len(txs))
+	assert.Equal(t, tx1, txs[0])
+	assert.Nil(t, txs[1])
+}
+
+func TestHandleCompactBlock(t *testing.T) {
+	received = []byte{}
+
+	neb := testNeb()
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	var n MockNetManager
+	bc.bkPool.RegisterInNetwork(n)
+	bc.SetConsensusHandler(&MockConsensus{neb.storage})
+	from := mockAddress()
+	key, _ := keystore.DefaultKS.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.SetMiner(from)
+	block.Seal()
+	block.Sign(signature)
+	pbMsg, err := block.ToCompactProto()
+	assert.Nil(t, err)
+	data, err := proto.Marshal(pbMsg)
+	assert.Nil(t, err)
+
+	// all the transactions are known, the block is reconstructed at once.
+	msg := messages.NewBaseMessage(MessageTypeCompactBlock, "from", data)
+	bc.bkPool.handleCompactMessage(msg)
+	assert.NotNil(t, bc.GetBlock(block.Hash()))
+	assert.Equal(t, 0, len(bc.bkPool.compactBlocks))
+
+	// a compact block with unknown transactions waits for them.
+	pbCompact := pbMsg.(*corepb.CompactBlock)
+	pbCompact.Header.Hash = []byte("unknown")
+	pbCompact.ShortIds = [][]byte{[]byte("shortid1")}
+	data, err = proto.Marshal(pbCompact)
+	assert.Nil(t, err)
+	msg = messages.NewBaseMessage(MessageTypeCompactBlock, "from", data)
+	bc.bkPool.handleCompactMessage(msg)
+	assert.Equal(t, 1, len(bc.bkPool.compactBlocks))
+	getBlockTxs := new(corepb.GetBlockTxs)
+	assert.Nil(t, proto.Unmarshal(received, getBlockTxs))
+	assert.Equal(t, []uint32{0}, getBlockTxs.Indexes)
+
+	// transactions not matching the short ids are refused.
+	tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, []byte("1"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	pbTx, _ := tx.ToProto()
+	data, err = proto.Marshal(&corepb.BlockTxs{Hash: []byte("unknown"), Indexes: []uint32{0}, Transactions: []*corepb.Transaction{pbTx.(*corepb.Transaction)}})
+	assert.Nil(t, err)
+	msg = messages.NewBaseMessage(MessageTypeBlockTxs, "from", data)
+	bc.bkPool.handleCompactMessage(msg)
+	assert.Equal(t, 0, len(bc.bkPool.compactBlocks))
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// blockIntervalFork is a change of the seconds between blocks taking effect from the timestamp.
+type blockIntervalFork struct {
+	timestamp int64
+	interval  int64
+}
+
+// BlockIntervals are the seconds between the blocks of a chain, scheduled in its genesis conf.
+// Block timestamps are in seconds, so the interval is at least one second.
+type BlockIntervals struct {
+	forks []*blockIntervalFork
+}
+
+// DefaultBlockIntervals keeps BlockInterval from the genesis, used by the blocks without a chain.
+var DefaultBlockIntervals = &BlockIntervals{forks: []*blockIntervalFork{{timestamp: GenesisTimestamp, interval: BlockInterval}}}
+
+// NewBlockIntervals returns the block interval and its scheduled changes in the genesis conf.
+// The changes take effect at the start of dynasties, so the slots of a dynasty share the interval.
+func NewBlockIntervals(conf *corepb.GenesisConsensusDpos) (*BlockIntervals, error) {
+	forks := []*blockIntervalFork{{timestamp: GenesisTimestamp, interval: BlockInterval}}
+	if conf != nil {
+		if conf.BlockInterval > 0 {
+			forks[0].interval = int64(conf.BlockInterval)
+		}
+		for _, v := range conf.BlockIntervalForks {
+			last := forks[len(forks)-1]
+			if v.Timestamp <= last.timestamp || v.Timestamp%DynastyInterval != 0 {
+				return nil, ErrInvalidBlockIntervalFork
+			}
+			forks = append(forks, &blockIntervalFork{timestamp: v.Timestamp, interval: int64(v.BlockInterval)})
+		}
+	}
+	for _, v := range forks {
+		if v.interval <= 0 || DynastyInterval%v.interval != 0 {
+			return nil, ErrInvalidBlockInterval
+		}
+	}
+
+	for _, v := range forks {
+		logging.CLog().WithFields(logrus.Fields{
+			"timestamp": v.timestamp,
+			"interval":  v.interval,
+		}).Info("Block interval scheduled.")
+	}
+	return &BlockIntervals{forks: forks}, nil
+}
+
+// At returns the seconds between blocks at the timestamp, DefaultBlockIntervals are used if nil.
+func (bi *BlockIntervals) At(timestamp int64) int64 {
+	if bi == nil {
+		bi = DefaultBlockIntervals
+	}
+	for i := len(bi.forks) - 1; i > 0; i-- {
+		if timestamp >= bi.forks[i].timestamp {
+			return bi.forks[i].interval
+		}
+	}
+	return bi.forks[0].interval
+}
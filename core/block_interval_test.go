@@ -0,0 +1,74 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBlockIntervals(t *testing.T) {
+	intervals, err := NewBlockIntervals(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, intervals.At(0), BlockInterval)
+
+	var unset *BlockIntervals
+	assert.Equal(t, unset.At(0), BlockInterval)
+
+	intervals, err = NewBlockIntervals(&corepb.GenesisConsensusDpos{
+		BlockInterval: 2,
+		BlockIntervalForks: []*corepb.BlockIntervalFork{
+			{Timestamp: DynastyInterval * 2, BlockInterval: 10},
+			{Timestamp: DynastyInterval * 5, BlockInterval: 1},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, intervals.At(0), int64(2))
+	assert.Equal(t, intervals.At(DynastyInterval*2-1), int64(2))
+	assert.Equal(t, intervals.At(DynastyInterval*2), int64(10))
+	assert.Equal(t, intervals.At(DynastyInterval*5-1), int64(10))
+	assert.Equal(t, intervals.At(DynastyInterval*5), int64(1))
+	assert.Equal(t, intervals.roundStart(DynastyInterval*2+DynastySize*10+1), DynastyInterval*2+DynastySize*10)
+
+	tests := []struct {
+		name string
+		conf *corepb.GenesisConsensusDpos
+		err  error
+	}{
+		{"not divide dynasty", &corepb.GenesisConsensusDpos{BlockInterval: 7}, ErrInvalidBlockInterval},
+		{"fork not at dynasty", &corepb.GenesisConsensusDpos{BlockIntervalForks: []*corepb.BlockIntervalFork{
+			{Timestamp: DynastyInterval + 1, BlockInterval: 10},
+		}}, ErrInvalidBlockIntervalFork},
+		{"fork not later", &corepb.GenesisConsensusDpos{BlockIntervalForks: []*corepb.BlockIntervalFork{
+			{Timestamp: DynastyInterval * 2, BlockInterval: 10},
+			{Timestamp: DynastyInterval, BlockInterval: 10},
+		}}, ErrInvalidBlockIntervalFork},
+		{"zero fork interval", &corepb.GenesisConsensusDpos{BlockIntervalForks: []*corepb.BlockIntervalFork{
+			{Timestamp: DynastyInterval, BlockInterval: 0},
+		}}, ErrInvalidBlockInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBlockIntervals(tt.conf)
+			assert.Equal(t, err, tt.err)
+		})
+	}
+}
@@ -54,12 +54,17 @@ type BlockPool struct {
 	size                          int
 	receiveBlockMessageCh         chan net.Message
 	receiveDownloadBlockMessageCh chan net.Message
+	receiveCompactMessageCh       chan net.Message
 	receivedLinkedBlockCh         chan *Block
 	quitCh                        chan int
 
 	bc    *BlockChain
 	cache *lru.Cache
 	slot  *lru.Cache
+	// the late blocks a peer was penalized for.
+	late *lru.Cache
+
+	compactBlocks map[byteutils.HexHash]*compactBlock
 
 	nm p2p.Manager
 	mu sync.RWMutex
@@ -81,6 +86,8 @@ func NewBlockPool(size int) (*BlockPool, error) {
 		size: size,
 		receiveBlockMessageCh:         make(chan net.Message, size),
 		receiveDownloadBlockMessageCh: make(chan net.Message, size),
+		receiveCompactMessageCh:       make(chan net.Message, size),
+		compactBlocks:                 make(map[byteutils.HexHash]*compactBlock),
 		receivedLinkedBlockCh:         make(chan *Block, size),
 		quitCh:                        make(chan int, 1),
 	}
@@ -93,6 +100,10 @@ func NewBlockPool(size int) (*BlockPool, error) {
 	if err != nil {
 		return nil, err
 	}
+	bp.late, err = lru.New(size)
+	if err != nil {
+		return nil, err
+	}
 	return bp, nil
 }
 
@@ -106,6 +117,16 @@ func (pool *BlockPool) RegisterInNetwork(nm p2p.Manager) {
 	nm.Register(net.NewSubscriber(pool, pool.receiveBlockMessageCh, MessageTypeNewBlock))
 	nm.Register(net.NewSubscriber(pool, pool.receiveBlockMessageCh, MessageTypeDownloadedBlockReply))
 	nm.Register(net.NewSubscriber(pool, pool.receiveDownloadBlockMessageCh, MessageTypeDownloadedBlock))
+	nm.Register(net.NewSubscriber(pool, pool.receiveCompactMessageCh, MessageTypeCompactBlock))
+	nm.Register(net.NewSubscriber(pool, pool.receiveCompactMessageCh, MessageTypeGetBlockTxs))
+	nm.Register(net.NewSubscriber(pool, pool.receiveCompactMessageCh, MessageTypeBlockTxs))
+	p2p.RegisterTopic(MessageTypeNewBlock, p2p.TopicBlocks)
+	for _, name := range []string{MessageTypeCompactBlock, MessageTypeGetBlockTxs, MessageTypeBlockTxs} {
+		p2p.RegisterMessageVersion(name, 2)
+	}
+	for _, name := range []string{MessageTypeNewBlock, MessageTypeCompactBlock, MessageTypeGetBlockTxs, MessageTypeBlockTxs} {
+		p2p.RegisterUrgentMessage(name)
+	}
 	pool.nm = nm
 }
 
@@ -145,6 +166,7 @@ func (pool *BlockPool) handleBlock(msg net.Message) {
 			"msg":     msg,
 			"err":     err,
 		}).Error("Failed to unmarshal data.")
+		pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
 		return
 	}
 	if err := block.FromProto(pbblock); err != nil {
@@ -153,26 +175,41 @@ func (pool *BlockPool) handleBlock(msg net.Message) {
 			"msg":     msg,
 			"err":     err,
 		}).Error("Failed to recover a block from proto data.")
+		pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
 		return
 	}
 
+	pool.acceptBlock(msg.MessageFrom(), msg.MessageType(), block)
+}
+
+// acceptBlock push a block received from the network into the pool.
+func (pool *BlockPool) acceptBlock(sender string, msgType string, block *Block) {
 	diff := time.Now().Unix() - block.Timestamp()
-	if msg.MessageType() == MessageTypeNewBlock && int64(math.Abs(float64(diff))) > AcceptedNetWorkDelay {
+	if msgType == MessageTypeNewBlock && int64(math.Abs(float64(diff))) > AcceptedNetWorkDelay {
 		logging.VLog().WithFields(logrus.Fields{
 			"block": block,
 			"diff":  diff,
 			"limit": AcceptedNetWorkDelay,
 			"err":   "timeout",
 		}).Warn("Failed to accept a timeout block.")
+		// a late block is relayed by many peers, only the first one is penalized for it.
+		if key := block.Hash().Hex(); !pool.late.Contains(key) {
+			pool.late.Add(key, true)
+			pool.reportPeer(sender, p2p.UselessBlock)
+		}
 		return
 	}
 
 	logging.VLog().WithFields(logrus.Fields{
 		"block": block,
-		"type":  msg.MessageType(),
+		"type":  msgType,
 	}).Info("Received a new block.")
 
-	if err := pool.PushAndRelay(msg.MessageFrom(), block); err != nil {
+	err := pool.PushAndRelay(sender, block)
+	if err == nil || err == ErrDuplicatedBlock {
+		pool.nm.UpdatePeerHead(sender, block.Height(), block.Hash().String())
+	}
+	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"block": block,
 			"err":   err,
@@ -197,6 +234,7 @@ func (pool *BlockPool) handleDownloadedBlock(msg net.Message) {
 			"msg":     msg,
 			"err":     err,
 		}).Error("Failed to unmarshal data.")
+		pool.reportPeer(msg.MessageFrom(), p2p.InvalidMessage)
 		return
 	}
 
@@ -267,6 +305,8 @@ func (pool *BlockPool) loop() {
 			pool.handleBlock(msg)
 		case msg := <-pool.receiveDownloadBlockMessageCh:
 			pool.handleDownloadedBlock(msg)
+		case msg := <-pool.receiveCompactMessageCh:
+			pool.handleCompactMessage(msg)
 		}
 	}
 }
@@ -367,6 +407,7 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 	// verify block integrity
 	if err := block.VerifyIntegrity(pool.bc.chainID, pool.bc.ConsensusHandler()); err != nil {
 		invalidBlockCounter.Inc(1)
+		pool.reportPeer(sender, p2p.InvalidMessage)
 		return err
 	}
 
@@ -382,6 +423,7 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 
 	if exist := pool.slot.Contains(lb.block.Timestamp()); exist {
 		invalidBlockCounter.Inc(1)
+		pool.collectEvidence(lb.block)
 		return ErrDoubleBlockMinted
 	}
 	pool.slot.Add(lb.block.Timestamp(), lb.block.Hash())
@@ -426,12 +468,12 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 			return ErrMissingParentBlock
 		}
 		// do sync if there are so many empty slots.
-		if lb.block.Timestamp()-bc.TailBlock().Timestamp() > BlockInterval*DynastySize {
+		if lb.block.Timestamp()-bc.TailBlock().Timestamp() > bc.BlockIntervalAt(bc.TailBlock().Timestamp())*DynastySize {
 
 			logging.CLog().WithFields(logrus.Fields{
 				"tail":    bc.tailBlock,
 				"offline": strconv.Itoa(int(lb.block.Timestamp()-bc.TailBlock().Timestamp())) + "s",
-				"limit":   strconv.Itoa(int(BlockInterval*DynastySize)) + "s",
+				"limit":   strconv.Itoa(int(bc.BlockIntervalAt(bc.TailBlock().Timestamp())*DynastySize)) + "s",
 			}).Warn("offline too long, restart sync from others.")
 
 			bc.Neb().StartSync()
@@ -466,6 +508,14 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 	return nil
 }
 
+// reportPeer penalize the peer sent an invalid or useless block.
+func (pool *BlockPool) reportPeer(sender string, m p2p.PeerMisbehavior) {
+	if sender == NoSender || pool.nm == nil {
+		return
+	}
+	pool.nm.ReportPeer(sender, m)
+}
+
 func (pool *BlockPool) setBlockChain(bc *BlockChain) {
 	pool.bc = bc
 }
@@ -487,13 +537,45 @@ func (lb *linkedBlock) LinkParent(parentBlock *linkedBlock) {
 }
 
 func (lb *linkedBlock) travelToLinkAndReturnAllValidBlocks(parentBlock *Block) ([]*Block, []*Block, error) {
+	if lb.pool.bc.RelayMode() {
+		if err := lb.block.linkRelayBlock(parentBlock, lb.pool.bc.checkpoints); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"parent": parentBlock,
+				"block":  lb.block,
+				"err":    err,
+			}).Error("Failed to link the relay block with its parent.")
+			return nil, nil, err
+		}
+	} else if err := lb.linkAndExecute(parentBlock); err != nil {
+		return nil, nil, err
+	}
+
+	allBlocks := []*Block{lb.block}
+	tailBlocks := []*Block{}
+
+	if len(lb.childBlocks) == 0 {
+		tailBlocks = append(tailBlocks, lb.block)
+	}
+
+	for _, clb := range lb.childBlocks {
+		a, b, err := clb.travelToLinkAndReturnAllValidBlocks(lb.block)
+		if err == nil {
+			allBlocks = append(allBlocks, a...)
+			tailBlocks = append(tailBlocks, b...)
+		}
+	}
+
+	return allBlocks, tailBlocks, nil
+}
+
+func (lb *linkedBlock) linkAndExecute(parentBlock *Block) error {
 	if err := lb.block.LinkParentBlock(parentBlock); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"parent": parentBlock,
 			"block":  lb.block,
 			"err":    err,
 		}).Error("Failed to link the block with its parent.")
-		return nil, nil, err
+		return err
 	}
 
 	if err := lb.block.VerifyExecution(parentBlock, lb.pool.bc.ConsensusHandler()); err != nil {
@@ -501,27 +583,43 @@ func (lb *linkedBlock) travelToLinkAndReturnAllValidBlocks(parentBlock *Block) (
 			"block": lb.block,
 			"err":   err,
 		}).Error("Failed to execute block.")
-		return nil, nil, err
+		return err
 	}
 
 	logging.VLog().WithFields(logrus.Fields{
 		"block": lb.block,
 	}).Info("Block Verified.")
+	return nil
+}
 
-	allBlocks := []*Block{lb.block}
-	tailBlocks := []*Block{}
-
-	if len(lb.childBlocks) == 0 {
-		tailBlocks = append(tailBlocks, lb.block)
+// collectEvidence keep the evidence if the block and the one minted before in the same slot are signed by the same proposer.
+func (pool *BlockPool) collectEvidence(block *Block) {
+	v, ok := pool.slot.Get(block.Timestamp())
+	if !ok {
+		return
 	}
-
-	for _, clb := range lb.childBlocks {
-		a, b, err := clb.travelToLinkAndReturnAllValidBlocks(lb.block)
-		if err == nil {
-			allBlocks = append(allBlocks, a...)
-			tailBlocks = append(tailBlocks, b...)
-		}
+	hash := v.(byteutils.Hash)
+	var minted *Block
+	if v, ok := pool.cache.Get(hash.Hex()); ok {
+		minted = v.(*linkedBlock).block
+	} else {
+		minted = pool.bc.GetBlock(hash)
+	}
+	if minted == nil {
+		return
 	}
 
-	return allBlocks, tailBlocks, nil
+	evidence, err := NewEvidence(minted, block)
+	if err != nil {
+		return
+	}
+	if _, err := evidence.VerifyIntegrity(pool.bc.chainID); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block":  block,
+			"minted": minted,
+			"err":    err,
+		}).Warn("Failed to verify double-proposal evidence.")
+		return
+	}
+	pool.bc.EvidencePool().Push(evidence)
 }
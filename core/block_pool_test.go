@@ -78,6 +78,20 @@ func (n MockNetManager) BroadcastNetworkID([]byte) {}
 
 func (n MockNetManager) BuildData([]byte, string) []byte { return nil }
 
+func (n MockNetManager) ReportPeer(string, p2p.PeerMisbehavior) {}
+
+func (n MockNetManager) UpdatePeerHead(string, uint64, string) {}
+
+// reportingNetManager records the misbehaviors reported.
+type reportingNetManager struct {
+	MockNetManager
+	reports map[string][]p2p.PeerMisbehavior
+}
+
+func (n *reportingNetManager) ReportPeer(id string, m p2p.PeerMisbehavior) {
+	n.reports[id] = append(n.reports[id], m)
+}
+
 func TestBlockPool(t *testing.T) {
 	received = []byte{}
 
@@ -303,6 +317,34 @@ func TestHandleBlock(t *testing.T) {
 	assert.NotNil(t, bc.GetBlock(block.Hash()))
 }
 
+func TestHandleBlock_Late(t *testing.T) {
+	neb := testNeb()
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	n := &reportingNetManager{reports: make(map[string][]p2p.PeerMisbehavior)}
+	bc.bkPool.RegisterInNetwork(n)
+	bc.SetConsensusHandler(&MockConsensus{neb.storage})
+	from := mockAddress()
+
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.header.timestamp = time.Now().Unix() - AcceptedNetWorkDelay - 1
+	block.SetMiner(from)
+	assert.Nil(t, block.Seal())
+	pbMsg, err := block.ToProto()
+	assert.Nil(t, err)
+	data, err := proto.Marshal(pbMsg)
+	assert.Nil(t, err)
+
+	// the late block is relayed by several peers, only the first one is penalized.
+	for _, sender := range []string{"a", "b", "a"} {
+		bc.bkPool.handleBlock(messages.NewBaseMessage(MessageTypeNewBlock, sender, data))
+	}
+	assert.Nil(t, bc.GetBlock(block.Hash()))
+	assert.Equal(t, []p2p.PeerMisbehavior{p2p.UselessBlock}, n.reports["a"])
+	assert.Empty(t, n.reports["b"])
+}
+
 func TestHandleDownloadedBlock(t *testing.T) {
 	received = []byte{}
 
@@ -472,6 +472,38 @@ func TestParentBlock(t *testing.T) {
 	assert.Equal(t, parent.Hash(), bc.genesisBlock.Hash())
 }
 
+func TestBlock_AncestorHash(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	coinbase := mockAddress()
+	blocks := []*Block{bc.genesisBlock}
+	for i := 0; i < 3; i++ {
+		block, err := NewBlock(bc.ChainID(), coinbase, blocks[len(blocks)-1])
+		assert.Nil(t, err)
+		block.SetTimestamp(int64(i+1) * BlockInterval)
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, bc.storeBlockToStorage(block))
+		blocks = append(blocks, block)
+	}
+	block := blocks[len(blocks)-1]
+	for _, v := range blocks[:len(blocks)-1] {
+		hash, err := block.AncestorHash(v.Height())
+		assert.Nil(t, err)
+		assert.Equal(t, v.Hash(), hash)
+	}
+	_, err = block.AncestorHash(block.Height())
+	assert.Equal(t, ErrOutOfBlockHashWindow, err)
+
+	window := BlockHashWindow
+	BlockHashWindow = 2
+	defer func() { BlockHashWindow = window }()
+	_, err = block.AncestorHash(block.Height() - 3)
+	assert.Equal(t, ErrOutOfBlockHashWindow, err)
+	hash, err := block.AncestorHash(block.Height() - 2)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[len(blocks)-3].Hash(), hash)
+}
+
 func TestGivebackInvalidTx(t *testing.T) {
 	bc, err := NewBlockChain(testNeb())
 	assert.Nil(t, err)
@@ -21,11 +21,13 @@ package core
 import (
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -45,6 +47,7 @@ type BlockChain struct {
 
 	bkPool           *BlockPool
 	txPool           *TransactionPool
+	evidencePool     *EvidencePool
 	consensusHandler Consensus
 
 	cachedBlocks       *lru.Cache
@@ -54,6 +57,17 @@ type BlockChain struct {
 	neb     Neblet
 
 	eventEmitter *EventEmitter
+	eventStore   *EventStore
+
+	// the blocks are linked without being executed in relay mode.
+	relay       bool
+	checkpoints map[uint64]byteutils.Hash
+
+	headSubs *sync.Map // the channels receiving the changes of the canonical chain.
+
+	blockIntervals   *BlockIntervals
+	commissionHeight uint64
+	randomHeight     uint64
 }
 
 const (
@@ -86,15 +100,42 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 	if err != nil {
 		return nil, err
 	}
+	blockIntervals, err := NewBlockIntervals(neb.Genesis().Consensus.Dpos)
+	if err != nil {
+		return nil, err
+	}
+	if err := SetStorageRent(neb.Genesis().StorageRent); err != nil {
+		return nil, err
+	}
+	if err := nvm.SetMathLibs(neb.Genesis().MathLibForks); err != nil {
+		return nil, err
+	}
+	nvm.SetSandboxHeight(neb.Genesis().SandboxHeight)
+	nvm.SetTypeCheckHeight(neb.Genesis().TypecheckHeight)
+	if err := nvm.SetGasTables(neb.Genesis().GasTableForks); err != nil {
+		return nil, err
+	}
+	if err := nvm.SetExecutionLimitsForks(neb.Genesis().ExecutionLimitsForks); err != nil {
+		return nil, err
+	}
+	if err := SetOracleOperators(neb.Genesis().OracleOperators); err != nil {
+		return nil, err
+	}
 
 	var bc = &BlockChain{
 		chainID:      neb.Genesis().Meta.ChainId,
 		genesis:      neb.Genesis(),
 		bkPool:       blockPool,
 		txPool:       txPool,
+		evidencePool: NewEvidencePool(),
 		storage:      neb.Storage(),
 		neb:          neb,
 		eventEmitter: neb.EventEmitter(),
+		headSubs:     new(sync.Map),
+
+		blockIntervals:   blockIntervals,
+		commissionHeight: neb.Genesis().CommissionHeight,
+		randomHeight:     neb.Genesis().RandomHeight,
 	}
 
 	bc.cachedBlocks, _ = lru.New(1024)
@@ -114,10 +155,13 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		"token.distribution":     genesisConf.TokenDistribution,
 	}).Info("Genesis Configuration.")
 
+	bc.relay = bc.loadRelayFromStorage()
 	bc.tailBlock, err = bc.loadTailFromStorage()
 	if err != nil {
 		return nil, err
 	}
+	// the chains stored before the index are indexed once.
+	bc.indexCanonicalChain(bc.tailBlock)
 	logging.CLog().WithFields(logrus.Fields{
 		"block": bc.tailBlock,
 	}).Info("Tail Block.")
@@ -163,11 +207,14 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 	oldTail := bc.tailBlock
 	bc.tailBlock = newTail
 	bc.storeTailToStorage(bc.tailBlock)
+	bc.indexCanonicalChain(bc.tailBlock)
 	// giveBack txs in reverted blocks to tx pool
 	ancestor, err := bc.FindCommonAncestorWithTail(oldTail)
 	if err != nil {
 		return err
 	}
+	bc.storeEvents(ancestor, newTail)
+	bc.notifyChainHead(ancestor, oldTail, newTail)
 	if ancestor.Hash().Equals(oldTail.Hash()) {
 		// oldTail and newTail is on same chain, no reverted blocks
 		// when tail change, add metrics
@@ -187,6 +234,7 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 	for revertTimes = 0; !reverted.Hash().Equals(ancestor.Hash()); {
 		revertTimes++
 		reverted.ReturnTransactions()
+		reverted.triggerRevertEvent()
 		reverted = bc.GetBlock(reverted.header.parentHash)
 		if reverted == nil {
 			return ErrMissingParentBlock
@@ -272,6 +320,73 @@ func (bc *BlockChain) FetchDescendantInCanonicalChain(n int, block *Block) ([]*B
 	return res, nil
 }
 
+// FetchBlocksInCanonicalChain return at most count blocks of the canonical chain from the height start,
+// in ascending order, fewer if the tail is reached.
+func (bc *BlockChain) FetchBlocksInCanonicalChain(start uint64, count int) []*Block {
+	tail := bc.TailBlock()
+	if count <= 0 || start == 0 || start > tail.Height() {
+		return nil
+	}
+	end := start + uint64(count) - 1
+	if end > tail.Height() {
+		end = tail.Height()
+	}
+	var res []*Block
+	for height := start; height <= end; height++ {
+		// the blocks not kept are skipped.
+		if block := bc.GetBlockOnCanonicalChainByHeight(height); block != nil {
+			res = append(res, block)
+		}
+	}
+	return res
+}
+
+// StateAt return the block whose state is queried, of the hash if given, else of the canonical chain
+// at the height if not 0, else the tail. Its state must be kept in the storage.
+func (bc *BlockChain) StateAt(blockHash byteutils.Hash, height uint64) (*Block, error) {
+	if len(blockHash) > 0 && height > 0 {
+		return nil, ErrStateBlockAndHeight
+	}
+	block := bc.TailBlock()
+	if len(blockHash) > 0 {
+		block = bc.GetBlock(blockHash)
+	} else if height > 0 {
+		block = nil
+		if blocks := bc.FetchBlocksInCanonicalChain(height, 1); len(blocks) > 0 {
+			block = blocks[0]
+		}
+	}
+	if block == nil {
+		return nil, ErrStateBlockNotFound
+	}
+	if _, err := bc.storage.Get(block.StateRoot()); err != nil {
+		return nil, ErrStateNotRetained
+	}
+	return block, nil
+}
+
+// SetHead rewind the canonical chain to its block at the height, the blocks above are reverted
+// and forgotten by the fork choice until new blocks are linked on them.
+func (bc *BlockChain) SetHead(height uint64) error {
+	if height == 0 || height > bc.TailBlock().Height() {
+		return ErrInvalidHeadHeight
+	}
+	head := bc.GetBlockOnCanonicalChainByHeight(height)
+	if head == nil {
+		return ErrInvalidHeadHeight
+	}
+	if err := bc.SetTailBlock(head); err != nil {
+		return err
+	}
+	bc.detachedTailBlocks.Purge()
+	bc.detachedTailBlocks.Add(head.Hash().Hex(), head)
+
+	logging.CLog().WithFields(logrus.Fields{
+		"head": head,
+	}).Warn("Rewound the canonical chain.")
+	return nil
+}
+
 // BlockPool return block pool.
 func (bc *BlockChain) BlockPool() *BlockPool {
 	return bc.bkPool
@@ -282,6 +397,11 @@ func (bc *BlockChain) TransactionPool() *TransactionPool {
 	return bc.txPool
 }
 
+// EvidencePool return evidence pool.
+func (bc *BlockChain) EvidencePool() *EvidencePool {
+	return bc.evidencePool
+}
+
 // SetConsensusHandler set consensus handler.
 func (bc *BlockChain) SetConsensusHandler(handler Consensus) {
 	bc.consensusHandler = handler
@@ -341,12 +461,22 @@ func (bc *BlockChain) DetachedTailBlocks() []*Block {
 	return ret
 }
 
+// BlockIntervals return the block intervals scheduled in the genesis conf.
+func (bc *BlockChain) BlockIntervals() *BlockIntervals {
+	return bc.blockIntervals
+}
+
+// BlockIntervalAt returns the seconds between the blocks of the chain at the timestamp.
+func (bc *BlockChain) BlockIntervalAt(timestamp int64) int64 {
+	return bc.blockIntervals.At(timestamp)
+}
+
 // GetBlock return block of given hash from local storage and detachedBlocks.
 func (bc *BlockChain) GetBlock(hash byteutils.Hash) *Block {
 	// TODO: get block from local storage.
 	v, _ := bc.cachedBlocks.Get(hash.Hex())
 	if v == nil {
-		block, err := LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+		block, err := bc.loadBlock(hash)
 		if err != nil {
 			return nil
 		}
@@ -399,15 +529,64 @@ func (bc *BlockChain) GasPrice() *util.Uint128 {
 
 // EstimateGas returns the transaction gas cost
 func (bc *BlockChain) EstimateGas(tx *Transaction) (*util.Uint128, error) {
+	result, err := bc.SimulateTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+	return result.GasUsed, nil
+}
+
+// ProfileGas estimates the gas of the transaction as EstimateGas does,
+// and attributes the gas of the contracts to their functions and storage accesses.
+func (bc *BlockChain) ProfileGas(tx *Transaction) (*util.Uint128, []*nvm.GasProfileEntry, error) {
+	result, err := bc.ProfileTransaction(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.GasUsed, result.Profile, nil
+}
+
+// SimulateTransaction executes the transaction of any type in a sandbox of the tail as it would be
+// in the next block, with the balance of the sender topped up for the gas, and returns the gas it
+// would use with the value returned by the contract or the error failing it, nothing is kept.
+func (bc *BlockChain) SimulateTransaction(tx *Transaction) (*SimulateResult, error) {
+	return bc.simulateTransaction(tx, nil)
+}
+
+// ProfileTransaction simulates the transaction as SimulateTransaction does, and profiles the gas of the contracts.
+func (bc *BlockChain) ProfileTransaction(tx *Transaction) (*SimulateResult, error) {
+	tracer := nvm.NewTracer()
+	result, err := bc.simulateTransaction(tx, tracer)
+	if err != nil {
+		return nil, err
+	}
+	result.Profile = tracer.Profile()
+	return result, nil
+}
+
+func (bc *BlockChain) simulateTransaction(tx *Transaction, tracer *nvm.Tracer) (*SimulateResult, error) {
 	// update gas to max for estimate
 	tx.gasLimit = TransactionMaxGas
 
-	bc.tailBlock.accState.BeginBatch()
-	fromAcc := bc.tailBlock.accState.GetOrCreateUserAccount(tx.from.address)
+	// estimate in a sandbox of the tail, so the concurrent estimations don't touch the chain.
+	sandbox, err := bc.tailBlock.sandbox()
+	if err != nil {
+		return nil, err
+	}
+	fromAcc := sandbox.accState.GetOrCreateUserAccount(tx.from.address)
 	fromAcc.AddBalance(tx.MinBalanceRequired())
 	fromAcc.AddBalance(tx.value)
-	defer bc.tailBlock.accState.RollBack()
-	return tx.VerifyExecution(bc.tailBlock)
+
+	result := &SimulateResult{}
+	gas, err := tx.verifyExecution(sandbox, tracer, result)
+	if err != nil {
+		return nil, err
+	}
+	result.GasUsed = gas
+	if result.GasLimit == nil {
+		result.GasLimit = gas
+	}
+	return result, nil
 }
 
 func (bc *BlockChain) getAncestorHash(number int) (byteutils.Hash, error) {
@@ -476,7 +655,7 @@ func (bc *BlockChain) loadTailFromStorage() (*Block, error) {
 		return genesis, nil
 	}
 
-	return LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+	return bc.loadBlock(hash)
 }
 
 func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
@@ -491,5 +670,8 @@ func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
 		}
 
 	}
+	genesis.blockIntervals = bc.blockIntervals
+	genesis.commissionHeight = bc.commissionHeight
+	genesis.randomHeight = bc.randomHeight
 	return genesis, nil
 }
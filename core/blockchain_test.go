@@ -19,6 +19,7 @@
 package core
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -221,6 +222,86 @@ func TestBlockChain_FetchDescendantInCanonicalChain(t *testing.T) {
 	assert.Nil(t, err0)
 }
 
+func TestBlockChain_FetchBlocksInCanonicalChain(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	/*
+		genesisi -- 1 - 2 - 3 - 4 - 5 - 6
+	*/
+	var blocks []*Block
+	for i := 0; i < 6; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		blocks = append(blocks, block)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		bc.BlockPool().Push(block)
+		bc.SetTailBlock(block)
+	}
+	blocks24 := bc.FetchBlocksInCanonicalChain(blocks[1].Height(), 3)
+	assert.Equal(t, len(blocks24), 3)
+	assert.Equal(t, BlockFromNetwork(blocks24[0]), BlockFromNetwork(blocks[1]))
+	assert.Equal(t, BlockFromNetwork(blocks24[2]), BlockFromNetwork(blocks[3]))
+	blocks46 := bc.FetchBlocksInCanonicalChain(blocks[3].Height(), 10)
+	assert.Equal(t, len(blocks46), 3)
+	assert.Equal(t, BlockFromNetwork(blocks46[2]), BlockFromNetwork(blocks[5]))
+	assert.Nil(t, bc.FetchBlocksInCanonicalChain(blocks[5].Height()+1, 3))
+}
+
+func TestBlockChain_CanonicalIndex(t *testing.T) {
+	neb := testNeb()
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	extend := func(parent *Block, count int, offset int64) []*Block {
+		var blocks []*Block
+		for i := 0; i < count; i++ {
+			block, _ := bc.NewBlockFromParent(coinbase, parent)
+			block.header.timestamp = parent.Timestamp() + BlockInterval*offset
+			block.CollectTransactions(0)
+			block.SetMiner(coinbase)
+			block.Seal()
+			assert.Nil(t, bc.BlockPool().Push(block))
+			blocks = append(blocks, block)
+			parent = bc.GetBlock(block.Hash())
+		}
+		assert.Nil(t, bc.SetTailBlock(parent))
+		return blocks
+	}
+	/*
+		genesis -- 1 - 2 - 3
+		             \ 2' - 3' - 4'
+	*/
+	blocks := extend(bc.genesisBlock, 3, 1)
+	assert.Equal(t, blocks[2].Hash(), bc.GetBlockOnCanonicalChainByHeight(blocks[2].Height()).Hash())
+	assert.Nil(t, bc.GetBlockOnCanonicalChainByHeight(0))
+	assert.Nil(t, bc.GetBlockOnCanonicalChainByHeight(blocks[2].Height()+1))
+
+	// the fork rewrites the heights of the blocks it replaces.
+	forked := extend(bc.GetBlock(blocks[0].Hash()), 3, 2)
+	assert.Equal(t, blocks[0].Hash(), bc.GetBlockOnCanonicalChainByHeight(blocks[0].Height()).Hash())
+	for _, block := range forked {
+		assert.Equal(t, block.Hash(), bc.GetBlockOnCanonicalChainByHeight(block.Height()).Hash())
+	}
+
+	// the heights above the tail are not served after a rewind.
+	assert.Nil(t, bc.SetHead(forked[0].Height()))
+	assert.Nil(t, bc.GetBlockOnCanonicalChainByHeight(forked[1].Height()))
+	assert.Equal(t, 2, len(bc.FetchBlocksInCanonicalChain(blocks[0].Height(), 10)))
+
+	// a chain stored without the index is indexed when it is loaded.
+	for h := uint64(1); h <= forked[0].Height(); h++ {
+		assert.Nil(t, neb.storage.Del(canonicalIndexKey(h)))
+	}
+	bc, _ = NewBlockChain(neb)
+	assert.Equal(t, forked[0].Hash(), bc.GetBlockOnCanonicalChainByHeight(forked[0].Height()).Hash())
+	assert.Equal(t, bc.genesisBlock.Hash(), bc.GetBlockOnCanonicalChainByHeight(1).Hash())
+}
+
 func TestBlockChain_EstimateGas(t *testing.T) {
 	priv := secp256k1.GeneratePrivateKey()
 	pubdata, _ := priv.PublicKey().Encoded()
@@ -280,3 +361,120 @@ func TestGetPrice(t *testing.T) {
 	bc.storeBlockToStorage(block)
 	assert.Equal(t, bc.GasPrice(), lowerGasPrice)
 }
+
+func TestBlockChain_SubscribeChainHead(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	ch := make(chan *ChainHeadEvent, 8)
+	bc.SubscribeChainHead(ch)
+
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	block0, _ := bc.NewBlock(coinbase)
+	block0.header.timestamp = BlockInterval
+	block0.SetMiner(coinbase)
+	block0.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	coinbase11 := &Address{[]byte("012345678901234567890011")}
+	coinbase12 := &Address{[]byte("012345678901234567890012")}
+	block11, _ := bc.NewBlock(coinbase11)
+	block11.header.timestamp = BlockInterval * 2
+	block11.SetMiner(coinbase11)
+	block11.Seal()
+	block12, _ := bc.NewBlock(coinbase12)
+	block12.header.timestamp = BlockInterval * 3
+	block12.SetMiner(coinbase12)
+	block12.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block11)))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block12)))
+	assert.Nil(t, bc.SetTailBlock(block11))
+	assert.Nil(t, bc.SetTailBlock(block12))
+
+	var events []*ChainHeadEvent
+	for len(ch) > 0 {
+		events = append(events, <-ch)
+	}
+	expected := []struct {
+		block    *Block
+		reverted bool
+	}{
+		{block0, false},
+		{block11, false},
+		{block11, true},
+		{block12, false},
+	}
+	assert.Equal(t, len(expected), len(events))
+	for i, v := range expected {
+		assert.Equal(t, v.block.Hash(), events[i].Block.Hash())
+		assert.Equal(t, v.reverted, events[i].Reverted)
+	}
+
+	bc.UnsubscribeChainHead(ch)
+	assert.Nil(t, bc.SetTailBlock(block11))
+	assert.Equal(t, 0, len(ch))
+}
+
+func TestBlockChain_SetHead(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		coinbase := &Address{[]byte(fmt.Sprintf("01234567890123456789%04d", i))}
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	assert.Equal(t, ErrInvalidHeadHeight, bc.SetHead(0))
+	assert.Equal(t, ErrInvalidHeadHeight, bc.SetHead(blocks[2].Height()+1))
+	assert.Nil(t, bc.SetHead(blocks[0].Height()))
+	assert.Equal(t, blocks[0].Hash(), bc.TailBlock().Hash())
+	tails := bc.DetachedTailBlocks()
+	assert.Equal(t, 1, len(tails))
+	assert.Equal(t, blocks[0].Hash(), tails[0].Hash())
+}
+
+func TestBlockChain_StateAt(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		coinbase := &Address{[]byte(fmt.Sprintf("01234567890123456789%04d", i))}
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	block, err := bc.StateAt(nil, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[2].Hash(), block.Hash())
+	block, err = bc.StateAt(nil, blocks[0].Height())
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[0].Hash(), block.Hash())
+	assert.Equal(t, blocks[0].StateRoot(), block.StateRoot())
+	block, err = bc.StateAt(blocks[1].Hash(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[1].Hash(), block.Hash())
+
+	_, err = bc.StateAt(blocks[1].Hash(), blocks[1].Height())
+	assert.Equal(t, ErrStateBlockAndHeight, err)
+	_, err = bc.StateAt(nil, blocks[2].Height()+1)
+	assert.Equal(t, ErrStateBlockNotFound, err)
+	_, err = bc.StateAt([]byte("unknown"), 0)
+	assert.Equal(t, ErrStateBlockNotFound, err)
+}
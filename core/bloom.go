@@ -0,0 +1,106 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// BloomByteLength is the size of the bloom filter of contract logs in a block.
+const BloomByteLength = 256
+
+// bloomBits is the number of bits set for each item.
+const bloomBits = 3
+
+// Bloom filters the contract addresses and topics of the logs in a block.
+// It's empty if the block has no log.
+type Bloom byteutils.Hash
+
+// NewBloom returns an empty bloom filter.
+func NewBloom() Bloom {
+	return make(Bloom, BloomByteLength)
+}
+
+func bloomIndexes(data []byte) [bloomBits]uint {
+	hasher := sha3.New256()
+	hasher.Write(data)
+	hash := hasher.Sum(nil)
+
+	var indexes [bloomBits]uint
+	for i := 0; i < bloomBits; i++ {
+		indexes[i] = (uint(hash[2*i])<<8 | uint(hash[2*i+1])) % (BloomByteLength * 8)
+	}
+	return indexes
+}
+
+// Add data into the bloom filter.
+func (b Bloom) Add(data []byte) {
+	for _, idx := range bloomIndexes(data) {
+		b[idx/8] |= 1 << (idx % 8)
+	}
+}
+
+// Test returns false if data is definitely not in the bloom filter.
+func (b Bloom) Test(data []byte) bool {
+	if len(b) != BloomByteLength {
+		return false
+	}
+	for _, idx := range bloomIndexes(data) {
+		if b[idx/8]&(1<<(idx%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MayMatch returns false if no log in the block is emitted by address with the topics,
+// empty address and topics match any.
+func (b Bloom) MayMatch(address string, topics []string) bool {
+	if len(b) != BloomByteLength {
+		return false
+	}
+	if len(address) > 0 && !b.Test([]byte(address)) {
+		return false
+	}
+	for _, topic := range topics {
+		if len(topic) > 0 && !b.Test([]byte(topic)) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchLog returns whether the log is emitted by address with the topics in position,
+// empty address and topics match any.
+func MatchLog(log *nvm.ContractLog, address string, topics []string) bool {
+	if len(address) > 0 && log.Address != address {
+		return false
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, topic := range topics {
+		if len(topic) > 0 && log.Topics[i] != topic {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBloom(t *testing.T) {
+	var empty Bloom
+	assert.False(t, empty.Test([]byte("Transfer")))
+	assert.False(t, empty.MayMatch("", nil))
+
+	bloom := NewBloom()
+	bloom.Add([]byte("contract"))
+	bloom.Add([]byte("Transfer"))
+	assert.True(t, bloom.Test([]byte("contract")))
+	assert.True(t, bloom.Test([]byte("Transfer")))
+	assert.False(t, bloom.Test([]byte("Approval")))
+
+	assert.True(t, bloom.MayMatch("", nil))
+	assert.True(t, bloom.MayMatch("contract", []string{"Transfer", ""}))
+	assert.False(t, bloom.MayMatch("other", []string{"Transfer"}))
+	assert.False(t, bloom.MayMatch("contract", []string{"Approval"}))
+}
+
+func TestMatchLog(t *testing.T) {
+	log := &nvm.ContractLog{Address: "contract", Topics: []string{"Transfer", "alice", "bob"}}
+	tests := []struct {
+		name    string
+		address string
+		topics  []string
+		want    bool
+	}{
+		{"any", "", nil, true},
+		{"address", "contract", nil, true},
+		{"other address", "other", nil, false},
+		{"topics", "contract", []string{"Transfer", "", "bob"}, true},
+		{"topic in other position", "", []string{"", "bob"}, false},
+		{"too many topics", "", []string{"Transfer", "alice", "bob", "carol"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchLog(log, tt.address, tt.topics))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var canonicalIndexPrefix = []byte("canonical_")
+
+func canonicalIndexKey(height uint64) []byte {
+	return append(append([]byte{}, canonicalIndexPrefix...), byteutils.FromUint64(height)...)
+}
+
+// indexCanonicalChain index the hashes of the blocks of the canonical chain by height, from the new tail back
+// to the first block already indexed, so a fork only rewrites the heights of the blocks it replaces.
+// The entries above the new tail are left, the lookups are bounded by the tail.
+func (bc *BlockChain) indexCanonicalChain(newTail *Block) {
+	for block := newTail; block != nil; block = bc.GetBlock(block.ParentHash()) {
+		key := canonicalIndexKey(block.Height())
+		if hash, err := bc.storage.Get(key); err == nil && block.Hash().Equals(hash) {
+			return
+		}
+		if err := bc.storage.Put(key, block.Hash()); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"err":   err,
+			}).Error("Failed to index the block of the canonical chain.")
+			return
+		}
+		if CheckGenesisBlock(block) {
+			return
+		}
+	}
+}
+
+// GetBlockOnCanonicalChainByHeight return the block of the canonical chain at the height,
+// nil if it's above the tail or not kept, like the blocks before the one a fast sync started from.
+func (bc *BlockChain) GetBlockOnCanonicalChainByHeight(height uint64) *Block {
+	if height == 0 || height > bc.TailBlock().Height() {
+		return nil
+	}
+	hash, err := bc.storage.Get(canonicalIndexKey(height))
+	if err != nil {
+		if err != storage.ErrKeyNotFound {
+			logging.VLog().WithFields(logrus.Fields{
+				"height": height,
+				"err":    err,
+			}).Error("Failed to get the block of the canonical chain.")
+		}
+		return nil
+	}
+	return bc.GetBlock(hash)
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	chainHeadDroppedCounter = metrics.GetOrRegisterCounter("neb.block.head.dropped", nil)
+)
+
+// ChainHeadEvent is a block linked to the canonical chain, or reverted from it by a fork.
+type ChainHeadEvent struct {
+	Block    *Block
+	Reverted bool
+}
+
+// SubscribeChainHead register the channel receiving the blocks linked to and reverted from the canonical chain
+// when the tail changes, the reverted ones first from the old tail, then the linked ones up to the new tail.
+// An event is dropped for the channel if it's full.
+func (bc *BlockChain) SubscribeChainHead(ch chan *ChainHeadEvent) {
+	bc.headSubs.Store(ch, true)
+}
+
+// UnsubscribeChainHead deregister the channel.
+func (bc *BlockChain) UnsubscribeChainHead(ch chan *ChainHeadEvent) {
+	bc.headSubs.Delete(ch)
+}
+
+// notifyChainHead send the blocks reverted from the old tail and linked up to the new tail, from their common ancestor.
+func (bc *BlockChain) notifyChainHead(ancestor, oldTail, newTail *Block) {
+	subscribed := false
+	bc.headSubs.Range(func(k, v interface{}) bool {
+		subscribed = true
+		return false
+	})
+	if !subscribed {
+		return
+	}
+
+	var events []*ChainHeadEvent
+	for block := oldTail; block != nil && !block.Hash().Equals(ancestor.Hash()); block = bc.GetBlock(block.ParentHash()) {
+		events = append(events, &ChainHeadEvent{Block: block, Reverted: true})
+	}
+	var linked []*Block
+	for block := newTail; block != nil && !block.Hash().Equals(ancestor.Hash()); block = bc.GetBlock(block.ParentHash()) {
+		linked = append(linked, block)
+	}
+	for i := len(linked) - 1; i >= 0; i-- {
+		events = append(events, &ChainHeadEvent{Block: linked[i]})
+	}
+
+	bc.headSubs.Range(func(k, v interface{}) bool {
+		ch := k.(chan *ChainHeadEvent)
+		for _, e := range events {
+			select {
+			case ch <- e:
+			default:
+				chainHeadDroppedCounter.Inc(1)
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// saveContractABI keeps the hash of the ABI in the contract account on chain,
+// and the ABI in storage addressed by its hash.
+func (block *Block) saveContractABI(contract state.Account, abi *nvm.ABI) error {
+	if abi == nil {
+		return nil
+	}
+	data, err := abi.ToBytes()
+	if err != nil {
+		return err
+	}
+	abiHash := hash.Sha3256(data)
+	if err := block.storage.Put(abiHash, data); err != nil {
+		return err
+	}
+	contract.SetABIHash(abiHash)
+	return nil
+}
+
+// GetContractABI returns the ABI of the contract generated when it was deployed, and its hash.
+func (block *Block) GetContractABI(address byteutils.Hash) (byteutils.Hash, *nvm.ABI, error) {
+	contract, err := block.accState.GetContractAccount(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(contract.ABIHash()) == 0 {
+		return nil, nil, ErrContractABINotFound
+	}
+	data, err := block.storage.Get(contract.ABIHash())
+	if err != nil {
+		return nil, nil, err
+	}
+	abi, err := nvm.ParseABI(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return contract.ABIHash(), abi, nil
+}
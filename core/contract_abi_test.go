@@ -0,0 +1,53 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_ContractABI(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	block.begin()
+
+	addr := mockAddress()
+	contract, err := block.accState.CreateContractAccount(addr.Bytes(), []byte("birth"))
+	assert.Nil(t, err)
+	_, _, err = block.GetContractABI(addr.Bytes())
+	assert.Equal(t, ErrContractABINotFound, err)
+
+	abi := &nvm.ABI{Functions: []*nvm.ABIFunction{
+		{Name: "save", Args: []*nvm.ABIArg{{Name: "height", Type: "number"}}, Payable: true},
+	}}
+	assert.Nil(t, block.saveContractABI(contract, abi))
+	data, _ := abi.ToBytes()
+	assert.Equal(t, contract.ABIHash(), hash.Sha3256(data))
+
+	abiHash, got, err := block.GetContractABI(addr.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, contract.ABIHash(), abiHash)
+	assert.Equal(t, abi, got)
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// ContractAddress returns the address of the contract deployed by the creator contract with its nonce,
+// which only counts the contracts it deployed.
+func (block *Block) ContractAddress(creator byteutils.Hash, nonce uint64) (byteutils.Hash, error) {
+	addr, err := NewContractAddressFromHash(hash.Sha3256(creator, byteutils.FromUint64(nonce)))
+	if err != nil {
+		return nil, err
+	}
+	return addr.Bytes(), nil
+}
+
+// ContractCode returns the code at the hash for contracts to deploy, the hash of a transaction
+// deploying or upgrading a contract, or of the code of a contract deployed by a contract.
+func (block *Block) ContractCode(codeHash byteutils.Hash) (string, string, map[string]string, error) {
+	code, err := loadCodeAt(block, codeHash)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if code.Library {
+		return "", "", nil, ErrCallLibrary
+	}
+	return code.Source, code.SourceType, code.Libraries, nil
+}
+
+// SetContractCode keeps the code of the contract deployed by a contract in storage addressed by its hash,
+// which becomes the code place of the contract, and its ABI.
+func (block *Block) SetContractCode(contract state.Account, source, sourceType string, libraries map[string]string, abi *nvm.ABI) error {
+	code := &DeployPayload{SourceType: sourceType, Source: source, Libraries: libraries}
+	data, err := code.ToBytes()
+	if err != nil {
+		return err
+	}
+	codeHash := hash.Sha3256(data)
+	if err := block.storage.Put(codeHash, data); err != nil {
+		return err
+	}
+	contract.SetCodePlace(codeHash)
+	return block.saveContractABI(contract, abi)
+}
+
+// loadCodeAt returns the code at the hash of a deploy or upgrade transaction, or of the code of
+// a contract deployed by a contract.
+func loadCodeAt(block *Block, codeHash byteutils.Hash) (*DeployPayload, error) {
+	codeTx, err := block.GetTransaction(codeHash)
+	if err == storage.ErrKeyNotFound {
+		data, err := block.storage.Get(codeHash)
+		if err == storage.ErrKeyNotFound {
+			return nil, ErrInvalidContractCode
+		}
+		if err != nil {
+			return nil, err
+		}
+		return LoadDeployPayload(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch codeTx.data.Type {
+	case TxPayloadDeployType:
+		return LoadDeployPayload(codeTx.data.Payload)
+	case TxPayloadUpgradeType:
+		upgrade, err := LoadUpgradePayload(codeTx.data.Payload)
+		if err != nil {
+			return nil, err
+		}
+		return &DeployPayload{SourceType: upgrade.SourceType, Source: upgrade.Source, Libraries: upgrade.Libraries}, nil
+	}
+	return nil, ErrInvalidContractCode
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_ContractCode(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	block.begin()
+
+	creator := mockAddress()
+	addr, err := block.ContractAddress(creator.Bytes(), 1)
+	assert.Nil(t, err)
+	want, _ := NewContractAddressFromHash(hash.Sha3256(creator.Bytes(), byteutils.FromUint64(1)))
+	assert.Equal(t, want.Bytes(), []byte(addr))
+	other, _ := block.ContractAddress(creator.Bytes(), 2)
+	assert.NotEqual(t, addr, other)
+
+	_, _, _, err = block.ContractCode(hash.Sha3256([]byte("unknown")))
+	assert.Equal(t, ErrInvalidContractCode, err)
+
+	contract, err := block.accState.CreateContractAccount(addr, []byte("birth"))
+	assert.Nil(t, err)
+	source := "module.exports = function () {};"
+	abi := &nvm.ABI{Functions: []*nvm.ABIFunction{{Name: "save"}}}
+	assert.Nil(t, block.SetContractCode(contract, source, "js", nil, abi))
+	assert.NotEmpty(t, contract.ABIHash())
+
+	gotSource, gotType, libraries, err := block.ContractCode(contract.CodePlace())
+	assert.Nil(t, err)
+	assert.Equal(t, source, gotSource)
+	assert.Equal(t, "js", gotType)
+	assert.Nil(t, libraries)
+
+	code, err := loadContractCode(block, contract)
+	assert.Nil(t, err)
+	assert.Equal(t, source, code.Source)
+}
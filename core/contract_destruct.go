@@ -0,0 +1,86 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxGasRefundQuotient limits the refund of a transaction to its gas divided by it,
+// so freeing storage can't make the execution free.
+const MaxGasRefundQuotient = 2
+
+// destroyContract drops the storage of the self-destructed contract and adds the refund
+// of the freed storage, its balance has been sent to the beneficiary during the execution.
+func destroyContract(ctx *PayloadContext, destruct *nvm.ContractDestruct) error {
+	addr, err := byteutils.FromHex(destruct.Address)
+	if err != nil {
+		return err
+	}
+	contract, err := ctx.accState.GetContractAccount(addr)
+	if err != nil {
+		return err
+	}
+	size := contract.StorageSize()
+	refund := nvm.GasTableAt(ctx.block.height).DestructRefund(size)
+	if err := ctx.accState.DestroyContract(addr); err != nil {
+		return err
+	}
+	ctx.refund.Add(ctx.refund.Int, refund.Int)
+
+	data, err := json.Marshal(map[string]interface{}{
+		"contract":    destruct.Address,
+		"beneficiary": destruct.Beneficiary,
+		"value":       destruct.Value,
+		"storageSize": size,
+		"refund":      refund.String(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := ctx.block.RecordEvent(ctx.tx.Hash(), TopicContractDestroyed, string(data)); err != nil {
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"tx":          ctx.tx,
+		"contract":    destruct.Address,
+		"beneficiary": destruct.Beneficiary,
+		"value":       destruct.Value,
+		"storageSize": size,
+	}).Info("Destroyed the self-destructed contract.")
+	return nil
+}
+
+// refundGas returns the gas minus the refund of the execution, the refund is at most
+// the gas divided by MaxGasRefundQuotient.
+func (ctx *PayloadContext) refundGas(gas *util.Uint128) *util.Uint128 {
+	refund := new(big.Int).Div(gas.Int, big.NewInt(MaxGasRefundQuotient))
+	if ctx.refund.Cmp(refund) < 0 {
+		refund.Set(ctx.refund.Int)
+	}
+	return util.NewUint128FromBigInt(new(big.Int).Sub(gas.Int, refund))
+}
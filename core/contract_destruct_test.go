@@ -0,0 +1,87 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDestroyContract(t *testing.T) {
+	defer nvm.SetGasTables(nil)
+	assert.Nil(t, nvm.SetGasTables([]*corepb.GasTableFork{{Version: 1, Height: 1, DestructRefundByte: 2}}))
+
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	deployTx := mockDeployTransaction(bc.chainID, 0)
+	assert.Nil(t, block.acceptTransaction(deployTx))
+	payload, _ := deployTx.LoadPayload()
+	ctx := NewPayloadContext(block, deployTx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, err := payload.Execute(ctx)
+	assert.Nil(t, err)
+	ctx.Commit()
+	addr, _ := deployTx.GenerateContractAddress()
+	contract, _ := block.accState.GetContractAccount(addr.Bytes())
+	size := contract.StorageSize()
+	assert.True(t, size > 0)
+
+	callTx := mockCallTransaction(bc.chainID, 1, "totalSupply", "")
+	callTx.to = addr
+	ctx = NewPayloadContext(block, callTx)
+	assert.Nil(t, ctx.BeginBatch())
+	destruct := &nvm.ContractDestruct{Address: addr.String(), Beneficiary: callTx.from.String(), Value: "0"}
+	assert.Nil(t, destroyContract(ctx, destruct))
+	ctx.Commit()
+	block.commit()
+
+	contract, _ = block.accState.GetContractAccount(addr.Bytes())
+	assert.True(t, contract.Destroyed())
+	assert.Equal(t, uint64(0), contract.StorageSize())
+	assert.Equal(t, util.NewUint128FromInt(int64(size*2)), ctx.refund)
+	events, _ := block.FetchEvents(callTx.Hash())
+	assert.Equal(t, TopicContractDestroyed, events[len(events)-1].Topic)
+
+	// the destroyed contract can't be called or upgraded.
+	block.begin()
+	ctx = NewPayloadContext(block, callTx)
+	assert.Nil(t, ctx.BeginBatch())
+	callPayload, _ := callTx.LoadPayload()
+	_, err = callPayload.Execute(ctx)
+	assert.Equal(t, ErrContractDestroyed, err)
+	_, _, _, err = block.ContractSource(contract)
+	assert.Equal(t, ErrContractDestroyed, err)
+	block.rollback()
+}
+
+func TestPayloadContext_refundGas(t *testing.T) {
+	ctx := NewPayloadContext(nil, nil)
+	assert.Equal(t, util.NewUint128FromInt(100), ctx.refundGas(util.NewUint128FromInt(100)))
+
+	ctx.refund = util.NewUint128FromInt(30)
+	assert.Equal(t, util.NewUint128FromInt(70), ctx.refundGas(util.NewUint128FromInt(100)))
+	// at most half of the gas is refunded.
+	assert.Equal(t, util.NewUint128FromInt(25), ctx.refundGas(util.NewUint128FromInt(50)))
+}
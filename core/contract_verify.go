@@ -0,0 +1,160 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var contractVerificationPrefix = []byte("contract_verification_")
+
+// ContractVerification is the source submitted for a contract and confirmed to match its code on chain.
+type ContractVerification struct {
+	// CodeHash is the code place of the contract when verified, an upgrade invalidates the verification.
+	CodeHash   string            `json:"code_hash"`
+	SourceType string            `json:"source_type"`
+	Source     string            `json:"source"`
+	Args       string            `json:"args"`
+	Libraries  map[string]string `json:"libraries,omitempty"`
+	// Height is the height of the tail block the source was verified against.
+	Height uint64 `json:"height"`
+}
+
+func contractVerificationKey(address byteutils.Hash) []byte {
+	return append(append([]byte{}, contractVerificationPrefix...), address...)
+}
+
+// normalizeContractSource drops the differences of editors, the byte order mark, line endings
+// and trailing whitespace, so that the source checked out from a repository matches the deployed one.
+func normalizeContractSource(source, sourceType string) string {
+	if sourceType == "wasm" {
+		return strings.TrimSpace(source)
+	}
+	source = strings.TrimPrefix(source, "\ufeff")
+	source = strings.Replace(source, "\r\n", "\n", -1)
+	source = strings.Replace(source, "\r", "\n", -1)
+	lines := strings.Split(source, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// normalizeContractArgs re-encodes the JSON args so that the spacing and the order of keys don't matter.
+func normalizeContractArgs(args string) string {
+	args = strings.TrimSpace(args)
+	var v interface{}
+	if err := json.Unmarshal([]byte(args), &v); err != nil {
+		return args
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return args
+	}
+	return string(data)
+}
+
+// VerifyContractSource confirms the source matches the current code of the contract on the tail block,
+// and the args match its deploy transaction, then keeps the verification for explorers to query.
+// The args are only checked for the contracts deployed by transactions, those deployed by contracts
+// don't have their args on chain.
+func (bc *BlockChain) VerifyContractSource(address byteutils.Hash, source, sourceType, args string) (*ContractVerification, error) {
+	tail := bc.TailBlock()
+	contract, err := tail.accState.GetContractAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if contract.Destroyed() {
+		return nil, ErrContractDestroyed
+	}
+	code, err := loadContractCode(tail, contract)
+	if err != nil {
+		return nil, err
+	}
+	if code.SourceType != sourceType ||
+		normalizeContractSource(code.Source, code.SourceType) != normalizeContractSource(source, sourceType) {
+		return nil, ErrContractSourceMismatch
+	}
+	birthTx, err := tail.GetTransaction(contract.BirthPlace())
+	if err != nil {
+		return nil, err
+	}
+	if birthTx.data.Type == TxPayloadDeployType {
+		deploy, err := LoadDeployPayload(birthTx.data.Payload)
+		if err != nil {
+			return nil, err
+		}
+		if normalizeContractArgs(deploy.Args) != normalizeContractArgs(args) {
+			return nil, ErrContractArgsMismatch
+		}
+	}
+
+	verification := &ContractVerification{
+		CodeHash:   contract.CodePlace().String(),
+		SourceType: sourceType,
+		Source:     source,
+		Args:       args,
+		Libraries:  code.Libraries,
+		Height:     tail.Height(),
+	}
+	data, err := json.Marshal(verification)
+	if err != nil {
+		return nil, err
+	}
+	if err := bc.storage.Put(contractVerificationKey(address), data); err != nil {
+		return nil, err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"contract": address.Hex(),
+		"code":     verification.CodeHash,
+		"height":   verification.Height,
+	}).Info("Verified the contract source.")
+	return verification, nil
+}
+
+// GetContractVerification returns the verified source of the contract, nil if it was never verified
+// or its code has been upgraded since.
+func (bc *BlockChain) GetContractVerification(address byteutils.Hash) (*ContractVerification, error) {
+	contract, err := bc.TailBlock().accState.GetContractAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	data, err := bc.storage.Get(contractVerificationKey(address))
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	verification := new(ContractVerification)
+	if err := json.Unmarshal(data, verification); err != nil {
+		return nil, err
+	}
+	if verification.CodeHash != contract.CodePlace().String() || contract.Destroyed() {
+		return nil, nil
+	}
+	return verification, nil
+}
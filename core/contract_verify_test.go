@@ -0,0 +1,73 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeContractSource(t *testing.T) {
+	assert.Equal(t, "a\nb", normalizeContractSource("\ufeffa  \r\nb\t\r\n\n", "js"))
+	assert.Equal(t, normalizeContractSource("a\nb", "ts"), normalizeContractSource("a\rb\n", "ts"))
+	assert.Equal(t, "0061736d", normalizeContractSource(" 0061736d\n", "wasm"))
+	assert.Equal(t, `{"a":1,"b":[2]}`, normalizeContractArgs(` {"b": [2], "a": 1} `))
+	assert.Equal(t, "not json", normalizeContractArgs("not json"))
+}
+
+func TestBlockChain_VerifyContractSource(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	source := "var Contract = function () {};\nContract.prototype = {\n    init: function (name) {}\n};\nmodule.exports = Contract;\n"
+	payload := &DeployPayload{SourceType: "js", Source: source, Args: `["nas"]`}
+	data, _ := payload.ToBytes()
+	tx := mockTransaction(bc.chainID, 0, TxPayloadDeployType, data)
+	ctx := NewPayloadContext(block, tx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, err := payload.Execute(ctx)
+	assert.Nil(t, err)
+	ctx.Commit()
+	assert.Nil(t, block.acceptTransaction(tx))
+	block.commit()
+	bc.tailBlock = block
+	contract, _ := tx.GenerateContractAddress()
+
+	verification, err := bc.GetContractVerification(contract.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, verification)
+
+	_, err = bc.VerifyContractSource(contract.Bytes(), source+"// changed\n", "js", `["nas"]`)
+	assert.Equal(t, ErrContractSourceMismatch, err)
+	_, err = bc.VerifyContractSource(contract.Bytes(), source, "ts", `["nas"]`)
+	assert.Equal(t, ErrContractSourceMismatch, err)
+	_, err = bc.VerifyContractSource(contract.Bytes(), source, "js", `["neb"]`)
+	assert.Equal(t, ErrContractArgsMismatch, err)
+
+	crlf := "var Contract = function () {};\r\nContract.prototype = {\r\n    init: function (name) {}\r\n};\r\nmodule.exports = Contract;"
+	verified, err := bc.VerifyContractSource(contract.Bytes(), crlf, "js", `[ "nas" ]`)
+	assert.Nil(t, err)
+	assert.Equal(t, tx.Hash().String(), verified.CodeHash)
+
+	verification, err = bc.GetContractVerification(contract.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, verified, verification)
+}
@@ -35,6 +35,7 @@ import (
 
 // Consensus Related Constants
 const (
+	// BlockInterval is the default seconds between blocks, see BlockIntervalAt.
 	BlockInterval        = int64(5)
 	AcceptedNetWorkDelay = int64(2)
 	DynastyInterval      = int64(60) // TODO(roy): 3600
@@ -44,8 +45,8 @@ const (
 
 // DposContext carry context in dpos consensus
 type DposContext struct {
-	dynastyTrie     *trie.BatchTrie // key: delegatee, val: delegatee
-	nextDynastyTrie *trie.BatchTrie // key: delegatee, val: delegatee
+	dynastyTrie     *trie.BatchTrie // key: delegatee, val: delegatee + signer if registered
+	nextDynastyTrie *trie.BatchTrie // key: delegatee, val: delegatee + signer if registered
 	delegateTrie    *trie.BatchTrie // key: delegatee + delegator, val: delegator
 	voteTrie        *trie.BatchTrie // key: delegator, val: delegatee
 	candidateTrie   *trie.BatchTrie // key: delegatee, val: delegatee
@@ -214,9 +215,11 @@ type DynastyContext struct {
 	MintCntTrie     *trie.BatchTrie
 	Accounts        state.AccountState
 	Storage         storage.Storage
+	BlockIntervals  *BlockIntervals
 }
 
-func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
+// TallyVotes returns the votes of all candidates, weighted by the balance of their delegators.
+func (dc *DynastyContext) TallyVotes() (map[string]*util.Uint128, error) {
 	votes := make(map[string]*util.Uint128)
 	delegate := dc.DelegateTrie
 	candidates := dc.CandidateTrie
@@ -233,7 +236,15 @@ func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
 		return nil, err
 	}
 	for existCandidates {
-		delegatee, err := AddressParseFromBytes(iterCandidates.Value())
+		value, _, jailed := parseCandidate(iterCandidates.Value())
+		if jailed {
+			existCandidates, err = iterCandidates.Next()
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		delegatee, err := AddressParseFromBytes(value)
 		if err != nil {
 			return nil, err
 		}
@@ -254,7 +265,8 @@ func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
 			return nil, err
 		}
 		for existDelegate {
-			delegator, err := AddressParseFromBytes(iterDelegate.Value())
+			value, share, total := ParseDelegation(iterDelegate.Value())
+			delegator, err := AddressParseFromBytes(value)
 			if err != nil {
 				return nil, err
 			}
@@ -262,7 +274,7 @@ func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
 			if !ok {
 				score = util.NewUint128()
 			}
-			weight := accounts.GetOrCreateUserAccount(delegator.Bytes()).Balance()
+			weight := delegationVotes(accounts.GetOrCreateUserAccount(delegator.Bytes()).Balance(), share, total)
 			score.Add(score.Int, weight.Int)
 			votes[delegatee.String()] = score
 			existDelegate, err = iterDelegate.Next()
@@ -318,11 +330,11 @@ func fetchActiveBootstapValidators(stor storage.Storage, candidates *trie.BatchT
 	}
 	for exist {
 		var validator byteutils.Hash = iter.Value()
-		_, err = candidates.Get(validator)
-		if err != nil && err != storage.ErrKeyNotFound {
+		electable, err := isElectable(candidates, validator)
+		if err != nil {
 			return nil, err
 		}
-		if err != storage.ErrKeyNotFound {
+		if electable {
 			activeBootstapValidators = append(activeBootstapValidators, validator)
 		}
 		exist, err = iter.Next()
@@ -345,14 +357,7 @@ func checkActiveBootstrapValidator(validator byteutils.Hash, stor storage.Storag
 	if err != nil {
 		return false, nil
 	}
-	_, err = candidates.Get(validator)
-	if err != nil && err != storage.ErrKeyNotFound {
-		return false, err
-	}
-	if err != nil {
-		return false, nil
-	}
-	return true, nil
+	return isElectable(candidates, validator)
 }
 
 func (dc *DynastyContext) chooseCandidates(votes map[string]*util.Uint128) (Candidates, error) {
@@ -410,8 +415,8 @@ func kickout(stor storage.Storage, candidatesTrie *trie.BatchTrie, delegateTrie
 		return err
 	}
 	for exist {
-		delegator := iter.Value()
-		key := append(candidate, delegator...)
+		delegator, _, _ := ParseDelegation(iter.Value())
+		key := append(append(byteutils.Hash{}, candidate...), delegator...)
 		if _, err := delegateTrie.Del(key); err != nil && err != storage.ErrKeyNotFound {
 			return err
 		}
@@ -425,9 +430,25 @@ func kickout(stor storage.Storage, candidatesTrie *trie.BatchTrie, delegateTrie
 				"candidate": candidate.Hex(),
 			}).Error("Unexpected voter who votes nobody appears in delegate trie")
 		}
-		if err == nil && byteutils.Equal(bytes, candidate) {
-			if _, err := voteTrie.Del(delegator); err != nil && err != storage.ErrKeyNotFound {
-				return err
+		if err == nil {
+			// the voter keeps its delegations to other candidates.
+			remains := []byteutils.Hash{}
+			for _, v := range splitDelegatees(bytes) {
+				if !v.Equals(candidate) {
+					remains = append(remains, v)
+				}
+			}
+			if len(remains) == 0 {
+				if _, err := voteTrie.Del(delegator); err != nil && err != storage.ErrKeyNotFound {
+					return err
+				}
+			} else {
+				if _, err := voteTrie.Put(delegator, joinDelegatees(remains)); err != nil {
+					return err
+				}
+				if err := normalizeDelegations(delegateTrie, delegator, remains); err != nil {
+					return err
+				}
 			}
 		}
 		exist, err = iter.Next()
@@ -447,7 +468,8 @@ func (dc *DynastyContext) kickoutCandidate(candidate byteutils.Hash) error {
 	return kickout(dc.Storage, dc.CandidateTrie, dc.DelegateTrie, dc.VoteTrie, candidate)
 }
 
-func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
+// jailDynasty jails the validators minting too few blocks in the dynasty.
+func (dc *DynastyContext) jailDynasty(dynastyID int64) error {
 	dynastyTrie := dc.DynastyTrie
 	iter, err := dynastyTrie.Iterator(nil)
 	if err != nil && err != storage.ErrKeyNotFound {
@@ -461,7 +483,7 @@ func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
 		return err
 	}
 	for exist {
-		validator := iter.Value()
+		validator, _ := parseDynastyValue(iter.Value())
 		key := append(byteutils.FromInt64(dynastyID), validator...)
 		bytes, err := dc.MintCntTrie.Get(key)
 		if err != nil && err != storage.ErrKeyNotFound {
@@ -469,7 +491,7 @@ func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
 		}
 		if err != storage.ErrKeyNotFound {
 			cnt := byteutils.Int64(bytes)
-			if cnt >= DynastyInterval/BlockInterval/DynastySize/2 {
+			if cnt >= DynastyInterval/dc.BlockIntervals.At(dynastyID*DynastyInterval)/DynastySize/2 {
 				exist, err = iter.Next()
 				if err != nil {
 					return err
@@ -488,7 +510,7 @@ func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
 			}
 			logging.VLog().Info("Protect active bootstrap candidate: ", addr)
 		} else {
-			if err := dc.kickoutCandidate(validator); err != nil {
+			if err := dc.jailCandidate(validator, dynastyID+JailDynasties); err != nil {
 				return err
 			}
 		}
@@ -498,7 +520,7 @@ func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
 		}
 	}
 
-	logging.VLog().Info("Kickouted dynasty: ", dynastyID)
+	logging.VLog().Info("Jailed dynasty: ", dynastyID)
 	return nil
 }
 
@@ -515,12 +537,12 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 	for i := baseDynastyID; i < nextDynastyID; i++ {
 		// collect candidates
 		if !baseGenesis {
-			err := dc.kickoutDynasty(i)
+			err := dc.jailDynasty(i)
 			if err != nil {
 				return err
 			}
 		}
-		votes, err := dc.tallyVotes()
+		votes, err := dc.TallyVotes()
 		if err != nil {
 			return err
 		}
@@ -537,8 +559,7 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 		directSelected := DynastySize - 1
 		for i := 0; i < directSelected && i < len(candidates); i++ {
 			delegatee := candidates[i].Address.Bytes()
-			_, err := nextDynastyTrie.Put(delegatee, delegatee)
-			if err != nil {
+			if err := dc.putDelegatee(nextDynastyTrie, delegatee); err != nil {
 				return err
 			}
 			newDynasty = append(newDynasty, candidates[i].Address.String())
@@ -551,8 +572,7 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 			result := int(hasher.Sum32()) % (len(candidates) - directSelected)
 			offset := result + DynastySize - 1
 			delegatee := candidates[offset].Address.Bytes()
-			_, err = nextDynastyTrie.Put(delegatee, delegatee)
-			if err != nil {
+			if err := dc.putDelegatee(nextDynastyTrie, delegatee); err != nil {
 				return err
 			}
 			newDynasty = append(newDynasty, candidates[offset].Address.String())
@@ -568,6 +588,23 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 	return nil
 }
 
+// putDelegatee puts the delegatee into the dynasty with the signing key it registered.
+func (dc *DynastyContext) putDelegatee(dynasty *trie.BatchTrie, delegatee byteutils.Hash) error {
+	var signer byteutils.Hash
+	// the account is only looked up, a delegatee without account is not created in the state.
+	acc, err := dc.Accounts.GetContractAccount(delegatee)
+	if err != nil && err != state.ErrAccountNotFound {
+		return err
+	}
+	if err == nil {
+		if signer, err = registeredSigner(acc); err != nil {
+			return err
+		}
+	}
+	_, err = dynasty.Put(delegatee, dynastyValue(delegatee, signer))
+	return err
+}
+
 // LoadDynastyContext from a given context
 func (block *Block) LoadDynastyContext(context *DynastyContext) error {
 	block.header.timestamp = context.TimeStamp
@@ -670,27 +707,9 @@ func GenesisDynastyContext(storage storage.Storage, conf *corepb.Genesis) (*Dyna
 	}, nil
 }
 
-// FindProposer for now in given dynasty
-func FindProposer(now int64, dynasty *trie.BatchTrie) (proposer byteutils.Hash, err error) {
-	offset := now % DynastyInterval
-	if offset%BlockInterval != 0 {
-		return nil, ErrNotBlockForgTime
-	}
-	offset /= BlockInterval
-	offset %= DynastySize
-	delegatees, err := TraverseDynasty(dynasty)
-	if err != nil {
-		return nil, err
-	}
-	if int(offset) < len(delegatees) {
-		proposer = delegatees[offset]
-	}
-	return proposer, nil
-}
-
 // NextDynastyContext when some seconds elapsed
 func (block *Block) NextDynastyContext(elapsedSecond int64) (*DynastyContext, error) {
-	if elapsedSecond%BlockInterval != 0 {
+	if timestamp := block.header.timestamp + elapsedSecond; timestamp%block.BlockIntervalAt(timestamp) != 0 {
 		return nil, ErrNotBlockForgTime
 	}
 
@@ -729,26 +748,31 @@ func (block *Block) NextDynastyContext(elapsedSecond int64) (*DynastyContext, er
 		MintCntTrie:     mintCntTrie,
 		Accounts:        block.accState,
 		Storage:         block.storage,
+		BlockIntervals:  block.blockIntervals,
 	}
 
 	baseDynastyID := block.header.timestamp / DynastyInterval
 	newDynastyID := context.TimeStamp / DynastyInterval
 	if baseDynastyID < newDynastyID {
 		if baseDynastyID+1 < newDynastyID {
-			// do not kickout genesis dynasty
+			// do not jail genesis dynasty
 			err = context.electNextDynastyOnBaseDynasty(baseDynastyID, newDynastyID-1, baseDynastyID == 0)
 			if err != nil {
 				return nil, err
 			}
 		}
-		// do not kickout genesis's next dynasty
+		// do not jail genesis's next dynasty
 		err = context.electNextDynastyOnBaseDynasty(newDynastyID-1, newDynastyID, baseDynastyID == 0)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	context.Proposer, err = FindProposer(context.TimeStamp, context.DynastyTrie)
+	seed, err := RoundSeed(block, context.TimeStamp)
+	if err != nil {
+		return nil, err
+	}
+	context.Proposer, err = FindProposer(context.TimeStamp, block.BlockIntervalAt(context.TimeStamp), context.DynastyTrie, seed)
 	if err != nil {
 		return nil, err
 	}
@@ -767,7 +791,8 @@ func TraverseDynasty(dynasty *trie.BatchTrie) ([]byteutils.Hash, error) {
 	}
 	exist, err := iter.Next()
 	for exist {
-		members = append(members, iter.Value())
+		member, _ := parseDynastyValue(iter.Value())
+		members = append(members, member)
 		exist, err = iter.Next()
 	}
 	return members, nil
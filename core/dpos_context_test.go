@@ -48,7 +48,8 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	context, err := block.NextDynastyContext(BlockInterval)
 	assert.Nil(t, err)
 	validators, _ := TraverseDynasty(block.dposContext.dynastyTrie)
-	assert.Equal(t, context.Proposer, validators[1])
+	proposers := ProposerOrder(validators, block.Seed())
+	assert.Equal(t, context.Proposer, proposers[1])
 	// check dynasty
 	checkDynasty(t, context.DynastyTrie)
 	checkDynasty(t, context.NextDynastyTrie)
@@ -56,7 +57,8 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	context, err = block.NextDynastyContext(BlockInterval + DynastyInterval)
 	assert.Nil(t, err)
 	validators, _ = TraverseDynasty(block.dposContext.dynastyTrie)
-	assert.Equal(t, context.Proposer, validators[1])
+	proposers = ProposerOrder(validators, block.Seed())
+	assert.Equal(t, context.Proposer, proposers[1])
 	// check dynasty
 	checkDynasty(t, context.DynastyTrie)
 	checkDynasty(t, context.NextDynastyTrie)
@@ -64,7 +66,8 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	context, err = block.NextDynastyContext(DynastyInterval / 2)
 	assert.Nil(t, err)
 	validators, _ = TraverseDynasty(block.dposContext.dynastyTrie)
-	assert.Equal(t, context.Proposer, validators[int(DynastyInterval/2/BlockInterval)%DynastySize])
+	proposers = ProposerOrder(validators, block.Seed())
+	assert.Equal(t, context.Proposer, proposers[int(DynastyInterval/2/BlockInterval)%DynastySize])
 	// check dynasty
 	checkDynasty(t, context.DynastyTrie)
 	checkDynasty(t, context.NextDynastyTrie)
@@ -72,8 +75,9 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	context, err = block.NextDynastyContext(DynastyInterval*2 + DynastyInterval/3)
 	assert.Nil(t, err)
 	validators, _ = TraverseDynasty(block.dposContext.dynastyTrie)
+	proposers = ProposerOrder(validators, block.Seed())
 	index := int((DynastyInterval*2+DynastyInterval/3)%DynastyInterval) / int(BlockInterval) % DynastySize
-	assert.Equal(t, context.Proposer, validators[index])
+	assert.Equal(t, context.Proposer, proposers[index])
 	// check dynasty
 	checkDynasty(t, context.DynastyTrie)
 	checkDynasty(t, context.NextDynastyTrie)
@@ -174,13 +178,13 @@ func TestTallyVotes(t *testing.T) {
 	// empty candidates
 	candidates := dc.CandidateTrie
 	dc.CandidateTrie, err = trie.NewBatchTrie(nil, stor)
-	votes, err := dc.tallyVotes()
+	votes, err := dc.TallyVotes()
 	assert.Nil(t, err)
 	assert.Equal(t, votes, make(map[string]*util.Uint128))
 	dc.CandidateTrie = candidates
 	dc.VoteTrie.Del(candidate.Bytes())
 	dc.DelegateTrie.Del(append(candidate.Bytes(), candidate.Bytes()...))
-	votes, err = dc.tallyVotes()
+	votes, err = dc.TallyVotes()
 	assert.Nil(t, err)
 	assert.Equal(t, votes[tester], util.NewUint128())
 }
@@ -190,7 +194,7 @@ func TestChooseCandidates(t *testing.T) {
 	chain, err := NewBlockChain(neb)
 	dc, err := chain.TailBlock().NextDynastyContext(0)
 	assert.Nil(t, err)
-	votes, err := dc.tallyVotes()
+	votes, err := dc.TallyVotes()
 	assert.Nil(t, err)
 	tester := "2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8"
 	candidate, err := AddressParse(tester)
@@ -209,7 +213,7 @@ func TestChooseCandidates(t *testing.T) {
 	assert.Equal(t, votes2[lenVotes2-1].Address.String(), tester)
 }
 
-func TestKickoutDynastyActuallyKickoutCandidates(t *testing.T) {
+func TestJailDynastyActuallyJailCandidates(t *testing.T) {
 	neb := testNeb()
 	chain, err := NewBlockChain(neb)
 	dc, err := chain.TailBlock().NextDynastyContext(0)
@@ -223,10 +227,17 @@ func TestKickoutDynastyActuallyKickoutCandidates(t *testing.T) {
 	genesis.header.dposContext, err = genesis.dposContext.ToProto()
 	assert.Nil(t, err)
 	chain.storeBlockToStorage(genesis)
-	assert.Nil(t, dc.kickoutDynasty(0))
+	assert.Nil(t, dc.jailDynasty(0))
 	candidates, err := TraverseDynasty(dc.CandidateTrie)
 	assert.Nil(t, err)
-	assert.Equal(t, len(candidates), len(neb.Genesis().Consensus.Dpos.Dynasty)-1)
+	assert.Equal(t, len(candidates), len(neb.Genesis().Consensus.Dpos.Dynasty))
+	electable, err := isElectable(dc.CandidateTrie, candidate.Bytes())
+	assert.Nil(t, err)
+	assert.False(t, electable)
+	votes, err := dc.TallyVotes()
+	assert.Nil(t, err)
+	_, ok := votes[tester]
+	assert.False(t, ok)
 }
 
 func TestCheckActiveBootstrapValidators(t *testing.T) {
@@ -0,0 +1,139 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// DynastyMember is a delegate in the dynasty with its votes.
+type DynastyMember struct {
+	Delegatee string `json:"delegatee"`
+	Votes     string `json:"votes"`
+}
+
+// DynastyEvent is the data of the event published when a new dynasty takes over.
+type DynastyEvent struct {
+	DynastyID         int64            `json:"dynasty_id"`
+	PreviousDynastyID int64            `json:"previous_dynasty_id"`
+	Height            uint64           `json:"height"`
+	Timestamp         int64            `json:"timestamp"`
+	Members           []*DynastyMember `json:"members"`
+	Incoming          []string         `json:"incoming"`
+	Outgoing          []string         `json:"outgoing"`
+	TotalVotes        string           `json:"total_votes"`
+}
+
+// NewDynastyEvent returns the change of dynasty from parent to block,
+// or nil if they are in the same dynasty.
+func NewDynastyEvent(parent *Block, block *Block) (*DynastyEvent, error) {
+	previousID := parent.header.timestamp / DynastyInterval
+	dynastyID := block.header.timestamp / DynastyInterval
+	if previousID >= dynastyID {
+		return nil, nil
+	}
+
+	previous, err := TraverseDynasty(parent.dposContext.dynastyTrie)
+	if err != nil {
+		return nil, err
+	}
+	current, err := TraverseDynasty(block.dposContext.dynastyTrie)
+	if err != nil {
+		return nil, err
+	}
+	context := &DynastyContext{
+		DelegateTrie:  block.dposContext.delegateTrie,
+		CandidateTrie: block.dposContext.candidateTrie,
+		Accounts:      block.accState,
+		Storage:       block.storage,
+	}
+	votes, err := context.TallyVotes()
+	if err != nil {
+		return nil, err
+	}
+
+	event := &DynastyEvent{
+		DynastyID:         dynastyID,
+		PreviousDynastyID: previousID,
+		Height:            block.height,
+		Timestamp:         block.header.timestamp,
+		Members:           []*DynastyMember{},
+		Incoming:          diffDynasty(current, previous),
+		Outgoing:          diffDynasty(previous, current),
+	}
+	total := util.NewUint128()
+	for _, v := range current {
+		addr, err := AddressParseFromBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		vote, ok := votes[addr.String()]
+		if !ok {
+			vote = util.NewUint128()
+		}
+		total.Add(total.Int, vote.Int)
+		event.Members = append(event.Members, &DynastyMember{Delegatee: addr.String(), Votes: vote.String()})
+	}
+	event.TotalVotes = total.String()
+	return event, nil
+}
+
+// diffDynasty returns the members of a not in b, in order of address.
+func diffDynasty(a []byteutils.Hash, b []byteutils.Hash) []string {
+	in := make(map[string]bool)
+	for _, v := range b {
+		in[v.Hex()] = true
+	}
+	diff := []string{}
+	for _, v := range a {
+		if !in[v.Hex()] {
+			diff = append(diff, v.Hex())
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+func (block *Block) triggerDynastyEvent(parent *Block) {
+	event, err := NewDynastyEvent(parent, block)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to collect dynasty change.")
+		return
+	}
+	if event == nil {
+		return
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	block.eventEmitter.Trigger(&Event{
+		Topic: TopicDynastyChange,
+		Data:  string(data),
+	})
+}
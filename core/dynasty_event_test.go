@@ -0,0 +1,55 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDynastyEvent(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+
+	block.SetTimestamp(BlockInterval)
+	event, err := NewDynastyEvent(bc.GenesisBlock(), block)
+	assert.Nil(t, err)
+	assert.Nil(t, event)
+
+	block.SetTimestamp(DynastyInterval)
+	event, err = NewDynastyEvent(bc.GenesisBlock(), block)
+	assert.Nil(t, err)
+	assert.Equal(t, event.DynastyID, int64(1))
+	assert.Equal(t, event.PreviousDynastyID, int64(0))
+	assert.Equal(t, len(event.Members), DynastySize)
+	assert.Equal(t, len(event.Incoming), 0)
+	assert.Equal(t, len(event.Outgoing), 0)
+
+	dynasty := block.dposContext.dynastyTrie
+	members, err := TraverseDynasty(dynasty)
+	assert.Nil(t, err)
+	_, err = dynasty.Del(members[0])
+	assert.Nil(t, err)
+	event, err = NewDynastyEvent(bc.GenesisBlock(), block)
+	assert.Nil(t, err)
+	assert.Equal(t, event.Outgoing, []string{members[0].Hex()})
+}
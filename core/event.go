@@ -36,6 +36,39 @@ const (
 	// TopicCallSmartContract the topic of call a smart contract.
 	TopicCallSmartContract = "chain.callSmartContract"
 
+	// TopicUpgradeSmartContract the topic of upgrade a smart contract.
+	TopicUpgradeSmartContract = "chain.upgradeSmartContract"
+
+	// TopicOracleRequest the topic of an oracle request sent by a contract.
+	TopicOracleRequest = "chain.oracleRequest"
+
+	// TopicOracleResponse the topic of an oracle operator answering a request.
+	TopicOracleResponse = "chain.oracleResponse"
+
+	// TopicTransferFromContract the topic of a transfer sent by a contract.
+	TopicTransferFromContract = "chain.transferFromContract"
+
+	// TopicContractLog the topic of a log emitted by a contract with indexed topics.
+	TopicContractLog = "chain.contractLog"
+
+	// TopicContractStateDiff the topic of a key of contract storage written by a transaction.
+	TopicContractStateDiff = "chain.contractStateDiff"
+
+	// TopicContractConsole the topic of the console output of a contract, only sent to subscribers in dev mode.
+	TopicContractConsole = "chain.contractConsole"
+
+	// TopicContractHibernated the topic of a contract hibernated for unpaid storage rent.
+	TopicContractHibernated = "chain.contractHibernated"
+
+	// TopicContractRevived the topic of a hibernated contract revived.
+	TopicContractRevived = "chain.contractRevived"
+
+	// TopicContractDestroyed the topic of a contract self-destructed.
+	TopicContractDestroyed = "chain.contractDestroyed"
+
+	// TopicContractCreated the topic of a contract deployed by another contract.
+	TopicContractCreated = "chain.contractCreated"
+
 	// TopicDelegate the topic of delegate.
 	TopicDelegate = "chain.delegate"
 
@@ -45,6 +78,18 @@ const (
 	// TopicLinkBlock the topic of link a block.
 	TopicLinkBlock = "chain.linkBlock"
 
+	// TopicRevertBlock the topic of a block reverted from the canonical chain by a fork.
+	TopicRevertBlock = "chain.revertBlock"
+
+	// TopicSlash the topic of slash a proposer minting two blocks in one slot.
+	TopicSlash = "chain.slash"
+
+	// TopicDynastyChange the topic of a new dynasty taking over.
+	TopicDynastyChange = "chain.dynastyChange"
+
+	// TopicMinerFailover the topic of switching to the backup miner.
+	TopicMinerFailover = "chain.minerFailover"
+
 	// TopicExecuteTxFailed the topic of execute a transaction failed.
 	TopicExecuteTxFailed = "chain.executeTxFailed"
 
@@ -0,0 +1,215 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	eventStorePrefix = []byte("event_store_")
+	eventStoreBase   = []byte("event_store_base")
+	eventStoreTail   = []byte("event_store_tail")
+)
+
+// StoredEvent is an event of a block on the canonical chain, kept by the event store.
+type StoredEvent struct {
+	Height    uint64 `json:"height"`
+	BlockHash string `json:"block_hash"`
+	Topic     string `json:"topic"`
+	Data      string `json:"data"`
+}
+
+// EventStore keeps the events of the recent blocks on the canonical chain in storage,
+// so that the consumers reconnecting after downtime can replay the events they missed.
+// The events of the blocks reverted by a fork are replaced with the new chain's.
+type EventStore struct {
+	storage   storage.Storage
+	retention uint64
+	mu        sync.RWMutex
+}
+
+// NewEventStore return the event store keeping the events of the last retention blocks, which must be positive.
+func NewEventStore(storage storage.Storage, retention uint64) *EventStore {
+	return &EventStore{
+		storage:   storage,
+		retention: retention,
+	}
+}
+
+func eventStoreKey(height uint64) []byte {
+	return append(append([]byte{}, eventStorePrefix...), byteutils.FromUint64(height)...)
+}
+
+func (store *EventStore) height(key []byte) (uint64, bool) {
+	v, err := store.storage.Get(key)
+	if err != nil {
+		return 0, false
+	}
+	return byteutils.Uint64(v), true
+}
+
+// Range returns the heights of the first and last blocks whose events are kept, ok is false if none.
+func (store *EventStore) Range() (base uint64, tail uint64, ok bool) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	return store.heightRange()
+}
+
+func (store *EventStore) heightRange() (uint64, uint64, bool) {
+	base, ok := store.height(eventStoreBase)
+	if !ok {
+		return 0, 0, false
+	}
+	tail, ok := store.height(eventStoreTail)
+	return base, tail, ok
+}
+
+// Update stores the events of the blocks from the new tail back to the common ancestor,
+// and drops the events of the reverted blocks above the new tail.
+func (store *EventStore) Update(bc *BlockChain, ancestor, newTail *Block) error {
+	var blocks []*Block
+	for block := newTail; block != nil && block.Height() > ancestor.Height(); block = bc.GetBlock(block.ParentHash()) {
+		blocks = append(blocks, block)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	base, tail, ok := store.heightRange()
+	if !ok {
+		base = newTail.Height()
+		if len(blocks) > 0 {
+			base = blocks[len(blocks)-1].Height()
+		}
+	}
+	for h := newTail.Height() + 1; ok && h <= tail; h++ {
+		if err := store.storage.Del(eventStoreKey(h)); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+	}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		if err := store.put(blocks[i]); err != nil {
+			return err
+		}
+		if blocks[i].Height() < base {
+			base = blocks[i].Height()
+		}
+	}
+	tail = newTail.Height()
+
+	// drop the events out of the retention.
+	for ; base+store.retention <= tail; base++ {
+		if err := store.storage.Del(eventStoreKey(base)); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+	}
+	if err := store.storage.Put(eventStoreBase, byteutils.FromUint64(base)); err != nil {
+		return err
+	}
+	return store.storage.Put(eventStoreTail, byteutils.FromUint64(tail))
+}
+
+func (store *EventStore) put(block *Block) error {
+	events := []*StoredEvent{}
+	for _, e := range block.chainEvents() {
+		events = append(events, &StoredEvent{
+			Height:    block.Height(),
+			BlockHash: block.Hash().String(),
+			Topic:     e.Topic,
+			Data:      e.Data,
+		})
+	}
+	data, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	return store.storage.Put(eventStoreKey(block.Height()), data)
+}
+
+// Replay returns the kept events of the blocks between the heights with the topics in order,
+// any topic if none is given.
+func (store *EventStore) Replay(from, to uint64, topics []string) ([]*StoredEvent, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	events := []*StoredEvent{}
+	base, tail, ok := store.heightRange()
+	if !ok {
+		return events, nil
+	}
+	if from < base {
+		from = base
+	}
+	if to == 0 || to > tail {
+		to = tail
+	}
+	wanted := make(map[string]bool)
+	for _, v := range topics {
+		wanted[v] = true
+	}
+	for h := from; h <= to; h++ {
+		data, err := store.storage.Get(eventStoreKey(h))
+		if err == storage.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var stored []*StoredEvent
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return nil, err
+		}
+		for _, e := range stored {
+			if len(wanted) == 0 || wanted[e.Topic] {
+				events = append(events, e)
+			}
+		}
+	}
+	return events, nil
+}
+
+// SetEventStore set the store keeping the events of the canonical chain, none is kept if nil.
+func (bc *BlockChain) SetEventStore(store *EventStore) {
+	bc.eventStore = store
+}
+
+// EventStore return the store of the events of the canonical chain, nil if not kept.
+func (bc *BlockChain) EventStore() *EventStore {
+	return bc.eventStore
+}
+
+func (bc *BlockChain) storeEvents(ancestor, newTail *Block) {
+	if bc.eventStore == nil {
+		return
+	}
+	if err := bc.eventStore.Update(bc, ancestor, newTail); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail": newTail,
+			"err":  err,
+		}).Error("Failed to store the events of the new tail.")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventStore(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	store := NewEventStore(bc.storage, 2)
+	bc.SetEventStore(store)
+
+	linked := func() []string {
+		events, err := store.Replay(0, 0, []string{TopicLinkBlock})
+		assert.Nil(t, err)
+		hashes := []string{}
+		for _, v := range events {
+			hashes = append(hashes, v.BlockHash)
+		}
+		return hashes
+	}
+	seal := func(coinbase *Address, timestamp int64) *Block {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = timestamp
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		return block
+	}
+
+	/*
+		genesis -- 0 -- 11
+		             \_ 12 -- 121
+	*/
+	block0 := seal(&Address{[]byte("012345678901234567890000")}, BlockInterval)
+	assert.Nil(t, bc.SetTailBlock(block0))
+	block11 := seal(&Address{[]byte("012345678901234567890011")}, BlockInterval*2)
+	block12 := seal(&Address{[]byte("012345678901234567890012")}, BlockInterval*3)
+	assert.Nil(t, bc.SetTailBlock(block11))
+	assert.Equal(t, []string{block0.Hash().String(), block11.Hash().String()}, linked())
+
+	// the events of the reverted block are replaced with the new chain's.
+	assert.Nil(t, bc.SetTailBlock(block12))
+	assert.Equal(t, []string{block0.Hash().String(), block12.Hash().String()}, linked())
+
+	// only the events of the last 2 blocks are kept.
+	block121 := seal(&Address{[]byte("012345678901234567890121")}, BlockInterval*4)
+	assert.Nil(t, bc.SetTailBlock(block121))
+	assert.Equal(t, []string{block12.Hash().String(), block121.Hash().String()}, linked())
+	base, tail, ok := store.Range()
+	assert.True(t, ok)
+	assert.Equal(t, block12.Height(), base)
+	assert.Equal(t, block121.Height(), tail)
+
+	events, err := store.Replay(block121.Height(), 0, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, TopicLinkBlock, events[len(events)-1].Topic)
+	assert.Equal(t, block121.Height(), events[len(events)-1].Height)
+}
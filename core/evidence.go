@@ -0,0 +1,360 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/sha3"
+)
+
+// signedHeader is a signed block header with the hashes of the block's body,
+// which is enough to recompute and check the signed block hash.
+type signedHeader struct {
+	header    *BlockHeader
+	txs       []byteutils.Hash
+	evidences []byteutils.Hash
+}
+
+// ToProto converts domain signedHeader to proto SignedHeader
+func (h *signedHeader) ToProto() (proto.Message, error) {
+	header, err := h.header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	if header, ok := header.(*corepb.BlockHeader); ok {
+		var txs, evidences [][]byte
+		for _, v := range h.txs {
+			txs = append(txs, v)
+		}
+		for _, v := range h.evidences {
+			evidences = append(evidences, v)
+		}
+		return &corepb.SignedHeader{
+			Header:    header,
+			Txs:       txs,
+			Evidences: evidences,
+		}, nil
+	}
+	return nil, errors.New("Protobuf message cannot be converted into BlockHeader")
+}
+
+// FromProto converts proto SignedHeader to domain signedHeader
+func (h *signedHeader) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.SignedHeader); ok {
+		if msg.Header == nil || msg.Header.DposContext == nil {
+			return ErrInvalidEvidence
+		}
+		h.header = new(BlockHeader)
+		if err := h.header.FromProto(msg.Header); err != nil {
+			return err
+		}
+		h.txs = nil
+		for _, v := range msg.Txs {
+			h.txs = append(h.txs, v)
+		}
+		h.evidences = nil
+		for _, v := range msg.Evidences {
+			h.evidences = append(h.evidences, v)
+		}
+		return nil
+	}
+	return errors.New("Protobuf message cannot be converted into SignedHeader")
+}
+
+// signer verifies the header's hash and returns the address signing the header.
+func (h *signedHeader) signer() (*Address, error) {
+	wantedHash := hashBlockHeader(h.header, h.txs, h.evidences)
+	if !wantedHash.Equals(h.header.hash) {
+		return nil, ErrInvalidBlockHash
+	}
+	signature, err := crypto.NewSignature(keystore.Algorithm(h.header.alg))
+	if err != nil {
+		return nil, err
+	}
+	pub, err := signature.RecoverPublic(h.header.hash, h.header.sign)
+	if err != nil {
+		return nil, err
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return nil, err
+	}
+	return NewAddressFromPublicKey(pubdata)
+}
+
+func newSignedHeader(block *Block) *signedHeader {
+	h := &signedHeader{header: block.header}
+	for _, tx := range block.transactions {
+		h.txs = append(h.txs, tx.Hash())
+	}
+	for _, evidence := range block.evidences {
+		h.evidences = append(h.evidences, evidence.Hash())
+	}
+	return h
+}
+
+// Evidence proves that a proposer has signed two different blocks in the same slot.
+type Evidence struct {
+	first  *signedHeader
+	second *signedHeader
+}
+
+// NewEvidence return the evidence of two different blocks minted in the same slot.
+func NewEvidence(a *Block, b *Block) (*Evidence, error) {
+	if a.Timestamp() != b.Timestamp() || a.Hash().Equals(b.Hash()) {
+		return nil, ErrInvalidEvidence
+	}
+	first, second := newSignedHeader(a), newSignedHeader(b)
+	// keep the evidence of the same two blocks unique.
+	if bytes.Compare(a.Hash(), b.Hash()) > 0 {
+		first, second = second, first
+	}
+	return &Evidence{first: first, second: second}, nil
+}
+
+// ToProto converts domain Evidence to proto Evidence
+func (e *Evidence) ToProto() (proto.Message, error) {
+	first, err := e.first.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	second, err := e.second.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Evidence{
+		First:  first.(*corepb.SignedHeader),
+		Second: second.(*corepb.SignedHeader),
+	}, nil
+}
+
+// FromProto converts proto Evidence to domain Evidence
+func (e *Evidence) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.Evidence); ok {
+		if msg.First == nil || msg.Second == nil {
+			return ErrInvalidEvidence
+		}
+		e.first = new(signedHeader)
+		if err := e.first.FromProto(msg.First); err != nil {
+			return err
+		}
+		e.second = new(signedHeader)
+		if err := e.second.FromProto(msg.Second); err != nil {
+			return err
+		}
+		return nil
+	}
+	return errors.New("Protobuf message cannot be converted into Evidence")
+}
+
+// Hash return the hash of the evidence.
+func (e *Evidence) Hash() byteutils.Hash {
+	hasher := sha3.New256()
+	hasher.Write(e.first.header.hash)
+	hasher.Write(e.second.header.hash)
+	return hasher.Sum(nil)
+}
+
+// Timestamp return the slot in which the blocks were minted.
+func (e *Evidence) Timestamp() int64 {
+	return e.first.header.timestamp
+}
+
+func (e *Evidence) String() string {
+	return fmt.Sprintf("{\"hash\":\"%s\", \"timestamp\":%d, \"first\":\"%s\", \"second\":\"%s\"}",
+		e.Hash().String(),
+		e.Timestamp(),
+		e.first.header.hash.String(),
+		e.second.header.hash.String(),
+	)
+}
+
+// VerifyIntegrity verify the evidence and return the address of the offender.
+func (e *Evidence) VerifyIntegrity(chainID uint32) (*Address, error) {
+	if e.first.header.chainID != chainID || e.second.header.chainID != chainID {
+		return nil, ErrInvalidChainID
+	}
+	if e.first.header.timestamp != e.second.header.timestamp ||
+		bytes.Compare(e.first.header.hash, e.second.header.hash) >= 0 {
+		return nil, ErrInvalidEvidence
+	}
+	first, err := e.first.signer()
+	if err != nil {
+		return nil, err
+	}
+	second, err := e.second.signer()
+	if err != nil {
+		return nil, err
+	}
+	if !first.Equals(second) {
+		return nil, ErrInvalidEvidence
+	}
+	return first, nil
+}
+
+// EvidencePool holds the evidences waiting to be packed into blocks.
+type EvidencePool struct {
+	all map[byteutils.HexHash]*Evidence
+	mu  sync.RWMutex
+}
+
+// NewEvidencePool return new #EvidencePool instance.
+func NewEvidencePool() *EvidencePool {
+	return &EvidencePool{
+		all: make(map[byteutils.HexHash]*Evidence),
+	}
+}
+
+// Push evidence into pool
+func (pool *EvidencePool) Push(evidence *Evidence) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if _, ok := pool.all[evidence.Hash().Hex()]; ok {
+		return ErrDuplicatedEvidence
+	}
+	pool.all[evidence.Hash().Hex()] = evidence
+
+	logging.VLog().WithFields(logrus.Fields{
+		"evidence": evidence,
+	}).Info("Received double-proposal evidence.")
+	return nil
+}
+
+// Remove evidence from pool
+func (pool *EvidencePool) Remove(evidence *Evidence) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	delete(pool.all, evidence.Hash().Hex())
+}
+
+// Evidences return all evidences in pool, the oldest first.
+func (pool *EvidencePool) Evidences() []*Evidence {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	evidences := []*Evidence{}
+	for _, v := range pool.all {
+		evidences = append(evidences, v)
+	}
+	sort.Slice(evidences, func(i, j int) bool {
+		if evidences[i].Timestamp() != evidences[j].Timestamp() {
+			return evidences[i].Timestamp() < evidences[j].Timestamp()
+		}
+		return bytes.Compare(evidences[i].Hash(), evidences[j].Hash()) < 0
+	})
+	return evidences
+}
+
+// executeEvidence verifies the evidence and jails the offender.
+func (block *Block) executeEvidence(evidence *Evidence) error {
+	offender, err := evidence.VerifyIntegrity(block.header.chainID)
+	if err != nil {
+		return err
+	}
+	if evidence.Timestamp() >= block.header.timestamp {
+		return ErrInvalidEvidence
+	}
+
+	// each evidence can only be punished once.
+	if _, err := block.eventsTrie.Iterator(evidence.Hash()); err != storage.ErrKeyNotFound {
+		if err != nil {
+			return err
+		}
+		return ErrDuplicatedEvidence
+	}
+
+	// a signing key is slashed as the candidate it signs for.
+	delegator, err := signerDelegator(block.accState, offender.Bytes())
+	if err != nil {
+		return err
+	}
+	if delegator != nil {
+		if offender, err = AddressParseFromBytes(delegator); err != nil {
+			return err
+		}
+	}
+
+	release := block.header.timestamp/DynastyInterval + SlashJailDynasties
+	if err := block.dposContext.jailCandidate(offender.Bytes(), release); err != nil {
+		return err
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"offender":  offender.String(),
+		"timestamp": evidence.Timestamp(),
+		"release":   release,
+	})
+	if err != nil {
+		return err
+	}
+	if err := block.RecordEvent(evidence.Hash(), TopicSlash, string(data)); err != nil {
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":    block,
+		"evidence": evidence,
+		"offender": offender.String(),
+	}).Info("Slashed the proposer minting two blocks in one slot.")
+	return nil
+}
+
+// CollectEvidences pack the valid evidences in pool into block.
+func (block *Block) CollectEvidences(pool *EvidencePool) {
+	if block.sealed {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+		}).Error("Sealed block can't be changed.")
+		return
+	}
+
+	for _, evidence := range pool.Evidences() {
+		if evidence.Timestamp() >= block.header.timestamp {
+			continue
+		}
+		block.begin()
+		if err := block.executeEvidence(evidence); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block":    block,
+				"evidence": evidence,
+				"err":      err,
+			}).Warn("invalid evidence.")
+			block.rollback()
+			pool.Remove(evidence)
+			continue
+		}
+		block.commit()
+		block.evidences = append(block.evidences, evidence)
+	}
+}
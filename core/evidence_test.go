@@ -0,0 +1,116 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockSignedBlock(t *testing.T, bc *BlockChain, signer *Address, timestamp int64, nonce uint64) *Block {
+	key, err := keystore.DefaultKS.GetUnlocked(signer.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block, err := bc.NewBlock(signer)
+	assert.Nil(t, err)
+	block.SetTimestamp(timestamp)
+	block.SetNonce(nonce)
+	block.SetMiner(signer)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, block.Sign(signature))
+	return block
+}
+
+func TestEvidence(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	signer := mockAddress()
+	a := mockSignedBlock(t, bc, signer, BlockInterval, 1)
+	b := mockSignedBlock(t, bc, signer, BlockInterval, 2)
+
+	_, err = NewEvidence(a, a)
+	assert.Equal(t, err, ErrInvalidEvidence)
+
+	evidence, err := NewEvidence(a, b)
+	assert.Nil(t, err)
+	offender, err := evidence.VerifyIntegrity(bc.ChainID())
+	assert.Nil(t, err)
+	assert.Equal(t, offender, signer)
+	_, err = evidence.VerifyIntegrity(bc.ChainID() + 1)
+	assert.Equal(t, err, ErrInvalidChainID)
+
+	reversed, err := NewEvidence(b, a)
+	assert.Nil(t, err)
+	assert.Equal(t, reversed.Hash(), evidence.Hash())
+
+	msg, err := evidence.ToProto()
+	assert.Nil(t, err)
+	decoded := new(Evidence)
+	assert.Nil(t, decoded.FromProto(msg))
+	assert.Equal(t, decoded.Hash(), evidence.Hash())
+	offender, err = decoded.VerifyIntegrity(bc.ChainID())
+	assert.Nil(t, err)
+	assert.Equal(t, offender, signer)
+
+	decoded.first.header.nonce++
+	_, err = decoded.VerifyIntegrity(bc.ChainID())
+	assert.Equal(t, err, ErrInvalidBlockHash)
+}
+
+func TestBlock_CollectEvidences(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	signer := mockAddress()
+	a := mockSignedBlock(t, bc, signer, BlockInterval, 1)
+	b := mockSignedBlock(t, bc, signer, BlockInterval, 2)
+	evidence, err := NewEvidence(a, b)
+	assert.Nil(t, err)
+
+	pool := NewEvidencePool()
+	assert.Nil(t, pool.Push(evidence))
+	assert.Equal(t, pool.Push(evidence), ErrDuplicatedEvidence)
+
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	block.SetTimestamp(BlockInterval * 2)
+	_, err = block.dposContext.candidateTrie.Put(signer.Bytes(), signer.Bytes())
+	assert.Nil(t, err)
+
+	block.CollectEvidences(pool)
+	assert.Equal(t, len(block.Evidences()), 1)
+	assert.Equal(t, len(pool.Evidences()), 1)
+	electable, err := isElectable(block.dposContext.candidateTrie, signer.Bytes())
+	assert.Nil(t, err)
+	assert.False(t, electable)
+	events, err := block.FetchEvents(evidence.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, len(events), 1)
+	assert.Equal(t, events[0].Topic, TopicSlash)
+
+	// the punished evidence is dropped from pool.
+	block.CollectEvidences(pool)
+	assert.Equal(t, len(block.Evidences()), 1)
+	assert.Equal(t, len(pool.Evidences()), 0)
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Header is a block header with the hashes of the block's body, enough to check the block hash
+// without the body, downloaded before the blocks by the fast sync.
+type Header struct {
+	signed *signedHeader
+}
+
+// NewHeader return the header of the block.
+func NewHeader(block *Block) *Header {
+	return &Header{signed: newSignedHeader(block)}
+}
+
+// Hash return the block hash.
+func (h *Header) Hash() byteutils.Hash {
+	return h.signed.header.hash
+}
+
+// ParentHash return the hash of the parent block.
+func (h *Header) ParentHash() byteutils.Hash {
+	return h.signed.header.parentHash
+}
+
+// ChainID return the chain id of the block.
+func (h *Header) ChainID() uint32 {
+	return h.signed.header.chainID
+}
+
+// VerifyHash check the block hash is the one of the header and the body hashes.
+func (h *Header) VerifyHash() error {
+	if !hashBlockHeader(h.signed.header, h.signed.txs, h.signed.evidences).Equals(h.Hash()) {
+		return ErrInvalidBlockHash
+	}
+	return nil
+}
+
+// ToProto converts domain Header to proto SignedHeader
+func (h *Header) ToProto() (proto.Message, error) {
+	return h.signed.ToProto()
+}
+
+// FromProto converts proto SignedHeader to domain Header
+func (h *Header) FromProto(msg proto.Message) error {
+	h.signed = new(signedHeader)
+	return h.signed.FromProto(msg)
+}
+
+// HeaderVerifier verify the headers following a block one by one, without their state. Each header must be
+// signed by the proposer of its slot or the signing key it registered, in the dynasty inherited from its parent and the order shuffled by its
+// round seed, with its random proved by the proposer over the parent's seed, from the random height. A dynasty elected after the block
+// is trusted because its root is signed in a header verified with the dynasty before it.
+type HeaderVerifier struct {
+	storage storage.Storage
+	chainID uint32
+	tail    *Block
+
+	parent       *BlockHeader
+	parentSeed   byteutils.Hash
+	parentHeight uint64
+
+	// seed of the round of the last header verified.
+	round     int64
+	roundSeed byteutils.Hash
+}
+
+// NewHeaderVerifier return a verifier of the headers following the block.
+func NewHeaderVerifier(tail *Block) *HeaderVerifier {
+	return &HeaderVerifier{
+		storage:      tail.storage,
+		chainID:      tail.header.chainID,
+		tail:         tail,
+		parent:       tail.header,
+		parentSeed:   tail.Seed(),
+		parentHeight: tail.height,
+		round:        -1,
+	}
+}
+
+// DynastyRoot return the root of the dynasty proposing the header, which must be in the storage to verify it:
+// the parent's dynasty in the same dynasty interval, the parent's next dynasty in the following one.
+func (v *HeaderVerifier) DynastyRoot(h *Header) (byteutils.Hash, error) {
+	header := h.signed.header
+	if !header.parentHash.Equals(v.parent.hash) || header.timestamp <= v.parent.timestamp {
+		return nil, ErrInvalidHeaderParent
+	}
+	if v.parent.dposContext == nil {
+		return nil, ErrInvalidHeaderDynasty
+	}
+	switch header.timestamp/DynastyInterval - v.parent.timestamp/DynastyInterval {
+	case 0:
+		return v.parent.dposContext.DynastyRoot, nil
+	case 1:
+		return v.parent.dposContext.NextDynastyRoot, nil
+	}
+	// the dynasties elected in the gap depend on the votes in the state.
+	return nil, ErrUnverifiableHeaderDynasty
+}
+
+// Verify verify the header follows the last one verified, and make it the parent of the next one.
+func (v *HeaderVerifier) Verify(h *Header) error {
+	header := h.signed.header
+	if header.chainID != v.chainID {
+		return ErrInvalidChainID
+	}
+	root, err := v.DynastyRoot(h)
+	if err != nil {
+		return err
+	}
+	if header.dposContext == nil || !byteutils.Hash(header.dposContext.DynastyRoot).Equals(root) {
+		return ErrInvalidHeaderDynasty
+	}
+	signer, err := h.signed.signer()
+	if err != nil {
+		return err
+	}
+	dynasty, err := trie.NewBatchTrie(root, v.storage)
+	if err != nil {
+		return err
+	}
+	// the rounds are followed before the random height too, a round shuffled from its middle takes the seed before it.
+	seed, err := v.seedOfRound(header.timestamp)
+	if err != nil {
+		return err
+	}
+	random := randomAt(v.tail.randomHeight, v.parentHeight+1)
+	shuffle := seed
+	if !random {
+		shuffle = nil
+	}
+	proposer, err := FindProposer(header.timestamp, v.tail.BlockIntervalAt(header.timestamp), dynasty, shuffle)
+	if err != nil {
+		return err
+	}
+	if proposer == nil {
+		return ErrInvalidHeaderProposer
+	}
+	if ok, err := CanSign(dynasty, proposer, signer); err != nil || !ok {
+		return ErrInvalidHeaderProposer
+	}
+	if err := verifyRandom(header, v.parentSeed, signer, random); err != nil {
+		return err
+	}
+
+	v.round, v.roundSeed = v.tail.blockIntervals.roundStart(header.timestamp), seed
+	v.parent, v.parentSeed, v.parentHeight = header, headerSeed(header), v.parentHeight+1
+	return nil
+}
+
+// seedOfRound return the seed of the last block before the round of the timestamp.
+func (v *HeaderVerifier) seedOfRound(timestamp int64) (byteutils.Hash, error) {
+	start := v.tail.blockIntervals.roundStart(timestamp)
+	if start == v.round {
+		return v.roundSeed, nil
+	}
+	if v.parent.timestamp < start {
+		return v.parentSeed, nil
+	}
+	// only the tail, whose ancestors are known, shares its round with its first child before the round is seen.
+	return roundSeed(v.tail, timestamp)
+}
+
+// InstallSyncedBlock make the block, whose world state was downloaded by the fast sync, the tail of the chain.
+// The blocks before it are not kept, it returns an error if its state is not in the storage.
+func (bc *BlockChain) InstallSyncedBlock(block *Block) error {
+	if err := bc.storeBlockToStorage(block); err != nil {
+		return err
+	}
+	synced, err := LoadBlockFromStorage(block.Hash(), bc.storage, bc.txPool, bc.eventEmitter)
+	if err != nil {
+		return err
+	}
+	synced.blockIntervals = bc.blockIntervals
+	synced.commissionHeight = bc.commissionHeight
+	synced.randomHeight = bc.randomHeight
+	bc.tailBlock = synced
+	bc.storeTailToStorage(synced)
+	bc.indexCanonicalChain(synced)
+	blockHeightGauge.Update(int64(synced.Height()))
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block": synced,
+	}).Info("Installed the block synced fast as the tail.")
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeader(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	block, _ := bc.NewBlock(coinbase)
+	block.header.timestamp = BlockInterval
+	block.CollectTransactions(0)
+	block.SetMiner(coinbase)
+	block.Seal()
+
+	header := NewHeader(block)
+	assert.Nil(t, header.VerifyHash())
+	pb, err := header.ToProto()
+	assert.Nil(t, err)
+	received := new(Header)
+	assert.Nil(t, received.FromProto(pb))
+	assert.Equal(t, block.Hash(), received.Hash())
+	assert.Equal(t, block.ParentHash(), received.ParentHash())
+	assert.Nil(t, received.VerifyHash())
+
+	pb.(*corepb.SignedHeader).Header.Timestamp++
+	forged := new(Header)
+	assert.Nil(t, forged.FromProto(pb))
+	assert.Equal(t, ErrInvalidBlockHash, forged.VerifyHash())
+}
+
+func TestInstallSyncedBlock(t *testing.T) {
+	remote := testNeb()
+	bc, _ := NewBlockChain(remote)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+	for i := 0; i < 3; i++ {
+		block, _ := bc.NewBlock(coinbase)
+		block.header.timestamp = BlockInterval * int64(i+1)
+		block.CollectTransactions(0)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(block))
+		bc.SetTailBlock(block)
+	}
+	pivot := bc.TailBlock()
+
+	local := testNeb()
+	fast, _ := NewBlockChain(local)
+	pbBlock, _ := pivot.ToProto()
+	received := new(Block)
+	assert.Nil(t, received.FromProto(pbBlock))
+
+	syncer := trie.NewSyncer(local.storage)
+	AddStateTries(syncer, received)
+	for !syncer.Done() {
+		for _, h := range syncer.Missing(16) {
+			data, err := remote.storage.Get(h)
+			assert.Nil(t, err)
+			_, err = syncer.Process(data)
+			assert.Nil(t, err)
+		}
+	}
+	assert.Nil(t, fast.InstallSyncedBlock(received))
+	assert.Equal(t, pivot.Hash(), fast.TailBlock().Hash())
+	assert.Equal(t, pivot.Height(), fast.TailBlock().Height())
+	assert.Equal(t, pivot.GetBalance(coinbase.address), fast.TailBlock().GetBalance(coinbase.address))
+}
+
+func signedTestBlock(t *testing.T, parent *Block, slot int64, signer *Address) *Block {
+	context, err := parent.NextDynastyContext(slot - parent.Timestamp())
+	assert.Nil(t, err)
+	if signer == nil {
+		signer = &Address{context.Proposer}
+	}
+	block, err := NewBlock(parent.ChainID(), signer, parent)
+	assert.Nil(t, err)
+	assert.Nil(t, block.LoadDynastyContext(context))
+	block.SetMiner(signer)
+	key, err := keystore.DefaultKS.GetUnlocked(signer.String())
+	assert.Nil(t, err)
+	assert.Nil(t, block.SignRandom(key.(keystore.PrivateKey)))
+	block.CollectTransactions(0)
+	assert.Nil(t, block.Seal())
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+	assert.Nil(t, block.Sign(signature))
+	return block
+}
+
+func TestHeaderVerifier(t *testing.T) {
+	neb := testNeb()
+	var dynasty []string
+	for i := 0; i < DynastySize; i++ {
+		dynasty = append(dynasty, mockAddress().String())
+	}
+	neb.genesis.Consensus.Dpos.Dynasty = dynasty
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	genesis := bc.GenesisBlock()
+
+	// the headers of a dynasty and the next one, signed by the proposers of their slots.
+	var headers []*Header
+	for _, slot := range []int64{BlockInterval, BlockInterval * 2, BlockInterval * DynastySize, DynastyInterval} {
+		block := signedTestBlock(t, bc.TailBlock(), slot, nil)
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+		headers = append(headers, NewHeader(block))
+	}
+	verifier := NewHeaderVerifier(genesis)
+	for _, h := range headers {
+		root, err := verifier.DynastyRoot(h)
+		assert.Nil(t, err)
+		_, err = bc.Storage().Get(root)
+		assert.Nil(t, err)
+		assert.Nil(t, verifier.Verify(h))
+	}
+
+	// a header must follow the last one verified.
+	verifier = NewHeaderVerifier(genesis)
+	assert.Equal(t, ErrInvalidHeaderParent, verifier.Verify(headers[1]))
+
+	// a header signed by a key out of its slot is refused, even with a valid hash.
+	forged := signedTestBlock(t, genesis, BlockInterval, mockAddress())
+	assert.Nil(t, NewHeader(forged).VerifyHash())
+	assert.Equal(t, ErrInvalidHeaderProposer, verifier.Verify(NewHeader(forged)))
+
+	// a header skipping a dynasty can't be verified without the votes.
+	skipped := signedTestBlock(t, genesis, DynastyInterval*2, nil)
+	assert.Equal(t, ErrUnverifiableHeaderDynasty, verifier.Verify(NewHeader(skipped)))
+	assert.Nil(t, verifier.Verify(headers[0]))
+}
@@ -88,6 +88,10 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 		storage:     chain.storage,
 		height:      1,
 		sealed:      false,
+
+		blockIntervals:   chain.blockIntervals,
+		commissionHeight: chain.commissionHeight,
+		randomHeight:     chain.randomHeight,
 	}
 
 	context, err := GenesisDynastyContext(chain.storage, conf)
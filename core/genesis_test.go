@@ -63,6 +63,7 @@ func MockGenesisConf() *corepb.Genesis {
 				Dynasty: MockDynasty,
 			},
 		},
+		RandomHeight: 1,
 		TokenDistribution: []*corepb.GenesisTokenDistribution{
 			&corepb.GenesisTokenDistribution{
 				Address: "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
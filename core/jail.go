@@ -0,0 +1,89 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Jail Related Constants, counted in dynasties.
+const (
+	// a candidate minting too few blocks in its dynasty is jailed for JailDynasties.
+	JailDynasties = int64(2)
+
+	// a candidate slashed for double-proposal is jailed for SlashJailDynasties.
+	SlashJailDynasties = int64(24)
+)
+
+// parseCandidate returns the candidate stored in candidate trie,
+// a jailed candidate is stored with the dynasty it can be unjailed since.
+func parseCandidate(value []byte) (candidate byteutils.Hash, release int64, jailed bool) {
+	if len(value) != AddressLength+8 {
+		return value, 0, false
+	}
+	return value[:AddressLength], byteutils.Int64(value[AddressLength:]), true
+}
+
+// jail keeps the candidate out of election until it's unjailed after the release dynasty.
+func jail(candidatesTrie *trie.BatchTrie, candidate byteutils.Hash, release int64) error {
+	value, err := candidatesTrie.Get(candidate)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err != nil {
+		return nil
+	}
+	if _, current, jailed := parseCandidate(value); jailed && current > release {
+		release = current
+	}
+	value = append(append(byteutils.Hash{}, candidate...), byteutils.FromInt64(release)...)
+	if _, err := candidatesTrie.Put(candidate, value); err != nil {
+		return err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"candidate": candidate.Hex(),
+		"release":   release,
+	}).Info("Jailed candidate.")
+	return nil
+}
+
+// isElectable returns whether the candidate is logged in and not jailed.
+func isElectable(candidatesTrie *trie.BatchTrie, candidate byteutils.Hash) (bool, error) {
+	value, err := candidatesTrie.Get(candidate)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return false, err
+	}
+	if err != nil {
+		return false, nil
+	}
+	_, _, jailed := parseCandidate(value)
+	return !jailed, nil
+}
+
+func (dc *DposContext) jailCandidate(candidate byteutils.Hash, release int64) error {
+	return jail(dc.candidateTrie, candidate, release)
+}
+
+func (dc *DynastyContext) jailCandidate(candidate byteutils.Hash, release int64) error {
+	return jail(dc.CandidateTrie, candidate, release)
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJail(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	candidate := mockAddress()
+	_, err = block.dposContext.candidateTrie.Put(candidate.Bytes(), candidate.Bytes())
+	assert.Nil(t, err)
+
+	release := block.Timestamp()/DynastyInterval + 1
+	assert.Nil(t, block.dposContext.jailCandidate(candidate.Bytes(), release))
+	assert.Nil(t, block.dposContext.jailCandidate(candidate.Bytes(), release-1))
+	value, err := block.dposContext.candidateTrie.Get(candidate.Bytes())
+	assert.Nil(t, err)
+	got, until, jailed := parseCandidate(value)
+	assert.Equal(t, []byte(got), candidate.Bytes())
+	assert.Equal(t, until, release)
+	assert.True(t, jailed)
+	electable, err := isElectable(block.dposContext.candidateTrie, candidate.Bytes())
+	assert.Nil(t, err)
+	assert.False(t, electable)
+
+	tx := mockCandidateTransaction(bc.chainID, 0, LogoutAction)
+	tx.from = candidate
+	ctx := NewPayloadContext(block, tx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, err = NewCandidatePayload(LogoutAction).Execute(ctx)
+	assert.Equal(t, err, ErrCandidateJailed)
+	_, err = NewCandidatePayload(UnjailAction).Execute(ctx)
+	assert.Equal(t, err, ErrUnjailBeforeRelease)
+
+	block.SetTimestamp(release * DynastyInterval)
+	_, err = NewCandidatePayload(UnjailAction).Execute(ctx)
+	assert.Nil(t, err)
+	electable, err = isElectable(ctx.dposContext.candidateTrie, candidate.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, electable)
+	_, err = NewCandidatePayload(UnjailAction).Execute(ctx)
+	assert.Equal(t, err, ErrCandidateNotJailed)
+}
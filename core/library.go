@@ -0,0 +1,104 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// LibrarySourceCacheSize is the number of library sources cached for the contracts linking them.
+const LibrarySourceCacheSize = 128
+
+// librarySources caches the sources of the libraries by their deploy transactions,
+// the libraries can't be upgraded so the sources never change.
+var librarySources, _ = lru.New(LibrarySourceCacheSize)
+
+// deployLibrary creates the library account keeping the source, nothing runs.
+func deployLibrary(ctx *PayloadContext, payload *DeployPayload, codeGas *util.Uint128) (*util.Uint128, error) {
+	if payload.SourceType != nvm.SourceTypeJavaScript || len(payload.Admin) > 0 || len(payload.Libraries) > 0 {
+		return util.NewUint128(), ErrInvalidLibrary
+	}
+	addr, err := ctx.tx.GenerateContractAddress()
+	if err != nil {
+		return util.NewUint128(), err
+	}
+	library, err := ctx.accState.CreateContractAccount(addr.Bytes(), ctx.tx.Hash())
+	if err != nil {
+		return util.NewUint128(), err
+	}
+	library.SetRentHeight(ctx.block.height)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":   ctx.block,
+		"tx":      ctx.tx,
+		"library": addr.String(),
+	}).Info("Library deployed.")
+	return codeGas, nil
+}
+
+// checkLibraries verifies the libraries linked by a contract are deployed.
+func (block *Block) checkLibraries(libraries map[string]string) error {
+	for name, address := range libraries {
+		if !nvm.IsLibraryName(name) {
+			return ErrInvalidLibraryLink
+		}
+		if _, err := block.LibrarySource(address); err != nil {
+			return ErrInvalidLibraryLink
+		}
+	}
+	return nil
+}
+
+// ContractLibraries returns the libraries linked by the current code of contract, for the calls between contracts.
+func (block *Block) ContractLibraries(contract state.Account) (map[string]string, error) {
+	code, err := loadContractCode(block, contract)
+	if err != nil {
+		return nil, err
+	}
+	return code.Libraries, nil
+}
+
+// LibrarySource returns the source of the library deployed at address.
+func (block *Block) LibrarySource(address string) (string, error) {
+	addr, err := AddressParse(address)
+	if err != nil {
+		return "", err
+	}
+	library, err := block.accState.GetContractAccount(addr.Bytes())
+	if err != nil {
+		return "", err
+	}
+	if source, ok := librarySources.Get(library.BirthPlace().Hex()); ok {
+		return source.(string), nil
+	}
+	code, err := loadContractCode(block, library)
+	if err != nil {
+		return "", err
+	}
+	if !code.Library {
+		return "", ErrInvalidLibraryLink
+	}
+	librarySources.Add(library.BirthPlace().Hex(), code.Source)
+	return code.Source, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_ContractLibrary(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	deploy := func(payload *DeployPayload) (*Address, error) {
+		data, _ := payload.ToBytes()
+		tx := mockTransaction(bc.chainID, 0, TxPayloadDeployType, data)
+		ctx := NewPayloadContext(block, tx)
+		assert.Nil(t, ctx.BeginBatch())
+		if _, err := payload.Execute(ctx); err != nil {
+			return nil, err
+		}
+		ctx.Commit()
+		assert.Nil(t, block.acceptTransaction(tx))
+		return tx.GenerateContractAddress()
+	}
+
+	library, err := deploy(&DeployPayload{
+		SourceType: "js",
+		Source:     `exports.double = function (n) { return n * 2; };`,
+		Library:    true,
+	})
+	assert.Nil(t, err)
+	contract, err := deploy(&DeployPayload{
+		SourceType: "js",
+		Source: `var Lib = require("doubler");
+var Contract = function () {};
+Contract.prototype = {
+    init: function () {},
+    double: function (n) { return Lib.double(n); }
+};
+module.exports = Contract;`,
+		Libraries: map[string]string{"doubler": library.String()},
+	})
+	assert.Nil(t, err)
+	block.commit()
+
+	callTx := mockCallTransaction(bc.chainID, 0, "double", "[21]")
+	callTx.to = contract
+	result, err := block.SimulateCall(callTx)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "42", result.Result)
+
+	// the libraries can't be called.
+	callTx = mockCallTransaction(bc.chainID, 0, "double", "[21]")
+	callTx.to = library
+	result, err = block.SimulateCall(callTx)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrCallLibrary, result.Err)
+
+	// only libraries can be linked, and libraries are immutable javascript.
+	block.begin()
+	_, err = deploy(&DeployPayload{SourceType: "js", Source: "", Libraries: map[string]string{"token": contract.String()}})
+	assert.Equal(t, ErrInvalidLibraryLink, err)
+	_, err = deploy(&DeployPayload{SourceType: "js", Source: "", Libraries: map[string]string{"decimal.js": library.String()}})
+	assert.Equal(t, ErrInvalidLibraryLink, err)
+	_, err = deploy(&DeployPayload{SourceType: "js", Source: "", Library: true, Admin: contract.String()})
+	assert.Equal(t, ErrInvalidLibrary, err)
+	block.rollback()
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// ProvedEvent is an event of a transaction with the Merkle proof of its key in the block's events trie.
+type ProvedEvent struct {
+	TxHash byteutils.Hash
+	Index  int64
+	Event  *Event
+	Proof  trie.MerkleProof
+}
+
+// ProveAccount return the account of the address in the block's state, and its Merkle proof against the state root.
+func (block *Block) ProveAccount(address byteutils.Hash) ([]byte, trie.MerkleProof, error) {
+	return block.prove(block.StateRoot(), address)
+}
+
+// ProveTransaction return the transaction of the hash, and its Merkle proof against the transactions root.
+func (block *Block) ProveTransaction(hash byteutils.Hash) ([]byte, trie.MerkleProof, error) {
+	return block.prove(block.TxsRoot(), hash)
+}
+
+// FilterEvents return the events of the block's transactions with one of the topics, all of them if no topic,
+// each with the Merkle proof of its key against the events root.
+func (block *Block) FilterEvents(topics []string) ([]*ProvedEvent, error) {
+	var result []*ProvedEvent
+	for _, tx := range block.transactions {
+		events, err := block.FetchEvents(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		for i, event := range events {
+			if len(topics) > 0 && !containsTopic(topics, event.Topic) {
+				continue
+			}
+			index := int64(i + 1)
+			key := append(append([]byte{}, tx.Hash()...), byteutils.FromInt64(index)...)
+			_, proof, err := block.prove(block.EventsRoot(), key)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &ProvedEvent{
+				TxHash: tx.Hash(),
+				Index:  index,
+				Event:  event,
+				Proof:  proof,
+			})
+		}
+	}
+	return result, nil
+}
+
+func containsTopic(topics []string, topic string) bool {
+	for _, v := range topics {
+		if v == topic {
+			return true
+		}
+	}
+	return false
+}
+
+func (block *Block) prove(root byteutils.Hash, key []byte) ([]byte, trie.MerkleProof, error) {
+	t, err := trie.NewTrie(root, block.storage)
+	if err != nil {
+		return nil, nil, err
+	}
+	val, err := t.Get(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := t.Prove(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return val, proof, nil
+}
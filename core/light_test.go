@@ -0,0 +1,51 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProveAccount(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	genesis := bc.GenesisBlock()
+	addr, err := AddressParse("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	assert.Nil(t, err)
+
+	val, proof, err := genesis.ProveAccount(addr.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, val, proof[len(proof)-1][2])
+
+	// the client checks the proof against the state root of the header.
+	stor, _ := storage.NewMemoryStorage()
+	client, _ := trie.NewTrie(nil, stor)
+	assert.Nil(t, client.Verify(genesis.StateRoot(), addr.Bytes(), proof))
+
+	_, _, err = genesis.ProveAccount(mockAddress().Bytes())
+	assert.NotNil(t, err)
+
+	events, err := genesis.FilterEvents(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(events))
+}
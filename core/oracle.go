@@ -0,0 +1,180 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	oracleOperators   map[byteutils.HexHash]bool
+	oracleOperatorsMu sync.RWMutex
+)
+
+// SetOracleOperators installs the oracle operators in the genesis conf, no request can be answered if empty.
+func SetOracleOperators(addrs []string) error {
+	operators := make(map[byteutils.HexHash]bool)
+	for _, v := range addrs {
+		addr, err := AddressParse(v)
+		if err != nil {
+			return ErrInvalidOracleOperator
+		}
+		operators[addr.Bytes().Hex()] = true
+	}
+
+	oracleOperatorsMu.Lock()
+	defer oracleOperatorsMu.Unlock()
+	oracleOperators = operators
+
+	if len(operators) > 0 {
+		logging.CLog().WithFields(logrus.Fields{
+			"operators": addrs,
+		}).Info("Oracle operators installed.")
+	}
+	return nil
+}
+
+// isOracleOperator returns whether the address can answer oracle requests.
+func isOracleOperator(addr *Address) bool {
+	oracleOperatorsMu.RLock()
+	defer oracleOperatorsMu.RUnlock()
+
+	return oracleOperators[addr.Bytes().Hex()]
+}
+
+// oracleRequestKey is the storage key of the pending request in the requesting contract,
+// out of the domains of the contract's own properties.
+func oracleRequestKey(id string) []byte {
+	return trie.HashDomains("oracle", "request", id)
+}
+
+// OraclePayload carries the answer of an oracle operator to a request of the contract at tx.to.
+type OraclePayload struct {
+	Request string
+	Answer  string
+}
+
+// LoadOraclePayload from bytes
+func LoadOraclePayload(bytes []byte) (*OraclePayload, error) {
+	payload := &OraclePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewOraclePayload with the request id & answer
+func NewOraclePayload(request, answer string) *OraclePayload {
+	return &OraclePayload{
+		Request: request,
+		Answer:  answer,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *OraclePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *OraclePayload) BaseGasCount() *util.Uint128 {
+	return util.NewUint128()
+}
+
+// Execute the oracle payload in tx, deliver the answer to the callback of the request.
+// The request stays pending if the callback fails, to be answered again.
+func (payload *OraclePayload) Execute(context *PayloadContext) (*util.Uint128, error) {
+	if !isOracleOperator(context.tx.from) {
+		return util.NewUint128(), ErrNotOracleOperator
+	}
+	ctx, deployPayload, err := generateCallContext(context)
+	if err != nil {
+		return util.NewUint128(), err
+	}
+	if deployPayload.SourceType != nvm.SourceTypeJavaScript && deployPayload.SourceType != nvm.SourceTypeTypeScript {
+		return util.NewUint128(), ErrInvalidOracleContract
+	}
+
+	contract := ctx.Contract()
+	data, err := contract.Get(oracleRequestKey(payload.Request))
+	if err == storage.ErrKeyNotFound {
+		return util.NewUint128(), ErrUnknownOracleRequest
+	}
+	if err != nil {
+		return util.NewUint128(), err
+	}
+	request := new(nvm.OracleRequest)
+	if err := json.Unmarshal(data, request); err != nil {
+		return util.NewUint128(), err
+	}
+	if err := contract.Del(oracleRequestKey(payload.Request)); err != nil {
+		return util.NewUint128(), err
+	}
+	args, err := json.Marshal([]string{request.ID, payload.Answer})
+	if err != nil {
+		return util.NewUint128(), err
+	}
+
+	if context.simulated {
+		ctx.KeepResult()
+	}
+	engine := nvm.NewV8Engine(ctx)
+	defer engine.Dispose()
+
+	limits := nvm.ExecutionLimitsAt(context.block.Height())
+	engine.SetExecutionLimits(limits.Instructions(context.tx.PayloadGasLimit(payload).Uint64()), limits.MaxMemorySize)
+
+	err = engine.Callback(deployPayload.Source, deployPayload.SourceType, request.Callback, string(args))
+	context.result = engine.Result()
+	emitContractConsole(context, ctx)
+	if err == nil {
+		err = recordContractEffects(context, ctx)
+	}
+	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), err
+}
+
+// saveOracleRequest keeps the request pending in the storage of the requesting contract
+// until an operator answers it.
+func saveOracleRequest(ctx *PayloadContext, request *nvm.OracleRequest) error {
+	addr, err := byteutils.FromHex(request.Contract)
+	if err != nil {
+		return err
+	}
+	contract, err := ctx.accState.GetContractAccount(addr)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	if err := contract.Put(oracleRequestKey(request.ID), data); err != nil {
+		return err
+	}
+	return recordContractEvent(ctx, TopicOracleRequest, request)
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_Oracle(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	execute := func(tx *Transaction) error {
+		payload, err := tx.LoadPayload()
+		assert.Nil(t, err)
+		ctx := NewPayloadContext(block, tx)
+		assert.Nil(t, ctx.BeginBatch())
+		if _, err := payload.Execute(ctx); err != nil {
+			ctx.RollBack()
+			return err
+		}
+		ctx.Commit()
+		return block.acceptTransaction(tx)
+	}
+
+	deploy, _ := NewDeployPayload(`var Contract = function () {};
+Contract.prototype = {
+    init: function () {},
+    ask: function () {
+        LocalContractStorage.set("request", Blockchain.requestOracle("price of NAS", "_answer"));
+    },
+    request: function () { return LocalContractStorage.get("request"); },
+    answer: function () { return LocalContractStorage.get("answer"); },
+    _answer: function (id, answer) {
+        if (id !== LocalContractStorage.get("request")) { throw new Error("wrong request."); }
+        LocalContractStorage.set("answer", answer);
+    }
+};
+module.exports = Contract;`, "js", "").ToBytes()
+	deployTx := mockTransaction(bc.chainID, 0, TxPayloadDeployType, deploy)
+	assert.Nil(t, execute(deployTx))
+	contract, _ := deployTx.GenerateContractAddress()
+
+	askTx := mockCallTransaction(bc.chainID, 0, "ask", "")
+	askTx.to = contract
+	assert.Nil(t, execute(askTx))
+	block.commit()
+
+	simulate := func(function string) string {
+		tx := mockCallTransaction(bc.chainID, 0, function, "")
+		tx.to = contract
+		result, err := block.SimulateCall(tx)
+		assert.Nil(t, err)
+		assert.Nil(t, result.Err)
+		return result.Result
+	}
+	var request string
+	assert.Nil(t, json.Unmarshal([]byte(simulate("request")), &request))
+
+	// the callbacks can't be called by transactions.
+	callbackTx := mockCallTransaction(bc.chainID, 0, "_answer", "")
+	callbackTx.to = contract
+	result, err := block.SimulateCall(callbackTx)
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Err)
+
+	answer := func() *Transaction {
+		data, _ := NewOraclePayload(request, "0.42").ToBytes()
+		tx := mockTransaction(bc.chainID, 0, TxPayloadOracleType, data)
+		tx.to = contract
+		return tx
+	}
+	block.begin()
+	assert.Equal(t, ErrNotOracleOperator, execute(answer()))
+
+	operatorTx := answer()
+	assert.Nil(t, SetOracleOperators([]string{operatorTx.from.String()}))
+	defer SetOracleOperators(nil)
+	assert.Nil(t, execute(operatorTx))
+	assert.Equal(t, `"0.42"`, simulate("answer"))
+
+	// each request is answered once.
+	againTx := answer()
+	againTx.from = operatorTx.from
+	assert.Equal(t, ErrUnknownOracleRequest, execute(againTx))
+	block.rollback()
+
+	assert.Equal(t, ErrInvalidOracleOperator, SetOracleOperators([]string{"n1invalid"}))
+}
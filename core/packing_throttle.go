@@ -0,0 +1,69 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"time"
+)
+
+// PackingThrottle caps the cpu of executing txs when packing blocks, so a validator co-hosted
+// with other services doesn't starve them on heavy contract blocks. The txs of a block are
+// executed one after another on the state left by the previous one, so they are never concurrent.
+type PackingThrottle struct {
+	cpuPercent int
+}
+
+// NewPackingThrottle create a throttle, 0 means unlimited.
+func NewPackingThrottle(cpuPercent uint32) *PackingThrottle {
+	t := &PackingThrottle{}
+	if cpuPercent > 0 && cpuPercent < 100 {
+		t.cpuPercent = int(cpuPercent)
+	}
+	return t
+}
+
+// PackingDeadline return the time packing the block of the slot, lasting interval seconds, stops,
+// half the slot is left to seal, sign and broadcast it before the next proposer's slot.
+func PackingDeadline(slot, interval int64) time.Time {
+	return time.Unix(slot, 0).Add(time.Duration(interval) * time.Second / 2)
+}
+
+// Execute runs fn, then rests in proportion to the time it took, keeping the share of the cpu
+// under the limit, but never past the deadline, none if zero. A nil throttle runs fn directly.
+func (t *PackingThrottle) Execute(fn func(), deadline time.Time) {
+	start := time.Now()
+	fn()
+	if t == nil || t.cpuPercent == 0 {
+		return
+	}
+	rest := t.rest(time.Since(start))
+	if !deadline.IsZero() {
+		if left := time.Until(deadline); left < rest {
+			rest = left
+		}
+	}
+	if rest > 0 {
+		time.Sleep(rest)
+	}
+}
+
+// rest returns the idle time after busy to keep the cpu percent.
+func (t *PackingThrottle) rest(busy time.Duration) time.Duration {
+	return busy * time.Duration(100-t.cpuPercent) / time.Duration(t.cpuPercent)
+}
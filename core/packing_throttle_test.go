@@ -0,0 +1,82 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackingThrottle(t *testing.T) {
+	var nilThrottle *PackingThrottle
+	called := false
+	nilThrottle.Execute(func() { called = true }, time.Time{})
+	assert.True(t, called)
+
+	throttle := NewPackingThrottle(100)
+	assert.Equal(t, throttle.cpuPercent, 0)
+
+	throttle = NewPackingThrottle(25)
+	assert.Equal(t, throttle.rest(time.Second), 3*time.Second)
+
+	// the rest never goes past the deadline.
+	throttle = NewPackingThrottle(1)
+	start := time.Now()
+	throttle.Execute(func() { time.Sleep(10 * time.Millisecond) }, start.Add(50*time.Millisecond))
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+
+	assert.Equal(t, PackingDeadline(BlockInterval, BlockInterval), time.Unix(BlockInterval, 0).Add(time.Duration(BlockInterval)*time.Second/2))
+}
+
+func TestPackingThrottle_HeavyBlock(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	bc.TransactionPool().SetPackingThrottle(NewPackingThrottle(1))
+
+	from := mockAddress()
+	to := mockAddress()
+	key, _ := keystore.DefaultKS.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	for i := 1; i <= 256; i++ {
+		tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(0), uint64(i), TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, bc.TransactionPool().Push(tx))
+	}
+
+	// at 1 percent of the cpu, the txs would take a hundred times their execution, the block is sealed
+	// in the slot anyway, with the txs left to the next blocks.
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.header.timestamp = BlockInterval
+	start := time.Now()
+	slotEnd := start.Add(time.Second)
+	block.CollectTransactionsBefore(256, start.Add(50*time.Millisecond))
+	block.SetMiner(from)
+	assert.Nil(t, block.Seal())
+	assert.True(t, time.Now().Before(slotEnd))
+	assert.True(t, len(block.Transactions()) < 256)
+	assert.False(t, bc.TransactionPool().Empty())
+}
@@ -16,7 +16,22 @@ It has these top-level messages:
 	Block
 	NetBlocks
 	NetBlock
+	ChunkRequest
+	ChunkBlocks
+	TrieNodesRequest
+	TrieNodes
+	Headers
+	LightRequest
+	ProofNode
+	LightEvent
+	LightResponse
 	DownloadBlock
+	SignedHeader
+	Evidence
+	CompactBlock
+	GetBlockTxs
+	BlockTxs
+	TxHashes
 */
 package corepb
 
@@ -36,11 +51,18 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Account struct {
-	Address    []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Balance    []byte `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	Nonce      uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	VarsHash   []byte `protobuf:"bytes,4,opt,name=vars_hash,json=varsHash,proto3" json:"vars_hash,omitempty"`
-	BirthPlace []byte `protobuf:"bytes,5,opt,name=birth_place,json=birthPlace,proto3" json:"birth_place,omitempty"`
+	Address     []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance     []byte `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce       uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	VarsHash    []byte `protobuf:"bytes,4,opt,name=vars_hash,json=varsHash,proto3" json:"vars_hash,omitempty"`
+	BirthPlace  []byte `protobuf:"bytes,5,opt,name=birth_place,json=birthPlace,proto3" json:"birth_place,omitempty"`
+	Admin       []byte `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
+	CodePlace   []byte `protobuf:"bytes,7,opt,name=code_place,json=codePlace,proto3" json:"code_place,omitempty"`
+	StorageSize uint64 `protobuf:"varint,8,opt,name=storage_size,json=storageSize,proto3" json:"storage_size,omitempty"`
+	RentHeight  uint64 `protobuf:"varint,9,opt,name=rent_height,json=rentHeight,proto3" json:"rent_height,omitempty"`
+	Hibernated  bool   `protobuf:"varint,10,opt,name=hibernated,proto3" json:"hibernated,omitempty"`
+	AbiHash     []byte `protobuf:"bytes,11,opt,name=abi_hash,json=abiHash,proto3" json:"abi_hash,omitempty"`
+	Destroyed   bool   `protobuf:"varint,12,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
 }
 
 func (m *Account) Reset()                    { *m = Account{} }
@@ -83,6 +105,55 @@ func (m *Account) GetBirthPlace() []byte {
 	return nil
 }
 
+func (m *Account) GetAdmin() []byte {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
+}
+
+func (m *Account) GetCodePlace() []byte {
+	if m != nil {
+		return m.CodePlace
+	}
+	return nil
+}
+
+func (m *Account) GetStorageSize() uint64 {
+	if m != nil {
+		return m.StorageSize
+	}
+	return 0
+}
+
+func (m *Account) GetRentHeight() uint64 {
+	if m != nil {
+		return m.RentHeight
+	}
+	return 0
+}
+
+func (m *Account) GetHibernated() bool {
+	if m != nil {
+		return m.Hibernated
+	}
+	return false
+}
+
+func (m *Account) GetAbiHash() []byte {
+	if m != nil {
+		return m.AbiHash
+	}
+	return nil
+}
+
+func (m *Account) GetDestroyed() bool {
+	if m != nil {
+		return m.Destroyed
+	}
+	return false
+}
+
 type Data struct {
 	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -280,6 +351,8 @@ type BlockHeader struct {
 	TxsRoot     []byte       `protobuf:"bytes,10,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
 	EventsRoot  []byte       `protobuf:"bytes,11,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
 	DposContext *DposContext `protobuf:"bytes,12,opt,name=dpos_context,json=dposContext" json:"dpos_context,omitempty"`
+	Random      []byte       `protobuf:"bytes,13,opt,name=random,proto3" json:"random,omitempty"`
+	Bloom       []byte       `protobuf:"bytes,14,opt,name=bloom,proto3" json:"bloom,omitempty"`
 }
 
 func (m *BlockHeader) Reset()                    { *m = BlockHeader{} }
@@ -371,10 +444,25 @@ func (m *BlockHeader) GetDposContext() *DposContext {
 	return nil
 }
 
+func (m *BlockHeader) GetRandom() []byte {
+	if m != nil {
+		return m.Random
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetBloom() []byte {
+	if m != nil {
+		return m.Bloom
+	}
+	return nil
+}
+
 type Block struct {
 	Header       *BlockHeader   `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions" json:"transactions,omitempty"`
 	Height       uint64         `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Evidences    []*Evidence    `protobuf:"bytes,4,rep,name=evidences" json:"evidences,omitempty"`
 }
 
 func (m *Block) Reset()                    { *m = Block{} }
@@ -403,10 +491,18 @@ func (m *Block) GetHeight() uint64 {
 	return 0
 }
 
+func (m *Block) GetEvidences() []*Evidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
 type NetBlocks struct {
-	From   string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Batch  uint64   `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
-	Blocks []*Block `protobuf:"bytes,3,rep,name=blocks" json:"blocks,omitempty"`
+	From       string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Batch      uint64   `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	Blocks     []*Block `protobuf:"bytes,3,rep,name=blocks" json:"blocks,omitempty"`
+	TailHeight uint64   `protobuf:"varint,4,opt,name=tail_height,json=tailHeight,proto3" json:"tail_height,omitempty"`
 }
 
 func (m *NetBlocks) Reset()                    { *m = NetBlocks{} }
@@ -435,6 +531,13 @@ func (m *NetBlocks) GetBlocks() []*Block {
 	return nil
 }
 
+func (m *NetBlocks) GetTailHeight() uint64 {
+	if m != nil {
+		return m.TailHeight
+	}
+	return 0
+}
+
 type NetBlock struct {
 	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	Batch uint64 `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
@@ -467,6 +570,386 @@ func (m *NetBlock) GetBlock() *Block {
 	return nil
 }
 
+type ChunkRequest struct {
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Id    uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Start uint64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ChunkRequest) Reset()                    { *m = ChunkRequest{} }
+func (m *ChunkRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChunkRequest) ProtoMessage()               {}
+func (*ChunkRequest) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+
+func (m *ChunkRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ChunkRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ChunkRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ChunkRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ChunkBlocks struct {
+	From   string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Id     uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Start  uint64   `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Blocks []*Block `protobuf:"bytes,4,rep,name=blocks" json:"blocks,omitempty"`
+}
+
+func (m *ChunkBlocks) Reset()                    { *m = ChunkBlocks{} }
+func (m *ChunkBlocks) String() string            { return proto.CompactTextString(m) }
+func (*ChunkBlocks) ProtoMessage()               {}
+func (*ChunkBlocks) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
+
+func (m *ChunkBlocks) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ChunkBlocks) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ChunkBlocks) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ChunkBlocks) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type TrieNodesRequest struct {
+	From   string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Id     uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Hashes [][]byte `protobuf:"bytes,3,rep,name=hashes" json:"hashes,omitempty"`
+}
+
+func (m *TrieNodesRequest) Reset()                    { *m = TrieNodesRequest{} }
+func (m *TrieNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrieNodesRequest) ProtoMessage()               {}
+func (*TrieNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{10} }
+
+func (m *TrieNodesRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TrieNodesRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TrieNodesRequest) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type TrieNodes struct {
+	From  string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Id    uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Nodes [][]byte `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *TrieNodes) Reset()                    { *m = TrieNodes{} }
+func (m *TrieNodes) String() string            { return proto.CompactTextString(m) }
+func (*TrieNodes) ProtoMessage()               {}
+func (*TrieNodes) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{11} }
+
+func (m *TrieNodes) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TrieNodes) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TrieNodes) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type Headers struct {
+	From    string          `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Id      uint64          `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Start   uint64          `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Headers []*SignedHeader `protobuf:"bytes,4,rep,name=headers" json:"headers,omitempty"`
+}
+
+func (m *Headers) Reset()                    { *m = Headers{} }
+func (m *Headers) String() string            { return proto.CompactTextString(m) }
+func (*Headers) ProtoMessage()               {}
+func (*Headers) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{12} }
+
+func (m *Headers) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *Headers) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Headers) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Headers) GetHeaders() []*SignedHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type LightRequest struct {
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// the headers from the height start.
+	Start uint64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// the block the account, transaction or events are proved in, the tail if empty.
+	BlockHash []byte `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// the address of the account or the hash of the transaction.
+	Key []byte `protobuf:"bytes,6,opt,name=key,proto3" json:"key,omitempty"`
+	// the topics of the events, all if empty.
+	Topics []string `protobuf:"bytes,7,rep,name=topics" json:"topics,omitempty"`
+}
+
+func (m *LightRequest) Reset()                    { *m = LightRequest{} }
+func (m *LightRequest) String() string            { return proto.CompactTextString(m) }
+func (*LightRequest) ProtoMessage()               {}
+func (*LightRequest) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{13} }
+
+func (m *LightRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LightRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *LightRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *LightRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LightRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *LightRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *LightRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type ProofNode struct {
+	Val [][]byte `protobuf:"bytes,1,rep,name=val" json:"val,omitempty"`
+}
+
+func (m *ProofNode) Reset()                    { *m = ProofNode{} }
+func (m *ProofNode) String() string            { return proto.CompactTextString(m) }
+func (*ProofNode) ProtoMessage()               {}
+func (*ProofNode) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{14} }
+
+func (m *ProofNode) GetVal() [][]byte {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+type LightEvent struct {
+	TxHash []byte       `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Index  int64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Topic  string       `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data   string       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Proof  []*ProofNode `protobuf:"bytes,5,rep,name=proof" json:"proof,omitempty"`
+}
+
+func (m *LightEvent) Reset()                    { *m = LightEvent{} }
+func (m *LightEvent) String() string            { return proto.CompactTextString(m) }
+func (*LightEvent) ProtoMessage()               {}
+func (*LightEvent) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{15} }
+
+func (m *LightEvent) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *LightEvent) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *LightEvent) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *LightEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *LightEvent) GetProof() []*ProofNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type LightResponse struct {
+	Id        uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error     string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Headers   []*SignedHeader `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty"`
+	BlockHash []byte          `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Value     []byte          `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Proof     []*ProofNode    `protobuf:"bytes,6,rep,name=proof" json:"proof,omitempty"`
+	Events    []*LightEvent   `protobuf:"bytes,7,rep,name=events" json:"events,omitempty"`
+}
+
+func (m *LightResponse) Reset()                    { *m = LightResponse{} }
+func (m *LightResponse) String() string            { return proto.CompactTextString(m) }
+func (*LightResponse) ProtoMessage()               {}
+func (*LightResponse) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{16} }
+
+func (m *LightResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LightResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *LightResponse) GetHeaders() []*SignedHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *LightResponse) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *LightResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *LightResponse) GetProof() []*ProofNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *LightResponse) GetEvents() []*LightEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type DownloadBlock struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Sign []byte `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
@@ -475,7 +958,7 @@ type DownloadBlock struct {
 func (m *DownloadBlock) Reset()                    { *m = DownloadBlock{} }
 func (m *DownloadBlock) String() string            { return proto.CompactTextString(m) }
 func (*DownloadBlock) ProtoMessage()               {}
-func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{17} }
 
 func (m *DownloadBlock) GetHash() []byte {
 	if m != nil {
@@ -491,6 +974,174 @@ func (m *DownloadBlock) GetSign() []byte {
 	return nil
 }
 
+type SignedHeader struct {
+	Header    *BlockHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Txs       [][]byte     `protobuf:"bytes,2,rep,name=txs" json:"txs,omitempty"`
+	Evidences [][]byte     `protobuf:"bytes,3,rep,name=evidences" json:"evidences,omitempty"`
+}
+
+func (m *SignedHeader) Reset()                    { *m = SignedHeader{} }
+func (m *SignedHeader) String() string            { return proto.CompactTextString(m) }
+func (*SignedHeader) ProtoMessage()               {}
+func (*SignedHeader) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{18} }
+
+func (m *SignedHeader) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignedHeader) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *SignedHeader) GetEvidences() [][]byte {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+type Evidence struct {
+	First  *SignedHeader `protobuf:"bytes,1,opt,name=first" json:"first,omitempty"`
+	Second *SignedHeader `protobuf:"bytes,2,opt,name=second" json:"second,omitempty"`
+}
+
+func (m *Evidence) Reset()                    { *m = Evidence{} }
+func (m *Evidence) String() string            { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()               {}
+func (*Evidence) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{19} }
+
+func (m *Evidence) GetFirst() *SignedHeader {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *Evidence) GetSecond() *SignedHeader {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+type CompactBlock struct {
+	Header    *BlockHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Height    uint64       `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Evidences []*Evidence  `protobuf:"bytes,3,rep,name=evidences" json:"evidences,omitempty"`
+	ShortIds  [][]byte     `protobuf:"bytes,4,rep,name=short_ids,json=shortIds" json:"short_ids,omitempty"`
+}
+
+func (m *CompactBlock) Reset()                    { *m = CompactBlock{} }
+func (m *CompactBlock) String() string            { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()               {}
+func (*CompactBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{20} }
+
+func (m *CompactBlock) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetEvidences() []*Evidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetShortIds() [][]byte {
+	if m != nil {
+		return m.ShortIds
+	}
+	return nil
+}
+
+type GetBlockTxs struct {
+	Hash    []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Indexes []uint32 `protobuf:"varint,2,rep,packed,name=indexes" json:"indexes,omitempty"`
+}
+
+func (m *GetBlockTxs) Reset()                    { *m = GetBlockTxs{} }
+func (m *GetBlockTxs) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockTxs) ProtoMessage()               {}
+func (*GetBlockTxs) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{21} }
+
+func (m *GetBlockTxs) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *GetBlockTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type BlockTxs struct {
+	Hash         []byte         `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Indexes      []uint32       `protobuf:"varint,2,rep,packed,name=indexes" json:"indexes,omitempty"`
+	Transactions []*Transaction `protobuf:"bytes,3,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *BlockTxs) Reset()                    { *m = BlockTxs{} }
+func (m *BlockTxs) String() string            { return proto.CompactTextString(m) }
+func (*BlockTxs) ProtoMessage()               {}
+func (*BlockTxs) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{22} }
+
+func (m *BlockTxs) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BlockTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *BlockTxs) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type TxHashes struct {
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes" json:"hashes,omitempty"`
+}
+
+func (m *TxHashes) Reset()                    { *m = TxHashes{} }
+func (m *TxHashes) String() string            { return proto.CompactTextString(m) }
+func (*TxHashes) ProtoMessage()               {}
+func (*TxHashes) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{23} }
+
+func (m *TxHashes) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 	proto.RegisterType((*Data)(nil), "corepb.Data")
@@ -500,57 +1151,110 @@ func init() {
 	proto.RegisterType((*Block)(nil), "corepb.Block")
 	proto.RegisterType((*NetBlocks)(nil), "corepb.NetBlocks")
 	proto.RegisterType((*NetBlock)(nil), "corepb.NetBlock")
+	proto.RegisterType((*ChunkRequest)(nil), "corepb.ChunkRequest")
+	proto.RegisterType((*ChunkBlocks)(nil), "corepb.ChunkBlocks")
+	proto.RegisterType((*TrieNodesRequest)(nil), "corepb.TrieNodesRequest")
+	proto.RegisterType((*TrieNodes)(nil), "corepb.TrieNodes")
+	proto.RegisterType((*Headers)(nil), "corepb.Headers")
+	proto.RegisterType((*LightRequest)(nil), "corepb.LightRequest")
+	proto.RegisterType((*ProofNode)(nil), "corepb.ProofNode")
+	proto.RegisterType((*LightEvent)(nil), "corepb.LightEvent")
+	proto.RegisterType((*LightResponse)(nil), "corepb.LightResponse")
 	proto.RegisterType((*DownloadBlock)(nil), "corepb.DownloadBlock")
+	proto.RegisterType((*SignedHeader)(nil), "corepb.SignedHeader")
+	proto.RegisterType((*Evidence)(nil), "corepb.Evidence")
+	proto.RegisterType((*CompactBlock)(nil), "corepb.CompactBlock")
+	proto.RegisterType((*GetBlockTxs)(nil), "corepb.GetBlockTxs")
+	proto.RegisterType((*BlockTxs)(nil), "corepb.BlockTxs")
+	proto.RegisterType((*TxHashes)(nil), "corepb.TxHashes")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 722 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xd3, 0x4a,
-	0x10, 0x96, 0x93, 0x38, 0x3f, 0x63, 0xa7, 0xe7, 0x9c, 0x3d, 0x15, 0x72, 0xf9, 0x51, 0x83, 0xab,
-	0x4a, 0x11, 0x48, 0xbd, 0x28, 0x88, 0x5e, 0x43, 0x73, 0x51, 0x24, 0x84, 0x2a, 0x8b, 0x1b, 0x24,
-	0x24, 0x6b, 0x63, 0x2f, 0x89, 0x85, 0xb3, 0x6b, 0x79, 0xa7, 0x25, 0x79, 0x00, 0x1e, 0x80, 0xf7,
-	0xe0, 0xb9, 0x90, 0x78, 0x0b, 0xb4, 0xb3, 0x1b, 0xc7, 0xa1, 0xbd, 0xe1, 0x6e, 0xe7, 0xfb, 0x66,
-	0x77, 0x67, 0xbe, 0xf9, 0x76, 0x21, 0x98, 0x97, 0x2a, 0xfb, 0x72, 0x56, 0xd5, 0x0a, 0x15, 0xeb,
-	0x67, 0xaa, 0x16, 0xd5, 0x3c, 0xfe, 0xee, 0xc1, 0xe0, 0x75, 0x96, 0xa9, 0x1b, 0x89, 0x2c, 0x82,
-	0x01, 0xcf, 0xf3, 0x5a, 0x68, 0x1d, 0x79, 0x13, 0x6f, 0x1a, 0x26, 0xdb, 0xd0, 0x30, 0x73, 0x5e,
-	0x72, 0x99, 0x89, 0xa8, 0x63, 0x19, 0x17, 0xb2, 0x43, 0xf0, 0xa5, 0x32, 0x78, 0x77, 0xe2, 0x4d,
-	0x7b, 0x89, 0x0d, 0xd8, 0x23, 0x18, 0xdd, 0xf2, 0x5a, 0xa7, 0x4b, 0xae, 0x97, 0x51, 0x8f, 0x76,
-	0x0c, 0x0d, 0x70, 0xc5, 0xf5, 0x92, 0x1d, 0x43, 0x30, 0x2f, 0x6a, 0x5c, 0xa6, 0x55, 0xc9, 0x33,
-	0x11, 0xf9, 0x44, 0x03, 0x41, 0xd7, 0x06, 0x89, 0x5f, 0x42, 0x6f, 0xc6, 0x91, 0x33, 0x06, 0x3d,
-	0xdc, 0x54, 0x82, 0x8a, 0x19, 0x25, 0xb4, 0x36, 0x95, 0x54, 0x7c, 0x53, 0x2a, 0x9e, 0x6f, 0x2b,
-	0x71, 0x61, 0xfc, 0xa3, 0x03, 0xc1, 0x87, 0x9a, 0x4b, 0xcd, 0x33, 0x2c, 0x94, 0x34, 0xbb, 0xe9,
-	0x7a, 0xdb, 0x0a, 0xad, 0x0d, 0xf6, 0xb9, 0x56, 0x2b, 0xb7, 0x95, 0xd6, 0xec, 0x00, 0x3a, 0xa8,
-	0xa8, 0xfc, 0x30, 0xe9, 0xa0, 0x32, 0x1d, 0xdd, 0xf2, 0xf2, 0x46, 0xb8, 0xba, 0x6d, 0xb0, 0xeb,
-	0xd3, 0x6f, 0xf7, 0xf9, 0x18, 0x46, 0x58, 0xac, 0x84, 0x46, 0xbe, 0xaa, 0xa2, 0xfe, 0xc4, 0x9b,
-	0x76, 0x93, 0x1d, 0xc0, 0x26, 0xd0, 0xcb, 0x39, 0xf2, 0x68, 0x30, 0xf1, 0xa6, 0xc1, 0x79, 0x78,
-	0x66, 0x25, 0x3f, 0x33, 0xbd, 0x25, 0xc4, 0xb0, 0x23, 0x18, 0x66, 0x4b, 0x5e, 0xc8, 0xb4, 0xc8,
-	0xa3, 0xe1, 0xc4, 0x9b, 0x8e, 0x93, 0x01, 0xc5, 0x6f, 0x73, 0x23, 0xe1, 0x82, 0xeb, 0xb4, 0xaa,
-	0x8b, 0x4c, 0x44, 0x23, 0x2b, 0xe1, 0x82, 0xeb, 0x6b, 0x13, 0x6f, 0xc9, 0xb2, 0x58, 0x15, 0x18,
-	0x41, 0x43, 0xbe, 0x33, 0x31, 0xfb, 0x17, 0xba, 0xbc, 0x5c, 0x44, 0x01, 0x9d, 0x67, 0x96, 0xa6,
-	0x6d, 0x5d, 0x2c, 0x64, 0x14, 0xda, 0xb6, 0xcd, 0x3a, 0xfe, 0xe5, 0x41, 0x30, 0xab, 0x94, 0xbe,
-	0x54, 0x12, 0xc5, 0x1a, 0xd9, 0x53, 0x08, 0xf3, 0x8d, 0xe4, 0x1a, 0x37, 0x69, 0xad, 0x14, 0x3a,
-	0xd9, 0x02, 0x87, 0x25, 0x4a, 0x21, 0x7b, 0x06, 0xff, 0x49, 0xb1, 0xc6, 0x74, 0x2f, 0xcf, 0x4a,
-	0xf9, 0x8f, 0x21, 0x66, 0xad, 0xdc, 0x13, 0x18, 0xe7, 0xa2, 0x14, 0x0b, 0x8e, 0xc2, 0xe6, 0x59,
-	0x81, 0xc3, 0x2d, 0x48, 0x49, 0xa7, 0x70, 0x90, 0x71, 0x99, 0x17, 0x79, 0x93, 0x65, 0x35, 0x1f,
-	0x37, 0x28, 0xa5, 0x19, 0x37, 0xa9, 0x6d, 0x86, 0xef, 0xdc, 0xa4, 0x1c, 0x19, 0xc3, 0x78, 0x55,
-	0x48, 0x4c, 0x33, 0x89, 0x36, 0xa1, 0x6f, 0x0b, 0x37, 0xe0, 0xa5, 0x44, 0x93, 0x13, 0xff, 0xec,
-	0x40, 0xf0, 0xc6, 0x98, 0xff, 0x4a, 0xf0, 0x5c, 0xd4, 0xf7, 0x5a, 0xe3, 0x18, 0x82, 0x8a, 0xd7,
-	0x42, 0xa2, 0x35, 0xad, 0x6d, 0x0b, 0x2c, 0x44, 0xb6, 0xbd, 0xdf, 0xe9, 0x0f, 0x61, 0x98, 0xa9,
-	0x42, 0xce, 0xb9, 0xde, 0x1a, 0xa6, 0x89, 0xf7, 0xdd, 0xe1, 0xff, 0xe9, 0x8e, 0xf6, 0xec, 0xfb,
-	0xfb, 0xb3, 0x77, 0x13, 0x1c, 0xdc, 0x9d, 0xe0, 0x70, 0x37, 0x41, 0xf6, 0x04, 0x40, 0x63, 0xa3,
-	0x9c, 0xb5, 0xc8, 0x88, 0x10, 0x12, 0xe6, 0x08, 0x86, 0xb8, 0xd6, 0x96, 0xb4, 0x16, 0x19, 0xe0,
-	0x5a, 0x13, 0x75, 0x0c, 0x81, 0xb8, 0x15, 0x12, 0x1d, 0x1b, 0xd8, 0x5e, 0x2d, 0x44, 0x09, 0xaf,
-	0x20, 0xcc, 0x2b, 0xa5, 0xd3, 0xcc, 0x9a, 0x83, 0x8c, 0x13, 0x9c, 0xff, 0xdf, 0x38, 0x78, 0xe7,
-	0x9b, 0x24, 0xc8, 0x77, 0x41, 0xfc, 0xcd, 0x03, 0x9f, 0x84, 0x66, 0xcf, 0xa1, 0xbf, 0x24, 0xb1,
-	0x49, 0xe4, 0xd6, 0xde, 0xd6, 0x1c, 0x12, 0x97, 0xc2, 0x2e, 0x20, 0xc4, 0xdd, 0xcb, 0xd5, 0x51,
-	0x67, 0xd2, 0x6d, 0x6f, 0x69, 0xbd, 0xea, 0x64, 0x2f, 0x91, 0x3d, 0x30, 0xb7, 0x14, 0x8b, 0x25,
-	0xba, 0xa1, 0xb8, 0x28, 0xfe, 0x04, 0xa3, 0xf7, 0x02, 0xe9, 0x2a, 0xdd, 0x3c, 0x7a, 0xf7, 0x8d,
-	0xd0, 0xa3, 0x3f, 0x04, 0x7f, 0xce, 0x31, 0xb3, 0x73, 0xee, 0x25, 0x36, 0x60, 0xa7, 0xd0, 0xa7,
-	0x3f, 0x52, 0x47, 0x5d, 0xaa, 0x60, 0xbc, 0x57, 0x74, 0xe2, 0xc8, 0xf8, 0x23, 0x0c, 0xb7, 0xa7,
-	0xff, 0xc5, 0xe1, 0x27, 0xe0, 0xd3, 0x7e, 0x2a, 0xf5, 0xce, 0xd9, 0x96, 0x8b, 0x2f, 0x60, 0x3c,
-	0x53, 0x5f, 0xa5, 0xf9, 0xd0, 0x9a, 0xf3, 0xef, 0xfb, 0xc5, 0xc8, 0x0c, 0x9d, 0x9d, 0x19, 0xe6,
-	0x7d, 0xfa, 0xd6, 0x5f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x27, 0xd4, 0x2d, 0xe5, 0x05,
-	0x00, 0x00,
+	// 1343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcb, 0x6e, 0x1c, 0x45,
+	0x17, 0x56, 0x4f, 0xcf, 0xa5, 0xfb, 0x74, 0x8f, 0x7f, 0xa7, 0xff, 0xe8, 0xff, 0x3b, 0x90, 0x10,
+	0xd3, 0x51, 0x84, 0x15, 0x90, 0x17, 0x01, 0x91, 0x05, 0x2b, 0xb0, 0x23, 0x12, 0x29, 0x8a, 0xa2,
+	0x8a, 0x37, 0x6c, 0x18, 0xd5, 0x74, 0x95, 0x67, 0x4a, 0x9e, 0xa9, 0xea, 0x74, 0x95, 0xcd, 0x38,
+	0x12, 0xaf, 0xc0, 0x8a, 0x07, 0x60, 0xc7, 0x86, 0x2d, 0x4f, 0xc4, 0x8a, 0x15, 0xaf, 0x80, 0xea,
+	0x54, 0xf5, 0xc5, 0xb1, 0x13, 0xec, 0xb0, 0xab, 0xf3, 0x9d, 0x53, 0x97, 0xf3, 0x9d, 0x5b, 0x37,
+	0x24, 0xf3, 0x95, 0x2a, 0x8f, 0xf7, 0xaa, 0x5a, 0x19, 0x95, 0x8d, 0x4b, 0x55, 0xf3, 0x6a, 0x5e,
+	0xfc, 0x31, 0x80, 0xc9, 0xd7, 0x65, 0xa9, 0x4e, 0xa4, 0xc9, 0x72, 0x98, 0x50, 0xc6, 0x6a, 0xae,
+	0x75, 0x1e, 0xec, 0x04, 0xbb, 0x29, 0x69, 0x44, 0xab, 0x99, 0xd3, 0x15, 0x95, 0x25, 0xcf, 0x07,
+	0x4e, 0xe3, 0xc5, 0xec, 0x26, 0x8c, 0xa4, 0xb2, 0x78, 0xb8, 0x13, 0xec, 0x0e, 0x89, 0x13, 0xb2,
+	0x0f, 0x21, 0x3e, 0xa5, 0xb5, 0x9e, 0x2d, 0xa9, 0x5e, 0xe6, 0x43, 0xdc, 0x11, 0x59, 0xe0, 0x09,
+	0xd5, 0xcb, 0xec, 0x2e, 0x24, 0x73, 0x51, 0x9b, 0xe5, 0xac, 0x5a, 0xd1, 0x92, 0xe7, 0x23, 0x54,
+	0x03, 0x42, 0x2f, 0x56, 0xd4, 0x9d, 0x49, 0xd9, 0x5a, 0xc8, 0x7c, 0x8c, 0x2a, 0x27, 0x64, 0x77,
+	0x00, 0x4a, 0xc5, 0xb8, 0xdf, 0x35, 0x41, 0x55, 0x6c, 0x11, 0xb7, 0xe9, 0x63, 0x48, 0xb5, 0x51,
+	0x35, 0x5d, 0xf0, 0x99, 0x16, 0xaf, 0x79, 0x1e, 0xe1, 0x7b, 0x12, 0x8f, 0xbd, 0x14, 0xaf, 0xb9,
+	0xbd, 0xb8, 0xe6, 0xd2, 0xcc, 0x96, 0x5c, 0x2c, 0x96, 0x26, 0x8f, 0xd1, 0x02, 0x2c, 0xf4, 0x04,
+	0x91, 0xec, 0x23, 0x80, 0xa5, 0x98, 0xf3, 0x5a, 0x52, 0xc3, 0x59, 0x0e, 0x3b, 0xc1, 0x6e, 0x44,
+	0x7a, 0x48, 0x76, 0x0b, 0x22, 0x3a, 0x17, 0xce, 0xab, 0xc4, 0x33, 0x34, 0x17, 0xe8, 0xd4, 0x6d,
+	0x88, 0x19, 0xd7, 0xa6, 0x56, 0x67, 0x9c, 0xe5, 0x29, 0xee, 0xec, 0x80, 0xe2, 0x0b, 0x18, 0x1e,
+	0x50, 0x43, 0xb3, 0x0c, 0x86, 0xe6, 0xac, 0xe2, 0x48, 0x6f, 0x4c, 0x70, 0x6d, 0xb9, 0xad, 0xe8,
+	0xd9, 0x4a, 0x51, 0xd6, 0x70, 0xeb, 0xc5, 0xe2, 0xb7, 0x01, 0x24, 0x87, 0x35, 0x95, 0x9a, 0x96,
+	0x46, 0x28, 0x69, 0x77, 0xe3, 0xd5, 0x2e, 0x38, 0xb8, 0xb6, 0xd8, 0x51, 0xad, 0xd6, 0x7e, 0x2b,
+	0xae, 0xb3, 0x2d, 0x18, 0x18, 0x85, 0x01, 0x49, 0xc9, 0xc0, 0x28, 0xcb, 0xe7, 0x29, 0x5d, 0x9d,
+	0x70, 0x1f, 0x09, 0x27, 0x74, 0x91, 0x1b, 0xf5, 0x23, 0x77, 0x1b, 0x62, 0x23, 0xd6, 0x5c, 0x1b,
+	0xba, 0xae, 0x90, 0xff, 0x90, 0x74, 0x40, 0xb6, 0x03, 0x43, 0x46, 0x0d, 0x45, 0xf6, 0x93, 0x87,
+	0xe9, 0x9e, 0x4b, 0xa2, 0x3d, 0xeb, 0x1b, 0x41, 0x8d, 0xa5, 0xa8, 0x5c, 0x52, 0x21, 0x67, 0x82,
+	0x61, 0x08, 0xa6, 0x64, 0x82, 0xf2, 0x53, 0x66, 0x93, 0x62, 0x41, 0xf5, 0xac, 0xaa, 0x45, 0xc9,
+	0x91, 0xfc, 0x94, 0x44, 0x0b, 0xaa, 0x5f, 0x58, 0xb9, 0x51, 0xae, 0xc4, 0x5a, 0x98, 0x1c, 0x5a,
+	0xe5, 0x33, 0x2b, 0x67, 0xdb, 0x10, 0xd2, 0xd5, 0x02, 0x29, 0x9f, 0x12, 0xbb, 0xb4, 0x6e, 0x6b,
+	0xb1, 0x90, 0xc8, 0x74, 0x4a, 0x70, 0x5d, 0xfc, 0x19, 0x40, 0x72, 0x50, 0x29, 0xbd, 0xaf, 0xa4,
+	0xe1, 0x1b, 0x63, 0x33, 0x82, 0x9d, 0x49, 0xaa, 0xcd, 0xd9, 0xac, 0x56, 0xca, 0x78, 0xda, 0x12,
+	0x8f, 0x11, 0xa5, 0x4c, 0xf6, 0x00, 0x6e, 0x48, 0xbe, 0x31, 0xb3, 0x73, 0x76, 0x8e, 0xca, 0xff,
+	0x58, 0xc5, 0x41, 0xcf, 0xf6, 0x1e, 0x4c, 0x19, 0x5f, 0xf1, 0x05, 0x35, 0xdc, 0xd9, 0x39, 0x82,
+	0xd3, 0x06, 0x44, 0xa3, 0xfb, 0xb0, 0x55, 0x52, 0xc9, 0x04, 0x6b, 0xad, 0x1c, 0xe7, 0xd3, 0x16,
+	0x45, 0x33, 0x5b, 0x1f, 0xaa, 0xb1, 0x18, 0xf9, 0xfa, 0x50, 0x5e, 0x59, 0xc0, 0x74, 0x2d, 0xa4,
+	0x99, 0x95, 0xd2, 0x38, 0x03, 0x57, 0x06, 0x89, 0x05, 0xf7, 0xa5, 0xb1, 0x36, 0xc5, 0xcf, 0x21,
+	0x24, 0xdf, 0xd8, 0x72, 0x7e, 0xc2, 0x29, 0xe3, 0xf5, 0xa5, 0xa9, 0x71, 0x17, 0x92, 0x8a, 0xba,
+	0x84, 0xb7, 0x2a, 0xe7, 0x16, 0x38, 0x08, 0x73, 0xf6, 0xf2, 0xda, 0xfd, 0x00, 0xa2, 0x52, 0x09,
+	0x39, 0xa7, 0xba, 0x49, 0x98, 0x56, 0x3e, 0x9f, 0x1d, 0xa3, 0x37, 0xb3, 0xa3, 0x1f, 0xfb, 0xf1,
+	0xf9, 0xd8, 0xfb, 0x08, 0x4e, 0x2e, 0x46, 0x30, 0xea, 0x22, 0x68, 0x4b, 0x5c, 0x9b, 0x96, 0x39,
+	0x97, 0x22, 0x31, 0x22, 0x48, 0xcc, 0x2d, 0x88, 0xcc, 0x46, 0x3b, 0xa5, 0x4b, 0x91, 0x89, 0xd9,
+	0x68, 0x54, 0xdd, 0x85, 0x84, 0x9f, 0x72, 0x69, 0xbc, 0xd6, 0x15, 0x27, 0x38, 0x08, 0x0d, 0xbe,
+	0x84, 0x94, 0x55, 0x4a, 0xcf, 0x4a, 0x97, 0x1c, 0x98, 0x38, 0xc9, 0xc3, 0xff, 0xb6, 0x19, 0xdc,
+	0xe5, 0x0d, 0x49, 0x58, 0x27, 0x64, 0xff, 0x83, 0x71, 0x4d, 0x25, 0x53, 0xeb, 0x7c, 0x8a, 0x67,
+	0x7a, 0xc9, 0x72, 0x37, 0x5f, 0x29, 0xb5, 0xce, 0xb7, 0x5c, 0x4d, 0xa1, 0x50, 0xfc, 0x1e, 0xc0,
+	0x08, 0xc3, 0x92, 0x7d, 0x0a, 0xe3, 0x25, 0x86, 0x26, 0x0f, 0xce, 0xdf, 0xd4, 0x8b, 0x1a, 0xf1,
+	0x26, 0xd9, 0x23, 0x48, 0x4d, 0x57, 0xe7, 0x3a, 0x1f, 0xec, 0x84, 0xfd, 0x2d, 0xbd, 0x1e, 0x40,
+	0xce, 0x19, 0xda, 0xd7, 0xf9, 0x66, 0xe6, 0x42, 0xe8, 0xa5, 0x6c, 0x0f, 0x62, 0x7e, 0x2a, 0x18,
+	0x97, 0x25, 0xd7, 0xf9, 0x10, 0x4f, 0xdb, 0x6e, 0x4e, 0x7b, 0xec, 0x15, 0xa4, 0x33, 0x29, 0x7e,
+	0x84, 0xf8, 0x39, 0x37, 0xf8, 0x34, 0xdd, 0xb6, 0x14, 0xdf, 0xa4, 0x8e, 0x6a, 0xef, 0x2e, 0x35,
+	0xa5, 0xcb, 0xa2, 0x21, 0x71, 0x42, 0x76, 0x1f, 0xc6, 0x38, 0x53, 0x74, 0x1e, 0xe2, 0x1d, 0xd3,
+	0x73, 0x4e, 0x12, 0xaf, 0xb4, 0xc1, 0x31, 0x54, 0xac, 0x9a, 0xbe, 0x3b, 0x74, 0x7d, 0xd7, 0x42,
+	0xae, 0xef, 0x16, 0xdf, 0x41, 0xd4, 0x5c, 0x7f, 0x8d, 0xdb, 0xef, 0x61, 0x08, 0xca, 0x63, 0xf4,
+	0xfd, 0xc2, 0xe5, 0x4e, 0x57, 0x7c, 0x0f, 0xe9, 0xfe, 0xf2, 0x44, 0x1e, 0x13, 0xfe, 0xea, 0x84,
+	0x6b, 0x73, 0xe9, 0xf1, 0x5b, 0x30, 0x10, 0xcc, 0x9f, 0x3d, 0x10, 0xcc, 0x5e, 0xa7, 0x0d, 0xad,
+	0x1b, 0x52, 0x9d, 0x60, 0x51, 0x1c, 0x93, 0xfe, 0xfd, 0x4e, 0x28, 0x24, 0x24, 0x78, 0xfe, 0x3b,
+	0xb8, 0xbb, 0xda, 0xf1, 0x1d, 0x97, 0xc3, 0x77, 0x70, 0x59, 0x3c, 0x87, 0xed, 0xc3, 0x5a, 0xf0,
+	0xe7, 0x8a, 0x71, 0x7d, 0x1d, 0x9f, 0x6c, 0xa6, 0x50, 0xbd, 0xe4, 0x2e, 0x54, 0x29, 0xf1, 0x52,
+	0xf1, 0x18, 0xe2, 0xf6, 0xbc, 0xab, 0xbe, 0x5e, 0x2a, 0xd6, 0x9e, 0xe3, 0x84, 0x42, 0xc3, 0xc4,
+	0xe5, 0xf4, 0xbf, 0xa1, 0x60, 0x0f, 0x26, 0xae, 0x20, 0x1a, 0x0e, 0x6e, 0x36, 0x1c, 0xbc, 0x14,
+	0x0b, 0xc9, 0x99, 0xaf, 0x9a, 0xc6, 0xa8, 0xf8, 0x35, 0x80, 0xf4, 0x99, 0x4d, 0xa0, 0x86, 0x08,
+	0x77, 0x4d, 0xd0, 0x5e, 0xd3, 0x8c, 0xdb, 0x41, 0x6f, 0xdc, 0x5e, 0x23, 0xb8, 0xb6, 0x1f, 0x21,
+	0xed, 0xae, 0x81, 0xba, 0x3e, 0x1d, 0x23, 0x82, 0xfd, 0x73, 0x1b, 0xc2, 0x63, 0x7e, 0xe6, 0xdb,
+	0xb3, 0x5d, 0x5a, 0x96, 0x8d, 0xaa, 0x44, 0xa9, 0xf3, 0xc9, 0x4e, 0xb8, 0x1b, 0x13, 0x2f, 0x15,
+	0x77, 0x20, 0x7e, 0x51, 0x2b, 0x75, 0x64, 0x69, 0xb6, 0xdb, 0x4e, 0xe9, 0x2a, 0x0f, 0x90, 0x3f,
+	0xbb, 0x2c, 0x7e, 0x0a, 0x00, 0xd0, 0x91, 0xc7, 0xb6, 0x61, 0x65, 0xff, 0x87, 0x89, 0xd9, 0xcc,
+	0x7a, 0xfd, 0x7c, 0x6c, 0x36, 0x4d, 0xc3, 0x16, 0x92, 0xf1, 0x0d, 0x3a, 0x14, 0x12, 0x27, 0x58,
+	0x14, 0xaf, 0x41, 0x8f, 0x62, 0xe2, 0x04, 0xeb, 0x3b, 0x8e, 0xea, 0xa1, 0xf3, 0xdd, 0xae, 0xb3,
+	0x4f, 0x60, 0x54, 0xd9, 0x67, 0xe4, 0x23, 0xa4, 0xf7, 0x46, 0x43, 0x6f, 0xfb, 0x36, 0xe2, 0xf4,
+	0xc5, 0x5f, 0x01, 0x4c, 0x3d, 0xb3, 0xba, 0x52, 0x52, 0xf3, 0x0b, 0xd4, 0xde, 0x84, 0x11, 0xaf,
+	0x6b, 0x55, 0x7b, 0x6e, 0x9d, 0xd0, 0x8f, 0x60, 0x78, 0x85, 0x08, 0xbe, 0x41, 0xf0, 0xf0, 0x4d,
+	0x82, 0xdb, 0x0f, 0x97, 0x51, 0xff, 0xc3, 0xa5, 0xf5, 0x62, 0xfc, 0x6e, 0x2f, 0xb2, 0x07, 0x30,
+	0x76, 0x13, 0x00, 0xa3, 0x91, 0x3c, 0xcc, 0x1a, 0xcb, 0x8e, 0x6b, 0xe2, 0x2d, 0x8a, 0x47, 0x30,
+	0x3d, 0x50, 0x3f, 0x48, 0xfb, 0xdd, 0xd5, 0xf6, 0xa1, 0xcb, 0x3e, 0xb6, 0x70, 0x66, 0x0d, 0x7a,
+	0x5f, 0x1d, 0xc7, 0x90, 0xf6, 0x7d, 0xbb, 0x5e, 0xe3, 0xdf, 0x86, 0xd0, 0x6c, 0x5c, 0xbf, 0x4f,
+	0x89, 0x5d, 0xda, 0x09, 0xdb, 0x75, 0x6e, 0x57, 0x62, 0x1d, 0x50, 0x30, 0x88, 0x9a, 0xf6, 0x9d,
+	0x3d, 0x80, 0xd1, 0x91, 0xa8, 0xb5, 0xf1, 0xf7, 0x5c, 0xce, 0xb4, 0x33, 0xc9, 0x3e, 0x83, 0xb1,
+	0xe6, 0xa5, 0x92, 0xae, 0x06, 0xdf, 0x66, 0xec, 0x6d, 0x8a, 0x5f, 0x02, 0x48, 0xf7, 0xd5, 0xba,
+	0xa2, 0xa5, 0x79, 0x8f, 0x61, 0xd6, 0xcd, 0xa4, 0xc1, 0xdb, 0x67, 0x52, 0xf8, 0x8f, 0x33, 0xc9,
+	0x7e, 0x23, 0xe9, 0xa5, 0xaa, 0xcd, 0x4c, 0x30, 0xd7, 0x0f, 0x52, 0x12, 0x21, 0xf0, 0x94, 0xe9,
+	0xe2, 0x2b, 0x48, 0xbe, 0xf5, 0x13, 0xe3, 0x70, 0xa3, 0x2f, 0x0d, 0x56, 0x0e, 0x13, 0xac, 0x0f,
+	0xee, 0xf8, 0x9d, 0x92, 0x46, 0x2c, 0x5e, 0x41, 0xf4, 0x7e, 0x3b, 0x2f, 0x0c, 0xea, 0xf0, 0x8a,
+	0x83, 0xba, 0x28, 0x20, 0x3a, 0xc4, 0x1a, 0xe6, 0xba, 0xd7, 0x8a, 0x83, 0x7e, 0x2b, 0x9e, 0x8f,
+	0xf1, 0xcf, 0xec, 0xf3, 0xbf, 0x07, 0x00, 0x1b, 0xdc, 0x6c, 0x30, 0xa8, 0x0d, 0x00, 0x00,
 }
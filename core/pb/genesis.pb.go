@@ -12,7 +12,13 @@ It has these top-level messages:
 	GenesisMeta
 	GenesisConsensus
 	GenesisConsensusDpos
+	BlockIntervalFork
 	GenesisTokenDistribution
+	GasTableFork
+	MathLibFork
+	ExecutionLimitsFork
+	ExpressionGas
+	GenesisStorageRent
 */
 package corepb
 
@@ -39,6 +45,25 @@ type Genesis struct {
 	// genesis token distribution address
 	// map<string, string> token_distribution = 3;
 	TokenDistribution []*GenesisTokenDistribution `protobuf:"bytes,3,rep,name=token_distribution,json=tokenDistribution" json:"token_distribution,omitempty"`
+	// scheduled repricing of the nvm gas, ordered by height.
+	GasTableForks []*GasTableFork `protobuf:"bytes,4,rep,name=gas_table_forks,json=gasTableForks" json:"gas_table_forks,omitempty"`
+	// rent of contract storage, disabled if not set.
+	StorageRent *GenesisStorageRent `protobuf:"bytes,5,opt,name=storage_rent,json=storageRent" json:"storage_rent,omitempty"`
+	// scheduled versions of the contract math library, ordered by height.
+	MathLibForks []*MathLibFork `protobuf:"bytes,6,rep,name=math_lib_forks,json=mathLibForks" json:"math_lib_forks,omitempty"`
+	// scheduled limits of each contract call, ordered by height.
+	ExecutionLimitsForks []*ExecutionLimitsFork `protobuf:"bytes,7,rep,name=execution_limits_forks,json=executionLimitsForks" json:"execution_limits_forks,omitempty"`
+	// addresses allowed to answer the oracle requests of contracts.
+	OracleOperators []string `protobuf:"bytes,8,rep,name=oracle_operators,json=oracleOperators" json:"oracle_operators,omitempty"`
+	// contracts run in the deterministic sandbox from the block height, from the genesis if 0.
+	SandboxHeight uint64 `protobuf:"varint,9,opt,name=sandbox_height,json=sandboxHeight,proto3" json:"sandbox_height,omitempty"`
+	// the block reward is shared with the voters of the miner from the block height, the coinbase takes it all if 0.
+	CommissionHeight uint64 `protobuf:"varint,10,opt,name=commission_height,json=commissionHeight,proto3" json:"commission_height,omitempty"`
+	// the blocks carry the VRF random of their proposer, shuffling the proposers of the rounds, from the block height.
+	// the proposers take turns in the dynasty's order and the blocks carry no random if 0.
+	RandomHeight uint64 `protobuf:"varint,11,opt,name=random_height,json=randomHeight,proto3" json:"random_height,omitempty"`
+	// the TypeScript contracts are type checked when deployed from the block height, only transpiled if 0.
+	TypecheckHeight uint64 `protobuf:"varint,12,opt,name=typecheck_height,json=typecheckHeight,proto3" json:"typecheck_height,omitempty"`
 }
 
 func (m *Genesis) Reset()                    { *m = Genesis{} }
@@ -67,6 +92,69 @@ func (m *Genesis) GetTokenDistribution() []*GenesisTokenDistribution {
 	return nil
 }
 
+func (m *Genesis) GetGasTableForks() []*GasTableFork {
+	if m != nil {
+		return m.GasTableForks
+	}
+	return nil
+}
+
+func (m *Genesis) GetStorageRent() *GenesisStorageRent {
+	if m != nil {
+		return m.StorageRent
+	}
+	return nil
+}
+
+func (m *Genesis) GetMathLibForks() []*MathLibFork {
+	if m != nil {
+		return m.MathLibForks
+	}
+	return nil
+}
+
+func (m *Genesis) GetExecutionLimitsForks() []*ExecutionLimitsFork {
+	if m != nil {
+		return m.ExecutionLimitsForks
+	}
+	return nil
+}
+
+func (m *Genesis) GetOracleOperators() []string {
+	if m != nil {
+		return m.OracleOperators
+	}
+	return nil
+}
+
+func (m *Genesis) GetSandboxHeight() uint64 {
+	if m != nil {
+		return m.SandboxHeight
+	}
+	return 0
+}
+
+func (m *Genesis) GetCommissionHeight() uint64 {
+	if m != nil {
+		return m.CommissionHeight
+	}
+	return 0
+}
+
+func (m *Genesis) GetRandomHeight() uint64 {
+	if m != nil {
+		return m.RandomHeight
+	}
+	return 0
+}
+
+func (m *Genesis) GetTypecheckHeight() uint64 {
+	if m != nil {
+		return m.TypecheckHeight
+	}
+	return 0
+}
+
 type GenesisMeta struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -104,6 +192,11 @@ func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 type GenesisConsensusDpos struct {
 	// dpos genesis dynasty address
 	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
+	// whole seconds between blocks, 5 if not set, must divide the dynasty interval.
+	// sub-second intervals are not supported, the block timestamps are in seconds.
+	BlockInterval uint32 `protobuf:"varint,2,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// scheduled changes of the block interval.
+	BlockIntervalForks []*BlockIntervalFork `protobuf:"bytes,3,rep,name=block_interval_forks,json=blockIntervalForks" json:"block_interval_forks,omitempty"`
 }
 
 func (m *GenesisConsensusDpos) Reset()                    { *m = GenesisConsensusDpos{} }
@@ -118,6 +211,46 @@ func (m *GenesisConsensusDpos) GetDynasty() []string {
 	return nil
 }
 
+func (m *GenesisConsensusDpos) GetBlockInterval() uint32 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetBlockIntervalForks() []*BlockIntervalFork {
+	if m != nil {
+		return m.BlockIntervalForks
+	}
+	return nil
+}
+
+type BlockIntervalFork struct {
+	// the change takes effect from the timestamp, which must start a dynasty.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// whole seconds between blocks from the timestamp.
+	BlockInterval uint32 `protobuf:"varint,2,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+}
+
+func (m *BlockIntervalFork) Reset()                    { *m = BlockIntervalFork{} }
+func (m *BlockIntervalFork) String() string            { return proto.CompactTextString(m) }
+func (*BlockIntervalFork) ProtoMessage()               {}
+func (*BlockIntervalFork) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+
+func (m *BlockIntervalFork) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockIntervalFork) GetBlockInterval() uint32 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
 type GenesisTokenDistribution struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -126,7 +259,7 @@ type GenesisTokenDistribution struct {
 func (m *GenesisTokenDistribution) Reset()                    { *m = GenesisTokenDistribution{} }
 func (m *GenesisTokenDistribution) String() string            { return proto.CompactTextString(m) }
 func (*GenesisTokenDistribution) ProtoMessage()               {}
-func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{5} }
 
 func (m *GenesisTokenDistribution) GetAddress() string {
 	if m != nil {
@@ -142,33 +275,305 @@ func (m *GenesisTokenDistribution) GetValue() string {
 	return ""
 }
 
+type GasTableFork struct {
+	// version of the gas table, increasing with each repricing.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the table takes effect from the block height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// changed gas of the tracked expressions, the others keep the gas of the previous table.
+	Expressions []*ExpressionGas `protobuf:"bytes,3,rep,name=expressions" json:"expressions,omitempty"`
+	// gas of each byte of the storage key and value, unchanged if 0.
+	StorageByte uint32 `protobuf:"varint,4,opt,name=storage_byte,json=storageByte,proto3" json:"storage_byte,omitempty"`
+	// gas of each byte of the event data, unchanged if 0.
+	EventByte uint32 `protobuf:"varint,5,opt,name=event_byte,json=eventByte,proto3" json:"event_byte,omitempty"`
+	// base gas of each event, unchanged if 0.
+	EventBase uint32 `protobuf:"varint,6,opt,name=event_base,json=eventBase,proto3" json:"event_base,omitempty"`
+	// max bytes of the contract source deployed or upgraded, unchanged if 0.
+	MaxCodeSize uint32 `protobuf:"varint,7,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// gas of each byte of the contract source, unchanged if 0.
+	CodeByte uint32 `protobuf:"varint,8,opt,name=code_byte,json=codeByte,proto3" json:"code_byte,omitempty"`
+	// the square of the source size divided by it is added to the gas of the source, unchanged if 0.
+	CodeQuadDivisor uint32 `protobuf:"varint,9,opt,name=code_quad_divisor,json=codeQuadDivisor,proto3" json:"code_quad_divisor,omitempty"`
+	// gas refunded for each byte of storage freed by a self-destructed contract, unchanged if 0.
+	DestructRefundByte uint32 `protobuf:"varint,10,opt,name=destruct_refund_byte,json=destructRefundByte,proto3" json:"destruct_refund_byte,omitempty"`
+}
+
+func (m *GasTableFork) Reset()                    { *m = GasTableFork{} }
+func (m *GasTableFork) String() string            { return proto.CompactTextString(m) }
+func (*GasTableFork) ProtoMessage()               {}
+func (*GasTableFork) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{6} }
+
+func (m *GasTableFork) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GasTableFork) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasTableFork) GetExpressions() []*ExpressionGas {
+	if m != nil {
+		return m.Expressions
+	}
+	return nil
+}
+
+func (m *GasTableFork) GetStorageByte() uint32 {
+	if m != nil {
+		return m.StorageByte
+	}
+	return 0
+}
+
+func (m *GasTableFork) GetEventByte() uint32 {
+	if m != nil {
+		return m.EventByte
+	}
+	return 0
+}
+
+func (m *GasTableFork) GetEventBase() uint32 {
+	if m != nil {
+		return m.EventBase
+	}
+	return 0
+}
+
+func (m *GasTableFork) GetMaxCodeSize() uint32 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *GasTableFork) GetCodeByte() uint32 {
+	if m != nil {
+		return m.CodeByte
+	}
+	return 0
+}
+
+func (m *GasTableFork) GetCodeQuadDivisor() uint32 {
+	if m != nil {
+		return m.CodeQuadDivisor
+	}
+	return 0
+}
+
+func (m *GasTableFork) GetDestructRefundByte() uint32 {
+	if m != nil {
+		return m.DestructRefundByte
+	}
+	return 0
+}
+
+type MathLibFork struct {
+	// version of the Decimal library in contracts, frozen once scheduled.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the version takes effect from the block height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MathLibFork) Reset()                    { *m = MathLibFork{} }
+func (m *MathLibFork) String() string            { return proto.CompactTextString(m) }
+func (*MathLibFork) ProtoMessage()               {}
+func (*MathLibFork) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{7} }
+
+func (m *MathLibFork) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MathLibFork) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ExecutionLimitsFork struct {
+	// the limits take effect from the block height, a fork at 0 replaces the defaults.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// wall-clock milliseconds after which a call is terminated, unchanged if 0.
+	TimeoutMs uint64 `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// max instructions counted in a call whatever its gas limit, unchanged if 0.
+	MaxInstructions uint64 `protobuf:"varint,3,opt,name=max_instructions,json=maxInstructions,proto3" json:"max_instructions,omitempty"`
+	// max V8 heap bytes of a call, at least 6000000, unchanged if 0.
+	MaxMemorySize uint64 `protobuf:"varint,4,opt,name=max_memory_size,json=maxMemorySize,proto3" json:"max_memory_size,omitempty"`
+	// max number of contracts on the stack of nested calls, unchanged if 0.
+	MaxCallDepth uint64 `protobuf:"varint,5,opt,name=max_call_depth,json=maxCallDepth,proto3" json:"max_call_depth,omitempty"`
+}
+
+func (m *ExecutionLimitsFork) Reset()                    { *m = ExecutionLimitsFork{} }
+func (m *ExecutionLimitsFork) String() string            { return proto.CompactTextString(m) }
+func (*ExecutionLimitsFork) ProtoMessage()               {}
+func (*ExecutionLimitsFork) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{8} }
+
+func (m *ExecutionLimitsFork) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecutionLimitsFork) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+func (m *ExecutionLimitsFork) GetMaxInstructions() uint64 {
+	if m != nil {
+		return m.MaxInstructions
+	}
+	return 0
+}
+
+func (m *ExecutionLimitsFork) GetMaxMemorySize() uint64 {
+	if m != nil {
+		return m.MaxMemorySize
+	}
+	return 0
+}
+
+func (m *ExecutionLimitsFork) GetMaxCallDepth() uint64 {
+	if m != nil {
+		return m.MaxCallDepth
+	}
+	return 0
+}
+
+type ExpressionGas struct {
+	// type of the syntax node, e.g. CallExpression.
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Gas        uint32 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *ExpressionGas) Reset()                    { *m = ExpressionGas{} }
+func (m *ExpressionGas) String() string            { return proto.CompactTextString(m) }
+func (*ExpressionGas) ProtoMessage()               {}
+func (*ExpressionGas) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{9} }
+
+func (m *ExpressionGas) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *ExpressionGas) GetGas() uint32 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+type GenesisStorageRent struct {
+	// the rent is charged from the block height.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// wei charged for each byte of storage in each block.
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *GenesisStorageRent) Reset()                    { *m = GenesisStorageRent{} }
+func (m *GenesisStorageRent) String() string            { return proto.CompactTextString(m) }
+func (*GenesisStorageRent) ProtoMessage()               {}
+func (*GenesisStorageRent) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{10} }
+
+func (m *GenesisStorageRent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GenesisStorageRent) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Genesis)(nil), "corepb.Genesis")
 	proto.RegisterType((*GenesisMeta)(nil), "corepb.GenesisMeta")
 	proto.RegisterType((*GenesisConsensus)(nil), "corepb.GenesisConsensus")
 	proto.RegisterType((*GenesisConsensusDpos)(nil), "corepb.GenesisConsensusDpos")
+	proto.RegisterType((*BlockIntervalFork)(nil), "corepb.BlockIntervalFork")
 	proto.RegisterType((*GenesisTokenDistribution)(nil), "corepb.GenesisTokenDistribution")
+	proto.RegisterType((*GasTableFork)(nil), "corepb.GasTableFork")
+	proto.RegisterType((*MathLibFork)(nil), "corepb.MathLibFork")
+	proto.RegisterType((*ExecutionLimitsFork)(nil), "corepb.ExecutionLimitsFork")
+	proto.RegisterType((*ExpressionGas)(nil), "corepb.ExpressionGas")
+	proto.RegisterType((*GenesisStorageRent)(nil), "corepb.GenesisStorageRent")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x9d, 0xab, 0xbd, 0x65, 0xa0, 0x71, 0x0f, 0x11, 0x7c, 0x28, 0x79, 0xb1, 0x4f,
-	0x65, 0x4c, 0xf0, 0x0f, 0x58, 0x10, 0x05, 0x11, 0x82, 0xef, 0x23, 0x6d, 0x2e, 0x1a, 0xd4, 0xa4,
-	0xf4, 0xa6, 0xc2, 0x7e, 0x9b, 0x7f, 0x4e, 0x96, 0xae, 0x38, 0x8a, 0x7b, 0x3c, 0x39, 0x5f, 0x2e,
-	0xe7, 0xdc, 0x0b, 0x8b, 0x37, 0xb4, 0x48, 0x86, 0xca, 0xb6, 0x73, 0xde, 0xb1, 0x79, 0xe3, 0x3a,
-	0x6c, 0x6b, 0xf1, 0x13, 0x41, 0xf2, 0x30, 0x38, 0xec, 0x06, 0x66, 0x5f, 0xe8, 0x15, 0x8f, 0xf2,
-	0xa8, 0xc8, 0xd6, 0x97, 0xe5, 0x80, 0x94, 0x7b, 0xfb, 0x19, 0xbd, 0x92, 0x01, 0x60, 0x77, 0x90,
-	0x36, 0xce, 0x12, 0x5a, 0xea, 0x89, 0x9f, 0x04, 0x9a, 0x4f, 0xe8, 0xfb, 0xd1, 0x97, 0x7f, 0x28,
-	0x7b, 0x01, 0xe6, 0xdd, 0x07, 0xda, 0x8d, 0x36, 0xe4, 0x3b, 0x53, 0xf7, 0xde, 0x38, 0xcb, 0xe3,
-	0x3c, 0x2e, 0xb2, 0x75, 0x3e, 0x19, 0xf0, 0xba, 0x03, 0xab, 0x03, 0x4e, 0x5e, 0xf8, 0xe9, 0x93,
-	0x28, 0x20, 0x3b, 0x48, 0xc7, 0xae, 0xe0, 0xac, 0x79, 0x57, 0xc6, 0x6e, 0x8c, 0x0e, 0x25, 0x16,
-	0x32, 0x09, 0xfa, 0x51, 0x8b, 0x0a, 0xce, 0xa7, 0xc9, 0xd8, 0x0a, 0x66, 0xba, 0x75, 0xb4, 0xef,
-	0x7b, 0x7d, 0xac, 0x41, 0xd5, 0x3a, 0x92, 0x81, 0x14, 0x2b, 0x58, 0xfe, 0xe7, 0x32, 0x0e, 0x89,
-	0xde, 0x5a, 0x45, 0x7e, 0xcb, 0xa3, 0x3c, 0x2e, 0x52, 0x39, 0x4a, 0xf1, 0x04, 0xfc, 0x58, 0xa1,
-	0xdd, 0x2f, 0xa5, 0x75, 0x87, 0x34, 0x44, 0x48, 0xe5, 0x28, 0xd9, 0x12, 0x4e, 0xbf, 0xd5, 0x67,
-	0x8f, 0x61, 0xb9, 0xa9, 0x1c, 0x44, 0x3d, 0x0f, 0xa7, 0xbb, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbe, 0xf4, 0x0e, 0x3f, 0xcb, 0x01, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x6f, 0x6f, 0x1b, 0x45,
+	0x10, 0xc6, 0xe5, 0xd8, 0xb1, 0xe3, 0xb1, 0xaf, 0x71, 0xb6, 0xa6, 0xba, 0xd2, 0x16, 0x99, 0xe3,
+	0x9f, 0x0b, 0x52, 0x54, 0x15, 0x09, 0x84, 0x04, 0x42, 0x24, 0x86, 0x12, 0x68, 0x54, 0xf5, 0xda,
+	0x17, 0xbc, 0x3b, 0xed, 0xdd, 0x4d, 0xed, 0x95, 0xef, 0x6e, 0x8f, 0xdd, 0xb5, 0x65, 0xf7, 0x7b,
+	0xf0, 0x0d, 0xf8, 0x2a, 0x7c, 0x28, 0xde, 0xa1, 0x9d, 0xdb, 0x8b, 0x2f, 0x4e, 0x23, 0xd1, 0x77,
+	0x99, 0x67, 0x7e, 0x99, 0xdd, 0x9b, 0x7d, 0x66, 0x0c, 0xde, 0x1c, 0x0b, 0xd4, 0x42, 0x9f, 0x96,
+	0x4a, 0x1a, 0xc9, 0xba, 0x89, 0x54, 0x58, 0xc6, 0xc1, 0x5f, 0x87, 0xd0, 0x7b, 0x56, 0x65, 0xd8,
+	0x17, 0xd0, 0xc9, 0xd1, 0x70, 0xbf, 0x35, 0x69, 0x4d, 0x07, 0x4f, 0xef, 0x9e, 0x56, 0xc8, 0xa9,
+	0x4b, 0x5f, 0xa2, 0xe1, 0x21, 0x01, 0xec, 0x1b, 0xe8, 0x27, 0xb2, 0xd0, 0x58, 0xe8, 0x95, 0xf6,
+	0x0f, 0x88, 0xf6, 0xf7, 0xe8, 0xf3, 0x3a, 0x1f, 0xee, 0x50, 0xf6, 0x02, 0x98, 0x91, 0x4b, 0x2c,
+	0xa2, 0x54, 0x68, 0xa3, 0x44, 0xbc, 0x32, 0x42, 0x16, 0x7e, 0x7b, 0xd2, 0x9e, 0x0e, 0x9e, 0x4e,
+	0xf6, 0x0a, 0xbc, 0xb6, 0xe0, 0xac, 0xc1, 0x85, 0x27, 0x66, 0x5f, 0x62, 0xdf, 0xc3, 0xf1, 0x9c,
+	0xeb, 0xc8, 0xf0, 0x38, 0xc3, 0xe8, 0x8d, 0x54, 0x4b, 0xed, 0x77, 0xa8, 0xda, 0xf8, 0xaa, 0x1a,
+	0xd7, 0xaf, 0x6d, 0xf6, 0x17, 0xa9, 0x96, 0xa1, 0x37, 0x6f, 0x44, 0x9a, 0xfd, 0x00, 0x43, 0x6d,
+	0xa4, 0xe2, 0x73, 0x8c, 0x14, 0x16, 0xc6, 0x3f, 0xa4, 0x2f, 0xf9, 0x70, 0xef, 0x22, 0xaf, 0x2a,
+	0x24, 0xc4, 0xc2, 0x84, 0x03, 0xbd, 0x0b, 0xd8, 0x77, 0x70, 0x27, 0xe7, 0x66, 0x11, 0x65, 0x22,
+	0x76, 0x67, 0x77, 0x27, 0xed, 0x66, 0xe3, 0x2e, 0xb9, 0x59, 0x3c, 0x17, 0x31, 0x1d, 0x3d, 0xcc,
+	0x77, 0x81, 0x66, 0x2f, 0xe1, 0x1e, 0x6e, 0x30, 0xa1, 0x8f, 0x88, 0x32, 0x91, 0x0b, 0xa3, 0x5d,
+	0x89, 0x1e, 0x95, 0x78, 0x50, 0x97, 0xf8, 0xb9, 0xa6, 0x9e, 0x13, 0x44, 0xa5, 0xc6, 0x78, 0x53,
+	0xd4, 0xec, 0x31, 0x8c, 0xa4, 0xe2, 0x49, 0x86, 0x91, 0x2c, 0x51, 0x71, 0x23, 0x95, 0xf6, 0x8f,
+	0x26, 0xed, 0x69, 0x3f, 0x3c, 0xae, 0xf4, 0x17, 0xb5, 0xcc, 0x3e, 0x83, 0x3b, 0x9a, 0x17, 0x69,
+	0x2c, 0x37, 0xd1, 0x02, 0xc5, 0x7c, 0x61, 0xfc, 0xfe, 0xa4, 0x35, 0xed, 0x84, 0x9e, 0x53, 0x7f,
+	0x25, 0x91, 0x7d, 0x05, 0x27, 0x89, 0xcc, 0x73, 0xa1, 0xb5, 0xbd, 0xa5, 0x23, 0x81, 0xc8, 0xd1,
+	0x2e, 0xe1, 0xe0, 0x4f, 0xc0, 0x53, 0xbc, 0x48, 0x65, 0x5e, 0x83, 0x03, 0x02, 0x87, 0x95, 0xe8,
+	0xa0, 0xc7, 0x30, 0x32, 0xdb, 0x12, 0x93, 0x05, 0x26, 0xcb, 0x9a, 0x1b, 0x12, 0x77, 0x7c, 0xa5,
+	0x57, 0x68, 0x30, 0x85, 0x41, 0xc3, 0x77, 0xec, 0x3e, 0x1c, 0x25, 0x0b, 0x2e, 0x8a, 0x48, 0xa4,
+	0x64, 0x4f, 0x2f, 0xec, 0x51, 0x7c, 0x91, 0x06, 0x33, 0x18, 0xed, 0x7b, 0x8e, 0x3d, 0x81, 0x4e,
+	0x5a, 0x4a, 0xed, 0x9c, 0xfc, 0xf0, 0x36, 0x6f, 0xce, 0x4a, 0xa9, 0x43, 0x22, 0x83, 0xbf, 0x5b,
+	0x30, 0x7e, 0x57, 0x9a, 0xf9, 0xd0, 0x4b, 0xb7, 0x05, 0xd7, 0x66, 0xeb, 0xb7, 0xa8, 0x9d, 0x75,
+	0x68, 0xdb, 0x18, 0x67, 0x32, 0x59, 0x46, 0xa2, 0x30, 0xa8, 0xd6, 0x3c, 0xa3, 0x51, 0xf0, 0x42,
+	0x8f, 0xd4, 0x0b, 0x27, 0xb2, 0xdf, 0x61, 0x7c, 0x1d, 0x73, 0x2f, 0x5d, 0xd9, 0xfe, 0x7e, 0x7d,
+	0xb7, 0xb3, 0xe6, 0x3f, 0xd1, 0x3b, 0xb3, 0x78, 0x5f, 0xd2, 0xc1, 0x1f, 0x70, 0x72, 0x03, 0x64,
+	0x0f, 0xa1, 0x6f, 0x44, 0x8e, 0xda, 0xf0, 0xbc, 0xa4, 0x4f, 0x6e, 0x87, 0x3b, 0xe1, 0x7f, 0x5e,
+	0x33, 0xf8, 0x0d, 0xfc, 0xdb, 0x26, 0xcf, 0xf6, 0x80, 0xa7, 0xa9, 0x42, 0x5d, 0x75, 0xb4, 0x1f,
+	0xd6, 0x21, 0x1b, 0xc3, 0xe1, 0x9a, 0x67, 0x2b, 0xa4, 0x9a, 0xfd, 0xb0, 0x0a, 0x82, 0x7f, 0x0f,
+	0x60, 0xd8, 0x1c, 0x3c, 0x5b, 0x60, 0x8d, 0xca, 0xda, 0xa5, 0x7e, 0x3d, 0x17, 0xb2, 0x7b, 0xd0,
+	0x75, 0x46, 0x38, 0x20, 0x23, 0xb8, 0x88, 0x7d, 0x0b, 0x03, 0xdc, 0x94, 0xf6, 0x0c, 0x21, 0x8b,
+	0xba, 0x59, 0x1f, 0xec, 0xc6, 0xa2, 0x4e, 0x3d, 0xe3, 0x3a, 0x6c, 0x92, 0xec, 0xe3, 0xdd, 0x50,
+	0xc7, 0x5b, 0x83, 0x7e, 0x87, 0xce, 0xab, 0x07, 0xf7, 0x6c, 0x6b, 0x90, 0x3d, 0x02, 0xc0, 0x35,
+	0x16, 0xa6, 0x02, 0x0e, 0x09, 0xe8, 0x93, 0xb2, 0x97, 0xe6, 0x1a, 0xfd, 0x6e, 0x33, 0xcd, 0x35,
+	0xb2, 0x00, 0xbc, 0x9c, 0x6f, 0xa2, 0x44, 0xa6, 0x18, 0x69, 0xf1, 0x16, 0xfd, 0x5e, 0x75, 0x42,
+	0xce, 0x37, 0xe7, 0x32, 0xc5, 0x57, 0xe2, 0x2d, 0xb2, 0x07, 0x76, 0x41, 0xa6, 0xee, 0x06, 0x47,
+	0x94, 0x3f, 0xb2, 0x02, 0xd5, 0xff, 0xd2, 0xce, 0x55, 0x8a, 0xd1, 0x9f, 0x2b, 0x9e, 0x46, 0xa9,
+	0x58, 0x0b, 0x2d, 0x15, 0x4d, 0xa0, 0x17, 0x1e, 0xdb, 0xc4, 0xcb, 0x15, 0x4f, 0x67, 0x95, 0xcc,
+	0x9e, 0xc0, 0x38, 0x45, 0x6d, 0xd4, 0x2a, 0x31, 0x91, 0xc2, 0x37, 0xab, 0x22, 0xad, 0x6a, 0x02,
+	0xe1, 0xac, 0xce, 0x85, 0x94, 0xb2, 0xd5, 0x83, 0x1f, 0x61, 0xd0, 0xd8, 0x3b, 0xef, 0xdf, 0xf9,
+	0xe0, 0x9f, 0x16, 0xdc, 0x7d, 0xc7, 0xda, 0x69, 0xf0, 0xad, 0x6b, 0x2f, 0xf5, 0x08, 0xc0, 0x9a,
+	0x4d, 0xae, 0x4c, 0x94, 0x6b, 0x57, 0xab, 0xef, 0x94, 0x4b, 0xda, 0x4b, 0xb6, 0x5d, 0xa2, 0xa8,
+	0x6e, 0xea, 0x5e, 0x93, 0x66, 0x3e, 0xe7, 0x9b, 0x8b, 0x86, 0xcc, 0x3e, 0x07, 0x2b, 0x45, 0x39,
+	0xe6, 0x52, 0x6d, 0xab, 0xde, 0x76, 0xaa, 0xc5, 0x94, 0xf3, 0xcd, 0x25, 0xa9, 0xd4, 0xdd, 0x4f,
+	0xed, 0xe2, 0xdd, 0x44, 0x09, 0xcf, 0xb2, 0x28, 0xc5, 0xd2, 0x2c, 0xe8, 0x0d, 0x3b, 0x76, 0xc7,
+	0x6e, 0xce, 0x79, 0x96, 0xcd, 0xac, 0x16, 0xfc, 0x04, 0xde, 0x35, 0x9b, 0xb0, 0x8f, 0x00, 0x76,
+	0x46, 0x71, 0x46, 0x6e, 0x28, 0x6c, 0x04, 0xed, 0x39, 0xd7, 0x6e, 0x3a, 0xec, 0x9f, 0xc1, 0x19,
+	0xb0, 0x9b, 0x3f, 0x02, 0xb7, 0x36, 0x62, 0x0c, 0x87, 0xa5, 0x12, 0xc9, 0xd5, 0x2c, 0x50, 0x10,
+	0x77, 0xe9, 0xf7, 0xf6, 0xeb, 0xff, 0x06, 0x00, 0x68, 0x91, 0x8b, 0x85, 0x80, 0x07, 0x00, 0x00,
 }
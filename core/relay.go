@@ -0,0 +1,145 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// RelayKey in storage, set once the node runs in relay mode, its storage has no world state from then on.
+const RelayKey = "blockchain_relay"
+
+// SetRelayMode make the chain link the blocks by their headers without executing them, each signed by the
+// proposer of its slot in the dynasty its verified parent elected, and the blocks at the heights of the
+// checkpoints must be the ones of the checkpoints. The world state after the tail is not kept, so the
+// storage stays a relay one.
+func (bc *BlockChain) SetRelayMode(checkpoints map[uint64]byteutils.Hash) error {
+	if err := bc.storage.Put([]byte(RelayKey), []byte{1}); err != nil {
+		return err
+	}
+	bc.relay = true
+	bc.checkpoints = checkpoints
+
+	logging.CLog().WithFields(logrus.Fields{
+		"checkpoints": len(checkpoints),
+	}).Info("Running in relay mode, the blocks are not executed.")
+	return nil
+}
+
+// RelayMode return if the chain links the blocks without executing them.
+func (bc *BlockChain) RelayMode() bool {
+	return bc.relay
+}
+
+func (bc *BlockChain) loadRelayFromStorage() bool {
+	_, err := bc.storage.Get([]byte(RelayKey))
+	return err == nil
+}
+
+// loadBlock return the block of the hash from the storage, without its world state in relay mode.
+func (bc *BlockChain) loadBlock(hash byteutils.Hash) (*Block, error) {
+	var (
+		block *Block
+		err   error
+	)
+	if bc.relay {
+		block, err = loadBlockHeaderFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+	} else {
+		block, err = LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	block.blockIntervals = bc.blockIntervals
+	block.commissionHeight = bc.commissionHeight
+	block.randomHeight = bc.randomHeight
+	return block, nil
+}
+
+// parentHeader return the parent block, loaded without its tries if it's not linked, enough to read its header.
+func (block *Block) parentHeader() (*Block, error) {
+	if block.parenetBlock != nil {
+		return block.parenetBlock, nil
+	}
+	parentBlock, err := loadBlockHeaderFromStorage(block.ParentHash(), block.storage, block.txPool, block.eventEmitter)
+	if err != nil {
+		return nil, ErrMissingParentBlock
+	}
+	parentBlock.blockIntervals = block.blockIntervals
+	parentBlock.commissionHeight = block.commissionHeight
+	parentBlock.randomHeight = block.randomHeight
+	return parentBlock, nil
+}
+
+// loadBlockHeaderFromStorage return a block from storage without its tries.
+func loadBlockHeaderFromStorage(hash byteutils.Hash, storage storage.Storage, txPool *TransactionPool, eventEmitter *EventEmitter) (*Block, error) {
+	value, err := storage.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	pbBlock := new(corepb.Block)
+	block := new(Block)
+	if err = proto.Unmarshal(value, pbBlock); err != nil {
+		return nil, err
+	}
+	if err = block.FromProto(pbBlock); err != nil {
+		return nil, err
+	}
+	block.txPool = txPool
+	block.storage = storage
+	block.sealed = true
+	block.eventEmitter = eventEmitter
+	return block, nil
+}
+
+// linkRelayBlock link the block to its parent without executing it. Its header is verified like the headers of
+// the fast sync, signed by the proposer of its slot in the dynasty of its parent, whose tries must be in the storage,
+// so the state roots it carries are the ones its proposer signed. Its hash and signatures were checked by VerifyIntegrity.
+func (block *Block) linkRelayBlock(parentBlock *Block, checkpoints map[uint64]byteutils.Hash) error {
+	if !block.ParentHash().Equals(parentBlock.Hash()) {
+		return ErrLinkToWrongParentBlock
+	}
+	height := parentBlock.height + 1
+	if checkpoint, ok := checkpoints[height]; ok && !checkpoint.Equals(block.Hash()) {
+		return ErrRelayCheckpointMismatch
+	}
+	if err := NewHeaderVerifier(parentBlock).Verify(NewHeader(block)); err != nil {
+		return err
+	}
+
+	block.txPool = parentBlock.txPool
+	block.parenetBlock = parentBlock
+	block.storage = parentBlock.storage
+	block.height = height
+	block.eventEmitter = parentBlock.eventEmitter
+	block.blockIntervals = parentBlock.blockIntervals
+	block.commissionHeight = parentBlock.commissionHeight
+	block.randomHeight = parentBlock.randomHeight
+
+	logging.VLog().WithFields(logrus.Fields{
+		"parent": parentBlock,
+		"block":  block,
+	}).Info("Linked the relay block.")
+	return nil
+}
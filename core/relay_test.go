@@ -0,0 +1,76 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelayBlockChain(t *testing.T) {
+	var dynasty []string
+	for i := 0; i < DynastySize; i++ {
+		dynasty = append(dynasty, mockAddress().String())
+	}
+	remote := testNeb()
+	remote.genesis.Consensus.Dpos.Dynasty = dynasty
+	bc, _ := NewBlockChain(remote)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	var blocks []*Block
+	for i := 0; i < 4; i++ {
+		block := signedTestBlock(t, bc.TailBlock(), BlockInterval*int64(i+1), nil)
+		assert.Nil(t, bc.BlockPool().Push(block))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	// a block linked to the chain, but signed by a key out of its slot.
+	forged := signedTestBlock(t, blocks[1], BlockInterval*3, mockAddress())
+
+	local := testNeb()
+	local.genesis.Consensus.Dpos.Dynasty = dynasty
+	relay, _ := NewBlockChain(local)
+	relay.SetConsensusHandler(c)
+	checkpoints := map[uint64]byteutils.Hash{
+		blocks[0].Height(): blocks[0].Hash(),
+		blocks[3].Height(): blocks[2].Hash(),
+	}
+	assert.Nil(t, relay.SetRelayMode(checkpoints))
+	for _, v := range []*Block{blocks[0], blocks[1], forged, blocks[2], blocks[3]} {
+		pbBlock, _ := v.ToProto()
+		received := new(Block)
+		assert.Nil(t, received.FromProto(pbBlock))
+		relay.BlockPool().Push(received)
+	}
+	// linked without the state, the blocks between the checkpoints verified by their proposers,
+	// up to the block not matching its checkpoint.
+	assert.NotNil(t, relay.GetBlock(blocks[2].Hash()))
+	assert.Nil(t, relay.GetBlock(forged.Hash()))
+	assert.Nil(t, relay.GetBlock(blocks[3].Hash()))
+	_, err := local.storage.Get(blocks[2].StateRoot())
+	assert.NotNil(t, err)
+
+	relay.SetTailBlock(relay.GetBlock(blocks[2].Hash()))
+	restarted, err := NewBlockChain(local)
+	assert.Nil(t, err)
+	assert.True(t, restarted.RelayMode())
+	assert.Equal(t, blocks[2].Hash(), restarted.TailBlock().Hash())
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Commission Related Constants
+const (
+	MaxCommissionRate = uint32(100)
+
+	// a new commission rate takes effect after the notice period, counted in dynasties.
+	CommissionNoticeDynasties = int64(2)
+
+	// MaxRewardedVoters is the most voters of the miner sharing a block reward, the first ones
+	// in the delegate trie, so the cost of a block doesn't grow with the voters of its miner.
+	MaxRewardedVoters = 256
+)
+
+var (
+	commissionKey        = hash.Sha3256([]byte("commission"))
+	pendingCommissionKey = hash.Sha3256([]byte("commission.pending"))
+)
+
+// commissionRate returns the commission rate of the candidate in effect in the dynasty.
+func commissionRate(acc state.Account, dynastyID int64) (uint32, error) {
+	rate := uint32(0)
+	bytes, err := acc.Get(commissionKey)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return 0, err
+	}
+	if err == nil {
+		if len(bytes) != 4 {
+			return 0, ErrInvalidCommissionData
+		}
+		rate = byteutils.Uint32(bytes)
+	}
+	bytes, err = acc.Get(pendingCommissionKey)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return 0, err
+	}
+	if err == nil {
+		// the pending rate and the dynasty it takes effect.
+		if len(bytes) != 12 {
+			return 0, ErrInvalidCommissionData
+		}
+		if dynastyID >= byteutils.Int64(bytes[4:]) {
+			rate = byteutils.Uint32(bytes[:4])
+		}
+	}
+	return rate, nil
+}
+
+// declareCommission schedules the new commission rate and returns the dynasty it takes effect.
+func declareCommission(acc state.Account, rate uint32, dynastyID int64) (int64, error) {
+	// settle the pending rate already in effect before scheduling the new one.
+	current, err := commissionRate(acc, dynastyID)
+	if err != nil {
+		return 0, err
+	}
+	if err := acc.Put(commissionKey, byteutils.FromUint32(current)); err != nil {
+		return 0, err
+	}
+	effective := dynastyID + CommissionNoticeDynasties
+	pending := append(byteutils.FromUint32(rate), byteutils.FromInt64(effective)...)
+	if err := acc.Put(pendingCommissionKey, pending); err != nil {
+		return 0, err
+	}
+	return effective, nil
+}
+
+// CommissionRate returns the commission rate of the candidate in effect on this block.
+func (block *Block) CommissionRate(candidate *Address) (uint32, error) {
+	acc := block.accState.GetOrCreateUserAccount(candidate.Bytes())
+	return commissionRate(acc, block.header.timestamp/DynastyInterval)
+}
+
+// sharesReward returns true if the block reward is shared with the voters of the miner,
+// before the commission height the coinbase is rewarded when the block is created.
+func (block *Block) sharesReward() bool {
+	return block.commissionHeight > 0 && block.height >= block.commissionHeight
+}
+
+// distributeReward pays the block reward, the coinbase keeps the commission declared by the miner
+// and the rest is shared by at most MaxRewardedVoters voters of the miner according to their votes.
+func (block *Block) distributeReward() error {
+	coinbaseAcc := block.accState.GetOrCreateUserAccount(block.header.coinbase.address)
+	reward := util.NewUint128FromBigInt(BlockReward.Int)
+
+	if block.miner != nil {
+		rate, err := block.CommissionRate(block.miner)
+		if err != nil {
+			return err
+		}
+		voters, weights, total, err := block.votersOf(block.miner, MaxRewardedVoters)
+		if err != nil {
+			return err
+		}
+		if total.Sign() > 0 {
+			share := util.NewUint128().Mul(BlockReward.Int, util.NewUint128FromInt(int64(MaxCommissionRate-rate)).Int)
+			share.Div(share, util.NewUint128FromInt(int64(MaxCommissionRate)).Int)
+			for i, voter := range voters {
+				amount := util.NewUint128().Mul(share, weights[i].Int)
+				amount.Div(amount, total.Int)
+				block.accState.GetOrCreateUserAccount(voter).AddBalance(util.NewUint128FromBigInt(amount))
+				reward.Sub(reward.Int, amount)
+			}
+		}
+	}
+
+	coinbaseAcc.AddBalance(reward)
+	logging.VLog().WithFields(logrus.Fields{
+		"coinbase": block.header.coinbase.address.Hex(),
+		"balance":  coinbaseAcc.Balance().Int64(),
+	}).Info("Rewarded the coinbase.")
+	return nil
+}
+
+// votersOf returns at most max voters of the delegatee, weighted by their votes.
+func (block *Block) votersOf(delegatee *Address, max int) ([]byteutils.Hash, []*util.Uint128, *util.Uint128, error) {
+	voters := []byteutils.Hash{}
+	weights := []*util.Uint128{}
+	total := util.NewUint128()
+	iter, err := block.dposContext.delegateTrie.Iterator(delegatee.Bytes())
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, nil, nil, err
+	}
+	if err != nil {
+		return voters, weights, total, nil
+	}
+	exist, err := iter.Next()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for exist && len(voters) < max {
+		voter, share, shares := ParseDelegation(iter.Value())
+		weight := delegationVotes(block.accState.GetOrCreateUserAccount(voter).Balance(), share, shares)
+		voters = append(voters, voter)
+		weights = append(weights, weight)
+		total.Add(total.Int, weight.Int)
+		exist, err = iter.Next()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return voters, weights, total, nil
+}
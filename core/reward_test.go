@@ -0,0 +1,148 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommissionNoticePeriod(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	acc := block.accState.GetOrCreateUserAccount(mockAddress().Bytes())
+
+	rate, err := commissionRate(acc, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, rate, uint32(0))
+
+	effective, err := declareCommission(acc, 10, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, effective, 1+CommissionNoticeDynasties)
+	rate, err = commissionRate(acc, effective-1)
+	assert.Nil(t, err)
+	assert.Equal(t, rate, uint32(0))
+	rate, err = commissionRate(acc, effective)
+	assert.Nil(t, err)
+	assert.Equal(t, rate, uint32(10))
+
+	next, err := declareCommission(acc, 20, effective)
+	assert.Nil(t, err)
+	rate, err = commissionRate(acc, next-1)
+	assert.Nil(t, err)
+	assert.Equal(t, rate, uint32(10))
+	rate, err = commissionRate(acc, next)
+	assert.Nil(t, err)
+	assert.Equal(t, rate, uint32(20))
+}
+
+func TestBlock_DistributeReward(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	bc.tailBlock.commissionHeight = 1
+	coinbase, miner, voter := mockAddress(), mockAddress(), mockAddress()
+
+	// the coinbase takes the whole reward if nobody votes the miner.
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.SetMiner(miner)
+	assert.Nil(t, block.distributeReward())
+	assert.Equal(t, block.GetBalance(coinbase.Bytes()).String(), BlockReward.String())
+
+	block, err = bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.SetMiner(miner)
+	block.accState.GetOrCreateUserAccount(voter.Bytes()).AddBalance(util.NewUint128FromInt(100))
+	_, err = block.dposContext.delegateTrie.Put(append(miner.Bytes(), voter.Bytes()...), voter.Bytes())
+	assert.Nil(t, err)
+	minerAcc := block.accState.GetOrCreateUserAccount(miner.Bytes())
+	_, err = declareCommission(minerAcc, 10, block.Timestamp()/DynastyInterval-CommissionNoticeDynasties)
+	assert.Nil(t, err)
+	assert.Nil(t, block.distributeReward())
+
+	commission := util.NewUint128().Div(BlockReward.Int, util.NewUint128FromInt(10).Int)
+	share := util.NewUint128().Sub(BlockReward.Int, commission)
+	assert.Equal(t, block.GetBalance(coinbase.Bytes()).String(), commission.String())
+	assert.Equal(t, block.GetBalance(voter.Bytes()).String(), share.Add(share, util.NewUint128FromInt(100).Int).String())
+
+	// the commission data not written by declareCommission is refused.
+	assert.Nil(t, minerAcc.Put(pendingCommissionKey, []byte{0, 0, 0, 10}))
+	assert.Equal(t, block.distributeReward(), ErrInvalidCommissionData)
+	assert.Nil(t, minerAcc.Put(commissionKey, []byte{10}))
+	_, err = commissionRate(minerAcc, 0)
+	assert.Equal(t, err, ErrInvalidCommissionData)
+}
+
+func TestBlock_MaxRewardedVoters(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	bc.tailBlock.commissionHeight = 1
+	coinbase, miner := mockAddress(), mockAddress()
+
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.SetMiner(miner)
+	for i := 0; i < MaxRewardedVoters+10; i++ {
+		voter := &Address{[]byte(fmt.Sprintf("%024d", i))}
+		block.accState.GetOrCreateUserAccount(voter.Bytes()).AddBalance(util.NewUint128FromInt(100))
+		_, err = block.dposContext.delegateTrie.Put(append(miner.Bytes(), voter.Bytes()...), voter.Bytes())
+		assert.Nil(t, err)
+	}
+	voters, _, total, err := block.votersOf(miner, MaxRewardedVoters)
+	assert.Nil(t, err)
+	assert.Equal(t, len(voters), MaxRewardedVoters)
+	assert.Equal(t, total.String(), util.NewUint128FromInt(100*MaxRewardedVoters).String())
+
+	// the voters paid share the whole reward, the coinbase has no commission but the rounding.
+	assert.Nil(t, block.distributeReward())
+	share := util.NewUint128().Div(BlockReward.Int, util.NewUint128FromInt(MaxRewardedVoters).Int)
+	left := util.NewUint128().Sub(BlockReward.Int, util.NewUint128().Mul(share, util.NewUint128FromInt(MaxRewardedVoters).Int))
+	assert.Equal(t, block.GetBalance(coinbase.Bytes()).String(), left.String())
+	assert.Equal(t, block.GetBalance(voters[0]).String(), share.Add(share, util.NewUint128FromInt(100).Int).String())
+}
+
+func TestBlock_RewardBeforeCommissionHeight(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	coinbase := mockAddress()
+
+	// the coinbase is rewarded once the block is created if the commission height is not set.
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	assert.False(t, block.sharesReward())
+	assert.Equal(t, block.GetBalance(coinbase.Bytes()).String(), BlockReward.String())
+
+	bc.tailBlock.commissionHeight = bc.TailBlock().Height() + 2
+	block, err = bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	assert.False(t, block.sharesReward())
+	assert.Equal(t, block.GetBalance(coinbase.Bytes()).String(), BlockReward.String())
+
+	// the reward is left to the seal from the commission height.
+	bc.tailBlock.commissionHeight = bc.TailBlock().Height() + 1
+	block, err = bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	assert.True(t, block.sharesReward())
+	assert.Equal(t, block.GetBalance(coinbase.Bytes()).String(), "0")
+}
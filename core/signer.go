@@ -0,0 +1,154 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+var (
+	// signerKey keeps the signing key registered by the candidate in its account.
+	signerKey = hash.Sha3256([]byte("signer"))
+	// delegatorKey keeps the candidate in the account of the signing key it registered.
+	delegatorKey = hash.Sha3256([]byte("signer.delegator"))
+)
+
+// registeredSigner returns the signing key registered by the candidate, nil if none.
+func registeredSigner(acc state.Account) (byteutils.Hash, error) {
+	signer, err := acc.Get(signerKey)
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	return signer, err
+}
+
+// registerSigner registers the signing key of the candidate, or removes it if nil.
+// A key signs for one candidate at most.
+func registerSigner(accState state.AccountState, candidate byteutils.Hash, signer byteutils.Hash) error {
+	if signer != nil {
+		delegator, err := signerDelegator(accState, signer)
+		if err != nil {
+			return err
+		}
+		if delegator != nil && !delegator.Equals(candidate) {
+			return ErrSignerRegistered
+		}
+	}
+	acc := accState.GetOrCreateUserAccount(candidate)
+	old, err := registeredSigner(acc)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if err := accState.GetOrCreateUserAccount(old).Del(delegatorKey); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+	}
+	if signer == nil {
+		if err := acc.Del(signerKey); err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		return nil
+	}
+	if err := accState.GetOrCreateUserAccount(signer).Put(delegatorKey, candidate); err != nil {
+		return err
+	}
+	return acc.Put(signerKey, signer)
+}
+
+// signerDelegator returns the candidate which registered the signing key, nil if none.
+func signerDelegator(accState state.AccountState, signer byteutils.Hash) (byteutils.Hash, error) {
+	// the account is only looked up, a key without account is not created in the state.
+	acc, err := accState.GetContractAccount(signer)
+	if err == state.ErrAccountNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	delegator, err := acc.Get(delegatorKey)
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	return delegator, err
+}
+
+// dynastyValue is the value of the delegatee in the dynasty, followed by the signing key it registered
+// before the dynasty was elected.
+func dynastyValue(delegatee byteutils.Hash, signer byteutils.Hash) byteutils.Hash {
+	if signer == nil {
+		return delegatee
+	}
+	return append(append(byteutils.Hash{}, delegatee...), signer...)
+}
+
+// parseDynastyValue returns the delegatee and its signing key of the value in the dynasty, nil if none.
+func parseDynastyValue(value []byte) (delegatee byteutils.Hash, signer byteutils.Hash) {
+	if len(value) != AddressLength*2 {
+		return value, nil
+	}
+	return value[:AddressLength], value[AddressLength:]
+}
+
+// DynastySigner returns the signing key of the delegatee in the dynasty, nil if it signs with its own key.
+func DynastySigner(dynasty *trie.BatchTrie, delegatee byteutils.Hash) (byteutils.Hash, error) {
+	value, err := dynasty.Get(delegatee)
+	if err != nil {
+		return nil, err
+	}
+	_, signer := parseDynastyValue(value)
+	return signer, nil
+}
+
+// CanSign returns whether the key signs the blocks of the delegatee in the dynasty: its own key,
+// or the signing key it registered.
+func CanSign(dynasty *trie.BatchTrie, delegatee byteutils.Hash, key *Address) (bool, error) {
+	if key.address.Equals(delegatee) {
+		return true, nil
+	}
+	signer, err := DynastySigner(dynasty, delegatee)
+	if err != nil {
+		return false, err
+	}
+	return signer != nil && key.address.Equals(signer), nil
+}
+
+// DelegateeOf returns the member of the dynasty the key signs for, nil if none.
+func DelegateeOf(dynasty *trie.BatchTrie, key *Address) (byteutils.Hash, error) {
+	iter, err := dynasty.Iterator(nil)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return nil, nil
+	}
+	exist, err := iter.Next()
+	for exist {
+		delegatee, signer := parseDynastyValue(iter.Value())
+		if key.address.Equals(delegatee) || key.address.Equals(signer) {
+			return delegatee, nil
+		}
+		exist, err = iter.Next()
+	}
+	return nil, err
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterSigner(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	candidate, other, signer := mockAddress(), mockAddress(), mockAddress()
+
+	tx := mockCandidateTransaction(bc.chainID, 0, SignerAction)
+	tx.from = candidate
+	ctx := NewPayloadContext(block, tx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, err = NewCandidateSignerPayload(signer.String()).Execute(ctx)
+	assert.Equal(t, ErrInvalidSignerFromNonCandidate, err)
+
+	for _, v := range []*Address{candidate, other} {
+		_, err = ctx.dposContext.candidateTrie.Put(v.Bytes(), v.Bytes())
+		assert.Nil(t, err)
+	}
+	_, err = NewCandidateSignerPayload("invalid").Execute(ctx)
+	assert.Equal(t, ErrInvalidSigner, err)
+	_, err = NewCandidateSignerPayload(signer.String()).Execute(ctx)
+	assert.Nil(t, err)
+	registered, err := registeredSigner(ctx.accState.GetOrCreateUserAccount(candidate.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, byteutils.Hash(signer.Bytes()), registered)
+	delegator, err := signerDelegator(ctx.accState, signer.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, byteutils.Hash(candidate.Bytes()), delegator)
+
+	// a key signs for one candidate.
+	tx.from = other
+	_, err = NewCandidateSignerPayload(signer.String()).Execute(ctx)
+	assert.Equal(t, ErrSignerRegistered, err)
+
+	// the dynasty elected keeps the signing key of its members.
+	dynasty, err := trie.NewBatchTrie(nil, bc.storage)
+	assert.Nil(t, err)
+	dc := &DynastyContext{Accounts: ctx.accState}
+	assert.Nil(t, dc.putDelegatee(dynasty, candidate.Bytes()))
+	assert.Nil(t, dc.putDelegatee(dynasty, other.Bytes()))
+	members, err := TraverseDynasty(dynasty)
+	assert.Nil(t, err)
+	assert.Len(t, members, 2)
+	assert.Contains(t, members, byteutils.Hash(candidate.Bytes()))
+	assert.Contains(t, members, byteutils.Hash(other.Bytes()))
+	for _, tt := range []struct {
+		delegatee *Address
+		key       *Address
+		ok        bool
+	}{
+		{candidate, candidate, true},
+		{candidate, signer, true},
+		{other, other, true},
+		{other, signer, false},
+		{candidate, other, false},
+	} {
+		ok, err := CanSign(dynasty, tt.delegatee.Bytes(), tt.key)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.ok, ok)
+	}
+	delegatee, err := DelegateeOf(dynasty, signer)
+	assert.Nil(t, err)
+	assert.Equal(t, byteutils.Hash(candidate.Bytes()), delegatee)
+	delegatee, err = DelegateeOf(dynasty, mockAddress())
+	assert.Nil(t, err)
+	assert.Nil(t, delegatee)
+
+	// an empty signer removes the key.
+	tx.from = candidate
+	_, err = NewCandidateSignerPayload("").Execute(ctx)
+	assert.Nil(t, err)
+	registered, err = registeredSigner(ctx.accState.GetOrCreateUserAccount(candidate.Bytes()))
+	assert.Nil(t, err)
+	assert.Nil(t, registered)
+	delegator, err = signerDelegator(ctx.accState, signer.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, delegator)
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/net/simnet"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+// waitUntil polls the condition until it holds or the timeout expires.
+func waitUntil(timeout time.Duration, cond func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return cond()
+}
+
+func TestTxGossipOverSimnet(t *testing.T) {
+	network := simnet.NewNetwork()
+	network.SetLatency(5 * time.Millisecond)
+
+	// a line of nodes, the tx is relayed hop by hop.
+	ids := []string{"n0", "n1", "n2", "n3", "n4"}
+	var pools []*TransactionPool
+	for i, id := range ids {
+		node, err := network.AddNode(id)
+		assert.Nil(t, err)
+		bc, err := NewBlockChain(testNeb())
+		assert.Nil(t, err)
+		bc.txPool.RegisterInNetwork(node)
+		bc.txPool.Start()
+		defer bc.txPool.Stop()
+		pools = append(pools, bc.txPool)
+		if i > 0 {
+			assert.Nil(t, network.Connect(ids[i-1], id))
+		}
+	}
+	network.Start()
+	defer network.Stop()
+
+	network.Partition([]string{"n0", "n1", "n2", "n3"}, []string{"n4"})
+
+	from := mockAddress()
+	key, _ := keystore.DefaultKS.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	tx := NewTransaction(pools[0].bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, []byte("gossip"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Nil(t, pools[0].PushAndBroadcast(tx))
+
+	assert.True(t, waitUntil(5*time.Second, func() bool {
+		return pools[3].get(tx.Hash()) != nil
+	}))
+	for _, pool := range pools[1:4] {
+		assert.NotNil(t, pool.get(tx.Hash()))
+	}
+
+	// the node cut off never receives it.
+	time.Sleep(50 * time.Millisecond)
+	network.Flush()
+	assert.Nil(t, pools[4].get(tx.Hash()))
+}
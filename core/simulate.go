@@ -0,0 +1,154 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// SimulateResult is the result of a contract call simulated against the state of a block.
+type SimulateResult struct {
+	// Result is the JSON of the value returned by the function.
+	Result string
+	// GasUsed is the gas the call would use when sent in a transaction.
+	GasUsed *util.Uint128
+	// GasLimit is the least gas limit the call needs to run, the gas used before the refunds.
+	GasLimit *util.Uint128
+	// Err is the error failing the call.
+	Err error
+	// Profile is the gas of the functions and storage accesses of the contracts, only in profiled calls.
+	Profile []*nvm.GasProfileEntry
+}
+
+// SimulateCall runs the contract call in tx against the state of the block in a sandbox,
+// without checking the nonce, signature or balance of the sender, nothing is kept.
+func (block *Block) SimulateCall(tx *Transaction) (*SimulateResult, error) {
+	return block.simulateCall(tx, nil)
+}
+
+// ProfileCall simulates the contract call as SimulateCall does, and profiles the gas of the contracts.
+func (block *Block) ProfileCall(tx *Transaction) (*SimulateResult, error) {
+	tracer := nvm.NewTracer()
+	result, err := block.simulateCall(tx, tracer)
+	if err != nil {
+		return nil, err
+	}
+	result.Profile = tracer.Profile()
+	return result, nil
+}
+
+func (block *Block) simulateCall(tx *Transaction, tracer *nvm.Tracer) (*SimulateResult, error) {
+	if tx.Type() != TxPayloadCallType && tx.Type() != TxPayloadMultiCallType {
+		return nil, ErrSimulateNonCall
+	}
+	payload, err := tx.LoadPayload()
+	if err != nil {
+		return nil, err
+	}
+	sandbox, err := block.sandbox()
+	if err != nil {
+		return nil, err
+	}
+	if tx.gasLimit.Sign() == 0 {
+		tx.gasLimit = TransactionMaxGas
+	}
+
+	gasUsed := tx.GasCountOfTxBase()
+	gasUsed.Add(gasUsed.Int, payload.BaseGasCount().Int)
+	if tx.gasLimit.Cmp(gasUsed.Int) < 0 {
+		return &SimulateResult{GasUsed: gasUsed, GasLimit: gasUsed, Err: ErrOutOfGasLimit}, nil
+	}
+
+	ctx := NewPayloadContext(sandbox, tx)
+	ctx.simulated = true
+	ctx.tracer = tracer
+	if err := ctx.BeginBatch(); err != nil {
+		return nil, err
+	}
+	gasExecution, err := payload.Execute(ctx)
+	gas := util.NewUint128FromBigInt(util.NewUint128().Add(gasUsed.Int, gasExecution.Int))
+	limit := gas
+	if err == nil {
+		gas = ctx.refundGas(gas)
+	}
+	return &SimulateResult{Result: ctx.Result(), GasUsed: gas, GasLimit: limit, Err: err}, nil
+}
+
+// fail keeps the first error failing the simulated execution, and the gas limit it needs if known.
+func (result *SimulateResult) fail(err error, limit *util.Uint128) {
+	if result == nil || result.Err != nil {
+		return
+	}
+	result.Err = err
+	if limit != nil {
+		result.GasLimit = limit
+	}
+}
+
+// sandbox returns a copy of the block whose changes are kept in memory and discarded with it.
+func (block *Block) sandbox() (*Block, error) {
+	stor := storage.NewOverlayStorage(block.storage)
+	accState, err := state.NewAccountState(block.accState.RootHash(), stor)
+	if err != nil {
+		return nil, err
+	}
+	txsTrie, err := trie.NewBatchTrie(block.txsTrie.RootHash(), stor)
+	if err != nil {
+		return nil, err
+	}
+	eventsTrie, err := trie.NewBatchTrie(block.eventsTrie.RootHash(), stor)
+	if err != nil {
+		return nil, err
+	}
+	dposContext, err := NewDposContext(stor)
+	if err != nil {
+		return nil, err
+	}
+	pbDposContext, err := block.dposContext.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	if err := dposContext.FromProto(pbDposContext); err != nil {
+		return nil, err
+	}
+
+	return &Block{
+		header:       block.header,
+		transactions: block.transactions,
+		evidences:    block.evidences,
+		sealed:       true,
+		height:       block.height,
+		parenetBlock: block.parenetBlock,
+		accState:     accState,
+		txsTrie:      txsTrie,
+		eventsTrie:   eventsTrie,
+		dposContext:  dposContext,
+		miner:        block.miner,
+		storage:      stor,
+		sandboxed:    true,
+
+		blockIntervals:   block.blockIntervals,
+		commissionHeight: block.commissionHeight,
+		randomHeight:     block.randomHeight,
+	}, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_SimulateCall(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	deployTx := mockDeployTransaction(bc.chainID, 0)
+	assert.Nil(t, block.acceptTransaction(deployTx))
+	payload, _ := deployTx.LoadPayload()
+	ctx := NewPayloadContext(block, deployTx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, err := payload.Execute(ctx)
+	assert.Nil(t, err)
+	ctx.Commit()
+	block.commit()
+	contract, _ := deployTx.GenerateContractAddress()
+
+	// no gas limit, nonce or signature is required.
+	callTx := mockCallTransaction(bc.chainID, 0, "totalSupply", "")
+	callTx.to = contract
+	callTx.gasLimit = util.NewUint128()
+	result, err := block.SimulateCall(callTx)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "1000000000", result.Result)
+	assert.True(t, result.GasUsed.Cmp(callTx.GasCountOfTxBase().Int) > 0)
+
+	// the changes are discarded.
+	root := block.accState.RootHash()
+	callTx = mockCallTransaction(bc.chainID, 0, "pay", `[{"sender":"someone"}, 10]`)
+	callTx.to = contract
+	result, err = block.SimulateCall(callTx)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.Equal(t, root, block.accState.RootHash())
+	callTx = mockCallTransaction(bc.chainID, 0, "totalSupply", "")
+	callTx.to = contract
+	result, _ = block.SimulateCall(callTx)
+	assert.Equal(t, "1000000000", result.Result)
+
+	// the failure is in the result.
+	callTx = mockCallTransaction(bc.chainID, 0, "transfer", `["someone", 10]`)
+	callTx.to = contract
+	result, err = block.SimulateCall(callTx)
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Err)
+
+	_, err = block.SimulateCall(deployTx)
+	assert.Equal(t, ErrSimulateNonCall, err)
+}
+
+func TestBlockChain_SimulateTransaction(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	deployTx := mockDeployTransaction(bc.chainID, 0)
+	assert.Nil(t, block.acceptTransaction(deployTx))
+	payload, _ := deployTx.LoadPayload()
+	ctx := NewPayloadContext(block, deployTx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, err := payload.Execute(ctx)
+	assert.Nil(t, err)
+	ctx.Commit()
+	block.commit()
+	contract, _ := deployTx.GenerateContractAddress()
+	bc.tailBlock = block
+
+	// the gas limit needed is the gas before the refunds.
+	root := block.accState.RootHash()
+	callTx := mockCallTransaction(bc.chainID, 1, "pay", `[{"sender":"someone"}, 10]`)
+	callTx.to = contract
+	result, err := bc.SimulateTransaction(callTx)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.True(t, result.GasUsed.Cmp(callTx.GasCountOfTxBase().Int) > 0)
+	assert.True(t, result.GasLimit.Cmp(result.GasUsed.Int) >= 0)
+	assert.Equal(t, root, block.accState.RootHash())
+
+	callTx = mockCallTransaction(bc.chainID, 1, "totalSupply", "")
+	callTx.to = contract
+	result, err = bc.SimulateTransaction(callTx)
+	assert.Nil(t, err)
+	assert.Equal(t, "1000000000", result.Result)
+
+	// the failure is in the result with the gas it used.
+	callTx = mockCallTransaction(bc.chainID, 1, "transfer", `["someone", 10]`)
+	callTx.to = contract
+	result, err = bc.SimulateTransaction(callTx)
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Err)
+	assert.True(t, result.GasUsed.Cmp(callTx.GasCountOfTxBase().Int) > 0)
+}
@@ -48,6 +48,20 @@ type account struct {
 	variables *trie.BatchTrie
 	// ContractType: Transaction Hash
 	birthPlace byteutils.Hash
+	// ContractType: the address allowed to upgrade the contract
+	admin byteutils.Hash
+	// ContractType: Transaction Hash of the current code
+	codePlace byteutils.Hash
+	// ContractType: bytes of the keys and values in storage, charged by the storage rent
+	storageSize uint64
+	// ContractType: the height until which the storage rent is paid
+	rentHeight uint64
+	// ContractType: hibernated after the balance runs dry paying the rent
+	hibernated bool
+	// ContractType: hash of the ABI generated when the contract is deployed
+	abiHash byteutils.Hash
+	// ContractType: self-destructed, its storage is dropped and it can't be called any more
+	destroyed bool
 }
 
 // ToBytes converts domain Account to bytes
@@ -57,11 +71,18 @@ func (acc *account) ToBytes() ([]byte, error) {
 		return nil, err
 	}
 	pbAcc := &corepb.Account{
-		Address:    acc.address,
-		Balance:    value,
-		Nonce:      acc.nonce,
-		VarsHash:   acc.variables.RootHash(),
-		BirthPlace: acc.birthPlace,
+		Address:     acc.address,
+		Balance:     value,
+		Nonce:       acc.nonce,
+		VarsHash:    acc.variables.RootHash(),
+		BirthPlace:  acc.birthPlace,
+		Admin:       acc.admin,
+		CodePlace:   acc.codePlace,
+		StorageSize: acc.storageSize,
+		RentHeight:  acc.rentHeight,
+		Hibernated:  acc.hibernated,
+		AbiHash:     acc.abiHash,
+		Destroyed:   acc.destroyed,
 	}
 	bytes, err := proto.Marshal(pbAcc)
 	if err != nil {
@@ -84,6 +105,13 @@ func (acc *account) FromBytes(bytes []byte, storage storage.Storage) error {
 	acc.balance = value
 	acc.nonce = pbAcc.Nonce
 	acc.birthPlace = pbAcc.BirthPlace
+	acc.admin = pbAcc.Admin
+	acc.codePlace = pbAcc.CodePlace
+	acc.storageSize = pbAcc.StorageSize
+	acc.rentHeight = pbAcc.RentHeight
+	acc.hibernated = pbAcc.Hibernated
+	acc.abiHash = pbAcc.AbiHash
+	acc.destroyed = pbAcc.Destroyed
 	acc.variables, err = trie.NewBatchTrie(pbAcc.VarsHash, storage)
 	if err != nil {
 		return err
@@ -116,6 +144,44 @@ func (acc *account) BirthPlace() byteutils.Hash {
 	return acc.birthPlace
 }
 
+// Admin return the address allowed to upgrade the contract, nil if not upgradeable
+func (acc *account) Admin() byteutils.Hash {
+	return acc.admin
+}
+
+// CodePlace return the transaction hash of contract's current code
+func (acc *account) CodePlace() byteutils.Hash {
+	if len(acc.codePlace) == 0 {
+		return acc.birthPlace
+	}
+	return acc.codePlace
+}
+
+// StorageSize return the bytes of the keys and values in account's storage
+func (acc *account) StorageSize() uint64 {
+	return acc.storageSize
+}
+
+// RentHeight return the height until which the storage rent is paid
+func (acc *account) RentHeight() uint64 {
+	return acc.rentHeight
+}
+
+// Hibernated return whether the contract is hibernated for the unpaid storage rent
+func (acc *account) Hibernated() bool {
+	return acc.hibernated
+}
+
+// ABIHash return the hash of contract's ABI, nil if there is none
+func (acc *account) ABIHash() byteutils.Hash {
+	return acc.abiHash
+}
+
+// Destroyed return whether the contract has self-destructed
+func (acc *account) Destroyed() bool {
+	return acc.destroyed
+}
+
 // BeginBatch begins a batch task
 func (acc *account) BeginBatch() {
 	logging.VLog().Info("Account Begin.")
@@ -143,6 +209,31 @@ func (acc *account) IncrNonce() {
 	acc.nonce++
 }
 
+// SetAdmin set the address allowed to upgrade the contract
+func (acc *account) SetAdmin(admin byteutils.Hash) {
+	acc.admin = admin
+}
+
+// SetCodePlace set the transaction hash of contract's current code
+func (acc *account) SetCodePlace(codePlace byteutils.Hash) {
+	acc.codePlace = codePlace
+}
+
+// SetRentHeight set the height until which the storage rent is paid
+func (acc *account) SetRentHeight(height uint64) {
+	acc.rentHeight = height
+}
+
+// SetHibernated set whether the contract is hibernated
+func (acc *account) SetHibernated(hibernated bool) {
+	acc.hibernated = hibernated
+}
+
+// SetABIHash set the hash of contract's ABI
+func (acc *account) SetABIHash(hash byteutils.Hash) {
+	acc.abiHash = hash
+}
+
 // AddBalance to an account
 func (acc *account) AddBalance(value *util.Uint128) {
 	acc.balance.Add(acc.balance.Int, value.Int)
@@ -159,8 +250,15 @@ func (acc *account) SubBalance(value *util.Uint128) error {
 
 // Put into account's storage
 func (acc *account) Put(key []byte, value []byte) error {
-	_, err := acc.variables.Put(key, value)
-	return err
+	old, err := acc.variables.Get(key)
+	if _, err := acc.variables.Put(key, value); err != nil {
+		return err
+	}
+	if err == nil {
+		acc.storageSize -= uint64(len(key) + len(old))
+	}
+	acc.storageSize += uint64(len(key) + len(value))
+	return nil
 }
 
 // Get from account's storage
@@ -170,9 +268,13 @@ func (acc *account) Get(key []byte) ([]byte, error) {
 
 // Del from account's storage
 func (acc *account) Del(key []byte) error {
+	old, err := acc.variables.Get(key)
 	if _, err := acc.variables.Del(key); err != nil {
 		return err
 	}
+	if err == nil {
+		acc.storageSize -= uint64(len(key) + len(old))
+	}
 	return nil
 }
 
@@ -288,6 +390,23 @@ func (as *accountState) CreateContractAccount(addr []byte, birthPlace []byte) (A
 	return acc, nil
 }
 
+// DestroyContract marks the contract self-destructed and drops its storage
+func (as *accountState) DestroyContract(addr []byte) error {
+	acc, err := as.getAccount(addr)
+	if err != nil {
+		return err
+	}
+	variables, err := trie.NewBatchTrie(nil, as.storage)
+	if err != nil {
+		return err
+	}
+	contract := acc.(*account)
+	contract.variables = variables
+	contract.storageSize = 0
+	contract.destroyed = true
+	return nil
+}
+
 func (as *accountState) Accounts() ([]Account, error) {
 	accounts := []Account{}
 	iter, err := as.stateTrie.Iterator(nil)
@@ -24,6 +24,7 @@ import (
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,6 +41,46 @@ func TestAccount_ToBytes(t *testing.T) {
 	a := &account{}
 	a.FromBytes(bytes, stor)
 	assert.Equal(t, acc, a)
+	assert.Equal(t, acc.birthPlace, a.CodePlace())
+
+	acc.SetAdmin([]byte("admin"))
+	acc.SetCodePlace([]byte("0x1"))
+	bytes, _ = acc.ToBytes()
+	a = &account{}
+	a.FromBytes(bytes, stor)
+	assert.Equal(t, acc, a)
+	assert.Equal(t, []byte("0x1"), []byte(a.CodePlace()))
+}
+
+func TestAccount_StorageSize(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	vars, _ := trie.NewBatchTrie(nil, stor)
+	acc := &account{
+		balance:    util.NewUint128(),
+		variables:  vars,
+		birthPlace: []byte("0x0"),
+	}
+	acc.Put([]byte("key"), []byte("value"))
+	assert.Equal(t, acc.StorageSize(), uint64(8))
+	acc.Put([]byte("key"), []byte("v"))
+	assert.Equal(t, acc.StorageSize(), uint64(4))
+	acc.Put([]byte("k2"), []byte("v2"))
+	assert.Equal(t, acc.StorageSize(), uint64(8))
+	acc.Del([]byte("key"))
+	assert.Equal(t, acc.StorageSize(), uint64(4))
+	acc.Del([]byte("key"))
+	assert.Equal(t, acc.StorageSize(), uint64(4))
+
+	acc.SetRentHeight(10)
+	acc.SetHibernated(true)
+	acc.SetABIHash([]byte("abi"))
+	bytes, _ := acc.ToBytes()
+	a := &account{}
+	a.FromBytes(bytes, stor)
+	assert.Equal(t, acc, a)
+	assert.Equal(t, a.RentHeight(), uint64(10))
+	assert.True(t, a.Hibernated())
+	assert.Equal(t, a.ABIHash(), byteutils.Hash("abi"))
 }
 
 func TestAccountState(t *testing.T) {
@@ -70,3 +111,24 @@ func TestAccountState(t *testing.T) {
 	as.RollBack()
 	assert.Equal(t, as.RootHash(), asClone.RootHash())
 }
+
+func TestAccountState_DestroyContract(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	as, _ := NewAccountState(nil, stor)
+	as.BeginBatch()
+	addr := []byte("contract")
+	contract, _ := as.CreateContractAccount(addr, []byte("0x0"))
+	contract.Put([]byte("key"), []byte("value"))
+	as.Commit()
+
+	as.BeginBatch()
+	assert.Nil(t, as.DestroyContract(addr))
+	as.Commit()
+	contract, _ = as.GetContractAccount(addr)
+	assert.True(t, contract.Destroyed())
+	assert.Equal(t, uint64(0), contract.StorageSize())
+	_, err := contract.Get([]byte("key"))
+	assert.NotNil(t, err)
+
+	assert.Equal(t, ErrAccountNotFound, as.DestroyContract([]byte("unknown")))
+}
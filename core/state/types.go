@@ -36,7 +36,14 @@ type Account interface {
 	Balance() *util.Uint128
 	Nonce() uint64
 	BirthPlace() byteutils.Hash
+	Admin() byteutils.Hash
+	CodePlace() byteutils.Hash
 	VarsHash() byteutils.Hash
+	StorageSize() uint64
+	RentHeight() uint64
+	Hibernated() bool
+	ABIHash() byteutils.Hash
+	Destroyed() bool
 
 	BeginBatch()
 	Commit()
@@ -46,6 +53,11 @@ type Account interface {
 	FromBytes(bytes []byte, storage storage.Storage) error
 
 	IncrNonce()
+	SetAdmin(admin byteutils.Hash)
+	SetCodePlace(codePlace byteutils.Hash)
+	SetRentHeight(height uint64)
+	SetHibernated(hibernated bool)
+	SetABIHash(hash byteutils.Hash)
 	AddBalance(value *util.Uint128)
 	SubBalance(value *util.Uint128) error
 	Put(key []byte, value []byte) error
@@ -68,4 +80,5 @@ type AccountState interface {
 	GetOrCreateUserAccount(addr []byte) Account
 	GetContractAccount(addr []byte) (Account, error)
 	CreateContractAccount(addr []byte, birthPlace []byte) (Account, error)
+	DestroyContract(addr []byte) error
 }
@@ -0,0 +1,47 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// AddStateTries schedule the tries of the block's world state in the syncer:
+// the accounts with their variables, the transactions, the events and the dpos context.
+func AddStateTries(syncer *trie.Syncer, block *Block) {
+	syncer.AddRoot(block.StateRoot(), accountVarsRoots)
+	syncer.AddRoot(block.TxsRoot(), nil)
+	syncer.AddRoot(block.EventsRoot(), nil)
+	if dc := block.DposContext(); dc != nil {
+		for _, root := range [][]byte{dc.DynastyRoot, dc.NextDynastyRoot, dc.DelegateRoot, dc.CandidateRoot, dc.VoteRoot, dc.MintCntRoot} {
+			syncer.AddRoot(root, nil)
+		}
+	}
+}
+
+// accountVarsRoots return the root of the variables trie of the account in a leaf of the state trie.
+func accountVarsRoots(val []byte) [][]byte {
+	pbAcc := new(corepb.Account)
+	if err := proto.Unmarshal(val, pbAcc); err != nil {
+		return nil
+	}
+	return [][]byte{pbAcc.VarsHash}
+}
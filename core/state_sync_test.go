@@ -0,0 +1,57 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddStateTries(t *testing.T) {
+	neb := testNeb()
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	genesis := bc.GenesisBlock()
+
+	local, _ := storage.NewMemoryStorage()
+	syncer := trie.NewSyncer(local)
+	AddStateTries(syncer, genesis)
+	for !syncer.Done() {
+		hashes := syncer.Missing(16)
+		assert.True(t, len(hashes) > 0)
+		for _, h := range hashes {
+			data, err := neb.storage.Get(h)
+			assert.Nil(t, err)
+			_, err = syncer.Process(data)
+			assert.Nil(t, err)
+		}
+	}
+
+	for _, root := range [][]byte{genesis.StateRoot(), genesis.TxsRoot(), genesis.DposContext().DynastyRoot} {
+		if len(root) == 0 {
+			continue
+		}
+		_, err := trie.NewTrie(root, local)
+		assert.Nil(t, err)
+	}
+	assert.True(t, syncer.Synced() > 0)
+}
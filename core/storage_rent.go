@@ -0,0 +1,169 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// StorageRentRevivalBlocks is the blocks of rent a hibernated contract must hold to revive.
+const StorageRentRevivalBlocks = 5760
+
+// storageRent is the wei charged for each byte of contract storage in each block from the height.
+type storageRent struct {
+	height uint64
+	price  *util.Uint128
+}
+
+var (
+	contractStorageRent   *storageRent
+	contractStorageRentMu sync.RWMutex
+)
+
+// SetStorageRent installs the storage rent in the genesis conf, no rent is charged if not set.
+func SetStorageRent(conf *corepb.GenesisStorageRent) error {
+	var rent *storageRent
+	if conf != nil && len(conf.Price) > 0 {
+		price, ok := util.NewUint128().FromString(conf.Price)
+		if !ok || price.Validate() != nil {
+			return ErrInvalidStorageRent
+		}
+		if price.Sign() > 0 {
+			rent = &storageRent{height: conf.Height, price: price}
+		}
+	}
+
+	contractStorageRentMu.Lock()
+	defer contractStorageRentMu.Unlock()
+	contractStorageRent = rent
+
+	if rent != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"height": rent.height,
+			"price":  rent.price.String(),
+		}).Info("Storage rent scheduled.")
+	}
+	return nil
+}
+
+// storageRentAt returns the storage rent charged at the height, nil if there is none.
+func storageRentAt(height uint64) *storageRent {
+	contractStorageRentMu.RLock()
+	defer contractStorageRentMu.RUnlock()
+
+	if contractStorageRent == nil || height < contractStorageRent.height {
+		return nil
+	}
+	return contractStorageRent
+}
+
+// rentDue returns the rent of the contract's storage for the blocks.
+func (rent *storageRent) rentDue(contract state.Account, blocks uint64) *util.Uint128 {
+	due := util.NewUint128().Mul(rent.price.Int, new(big.Int).SetUint64(contract.StorageSize()))
+	due.Mul(due, new(big.Int).SetUint64(blocks))
+	return util.NewUint128FromBigInt(due)
+}
+
+// chargeStorageRent charges the contract the rent of its storage since the last payment,
+// the contract hibernates if its balance can't pay the rent.
+func (block *Block) chargeStorageRent(txHash byteutils.Hash, contract state.Account) error {
+	rent := storageRentAt(block.height)
+	if rent == nil || len(contract.BirthPlace()) == 0 || contract.Hibernated() {
+		return nil
+	}
+	from := contract.RentHeight()
+	if from < rent.height {
+		from = rent.height
+	}
+	if block.height <= from {
+		return nil
+	}
+	due := rent.rentDue(contract, block.height-from)
+	contract.SetRentHeight(block.height)
+
+	if contract.Balance().Cmp(due.Int) >= 0 {
+		return contract.SubBalance(due)
+	}
+	paid := util.NewUint128FromBigInt(new(big.Int).Set(contract.Balance().Int))
+	if err := contract.SubBalance(paid); err != nil {
+		return err
+	}
+	contract.SetHibernated(true)
+	if err := block.recordRentEvent(txHash, TopicContractHibernated, contract, paid); err != nil {
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":    block,
+		"contract": contract.Address().Hex(),
+		"due":      due.String(),
+		"paid":     paid.String(),
+	}).Info("Hibernated the contract unable to pay the storage rent.")
+	return nil
+}
+
+// reviveContract wakes up the hibernated contract if its balance holds the rent of the revival blocks.
+func (block *Block) reviveContract(txHash byteutils.Hash, contract state.Account) error {
+	if !contract.Hibernated() {
+		return nil
+	}
+	if rent := storageRentAt(block.height); rent != nil {
+		if contract.Balance().Cmp(rent.rentDue(contract, StorageRentRevivalBlocks).Int) < 0 {
+			return nil
+		}
+	}
+	contract.SetHibernated(false)
+	contract.SetRentHeight(block.height)
+	if err := block.recordRentEvent(txHash, TopicContractRevived, contract, util.NewUint128()); err != nil {
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":    block,
+		"contract": contract.Address().Hex(),
+		"balance":  contract.Balance().String(),
+	}).Info("Revived the hibernated contract.")
+	return nil
+}
+
+func (block *Block) recordRentEvent(txHash byteutils.Hash, topic string, contract state.Account, paid *util.Uint128) error {
+	addr, err := AddressParseFromBytes(contract.Address())
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"contract":    addr.String(),
+		"storageSize": contract.StorageSize(),
+		"balance":     contract.Balance().String(),
+		"paid":        paid.String(),
+	})
+	if err != nil {
+		return err
+	}
+	return block.RecordEvent(txHash, topic, string(data))
+}
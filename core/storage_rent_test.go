@@ -0,0 +1,88 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetStorageRent(t *testing.T) {
+	defer SetStorageRent(nil)
+
+	assert.Nil(t, SetStorageRent(nil))
+	assert.Nil(t, storageRentAt(100))
+	assert.Nil(t, SetStorageRent(&corepb.GenesisStorageRent{Height: 10, Price: "0"}))
+	assert.Nil(t, storageRentAt(100))
+	assert.Equal(t, SetStorageRent(&corepb.GenesisStorageRent{Price: "-1"}), ErrInvalidStorageRent)
+	assert.Equal(t, SetStorageRent(&corepb.GenesisStorageRent{Price: "abc"}), ErrInvalidStorageRent)
+
+	assert.Nil(t, SetStorageRent(&corepb.GenesisStorageRent{Height: 10, Price: "2"}))
+	assert.Nil(t, storageRentAt(9))
+	assert.Equal(t, storageRentAt(10).price, util.NewUint128FromInt(2))
+}
+
+func TestBlock_ChargeStorageRent(t *testing.T) {
+	defer SetStorageRent(nil)
+	assert.Nil(t, SetStorageRent(&corepb.GenesisStorageRent{Height: 10, Price: "2"}))
+
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	block.begin()
+
+	contract, err := block.accState.CreateContractAccount(mockAddress().Bytes(), []byte("birth"))
+	assert.Nil(t, err)
+	assert.Nil(t, contract.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, contract.StorageSize(), uint64(8))
+	contract.AddBalance(util.NewUint128FromInt(200))
+
+	// no rent before the activation height.
+	block.height = 5
+	assert.Nil(t, block.chargeStorageRent(nil, contract))
+	assert.Equal(t, contract.Balance(), util.NewUint128FromInt(200))
+
+	// 8 bytes * 5 blocks * 2 wei since the activation height.
+	block.height = 15
+	assert.Nil(t, block.chargeStorageRent(nil, contract))
+	assert.Equal(t, contract.Balance(), util.NewUint128FromInt(120))
+	assert.Equal(t, contract.RentHeight(), uint64(15))
+	assert.False(t, contract.Hibernated())
+
+	// the balance runs dry.
+	block.height = 25
+	assert.Nil(t, block.chargeStorageRent(block.Hash(), contract))
+	assert.Equal(t, contract.Balance(), util.NewUint128())
+	assert.True(t, contract.Hibernated())
+
+	// revival requires the rent of the revival blocks.
+	contract.AddBalance(util.NewUint128FromInt(100))
+	assert.Nil(t, block.reviveContract(block.Hash(), contract))
+	assert.True(t, contract.Hibernated())
+	contract.AddBalance(util.NewUint128FromInt(16 * StorageRentRevivalBlocks))
+	block.height = 30
+	assert.Nil(t, block.reviveContract(block.Hash(), contract))
+	assert.False(t, contract.Hibernated())
+	assert.Equal(t, contract.RentHeight(), uint64(30))
+	block.commit()
+}
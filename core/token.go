@@ -0,0 +1,229 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Token standards recognized by nodes.
+const (
+	NRC20Standard  = "nrc20"
+	NRC721Standard = "nrc721"
+)
+
+// TokenTransferLog is the name of the log emitted by the tokens for each transfer,
+// indexed by the sender and the receiver.
+const TokenTransferLog = "Transfer"
+
+// NRC20Functions are the functions exported by the contracts of the NRC20 token standard.
+var NRC20Functions = []string{"name", "symbol", "decimals", "totalSupply", "balanceOf", "transfer", "transferFrom", "approve", "allowance"}
+
+// NRC721Functions are the functions exported by the contracts of the NRC721 non-fungible token standard.
+var NRC721Functions = []string{"name", "symbol", "balanceOf", "ownerOf", "tokensOfOwner", "approve", "getApproved", "setApprovalForAll", "isApprovedForAll", "transferFrom"}
+
+// TokenStandard returns the token standard whose functions are all exported by the contract of the ABI,
+// empty if none.
+func TokenStandard(abi *nvm.ABI) string {
+	if abi == nil {
+		return ""
+	}
+	functions := make(map[string]bool)
+	for _, fn := range abi.Functions {
+		functions[fn.Name] = true
+	}
+	exportsAll := func(names []string) bool {
+		for _, name := range names {
+			if !functions[name] {
+				return false
+			}
+		}
+		return true
+	}
+	if exportsAll(NRC20Functions) {
+		return NRC20Standard
+	}
+	if exportsAll(NRC721Functions) {
+		return NRC721Standard
+	}
+	return ""
+}
+
+// TokenTransfer is a transfer of tokens, the sender is empty for minted tokens and the receiver
+// is empty for burned ones. NRC20 transfers carry the value, NRC721 ones the token id.
+type TokenTransfer struct {
+	Contract string `json:"contract"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Value    string `json:"value,omitempty"`
+	TokenID  string `json:"tokenId,omitempty"`
+}
+
+// ParseTokenTransfer returns the token transfer of the log, nil if it's not a transfer log.
+func ParseTokenTransfer(log *nvm.ContractLog) *TokenTransfer {
+	if len(log.Topics) != 3 || log.Topics[0] != TokenTransferLog {
+		return nil
+	}
+	var data struct {
+		Value   string `json:"value"`
+		TokenID string `json:"tokenId"`
+	}
+	if err := json.Unmarshal([]byte(log.Data), &data); err != nil {
+		return nil
+	}
+	transfer := &TokenTransfer{
+		Contract: log.Address,
+		From:     log.Topics[1],
+		To:       log.Topics[2],
+	}
+	if len(data.TokenID) > 0 {
+		transfer.TokenID = data.TokenID
+		return transfer
+	}
+	value, ok := new(big.Int).SetString(data.Value, 10)
+	if !ok || value.Sign() < 0 {
+		return nil
+	}
+	transfer.Value = value.String()
+	return transfer
+}
+
+// FetchTokenTransfers fetch the transfers of tokens in tx, recognized from the logs
+// of the contracts which are NRC20 or NRC721 tokens.
+func (block *Block) FetchTokenTransfers(txHash byteutils.Hash) ([]*TokenTransfer, error) {
+	logs, err := block.FetchLogs(txHash)
+	if err != nil {
+		return nil, err
+	}
+	transfers := []*TokenTransfer{}
+	standards := make(map[string]string)
+	for _, log := range logs {
+		transfer := ParseTokenTransfer(log)
+		if transfer == nil {
+			continue
+		}
+		standard, ok := standards[transfer.Contract]
+		if !ok {
+			standard = block.tokenStandard(transfer.Contract)
+			standards[transfer.Contract] = standard
+		}
+		if (standard == NRC20Standard && len(transfer.Value) > 0) ||
+			(standard == NRC721Standard && len(transfer.TokenID) > 0) {
+			transfers = append(transfers, transfer)
+		}
+	}
+	return transfers, nil
+}
+
+func (block *Block) tokenStandard(contract string) string {
+	addr, err := byteutils.FromHex(contract)
+	if err != nil {
+		return ""
+	}
+	_, abi, err := block.GetContractABI(addr)
+	if err != nil {
+		return ""
+	}
+	return TokenStandard(abi)
+}
+
+// TokenBalance is the balance of an account in an NRC20 token.
+type TokenBalance struct {
+	Name     string
+	Symbol   string
+	Decimals uint32
+	Balance  string
+}
+
+// GetTokenBalance returns the balance of the owner in the NRC20 token, read by the calls
+// of the token simulated against the state of the block.
+func (block *Block) GetTokenBalance(contract, owner *Address) (*TokenBalance, error) {
+	if block.tokenStandard(contract.String()) != NRC20Standard {
+		return nil, ErrNotNRC20Token
+	}
+	balance := new(TokenBalance)
+	if err := block.callToken(contract, "name", "", &balance.Name); err != nil {
+		return nil, err
+	}
+	if err := block.callToken(contract, "symbol", "", &balance.Symbol); err != nil {
+		return nil, err
+	}
+	if err := block.callToken(contract, "decimals", "", &balance.Decimals); err != nil {
+		return nil, err
+	}
+	args, err := json.Marshal([]string{owner.String()})
+	if err != nil {
+		return nil, err
+	}
+	if err := block.callToken(contract, "balanceOf", string(args), &balance.Balance); err != nil {
+		return nil, err
+	}
+	return balance, nil
+}
+
+func (block *Block) callToken(contract *Address, function, args string, v interface{}) error {
+	payload, err := NewCallPayload(function, args).ToBytes()
+	if err != nil {
+		return err
+	}
+	tx := NewTransaction(block.header.chainID, contract, contract, util.NewUint128(), 0, TxPayloadCallType, payload, TransactionGasPrice, TransactionMaxGas)
+	result, err := block.SimulateCall(tx)
+	if err != nil {
+		return err
+	}
+	if result.Err != nil {
+		return result.Err
+	}
+	return json.Unmarshal([]byte(result.Result), v)
+}
+
+// TokenHoldings is the non-fungible tokens owned by an account in an NRC721 token.
+type TokenHoldings struct {
+	Name     string
+	Symbol   string
+	TokenIDs []string
+}
+
+// GetTokenHoldings returns the ids of the tokens owned by the owner in the NRC721 token, read by
+// the calls of the token simulated against the state of the block.
+func (block *Block) GetTokenHoldings(contract, owner *Address) (*TokenHoldings, error) {
+	if block.tokenStandard(contract.String()) != NRC721Standard {
+		return nil, ErrNotNRC721Token
+	}
+	holdings := new(TokenHoldings)
+	if err := block.callToken(contract, "name", "", &holdings.Name); err != nil {
+		return nil, err
+	}
+	if err := block.callToken(contract, "symbol", "", &holdings.Symbol); err != nil {
+		return nil, err
+	}
+	args, err := json.Marshal([]string{owner.String()})
+	if err != nil {
+		return nil, err
+	}
+	if err := block.callToken(contract, "tokensOfOwner", string(args), &holdings.TokenIDs); err != nil {
+		return nil, err
+	}
+	return holdings, nil
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTokenTransfer(t *testing.T) {
+	tests := []struct {
+		name     string
+		log      *nvm.ContractLog
+		expected *TokenTransfer
+	}{
+		{"transfer", &nvm.ContractLog{Address: "c", Topics: []string{"Transfer", "a", "b"}, Data: `{"value":"10"}`}, &TokenTransfer{Contract: "c", From: "a", To: "b", Value: "10"}},
+		{"mint", &nvm.ContractLog{Address: "c", Topics: []string{"Transfer", "", "b"}, Data: `{"value":"10"}`}, &TokenTransfer{Contract: "c", From: "", To: "b", Value: "10"}},
+		{"nft transfer", &nvm.ContractLog{Address: "c", Topics: []string{"Transfer", "a", "b"}, Data: `{"tokenId":"7"}`}, &TokenTransfer{Contract: "c", From: "a", To: "b", TokenID: "7"}},
+		{"other log", &nvm.ContractLog{Address: "c", Topics: []string{"Approve", "a", "b"}, Data: `{"value":"10"}`}, nil},
+		{"not indexed", &nvm.ContractLog{Address: "c", Topics: []string{"Transfer"}, Data: `{"value":"10"}`}, nil},
+		{"negative value", &nvm.ContractLog{Address: "c", Topics: []string{"Transfer", "a", "b"}, Data: `{"value":"-1"}`}, nil},
+		{"invalid data", &nvm.ContractLog{Address: "c", Topics: []string{"Transfer", "a", "b"}, Data: `10`}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseTokenTransfer(tt.log))
+		})
+	}
+}
+
+func TestBlock_Token(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	execute := func(tx *Transaction) {
+		tx.hash, _ = HashTransaction(tx)
+		payload, err := tx.LoadPayload()
+		assert.Nil(t, err)
+		ctx := NewPayloadContext(block, tx)
+		assert.Nil(t, ctx.BeginBatch())
+		_, err = payload.Execute(ctx)
+		assert.Nil(t, err)
+		ctx.Commit()
+		assert.Nil(t, block.acceptTransaction(tx))
+	}
+
+	deploy, _ := NewDeployPayload(`module.exports = require("nrc20.js");`, "js", `["Token", "TK", 2, "100"]`).ToBytes()
+	deployTx := mockTransaction(bc.chainID, 0, TxPayloadDeployType, deploy)
+	execute(deployTx)
+	token, _ := deployTx.GenerateContractAddress()
+
+	receiver := mockAddress()
+	transferTx := mockCallTransaction(bc.chainID, 1, "transfer", `["`+receiver.String()+`", "500"]`)
+	transferTx.from = deployTx.from
+	transferTx.to = token
+	execute(transferTx)
+
+	plain, _ := NewDeployPayload(`var Contract = function () {};
+Contract.prototype = {
+    init: function () {},
+    transfer: function (to, value) {
+        Event.emit("Transfer", [Blockchain.transaction.from, to], {value: value});
+    }
+};
+module.exports = Contract;`, "js", "").ToBytes()
+	plainTx := mockTransaction(bc.chainID, 0, TxPayloadDeployType, plain)
+	execute(plainTx)
+	contract, _ := plainTx.GenerateContractAddress()
+	fakeTx := mockCallTransaction(bc.chainID, 1, "transfer", `["`+receiver.String()+`", "500"]`)
+	fakeTx.to = contract
+	execute(fakeTx)
+	block.commit()
+
+	transfers, err := block.FetchTokenTransfers(transferTx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, []*TokenTransfer{{Contract: token.String(), From: deployTx.from.String(), To: receiver.String(), Value: "500"}}, transfers)
+
+	// the transfer logs of contracts which are not NRC20 tokens are ignored.
+	transfers, err = block.FetchTokenTransfers(fakeTx.Hash())
+	assert.Nil(t, err)
+	assert.Empty(t, transfers)
+
+	balance, err := block.GetTokenBalance(token, receiver)
+	assert.Nil(t, err)
+	assert.Equal(t, &TokenBalance{Name: "Token", Symbol: "TK", Decimals: 2, Balance: "500"}, balance)
+	balance, err = block.GetTokenBalance(token, deployTx.from)
+	assert.Nil(t, err)
+	assert.Equal(t, "9500", balance.Balance)
+
+	_, err = block.GetTokenBalance(contract, receiver)
+	assert.Equal(t, ErrNotNRC20Token, err)
+}
+
+func TestTokenStandard(t *testing.T) {
+	abiOf := func(names []string) *nvm.ABI {
+		abi := new(nvm.ABI)
+		for _, name := range names {
+			abi.Functions = append(abi.Functions, &nvm.ABIFunction{Name: name})
+		}
+		return abi
+	}
+	assert.Equal(t, NRC20Standard, TokenStandard(abiOf(NRC20Functions)))
+	assert.Equal(t, NRC721Standard, TokenStandard(abiOf(append(NRC721Functions, "mint"))))
+	assert.Equal(t, "", TokenStandard(abiOf(NRC721Functions[1:])))
+	assert.Equal(t, "", TokenStandard(nil))
+}
+
+func TestBlock_NonFungibleToken(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	execute := func(tx *Transaction) {
+		tx.hash, _ = HashTransaction(tx)
+		payload, err := tx.LoadPayload()
+		assert.Nil(t, err)
+		ctx := NewPayloadContext(block, tx)
+		assert.Nil(t, ctx.BeginBatch())
+		_, err = payload.Execute(ctx)
+		assert.Nil(t, err)
+		ctx.Commit()
+		assert.Nil(t, block.acceptTransaction(tx))
+	}
+
+	deploy, _ := NewDeployPayload(`module.exports = require("nrc721.js");`, "js", `["Kitty", "KT"]`).ToBytes()
+	deployTx := mockTransaction(bc.chainID, 0, TxPayloadDeployType, deploy)
+	execute(deployTx)
+	token, _ := deployTx.GenerateContractAddress()
+	minter := deployTx.from
+
+	receiver := mockAddress()
+	for i, id := range []string{"1", "2"} {
+		mintTx := mockCallTransaction(bc.chainID, uint64(i+1), "mint", `["`+minter.String()+`", "`+id+`"]`)
+		mintTx.from = minter
+		mintTx.to = token
+		execute(mintTx)
+	}
+	transferTx := mockCallTransaction(bc.chainID, 3, "transferFrom", `["`+minter.String()+`", "`+receiver.String()+`", "2"]`)
+	transferTx.from = minter
+	transferTx.to = token
+	execute(transferTx)
+	block.commit()
+
+	transfers, err := block.FetchTokenTransfers(transferTx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, []*TokenTransfer{{Contract: token.String(), From: minter.String(), To: receiver.String(), TokenID: "2"}}, transfers)
+
+	holdings, err := block.GetTokenHoldings(token, minter)
+	assert.Nil(t, err)
+	assert.Equal(t, &TokenHoldings{Name: "Kitty", Symbol: "KT", TokenIDs: []string{"1"}}, holdings)
+	holdings, err = block.GetTokenHoldings(token, receiver)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2"}, holdings.TokenIDs)
+
+	_, err = block.GetTokenBalance(token, receiver)
+	assert.Equal(t, ErrNotNRC20Token, err)
+}
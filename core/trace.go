@@ -0,0 +1,161 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// TraceResult is the trace of a contract transaction re-executed in its block.
+type TraceResult struct {
+	// Steps are the source lines executed with their gas and the storage accessed, in order.
+	Steps []*nvm.TraceStep
+	// GasUsed is the gas used by the transaction.
+	GasUsed *util.Uint128
+	// Err is the error failing the execution.
+	Err error
+	// StateDiffs are the keys of contract storage written, empty if the execution fails.
+	StateDiffs []*nvm.StateDiff
+	// Events are the events emitted by the transaction, empty if the execution fails.
+	Events []*Event
+}
+
+// MaxTraceSearchBlocks is the most blocks searched back from the tail for the block of a transaction to trace.
+const MaxTraceSearchBlocks = 100000
+
+// FindTransactionBlock return the block of the canonical chain including the transaction, searched back from
+// the tail until the blocks older than the transaction.
+func (bc *BlockChain) FindTransactionBlock(txHash byteutils.Hash) (*Block, error) {
+	tx := bc.GetTransaction(txHash)
+	if tx == nil {
+		return nil, ErrTraceTxNotFound
+	}
+	block := bc.TailBlock()
+	for i := 0; block != nil && i < MaxTraceSearchBlocks; i++ {
+		if block.Timestamp()+AcceptedNetWorkDelay < tx.Timestamp() || CheckGenesisBlock(block) {
+			break
+		}
+		for _, v := range block.transactions {
+			if v.Hash().Equals(txHash) {
+				return block, nil
+			}
+		}
+		block = bc.GetBlock(block.ParentHash())
+	}
+	return nil, ErrTraceTxNotFound
+}
+
+// TraceTransaction re-executes the contract transaction in a sandbox of its block, after the transactions
+// before it, and records the steps of the contracts, for debugging failed calls. Nothing is kept.
+func (bc *BlockChain) TraceTransaction(blockHash, txHash byteutils.Hash) (*TraceResult, error) {
+	block := bc.GetBlock(blockHash)
+	if block == nil {
+		return nil, ErrTraceBlockNotFound
+	}
+	parent := bc.GetBlock(block.ParentHash())
+	if parent == nil {
+		return nil, ErrMissingParentBlock
+	}
+	replay, err := block.replaySandbox(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range block.transactions {
+		if tx.Hash().Equals(txHash) {
+			return replay.traceTransaction(tx)
+		}
+		if _, err := replay.executeTransaction(tx); err != nil {
+			return nil, err
+		}
+	}
+	return nil, ErrTraceTxNotInBlock
+}
+
+// traceTransaction executes the contract transaction as VerifyExecution does, with a tracer attached,
+// the result of the function isn't kept so that the gas is the same.
+func (block *Block) traceTransaction(tx *Transaction) (*TraceResult, error) {
+	if tx.Type() != TxPayloadCallType && tx.Type() != TxPayloadDeployType && tx.Type() != TxPayloadMultiCallType {
+		return nil, ErrTraceNonContract
+	}
+	payload, err := tx.LoadPayload()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := block.checkTransaction(tx); err != nil {
+		return nil, err
+	}
+	if err := block.chargeStorageRent(tx.Hash(), block.accState.GetOrCreateUserAccount(tx.to.address)); err != nil {
+		return nil, err
+	}
+
+	gasUsed := tx.GasCountOfTxBase()
+	gasUsed.Add(gasUsed.Int, payload.BaseGasCount().Int)
+	if tx.gasLimit.Cmp(gasUsed.Int) < 0 {
+		return &TraceResult{Steps: []*nvm.TraceStep{}, GasUsed: tx.gasLimit, Err: ErrOutOfGasLimit}, nil
+	}
+
+	tracer := nvm.NewTracer()
+	ctx := NewPayloadContext(block, tx)
+	ctx.tracer = tracer
+	if err := ctx.BeginBatch(); err != nil {
+		return nil, err
+	}
+	gasExecution, err := payload.Execute(ctx)
+	gas := util.NewUint128FromBigInt(util.NewUint128().Add(gasUsed.Int, gasExecution.Int))
+	result := &TraceResult{Steps: tracer.Steps(), GasUsed: gas, Err: err, StateDiffs: []*nvm.StateDiff{}}
+	if err == nil {
+		result.GasUsed = ctx.refundGas(gas)
+		if result.StateDiffs, err = block.FetchStateDiffs(tx.Hash()); err != nil {
+			return nil, err
+		}
+		if result.Events, err = block.FetchEvents(tx.Hash()); err != nil {
+			return nil, err
+		}
+	} else {
+		result.Events = []*Event{}
+	}
+	return result, nil
+}
+
+// replaySandbox returns a sandbox of the block holding the state left by its parent,
+// before any transaction of the block executes.
+func (block *Block) replaySandbox(parent *Block) (*Block, error) {
+	replay, err := parent.sandbox()
+	if err != nil {
+		return nil, err
+	}
+	context, err := replay.NextDynastyContext(block.Timestamp() - parent.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+	header := *block.header
+	replay.header = &header
+	replay.transactions = block.transactions
+	replay.evidences = block.evidences
+	replay.height = block.height
+	replay.parenetBlock = parent
+	replay.miner = block.miner
+	if err := replay.LoadDynastyContext(context); err != nil {
+		return nil, err
+	}
+	return replay, nil
+}
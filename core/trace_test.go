@@ -0,0 +1,92 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_TraceTransaction(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	deployTx := mockDeployTransaction(bc.chainID, 0)
+	assert.Nil(t, block.acceptTransaction(deployTx))
+	payload, _ := deployTx.LoadPayload()
+	ctx := NewPayloadContext(block, deployTx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, err := payload.Execute(ctx)
+	assert.Nil(t, err)
+	ctx.Commit()
+	block.commit()
+	contract, _ := deployTx.GenerateContractAddress()
+
+	replay, err := block.sandbox()
+	assert.Nil(t, err)
+	callTx := mockCallTransaction(bc.chainID, 1, "pay", `[{"sender":"someone"}, 10]`)
+	callTx.to = contract
+	result, err := replay.traceTransaction(callTx)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.True(t, result.GasUsed.Cmp(callTx.GasCountOfTxBase().Int) > 0)
+
+	var lines, gets int
+	puts := make(map[string]string)
+	gas := uint64(0)
+	for _, step := range result.Steps {
+		assert.Equal(t, contract.address.String(), step.Contract)
+		switch step.Op {
+		case nvm.TraceOpLine:
+			assert.True(t, step.Line > 0)
+			lines++
+		case nvm.TraceOpStorageGet:
+			gets++
+		case nvm.TraceOpStoragePut:
+			puts[step.Key] = step.Value
+		}
+		gas += step.Gas
+	}
+	assert.True(t, lines > 0)
+	assert.True(t, gets > 0)
+	assert.Equal(t, map[string]string{"@balances[someone]": "10", "totalIssued": "10"}, puts)
+	assert.True(t, gas <= result.GasUsed.Uint64())
+	assert.NotNil(t, result.Events)
+
+	// the failure is in the result with the steps before it.
+	callTx = mockCallTransaction(bc.chainID, 1, "pay", `[{"sender":"someone"}, 10000000000]`)
+	callTx.to = contract
+	result, err = replay.traceTransaction(callTx)
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Err)
+	assert.NotEmpty(t, result.Steps)
+	assert.Empty(t, result.Events)
+
+	binaryTx := mockTransaction(bc.chainID, 1, TxPayloadBinaryType, nil)
+	binaryTx.value = util.NewUint128FromInt(1)
+	_, err = replay.traceTransaction(binaryTx)
+	assert.Equal(t, ErrTraceNonContract, err)
+
+	_, err = bc.FindTransactionBlock(callTx.Hash())
+	assert.Equal(t, ErrTraceTxNotFound, err)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
@@ -60,6 +61,8 @@ var (
 	DelegateBaseGasCount = util.NewUint128FromInt(20000)
 	// CandidateBaseGasCount is base gas count of candidate transaction
 	CandidateBaseGasCount = util.NewUint128FromInt(20000)
+	// UpgradeBaseGasCount is base gas count of upgrade transaction
+	UpgradeBaseGasCount = util.NewUint128FromInt(20000)
 	// ZeroGasCount is zero gas count
 	ZeroGasCount = util.NewUint128()
 
@@ -291,6 +294,12 @@ func (tx *Transaction) LoadPayload() (TxPayload, error) {
 		payload, err = LoadCandidatePayload(tx.data.Payload)
 	case TxPayloadDelegateType:
 		payload, err = LoadDelegatePayload(tx.data.Payload)
+	case TxPayloadUpgradeType:
+		payload, err = LoadUpgradePayload(tx.data.Payload)
+	case TxPayloadOracleType:
+		payload, err = LoadOraclePayload(tx.data.Payload)
+	case TxPayloadMultiCallType:
+		payload, err = LoadMultiCallPayload(tx.data.Payload)
 	default:
 		err = ErrInvalidTxPayloadType
 	}
@@ -299,6 +308,12 @@ func (tx *Transaction) LoadPayload() (TxPayload, error) {
 
 // VerifyExecution transaction and return result.
 func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
+	return tx.verifyExecution(block, nil, nil)
+}
+
+// verifyExecution executes the transaction, the steps of the contracts are recorded in the tracer if not nil.
+// If simulated is not nil, it keeps the value returned by the contract and the error failing the execution.
+func (tx *Transaction) verifyExecution(block *Block, tracer *nvm.Tracer, simulated *SimulateResult) (*util.Uint128, error) {
 	// check balance.
 	fromAcc := block.accState.GetOrCreateUserAccount(tx.from.address)
 	toAcc := block.accState.GetOrCreateUserAccount(tx.to.address)
@@ -326,10 +341,18 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 		tx.gasConsumption(fromAcc, coinbaseAcc, gasUsed)
 		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		simulated.fail(err, gasUsed)
 		return gasUsed, nil
 	}
 
+	// the storage rent is charged even if the call fails.
+	if err := block.chargeStorageRent(tx.Hash(), toAcc); err != nil {
+		return util.NewUint128(), err
+	}
+
 	ctx := NewPayloadContext(block, tx)
+	ctx.tracer = tracer
+	ctx.simulated = simulated != nil
 
 	err = ctx.BeginBatch()
 	if err != nil {
@@ -346,7 +369,8 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 		executeTxErrCounter.Inc(1)
 
 		tx.gasConsumption(fromAcc, coinbaseAcc, tx.gasLimit)
-		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		tx.triggerEvent(TopicExecuteTxFailed, block, ErrOutOfGasLimit)
+		simulated.fail(ErrOutOfGasLimit, gasUsed)
 		return tx.gasLimit, nil
 	}
 
@@ -358,8 +382,14 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 		ctx.Commit()
 	}
 
-	// gas = tx.GasCountOfTxBase() +  gasExecution
+	// gas = tx.GasCountOfTxBase() +  gasExecution - refund
 	gas := util.NewUint128FromBigInt(util.NewUint128().Add(gasUsed.Int, gasExecution.Int))
+	if simulated != nil {
+		simulated.Result, simulated.GasLimit = ctx.Result(), gas
+	}
+	if err == nil {
+		gas = ctx.refundGas(gas)
+	}
 
 	logging.VLog().WithFields(logrus.Fields{
 		"tx":           tx,
@@ -383,6 +413,7 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 		executeTxErrCounter.Inc(1)
 		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		simulated.fail(err, nil)
 	} else {
 		if fromAcc.Balance().Cmp(tx.value.Int) < 0 {
 			logging.VLog().WithFields(logrus.Fields{
@@ -393,10 +424,14 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 			executeTxErrCounter.Inc(1)
 			tx.triggerEvent(TopicExecuteTxFailed, block, ErrInsufficientBalance)
+			simulated.fail(ErrInsufficientBalance, nil)
 		} else {
 			// accept the transaction
 			fromAcc.SubBalance(tx.value)
 			toAcc.AddBalance(tx.value)
+			if err := block.reviveContract(tx.Hash(), toAcc); err != nil {
+				return util.NewUint128(), err
+			}
 
 			executeTxCounter.Inc(1)
 			// record tx execution success event
@@ -420,11 +455,11 @@ func (tx *Transaction) triggerEvent(topic string, block *Block, err error) {
 		var (
 			txErrEvent struct {
 				Transaction proto.Message `json:"transaction"`
-				Error       error         `json:"error"`
+				Error       string        `json:"error"`
 			}
 		)
 		txErrEvent.Transaction = pbTx
-		txErrEvent.Error = err
+		txErrEvent.Error = err.Error()
 		txData, _ = json.Marshal(txErrEvent)
 	} else {
 		txData, _ = json.Marshal(pbTx)
@@ -0,0 +1,168 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Tx announcement constants
+const (
+	// TxFetchTimeout is how long a tx is waited from a peer before being fetched from the next one announcing it.
+	TxFetchTimeout = 5 * time.Second
+
+	// TxFetchPeers is the maximum number of peers announcing a tx kept to fetch it from.
+	TxFetchPeers = 4
+
+	// MaxTxHashesPerMsg is the maximum number of hashes in a tx announcement or fetch.
+	MaxTxHashesPerMsg = 256
+)
+
+// txAnnouncement is an announced tx being fetched from the peers announcing it, one at a time.
+type txAnnouncement struct {
+	hash       byteutils.Hash
+	announcers []string
+	requested  time.Time
+}
+
+// CompactName return the message name of the tx announcement.
+func (tx *Transaction) CompactName() string {
+	return MessageTypeTxHashes
+}
+
+// CompactCapability return the capability of the peers accepting tx announcements.
+func (tx *Transaction) CompactCapability() string {
+	return p2p.CapabilityAnnounce
+}
+
+// ToCompactProto converts domain Transaction into the proto TxHashes announcing it.
+func (tx *Transaction) ToCompactProto() (proto.Message, error) {
+	return &corepb.TxHashes{Hashes: [][]byte{tx.hash}}, nil
+}
+
+func (pool *TransactionPool) parseTxHashes(msg net.Message) [][]byte {
+	pbHashes := new(corepb.TxHashes)
+	if err := proto.Unmarshal(msg.Data().([]byte), pbHashes); err != nil || len(pbHashes.Hashes) > MaxTxHashesPerMsg {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to unmarshal data.")
+		pool.nm.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return nil
+	}
+	return pbHashes.Hashes
+}
+
+// handleTxHashes fetch the announced txs not in pool from the announcer,
+// or remember it to fetch them from if they are already being fetched.
+func (pool *TransactionPool) handleTxHashes(msg net.Message) {
+	hashes := pool.parseTxHashes(msg)
+
+	var fetch [][]byte
+	for _, v := range hashes {
+		hash := byteutils.Hash(v)
+		if pool.get(hash) != nil {
+			continue
+		}
+		if ann, ok := pool.announced[hash.Hex()]; ok {
+			if len(ann.announcers) < TxFetchPeers {
+				ann.announcers = append(ann.announcers, msg.MessageFrom())
+			}
+			continue
+		}
+		pool.announced[hash.Hex()] = &txAnnouncement{
+			hash:       hash,
+			announcers: []string{msg.MessageFrom()},
+			requested:  time.Now(),
+		}
+		fetch = append(fetch, hash)
+	}
+	pool.fetchTxs(msg.MessageFrom(), fetch)
+}
+
+// handleGetTxs send the txs asked for in pool to the peer.
+func (pool *TransactionPool) handleGetTxs(msg net.Message) {
+	hashes := pool.parseTxHashes(msg)
+
+	for _, v := range hashes {
+		tx := pool.get(v)
+		if tx == nil {
+			continue
+		}
+		pbTx, err := tx.ToProto()
+		if err != nil {
+			continue
+		}
+		data, err := proto.Marshal(pbTx)
+		if err != nil {
+			continue
+		}
+		pool.nm.SendMsg(MessageTypeNewTx, data, msg.MessageFrom())
+	}
+}
+
+func (pool *TransactionPool) get(hash byteutils.Hash) *Transaction {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+	return pool.all[hash.Hex()]
+}
+
+// refetchTxs fetch the txs not received in time from the next peers announcing them.
+func (pool *TransactionPool) refetchTxs() {
+	fetch := make(map[string][][]byte)
+	for k, ann := range pool.announced {
+		if time.Since(ann.requested) < TxFetchTimeout {
+			continue
+		}
+		ann.announcers = ann.announcers[1:]
+		if len(ann.announcers) == 0 {
+			delete(pool.announced, k)
+			continue
+		}
+		ann.requested = time.Now()
+		fetch[ann.announcers[0]] = append(fetch[ann.announcers[0]], ann.hash)
+	}
+	for pid, hashes := range fetch {
+		pool.fetchTxs(pid, hashes)
+	}
+}
+
+func (pool *TransactionPool) fetchTxs(pid string, hashes [][]byte) {
+	for len(hashes) > 0 {
+		n := len(hashes)
+		if n > MaxTxHashesPerMsg {
+			n = MaxTxHashesPerMsg
+		}
+		data, err := proto.Marshal(&corepb.TxHashes{Hashes: hashes[:n]})
+		if err != nil {
+			return
+		}
+		pool.nm.SendMsg(MessageTypeGetTxs, data, pid)
+		hashes = hashes[n:]
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net/messages"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxAnnouncement(t *testing.T) {
+	received = []byte{}
+
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	var n MockNetManager
+	bc.txPool.RegisterInNetwork(n)
+	from := mockAddress()
+	tx := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, []byte("1"), TransactionGasPrice, util.NewUint128FromInt(200000))
+
+	pbMsg, err := tx.ToCompactProto()
+	assert.Nil(t, err)
+	data, err := proto.Marshal(pbMsg)
+	assert.Nil(t, err)
+
+	// the first announcer is asked for the tx.
+	bc.txPool.handleTxHashes(messages.NewBaseMessage(MessageTypeTxHashes, "peer1", data))
+	getTxs := new(corepb.TxHashes)
+	assert.Nil(t, proto.Unmarshal(received, getTxs))
+	assert.Equal(t, [][]byte{tx.Hash()}, getTxs.Hashes)
+
+	// the next announcers are kept to fetch it from later.
+	received = []byte{}
+	bc.txPool.handleTxHashes(messages.NewBaseMessage(MessageTypeTxHashes, "peer2", data))
+	assert.Equal(t, []byte{}, received)
+	ann := bc.txPool.announced[tx.Hash().Hex()]
+	assert.Equal(t, []string{"peer1", "peer2"}, ann.announcers)
+
+	ann.requested = time.Now().Add(-TxFetchTimeout)
+	bc.txPool.refetchTxs()
+	assert.Equal(t, []string{"peer2"}, ann.announcers)
+	assert.NotEqual(t, []byte{}, received)
+
+	ann.requested = time.Now().Add(-TxFetchTimeout)
+	bc.txPool.refetchTxs()
+	assert.Nil(t, bc.txPool.announced[tx.Hash().Hex()])
+
+	// the txs in pool are not fetched.
+	received = []byte{}
+	bc.txPool.all[tx.Hash().Hex()] = tx
+	bc.txPool.handleTxHashes(messages.NewBaseMessage(MessageTypeTxHashes, "peer1", data))
+	assert.Equal(t, []byte{}, received)
+	assert.Equal(t, 0, len(bc.txPool.announced))
+
+	// and they are served.
+	bc.txPool.handleGetTxs(messages.NewBaseMessage(MessageTypeGetTxs, "peer1", data))
+	pbTx := new(corepb.Transaction)
+	assert.Nil(t, proto.Unmarshal(received, pbTx))
+	assert.Equal(t, []byte(tx.Hash()), pbTx.Hash)
+}
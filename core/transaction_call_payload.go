@@ -21,8 +21,10 @@ package core
 import (
 	"encoding/json"
 
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
 // CallPayload carry function call information
@@ -65,32 +67,87 @@ func (payload *CallPayload) Execute(context *PayloadContext) (*util.Uint128, err
 		return util.NewUint128(), err
 	}
 
-	engine := nvm.NewV8Engine(ctx)
+	if context.simulated {
+		ctx.KeepResult()
+	}
+	engine := nvm.NewEngine(ctx, deployPayload.SourceType)
 	defer engine.Dispose()
 
 	//add gas limit and memory use limit
-	engine.SetExecutionLimits(context.tx.PayloadGasLimit(payload).Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
+	limits := nvm.ExecutionLimitsAt(context.block.Height())
+	engine.SetExecutionLimits(limits.Instructions(context.tx.PayloadGasLimit(payload).Uint64()), limits.MaxMemorySize)
 
 	err = engine.Call(deployPayload.Source, deployPayload.SourceType, payload.Function, payload.Args)
+	context.result = engine.Result()
+	emitContractConsole(context, ctx)
+	if err == nil {
+		err = recordContractEffects(context, ctx)
+	}
 	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), err
 }
 
 func generateCallContext(ctx *PayloadContext) (*nvm.Context, *DeployPayload, error) {
+	return generateCallContextAt(ctx, ctx.tx.to, convertNvmTx(ctx.tx))
+}
 
-	contract, err := ctx.accState.GetContractAccount(ctx.tx.to.Bytes())
+// generateCallContextAt returns the context calling the contract at the address in the transaction,
+// the calls of a multi-call transaction each see their own contract and value in it.
+func generateCallContextAt(ctx *PayloadContext, to *Address, nvmTx *nvm.ContextTransaction) (*nvm.Context, *DeployPayload, error) {
+	contract, err := ctx.accState.GetContractAccount(to.Bytes())
 	if err != nil {
 		return nil, nil, err
 	}
+	if contract.Destroyed() {
+		return nil, nil, ErrContractDestroyed
+	}
+	if contract.Hibernated() {
+		return nil, nil, ErrContractHibernated
+	}
 	birthTx, err := ctx.block.GetTransaction(contract.BirthPlace())
 	if err != nil {
 		return nil, nil, err
 	}
 	owner := ctx.accState.GetOrCreateUserAccount(birthTx.from.Bytes())
-	deploy, err := LoadDeployPayload(birthTx.data.Payload)
+	deploy, err := loadContractCode(ctx.block, contract)
 	if err != nil {
 		return nil, nil, err
 	}
+	if deploy.Library {
+		return nil, nil, ErrCallLibrary
+	}
 
-	nvmctx := nvm.NewContext(ctx.block, convertNvmTx(ctx.tx), owner, contract, ctx.accState)
+	nvmctx := nvm.NewContext(ctx.block, nvmTx, owner, contract, ctx.accState)
+	nvmctx.LinkLibraries(deploy.Libraries)
+	nvmctx.Trace(ctx.tracer)
+	if ctx.block.sandboxed {
+		nvmctx.ReadOnly()
+	}
 	return nvmctx, deploy, nil
 }
+
+// ContractSource return the creator and the current code of contract, for the calls between contracts.
+func (block *Block) ContractSource(contract state.Account) (byteutils.Hash, string, string, error) {
+	if contract.Destroyed() {
+		return nil, "", "", ErrContractDestroyed
+	}
+	if contract.Hibernated() {
+		return nil, "", "", ErrContractHibernated
+	}
+	birthTx, err := block.GetTransaction(contract.BirthPlace())
+	if err != nil {
+		return nil, "", "", err
+	}
+	deploy, err := loadContractCode(block, contract)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if deploy.Library {
+		return nil, "", "", ErrCallLibrary
+	}
+	return birthTx.from.Bytes(), deploy.Source, deploy.SourceType, nil
+}
+
+// loadContractCode return the current code of contract, the latest upgrade if any.
+func loadContractCode(block *Block, contract state.Account) (*DeployPayload, error) {
+	return loadCodeAt(block, contract.CodePlace())
+}
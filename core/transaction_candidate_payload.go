@@ -21,20 +21,29 @@ package core
 import (
 	"encoding/json"
 
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
 // Candidate Action
 const (
-	LoginAction  = "login"
-	LogoutAction = "logout"
+	LoginAction      = "login"
+	LogoutAction     = "logout"
+	CommissionAction = "commission"
+	UnjailAction     = "unjail"
+	SignerAction     = "signer"
 )
 
 // CandidatePayload carry candidate application
 type CandidatePayload struct {
 	Action string
+	// commission percentage of block reward kept by the candidate, the rest goes to its voters.
+	Commission *uint32 `json:"Commission,omitempty"`
+	// key signing the blocks of the candidate besides its own from the next dynasty elected, removed if empty.
+	Signer string `json:"Signer,omitempty"`
 }
 
 // LoadCandidatePayload from bytes
@@ -53,6 +62,22 @@ func NewCandidatePayload(action string) *CandidatePayload {
 	}
 }
 
+// NewCandidateCommissionPayload declares the commission rate along with the action
+func NewCandidateCommissionPayload(action string, commission uint32) *CandidatePayload {
+	return &CandidatePayload{
+		Action:     action,
+		Commission: &commission,
+	}
+}
+
+// NewCandidateSignerPayload registers the key signing blocks for the candidate
+func NewCandidateSignerPayload(signer string) *CandidatePayload {
+	return &CandidatePayload{
+		Action: SignerAction,
+		Signer: signer,
+	}
+}
+
 // ToBytes serialize payload
 func (payload *CandidatePayload) ToBytes() ([]byte, error) {
 	return json.Marshal(payload)
@@ -68,8 +93,19 @@ func (payload *CandidatePayload) Execute(ctx *PayloadContext) (*util.Uint128, er
 	candidate := ctx.tx.from.Bytes()
 	switch payload.Action {
 	case LoginAction:
-		if _, err := ctx.dposContext.candidateTrie.Put(candidate, candidate); err != nil {
-			return ZeroGasCount, err
+		// a jailed candidate keeps jailed when login again.
+		if _, err := ctx.dposContext.candidateTrie.Get(candidate); err != nil {
+			if err != storage.ErrKeyNotFound {
+				return ZeroGasCount, err
+			}
+			if _, err := ctx.dposContext.candidateTrie.Put(candidate, candidate); err != nil {
+				return ZeroGasCount, err
+			}
+		}
+		if payload.Commission != nil {
+			if err := payload.declareCommission(ctx); err != nil {
+				return ZeroGasCount, err
+			}
 		}
 		logging.VLog().WithFields(logrus.Fields{
 			"block":     ctx.block,
@@ -77,6 +113,13 @@ func (payload *CandidatePayload) Execute(ctx *PayloadContext) (*util.Uint128, er
 			"candidate": ctx.tx.from.String(),
 		}).Info("Candidate login.")
 	case LogoutAction:
+		if value, err := ctx.dposContext.candidateTrie.Get(candidate); err == nil {
+			if _, _, jailed := parseCandidate(value); jailed {
+				return ZeroGasCount, ErrCandidateJailed
+			}
+		} else if err != storage.ErrKeyNotFound {
+			return ZeroGasCount, err
+		}
 		if err := ctx.dposContext.kickoutCandidate(candidate); err != nil {
 			return ZeroGasCount, err
 		}
@@ -85,8 +128,98 @@ func (payload *CandidatePayload) Execute(ctx *PayloadContext) (*util.Uint128, er
 			"tx":        ctx.tx,
 			"candidate": ctx.tx.from.String(),
 		}).Info("Candidate logout.")
+	case CommissionAction:
+		if payload.Commission == nil {
+			return ZeroGasCount, ErrInvalidCommissionRate
+		}
+		if _, err := ctx.dposContext.candidateTrie.Get(candidate); err != nil {
+			if err == storage.ErrKeyNotFound {
+				return ZeroGasCount, ErrInvalidCommissionFromNonCandidate
+			}
+			return ZeroGasCount, err
+		}
+		if err := payload.declareCommission(ctx); err != nil {
+			return ZeroGasCount, err
+		}
+	case SignerAction:
+		if _, err := ctx.dposContext.candidateTrie.Get(candidate); err != nil {
+			if err == storage.ErrKeyNotFound {
+				return ZeroGasCount, ErrInvalidSignerFromNonCandidate
+			}
+			return ZeroGasCount, err
+		}
+		if err := payload.registerSigner(ctx); err != nil {
+			return ZeroGasCount, err
+		}
+	case UnjailAction:
+		value, err := ctx.dposContext.candidateTrie.Get(candidate)
+		if err != nil {
+			if err == storage.ErrKeyNotFound {
+				return ZeroGasCount, ErrInvalidUnjailFromNonCandidate
+			}
+			return ZeroGasCount, err
+		}
+		_, release, jailed := parseCandidate(value)
+		if !jailed {
+			return ZeroGasCount, ErrCandidateNotJailed
+		}
+		if ctx.block.Timestamp()/DynastyInterval < release {
+			return ZeroGasCount, ErrUnjailBeforeRelease
+		}
+		if _, err := ctx.dposContext.candidateTrie.Put(candidate, candidate); err != nil {
+			return ZeroGasCount, err
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"block":     ctx.block,
+			"tx":        ctx.tx,
+			"candidate": ctx.tx.from.String(),
+		}).Info("Candidate unjailed.")
 	default:
 		return ZeroGasCount, ErrInvalidCandidatePayloadAction
 	}
 	return ZeroGasCount, nil
 }
+
+func (payload *CandidatePayload) registerSigner(ctx *PayloadContext) error {
+	var signer byteutils.Hash
+	if len(payload.Signer) > 0 {
+		addr, err := AddressParse(payload.Signer)
+		if err != nil {
+			return ErrInvalidSigner
+		}
+		if !addr.Equals(ctx.tx.from) {
+			signer = addr.Bytes()
+		}
+	}
+	if err := registerSigner(ctx.accState, ctx.tx.from.Bytes(), signer); err != nil {
+		return err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"block":     ctx.block,
+		"tx":        ctx.tx,
+		"candidate": ctx.tx.from.String(),
+		"signer":    payload.Signer,
+	}).Info("Candidate registered signer.")
+	return nil
+}
+
+func (payload *CandidatePayload) declareCommission(ctx *PayloadContext) error {
+	rate := *payload.Commission
+	if rate > MaxCommissionRate {
+		return ErrInvalidCommissionRate
+	}
+	acc := ctx.accState.GetOrCreateUserAccount(ctx.tx.from.Bytes())
+	dynastyID := ctx.block.Timestamp() / DynastyInterval
+	effective, err := declareCommission(acc, rate, dynastyID)
+	if err != nil {
+		return err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"block":     ctx.block,
+		"tx":        ctx.tx,
+		"candidate": ctx.tx.from.String(),
+		"rate":      rate,
+		"effective": effective,
+	}).Info("Candidate declared commission rate.")
+	return nil
+}
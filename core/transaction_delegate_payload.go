@@ -20,7 +20,9 @@ package core
 
 import (
 	"encoding/json"
+	"math"
 
+	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -34,10 +36,21 @@ const (
 	UnDelegateAction = "undo"
 )
 
+// MaxSplitDelegatees is the max number of delegatees a vote can be split to.
+const MaxSplitDelegatees = 10
+
 // DelegatePayload carry election information
 type DelegatePayload struct {
 	Action    string
 	Delegatee string
+	// Delegatees split the vote with weights, used instead of Delegatee.
+	Delegatees []*DelegateWeight `json:"Delegatees,omitempty"`
+}
+
+// DelegateWeight is the weight of the vote given to the delegatee
+type DelegateWeight struct {
+	Delegatee string
+	Weight    uint32
 }
 
 // LoadDelegatePayload from bytes
@@ -57,6 +70,14 @@ func NewDelegatePayload(action string, addr string) *DelegatePayload {
 	}
 }
 
+// NewSplitDelegatePayload split the vote to delegatees with weights
+func NewSplitDelegatePayload(delegatees []*DelegateWeight) *DelegatePayload {
+	return &DelegatePayload{
+		Action:     DelegateAction,
+		Delegatees: delegatees,
+	}
+}
+
 // ToBytes serialize payload
 func (payload *DelegatePayload) ToBytes() ([]byte, error) {
 	return json.Marshal(payload)
@@ -67,20 +88,133 @@ func (payload *DelegatePayload) BaseGasCount() *util.Uint128 {
 	return DelegateBaseGasCount
 }
 
+// delegation is the share of a delegator's vote given to a delegatee.
+type delegation struct {
+	delegatee *Address
+	weight    uint32
+}
+
+// delegations returns the delegatees and weights of the vote, with the total weight.
+func (payload *DelegatePayload) delegations() ([]*delegation, uint32, error) {
+	if len(payload.Delegatees) == 0 {
+		delegatee, err := AddressParse(payload.Delegatee)
+		if err != nil {
+			return nil, 0, err
+		}
+		return []*delegation{&delegation{delegatee: delegatee, weight: 1}}, 1, nil
+	}
+	if len(payload.Delegatee) > 0 || len(payload.Delegatees) > MaxSplitDelegatees {
+		return nil, 0, ErrInvalidSplitDelegation
+	}
+	delegations := []*delegation{}
+	seen := make(map[string]bool)
+	total := uint64(0)
+	for _, v := range payload.Delegatees {
+		delegatee, err := AddressParse(v.Delegatee)
+		if err != nil {
+			return nil, 0, err
+		}
+		if v.Weight == 0 || seen[delegatee.String()] {
+			return nil, 0, ErrInvalidSplitDelegation
+		}
+		seen[delegatee.String()] = true
+		total += uint64(v.Weight)
+		delegations = append(delegations, &delegation{delegatee: delegatee, weight: v.Weight})
+	}
+	if total > math.MaxUint32 {
+		return nil, 0, ErrInvalidSplitDelegation
+	}
+	return delegations, uint32(total), nil
+}
+
+// encodeDelegation returns the value stored in delegate trie,
+// a whole vote is stored as the delegator only.
+func encodeDelegation(delegator byteutils.Hash, weight uint32, total uint32) []byte {
+	if weight == total {
+		return delegator
+	}
+	value := append([]byte{}, delegator...)
+	value = append(value, byteutils.FromUint32(weight)...)
+	return append(value, byteutils.FromUint32(total)...)
+}
+
+// ParseDelegation returns the delegator and the share of its vote stored in delegate trie.
+func ParseDelegation(value []byte) (delegator byteutils.Hash, weight uint32, total uint32) {
+	if len(value) != AddressLength+8 {
+		return value, 1, 1
+	}
+	return value[:AddressLength], byteutils.Uint32(value[AddressLength : AddressLength+4]), byteutils.Uint32(value[AddressLength+4:])
+}
+
+// normalizeDelegations rewrites the delegations of the delegator to the delegatees left after one was removed,
+// so their shares still add up to the whole vote.
+func normalizeDelegations(delegateTrie *trie.BatchTrie, delegator byteutils.Hash, delegatees []byteutils.Hash) error {
+	weights := make([]uint32, len(delegatees))
+	total := uint64(0)
+	for i, v := range delegatees {
+		value, err := delegateTrie.Get(append(append(byteutils.Hash{}, v...), delegator...))
+		if err != nil && err != storage.ErrKeyNotFound {
+			return err
+		}
+		if err == nil {
+			_, weights[i], _ = ParseDelegation(value)
+			total += uint64(weights[i])
+		}
+	}
+	for i, v := range delegatees {
+		if weights[i] == 0 {
+			continue
+		}
+		key := append(append(byteutils.Hash{}, v...), delegator...)
+		if _, err := delegateTrie.Put(key, encodeDelegation(delegator, weights[i], uint32(total))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delegationVotes returns the votes of the delegation with the delegator's balance.
+func delegationVotes(balance *util.Uint128, weight uint32, total uint32) *util.Uint128 {
+	if weight == total {
+		return balance
+	}
+	votes := util.NewUint128().Mul(balance.Int, util.NewUint128FromInt(int64(weight)).Int)
+	return util.NewUint128FromBigInt(votes.Div(votes, util.NewUint128FromInt(int64(total)).Int))
+}
+
+// splitDelegatees returns the delegatees stored in vote trie.
+func splitDelegatees(value []byte) []byteutils.Hash {
+	delegatees := []byteutils.Hash{}
+	for i := 0; i+AddressLength <= len(value); i += AddressLength {
+		delegatees = append(delegatees, append(byteutils.Hash{}, value[i:i+AddressLength]...))
+	}
+	return delegatees
+}
+
+func joinDelegatees(delegatees []byteutils.Hash) []byte {
+	value := []byte{}
+	for _, v := range delegatees {
+		value = append(value, v...)
+	}
+	return value
+}
+
 // Execute the call payload in tx, call a function
 func (payload *DelegatePayload) Execute(ctx *PayloadContext) (*util.Uint128, error) {
 	delegator := ctx.tx.from.Bytes()
-	delegatee, err := AddressParse(payload.Delegatee)
+	delegations, total, err := payload.delegations()
 	if err != nil {
 		return ZeroGasCount, err
 	}
-	// check delegatee valid
-	_, err = ctx.dposContext.candidateTrie.Get(delegatee.Bytes())
-	if err != nil && err != storage.ErrKeyNotFound {
-		return ZeroGasCount, err
-	}
-	if err == storage.ErrKeyNotFound {
-		return ZeroGasCount, ErrInvalidDelegateToNonCandidate
+	// check delegatees valid
+	for _, v := range delegations {
+		_, err = ctx.dposContext.candidateTrie.Get(v.delegatee.Bytes())
+		if err != nil && err != storage.ErrKeyNotFound {
+			return ZeroGasCount, err
+		}
+		if err == storage.ErrKeyNotFound {
+			return ZeroGasCount, ErrInvalidDelegateToNonCandidate
+		}
 	}
 	pre, err := ctx.dposContext.voteTrie.Get(delegator)
 	if err != nil && err != storage.ErrKeyNotFound {
@@ -88,35 +222,59 @@ func (payload *DelegatePayload) Execute(ctx *PayloadContext) (*util.Uint128, err
 	}
 	switch payload.Action {
 	case DelegateAction:
-		if err != storage.ErrKeyNotFound {
-			key := append(pre, delegator...)
-			if _, err = ctx.dposContext.delegateTrie.Del(key); err != nil {
+		// the new vote replaces all the previous delegations.
+		for _, v := range splitDelegatees(pre) {
+			key := append(v, delegator...)
+			if _, err = ctx.dposContext.delegateTrie.Del(key); err != nil && err != storage.ErrKeyNotFound {
 				return ZeroGasCount, err
 			}
 		}
-		key := append(delegatee.Bytes(), delegator...)
-		if _, err = ctx.dposContext.delegateTrie.Put(key, delegator); err != nil {
-			return ZeroGasCount, err
+		delegatees := []byteutils.Hash{}
+		for _, v := range delegations {
+			key := append(v.delegatee.Bytes(), delegator...)
+			if _, err = ctx.dposContext.delegateTrie.Put(key, encodeDelegation(delegator, v.weight, total)); err != nil {
+				return ZeroGasCount, err
+			}
+			delegatees = append(delegatees, v.delegatee.Bytes())
 		}
-		if _, err = ctx.dposContext.voteTrie.Put(delegator, delegatee.Bytes()); err != nil {
+		if _, err = ctx.dposContext.voteTrie.Put(delegator, joinDelegatees(delegatees)); err != nil {
 			return ZeroGasCount, err
 		}
 		logging.VLog().WithFields(logrus.Fields{
-			"block":     ctx.block,
-			"tx":        ctx.tx,
-			"delegatee": delegatee.String(),
-			"pre":       byteutils.Hex(pre),
+			"block":      ctx.block,
+			"tx":         ctx.tx,
+			"delegatees": delegatees,
+			"pre":        byteutils.Hex(pre),
 		}).Info("Delegate candidate.")
 	case UnDelegateAction:
-		if !delegatee.address.Equals(pre) {
+		if len(delegations) != 1 {
+			return ZeroGasCount, ErrInvalidSplitDelegation
+		}
+		delegatee := delegations[0].delegatee
+		remains := []byteutils.Hash{}
+		for _, v := range splitDelegatees(pre) {
+			if !delegatee.address.Equals(v) {
+				remains = append(remains, v)
+			}
+		}
+		if len(remains) == len(splitDelegatees(pre)) {
 			return ZeroGasCount, ErrInvalidUnDelegateFromNonDelegatee
 		}
 		key := append(delegatee.Bytes(), delegator...)
 		if _, err = ctx.dposContext.delegateTrie.Del(key); err != nil {
 			return ZeroGasCount, err
 		}
-		if _, err = ctx.dposContext.voteTrie.Del(delegator); err != nil {
-			return ZeroGasCount, err
+		if len(remains) == 0 {
+			if _, err = ctx.dposContext.voteTrie.Del(delegator); err != nil {
+				return ZeroGasCount, err
+			}
+		} else {
+			if _, err = ctx.dposContext.voteTrie.Put(delegator, joinDelegatees(remains)); err != nil {
+				return ZeroGasCount, err
+			}
+			if err = normalizeDelegations(ctx.dposContext.delegateTrie, delegator, remains); err != nil {
+				return ZeroGasCount, err
+			}
 		}
 		logging.VLog().WithFields(logrus.Fields{
 			"block":     ctx.block,
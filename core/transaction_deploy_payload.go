@@ -20,9 +20,11 @@ package core
 
 import (
 	"encoding/json"
+	"math/big"
 
 	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
 // DeployPayload carry contract deploy information
@@ -30,6 +32,12 @@ type DeployPayload struct {
 	SourceType string
 	Source     string
 	Args       string
+	// Admin is the address allowed to upgrade the contract code, not upgradeable if empty.
+	Admin string `json:",omitempty"`
+	// Library deploys the source as a library for contracts to require, it has no init and can't be called.
+	Library bool `json:",omitempty"`
+	// Libraries are the addresses of the libraries linked by the contract, by the names it requires them.
+	Libraries map[string]string `json:",omitempty"`
 }
 
 // LoadDeployPayload from bytes
@@ -62,22 +70,64 @@ func (payload *DeployPayload) BaseGasCount() *util.Uint128 {
 
 // Execute deploy payload in tx, deploy a new contract
 func (payload *DeployPayload) Execute(ctx *PayloadContext) (*util.Uint128, error) {
-	nvmctx, err := generateDeployContext(ctx)
+	var admin byteutils.Hash
+	if len(payload.Admin) > 0 {
+		addr, err := AddressParse(payload.Admin)
+		if err != nil {
+			return util.NewUint128(), ErrInvalidContractAdmin
+		}
+		admin = addr.Bytes()
+	}
+	codeGas, err := contractCodeGas(ctx.block, payload.Source)
+	if err != nil {
+		return util.NewUint128(), err
+	}
+	gasLimit := ctx.tx.PayloadGasLimit(payload)
+	if gasLimit.Cmp(codeGas.Int) < 0 {
+		return gasLimit, ErrOutOfGasLimit
+	}
+	if payload.Library {
+		return deployLibrary(ctx, payload, codeGas)
+	}
+	if err := ctx.block.checkLibraries(payload.Libraries); err != nil {
+		return util.NewUint128(), err
+	}
+	nvmctx, err := generateDeployContext(ctx, admin)
 	if err != nil {
 		return util.NewUint128(), err
 	}
+	nvmctx.LinkLibraries(payload.Libraries)
 
-	engine := nvm.NewV8Engine(nvmctx)
+	engine := nvm.NewEngine(nvmctx, payload.SourceType)
 	defer engine.Dispose()
 
-	engine.SetExecutionLimits(ctx.tx.PayloadGasLimit(payload).Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
+	limits := nvm.ExecutionLimitsAt(ctx.block.Height())
+	engine.SetExecutionLimits(limits.Instructions(gasLimit.Uint64()-codeGas.Uint64()), limits.MaxMemorySize)
 
 	// Deploy and Init.
 	err = engine.DeployAndInit(payload.Source, payload.SourceType, payload.Args)
-	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), err
+	emitContractConsole(ctx, nvmctx)
+	if err == nil {
+		err = recordContractEffects(ctx, nvmctx)
+	}
+	if err == nil {
+		err = ctx.block.saveContractABI(nvmctx.Contract(), engine.ABI())
+	}
+	gas := new(big.Int).Add(codeGas.Int, new(big.Int).SetUint64(engine.ExecutionInstructions()))
+	return util.NewUint128FromBigInt(gas), err
 }
 
-func generateDeployContext(ctx *PayloadContext) (*nvm.Context, error) {
+// contractCodeGas returns the gas of the contract source in the gas table of the block,
+// failing if the source exceeds the max size.
+func contractCodeGas(block *Block, source string) (*util.Uint128, error) {
+	table := nvm.GasTableAt(block.height)
+	if table.MaxCodeSize > 0 && len(source) > int(table.MaxCodeSize) {
+		return nil, ErrContractCodeTooLarge
+	}
+	return table.CodeGas(len(source)), nil
+}
+
+func generateDeployContext(ctx *PayloadContext, admin byteutils.Hash) (*nvm.Context, error) {
 	addr, err := ctx.tx.GenerateContractAddress()
 	if err != nil {
 		return nil, err
@@ -87,10 +137,75 @@ func generateDeployContext(ctx *PayloadContext) (*nvm.Context, error) {
 	if err != nil {
 		return nil, err
 	}
+	contract.SetAdmin(admin)
+	contract.SetRentHeight(ctx.block.height)
 	nvmctx := nvm.NewContext(ctx.block, convertNvmTx(ctx.tx), owner, contract, ctx.accState)
+	nvmctx.Trace(ctx.tracer)
+	if ctx.block.sandboxed {
+		nvmctx.ReadOnly()
+	}
 	return nvmctx, nil
 }
 
+// recordContractEffects records the transfers, logs, state diffs, deployments, oracle requests and self-destructs of contracts in a succeeded
+// execution, the transfers of failed ones are reverted with the state.
+func recordContractEffects(ctx *PayloadContext, nvmctx *nvm.Context) error {
+	for _, v := range nvmctx.Transfers() {
+		if err := recordContractEvent(ctx, TopicTransferFromContract, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range nvmctx.Logs() {
+		if err := recordContractEvent(ctx, TopicContractLog, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range nvmctx.StateDiffs() {
+		if err := recordContractEvent(ctx, TopicContractStateDiff, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range nvmctx.Creations() {
+		if err := recordContractEvent(ctx, TopicContractCreated, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range nvmctx.OracleRequests() {
+		if err := saveOracleRequest(ctx, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range nvmctx.Destructs() {
+		if err := destroyContract(ctx, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emitContractConsole sends the console output of contracts to the subscribers,
+// it's not kept in the block and is sent even if the execution fails.
+func emitContractConsole(ctx *PayloadContext, nvmctx *nvm.Context) {
+	if ctx.block.eventEmitter == nil {
+		return
+	}
+	for _, v := range nvmctx.Console() {
+		data, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		ctx.block.eventEmitter.Trigger(&Event{Topic: TopicContractConsole, Data: string(data)})
+	}
+}
+
+func recordContractEvent(ctx *PayloadContext, topic string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return ctx.block.RecordEvent(ctx.tx.Hash(), topic, string(data))
+}
+
 func convertNvmTx(tx *Transaction) *nvm.ContextTransaction {
 	ctxTx := &nvm.ContextTransaction{
 		From:      tx.from.String(),
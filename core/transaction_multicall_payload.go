@@ -0,0 +1,174 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxMultiCalls is the max calls in a multi-call transaction.
+const MaxMultiCalls = 16
+
+// ContractCall is a call of a multi-call transaction, the value is sent from the sender to the contract.
+type ContractCall struct {
+	Contract string
+	Function string
+	Args     string
+	Value    string `json:",omitempty"`
+}
+
+// MultiCallPayload carry the calls executed in order in one transaction, all of them fail if any fails.
+// The transaction is sent to its sender without value, the calls carry the values.
+type MultiCallPayload struct {
+	Calls []*ContractCall
+}
+
+// LoadMultiCallPayload from bytes
+func LoadMultiCallPayload(bytes []byte) (*MultiCallPayload, error) {
+	payload := &MultiCallPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewMultiCallPayload with the calls
+func NewMultiCallPayload(calls []*ContractCall) *MultiCallPayload {
+	return &MultiCallPayload{Calls: calls}
+}
+
+// ToBytes serialize payload
+func (payload *MultiCallPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *MultiCallPayload) BaseGasCount() *util.Uint128 {
+	return util.NewUint128()
+}
+
+// Execute the calls in order, each call sees the state changed by the calls before it.
+func (payload *MultiCallPayload) Execute(context *PayloadContext) (*util.Uint128, error) {
+	if len(payload.Calls) == 0 || len(payload.Calls) > MaxMultiCalls ||
+		!context.tx.to.Equals(context.tx.from) || context.tx.value.Sign() != 0 {
+		return util.NewUint128(), ErrInvalidMultiCall
+	}
+
+	// the calls share the instructions of the transaction, capped once for all of them.
+	limits := nvm.ExecutionLimitsAt(context.block.Height())
+	instructions := limits.Instructions(context.tx.PayloadGasLimit(payload).Uint64())
+	gasUsed := uint64(0)
+	results := []string{}
+	nvmctxs := []*nvm.Context{}
+	for i, call := range payload.Calls {
+		// no instructions left would be no limit for the engine.
+		if gasUsed >= instructions {
+			return util.NewUint128FromInt(int64(instructions)), nvm.ErrInsufficientGas
+		}
+		nvmctx, result, gas, err := payload.executeCall(context, call, instructions-gasUsed, limits.MaxMemorySize)
+		gasUsed += gas
+		if gasUsed > instructions {
+			gasUsed = instructions
+		}
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"tx":    context.tx,
+				"index": i,
+				"call":  call.Contract + "." + call.Function,
+				"err":   err,
+			}).Debug("Multi-call failed.")
+			return util.NewUint128FromInt(int64(gasUsed)), err
+		}
+		if len(result) == 0 {
+			result = "null"
+		}
+		results = append(results, result)
+		nvmctxs = append(nvmctxs, nvmctx)
+	}
+	// the effects are only recorded when all the calls succeed.
+	for _, nvmctx := range nvmctxs {
+		if err := recordContractEffects(context, nvmctx); err != nil {
+			return util.NewUint128FromInt(int64(gasUsed)), err
+		}
+	}
+	if context.simulated {
+		context.result = "[" + strings.Join(results, ",") + "]"
+	}
+	return util.NewUint128FromInt(int64(gasUsed)), nil
+}
+
+// executeCall runs the call with the instructions left, then sends its value to the contract as the transactions do.
+func (payload *MultiCallPayload) executeCall(context *PayloadContext, call *ContractCall, instructions, memorySize uint64) (*nvm.Context, string, uint64, error) {
+	addr, err := AddressParse(call.Contract)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	value := util.NewUint128()
+	if len(call.Value) > 0 {
+		if _, ok := value.FromString(call.Value); !ok || value.Validate() != nil {
+			return nil, "", 0, ErrInvalidMultiCall
+		}
+	}
+	contract, err := context.accState.GetContractAccount(addr.Bytes())
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if err := context.block.chargeStorageRent(context.tx.Hash(), contract); err != nil {
+		return nil, "", 0, err
+	}
+
+	nvmTx := convertNvmTx(context.tx)
+	nvmTx.To, nvmTx.Value = addr.String(), value.String()
+	ctx, deployPayload, err := generateCallContextAt(context, addr, nvmTx)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if context.simulated {
+		ctx.KeepResult()
+	}
+	engine := nvm.NewEngine(ctx, deployPayload.SourceType)
+	defer engine.Dispose()
+
+	engine.SetExecutionLimits(instructions, memorySize)
+
+	err = engine.Call(deployPayload.Source, deployPayload.SourceType, call.Function, call.Args)
+	gas := engine.ExecutionInstructions()
+	emitContractConsole(context, ctx)
+	if err != nil {
+		return nil, "", gas, err
+	}
+
+	from := context.accState.GetOrCreateUserAccount(context.tx.from.Bytes())
+	if from.Balance().Cmp(value.Int) < 0 {
+		return nil, "", gas, ErrInsufficientBalance
+	}
+	if err := from.SubBalance(value); err != nil {
+		return nil, "", gas, err
+	}
+	if err := contract.AddBalance(value); err != nil {
+		return nil, "", gas, err
+	}
+	return ctx, engine.Result(), gas, context.block.reviveContract(context.tx.Hash(), contract)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiCallPayload(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+	block.begin()
+
+	deployTx := mockDeployTransaction(bc.chainID, 0)
+	assert.Nil(t, block.acceptTransaction(deployTx))
+	payload, _ := deployTx.LoadPayload()
+	ctx := NewPayloadContext(block, deployTx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, err := payload.Execute(ctx)
+	assert.Nil(t, err)
+	ctx.Commit()
+	block.commit()
+	contract, _ := deployTx.GenerateContractAddress()
+
+	multiCall := func(calls ...*ContractCall) *Transaction {
+		data, _ := NewMultiCallPayload(calls).ToBytes()
+		tx := mockTransaction(bc.chainID, 0, TxPayloadMultiCallType, data)
+		tx.to = tx.from
+		return tx
+	}
+	totalSupply := &ContractCall{Contract: contract.String(), Function: "totalSupply"}
+
+	result, err := block.SimulateCall(multiCall(totalSupply, totalSupply))
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "[1000000000,1000000000]", result.Result)
+
+	// all the calls fail if any fails.
+	result, err = block.SimulateCall(multiCall(totalSupply, &ContractCall{Contract: contract.String(), Function: "transfer", Args: `["someone", 10]`}))
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Err)
+
+	result, _ = block.SimulateCall(multiCall())
+	assert.Equal(t, ErrInvalidMultiCall, result.Err)
+	tx := multiCall(totalSupply)
+	tx.to = contract
+	result, _ = block.SimulateCall(tx)
+	assert.Equal(t, ErrInvalidMultiCall, result.Err)
+	result, _ = block.SimulateCall(multiCall(&ContractCall{Contract: contract.String(), Function: "totalSupply", Value: "-1"}))
+	assert.Equal(t, ErrInvalidMultiCall, result.Err)
+
+	execute := func(tx *Transaction, extraGas uint64) (uint64, error) {
+		tx.gasLimit = util.NewUint128FromBigInt(util.NewUint128().Add(tx.GasCountOfTxBase().Int, util.NewUint128FromInt(int64(extraGas)).Int))
+		payload, _ := tx.LoadPayload()
+		ctx := NewPayloadContext(block, tx)
+		assert.Nil(t, ctx.BeginBatch())
+		defer ctx.RollBack()
+		gas, err := payload.Execute(ctx)
+		return gas.Uint64(), err
+	}
+	gas, err := execute(multiCall(totalSupply), TransactionMaxGas.Uint64())
+	assert.Nil(t, err)
+
+	// the calls share the gas of the transaction, and none runs without gas left.
+	used, err := execute(multiCall(totalSupply, totalSupply), gas+gas/2)
+	assert.Equal(t, nvm.ErrInsufficientGas, err)
+	assert.True(t, used <= gas+gas/2)
+	used, err = execute(multiCall(totalSupply, totalSupply), gas)
+	assert.Equal(t, nvm.ErrInsufficientGas, err)
+	assert.Equal(t, gas, used)
+	used, err = execute(multiCall(totalSupply), 0)
+	assert.Equal(t, nvm.ErrInsufficientGas, err)
+	assert.Equal(t, uint64(0), used)
+}
@@ -18,7 +18,11 @@
 
 package core
 
-import "github.com/nebulasio/go-nebulas/core/state"
+import (
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+)
 
 // PayloadContext transaction payload context
 type PayloadContext struct {
@@ -27,11 +31,21 @@ type PayloadContext struct {
 
 	accState    state.AccountState
 	dposContext *DposContext
+
+	// gas refunded for the storage freed in the execution.
+	refund *util.Uint128
+
+	// simulated calls keep the result of the function.
+	simulated bool
+	result    string
+
+	// records the steps of the contracts in traced executions.
+	tracer *nvm.Tracer
 }
 
 // NewPayloadContext returns new payloadcontxt
 func NewPayloadContext(block *Block, tx *Transaction) *PayloadContext {
-	ctx := &PayloadContext{block: block, tx: tx}
+	ctx := &PayloadContext{block: block, tx: tx, refund: util.NewUint128()}
 	return ctx
 }
 
@@ -45,6 +59,11 @@ func (ctx *PayloadContext) Transaction() *Transaction {
 	return ctx.tx
 }
 
+// Result returns the JSON of the value returned by the contract function, only kept in simulated calls
+func (ctx *PayloadContext) Result() string {
+	return ctx.result
+}
+
 // BeginBatch begin a batch task
 func (ctx *PayloadContext) BeginBatch() (err error) {
 	ctx.accState, err = ctx.block.accState.Clone()
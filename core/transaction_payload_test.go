@@ -19,11 +19,17 @@
 package core
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -184,6 +190,13 @@ func TestLoadCandidatePayload(t *testing.T) {
 			want:      NewCandidatePayload(LogoutAction),
 			wantEqual: true,
 		},
+		{
+			name:      CommissionAction,
+			bytes:     []byte(`{"action": "commission", "commission": 10}`),
+			parse:     true,
+			want:      NewCandidateCommissionPayload(CommissionAction, 10),
+			wantEqual: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -241,6 +254,15 @@ func TestLoadDelegatePayload(t *testing.T) {
 			want:      NewDelegatePayload(UnDelegateAction, "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c"),
 			wantEqual: true,
 		},
+		{
+			name:  "split",
+			bytes: []byte(`{"action": "do", "delegatees": [{"delegatee": "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c", "weight": 3}]}`),
+			parse: true,
+			want: NewSplitDelegatePayload([]*DelegateWeight{
+				&DelegateWeight{Delegatee: "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c", Weight: 3},
+			}),
+			wantEqual: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -260,6 +282,65 @@ func TestLoadDelegatePayload(t *testing.T) {
 	}
 }
 
+func TestDelegation(t *testing.T) {
+	delegator := byteutils.Hash(mockAddress().Bytes())
+
+	got, weight, total := ParseDelegation(encodeDelegation(delegator, 1, 1))
+	assert.Equal(t, delegator, got)
+	assert.Equal(t, uint32(1), weight)
+	assert.Equal(t, uint32(1), total)
+
+	got, weight, total = ParseDelegation(encodeDelegation(delegator, 1, 3))
+	assert.Equal(t, delegator, got)
+	assert.Equal(t, uint32(1), weight)
+	assert.Equal(t, uint32(3), total)
+
+	balance := util.NewUint128FromInt(100)
+	assert.Equal(t, balance, delegationVotes(balance, 1, 1))
+	assert.Equal(t, util.NewUint128FromInt(33), delegationVotes(balance, 1, 3))
+
+	payload := NewSplitDelegatePayload([]*DelegateWeight{
+		&DelegateWeight{Delegatee: mockAddress().String(), Weight: 1},
+		&DelegateWeight{Delegatee: mockAddress().String(), Weight: 0},
+	})
+	_, _, err := payload.delegations()
+	assert.Equal(t, ErrInvalidSplitDelegation, err)
+}
+
+func TestNormalizeDelegations(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	delegateTrie, err := trie.NewBatchTrie(nil, stor)
+	assert.Nil(t, err)
+	delegator := byteutils.Hash(mockAddress().Bytes())
+	a, b, c := byteutils.Hash(mockAddress().Bytes()), byteutils.Hash(mockAddress().Bytes()), byteutils.Hash(mockAddress().Bytes())
+	for i, v := range []byteutils.Hash{a, b, c} {
+		_, err := delegateTrie.Put(append(append(byteutils.Hash{}, v...), delegator...), encodeDelegation(delegator, uint32(i+1), 6))
+		assert.Nil(t, err)
+	}
+
+	// the shares left after the delegation to c is undone add up to the whole vote.
+	_, err = delegateTrie.Del(append(append(byteutils.Hash{}, c...), delegator...))
+	assert.Nil(t, err)
+	assert.Nil(t, normalizeDelegations(delegateTrie, delegator, []byteutils.Hash{a, b}))
+	for i, v := range []byteutils.Hash{a, b} {
+		value, err := delegateTrie.Get(append(append(byteutils.Hash{}, v...), delegator...))
+		assert.Nil(t, err)
+		got, weight, total := ParseDelegation(value)
+		assert.Equal(t, delegator, got)
+		assert.Equal(t, uint32(i+1), weight)
+		assert.Equal(t, uint32(3), total)
+	}
+
+	// a single delegation left is the whole vote.
+	_, err = delegateTrie.Del(append(append(byteutils.Hash{}, a...), delegator...))
+	assert.Nil(t, err)
+	assert.Nil(t, normalizeDelegations(delegateTrie, delegator, []byteutils.Hash{b}))
+	value, err := delegateTrie.Get(append(append(byteutils.Hash{}, b...), delegator...))
+	assert.Nil(t, err)
+	assert.Equal(t, delegator, byteutils.Hash(value))
+}
+
 func TestLoadDeployPayload(t *testing.T) {
 
 	deployTx := mockDeployTransaction(0, 0)
@@ -373,6 +454,84 @@ func TestPayload_Execute(t *testing.T) {
 		wantErr: nil,
 	})
 
+	upgradeSource := `"use strict";var Token=function(){LocalContractStorage.defineProperties(this,{_totalSupply:null})};Token.prototype={init:function(){},totalSupply:function(){return this._totalSupply}};module.exports=Token;`
+	upgradeTx := mockUpgradeTransaction(bc.chainID, 2, upgradeSource)
+	upgradeTx.to = callTx.to
+	upgradePayload, _ := upgradeTx.LoadPayload()
+	tests = append(tests, testPayload{
+		name:    "upgrade without admin",
+		payload: upgradePayload,
+		tx:      upgradeTx,
+		block:   block,
+		want:    util.NewUint128(),
+		wantErr: ErrContractNotUpgradeable,
+	})
+
+	admin := mockAddress()
+	adminDeployTx := mockDeployTransaction(bc.chainID, 0)
+	adminDeployPayload, _ := LoadDeployPayload(adminDeployTx.data.Payload)
+	adminDeployPayload.Admin = admin.String()
+	adminDeployTx.data.Payload, _ = adminDeployPayload.ToBytes()
+	tests = append(tests, testPayload{
+		name:    "deploy with admin",
+		payload: adminDeployPayload,
+		tx:      adminDeployTx,
+		block:   block,
+		want:    util.NewUint128FromInt(189),
+		wantErr: nil,
+	})
+
+	badTypeUpgradeTx := mockUpgradeTransaction(bc.chainID, 1, upgradeSource)
+	badTypeUpgradeTx.to, _ = adminDeployTx.GenerateContractAddress()
+	badTypeUpgradePayload, _ := badTypeUpgradeTx.LoadPayload()
+	badTypeUpgradePayload.(*UpgradePayload).SourceType = "py"
+	tests = append(tests, testPayload{
+		name:    "upgrade with invalid source type",
+		payload: badTypeUpgradePayload,
+		tx:      badTypeUpgradeTx,
+		block:   block,
+		want:    util.NewUint128(),
+		wantErr: ErrInvalidUpgradeSourceType,
+	})
+
+	brokenUpgradeTx := mockUpgradeTransaction(bc.chainID, 1, `"use strict";var Token=function(){;module.exports=Token;`)
+	brokenUpgradeTx.from = admin
+	brokenUpgradeTx.to, _ = adminDeployTx.GenerateContractAddress()
+	brokenUpgradePayload, _ := brokenUpgradeTx.LoadPayload()
+	tests = append(tests, testPayload{
+		name:    "upgrade not compiled",
+		payload: brokenUpgradePayload,
+		tx:      brokenUpgradeTx,
+		block:   block,
+		want:    util.NewUint128(),
+		wantErr: ErrUpgradeSourceNotCompiled,
+	})
+
+	nonAdminUpgradeTx := mockUpgradeTransaction(bc.chainID, 1, upgradeSource)
+	nonAdminUpgradeTx.to, _ = adminDeployTx.GenerateContractAddress()
+	nonAdminUpgradePayload, _ := nonAdminUpgradeTx.LoadPayload()
+	tests = append(tests, testPayload{
+		name:    "upgrade from non admin",
+		payload: nonAdminUpgradePayload,
+		tx:      nonAdminUpgradeTx,
+		block:   block,
+		want:    util.NewUint128(),
+		wantErr: ErrUpgradeFromNonAdmin,
+	})
+
+	adminUpgradeTx := mockUpgradeTransaction(bc.chainID, 0, upgradeSource)
+	adminUpgradeTx.from = admin
+	adminUpgradeTx.to = nonAdminUpgradeTx.to
+	adminUpgradePayload, _ := adminUpgradeTx.LoadPayload()
+	tests = append(tests, testPayload{
+		name:    "upgrade from admin",
+		payload: adminUpgradePayload,
+		tx:      adminUpgradeTx,
+		block:   block,
+		want:    util.NewUint128(),
+		wantErr: nil,
+	})
+
 	delegateTx := mockDelegateTransaction(bc.chainID, 0, DelegateAction, mockAddress().String())
 	delegatePayload, _ := delegateTx.LoadPayload()
 	tests = append(tests, testPayload{
@@ -447,3 +606,29 @@ func TestPayload_Execute(t *testing.T) {
 
 	block.accState.Commit()
 }
+
+func TestContractCodeGas(t *testing.T) {
+	defer nvm.SetGasTables(nil)
+	assert.Nil(t, nvm.SetGasTables([]*corepb.GasTableFork{{Version: 1, Height: 10, MaxCodeSize: 100, CodeByte: 2, CodeQuadDivisor: 10}}))
+
+	bc, _ := NewBlockChain(testNeb())
+	block, _ := bc.NewBlock(mockAddress())
+
+	block.height = 5
+	gas, err := contractCodeGas(block, strings.Repeat("a", 1000))
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128(), gas)
+
+	block.height = 10
+	gas, err = contractCodeGas(block, strings.Repeat("a", 100))
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128FromInt(1200), gas)
+	_, err = contractCodeGas(block, strings.Repeat("a", 101))
+	assert.Equal(t, ErrContractCodeTooLarge, err)
+
+	// the deployment of a source over the max size fails.
+	deployTx := mockDeployTransaction(bc.chainID, 0)
+	payload, _ := deployTx.LoadPayload()
+	_, err = payload.Execute(NewPayloadContext(block, deployTx))
+	assert.Equal(t, ErrContractCodeTooLarge, err)
+}
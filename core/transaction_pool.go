@@ -19,13 +19,16 @@
 package core
 
 import (
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/common/pdeque"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
@@ -38,6 +41,8 @@ var (
 	duplicateTxCounter     = metrics.GetOrRegisterCounter("txpool_duplicate", nil)
 	belowGasPriceTxCounter = metrics.GetOrRegisterCounter("txpool_below_gas_price", nil)
 	outOfGasLimitTxCounter = metrics.GetOrRegisterCounter("txpool_out_of_gas_limit", nil)
+	lintFailedTxCounter    = metrics.GetOrRegisterCounter("txpool_lint_failed", nil)
+	pendingDroppedCounter  = metrics.GetOrRegisterCounter("txpool_pending_dropped", nil)
 )
 
 // TransactionPool cache txs, is thread safe
@@ -50,11 +55,19 @@ type TransactionPool struct {
 	all   map[byteutils.HexHash]*Transaction
 	bc    *BlockChain
 
+	announced map[byteutils.HexHash]*txAnnouncement // the announced txs being fetched.
+
 	nm p2p.Manager
 	mu sync.RWMutex
 
 	gasPrice *util.Uint128 // the lowest gasPrice.
 	gasLimit *util.Uint128 // the maximum gasLimit.
+
+	observer bool // observer never broadcasts its own txs.
+
+	throttle *PackingThrottle // caps the resources spent packing txs.
+
+	pendingSubs *sync.Map // the channels receiving the txs pushed.
 }
 
 func less(a interface{}, b interface{}) bool {
@@ -79,8 +92,10 @@ func NewTransactionPool(size int) (*TransactionPool, error) {
 		size:              size,
 		cache:             pdeque.NewPriorityDeque(less),
 		all:               make(map[byteutils.HexHash]*Transaction),
+		announced:         make(map[byteutils.HexHash]*txAnnouncement),
 		gasPrice:          TransactionGasPrice,
 		gasLimit:          TransactionMaxGas,
+		pendingSubs:       new(sync.Map),
 	}
 	return txPool, nil
 }
@@ -99,9 +114,35 @@ func (pool *TransactionPool) SetGasConfig(gasPrice, gasLimit *util.Uint128) {
 	}
 }
 
+// SetPackingThrottle set the throttle of packing txs into blocks.
+func (pool *TransactionPool) SetPackingThrottle(throttle *PackingThrottle) {
+	pool.throttle = throttle
+}
+
+// SetObserver set if the pool refuses to broadcast txs submitted locally.
+func (pool *TransactionPool) SetObserver(observer bool) {
+	pool.observer = observer
+}
+
+// SubscribePending register the channel receiving the txs pushed into the pool,
+// a tx is dropped for the channel if it's full.
+func (pool *TransactionPool) SubscribePending(ch chan *Transaction) {
+	pool.pendingSubs.Store(ch, true)
+}
+
+// UnsubscribePending deregister the channel.
+func (pool *TransactionPool) UnsubscribePending(ch chan *Transaction) {
+	pool.pendingSubs.Delete(ch)
+}
+
 // RegisterInNetwork register message subscriber in network.
 func (pool *TransactionPool) RegisterInNetwork(nm p2p.Manager) {
 	nm.Register(net.NewSubscriber(pool, pool.receivedMessageCh, MessageTypeNewTx))
+	nm.Register(net.NewSubscriber(pool, pool.receivedMessageCh, MessageTypeTxHashes))
+	nm.Register(net.NewSubscriber(pool, pool.receivedMessageCh, MessageTypeGetTxs))
+	p2p.RegisterTopic(MessageTypeNewTx, p2p.TopicTxs)
+	p2p.RegisterMessageVersion(MessageTypeTxHashes, 2)
+	p2p.RegisterMessageVersion(MessageTypeGetTxs, 2)
 	pool.nm = nm
 }
 
@@ -132,6 +173,8 @@ func (pool *TransactionPool) loop() {
 		"size": pool.size,
 	}).Info("Launched TransactionPool.")
 
+	ticker := time.NewTicker(TxFetchTimeout)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-pool.quitCh:
@@ -139,55 +182,78 @@ func (pool *TransactionPool) loop() {
 				"size": pool.size,
 			}).Info("Shutdowned TransactionPool.")
 			return
+		case <-ticker.C:
+			pool.refetchTxs()
 		case msg := <-pool.receivedMessageCh:
-			if msg.MessageType() != MessageTypeNewTx {
+			switch msg.MessageType() {
+			case MessageTypeNewTx:
+				pool.handleNewTx(msg)
+			case MessageTypeTxHashes:
+				pool.handleTxHashes(msg)
+			case MessageTypeGetTxs:
+				pool.handleGetTxs(msg)
+			default:
 				logging.VLog().WithFields(logrus.Fields{
 					"messageType": msg.MessageType(),
 					"message":     msg,
 					"err":         "not new tx msg",
 				}).Warn("Received unregistered message.")
-				continue
-			}
-
-			tx := new(Transaction)
-			pbTx := new(corepb.Transaction)
-			if err := proto.Unmarshal(msg.Data().([]byte), pbTx); err != nil {
-				logging.VLog().WithFields(logrus.Fields{
-					"msgType": msg.MessageType(),
-					"msg":     msg,
-					"err":     err,
-				}).Error("Failed to unmarshal data.")
-				continue
-			}
-			if err := tx.FromProto(pbTx); err != nil {
-				logging.VLog().WithFields(logrus.Fields{
-					"msgType": msg.MessageType(),
-					"msg":     msg,
-					"err":     err,
-				}).Error("Failed to recover a tx from proto data.")
-				continue
 			}
+		}
+	}
+}
 
-			logging.VLog().WithFields(logrus.Fields{
-				"tx":   tx,
-				"type": msg.MessageType(),
-			}).Info("Received a new tx.")
+func (pool *TransactionPool) handleNewTx(msg net.Message) {
+	tx := new(Transaction)
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(msg.Data().([]byte), pbTx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to unmarshal data.")
+		pool.nm.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	if err := tx.FromProto(pbTx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Error("Failed to recover a tx from proto data.")
+		pool.nm.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
 
-			if err := pool.PushAndRelay(tx); err != nil {
-				logging.VLog().WithFields(logrus.Fields{
-					"func":        "TxPool.loop",
-					"messageType": msg.MessageType(),
-					"transaction": tx,
-					"err":         err,
-				}).Error("Failed to push a tx into tx pool.")
-				continue
-			}
+	delete(pool.announced, tx.hash.Hex())
+
+	logging.VLog().WithFields(logrus.Fields{
+		"tx":   tx,
+		"type": msg.MessageType(),
+	}).Info("Received a new tx.")
+
+	if err := pool.PushAndRelay(tx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"func":        "TxPool.loop",
+			"messageType": msg.MessageType(),
+			"transaction": tx,
+			"err":         err,
+		}).Error("Failed to push a tx into tx pool.")
+		switch err {
+		case ErrInvalidChainID, ErrInvalidTransactionHash, ErrInvalidTransactionSigner:
+			pool.nm.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
 		}
 	}
 }
 
 // Push tx into pool
 func (pool *TransactionPool) Push(tx *Transaction) error {
+	// lint the contract source out of the lock, it's parsed by the engine.
+	if err := lintContractPayload(tx); err != nil {
+		lintFailedTxCounter.Inc(1)
+		return err
+	}
+
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 	return pool.push(tx)
@@ -204,6 +270,9 @@ func (pool *TransactionPool) PushAndRelay(tx *Transaction) error {
 
 // PushAndBroadcast push tx into pool and broadcast it
 func (pool *TransactionPool) PushAndBroadcast(tx *Transaction) error {
+	if pool.observer {
+		return ErrObserverRefuseTx
+	}
 	if err := pool.Push(tx); err != nil {
 		return err
 	}
@@ -242,6 +311,47 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 		tx := pool.cache.PopMax().(*Transaction)
 		delete(pool.all, tx.hash.Hex())
 	}
+	if _, ok := pool.all[tx.hash.Hex()]; ok {
+		pool.pendingSubs.Range(func(k, v interface{}) bool {
+			select {
+			case k.(chan *Transaction) <- tx:
+			default:
+				pendingDroppedCounter.Inc(1)
+			}
+			return true
+		})
+	}
+	return nil
+}
+
+// lintContractPayload rejects the deploy and upgrade payloads whose source is obviously broken,
+// before they waste the block space and the gas of the sender.
+func lintContractPayload(tx *Transaction) error {
+	var source, sourceType string
+	switch tx.data.Type {
+	case TxPayloadDeployType:
+		payload, err := LoadDeployPayload(tx.data.Payload)
+		if err != nil {
+			return err
+		}
+		source, sourceType = payload.Source, payload.SourceType
+	case TxPayloadUpgradeType:
+		payload, err := LoadUpgradePayload(tx.data.Payload)
+		if err != nil {
+			return err
+		}
+		source, sourceType = payload.Source, payload.SourceType
+	default:
+		return nil
+	}
+
+	if err := nvm.LintContract(source, sourceType); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tx":  tx,
+			"err": err,
+		}).Debug("Contract source failed the lint.")
+		return err
+	}
 	return nil
 }
 
@@ -261,6 +371,38 @@ func (pool *TransactionPool) pop() *Transaction {
 	return nil
 }
 
+// Pending return at most limit txs of the pool, all of them if limit is 0, by sender and nonce.
+func (pool *TransactionPool) Pending(limit int) []*Transaction {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	txs := make([]*Transaction, 0, len(pool.all))
+	for _, tx := range pool.all {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		if !txs[i].from.Equals(txs[j].from) {
+			return txs[i].from.String() < txs[j].from.String()
+		}
+		return txs[i].nonce < txs[j].nonce
+	})
+	if limit > 0 && len(txs) > limit {
+		txs = txs[:limit]
+	}
+	return txs
+}
+
+// Flush drop all the txs of the pool, return the number dropped.
+func (pool *TransactionPool) Flush() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	n := len(pool.all)
+	pool.cache = pdeque.NewPriorityDeque(less)
+	pool.all = make(map[byteutils.HexHash]*Transaction)
+	return n
+}
+
 // Empty return if the pool is empty
 func (pool *TransactionPool) Empty() bool {
 	pool.mu.Lock()
@@ -19,6 +19,7 @@
 package core
 
 import (
+	"strings"
 	"testing"
 
 	"time"
@@ -26,6 +27,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -155,3 +157,106 @@ func TestPushTxs(t *testing.T) {
 	assert.Equal(t, txPool.push(txs[0]), ErrBelowGasPrice)
 	assert.Equal(t, txPool.push(txs[1]), ErrOutOfGasLimit)
 }
+
+func TestObserverRefuseTx(t *testing.T) {
+	txPool, _ := NewTransactionPool(3)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.SetObserver(true)
+
+	tx := mockTransaction(bc.ChainID(), 1, TxPayloadBinaryType, nil)
+	assert.Equal(t, txPool.PushAndBroadcast(tx), ErrObserverRefuseTx)
+	assert.Nil(t, txPool.Pop())
+}
+
+func TestPushLintedContract(t *testing.T) {
+	txPool, _ := NewTransactionPool(3)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+
+	assert.Nil(t, lintContractPayload(mockDeployTransaction(bc.ChainID(), 0)))
+	assert.Nil(t, lintContractPayload(mockCallTransaction(bc.ChainID(), 0, "eval", "")))
+
+	payload, _ := NewDeployPayload("var a = ;", "js", "").ToBytes()
+	err := txPool.Push(mockTransaction(bc.ChainID(), 1, TxPayloadDeployType, payload))
+	assert.True(t, strings.HasPrefix(err.Error(), nvm.ErrContractLintFailed.Error()))
+	upgradeTx := mockUpgradeTransaction(bc.ChainID(), 2, "eval('1');")
+	err = txPool.Push(upgradeTx)
+	assert.True(t, strings.HasPrefix(err.Error(), nvm.ErrContractLintFailed.Error()))
+}
+
+func TestSubscribePending(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	txPool, _ := NewTransactionPool(3)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+
+	ch := make(chan *Transaction, 1)
+	txPool.SubscribePending(ch)
+	txs := []*Transaction{
+		NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 2, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 3, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+	}
+	for _, tx := range txs {
+		assert.Nil(t, tx.Sign(signature))
+	}
+
+	assert.Nil(t, txPool.Push(txs[0]))
+	assert.Equal(t, txs[0], <-ch)
+	// a dup tx isn't sent again.
+	assert.NotNil(t, txPool.Push(txs[0]))
+	assert.Equal(t, 0, len(ch))
+	// the tx is dropped for a full channel.
+	assert.Nil(t, txPool.Push(txs[1]))
+	assert.Nil(t, txPool.Push(txs[2]))
+	assert.Equal(t, txs[1], <-ch)
+	assert.Equal(t, 0, len(ch))
+
+	txPool.UnsubscribePending(ch)
+	tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 4, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Nil(t, txPool.Push(tx))
+	assert.Equal(t, 0, len(ch))
+}
+
+func TestPendingAndFlush(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	txPool, _ := NewTransactionPool(3)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+
+	for _, nonce := range []uint64{3, 1, 2} {
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		assert.Nil(t, txPool.Push(tx))
+	}
+	pending := txPool.Pending(0)
+	assert.Equal(t, 3, len(pending))
+	for i, tx := range pending {
+		assert.Equal(t, uint64(i+1), tx.Nonce())
+	}
+	assert.Equal(t, 2, len(txPool.Pending(2)))
+
+	assert.Equal(t, 3, txPool.Flush())
+	assert.True(t, txPool.Empty())
+	assert.Equal(t, 0, len(txPool.Pending(0)))
+}
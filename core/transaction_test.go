@@ -52,6 +52,11 @@ func mockCallTransaction(chainID uint32, nonce uint64, function, args string) *T
 	return mockTransaction(chainID, nonce, TxPayloadCallType, payload)
 }
 
+func mockUpgradeTransaction(chainID uint32, nonce uint64, source string) *Transaction {
+	payload, _ := NewUpgradePayload(source, "js").ToBytes()
+	return mockTransaction(chainID, nonce, TxPayloadUpgradeType, payload)
+}
+
 func mockDelegateTransaction(chainID uint32, nonce uint64, action, addr string) *Transaction {
 	payload, _ := NewDelegatePayload(action, addr).ToBytes()
 	return mockTransaction(chainID, nonce, TxPayloadDelegateType, payload)
@@ -357,6 +362,9 @@ func TestTransaction_VerifyExecution(t *testing.T) {
 			for index, event := range events {
 				assert.Equal(t, tt.eventTopic[index], event.Topic)
 			}
+			execErr, err := block.FetchExecutionError(tt.tx.hash)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.eventTopic[0] == TopicExecuteTxFailed, execErr != "")
 
 			block.rollback()
 		})
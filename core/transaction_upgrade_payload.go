@@ -0,0 +1,113 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// UpgradePayload carry the new code of an upgradeable contract
+type UpgradePayload struct {
+	SourceType string
+	Source     string
+	// Libraries are the addresses of the libraries linked by the upgraded code.
+	Libraries map[string]string `json:",omitempty"`
+}
+
+// LoadUpgradePayload from bytes
+func LoadUpgradePayload(bytes []byte) (*UpgradePayload, error) {
+	payload := &UpgradePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewUpgradePayload with source
+func NewUpgradePayload(source, sourceType string) *UpgradePayload {
+	return &UpgradePayload{
+		Source:     source,
+		SourceType: sourceType,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *UpgradePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *UpgradePayload) BaseGasCount() *util.Uint128 {
+	return UpgradeBaseGasCount
+}
+
+// Execute the upgrade payload in tx, replace the code of contract while keeping its storage
+func (payload *UpgradePayload) Execute(ctx *PayloadContext) (*util.Uint128, error) {
+	switch payload.SourceType {
+	case nvm.SourceTypeJavaScript, nvm.SourceTypeTypeScript, nvm.SourceTypeWasm:
+	default:
+		return util.NewUint128(), ErrInvalidUpgradeSourceType
+	}
+	contract, err := ctx.accState.GetContractAccount(ctx.tx.to.Bytes())
+	if err != nil {
+		return util.NewUint128(), err
+	}
+	if contract.Destroyed() {
+		return util.NewUint128(), ErrContractDestroyed
+	}
+	if len(contract.Admin()) == 0 {
+		return util.NewUint128(), ErrContractNotUpgradeable
+	}
+	if !contract.Admin().Equals(ctx.tx.from.Bytes()) {
+		return util.NewUint128(), ErrUpgradeFromNonAdmin
+	}
+	codeGas, err := contractCodeGas(ctx.block, payload.Source)
+	if err != nil {
+		return util.NewUint128(), err
+	}
+	if gasLimit := ctx.tx.PayloadGasLimit(payload); gasLimit.Cmp(codeGas.Int) < 0 {
+		return gasLimit, ErrOutOfGasLimit
+	}
+	if err := ctx.block.checkLibraries(payload.Libraries); err != nil {
+		return util.NewUint128(), err
+	}
+	// the code replaced can't be restored, so the upgrade fails unless the new code parses.
+	if err := nvm.LintContract(payload.Source, payload.SourceType); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tx":  ctx.tx,
+			"err": err,
+		}).Debug("Upgraded code failed to compile.")
+		return codeGas, ErrUpgradeSourceNotCompiled
+	}
+	contract.SetCodePlace(ctx.tx.Hash())
+	// the ABI is generated when the code runs at deploy, the upgraded code has none.
+	contract.SetABIHash(nil)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":    ctx.block,
+		"tx":       ctx.tx,
+		"contract": ctx.tx.to.String(),
+	}).Info("Contract upgraded.")
+	return codeGas, nil
+}
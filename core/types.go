@@ -35,6 +35,9 @@ const (
 	TxPayloadCallType      = "call"
 	TxPayloadDelegateType  = "delegate"
 	TxPayloadCandidateType = "candidate"
+	TxPayloadUpgradeType   = "upgrade"
+	TxPayloadOracleType    = "oracle"
+	TxPayloadMultiCallType = "multicall"
 )
 
 // Error Types
@@ -56,6 +59,7 @@ var (
 	ErrInvalidBlockStateRoot               = errors.New("invalid block state root hash")
 	ErrInvalidBlockTxsRoot                 = errors.New("invalid block txs root hash")
 	ErrInvalidBlockEventsRoot              = errors.New("invalid block events root hash")
+	ErrInvalidBlockBloom                   = errors.New("invalid block bloom of contract logs")
 	ErrInvalidBlockDposContextRoot         = errors.New("invalid block dpos context root hash")
 	ErrInvalidChainID                      = errors.New("invalid transaction chainID")
 	ErrDuplicatedTransaction               = errors.New("duplicated transaction")
@@ -70,6 +74,7 @@ var (
 	ErrInvalidDelegatePayloadAction        = errors.New("invalid transaction vote payload action")
 	ErrInvalidDelegateToNonCandidate       = errors.New("cannot delegate to non-candidate")
 	ErrInvalidUnDelegateFromNonDelegatee   = errors.New("cannot un-delegate from non-delegatee")
+	ErrInvalidSplitDelegation              = errors.New("invalid split delegation, weights should be positive and delegatees unique")
 	ErrInvalidBaseAndNextDynastyID         = errors.New("cannot kickout from baseDynastyID to nextDynastyID if nextDynastyID <= baseDynastyID")
 	ErrInitialDynastyNotEnough             = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal " + strconv.Itoa(SafeSize))
 	ErrInvalidTransactionSigner            = errors.New("transaction recover public key address not equal to from")
@@ -85,6 +90,63 @@ var (
 	ErrCloneEventsState                    = errors.New("Failed to clone events state")
 	ErrGenerateNextDynastyContext          = errors.New("Failed to generate next dynasty context")
 	ErrLoadNextDynastyContext              = errors.New("Failed to load next dynasty context")
+	ErrInvalidEvidence                     = errors.New("invalid double-proposal evidence")
+	ErrDuplicatedEvidence                  = errors.New("duplicated double-proposal evidence")
+	ErrInvalidCommissionRate               = errors.New("invalid commission rate, should be a percentage between 0 and 100")
+	ErrInvalidCommissionFromNonCandidate   = errors.New("cannot declare commission rate from non-candidate")
+	ErrInvalidCommissionData               = errors.New("invalid commission rate data")
+	ErrCandidateJailed                     = errors.New("jailed candidate cannot logout before unjail")
+	ErrCandidateNotJailed                  = errors.New("cannot unjail candidate not jailed")
+	ErrInvalidUnjailFromNonCandidate       = errors.New("cannot unjail non-candidate")
+	ErrUnjailBeforeRelease                 = errors.New("cannot unjail before the jail period ends")
+	ErrInvalidBlockRandom                  = errors.New("invalid block random")
+	ErrInvalidSigner                       = errors.New("invalid signer address")
+	ErrInvalidSignerFromNonCandidate       = errors.New("cannot register signer from non-candidate")
+	ErrSignerRegistered                    = errors.New("signer is registered by another candidate")
+	ErrObserverRefuseTx                    = errors.New("observer node never broadcasts its own transactions")
+	ErrInvalidContractAdmin                = errors.New("invalid contract admin address")
+	ErrContractNotUpgradeable              = errors.New("contract without admin cannot be upgraded")
+	ErrUpgradeFromNonAdmin                 = errors.New("only the admin can upgrade the contract")
+	ErrInvalidUpgradeSourceType            = errors.New("invalid source type of the upgraded code")
+	ErrUpgradeSourceNotCompiled            = errors.New("upgraded code does not compile")
+	ErrMissingBlockRandom                  = errors.New("block random is not signed yet")
+	ErrOutOfBlockHashWindow                = errors.New("block height is out of the recent blocks window")
+	ErrInvalidBlockInterval                = errors.New("block interval must be positive and divide the dynasty interval")
+	ErrInvalidBlockIntervalFork            = errors.New("block interval fork must start a later dynasty")
+	ErrInvalidStorageRent                  = errors.New("storage rent price must be a uint128")
+	ErrContractHibernated                  = errors.New("contract is hibernated for unpaid storage rent")
+	ErrContractABINotFound                 = errors.New("contract has no abi")
+	ErrContractCodeTooLarge                = errors.New("contract code exceeds the max size")
+	ErrSimulateNonCall                     = errors.New("only contract calls can be simulated")
+	ErrContractDestroyed                   = errors.New("contract has self-destructed")
+	ErrTraceBlockNotFound                  = errors.New("block to trace not found")
+	ErrTraceTxNotInBlock                   = errors.New("transaction to trace is not in the block")
+	ErrTraceNonContract                    = errors.New("only contract transactions can be traced")
+	ErrTraceTxNotFound                     = errors.New("transaction to trace not found in the canonical chain")
+	ErrInvalidLibrary                      = errors.New("library must be immutable javascript without libraries")
+	ErrInvalidLibraryLink                  = errors.New("invalid library linked by contract")
+	ErrCallLibrary                         = errors.New("library cannot be called")
+	ErrInvalidContractCode                 = errors.New("no contract code at the hash")
+	ErrContractSourceMismatch              = errors.New("source does not match the contract code on chain")
+	ErrContractArgsMismatch                = errors.New("args do not match the contract deploy transaction")
+	ErrInvalidMultiCall                    = errors.New("invalid multi-call payload")
+	ErrInvalidOracleOperator               = errors.New("invalid oracle operator address in genesis")
+	ErrNotOracleOperator                   = errors.New("only oracle operators can answer oracle requests")
+	ErrUnknownOracleRequest                = errors.New("unknown or answered oracle request")
+	ErrInvalidOracleContract               = errors.New("only javascript contracts can request oracles")
+	ErrNotNRC20Token                       = errors.New("contract is not an NRC20 token")
+	ErrNotNRC721Token                      = errors.New("contract is not an NRC721 token")
+	ErrRelayNoState                        = errors.New("relay node keeps no world state")
+	ErrRelayStorage                        = errors.New("storage of a relay node has no world state, run it in relay mode")
+	ErrRelayCheckpointMismatch             = errors.New("block does not match the checkpoint at its height")
+	ErrInvalidHeadHeight                   = errors.New("head height must be in the canonical chain")
+	ErrStateBlockAndHeight                 = errors.New("only one of block and height can be given")
+	ErrStateBlockNotFound                  = errors.New("block of the state not found")
+	ErrStateNotRetained                    = errors.New("state of the block is not retained")
+	ErrInvalidHeaderParent                 = errors.New("header does not follow the last verified one")
+	ErrInvalidHeaderDynasty                = errors.New("header dynasty is not inherited from its parent")
+	ErrUnverifiableHeaderDynasty           = errors.New("header skips a dynasty, its dynasty cannot be verified without the state")
+	ErrInvalidHeaderProposer               = errors.New("header is not signed by the proposer of its slot")
 )
 
 // Default gas count
@@ -105,6 +167,11 @@ const (
 	MessageTypeDownloadedBlock      = "dlblock"
 	MessageTypeDownloadedBlockReply = "dlreply"
 	MessageTypeNewTx                = "newtx"
+	MessageTypeCompactBlock         = "cmpctblock"
+	MessageTypeGetBlockTxs          = "getblocktxn"
+	MessageTypeBlockTxs             = "blocktxn"
+	MessageTypeTxHashes             = "txhashes"
+	MessageTypeGetTxs               = "gettxs"
 )
 
 // Consensus interface
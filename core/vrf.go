@@ -0,0 +1,216 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/binary"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// The proposers take turns in rounds of DynastySize slots, and the order
+// in each round is shuffled by the random seed of the last block before the round.
+// A block's random is its proposer's public key and VRF proof over the parent's seed and the slot.
+// The VRF output is unique for the key and the input, so the proposer can't grind it, and the others
+// can verify it but can't predict it before the block is proposed.
+// The randoms and the shuffle start at the random height of the genesis, the blocks before it carry no random
+// and their proposers take turns in the dynasty's order, so an existing chain replays as it was proposed.
+
+// randomAt returns whether the block at the height carries a random, and its round is shuffled.
+func randomAt(randomHeight uint64, height uint64) bool {
+	return randomHeight > 0 && height >= randomHeight
+}
+
+// Seed returns the random seed produced by the block, the VRF output of its random.
+func (block *Block) Seed() byteutils.Hash {
+	return headerSeed(block.header)
+}
+
+func headerSeed(header *BlockHeader) byteutils.Hash {
+	if len(header.random) == 0 {
+		return header.hash
+	}
+	hasher := sha3.New256()
+	if len(header.random) == randomLength {
+		// the output is the hash of gamma, after the public key.
+		hasher.Write(header.random[randomPubLength : randomPubLength+65])
+	} else {
+		hasher.Write(header.random)
+	}
+	return hasher.Sum(nil)
+}
+
+// RandomSeed returns the seed for the contracts in the block, which is only known
+// after the proposer signs the random, so the random must be signed before executing txs.
+func (block *Block) RandomSeed() (byteutils.Hash, error) {
+	if len(block.header.random) == 0 {
+		return nil, ErrMissingBlockRandom
+	}
+	return block.Seed(), nil
+}
+
+// Random returns the public key and the random proof of the block's proposer.
+func (block *Block) Random() []byte {
+	return block.header.random
+}
+
+// lengths of the random: the uncompressed public key of the proposer, then its VRF proof.
+const (
+	randomPubLength = 65
+	randomLength    = randomPubLength + secp256k1.VRFProofLength
+)
+
+// vrfProver is a private key proving VRF outputs.
+type vrfProver interface {
+	VRFProve(alpha []byte) ([]byte, error)
+}
+
+func randomMessage(parentSeed byteutils.Hash, timestamp int64) byteutils.Hash {
+	hasher := sha3.New256()
+	hasher.Write(parentSeed)
+	hasher.Write(byteutils.FromInt64(timestamp))
+	return hasher.Sum(nil)
+}
+
+// SignRandom generates the block's random by proving the VRF of the parent's seed, must be done before seal.
+// A block before the random height carries none.
+func (block *Block) SignRandom(key keystore.PrivateKey) error {
+	if block.sealed {
+		return ErrDoubleSealBlock
+	}
+	if !randomAt(block.randomHeight, block.height) {
+		return nil
+	}
+	prover, ok := key.(vrfProver)
+	if !ok {
+		return ErrInvalidBlockRandom
+	}
+	parent, err := block.ParentBlock()
+	if err != nil {
+		return err
+	}
+	pub, err := key.PublicKey().Encoded()
+	if err != nil {
+		return err
+	}
+	proof, err := prover.VRFProve(randomMessage(parent.Seed(), block.header.timestamp))
+	if err != nil {
+		return err
+	}
+	block.header.random = append(pub, proof...)
+	return nil
+}
+
+// VerifyRandom verifies the block's random is the proposer's VRF proof over the parent's seed,
+// or that it carries none before the random height.
+func (block *Block) VerifyRandom(parent *Block, proposer *Address) error {
+	return verifyRandom(block.header, parent.Seed(), proposer, randomAt(parent.randomHeight, parent.height+1))
+}
+
+func verifyRandom(header *BlockHeader, parentSeed byteutils.Hash, proposer *Address, required bool) error {
+	if !required {
+		if len(header.random) != 0 {
+			return ErrInvalidBlockRandom
+		}
+		return nil
+	}
+	if len(header.random) != randomLength {
+		return ErrInvalidBlockRandom
+	}
+	pub := header.random[:randomPubLength]
+	addr, err := NewAddressFromPublicKey(pub)
+	if err != nil || !addr.Equals(proposer) {
+		return ErrInvalidBlockRandom
+	}
+	if _, err := secp256k1.VRFVerify(pub, randomMessage(parentSeed, header.timestamp), header.random[randomPubLength:]); err != nil {
+		return ErrInvalidBlockRandom
+	}
+	return nil
+}
+
+// roundStart returns the first slot of the round containing the timestamp.
+func (bi *BlockIntervals) roundStart(timestamp int64) int64 {
+	interval := bi.At(timestamp)
+	offset := timestamp % DynastyInterval
+	round := offset / interval / DynastySize
+	return timestamp - offset + round*DynastySize*interval
+}
+
+// RoundSeed returns the seed shuffling the proposers of the round containing the timestamp,
+// which is the seed of the last block before the round on the chain of parent, nil before the random height.
+// Only the headers of the ancestors are read, so a relay keeping no state can find it.
+func RoundSeed(parent *Block, timestamp int64) (byteutils.Hash, error) {
+	if !randomAt(parent.randomHeight, parent.height+1) {
+		return nil, nil
+	}
+	return roundSeed(parent, timestamp)
+}
+
+// roundSeed returns the seed of the last block before the round containing the timestamp, even before the random height.
+func roundSeed(parent *Block, timestamp int64) (byteutils.Hash, error) {
+	start := parent.blockIntervals.roundStart(timestamp)
+	block := parent
+	for block.header.timestamp >= start && !CheckGenesisBlock(block) {
+		var err error
+		if block, err = block.parentHeader(); err != nil {
+			return nil, err
+		}
+	}
+	return block.Seed(), nil
+}
+
+// ProposerOrder shuffles the members of dynasty with the seed.
+func ProposerOrder(members []byteutils.Hash, seed byteutils.Hash) []byteutils.Hash {
+	order := make([]byteutils.Hash, len(members))
+	copy(order, members)
+	for i := len(order) - 1; i > 0; i-- {
+		hasher := sha3.New256()
+		hasher.Write(seed)
+		hasher.Write(byteutils.FromInt64(int64(i)))
+		j := int(binary.BigEndian.Uint64(hasher.Sum(nil)) % uint64(i+1))
+		order[i], order[j] = order[j], order[i]
+	}
+	return order
+}
+
+// FindProposer for now in given dynasty and block interval, the order of proposers is shuffled by the round seed,
+// the dynasty's order if it's nil.
+func FindProposer(now, interval int64, dynasty *trie.BatchTrie, seed byteutils.Hash) (proposer byteutils.Hash, err error) {
+	offset := now % DynastyInterval
+	if offset%interval != 0 {
+		return nil, ErrNotBlockForgTime
+	}
+	offset /= interval
+	offset %= DynastySize
+	delegatees, err := TraverseDynasty(dynasty)
+	if err != nil {
+		return nil, err
+	}
+	if seed != nil {
+		delegatees = ProposerOrder(delegatees, seed)
+	}
+	if int(offset) < len(delegatees) {
+		proposer = delegatees[offset]
+	}
+	return proposer, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_Random(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	signer := mockAddress()
+	key, err := keystore.DefaultKS.GetUnlocked(signer.String())
+	assert.Nil(t, err)
+	signature := key.(keystore.PrivateKey)
+
+	genesis := bc.GenesisBlock()
+	block, err := bc.NewBlock(signer)
+	assert.Nil(t, err)
+	block.SetTimestamp(BlockInterval)
+	_, err = block.RandomSeed()
+	assert.Equal(t, err, ErrMissingBlockRandom)
+	assert.Nil(t, block.SignRandom(signature))
+	random := block.Random()
+	seed, err := block.RandomSeed()
+	assert.Nil(t, err)
+	assert.Equal(t, seed, block.Seed())
+	assert.Nil(t, block.VerifyRandom(genesis, signer))
+	assert.Equal(t, block.VerifyRandom(genesis, mockAddress()), ErrInvalidBlockRandom)
+
+	// the random is unique for the parent and slot.
+	assert.Nil(t, block.SignRandom(signature))
+	assert.Equal(t, block.Random(), random)
+	block.SetTimestamp(BlockInterval * 2)
+	assert.Equal(t, block.VerifyRandom(genesis, signer), ErrInvalidBlockRandom)
+	block.SetTimestamp(BlockInterval)
+
+	// a proof altered by the proposer is refused, it can't pick another output.
+	block.header.random = append([]byte{}, random...)
+	block.header.random[randomLength-1] ^= 1
+	assert.Equal(t, block.VerifyRandom(genesis, signer), ErrInvalidBlockRandom)
+	block.header.random = random[:randomLength-1]
+	assert.Equal(t, block.VerifyRandom(genesis, signer), ErrInvalidBlockRandom)
+	block.header.random = random
+
+	assert.Nil(t, block.Seal())
+	assert.NotEqual(t, block.Seed(), genesis.Seed())
+	assert.Equal(t, block.SignRandom(signature), ErrDoubleSealBlock)
+}
+
+func TestRoundSeed(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	genesis := bc.GenesisBlock()
+
+	seed, err := RoundSeed(genesis, BlockInterval)
+	assert.Nil(t, err)
+	assert.Equal(t, seed, genesis.Seed())
+
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	block.SetTimestamp(BlockInterval)
+	assert.Nil(t, block.Seal())
+
+	// the seed of a round is fixed by the last block before the round.
+	seed, err = RoundSeed(block, BlockInterval*2)
+	assert.Nil(t, err)
+	assert.Equal(t, seed, genesis.Seed())
+	seed, err = RoundSeed(block, BlockInterval*DynastySize)
+	assert.Nil(t, err)
+	assert.Equal(t, seed, block.Seed())
+}
+
+func TestProposerOrder(t *testing.T) {
+	members := []byteutils.Hash{}
+	for i := 0; i < DynastySize; i++ {
+		members = append(members, mockAddress().Bytes())
+	}
+	order := ProposerOrder(members, []byte("seed"))
+	assert.Equal(t, order, ProposerOrder(members, []byte("seed")))
+	assert.Equal(t, len(order), len(members))
+	for _, v := range members {
+		assert.Contains(t, order, v)
+	}
+}
+
+func TestBlock_RandomBeforeHeight(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.RandomHeight = 2
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	signer := mockAddress()
+	key, err := keystore.DefaultKS.GetUnlocked(signer.String())
+	assert.Nil(t, err)
+	signature := key.(keystore.PrivateKey)
+	genesis := bc.GenesisBlock()
+
+	// the block before the random height carries no random, and its round isn't shuffled.
+	block, err := bc.NewBlock(signer)
+	assert.Nil(t, err)
+	block.SetTimestamp(BlockInterval)
+	seed, err := RoundSeed(genesis, BlockInterval)
+	assert.Nil(t, err)
+	assert.Nil(t, seed)
+	assert.Nil(t, block.SignRandom(signature))
+	assert.Nil(t, block.Random())
+	assert.Nil(t, block.VerifyRandom(genesis, signer))
+	_, err = block.RandomSeed()
+	assert.Equal(t, err, ErrMissingBlockRandom)
+	block.header.random = make([]byte, randomLength)
+	assert.Equal(t, block.VerifyRandom(genesis, signer), ErrInvalidBlockRandom)
+	block.header.random = nil
+	assert.Nil(t, block.Seal())
+
+	// the block at the random height must carry it.
+	next, err := bc.NewBlockFromParent(signer, block)
+	assert.Nil(t, err)
+	next.SetTimestamp(BlockInterval * 2)
+	assert.Equal(t, next.VerifyRandom(block, signer), ErrInvalidBlockRandom)
+	assert.Nil(t, next.SignRandom(signature))
+	assert.Nil(t, next.VerifyRandom(block, signer))
+	seed, err = RoundSeed(block, BlockInterval*2)
+	assert.Nil(t, err)
+	assert.Equal(t, seed, genesis.Seed())
+}
@@ -71,3 +71,8 @@ func (k *PrivateKey) PublicKey() keystore.PublicKey {
 func (k *PrivateKey) Sign(hash []byte) ([]byte, error) {
 	return Sign(hash, k.privateKey)
 }
+
+// VRFProve return the proof of the random output of the key for alpha
+func (k *PrivateKey) VRFProve(alpha []byte) ([]byte, error) {
+	return VRFProve(k.privateKey, alpha)
+}
@@ -0,0 +1,172 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secp256k1
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1/bitelliptic"
+)
+
+// The verifiable random function is the ECVRF construction over secp256k1: the output is a hash of
+// gamma = sk*H(pk, alpha), which is unique for the key and the input, with a proof that gamma and the
+// public key share the same discrete log. Unlike a signature, the prover can't choose among outputs.
+
+// const
+const (
+	// VRFProofLength is the length of a proof: the uncompressed gamma, the challenge c and the response s.
+	VRFProofLength = 65 + 32 + 32
+)
+
+// errors
+var (
+	ErrInvalidVRFKey   = errors.New("invalid vrf public key")
+	ErrInvalidVRFProof = errors.New("invalid vrf proof")
+	ErrVRFHashToCurve  = errors.New("failed to hash the vrf input to the curve")
+)
+
+// VRFProve return the proof of the random output of the private key for alpha.
+func VRFProve(priv *ecdsa.PrivateKey, alpha []byte) ([]byte, error) {
+	curve := bitelliptic.S256()
+	n := curve.N
+	pub := curve.Marshal(priv.PublicKey.X, priv.PublicKey.Y)
+	hx, hy, err := hashToCurve(pub, alpha)
+	if err != nil {
+		return nil, err
+	}
+	sk := paddedBigBytes(priv.D, 32)
+	gx, gy := curve.ScalarMult(hx, hy, sk)
+
+	// the nonce is derived from the key and the input, it's never reused for another input.
+	k := new(big.Int).SetBytes(hash.Sha3256(sk, curve.Marshal(hx, hy)))
+	k.Mod(k, n)
+	if k.Sign() == 0 {
+		return nil, ErrInvalidVRFProof
+	}
+	ux, uy := curve.ScalarBaseMult(paddedBigBytes(k, 32))
+	vx, vy := curve.ScalarMult(hx, hy, paddedBigBytes(k, 32))
+	c := vrfChallenge(pub, hx, hy, gx, gy, ux, uy, vx, vy)
+
+	s := new(big.Int).Mul(c, priv.D)
+	s.Add(s, k)
+	s.Mod(s, n)
+
+	proof := make([]byte, 0, VRFProofLength)
+	proof = append(proof, curve.Marshal(gx, gy)...)
+	proof = append(proof, paddedBigBytes(c, 32)...)
+	proof = append(proof, paddedBigBytes(s, 32)...)
+	return proof, nil
+}
+
+// VRFVerify verify the proof of the public key for alpha, and return its random output.
+func VRFVerify(pub []byte, alpha []byte, proof []byte) ([]byte, error) {
+	curve := bitelliptic.S256()
+	n := curve.N
+	yx, yy := curve.Unmarshal(pub)
+	if yx == nil || !curve.IsOnCurve(yx, yy) {
+		return nil, ErrInvalidVRFKey
+	}
+	if len(proof) != VRFProofLength {
+		return nil, ErrInvalidVRFProof
+	}
+	gx, gy := curve.Unmarshal(proof[:65])
+	if gx == nil || !curve.IsOnCurve(gx, gy) {
+		return nil, ErrInvalidVRFProof
+	}
+	c := new(big.Int).SetBytes(proof[65:97])
+	s := new(big.Int).SetBytes(proof[97:])
+	if c.Sign() == 0 || c.Cmp(n) >= 0 || s.Sign() == 0 || s.Cmp(n) >= 0 {
+		return nil, ErrInvalidVRFProof
+	}
+	hx, hy, err := hashToCurve(pub, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	// u = s*G - c*Y and v = s*H - c*gamma, with -c as n-c.
+	negC := paddedBigBytes(new(big.Int).Sub(n, c), 32)
+	sb := paddedBigBytes(s, 32)
+	sgx, sgy := curve.ScalarBaseMult(sb)
+	cyx, cyy := curve.ScalarMult(yx, yy, negC)
+	ux, uy := addPoints(curve, sgx, sgy, cyx, cyy)
+	shx, shy := curve.ScalarMult(hx, hy, sb)
+	cgx, cgy := curve.ScalarMult(gx, gy, negC)
+	vx, vy := addPoints(curve, shx, shy, cgx, cgy)
+	if ux == nil || vx == nil {
+		return nil, ErrInvalidVRFProof
+	}
+	if vrfChallenge(pub, hx, hy, gx, gy, ux, uy, vx, vy).Cmp(c) != 0 {
+		return nil, ErrInvalidVRFProof
+	}
+	return hash.Sha3256(proof[:65]), nil
+}
+
+// hashToCurve map the public key and alpha to a point by trying the hashes with a counter as x.
+func hashToCurve(pub []byte, alpha []byte) (*big.Int, *big.Int, error) {
+	curve := bitelliptic.S256()
+	p := curve.P
+	exp := new(big.Int).Add(p, big.NewInt(1))
+	exp.Rsh(exp, 2)
+	for ctr := 0; ctr < 256; ctr++ {
+		x := new(big.Int).SetBytes(hash.Sha3256(pub, alpha, []byte{byte(ctr)}))
+		if x.Cmp(p) >= 0 {
+			continue
+		}
+		// y^2 = x^3 + 7, p = 3 mod 4 so the root is rhs^((p+1)/4).
+		rhs := new(big.Int).Exp(x, big.NewInt(3), p)
+		rhs.Add(rhs, curve.B)
+		rhs.Mod(rhs, p)
+		y := new(big.Int).Exp(rhs, exp, p)
+		if new(big.Int).Exp(y, big.NewInt(2), p).Cmp(rhs) != 0 {
+			continue
+		}
+		if y.Bit(0) == 1 {
+			y.Sub(p, y)
+		}
+		return x, y, nil
+	}
+	return nil, nil, ErrVRFHashToCurve
+}
+
+func vrfChallenge(pub []byte, points ...*big.Int) *big.Int {
+	curve := bitelliptic.S256()
+	data := [][]byte{pub}
+	for i := 0; i+1 < len(points); i += 2 {
+		data = append(data, curve.Marshal(points[i], points[i+1]))
+	}
+	c := new(big.Int).SetBytes(hash.Sha3256(data...))
+	return c.Mod(c, curve.N)
+}
+
+// addPoints add two points, nil being the point at infinity, which the jacobian addition can't handle.
+func addPoints(curve *bitelliptic.BitCurve, x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	if x1 == nil || x2 == nil {
+		return nil, nil
+	}
+	if x1.Cmp(x2) == 0 {
+		if y1.Cmp(y2) != 0 {
+			return nil, nil
+		}
+		return curve.Double(x1, y1)
+	}
+	return curve.Add(x1, y1, x2, y2)
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package secp256k1
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+func TestVRF(t *testing.T) {
+	priv := NewECDSAPrivateKey()
+	pub, err := FromECDSAPublicKey(&priv.PublicKey)
+	if err != nil {
+		t.Fatalf("FromECDSAPublicKey err:%s", err)
+	}
+	proof, err := VRFProve(priv, []byte("alpha"))
+	if err != nil {
+		t.Fatalf("VRFProve err:%s", err)
+	}
+	output, err := VRFVerify(pub, []byte("alpha"), proof)
+	if err != nil {
+		t.Fatalf("VRFVerify err:%s", err)
+	}
+
+	// the proof and the output are unique for the key and the input.
+	again, _ := VRFProve(priv, []byte("alpha"))
+	if !bytes.Equal(proof, again) {
+		t.Errorf("VRFProve not deterministic")
+	}
+	other, _ := VRFProve(priv, []byte("beta"))
+	if out, _ := VRFVerify(pub, []byte("beta"), other); bytes.Equal(out, output) {
+		t.Errorf("VRF output same for different inputs")
+	}
+
+	if _, err := VRFVerify(pub, []byte("beta"), proof); err != ErrInvalidVRFProof {
+		t.Errorf("VRFVerify of another input err:%v", err)
+	}
+	for _, i := range []int{1, 70, 100} {
+		forged := append([]byte{}, proof...)
+		forged[i] ^= 1
+		if _, err := VRFVerify(pub, []byte("alpha"), forged); err == nil {
+			t.Errorf("VRFVerify of forged proof at %d passed", i)
+		}
+	}
+	otherPriv := NewECDSAPrivateKey()
+	otherPub, _ := FromECDSAPublicKey(&otherPriv.PublicKey)
+	if _, err := VRFVerify(otherPub, []byte("alpha"), proof); err != ErrInvalidVRFProof {
+		t.Errorf("VRFVerify with another key err:%v", err)
+	}
+
+	// s = c*sk puts u at infinity, it must be refused without a panic.
+	infinity := append([]byte{}, proof[:65]...)
+	infinity = append(infinity, paddedBigBytes(big.NewInt(1), 32)...)
+	infinity = append(infinity, paddedBigBytes(priv.D, 32)...)
+	if _, err := VRFVerify(pub, []byte("alpha"), infinity); err != ErrInvalidVRFProof {
+		t.Errorf("VRFVerify of proof at infinity err:%v", err)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"sync"
+	"time"
+)
+
+// QuotaIdleTimeout is the duration after which an idle client is forgotten, its credits are full again anyway.
+const QuotaIdleTimeout = time.Minute
+
+// Quota gives each client credits at a rate per second, up to one second of credits,
+// a request takes the credits of its cost and is refused if the client has not enough.
+type Quota struct {
+	mu      sync.Mutex
+	rate    float64
+	clients map[string]*credits
+}
+
+type credits struct {
+	balance float64
+	last    time.Time
+}
+
+// NewQuota return a new Quota giving the rate credits per second to each client.
+func NewQuota(rate int) *Quota {
+	return &Quota{
+		rate:    float64(rate),
+		clients: make(map[string]*credits),
+	}
+}
+
+// Take take the cost credits from the client, return false if the client has not enough.
+func (q *Quota) Take(client string, cost int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	c, ok := q.clients[client]
+	if !ok {
+		c = &credits{balance: q.rate, last: now}
+		q.clients[client] = c
+	}
+	c.balance += now.Sub(c.last).Seconds() * q.rate
+	if c.balance > q.rate {
+		c.balance = q.rate
+	}
+	c.last = now
+	if c.balance < float64(cost) {
+		return false
+	}
+	c.balance -= float64(cost)
+	return true
+}
+
+// Expire forget the clients idle for longer than QuotaIdleTimeout.
+func (q *Quota) Expire() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	for client, c := range q.clients {
+		if now.Sub(c.last) > QuotaIdleTimeout {
+			delete(q.clients, client)
+		}
+	}
+}
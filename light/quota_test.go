@@ -0,0 +1,44 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuota(t *testing.T) {
+	q := NewQuota(4)
+	assert.True(t, q.Take("a", 3))
+	assert.False(t, q.Take("a", 3))
+	// the clients have their own credits.
+	assert.True(t, q.Take("b", 4))
+
+	// the credits come back at the rate, up to one second of them.
+	q.clients["a"].last = time.Now().Add(-10 * time.Second)
+	assert.True(t, q.Take("a", 4))
+	assert.False(t, q.Take("a", 1))
+
+	q.clients["b"].last = time.Now().Add(-QuotaIdleTimeout - time.Second)
+	q.Expire()
+	assert.Equal(t, 1, len(q.clients))
+	assert.NotNil(t, q.clients["a"])
+}
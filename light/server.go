@@ -0,0 +1,247 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// request types
+const (
+	RequestHeaders     = "headers"
+	RequestAccount     = "account"
+	RequestTransaction = "tx"
+	RequestEvents      = "events"
+)
+
+// const
+const (
+	// MaxHeadersPerRequest is the most headers served to a light client at once.
+	MaxHeadersPerRequest = 256
+	// headersPerCredit is the number of headers a credit pays for, above the first one.
+	headersPerCredit = 64
+
+	proofCost  = 2
+	eventsCost = 4
+)
+
+// errors
+var (
+	ErrUnknownRequest = errors.New("unknown light request type")
+	ErrQuotaExceeded  = errors.New("light client quota exceeded")
+	ErrBlockNotFound  = errors.New("block not found")
+)
+
+var (
+	lightRequestMeter  = metrics.GetOrRegisterMeter("neb.light.request", nil)
+	lightRejectedMeter = metrics.GetOrRegisterMeter("neb.light.rejected", nil)
+)
+
+// Server serves the headers, the Merkle proofs of the accounts and transactions,
+// and the filtered events of the blocks to the light clients, each within its quota.
+type Server struct {
+	bc                *core.BlockChain
+	nm                p2p.Manager
+	quota             *Quota
+	receivedMessageCh chan net.Message
+	quitCh            chan bool
+}
+
+// NewServer return a new light Server giving each client the quota credits per second.
+func NewServer(bc *core.BlockChain, quota int) *Server {
+	return &Server{
+		bc:                bc,
+		quota:             NewQuota(quota),
+		receivedMessageCh: make(chan net.Message, 128),
+		quitCh:            make(chan bool, 1),
+	}
+}
+
+// RegisterInNetwork register the light requests in the network.
+func (s *Server) RegisterInNetwork(nm p2p.Manager) {
+	s.nm = nm
+	nm.Register(net.NewSubscriber(s, s.receivedMessageCh, net.MessageTypeLightRequest))
+	p2p.RegisterMessageVersion(net.MessageTypeLightRequest, 2)
+	p2p.RegisterMessageVersion(net.MessageTypeLightResponse, 2)
+}
+
+// Start start the light server.
+func (s *Server) Start() {
+	logging.CLog().Info("Start light server.")
+	go s.loop()
+}
+
+// Stop stop the light server.
+func (s *Server) Stop() {
+	logging.CLog().Info("Stop light server.")
+	s.quitCh <- true
+}
+
+func (s *Server) loop() {
+	ticker := time.NewTicker(QuotaIdleTimeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.quitCh:
+			logging.CLog().Info("Shutdowned light server.")
+			return
+		case <-ticker.C:
+			s.quota.Expire()
+		case msg := <-s.receivedMessageCh:
+			s.handleRequest(msg)
+		}
+	}
+}
+
+func (s *Server) handleRequest(msg net.Message) {
+	req := new(corepb.LightRequest)
+	if err := pb.Unmarshal(msg.Data().([]byte), req); err != nil {
+		s.nm.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	lightRequestMeter.Mark(1)
+
+	resp := &corepb.LightResponse{Id: req.Id}
+	cost, err := requestCost(req)
+	if err == nil && !s.quota.Take(msg.MessageFrom(), cost) {
+		lightRejectedMeter.Mark(1)
+		err = ErrQuotaExceeded
+	}
+	if err == nil {
+		err = s.serve(req, resp)
+	}
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"from": msg.MessageFrom(),
+			"type": req.Type,
+			"err":  err,
+		}).Debug("Failed to serve light request.")
+		resp = &corepb.LightResponse{Id: req.Id, Error: err.Error()}
+	}
+
+	data, err := pb.Marshal(resp)
+	if err != nil {
+		return
+	}
+	s.nm.SendMsg(net.MessageTypeLightResponse, data, msg.MessageFrom())
+}
+
+func requestCost(req *corepb.LightRequest) (int, error) {
+	switch req.Type {
+	case RequestHeaders:
+		count := req.Count
+		if count > MaxHeadersPerRequest {
+			count = MaxHeadersPerRequest
+		}
+		return 1 + int(count/headersPerCredit), nil
+	case RequestAccount, RequestTransaction:
+		return proofCost, nil
+	case RequestEvents:
+		return eventsCost, nil
+	}
+	return 0, ErrUnknownRequest
+}
+
+func (s *Server) serve(req *corepb.LightRequest, resp *corepb.LightResponse) error {
+	if req.Type == RequestHeaders {
+		return s.serveHeaders(req, resp)
+	}
+
+	block := s.bc.TailBlock()
+	if len(req.BlockHash) > 0 {
+		block = s.bc.GetBlock(req.BlockHash)
+	}
+	if block == nil {
+		return ErrBlockNotFound
+	}
+	resp.BlockHash = block.Hash()
+
+	var (
+		val   []byte
+		proof trie.MerkleProof
+		err   error
+	)
+	switch req.Type {
+	case RequestAccount:
+		val, proof, err = block.ProveAccount(req.Key)
+	case RequestTransaction:
+		val, proof, err = block.ProveTransaction(req.Key)
+	case RequestEvents:
+		return serveEvents(block, req.Topics, resp)
+	}
+	if err != nil {
+		return err
+	}
+	resp.Value = val
+	resp.Proof = toProofNodes(proof)
+	return nil
+}
+
+func (s *Server) serveHeaders(req *corepb.LightRequest, resp *corepb.LightResponse) error {
+	count := req.Count
+	if count > MaxHeadersPerRequest {
+		count = MaxHeadersPerRequest
+	}
+	for _, block := range s.bc.FetchBlocksInCanonicalChain(req.Start, int(count)) {
+		header, err := core.NewHeader(block).ToProto()
+		if err != nil {
+			return err
+		}
+		if header, ok := header.(*corepb.SignedHeader); ok {
+			resp.Headers = append(resp.Headers, header)
+		}
+	}
+	return nil
+}
+
+func serveEvents(block *core.Block, topics []string, resp *corepb.LightResponse) error {
+	events, err := block.FilterEvents(topics)
+	if err != nil {
+		return err
+	}
+	for _, v := range events {
+		resp.Events = append(resp.Events, &corepb.LightEvent{
+			TxHash: v.TxHash,
+			Index:  v.Index,
+			Topic:  v.Event.Topic,
+			Data:   v.Event.Data,
+			Proof:  toProofNodes(v.Proof),
+		})
+	}
+	return nil
+}
+
+func toProofNodes(proof trie.MerkleProof) []*corepb.ProofNode {
+	result := make([]*corepb.ProofNode, len(proof))
+	for i, node := range proof {
+		result[i] = &corepb.ProofNode{Val: node}
+	}
+	return result
+}
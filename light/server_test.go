@@ -0,0 +1,202 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"testing"
+
+	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type testNeb struct {
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *core.EventEmitter
+}
+
+func (n *testNeb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *testNeb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *testNeb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *testNeb) StartSync() {}
+
+type testMessage struct {
+	data []byte
+	from string
+}
+
+func (msg *testMessage) MessageType() string {
+	return net.MessageTypeLightRequest
+}
+
+func (msg *testMessage) Data() interface{} {
+	return msg.data
+}
+
+func (msg *testMessage) MessageFrom() string {
+	return msg.from
+}
+
+// testManager records the responses and the reports of the server.
+type testManager struct {
+	responses map[string][]*corepb.LightResponse
+	reports   map[string][]p2p.PeerMisbehavior
+}
+
+func (m *testManager) Start() error { return nil }
+func (m *testManager) Stop()        {}
+
+func (m *testManager) Node() *p2p.Node { return &p2p.Node{} }
+
+func (m *testManager) Sync(net.Serializable) error            { return nil }
+func (m *testManager) SendSyncReply(string, net.Serializable) {}
+
+func (m *testManager) Register(...*net.Subscriber)   {}
+func (m *testManager) Deregister(...*net.Subscriber) {}
+
+func (m *testManager) Broadcast(string, net.Serializable) {}
+func (m *testManager) Relay(string, net.Serializable)     {}
+
+func (m *testManager) SendMsg(msgType string, data []byte, target string) error {
+	resp := new(corepb.LightResponse)
+	if err := pb.Unmarshal(data, resp); err != nil {
+		return err
+	}
+	m.responses[target] = append(m.responses[target], resp)
+	return nil
+}
+
+func (m *testManager) BroadcastNetworkID([]byte) {}
+
+func (m *testManager) BuildData([]byte, string) []byte { return nil }
+
+func (m *testManager) ReportPeer(id string, misbehavior p2p.PeerMisbehavior) {
+	m.reports[id] = append(m.reports[id], misbehavior)
+}
+
+func (m *testManager) UpdatePeerHead(string, uint64, string) {}
+
+func newTestServer(t *testing.T, quota int) (*Server, *testManager) {
+	genesis := &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{ChainId: 100},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{
+				Dynasty: []string{
+					"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+					"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+					"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+					"48f981ed38910f1232c1bab124f650c482a57271632db9e3",
+					"59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232",
+					"75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f",
+				},
+			},
+		},
+		TokenDistribution: []*corepb.GenesisTokenDistribution{
+			&corepb.GenesisTokenDistribution{
+				Address: "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+				Value:   "10000000000000000000000",
+			},
+		},
+	}
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	bc, err := core.NewBlockChain(&testNeb{genesis: genesis, storage: stor, emitter: core.NewEventEmitter(1024)})
+	assert.Nil(t, err)
+
+	nm := &testManager{
+		responses: make(map[string][]*corepb.LightResponse),
+		reports:   make(map[string][]p2p.PeerMisbehavior),
+	}
+	s := NewServer(bc, quota)
+	s.RegisterInNetwork(nm)
+	return s, nm
+}
+
+// request send the request to the server and return its response.
+func request(t *testing.T, s *Server, nm *testManager, from string, req *corepb.LightRequest) *corepb.LightResponse {
+	data, err := pb.Marshal(req)
+	assert.Nil(t, err)
+	s.handleRequest(&testMessage{data: data, from: from})
+	responses := nm.responses[from]
+	assert.NotEmpty(t, responses)
+	return responses[len(responses)-1]
+}
+
+func TestServer(t *testing.T) {
+	s, nm := newTestServer(t, 100)
+	genesis := s.bc.GenesisBlock()
+
+	resp := request(t, s, nm, "client", &corepb.LightRequest{Id: 1, Type: RequestHeaders, Start: 1, Count: 10})
+	assert.Equal(t, uint64(1), resp.Id)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, 1, len(resp.Headers))
+
+	address, err := byteutils.FromHex("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	assert.Nil(t, err)
+	resp = request(t, s, nm, "client", &corepb.LightRequest{Id: 2, Type: RequestAccount, Key: address})
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, []byte(genesis.Hash()), resp.BlockHash)
+	assert.NotEmpty(t, resp.Value)
+	assert.NotEmpty(t, resp.Proof)
+
+	resp = request(t, s, nm, "client", &corepb.LightRequest{Id: 3, Type: RequestEvents, BlockHash: genesis.Hash()})
+	assert.Empty(t, resp.Error)
+	assert.Empty(t, resp.Events)
+
+	resp = request(t, s, nm, "client", &corepb.LightRequest{Id: 4, Type: RequestAccount, BlockHash: []byte("unknown"), Key: address})
+	assert.Equal(t, ErrBlockNotFound.Error(), resp.Error)
+
+	resp = request(t, s, nm, "client", &corepb.LightRequest{Id: 5, Type: "receipt"})
+	assert.Equal(t, ErrUnknownRequest.Error(), resp.Error)
+
+	s.handleRequest(&testMessage{data: []byte("garbage"), from: "client"})
+	assert.Equal(t, []p2p.PeerMisbehavior{p2p.InvalidMessage}, nm.reports["client"])
+}
+
+func TestServer_Quota(t *testing.T) {
+	s, nm := newTestServer(t, 5)
+
+	// the headers cost a credit, and one more per headersPerCredit headers.
+	cost, err := requestCost(&corepb.LightRequest{Type: RequestHeaders, Count: 2 * MaxHeadersPerRequest})
+	assert.Nil(t, err)
+	assert.Equal(t, 1+MaxHeadersPerRequest/headersPerCredit, cost)
+
+	resp := request(t, s, nm, "a", &corepb.LightRequest{Type: RequestEvents})
+	assert.Empty(t, resp.Error)
+	resp = request(t, s, nm, "a", &corepb.LightRequest{Type: RequestAccount})
+	assert.Equal(t, ErrQuotaExceeded.Error(), resp.Error)
+	// the other clients are served.
+	resp = request(t, s, nm, "b", &corepb.LightRequest{Type: RequestEvents})
+	assert.Empty(t, resp.Error)
+}
@@ -6,12 +6,15 @@ import (
 
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/consensus/dev"
 	"github.com/nebulasio/go-nebulas/consensus/dpos"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/light"
 	"github.com/nebulasio/go-nebulas/metrics"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/rpc"
 	"github.com/nebulasio/go-nebulas/storage"
 	nsync "github.com/nebulasio/go-nebulas/sync"
@@ -53,6 +56,8 @@ type Neblet struct {
 
 	syncManager *nsync.Manager
 
+	lightServer *light.Server
+
 	apiServer rpc.Server
 
 	managementServer rpc.Server
@@ -97,21 +102,65 @@ func (n *Neblet) Setup() error {
 	if err != nil {
 		return err
 	}
+	if n.config.Chain.Relay {
+		checkpoints, err := nsync.ParseCheckpoints(n.config.Chain.Checkpoints)
+		if err != nil {
+			return err
+		}
+		if err := n.blockChain.SetRelayMode(checkpoints); err != nil {
+			return err
+		}
+	} else if n.blockChain.RelayMode() {
+		return core.ErrRelayStorage
+	}
 	gasPrice := util.NewUint128FromString(n.config.Chain.GasPrice)
 	gasLimit := util.NewUint128FromString(n.config.Chain.GasLimit)
 	n.blockChain.TransactionPool().SetGasConfig(gasPrice, gasLimit)
+	n.blockChain.TransactionPool().SetPackingThrottle(core.NewPackingThrottle(n.config.Chain.PackingCpuPercent))
 
 	n.blockChain.BlockPool().RegisterInNetwork(n.netService)
-	n.blockChain.TransactionPool().RegisterInNetwork(n.netService)
+	n.blockChain.TransactionPool().SetObserver(n.config.Chain.Observer)
+	if n.txPoolEnabled() {
+		n.blockChain.TransactionPool().RegisterInNetwork(n.netService)
+	}
 
-	n.consensus, err = dpos.NewDpos(n)
+	nvm.SetDevMode(n.config.Chain.Dev)
+	nvm.SetV8EnginePoolSize(int(n.config.GetRpc().GetEnginePoolSize()))
+	nvm.ResetAuditHooks()
+	if n.config.Chain.AuditLog {
+		nvm.RegisterAuditHook(&nvm.AuditLogger{})
+	}
+	if n.config.Chain.AuditHostCalls > 0 {
+		nvm.RegisterAuditHook(nvm.NewHostCallLimiter(n.config.Chain.AuditHostCalls))
+	}
+	if n.config.Chain.Dev {
+		n.consensus, err = dev.NewDev(n)
+	} else {
+		n.consensus, err = dpos.NewDpos(n)
+	}
 	if err != nil {
 		return err
 	}
 	n.blockChain.SetConsensusHandler(n.consensus)
+	if n.config.Chain.EventRetention > 0 && !n.config.Chain.Relay {
+		n.blockChain.SetEventStore(core.NewEventStore(n.storage, n.config.Chain.EventRetention))
+	}
 
 	// start sync service
 	n.syncManager = nsync.NewManager(n.blockChain, n.consensus, n.netService)
+	syncMode := n.config.Chain.SyncMode
+	if n.config.Chain.Relay {
+		// a relay downloads no state.
+		syncMode = nsync.SyncModeFull
+	}
+	if err := n.syncManager.SetSyncMode(syncMode, n.config.Chain.Checkpoints); err != nil {
+		return err
+	}
+
+	if quota := n.config.Network.LightServeQuota; quota > 0 {
+		n.lightServer = light.NewServer(n.blockChain, int(quota))
+		n.lightServer.RegisterInNetwork(n.netService)
+	}
 
 	n.apiServer = rpc.NewAPIServer(n)
 	return nil
@@ -142,11 +191,16 @@ func (n *Neblet) Start() error {
 	go n.apiServer.RunGateway()
 
 	n.blockChain.BlockPool().Start()
-	n.blockChain.TransactionPool().Start()
+	if n.txPoolEnabled() {
+		n.blockChain.TransactionPool().Start()
+	}
 	n.eventEmitter.Start()
 
 	n.syncManager.Start()
 	n.consensus.Start()
+	if n.lightServer != nil {
+		n.lightServer.Start()
+	}
 
 	nebstartGauge.Update(1)
 	// TODO: error handling
@@ -165,6 +219,11 @@ func (n *Neblet) Stop() error {
 		n.consensus = nil
 	}
 
+	if n.lightServer != nil {
+		n.lightServer.Stop()
+		n.lightServer = nil
+	}
+
 	if n.blockChain != nil {
 		n.blockChain.BlockPool().Stop()
 		n.blockChain = nil
@@ -201,6 +260,11 @@ func (n *Neblet) Stop() error {
 	return nil
 }
 
+// txPoolEnabled returns false if the tx pool is disabled in observer mode.
+func (n *Neblet) txPoolEnabled() bool {
+	return !n.config.Chain.Observer || !n.config.Chain.DisableTxPool
+}
+
 // SetGenesis set genesis conf
 func (n *Neblet) SetGenesis(g *corepb.Genesis) {
 	n.genesis = g
@@ -246,6 +310,16 @@ func (n *Neblet) NetManager() p2p.Manager {
 	return n.netService
 }
 
+// Consensus returns consensus reference.
+func (n *Neblet) Consensus() consensus.Consensus {
+	return n.consensus
+}
+
+// SyncManager returns sync manager reference.
+func (n *Neblet) SyncManager() *nsync.Manager {
+	return n.syncManager
+}
+
 // checks if the storage scheme version is compatiable
 func (n *Neblet) checkSchemeVersion(stor storage.Storage) error {
 	version, err := stor.Get(storageSchemeVersionKey)
@@ -127,6 +127,58 @@ type NetworkConfig struct {
 	PrivateKey string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
 	// Network ID
 	NetworkId uint32 `protobuf:"varint,4,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Score a misbehaving peer is banned at, 100 if 0.
+	BanScore uint32 `protobuf:"varint,5,opt,name=ban_score,json=banScore,proto3" json:"ban_score,omitempty"`
+	// Seconds a misbehaving peer is banned for, 1800 if 0.
+	BanDuration uint32 `protobuf:"varint,6,opt,name=ban_duration,json=banDuration,proto3" json:"ban_duration,omitempty"`
+	// Disable requesting port mappings from the router by UPnP or NAT-PMP.
+	DisableNat bool `protobuf:"varint,7,opt,name=disable_nat,json=disableNat,proto3" json:"disable_nat,omitempty"`
+	// Domains to resolve the seed nodes from, by their DNS TXT and SRV records.
+	DnsSeed []string `protobuf:"bytes,8,rep,name=dns_seed,json=dnsSeed" json:"dns_seed,omitempty"`
+	// Hex public key the DNS seed lists must be signed by, unsigned lists are accepted if empty.
+	DnsSeedSigner string `protobuf:"bytes,9,opt,name=dns_seed_signer,json=dnsSeedSigner,proto3" json:"dns_seed_signer,omitempty"`
+	// Multiaddrs with the peer ids of the peers always reconnected to.
+	StaticPeer []string `protobuf:"bytes,10,rep,name=static_peer,json=staticPeer" json:"static_peer,omitempty"`
+	// Peer ids of the peers exempt from the scoring, the bans and the connection limits.
+	TrustedPeer []string `protobuf:"bytes,11,rep,name=trusted_peer,json=trustedPeer" json:"trusted_peer,omitempty"`
+	// Size in bytes the block and transaction messages are compressed by snappy from, 1024 if 0.
+	CompressionThreshold uint32 `protobuf:"varint,12,opt,name=compression_threshold,json=compressionThreshold,proto3" json:"compression_threshold,omitempty"`
+	// Disable the compression.
+	DisableCompression bool `protobuf:"varint,13,opt,name=disable_compression,json=disableCompression,proto3" json:"disable_compression,omitempty"`
+	// Bytes per second sent to and received from all the peers, unlimited if 0.
+	MaxUploadRate   uint32 `protobuf:"varint,14,opt,name=max_upload_rate,json=maxUploadRate,proto3" json:"max_upload_rate,omitempty"`
+	MaxDownloadRate uint32 `protobuf:"varint,15,opt,name=max_download_rate,json=maxDownloadRate,proto3" json:"max_download_rate,omitempty"`
+	// Bytes per second sent to and received from each peer, unlimited if 0.
+	PeerUploadRate   uint32 `protobuf:"varint,16,opt,name=peer_upload_rate,json=peerUploadRate,proto3" json:"peer_upload_rate,omitempty"`
+	PeerDownloadRate uint32 `protobuf:"varint,17,opt,name=peer_download_rate,json=peerDownloadRate,proto3" json:"peer_download_rate,omitempty"`
+	// Pre-shared token of a private network, only the peers knowing the same one are connected.
+	NetworkToken string `protobuf:"bytes,18,opt,name=network_token,json=networkToken,proto3" json:"network_token,omitempty"`
+	// SOCKS5 proxy url the outbound peer connections are dialed through, e.g. "socks5://127.0.0.1:9050".
+	Proxy string `protobuf:"bytes,19,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	// Maximum numbers of the peers connected to the node and dialed by it, 96 and 32 if 0.
+	// The trusted peers have reserved slots beyond them.
+	MaxInboundPeers  uint32 `protobuf:"varint,20,opt,name=max_inbound_peers,json=maxInboundPeers,proto3" json:"max_inbound_peers,omitempty"`
+	MaxOutboundPeers uint32 `protobuf:"varint,21,opt,name=max_outbound_peers,json=maxOutboundPeers,proto3" json:"max_outbound_peers,omitempty"`
+	// CIDRs the peers are connected at, any if empty, and CIDRs they are never connected at.
+	IpAllow []string `protobuf:"bytes,22,rep,name=ip_allow,json=ipAllow" json:"ip_allow,omitempty"`
+	IpDeny  []string `protobuf:"bytes,23,rep,name=ip_deny,json=ipDeny" json:"ip_deny,omitempty"`
+	// Messages remembered per message type not to relay them twice, 65536 if 0,
+	// and seconds a message is remembered for, 600 if 0.
+	RelayCacheSize uint32 `protobuf:"varint,24,opt,name=relay_cache_size,json=relayCacheSize,proto3" json:"relay_cache_size,omitempty"`
+	RelayCacheTtl  uint32 `protobuf:"varint,25,opt,name=relay_cache_ttl,json=relayCacheTtl,proto3" json:"relay_cache_ttl,omitempty"`
+	// Credits per second of each light client served, 0 disables the light client serving.
+	LightServeQuota uint32 `protobuf:"varint,26,opt,name=light_serve_quota,json=lightServeQuota,proto3" json:"light_serve_quota,omitempty"`
+	// Outbound peers dialed per /24 IPv4 or /48 IPv6 subnet, 2 if 0, and per AS, 8 if 0,
+	// the AS of the IPs looked up in the as_map file of CIDR and AS number lines.
+	MaxPeersPerSubnet uint32 `protobuf:"varint,27,opt,name=max_peers_per_subnet,json=maxPeersPerSubnet,proto3" json:"max_peers_per_subnet,omitempty"`
+	MaxPeersPerAs     uint32 `protobuf:"varint,28,opt,name=max_peers_per_as,json=maxPeersPerAs,proto3" json:"max_peers_per_as,omitempty"`
+	AsMap             string `protobuf:"bytes,29,opt,name=as_map,json=asMap,proto3" json:"as_map,omitempty"`
+	// Seconds between the rotations of the outbound peers, 1800 if 0,
+	// and percent of them dropped for new ones at each rotation, 10 if 0.
+	PeerRotationInterval uint32 `protobuf:"varint,30,opt,name=peer_rotation_interval,json=peerRotationInterval,proto3" json:"peer_rotation_interval,omitempty"`
+	PeerRotationPercent  uint32 `protobuf:"varint,31,opt,name=peer_rotation_percent,json=peerRotationPercent,proto3" json:"peer_rotation_percent,omitempty"`
+	// Listen on the udp ports of the listen addresses over QUIC too, and dial the QUIC addresses of the peers.
+	Quic bool `protobuf:"varint,32,opt,name=quic,proto3" json:"quic,omitempty"`
 }
 
 func (m *NetworkConfig) Reset()                    { *m = NetworkConfig{} }
@@ -162,6 +214,202 @@ func (m *NetworkConfig) GetNetworkId() uint32 {
 	return 0
 }
 
+func (m *NetworkConfig) GetBanScore() uint32 {
+	if m != nil {
+		return m.BanScore
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetBanDuration() uint32 {
+	if m != nil {
+		return m.BanDuration
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetDisableNat() bool {
+	if m != nil {
+		return m.DisableNat
+	}
+	return false
+}
+
+func (m *NetworkConfig) GetDnsSeed() []string {
+	if m != nil {
+		return m.DnsSeed
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetDnsSeedSigner() string {
+	if m != nil {
+		return m.DnsSeedSigner
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetStaticPeer() []string {
+	if m != nil {
+		return m.StaticPeer
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetTrustedPeer() []string {
+	if m != nil {
+		return m.TrustedPeer
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetCompressionThreshold() uint32 {
+	if m != nil {
+		return m.CompressionThreshold
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetDisableCompression() bool {
+	if m != nil {
+		return m.DisableCompression
+	}
+	return false
+}
+
+func (m *NetworkConfig) GetMaxUploadRate() uint32 {
+	if m != nil {
+		return m.MaxUploadRate
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMaxDownloadRate() uint32 {
+	if m != nil {
+		return m.MaxDownloadRate
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetPeerUploadRate() uint32 {
+	if m != nil {
+		return m.PeerUploadRate
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetPeerDownloadRate() uint32 {
+	if m != nil {
+		return m.PeerDownloadRate
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetNetworkToken() string {
+	if m != nil {
+		return m.NetworkToken
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetMaxInboundPeers() uint32 {
+	if m != nil {
+		return m.MaxInboundPeers
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMaxOutboundPeers() uint32 {
+	if m != nil {
+		return m.MaxOutboundPeers
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetIpAllow() []string {
+	if m != nil {
+		return m.IpAllow
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetIpDeny() []string {
+	if m != nil {
+		return m.IpDeny
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetRelayCacheSize() uint32 {
+	if m != nil {
+		return m.RelayCacheSize
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetRelayCacheTtl() uint32 {
+	if m != nil {
+		return m.RelayCacheTtl
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetLightServeQuota() uint32 {
+	if m != nil {
+		return m.LightServeQuota
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMaxPeersPerSubnet() uint32 {
+	if m != nil {
+		return m.MaxPeersPerSubnet
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMaxPeersPerAs() uint32 {
+	if m != nil {
+		return m.MaxPeersPerAs
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetAsMap() string {
+	if m != nil {
+		return m.AsMap
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetPeerRotationInterval() uint32 {
+	if m != nil {
+		return m.PeerRotationInterval
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetPeerRotationPercent() uint32 {
+	if m != nil {
+		return m.PeerRotationPercent
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetQuic() bool {
+	if m != nil {
+		return m.Quic
+	}
+	return false
+}
+
 type ChainConfig struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -183,6 +431,39 @@ type ChainConfig struct {
 	GasLimit string `protobuf:"bytes,25,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// Supported signature cipher list. ["ECC_SECP256K1"]
 	SignatureCiphers []string `protobuf:"bytes,26,rep,name=signature_ciphers,json=signatureCiphers" json:"signature_ciphers,omitempty"`
+	// Dev mode, the node mines blocks alone with the miner, no dynasty needed.
+	Dev bool `protobuf:"varint,27,opt,name=dev,proto3" json:"dev,omitempty"`
+	// Seconds between empty blocks in dev mode, blocks are only mined for pending txs if 0.
+	DevBlockInterval uint32 `protobuf:"varint,28,opt,name=dev_block_interval,json=devBlockInterval,proto3" json:"dev_block_interval,omitempty"`
+	// Signing key registered by the miner with a candidate "signer" transaction, switched to when the
+	// miner's key fails to sign blocks. It signs the blocks in the miner's slots on its behalf.
+	BackupMiner string `protobuf:"bytes,29,opt,name=backup_miner,json=backupMiner,proto3" json:"backup_miner,omitempty"`
+	// Coinbase of the blocks signed by the backup key, the coinbase is used if empty.
+	BackupCoinbase string `protobuf:"bytes,30,opt,name=backup_coinbase,json=backupCoinbase,proto3" json:"backup_coinbase,omitempty"`
+	// Passphrase of the backup key.
+	BackupPassphrase string `protobuf:"bytes,31,opt,name=backup_passphrase,json=backupPassphrase,proto3" json:"backup_passphrase,omitempty"`
+	// NTP servers to check the local clock against, "pool.ntp.org" is used if empty.
+	NtpServers []string `protobuf:"bytes,32,rep,name=ntp_servers,json=ntpServers" json:"ntp_servers,omitempty"`
+	// Observer mode, the node validates and serves the chain, but never mines or broadcasts its own txs.
+	Observer bool `protobuf:"varint,33,opt,name=observer,proto3" json:"observer,omitempty"`
+	// Disable the tx pool in observer mode, txs from peers are dropped.
+	DisableTxPool bool `protobuf:"varint,34,opt,name=disable_tx_pool,json=disableTxPool,proto3" json:"disable_tx_pool,omitempty"`
+	// Max percentage of a cpu spent executing txs when packing blocks, unlimited if 0.
+	// The packing stops at half the slot whatever the limit.
+	PackingCpuPercent uint32 `protobuf:"varint,35,opt,name=packing_cpu_percent,json=packingCpuPercent,proto3" json:"packing_cpu_percent,omitempty"`
+	// Blocks whose events are kept on disk for the subscribers to replay, none is kept if 0.
+	EventRetention uint64 `protobuf:"varint,37,opt,name=event_retention,json=eventRetention,proto3" json:"event_retention,omitempty"`
+	// Log the timeouts and the memory of the contract executions, and the host functions they call in debug level.
+	AuditLog bool `protobuf:"varint,38,opt,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	// Max host function calls of a contract per second in the read-only calls of the API, unlimited if 0.
+	AuditHostCalls uint64 `protobuf:"varint,39,opt,name=audit_host_calls,json=auditHostCalls,proto3" json:"audit_host_calls,omitempty"`
+	// "full" replays every block, "fast" downloads the state of a recent block and replays the blocks after it.
+	SyncMode string `protobuf:"bytes,40,opt,name=sync_mode,json=syncMode,proto3" json:"sync_mode,omitempty"`
+	// Blocks the fast sync checks the chain against, as "height:hash".
+	Checkpoints []string `protobuf:"bytes,41,rep,name=checkpoints" json:"checkpoints,omitempty"`
+	// Relay the blocks and transactions, and serve the sync, without executing the blocks,
+	// trusting their state up to the checkpoints. The relay never mines.
+	Relay bool `protobuf:"varint,42,opt,name=relay,proto3" json:"relay,omitempty"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
@@ -260,6 +541,111 @@ func (m *ChainConfig) GetSignatureCiphers() []string {
 	return nil
 }
 
+func (m *ChainConfig) GetDev() bool {
+	if m != nil {
+		return m.Dev
+	}
+	return false
+}
+
+func (m *ChainConfig) GetDevBlockInterval() uint32 {
+	if m != nil {
+		return m.DevBlockInterval
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetBackupMiner() string {
+	if m != nil {
+		return m.BackupMiner
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetBackupCoinbase() string {
+	if m != nil {
+		return m.BackupCoinbase
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetBackupPassphrase() string {
+	if m != nil {
+		return m.BackupPassphrase
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetNtpServers() []string {
+	if m != nil {
+		return m.NtpServers
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetObserver() bool {
+	if m != nil {
+		return m.Observer
+	}
+	return false
+}
+
+func (m *ChainConfig) GetDisableTxPool() bool {
+	if m != nil {
+		return m.DisableTxPool
+	}
+	return false
+}
+
+func (m *ChainConfig) GetPackingCpuPercent() uint32 {
+	if m != nil {
+		return m.PackingCpuPercent
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetEventRetention() uint64 {
+	if m != nil {
+		return m.EventRetention
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetAuditLog() bool {
+	if m != nil {
+		return m.AuditLog
+	}
+	return false
+}
+
+func (m *ChainConfig) GetAuditHostCalls() uint64 {
+	if m != nil {
+		return m.AuditHostCalls
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetSyncMode() string {
+	if m != nil {
+		return m.SyncMode
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetCheckpoints() []string {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetRelay() bool {
+	if m != nil {
+		return m.Relay
+	}
+	return false
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen,omitempty"`
@@ -267,6 +653,44 @@ type RPCConfig struct {
 	HttpListen []string `protobuf:"bytes,2,rep,name=http_listen,json=httpListen" json:"http_listen,omitempty"`
 	// Enabled HTTP modules.["api", "admin"]
 	HttpModule []string `protobuf:"bytes,3,rep,name=http_module,json=httpModule" json:"http_module,omitempty"`
+	// Warm V8 isolates kept for the read-only contract calls and gas estimations, 8 if 0.
+	EnginePoolSize uint32 `protobuf:"varint,4,opt,name=engine_pool_size,json=enginePoolSize,proto3" json:"engine_pool_size,omitempty"`
+	// Most requests in a batch posted to /v1/batch, 100 if 0,
+	// and requests of a batch executed at once, 8 if 0.
+	MaxBatchSize     uint32 `protobuf:"varint,5,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	BatchConcurrency uint32 `protobuf:"varint,6,opt,name=batch_concurrency,json=batchConcurrency,proto3" json:"batch_concurrency,omitempty"`
+	// Requests per second of a client ip on the HTTP gateway, unlimited if 0,
+	// and requests of it allowed at once, the rate if 0.
+	IpRateLimit uint32 `protobuf:"varint,7,opt,name=ip_rate_limit,json=ipRateLimit,proto3" json:"ip_rate_limit,omitempty"`
+	IpRateBurst uint32 `protobuf:"varint,8,opt,name=ip_rate_burst,json=ipRateBurst,proto3" json:"ip_rate_burst,omitempty"`
+	// API keys given in the X-Api-Key header, "key" with the ip limits, or "key:rate:burst" with their own.
+	ApiKeys []string `protobuf:"bytes,9,rep,name=api_keys,json=apiKeys" json:"api_keys,omitempty"`
+	// gRPC and HTTP listen addresses of a separate admin service, on loopback interfaces only.
+	// The admin service is served with the api one if admin_listen is empty.
+	AdminListen     string   `protobuf:"bytes,10,opt,name=admin_listen,json=adminListen,proto3" json:"admin_listen,omitempty"`
+	AdminHttpListen []string `protobuf:"bytes,11,rep,name=admin_http_listen,json=adminHttpListen" json:"admin_http_listen,omitempty"`
+	// Seconds a polling filter is kept without being polled, 300 if 0,
+	// and most filters installed by a client ip, 16 if 0.
+	FilterTimeout uint32 `protobuf:"varint,12,opt,name=filter_timeout,json=filterTimeout,proto3" json:"filter_timeout,omitempty"`
+	MaxFilters    uint32 `protobuf:"varint,13,opt,name=max_filters,json=maxFilters,proto3" json:"max_filters,omitempty"`
+	// PEM files of the certificate and key to serve the HTTP gateway over https, reloaded when they change.
+	TlsCert string `protobuf:"bytes,14,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	TlsKey  string `protobuf:"bytes,15,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	// Origins allowed to call the HTTP gateway from a browser, any if empty, e.g. "https://*.example.com",
+	// and the methods and headers allowed, the default ones if empty.
+	CorsAllowedOrigins []string `protobuf:"bytes,16,rep,name=cors_allowed_origins,json=corsAllowedOrigins" json:"cors_allowed_origins,omitempty"`
+	CorsAllowedMethods []string `protobuf:"bytes,17,rep,name=cors_allowed_methods,json=corsAllowedMethods" json:"cors_allowed_methods,omitempty"`
+	CorsAllowedHeaders []string `protobuf:"bytes,18,rep,name=cors_allowed_headers,json=corsAllowedHeaders" json:"cors_allowed_headers,omitempty"`
+	// Unix socket serving the api and admin modules of the HTTP gateway to the local tools, none if empty,
+	// and its octal file permission, "0600" if empty.
+	IpcPath string `protobuf:"bytes,19,opt,name=ipc_path,json=ipcPath,proto3" json:"ipc_path,omitempty"`
+	IpcMode string `protobuf:"bytes,20,opt,name=ipc_mode,json=ipcMode,proto3" json:"ipc_mode,omitempty"`
+	// HTTP date the v1 api is removed, e.g. "Sat, 01 Aug 2026 00:00:00 GMT", none if empty.
+	// If given, the v1 responses tell it's deprecated with the date.
+	V1Sunset string `protobuf:"bytes,21,opt,name=v1_sunset,json=v1Sunset,proto3" json:"v1_sunset,omitempty"`
+	// IPs or CIDRs of the proxies in front of the HTTP gateway, whose X-Forwarded-For is trusted to tell
+	// the client ip the filters are kept for. The gateway of the node is always trusted.
+	TrustedProxies []string `protobuf:"bytes,22,rep,name=trusted_proxies,json=trustedProxies" json:"trusted_proxies,omitempty"`
 }
 
 func (m *RPCConfig) Reset()                    { *m = RPCConfig{} }
@@ -295,6 +719,139 @@ func (m *RPCConfig) GetHttpModule() []string {
 	return nil
 }
 
+func (m *RPCConfig) GetEnginePoolSize() uint32 {
+	if m != nil {
+		return m.EnginePoolSize
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetBatchConcurrency() uint32 {
+	if m != nil {
+		return m.BatchConcurrency
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetIpRateLimit() uint32 {
+	if m != nil {
+		return m.IpRateLimit
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetIpRateBurst() uint32 {
+	if m != nil {
+		return m.IpRateBurst
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetApiKeys() []string {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetAdminListen() string {
+	if m != nil {
+		return m.AdminListen
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetAdminHttpListen() []string {
+	if m != nil {
+		return m.AdminHttpListen
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetFilterTimeout() uint32 {
+	if m != nil {
+		return m.FilterTimeout
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetMaxFilters() uint32 {
+	if m != nil {
+		return m.MaxFilters
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetTlsCert() string {
+	if m != nil {
+		return m.TlsCert
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetTlsKey() string {
+	if m != nil {
+		return m.TlsKey
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetCorsAllowedOrigins() []string {
+	if m != nil {
+		return m.CorsAllowedOrigins
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetCorsAllowedMethods() []string {
+	if m != nil {
+		return m.CorsAllowedMethods
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetCorsAllowedHeaders() []string {
+	if m != nil {
+		return m.CorsAllowedHeaders
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetIpcPath() string {
+	if m != nil {
+		return m.IpcPath
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetIpcMode() string {
+	if m != nil {
+		return m.IpcMode
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetV1Sunset() string {
+	if m != nil {
+		return m.V1Sunset
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetTrustedProxies() []string {
+	if m != nil {
+		return m.TrustedProxies
+	}
+	return nil
+}
+
 type AppConfig struct {
 	LogLevel          string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
 	LogFile           string `protobuf:"bytes,2,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
@@ -470,52 +1027,118 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4d, 0x6f, 0xe3, 0x36,
-	0x10, 0xad, 0x9d, 0x2f, 0x6b, 0xec, 0x78, 0xb3, 0xdc, 0x2f, 0xee, 0x2e, 0xda, 0x4d, 0x05, 0x04,
-	0x30, 0xb0, 0x80, 0x81, 0xa6, 0xbd, 0xf6, 0x50, 0x18, 0x28, 0x10, 0x24, 0x29, 0x02, 0xb5, 0x3d,
-	0x0b, 0xb4, 0x34, 0x96, 0x89, 0xd0, 0x12, 0x41, 0xd2, 0xd9, 0x04, 0xbd, 0xf4, 0x0f, 0xf4, 0xa7,
-	0xf4, 0xdc, 0xbf, 0x57, 0xcc, 0x88, 0x92, 0x93, 0x60, 0x6f, 0x9c, 0xf7, 0x9e, 0x86, 0x9c, 0x79,
-	0x33, 0x82, 0x49, 0xd1, 0xd4, 0x2b, 0x5d, 0xcd, 0xad, 0x6b, 0x42, 0x23, 0x46, 0x35, 0x2e, 0x0d,
-	0x06, 0xbb, 0x4c, 0xff, 0x19, 0xc2, 0xe1, 0x82, 0x29, 0xf1, 0x03, 0x1c, 0xd5, 0x18, 0xbe, 0x34,
-	0xee, 0x56, 0x0e, 0x4e, 0x07, 0xb3, 0xf1, 0xf9, 0xbb, 0x79, 0x27, 0x9b, 0xff, 0xd6, 0x12, 0xad,
-	0x32, 0xeb, 0x74, 0xe2, 0x33, 0x1c, 0x14, 0x6b, 0xa5, 0x6b, 0x39, 0xe4, 0x0f, 0xde, 0xec, 0x3e,
-	0x58, 0x10, 0x1c, 0xe5, 0xad, 0x46, 0x9c, 0xc1, 0x9e, 0xb3, 0x85, 0xdc, 0x63, 0xe9, 0xab, 0x9d,
-	0x34, 0xbb, 0x59, 0x44, 0x21, 0xf1, 0x94, 0xd3, 0x07, 0x15, 0xbc, 0x2c, 0x9f, 0xe7, 0xfc, 0x9d,
-	0xe0, 0x2e, 0x27, 0x6b, 0xc4, 0x0c, 0xf6, 0x37, 0xda, 0x17, 0x12, 0x59, 0xfb, 0x7a, 0xa7, 0xbd,
-	0xd6, 0xbe, 0x88, 0x52, 0x56, 0xd0, 0xed, 0xca, 0x5a, 0xb9, 0x7a, 0x7e, 0xfb, 0x2f, 0xd6, 0x76,
-	0xb7, 0x2b, 0x6b, 0xd3, 0xbf, 0xe0, 0xf8, 0x49, 0xad, 0x42, 0xc0, 0xbe, 0x47, 0x2c, 0xe5, 0xe0,
-	0x74, 0x6f, 0x96, 0x64, 0x7c, 0x16, 0x6f, 0xe1, 0xd0, 0x68, 0x1f, 0x90, 0xea, 0x26, 0x34, 0x46,
-	0xe2, 0x13, 0x8c, 0xad, 0xd3, 0x77, 0x2a, 0x60, 0x7e, 0x8b, 0x0f, 0x5c, 0x69, 0x92, 0x41, 0x84,
-	0x2e, 0xf1, 0x41, 0x7c, 0x0b, 0x10, 0x5b, 0x97, 0xeb, 0x52, 0xee, 0x9f, 0x0e, 0x66, 0xc7, 0x59,
-	0x12, 0x91, 0x8b, 0x32, 0xfd, 0x77, 0x08, 0xe3, 0x47, 0x8d, 0x13, 0xef, 0x61, 0xc4, 0xad, 0x23,
-	0xf1, 0x80, 0xc5, 0x47, 0x1c, 0x5f, 0x94, 0x42, 0xc2, 0x51, 0x85, 0x35, 0x7a, 0xed, 0xb9, 0xf7,
-	0x49, 0xd6, 0x85, 0xc4, 0x94, 0x2a, 0xa8, 0x52, 0x3b, 0x39, 0x6e, 0x99, 0x18, 0xd2, 0xb3, 0x6f,
-	0xf1, 0x81, 0x88, 0x09, 0x13, 0x31, 0x12, 0x1f, 0x60, 0x54, 0x34, 0xba, 0x5e, 0x2a, 0x8f, 0xf2,
-	0x0d, 0x33, 0x7d, 0x2c, 0x5e, 0xc3, 0xc1, 0x46, 0xd7, 0xe8, 0xe4, 0x5b, 0x26, 0xda, 0x40, 0x7c,
-	0x07, 0x60, 0x95, 0xf7, 0x76, 0xed, 0xe8, 0x9b, 0x77, 0xb1, 0xce, 0x1e, 0x11, 0x1f, 0x21, 0xa9,
-	0x94, 0xcf, 0xad, 0xd3, 0x05, 0x4a, 0xd9, 0xa6, 0xac, 0x94, 0xbf, 0xa1, 0xb8, 0x23, 0x8d, 0xde,
-	0xe8, 0x20, 0xdf, 0xf7, 0xe4, 0x15, 0xc5, 0xe2, 0x33, 0xbc, 0xf4, 0xba, 0xaa, 0x55, 0xd8, 0x3a,
-	0xcc, 0x0b, 0x6d, 0xd7, 0xe8, 0xbc, 0xfc, 0xc0, 0x5d, 0x3e, 0xe9, 0x89, 0x45, 0x8b, 0xa7, 0x06,
-	0x92, 0x7e, 0x78, 0xa8, 0xb7, 0xce, 0x16, 0x79, 0x34, 0xa6, 0xb5, 0x2b, 0x71, 0xb6, 0xb8, 0xea,
-	0xbd, 0x59, 0x87, 0x60, 0xf3, 0x27, 0xc6, 0x01, 0x41, 0xcf, 0x04, 0x9b, 0xa6, 0xdc, 0x1a, 0x94,
-	0x7b, 0x3b, 0xc1, 0x35, 0x23, 0xe9, 0x7f, 0x03, 0x48, 0xfa, 0x69, 0xa1, 0x2a, 0x4c, 0x53, 0xe5,
-	0x06, 0xef, 0xd0, 0xb0, 0x39, 0x49, 0x36, 0x32, 0x4d, 0x75, 0x45, 0x31, 0x19, 0x47, 0xe4, 0x4a,
-	0x1b, 0xec, 0xec, 0x31, 0x4d, 0xf5, 0xab, 0x36, 0x28, 0xe6, 0xf0, 0x0a, 0x6b, 0xb5, 0x34, 0x98,
-	0x17, 0x4e, 0xf9, 0x75, 0xee, 0xd0, 0x36, 0x2e, 0xf0, 0xac, 0x8c, 0xb2, 0x97, 0x2d, 0xb5, 0x20,
-	0x26, 0x63, 0x42, 0xcc, 0xe0, 0xe4, 0xb1, 0x30, 0xdf, 0x3a, 0xc3, 0x83, 0x93, 0x64, 0xd3, 0x62,
-	0x27, 0xfb, 0xd3, 0x19, 0x32, 0xfe, 0x0e, 0x9d, 0xd7, 0x4d, 0xcd, 0xab, 0x93, 0x64, 0x5d, 0x98,
-	0x5e, 0x02, 0xec, 0xf6, 0x41, 0xfc, 0x0c, 0x1f, 0x4b, 0x5c, 0xa9, 0xad, 0x09, 0x34, 0xa5, 0x3e,
-	0x34, 0x0e, 0xf9, 0xa5, 0xd4, 0x6e, 0x74, 0xb1, 0x16, 0x19, 0x25, 0x97, 0x51, 0x41, 0x6f, 0x5f,
-	0x10, 0x9f, 0xfe, 0x3d, 0x84, 0xf1, 0xa3, 0x4d, 0x14, 0x67, 0x30, 0x8d, 0x05, 0x6d, 0x30, 0x38,
-	0x5d, 0x78, 0xce, 0x30, 0xca, 0x8e, 0x5b, 0xf4, 0xba, 0x05, 0xc5, 0x0d, 0x9c, 0xb4, 0x15, 0xe8,
-	0xba, 0xea, 0x7a, 0x4c, 0x26, 0x4c, 0xcf, 0xcf, 0xbe, 0xba, 0xe1, 0xf3, 0xac, 0x53, 0xb7, 0xed,
-	0xcf, 0x5e, 0xb8, 0xa7, 0x80, 0xf8, 0x09, 0x46, 0xba, 0x5e, 0x99, 0xed, 0x7d, 0xb9, 0xe4, 0x49,
-	0x1f, 0x9f, 0xcb, 0x5d, 0xa6, 0x8b, 0xc8, 0xc4, 0xdd, 0xee, 0x95, 0xe2, 0x7b, 0x98, 0xc4, 0x77,
-	0xe6, 0x41, 0x55, 0x5e, 0x4e, 0xd8, 0xe7, 0x71, 0xc4, 0xfe, 0x50, 0x95, 0x4f, 0x3f, 0xc1, 0x8b,
-	0x67, 0x97, 0x8b, 0x09, 0x8c, 0xba, 0x8c, 0x27, 0xdf, 0xa4, 0xf7, 0x30, 0x7d, 0x9a, 0x9f, 0xfe,
-	0x12, 0xeb, 0xc6, 0x87, 0xd8, 0x3c, 0x3e, 0x13, 0xc6, 0xd6, 0x0e, 0x79, 0x73, 0xf9, 0x2c, 0xa6,
-	0x30, 0x2c, 0x97, 0xf1, 0xc7, 0x30, 0x2c, 0x97, 0xa4, 0xd9, 0x7a, 0x74, 0xd1, 0x51, 0x3e, 0xd3,
-	0x3a, 0xd2, 0x2a, 0x7d, 0x69, 0x5c, 0x29, 0x0f, 0xda, 0xc1, 0xea, 0xe2, 0xe5, 0x21, 0xff, 0xbf,
-	0x7f, 0xfc, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x88, 0xda, 0x91, 0x6b, 0xcf, 0x05, 0x00, 0x00,
+	// 1802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x57, 0x51, 0x73, 0x1b, 0xb7,
+	0x11, 0xae, 0x64, 0x47, 0x22, 0x41, 0x91, 0x92, 0x60, 0xd9, 0x86, 0xed, 0xc6, 0x56, 0x98, 0x3a,
+	0x56, 0xed, 0x8e, 0x53, 0x3b, 0x79, 0xed, 0x83, 0x4d, 0x4f, 0xc6, 0x1a, 0x5b, 0x89, 0x7a, 0x52,
+	0x9e, 0x31, 0xe0, 0xdd, 0x8a, 0xc4, 0xe8, 0x08, 0x20, 0x00, 0x8e, 0x26, 0xf3, 0xd4, 0x3f, 0xd0,
+	0x1f, 0xd2, 0xe9, 0x0f, 0xe8, 0x6b, 0x7f, 0x5a, 0x67, 0x17, 0x38, 0x92, 0xb2, 0xfb, 0x46, 0x7c,
+	0xdf, 0x87, 0x3d, 0xec, 0x62, 0xb1, 0xbb, 0x64, 0x7b, 0xa5, 0x35, 0x57, 0x7a, 0xf2, 0xd2, 0x79,
+	0x1b, 0x2d, 0xef, 0x18, 0x18, 0xd7, 0x10, 0xdd, 0x78, 0xf8, 0xcf, 0x6d, 0xb6, 0x33, 0x22, 0x8a,
+	0xbf, 0x62, 0xbb, 0x06, 0xe2, 0x27, 0xeb, 0xaf, 0xc5, 0xd6, 0xf1, 0xd6, 0x49, 0xef, 0xf5, 0xfd,
+	0x97, 0xad, 0xec, 0xe5, 0xcf, 0x89, 0x48, 0xca, 0xa2, 0xd5, 0xf1, 0x17, 0xec, 0xab, 0x72, 0xaa,
+	0xb4, 0x11, 0xdb, 0xb4, 0xe1, 0xee, 0x7a, 0xc3, 0x08, 0xe1, 0x2c, 0x4f, 0x1a, 0xfe, 0x94, 0xdd,
+	0xf2, 0xae, 0x14, 0xb7, 0x48, 0x7a, 0x67, 0x2d, 0x2d, 0xce, 0x47, 0x59, 0x88, 0x3c, 0xda, 0x0c,
+	0x51, 0xc5, 0x20, 0xaa, 0xcf, 0x6d, 0x5e, 0x20, 0xdc, 0xda, 0x24, 0x0d, 0x3f, 0x61, 0xb7, 0x67,
+	0x3a, 0x94, 0x02, 0x48, 0x7b, 0xb4, 0xd6, 0x9e, 0xe9, 0x50, 0x66, 0x29, 0x29, 0xf0, 0xeb, 0xca,
+	0x39, 0x71, 0xf5, 0xf9, 0xd7, 0xdf, 0x38, 0xd7, 0x7e, 0x5d, 0x39, 0x37, 0xfc, 0x77, 0x97, 0xf5,
+	0x6f, 0x38, 0xcb, 0x39, 0xbb, 0x1d, 0x00, 0x2a, 0xb1, 0x75, 0x7c, 0xeb, 0xa4, 0x5b, 0xd0, 0x6f,
+	0x7e, 0x8f, 0xed, 0xd4, 0x3a, 0x44, 0x40, 0xc7, 0x11, 0xcd, 0x2b, 0xfe, 0x84, 0xf5, 0x9c, 0xd7,
+	0x73, 0x15, 0x41, 0x5e, 0xc3, 0x92, 0x5c, 0xed, 0x16, 0x2c, 0x43, 0x1f, 0x60, 0xc9, 0xbf, 0x66,
+	0x2c, 0xc7, 0x4e, 0xea, 0x4a, 0xdc, 0x3e, 0xde, 0x3a, 0xe9, 0x17, 0xdd, 0x8c, 0x9c, 0x56, 0xfc,
+	0x11, 0xeb, 0x8e, 0x95, 0x91, 0xa1, 0xb4, 0x1e, 0xc4, 0x57, 0xc4, 0x76, 0xc6, 0xca, 0x5c, 0xe0,
+	0x9a, 0x7f, 0xc3, 0xf6, 0x90, 0xac, 0x1a, 0xaf, 0xa2, 0xb6, 0x46, 0xec, 0x10, 0xdf, 0x1b, 0x2b,
+	0xf3, 0x2e, 0x43, 0xf8, 0xfd, 0x4a, 0x07, 0x35, 0xae, 0x41, 0x1a, 0x15, 0xc5, 0xee, 0xf1, 0xd6,
+	0x49, 0xa7, 0x60, 0x19, 0xfa, 0x59, 0x45, 0xfe, 0x80, 0x75, 0x2a, 0x13, 0x24, 0x39, 0xd4, 0xa1,
+	0xa3, 0xef, 0x56, 0x26, 0x5c, 0xa0, 0x4f, 0xdf, 0xb1, 0xfd, 0x96, 0x92, 0x41, 0x4f, 0x0c, 0x78,
+	0xd1, 0xa5, 0xf3, 0xf7, 0xb3, 0xe2, 0x82, 0x40, 0xfc, 0x06, 0xc6, 0x5e, 0x97, 0xd2, 0x01, 0x78,
+	0xc1, 0xc8, 0x0a, 0x4b, 0xd0, 0x39, 0x80, 0xc7, 0x73, 0x46, 0xdf, 0x84, 0x08, 0x55, 0x52, 0xf4,
+	0x48, 0xd1, 0xcb, 0x18, 0x49, 0x7e, 0x60, 0x77, 0x4b, 0x3b, 0x73, 0x1e, 0x42, 0xd0, 0xd6, 0xc8,
+	0x38, 0xf5, 0x10, 0xa6, 0xb6, 0xae, 0xc4, 0x1e, 0xf9, 0x74, 0xb4, 0x41, 0x5e, 0xb6, 0x1c, 0xff,
+	0x9e, 0xdd, 0x69, 0x9d, 0xdb, 0xe0, 0x45, 0x9f, 0x9c, 0xe4, 0x99, 0x1a, 0xad, 0x19, 0xf4, 0x68,
+	0xa6, 0x16, 0xb2, 0x71, 0xb5, 0x55, 0x95, 0xf4, 0x2a, 0x82, 0x18, 0x90, 0xfd, 0xfe, 0x4c, 0x2d,
+	0x7e, 0x25, 0xb4, 0x50, 0x11, 0xf8, 0x73, 0x76, 0x88, 0xba, 0xca, 0x7e, 0x32, 0x6b, 0xe5, 0x3e,
+	0x29, 0xd1, 0xc0, 0xbb, 0x8c, 0x93, 0xf6, 0x84, 0x1d, 0xa0, 0x53, 0x37, 0x8c, 0x1e, 0x90, 0x74,
+	0x80, 0xf8, 0x86, 0xd5, 0xbf, 0x30, 0x4e, 0xca, 0x9b, 0x66, 0x0f, 0x49, 0x4b, 0x36, 0x6e, 0xd8,
+	0xfd, 0x96, 0xf5, 0xdb, 0xc4, 0x88, 0xf6, 0x1a, 0x8c, 0xe0, 0x14, 0xfb, 0xbd, 0x0c, 0x5e, 0x22,
+	0xc6, 0x8f, 0xd8, 0x57, 0xce, 0xdb, 0xc5, 0x52, 0xdc, 0x21, 0x32, 0x2d, 0xda, 0xe3, 0x6b, 0x33,
+	0xb6, 0x8d, 0x49, 0x31, 0x0f, 0xe2, 0x68, 0x75, 0xfc, 0xd3, 0x84, 0x63, 0xdc, 0x03, 0x1e, 0x0a,
+	0xb5, 0xb6, 0x89, 0x9b, 0xe2, 0xbb, 0xe9, 0x50, 0x33, 0xb5, 0xf8, 0xa5, 0x89, 0x1b, 0xea, 0x07,
+	0xac, 0xa3, 0x9d, 0x54, 0x75, 0x6d, 0x3f, 0x89, 0x7b, 0x29, 0x5b, 0xb4, 0x7b, 0x83, 0x4b, 0x7e,
+	0x9f, 0xed, 0x6a, 0x27, 0x2b, 0x30, 0x4b, 0x71, 0x3f, 0x3d, 0x01, 0xed, 0xde, 0x81, 0x59, 0x62,
+	0x80, 0x3c, 0xd4, 0x6a, 0x29, 0x4b, 0x55, 0x4e, 0x41, 0x06, 0xfd, 0x3b, 0x08, 0x91, 0x02, 0x44,
+	0xf8, 0x08, 0xe1, 0x0b, 0xfd, 0x3b, 0xe0, 0xf5, 0x6c, 0x2a, 0x63, 0xac, 0xc5, 0x83, 0x74, 0x3d,
+	0x6b, 0xe1, 0x65, 0xac, 0xd1, 0xbf, 0x5a, 0x4f, 0xa6, 0x51, 0x06, 0xf0, 0x73, 0x90, 0xbf, 0x35,
+	0x36, 0x2a, 0xf1, 0x30, 0xf9, 0x47, 0xc4, 0x05, 0xe2, 0x7f, 0x47, 0x98, 0x7f, 0xcf, 0x8e, 0xd0,
+	0x3f, 0x72, 0x4b, 0x3a, 0xf0, 0x32, 0x34, 0x63, 0x03, 0x51, 0x3c, 0x22, 0x39, 0xc6, 0x89, 0x3c,
+	0x3b, 0x07, 0x7f, 0x41, 0x04, 0x7f, 0xc6, 0x0e, 0x6e, 0x6e, 0x50, 0x41, 0xfc, 0x71, 0x95, 0x24,
+	0xad, 0xf8, 0x4d, 0xe0, 0x77, 0xd9, 0x8e, 0x0a, 0x72, 0xa6, 0x9c, 0xf8, 0x3a, 0x05, 0x5f, 0x85,
+	0x33, 0xe5, 0xf8, 0x8f, 0xec, 0x1e, 0xdd, 0xb2, 0xb7, 0x91, 0x9e, 0xa0, 0xd4, 0x26, 0x82, 0x9f,
+	0xab, 0x5a, 0x3c, 0x4e, 0xa9, 0x8c, 0x6c, 0x91, 0xc9, 0xd3, 0xcc, 0xf1, 0xd7, 0xec, 0xee, 0xcd,
+	0x5d, 0x0e, 0x7c, 0x09, 0x26, 0x8a, 0x27, 0xb4, 0xe9, 0xce, 0xe6, 0xa6, 0xf3, 0x44, 0x61, 0x1d,
+	0xfa, 0xad, 0xd1, 0xa5, 0x38, 0xa6, 0x7c, 0xa7, 0xdf, 0xc3, 0xff, 0xee, 0xb0, 0xde, 0x46, 0xa5,
+	0xc5, 0x0b, 0xa3, 0x5a, 0x8b, 0xc5, 0x65, 0x8b, 0x4c, 0xed, 0xd2, 0xfa, 0xb4, 0xe2, 0x82, 0xed,
+	0x4e, 0xc0, 0x40, 0xd0, 0x81, 0x8a, 0x75, 0xb7, 0x68, 0x97, 0xc8, 0x54, 0x2a, 0xaa, 0x4a, 0xe3,
+	0x53, 0x25, 0x26, 0x2f, 0xb1, 0xcc, 0x5d, 0xc3, 0x12, 0x89, 0x3d, 0x22, 0xf2, 0x8a, 0x3f, 0x64,
+	0x9d, 0xd2, 0x6a, 0x33, 0x56, 0x01, 0x28, 0x77, 0xba, 0xc5, 0x6a, 0x8d, 0x39, 0x3a, 0xd3, 0x58,
+	0x3c, 0xee, 0xa5, 0x30, 0xd1, 0x82, 0x3f, 0x66, 0xcc, 0xa9, 0x10, 0xdc, 0xd4, 0xe3, 0x9e, 0xfb,
+	0xb9, 0x2e, 0xae, 0x10, 0x2c, 0x7c, 0x13, 0x15, 0xa4, 0xf3, 0xba, 0x4c, 0xe9, 0xd2, 0x2d, 0x3a,
+	0x13, 0x15, 0xce, 0x71, 0xdd, 0x92, 0xb5, 0x9e, 0xe9, 0x28, 0x1e, 0xac, 0xc8, 0x8f, 0xb8, 0xe6,
+	0x2f, 0xd8, 0x21, 0x56, 0x2b, 0x15, 0x1b, 0x0f, 0xb2, 0xd4, 0x6e, 0x8a, 0x09, 0xfd, 0x90, 0x52,
+	0xf2, 0x60, 0x45, 0x8c, 0x12, 0xce, 0x0f, 0xd8, 0xad, 0x0a, 0xe6, 0x94, 0x0d, 0x9d, 0x02, 0x7f,
+	0xe2, 0x83, 0xa8, 0x60, 0x2e, 0xc7, 0xb5, 0x2d, 0xaf, 0xd7, 0x77, 0x97, 0x32, 0xe0, 0xa0, 0x82,
+	0xf9, 0x5b, 0x24, 0x56, 0xf7, 0x46, 0x25, 0xb8, 0xbc, 0x6e, 0x9c, 0x4c, 0x3e, 0xa6, 0x54, 0xe8,
+	0x25, 0xec, 0x8c, 0x3c, 0x7d, 0xc6, 0xf6, 0xb3, 0x64, 0x15, 0xa2, 0xc7, 0xa4, 0x1a, 0x24, 0x78,
+	0xd4, 0x06, 0xea, 0x05, 0x3b, 0xcc, 0xc2, 0x8d, 0xc8, 0x3c, 0x21, 0xe9, 0x41, 0x22, 0xce, 0xd7,
+	0xf1, 0x79, 0xc2, 0x7a, 0x26, 0xba, 0xf4, 0x02, 0x7c, 0x10, 0xc7, 0xa9, 0xe8, 0x9a, 0xe8, 0x2e,
+	0x12, 0x82, 0x57, 0x62, 0xc7, 0x89, 0x16, 0xdf, 0x90, 0x7b, 0xab, 0x35, 0x55, 0xf6, 0x5c, 0x38,
+	0xe3, 0x42, 0x3a, 0x6b, 0x6b, 0x31, 0x24, 0x49, 0x3f, 0xc3, 0x97, 0x8b, 0x73, 0x6b, 0x6b, 0xfe,
+	0x92, 0xdd, 0x71, 0xaa, 0xbc, 0xd6, 0x66, 0x22, 0x4b, 0xd7, 0xac, 0x72, 0xf2, 0xdb, 0xf4, 0x76,
+	0x32, 0x35, 0x72, 0x4d, 0x9b, 0x91, 0xcf, 0xd8, 0x3e, 0xcc, 0xc1, 0x44, 0xe9, 0x21, 0x82, 0xa1,
+	0x9e, 0xf4, 0xf4, 0x78, 0xeb, 0xe4, 0x76, 0x31, 0x20, 0xb8, 0x68, 0x51, 0xbc, 0x40, 0xd5, 0x54,
+	0x3a, 0xca, 0xda, 0x4e, 0xc4, 0x77, 0xe9, 0x74, 0x04, 0x7c, 0xb4, 0x13, 0x2c, 0x18, 0x89, 0x9c,
+	0xda, 0x10, 0x65, 0xa9, 0xea, 0x3a, 0x88, 0x67, 0xc9, 0x0c, 0xe1, 0xef, 0x6d, 0x88, 0x23, 0x44,
+	0xd1, 0x4c, 0x58, 0x9a, 0x52, 0xce, 0x6c, 0x05, 0xe2, 0x24, 0xe5, 0x01, 0x02, 0x67, 0xb6, 0x02,
+	0x7e, 0xcc, 0x7a, 0xe5, 0x14, 0xca, 0x6b, 0x67, 0xb5, 0x89, 0x41, 0xfc, 0x39, 0x35, 0x9d, 0x0d,
+	0x08, 0x33, 0x93, 0x0a, 0x8b, 0x78, 0x4e, 0x27, 0x48, 0x8b, 0xe1, 0xbf, 0x76, 0x58, 0x77, 0x35,
+	0x81, 0x60, 0x7f, 0xf6, 0xae, 0x94, 0xb9, 0xb9, 0xa7, 0x96, 0xdf, 0xf5, 0xae, 0xfc, 0xb8, 0xea,
+	0xef, 0xd3, 0x18, 0x9d, 0xbc, 0xd1, 0xfc, 0x19, 0x42, 0x9f, 0x09, 0x66, 0xb6, 0x6a, 0x6a, 0x10,
+	0xb7, 0xd6, 0x82, 0x33, 0x42, 0xd0, 0x5b, 0x30, 0x13, 0x6d, 0x80, 0xee, 0x21, 0x95, 0xc7, 0x34,
+	0x06, 0x0c, 0x12, 0x8e, 0x37, 0x41, 0xe5, 0xf1, 0x4f, 0x6c, 0x80, 0x95, 0x69, 0xac, 0x62, 0x39,
+	0x4d, 0xba, 0x34, 0x10, 0xec, 0xcd, 0xd4, 0xe2, 0x2d, 0x82, 0xa4, 0xa2, 0x2c, 0x42, 0x45, 0x69,
+	0x4d, 0xd9, 0x78, 0x0f, 0xa6, 0x5c, 0xe6, 0xc9, 0xe0, 0x80, 0x88, 0xd1, 0x1a, 0xe7, 0x43, 0xd6,
+	0xd7, 0x8e, 0xfa, 0x50, 0x7e, 0x4c, 0xbb, 0x69, 0x84, 0xd0, 0x0e, 0x7b, 0x50, 0x7a, 0x4f, 0x1b,
+	0x9a, 0x71, 0xe3, 0x43, 0x14, 0x9d, 0x4d, 0xcd, 0x5b, 0x84, 0xb0, 0xcc, 0x28, 0xa7, 0x71, 0xc4,
+	0x09, 0xa2, 0x9b, 0xfa, 0x82, 0x72, 0xfa, 0x03, 0x2c, 0x03, 0xbe, 0x10, 0x55, 0xcd, 0xb4, 0x69,
+	0x43, 0xc4, 0xd2, 0x0b, 0x21, 0x2c, 0xc7, 0xe8, 0x39, 0x3b, 0x4c, 0x92, 0xcd, 0x50, 0xa6, 0x21,
+	0x61, 0x9f, 0x88, 0xf7, 0xeb, 0x78, 0x3e, 0x65, 0x83, 0x2b, 0x5d, 0x47, 0xf0, 0x32, 0xea, 0x19,
+	0xd8, 0x26, 0xe6, 0x09, 0xa1, 0x9f, 0xd0, 0xcb, 0x04, 0x62, 0xd8, 0x31, 0x56, 0x09, 0x0c, 0x34,
+	0x12, 0xf4, 0x0b, 0x36, 0x53, 0x8b, 0x9f, 0x12, 0x82, 0x27, 0x8e, 0x75, 0x90, 0x25, 0xf8, 0x48,
+	0x33, 0x40, 0xb7, 0xd8, 0x8d, 0x75, 0x18, 0x81, 0x8f, 0xd8, 0xc9, 0x90, 0xc2, 0x79, 0x6d, 0x3f,
+	0x55, 0xb9, 0x58, 0x07, 0x9c, 0xd5, 0xfe, 0xca, 0x8e, 0x4a, 0xeb, 0x43, 0xea, 0x7f, 0x50, 0x49,
+	0xeb, 0xf5, 0x44, 0x9b, 0x20, 0x0e, 0xe8, 0xa8, 0x1c, 0xb9, 0x37, 0x89, 0xfa, 0x25, 0x31, 0x5f,
+	0xec, 0x98, 0x41, 0x9c, 0xda, 0x2a, 0x88, 0xc3, 0x2f, 0x76, 0x9c, 0x25, 0xe6, 0x8b, 0x1d, 0x53,
+	0x50, 0x15, 0x7a, 0xc0, 0xbf, 0xd8, 0xf1, 0x3e, 0x31, 0xa9, 0x27, 0x97, 0xd2, 0xa9, 0x38, 0xcd,
+	0x63, 0xc0, 0xae, 0x76, 0xe5, 0xb9, 0x8a, 0xd3, 0x96, 0xa2, 0xe7, 0x71, 0xb4, 0xa2, 0xe8, 0x75,
+	0x3c, 0x62, 0xdd, 0xf9, 0x2b, 0x19, 0x1a, 0x13, 0x20, 0xb6, 0x25, 0x7b, 0xfe, 0xea, 0x82, 0xd6,
+	0xf8, 0x8e, 0x57, 0x03, 0x9b, 0xb7, 0x0b, 0x0d, 0x21, 0x77, 0xfb, 0x41, 0x3b, 0xb3, 0x25, 0x74,
+	0xf8, 0x9f, 0x2d, 0xd6, 0x5d, 0xcd, 0xcb, 0x68, 0xb3, 0xb6, 0x13, 0x59, 0xc3, 0x1c, 0x6a, 0xea,
+	0x36, 0xdd, 0xa2, 0x53, 0xdb, 0xc9, 0x47, 0x5c, 0xe3, 0x59, 0x90, 0xbc, 0xd2, 0x35, 0xb4, 0xfd,
+	0xa6, 0xb6, 0x93, 0x9f, 0x74, 0x0d, 0x58, 0x66, 0xc0, 0xa4, 0x31, 0xce, 0xab, 0x30, 0x95, 0x1e,
+	0x9c, 0xf5, 0x91, 0x86, 0xe5, 0x4e, 0x71, 0x98, 0xa8, 0x11, 0x32, 0x05, 0x11, 0xf8, 0x64, 0x36,
+	0x85, 0xb2, 0xf1, 0x35, 0x3d, 0x99, 0x6e, 0x31, 0x28, 0xd7, 0xb2, 0x5f, 0x7d, 0x8d, 0x9d, 0x0c,
+	0x8b, 0x21, 0x16, 0xa2, 0x2a, 0x7d, 0x33, 0x2f, 0x87, 0x1f, 0x18, 0x5b, 0xff, 0x23, 0xe0, 0x7f,
+	0x63, 0x8f, 0x2a, 0xb8, 0x52, 0x4d, 0x1d, 0x29, 0x87, 0xa3, 0xf5, 0x40, 0x27, 0xc5, 0xfe, 0x01,
+	0x3e, 0xfb, 0x22, 0xb2, 0xe4, 0x43, 0x56, 0xe0, 0xd9, 0x47, 0xc8, 0x0f, 0xff, 0xb1, 0xcd, 0x7a,
+	0x1b, 0xff, 0x45, 0x30, 0x49, 0xb3, 0x43, 0x33, 0x88, 0x5e, 0x97, 0x81, 0x2c, 0x74, 0x8a, 0x7e,
+	0x42, 0xcf, 0x12, 0xc8, 0xcf, 0x71, 0x32, 0xc2, 0xa3, 0x62, 0x81, 0xcd, 0x05, 0x02, 0x2b, 0xc8,
+	0xe0, 0xf5, 0xd3, 0xff, 0xfb, 0x1f, 0xe7, 0x65, 0xd1, 0xaa, 0x53, 0xed, 0x28, 0xf6, 0xfd, 0x4d,
+	0x80, 0xff, 0xc8, 0x3a, 0xda, 0x5c, 0xd5, 0xcd, 0xa2, 0x1a, 0x53, 0xeb, 0xee, 0xbd, 0x16, 0x6b,
+	0x4b, 0xa7, 0x99, 0x49, 0xc6, 0x8a, 0x95, 0x12, 0x9f, 0x68, 0x3e, 0xa7, 0x8c, 0x6a, 0x12, 0xc4,
+	0x5e, 0x2a, 0x95, 0x19, 0xbb, 0x54, 0x93, 0x30, 0x7c, 0xc2, 0xf6, 0x3f, 0xfb, 0x38, 0xdf, 0x63,
+	0x9d, 0xd6, 0xe2, 0xc1, 0x1f, 0x86, 0x0b, 0x36, 0xb8, 0x69, 0x1f, 0xc7, 0x13, 0x2c, 0xe0, 0x39,
+	0x78, 0xf4, 0x1b, 0x31, 0xba, 0xda, 0x6d, 0x7a, 0x8f, 0xf4, 0x9b, 0x0f, 0xd8, 0x76, 0x35, 0xce,
+	0xff, 0x8c, 0xb6, 0xab, 0x31, 0x6a, 0x9a, 0x00, 0x3e, 0xdf, 0x28, 0xfd, 0xc6, 0x66, 0x86, 0x3d,
+	0xf1, 0x93, 0xf5, 0x15, 0x15, 0xbd, 0x6e, 0xb1, 0x5a, 0x8f, 0x77, 0xe8, 0x1f, 0xec, 0x0f, 0xff,
+	0x1b, 0x00, 0x8c, 0x7c, 0xfd, 0xab, 0xd1, 0x0e, 0x00, 0x00,
 }
@@ -39,6 +39,12 @@ type BaseMessage struct {
 type HelloMessage struct {
 	NodeID        string
 	ClientVersion string
+	Addrs         []string
+	Capabilities  []string
+	NetworkProof  []byte
+	// the latest and the oldest protocol versions the node speaks.
+	ProtocolVersion    uint32
+	MinProtocolVersion uint32
 }
 
 // NewHelloMessage new hello message
@@ -49,8 +55,13 @@ func NewHelloMessage(nodeID string, clientVersion string) *HelloMessage {
 // ToProto converts domain HelloMessage to proto HelloMessage
 func (h *HelloMessage) ToProto() (proto.Message, error) {
 	return &netpb.Hello{
-		NodeId:        h.NodeID,
-		ClientVersion: h.ClientVersion,
+		NodeId:             h.NodeID,
+		ClientVersion:      h.ClientVersion,
+		Addrs:              h.Addrs,
+		Capabilities:       h.Capabilities,
+		NetworkProof:       h.NetworkProof,
+		ProtocolVersion:    h.ProtocolVersion,
+		MinProtocolVersion: h.MinProtocolVersion,
 	}, nil
 }
 
@@ -59,6 +70,11 @@ func (h *HelloMessage) FromProto(msg proto.Message) error {
 	if msg, ok := msg.(*netpb.Hello); ok {
 		h.NodeID = msg.NodeId
 		h.ClientVersion = msg.ClientVersion
+		h.Addrs = msg.Addrs
+		h.Capabilities = msg.Capabilities
+		h.NetworkProof = msg.NetworkProof
+		h.ProtocolVersion = msg.ProtocolVersion
+		h.MinProtocolVersion = msg.MinProtocolVersion
 		return nil
 	}
 	return errors.New("Pb Message cannot be converted into HelloMessage")
@@ -0,0 +1,71 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// errors
+var (
+	ErrInvalidListenAddr = errors.New("invalid listen address")
+)
+
+// listenMultiaddr convert a listen address, "host:port" or "[ipv6]:port", into its multiaddr.
+// A node listens on both the IPv4 and IPv6 stacks with an address of each, e.g. "0.0.0.0:8680" and "[::]:8680".
+func listenMultiaddr(listen string) (ma.Multiaddr, error) {
+	tcpAddr, err := net.ResolveTCPAddr("tcp", listen)
+	if err != nil {
+		return nil, err
+	}
+	ip := tcpAddr.IP
+	if ip == nil {
+		return nil, ErrInvalidListenAddr
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip4, tcpAddr.Port))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/tcp/%d", ip, tcpAddr.Port))
+}
+
+// quicListenMultiaddr return the quic multiaddr of the udp port of the listen address.
+func quicListenMultiaddr(listen string) (ma.Multiaddr, error) {
+	udpAddr, err := net.ResolveUDPAddr("udp", listen)
+	if err != nil {
+		return nil, err
+	}
+	if udpAddr.IP == nil {
+		return nil, ErrInvalidListenAddr
+	}
+	return quicMultiaddr(udpAddr)
+}
+
+// isSelfAddr return if the address is one the node listens on, of either stack.
+func (node *Node) isSelfAddr(addr ma.Multiaddr) bool {
+	for _, v := range node.host.Addrs() {
+		if v.Equal(addr) {
+			return true
+		}
+	}
+	return false
+}
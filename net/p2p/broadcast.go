@@ -65,31 +65,32 @@ func (ns *NetService) distribute(name string, msg net.Serializable, relay bool)
 		return
 	}
 
-	var relayness []peer.ID
 	dataChecksum := crc32.ChecksumIEEE(data)
-	peers, exists := node.relayness.Get(dataChecksum)
-	if exists {
-		relayness = peers.([]peer.ID)
-	}
+	relayness := node.seen.Peers(name, dataChecksum)
 	var allNode []peer.ID
 	transfer := node.routeTable.ListPeers()
 	if relay {
 		allNode = ns.nodeNotInRelayness(relayness, node.routeTable.ListPeers())
 		transfer = allNode
 	}
+	if topic, ok := topicOf(name); ok {
+		// gossip the message to the mesh of its topic instead of flooding it.
+		transfer = ns.gossipPeers(topic, transfer)
+		allNode = transfer
+	}
 	logging.VLog().WithFields(logrus.Fields{
 		"msg":      msg,
 		"transfer": transfer,
 	}).Info("distribute: start distribute msg.")
 
-	ns.doMsgTransfer(transfer, relayness, dataChecksum, name, data)
+	ns.doMsgTransfer(transfer, relayness, dataChecksum, name, data, newCompactMsg(msg))
 
 	if relay {
-		ns.doRelay(allNode, relayness, dataChecksum)
+		ns.doRelay(allNode, relayness, name, dataChecksum)
 	}
 }
 
-func (ns *NetService) doMsgTransfer(transfer []peer.ID, relayness []peer.ID, dataChecksum uint32, name string, data []byte) {
+func (ns *NetService) doMsgTransfer(transfer []peer.ID, relayness []peer.ID, dataChecksum uint32, name string, data []byte, compact *compactMsg) {
 	node := ns.node
 	for i := 0; i < len(transfer); i++ {
 		nodeID := transfer[i]
@@ -98,18 +99,22 @@ func (ns *NetService) doMsgTransfer(transfer []peer.ID, relayness []peer.ID, dat
 			continue
 		}
 		addrs := node.peerstore.PeerInfo(nodeID).Addrs
-		if len(addrs) == 0 || node.host.Addrs()[0].String() == addrs[0].String() {
+		if len(addrs) == 0 || node.isSelfAddr(addrs[0]) {
 			logging.VLog().Info("msgTransfer: skip self")
 			continue
 		}
 		if len(addrs) > 0 {
-			node.relayness.Add(dataChecksum, append(relayness, nodeID))
+			node.seen.Add(name, dataChecksum, nodeID)
+			if compact != nil && node.supports(nodeID.Pretty(), compact.capability) {
+				go ns.SendMsg(compact.name, compact.data, nodeID.Pretty())
+				continue
+			}
 			go ns.SendMsg(name, data, nodeID.Pretty())
 		}
 	}
 }
 
-func (ns *NetService) doRelay(nodes []peer.ID, relayness []peer.ID, dataChecksum uint32) {
+func (ns *NetService) doRelay(nodes []peer.ID, relayness []peer.ID, name string, dataChecksum uint32) {
 	node := ns.node
 	for i := 0; i < len(nodes); i++ {
 		nodeID := nodes[i]
@@ -118,12 +123,12 @@ func (ns *NetService) doRelay(nodes []peer.ID, relayness []peer.ID, dataChecksum
 			continue
 		}
 		addrs := node.peerstore.PeerInfo(nodeID).Addrs
-		if len(addrs) == 0 || node.host.Addrs()[0].String() == addrs[0].String() {
+		if len(addrs) == 0 || node.isSelfAddr(addrs[0]) {
 			logging.VLog().Info("distribute: relay skip self")
 			continue
 		}
 		if len(addrs) > 0 {
-			node.relayness.Add(dataChecksum, append(relayness, nodeID))
+			node.seen.Add(name, dataChecksum, nodeID)
 			go ns.SendMsg(NewHashMsg, byteutils.FromUint32(dataChecksum), nodeID.Pretty())
 		}
 	}
@@ -0,0 +1,74 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/net"
+)
+
+// Capabilities of the compact messages
+const (
+	// CapabilityCompact is advertised by the nodes accepting the compact blocks.
+	CapabilityCompact = "compact"
+
+	// CapabilityAnnounce is advertised by the nodes accepting the tx announcements.
+	CapabilityAnnounce = "announce"
+)
+
+// CompactSerializable is a message with a compact form, e.g. a block given by the short ids of its transactions,
+// sent instead to the peers advertising its capability.
+type CompactSerializable interface {
+	net.Serializable
+	CompactName() string
+	CompactCapability() string
+	ToCompactProto() (proto.Message, error)
+}
+
+type compactMsg struct {
+	name       string
+	capability string
+	data       []byte
+}
+
+// newCompactMsg return the compact form of the message, nil if it has none.
+func newCompactMsg(msg net.Serializable) *compactMsg {
+	cs, ok := msg.(CompactSerializable)
+	if !ok {
+		return nil
+	}
+	pbMsg, err := cs.ToCompactProto()
+	if err != nil {
+		return nil
+	}
+	data, err := proto.Marshal(pbMsg)
+	if err != nil {
+		return nil
+	}
+	return &compactMsg{name: cs.CompactName(), capability: cs.CompactCapability(), data: data}
+}
+
+// supports return if the peer advertises the capability.
+func (node *Node) supports(pid string, capability string) bool {
+	streamStore, ok := node.stream.Load(pid)
+	if !ok {
+		return false
+	}
+	return streamStore.(*StreamStore).stats.supports(capability)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+
+	"github.com/golang/snappy"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// const
+const (
+	// CapabilitySnappy is the capability of a node accepting the data compressed by snappy.
+	CapabilitySnappy = "snappy"
+
+	// CapabilityLight is the capability of a node serving the light clients.
+	CapabilityLight = "light"
+
+	// DefaultCompressionThreshold is the size in bytes the data is compressed from.
+	DefaultCompressionThreshold = 1024
+
+	// MaxDecompressedDataSize is the limit of the decompressed data.
+	MaxDecompressedDataSize = 64 * 1024 * 1024
+
+	// flagCompressed is set in the first reserved byte of the header if the data is compressed,
+	// the data checksum is the one of the decompressed data, so the relayness stays the same.
+	flagCompressed byte = 0x01
+)
+
+// errors
+var (
+	ErrInvalidCompressedData = errors.New("invalid compressed neb message data")
+)
+
+var (
+	compressionRawBytes        = metrics.GetOrRegisterCounter("neb.net.compression.raw", nil)
+	compressionCompressedBytes = metrics.GetOrRegisterCounter("neb.net.compression.compressed", nil)
+	// percent of the compressed bytes to the raw ones.
+	compressionRatioGauge = metrics.GetOrRegisterGauge("neb.net.compression.ratio", nil)
+)
+
+// Capabilities return the capabilities the node advertises in the handshake.
+func (node *Node) Capabilities() []string {
+	capabilities := []string{CapabilityPing, CapabilityGossip, CapabilityCompact, CapabilityAnnounce, CapabilityPex}
+	if node.config.CompressionThreshold > 0 {
+		capabilities = append(capabilities, CapabilitySnappy)
+	}
+	if node.config.LightServeQuota > 0 {
+		capabilities = append(capabilities, CapabilityLight)
+	}
+	return capabilities
+}
+
+func hasCapability(capabilities []string, capability string) bool {
+	for _, v := range capabilities {
+		if v == capability {
+			return true
+		}
+	}
+	return false
+}
+
+func isControlMessage(msgName string) bool {
+	switch msgName {
+	case HELLO, OK, BYE, SyncRoute, SyncRouteReply, NewHashMsg, NetworkID, NetworkIDReply, Ping, Pong, Subscribe, PexRequest, PexReply:
+		return true
+	}
+	return false
+}
+
+// compress return the data compressed by snappy, if the peer accepts it and it saves bytes.
+func (ns *NetService) compress(msgName string, data []byte, streamStore *StreamStore) ([]byte, bool) {
+	threshold := ns.node.config.CompressionThreshold
+	if !streamStore.snappy || threshold == 0 || len(data) < threshold || isControlMessage(msgName) {
+		return data, false
+	}
+
+	compressed := snappy.Encode(nil, data)
+	if len(compressed) >= len(data) {
+		return data, false
+	}
+
+	compressionRawBytes.Inc(int64(len(data)))
+	compressionCompressedBytes.Inc(int64(len(compressed)))
+	compressionRatioGauge.Update(compressionCompressedBytes.Count() * 100 / compressionRawBytes.Count())
+	return compressed, true
+}
+
+func decompress(data []byte) ([]byte, error) {
+	size, err := snappy.DecodedLen(data)
+	if err != nil || size > MaxDecompressedDataSize {
+		return nil, ErrInvalidCompressedData
+	}
+	decompressed, err := snappy.Decode(nil, data)
+	if err != nil {
+		return nil, ErrInvalidCompressedData
+	}
+	return decompressed, nil
+}
+
+func (nebMsg *NebMessage) compressed() bool {
+	return len(nebMsg.reserved) > 0 && nebMsg.reserved[0]&flagCompressed != 0
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompress(t *testing.T) {
+	ns := &NetService{node: &Node{config: &Config{CompressionThreshold: 1024}}}
+	ss := NewStreamStore("a", SOK, nil)
+	data := bytes.Repeat([]byte("newblock"), 512)
+
+	// the peer doesn't accept the compressed data.
+	result, compressed := ns.compress("newblock", data, ss)
+	assert.False(t, compressed)
+	assert.Equal(t, data, result)
+
+	ss.snappy = true
+	result, compressed = ns.compress("newblock", data, ss)
+	assert.True(t, compressed)
+	assert.True(t, len(result) < len(data))
+	decompressed, err := decompress(result)
+	assert.Nil(t, err)
+	assert.Equal(t, data, decompressed)
+
+	// the small data, the control messages and the data not saving bytes are sent as they are.
+	_, compressed = ns.compress("newblock", data[:1000], ss)
+	assert.False(t, compressed)
+	_, compressed = ns.compress(SyncRoute, data, ss)
+	assert.False(t, compressed)
+	random := make([]byte, 2048)
+	rand.Read(random)
+	_, compressed = ns.compress("newblock", random, ss)
+	assert.False(t, compressed)
+
+	ns.node.config.CompressionThreshold = 0
+	_, compressed = ns.compress("newblock", data, ss)
+	assert.False(t, compressed)
+}
+
+func TestDecompress(t *testing.T) {
+	_, err := decompress([]byte("not snappy"))
+	assert.Equal(t, ErrInvalidCompressedData, err)
+
+	// the data claiming to decompress over the limit isn't decoded.
+	header := []byte{0x80, 0x80, 0x80, 0x40}
+	_, err = decompress(header)
+	assert.Equal(t, ErrInvalidCompressedData, err)
+}
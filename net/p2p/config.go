@@ -24,6 +24,7 @@ import (
 
 	"github.com/multiformats/go-multiaddr"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 )
 
@@ -43,6 +44,8 @@ const (
 	DefaultStreamStoreExtendSize = 32
 	DefaultNetworkID             = 1
 	DefaultRoutingTableDir       = ""
+	DefaultBanScore              = 100
+	DefaultBanDuration           = 30 * time.Minute
 )
 
 // Config TODO: move to proto config.
@@ -62,6 +65,32 @@ type Config struct {
 	StreamStoreExtendSize int
 	NetworkID             uint32
 	RoutingTableDir       string
+	BanScore              int32
+	BanDuration           time.Duration
+	EnableNAT             bool
+	DNSSeeds              []string
+	DNSSeedSigner         []byte
+	StaticPeers           []string
+	TrustedPeers          []string
+	CompressionThreshold  int
+	MaxUploadRate         int
+	MaxDownloadRate       int
+	PeerUploadRate        int
+	PeerDownloadRate      int
+	NetworkToken          string
+	Proxy                 string
+	MaxInboundPeers       int
+	MaxOutboundPeers      int
+	AllowedIPs            []string
+	DeniedIPs             []string
+	RelayCacheTTL         time.Duration
+	LightServeQuota       int
+	MaxPeersPerSubnet     int
+	MaxPeersPerAS         int
+	ASMap                 string
+	PeerRotationInterval  time.Duration
+	PeerRotationPercent   int
+	QUIC                  bool
 }
 
 // Neblet interface breaks cycle import dependency.
@@ -98,6 +127,74 @@ func NewP2PConfig(n Neblet) *Config {
 	}
 	config.RoutingTableDir = n.Config().Chain.Datadir
 
+	if banScore := n.Config().Network.BanScore; banScore > 0 {
+		config.BanScore = int32(banScore)
+	}
+	if banDuration := n.Config().Network.BanDuration; banDuration > 0 {
+		config.BanDuration = time.Duration(banDuration) * time.Second
+	}
+	config.EnableNAT = !n.Config().Network.DisableNat
+
+	config.DNSSeeds = n.Config().Network.DnsSeed
+	if signer := n.Config().Network.DnsSeedSigner; len(signer) > 0 {
+		pub, err := byteutils.FromHex(signer)
+		if err != nil {
+			logging.VLog().Error("param dns_seed_signer error, creating seed node fail", err)
+			return nil
+		}
+		config.DNSSeedSigner = pub
+	}
+
+	config.StaticPeers = n.Config().Network.StaticPeer
+	config.TrustedPeers = n.Config().Network.TrustedPeer
+
+	if threshold := n.Config().Network.CompressionThreshold; threshold > 0 {
+		config.CompressionThreshold = int(threshold)
+	}
+	if n.Config().Network.DisableCompression {
+		config.CompressionThreshold = 0
+	}
+
+	config.MaxUploadRate = int(n.Config().Network.MaxUploadRate)
+	config.MaxDownloadRate = int(n.Config().Network.MaxDownloadRate)
+	config.PeerUploadRate = int(n.Config().Network.PeerUploadRate)
+	config.PeerDownloadRate = int(n.Config().Network.PeerDownloadRate)
+	config.NetworkToken = n.Config().Network.NetworkToken
+	config.Proxy = n.Config().Network.Proxy
+
+	if maxInbound := n.Config().Network.MaxInboundPeers; maxInbound > 0 {
+		config.MaxInboundPeers = int(maxInbound)
+	}
+	if maxOutbound := n.Config().Network.MaxOutboundPeers; maxOutbound > 0 {
+		config.MaxOutboundPeers = int(maxOutbound)
+	}
+
+	config.AllowedIPs = n.Config().Network.IpAllow
+	config.DeniedIPs = n.Config().Network.IpDeny
+
+	if size := n.Config().Network.RelayCacheSize; size > 0 {
+		config.RelayCacheSize = int(size)
+	}
+	if ttl := n.Config().Network.RelayCacheTtl; ttl > 0 {
+		config.RelayCacheTTL = time.Duration(ttl) * time.Second
+	}
+	config.LightServeQuota = int(n.Config().Network.LightServeQuota)
+
+	if maxPerSubnet := n.Config().Network.MaxPeersPerSubnet; maxPerSubnet > 0 {
+		config.MaxPeersPerSubnet = int(maxPerSubnet)
+	}
+	if maxPerAS := n.Config().Network.MaxPeersPerAs; maxPerAS > 0 {
+		config.MaxPeersPerAS = int(maxPerAS)
+	}
+	config.ASMap = n.Config().Network.AsMap
+	if interval := n.Config().Network.PeerRotationInterval; interval > 0 {
+		config.PeerRotationInterval = time.Duration(interval) * time.Second
+	}
+	if percent := n.Config().Network.PeerRotationPercent; percent > 0 {
+		config.PeerRotationPercent = int(percent)
+	}
+	config.QUIC = n.Config().Network.Quic
+
 	return config
 }
 
@@ -134,5 +231,31 @@ func DefautConfig() *Config {
 		DefaultStreamStoreExtendSize,
 		DefaultNetworkID,
 		DefaultRoutingTableDir,
+		DefaultBanScore,
+		DefaultBanDuration,
+		true,
+		[]string{},
+		nil,
+		[]string{},
+		[]string{},
+		DefaultCompressionThreshold,
+		0,
+		0,
+		0,
+		0,
+		"",
+		"",
+		DefaultMaxInboundPeers,
+		DefaultMaxOutboundPeers,
+		[]string{},
+		[]string{},
+		DefaultRelayCacheTTL,
+		0,
+		DefaultMaxPeersPerSubnet,
+		DefaultMaxPeersPerAS,
+		"",
+		DefaultPeerRotationInterval,
+		DefaultPeerRotationPercent,
+		false,
 	}
 }
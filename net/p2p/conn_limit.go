@@ -0,0 +1,91 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// errors
+var (
+	ErrTooManyInboundPeers  = errors.New("too many inbound peers")
+	ErrTooManyOutboundPeers = errors.New("too many outbound peers")
+)
+
+// const
+const (
+	DefaultMaxInboundPeers  = 96
+	DefaultMaxOutboundPeers = 32
+)
+
+// Metrics of the connections
+var (
+	inboundPeersGauge  = metrics.GetOrRegisterGauge("neb.net.peer.inbound", nil)
+	outboundPeersGauge = metrics.GetOrRegisterGauge("neb.net.peer.outbound", nil)
+)
+
+// peerCounts return the number of the inbound and outbound peers connected,
+// the trusted peers excepted, they have their reserved slots beyond the limits.
+func (node *Node) peerCounts() (int, int) {
+	var inbound, outbound int
+	node.stream.Range(func(k, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		if streamStore.conn != SOK || node.IsTrustedPeer(k.(string)) {
+			return true
+		}
+		if streamStore.outbound {
+			outbound++
+		} else {
+			inbound++
+		}
+		return true
+	})
+	inboundPeersGauge.Update(int64(inbound))
+	outboundPeersGauge.Update(int64(outbound))
+	return inbound, outbound
+}
+
+// acceptInbound return nil if an inbound peer can be connected.
+func (node *Node) acceptInbound(id string) error {
+	if node.IsTrustedPeer(id) || node.isConnected(id) {
+		return nil
+	}
+	if inbound, _ := node.peerCounts(); inbound >= node.config.MaxInboundPeers {
+		return ErrTooManyInboundPeers
+	}
+	return nil
+}
+
+// acceptOutbound return nil if an outbound peer can be dialed.
+func (node *Node) acceptOutbound(id string) error {
+	if node.IsTrustedPeer(id) || node.isConnected(id) {
+		return nil
+	}
+	if _, outbound := node.peerCounts(); outbound >= node.config.MaxOutboundPeers {
+		return ErrTooManyOutboundPeers
+	}
+	return nil
+}
+
+func (node *Node) isConnected(id string) bool {
+	streamStore, ok := node.stream.Load(id)
+	return ok && streamStore.(*StreamStore).conn == SOK
+}
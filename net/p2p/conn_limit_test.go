@@ -0,0 +1,71 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnLimit(t *testing.T) {
+	node := &Node{
+		stream:       new(sync.Map),
+		trustedPeers: new(sync.Map),
+		reputation:   NewReputation(50, time.Hour),
+		config:       &Config{MaxInboundPeers: 2, MaxOutboundPeers: 1},
+	}
+	ns := &NetService{node: node}
+
+	in1, in2, out := testPeerID(t), testPeerID(t), testPeerID(t)
+	connect(node, in1)
+	connect(node, out).outbound = true
+	assert.Nil(t, node.acceptInbound(testPeerID(t).Pretty()))
+	connect(node, in2)
+
+	// the slots of each direction are full.
+	assert.Equal(t, ErrTooManyInboundPeers, node.acceptInbound(testPeerID(t).Pretty()))
+	assert.Equal(t, ErrTooManyOutboundPeers, node.acceptOutbound(testPeerID(t).Pretty()))
+	assert.Equal(t, ErrTooManyOutboundPeers, ns.Hello(testPeerID(t)))
+	// the peers already connected say hello again.
+	assert.Nil(t, node.acceptInbound(in1.Pretty()))
+	assert.Nil(t, node.acceptOutbound(out.Pretty()))
+
+	// the handshaking peers don't take a slot.
+	node.stream.Store(in2.Pretty(), NewStreamStore(in2.Pretty(), SNC, nil))
+	assert.Nil(t, node.acceptInbound(testPeerID(t).Pretty()))
+	connect(node, in2)
+
+	// the trusted peers are accepted beyond the limits, and don't take a slot.
+	trusted := testPeerID(t)
+	assert.Nil(t, node.AddTrustedPeer(trusted.Pretty()))
+	assert.Nil(t, node.acceptInbound(trusted.Pretty()))
+	assert.Nil(t, node.acceptOutbound(trusted.Pretty()))
+	connect(node, trusted).outbound = true
+	inbound, outbound := node.peerCounts()
+	assert.Equal(t, 2, inbound)
+	assert.Equal(t, 1, outbound)
+
+	// a slot freed is taken again.
+	node.stream.Delete(out.Pretty())
+	assert.Nil(t, node.acceptOutbound(testPeerID(t).Pretty()))
+	assert.Equal(t, ErrTooManyInboundPeers, node.acceptInbound(testPeerID(t).Pretty()))
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	peer "github.com/libp2p/go-libp2p-peer"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// const
+const (
+	DefaultRelayCacheTTL = 10 * time.Minute
+)
+
+// seenEntry is the peers known to have a message, forgotten after expires.
+type seenEntry struct {
+	peers   []peer.ID
+	expires time.Time
+}
+
+// seenTypeCache is the LRU cache of the messages of a type, with its hit and miss meters,
+// the hit rate of the type is hit / (hit + miss).
+type seenTypeCache struct {
+	cache *lru.Cache
+	hit   metrics.Meter
+	miss  metrics.Meter
+}
+
+// SeenCache remember the peers known to have a message by its type and data checksum,
+// not to send a message to a peer twice. Each type has its own LRU cache of at most size messages,
+// and a message is forgotten ttl after it is first seen, bounding the memory of long-running relay nodes.
+type SeenCache struct {
+	mu     sync.Mutex
+	size   int
+	ttl    time.Duration
+	caches map[string]*seenTypeCache
+}
+
+// NewSeenCache return a new SeenCache.
+func NewSeenCache(size int, ttl time.Duration) *SeenCache {
+	return &SeenCache{
+		size:   size,
+		ttl:    ttl,
+		caches: make(map[string]*seenTypeCache),
+	}
+}
+
+// typeCache return the cache of the message type, created on its first message.
+func (c *SeenCache) typeCache(name string) *seenTypeCache {
+	tc, ok := c.caches[name]
+	if !ok {
+		cache, _ := lru.New(c.size)
+		tc = &seenTypeCache{
+			cache: cache,
+			hit:   metrics.GetOrRegisterMeter(fmt.Sprintf("neb.net.dedup.%s.hit", name), nil),
+			miss:  metrics.GetOrRegisterMeter(fmt.Sprintf("neb.net.dedup.%s.miss", name), nil),
+		}
+		c.caches[name] = tc
+	}
+	return tc
+}
+
+// get return the entry of the message in the cache if it has not expired.
+func (c *SeenCache) get(tc *seenTypeCache, checksum uint32) (*seenEntry, bool) {
+	v, ok := tc.cache.Get(checksum)
+	if !ok {
+		return nil, false
+	}
+	entry := v.(*seenEntry)
+	if time.Now().After(entry.expires) {
+		tc.cache.Remove(checksum)
+		return nil, false
+	}
+	return entry, true
+}
+
+// Peers return the peers known to have the message, including the ones which announced its hash.
+func (c *SeenCache) Peers(name string, checksum uint32) []peer.ID {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var peers []peer.ID
+	tc := c.typeCache(name)
+	if entry, ok := c.get(tc, checksum); ok {
+		tc.hit.Mark(1)
+		peers = append(peers, entry.peers...)
+	} else {
+		tc.miss.Mark(1)
+	}
+	if name != NewHashMsg {
+		if entry, ok := c.get(c.typeCache(NewHashMsg), checksum); ok {
+			for _, pid := range entry.peers {
+				if !InArray(pid, peers) {
+					peers = append(peers, pid)
+				}
+			}
+		}
+	}
+	return peers
+}
+
+// Add remember the peer has the message.
+func (c *SeenCache) Add(name string, checksum uint32, pid peer.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.add(c.typeCache(name), checksum, pid)
+}
+
+func (c *SeenCache) add(tc *seenTypeCache, checksum uint32, pid peer.ID) {
+	entry, ok := c.get(tc, checksum)
+	if !ok {
+		entry = &seenEntry{expires: time.Now().Add(c.ttl)}
+		tc.cache.Add(checksum, entry)
+	}
+	if !InArray(pid, entry.peers) {
+		entry.peers = append(entry.peers, pid)
+	}
+}
+
+// Announce remember the peer has the message of the checksum, whatever its type:
+// the hash is added to the types the message was seen in, or kept aside until it is.
+func (c *SeenCache) Announce(checksum uint32, pid peer.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	found := false
+	for name, tc := range c.caches {
+		if name == NewHashMsg {
+			continue
+		}
+		if _, ok := c.get(tc, checksum); ok {
+			c.add(tc, checksum, pid)
+			found = true
+		}
+	}
+	if !found {
+		c.add(c.typeCache(NewHashMsg), checksum, pid)
+	}
+}
+
+// Expire remove the expired messages from the caches.
+func (c *SeenCache) Expire() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for _, tc := range c.caches {
+		for _, k := range tc.cache.Keys() {
+			if v, ok := tc.cache.Peek(k); ok && now.After(v.(*seenEntry).expires) {
+				tc.cache.Remove(k)
+			}
+		}
+	}
+}
+
+// Len return the number of the messages cached by type.
+func (c *SeenCache) Len() map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lens := make(map[string]int)
+	for name, tc := range c.caches {
+		lens[name] = tc.cache.Len()
+	}
+	return lens
+}
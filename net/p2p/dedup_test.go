@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeenCache(t *testing.T) {
+	c := NewSeenCache(2, time.Hour)
+	a, b := testPeerID(t), testPeerID(t)
+
+	c.Add("newblock", 1, a)
+	c.Add("newblock", 1, a)
+	c.Add("newblock", 1, b)
+	assert.Equal(t, []peer.ID{a, b}, c.Peers("newblock", 1))
+	// the messages of the types are kept apart.
+	assert.Empty(t, c.Peers("newtx", 1))
+
+	// the peers announcing the hash of a message have it, whatever its type.
+	c.Add("newtx", 2, a)
+	c.Announce(2, b)
+	assert.Equal(t, []peer.ID{a, b}, c.Peers("newtx", 2))
+	c.Announce(3, b)
+	assert.Equal(t, []peer.ID{b}, c.Peers("newblock", 3))
+	assert.Equal(t, []peer.ID{b}, c.Peers("newtx", 3))
+
+	// each type keeps its last size messages.
+	c.Add("newblock", 4, a)
+	c.Add("newblock", 5, a)
+	assert.Empty(t, c.Peers("newblock", 1))
+	assert.Equal(t, 2, c.Len()["newblock"])
+	assert.Equal(t, 1, c.Len()["newtx"])
+}
+
+func TestSeenCache_Expire(t *testing.T) {
+	c := NewSeenCache(16, 200*time.Millisecond)
+	a, b := testPeerID(t), testPeerID(t)
+
+	c.Add("newblock", 1, a)
+	time.Sleep(120 * time.Millisecond)
+	c.Add("newblock", 2, a)
+	// adding a peer doesn't postpone the expiry of the message.
+	c.Add("newblock", 1, b)
+	time.Sleep(120 * time.Millisecond)
+	assert.Empty(t, c.Peers("newblock", 1))
+	assert.Equal(t, []peer.ID{a}, c.Peers("newblock", 2))
+
+	time.Sleep(120 * time.Millisecond)
+	c.Expire()
+	assert.Equal(t, 0, c.Len()["newblock"])
+}
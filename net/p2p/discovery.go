@@ -69,6 +69,7 @@ func (net *NetService) persistRoutingTable() {
 		select {
 		case <-ticker.C:
 			net.saveRoutingTableToDisk()
+			net.saveKnownPeers()
 		case <-net.quitCh:
 			return
 		}
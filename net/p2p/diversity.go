@@ -0,0 +1,258 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"bufio"
+	"errors"
+	"math/rand"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// errors
+var (
+	ErrPeerNotDiverse = errors.New("too many outbound peers in the subnet or the AS of the peer")
+	ErrInvalidASMap   = errors.New("invalid AS map, each line should be a CIDR and an AS number")
+)
+
+// const
+const (
+	DefaultMaxPeersPerSubnet    = 2
+	DefaultMaxPeersPerAS        = 8
+	DefaultPeerRotationInterval = 30 * time.Minute
+	DefaultPeerRotationPercent  = 10
+
+	// the prefix lengths of the IPv4 and IPv6 subnets the outbound peers are limited in.
+	subnetBitsIPv4 = 24
+	subnetBitsIPv6 = 48
+)
+
+// Metrics of the peer diversity
+var (
+	diversityRefusedCounter = metrics.GetOrRegisterCounter("neb.net.diversity.refused", nil)
+	rotationDroppedCounter  = metrics.GetOrRegisterCounter("neb.net.rotation.dropped", nil)
+)
+
+// the local networks, their peers are in no subnet nor AS, as the nodes of a local testnet.
+var localNetworks []*net.IPNet
+
+func init() {
+	for _, v := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "169.254.0.0/16", "fc00::/7", "fe80::/10"} {
+		_, ipnet, _ := net.ParseCIDR(v)
+		localNetworks = append(localNetworks, ipnet)
+	}
+}
+
+// ASMap maps the IPs to the numbers of their autonomous systems.
+type ASMap struct {
+	networks []*net.IPNet
+	numbers  []uint32
+}
+
+// LoadASMap load the AS map of a file, each line a CIDR and its AS number, the lines starting with # skipped.
+func LoadASMap(path string) (*ASMap, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	m := new(ASMap)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, ErrInvalidASMap
+		}
+		_, ipnet, err := net.ParseCIDR(fields[0])
+		if err != nil {
+			return nil, ErrInvalidASMap
+		}
+		number, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(fields[1]), "AS"), 10, 32)
+		if err != nil {
+			return nil, ErrInvalidASMap
+		}
+		m.networks = append(m.networks, ipnet)
+		m.numbers = append(m.numbers, uint32(number))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Lookup return the AS number of the IP, of the longest prefix containing it, 0 if unknown.
+func (m *ASMap) Lookup(ip net.IP) uint32 {
+	var number uint32
+	longest := -1
+	for i, v := range m.networks {
+		if ones, _ := v.Mask.Size(); ones > longest && v.Contains(ip) {
+			number, longest = m.numbers[i], ones
+		}
+	}
+	return number
+}
+
+// addrIP return the IP of the multiaddr, nil if it has none.
+func addrIP(addr ma.Multiaddr) net.IP {
+	value, err := addr.ValueForProtocol(ma.P_IP4)
+	if err != nil {
+		if value, err = addr.ValueForProtocol(ma.P_IP6); err != nil {
+			return nil
+		}
+	}
+	return net.ParseIP(value)
+}
+
+// peerGroup is the subnet and the AS of a peer address, both empty for the local addresses.
+type peerGroup struct {
+	subnet string
+	as     uint32
+}
+
+func (node *Node) addrGroup(addr ma.Multiaddr) peerGroup {
+	ip := addrIP(addr)
+	if ip == nil || ip.IsLoopback() {
+		return peerGroup{}
+	}
+	for _, v := range localNetworks {
+		if v.Contains(ip) {
+			return peerGroup{}
+		}
+	}
+	bits, size := subnetBitsIPv6, 8*net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits, size = ip4, subnetBitsIPv4, 8*net.IPv4len
+	}
+	group := peerGroup{subnet: ip.Mask(net.CIDRMask(bits, size)).String()}
+	if node.asMap != nil {
+		group.as = node.asMap.Lookup(ip)
+	}
+	return group
+}
+
+// isExemptPeer return if the peer is exempt from the diversity rules and the rotation,
+// the boot, static and trusted peers are chosen by the operator.
+func (node *Node) isExemptPeer(id string) bool {
+	if _, ok := node.staticPeers.Load(id); ok {
+		return true
+	}
+	return node.IsTrustedPeer(id) || InArray(id, node.bootIds)
+}
+
+// outboundGroups count the outbound peers connected by subnet and by AS.
+func (node *Node) outboundGroups() (map[string]int, map[uint32]int) {
+	subnets := make(map[string]int)
+	ases := make(map[uint32]int)
+	node.stream.Range(func(k, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		if streamStore.conn != SOK || !streamStore.outbound || node.isExemptPeer(k.(string)) {
+			return true
+		}
+		group := node.addrGroup(streamStore.stream.Conn().RemoteMultiaddr())
+		if len(group.subnet) > 0 {
+			subnets[group.subnet]++
+		}
+		if group.as > 0 {
+			ases[group.as]++
+		}
+		return true
+	})
+	return subnets, ases
+}
+
+// filterDiverseAddrs keep the addresses of the peer in the subnets and the ASes not full of outbound peers
+// before dialing it.
+func (node *Node) filterDiverseAddrs(pid peer.ID) error {
+	id := pid.Pretty()
+	if node.isExemptPeer(id) || node.isConnected(id) {
+		return nil
+	}
+	subnets, ases := node.outboundGroups()
+	addrs := node.peerstore.Addrs(pid)
+	var allowed []ma.Multiaddr
+	for _, v := range addrs {
+		group := node.addrGroup(v)
+		if len(group.subnet) > 0 && subnets[group.subnet] >= node.config.MaxPeersPerSubnet {
+			continue
+		}
+		if group.as > 0 && ases[group.as] >= node.config.MaxPeersPerAS {
+			continue
+		}
+		allowed = append(allowed, v)
+	}
+	if len(allowed) == len(addrs) {
+		return nil
+	}
+	if len(allowed) == 0 {
+		diversityRefusedCounter.Inc(1)
+		return ErrPeerNotDiverse
+	}
+	node.peerstore.ClearAddrs(pid)
+	node.peerstore.AddAddrs(pid, allowed, peerstore.ProviderAddrTTL)
+	return nil
+}
+
+// rotatePeers drop a part of the outbound peers, picked at random, every rotation interval,
+// their slots are taken by new peers so that no one keeps the node's view for long.
+func (ns *NetService) rotatePeers() {
+	node := ns.node
+	if time.Since(node.lastRotation) < node.config.PeerRotationInterval {
+		return
+	}
+	node.lastRotation = time.Now()
+
+	var candidates []*StreamStore
+	node.stream.Range(func(k, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		if streamStore.conn == SOK && streamStore.outbound && !node.isExemptPeer(k.(string)) {
+			candidates = append(candidates, streamStore)
+		}
+		return true
+	})
+	count := len(candidates) * node.config.PeerRotationPercent / 100
+	for _, i := range rand.Perm(len(candidates))[:count] {
+		streamStore := candidates[i]
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":  streamStore.key,
+			"addr": streamStore.stream.Conn().RemoteMultiaddr(),
+		}).Info("Rotated an outbound peer.")
+		streamStore.stream.Close()
+		node.stream.Delete(streamStore.key)
+		if pid, err := peer.IDB58Decode(streamStore.key); err == nil {
+			node.routeTable.Remove(pid)
+		}
+		rotationDroppedCounter.Inc(1)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// DNSSeedService is the SRV service name of the seed nodes, looked up as _neb._tcp.<domain>.
+	DNSSeedService = "neb"
+
+	dnsSeedIDPrefix        = "neb-id="
+	dnsSeedSignaturePrefix = "sig="
+)
+
+// errors
+var (
+	ErrInvalidDNSSeedSignature = errors.New("invalid dns seed signature")
+	ErrInvalidDNSSeed          = errors.New("invalid dns seed, no peer id in the address")
+)
+
+// DNSSeedsHash return the hash of the seed addresses signed in a dns seed list.
+func DNSSeedsHash(addrs []string) []byte {
+	sorted := make([]string, len(addrs))
+	copy(sorted, addrs)
+	sort.Strings(sorted)
+	return hash.Sha3256([]byte(strings.Join(sorted, "\n")))
+}
+
+// ResolveDNSSeeds resolve the seed nodes of a domain.
+// Its TXT records are the multiaddrs of the seeds, and the SRV records of _neb._tcp.<domain> point to
+// the hosts of the others, each with a TXT record "neb-id=<peer id>".
+// If a signer is given, only the TXT list signed by it in a record "sig=<hex signature>" is accepted.
+func ResolveDNSSeeds(domain string, signer []byte) ([]ma.Multiaddr, error) {
+	records, err := net.LookupTXT(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []string
+	var sign []byte
+	for _, v := range records {
+		v = strings.TrimSpace(v)
+		switch {
+		case strings.HasPrefix(v, dnsSeedSignaturePrefix):
+			if sign, err = byteutils.FromHex(strings.TrimPrefix(v, dnsSeedSignaturePrefix)); err != nil {
+				return nil, ErrInvalidDNSSeedSignature
+			}
+		case strings.HasPrefix(v, "/"):
+			addrs = append(addrs, v)
+		}
+	}
+
+	if len(signer) > 0 {
+		if err := verifyDNSSeeds(addrs, sign, signer); err != nil {
+			return nil, err
+		}
+	} else {
+		addrs = append(addrs, resolveSRVSeeds(domain)...)
+	}
+
+	var seeds []ma.Multiaddr
+	for _, v := range addrs {
+		seed, err := ma.NewMultiaddr(v)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := seed.ValueForProtocol(ma.P_IPFS); err != nil {
+			return nil, ErrInvalidDNSSeed
+		}
+		seeds = append(seeds, seed)
+	}
+	return seeds, nil
+}
+
+func verifyDNSSeeds(addrs []string, sign []byte, signer []byte) error {
+	if len(sign) == 0 {
+		return ErrInvalidDNSSeedSignature
+	}
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		return err
+	}
+	pub, err := signature.RecoverPublic(DNSSeedsHash(addrs), sign)
+	if err != nil {
+		return ErrInvalidDNSSeedSignature
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pubdata, signer) {
+		return ErrInvalidDNSSeedSignature
+	}
+	return nil
+}
+
+func resolveSRVSeeds(domain string) []string {
+	_, srvs, err := net.LookupSRV(DNSSeedService, "tcp", domain)
+	if err != nil {
+		return nil
+	}
+
+	var addrs []string
+	for _, srv := range srvs {
+		target := strings.TrimSuffix(srv.Target, ".")
+		id := ""
+		records, _ := net.LookupTXT(target)
+		for _, v := range records {
+			if strings.HasPrefix(v, dnsSeedIDPrefix) {
+				id = strings.TrimPrefix(v, dnsSeedIDPrefix)
+			}
+		}
+		if len(id) == 0 {
+			continue
+		}
+		hosts, err := net.LookupHost(target)
+		if err != nil {
+			continue
+		}
+		for _, host := range hosts {
+			proto := "ip4"
+			if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+				proto = "ip6"
+			}
+			addrs = append(addrs, fmt.Sprintf("/%s/%s/tcp/%d/ipfs/%s", proto, host, srv.Port, id))
+		}
+	}
+	return addrs
+}
+
+// resolveDNSSeeds add the seed nodes resolved from the dns seeds to the boot nodes.
+func (ns *NetService) resolveDNSSeeds() {
+	node := ns.node
+	if len(node.config.Proxy) > 0 && len(node.config.DNSSeeds) > 0 {
+		// the TXT and SRV records can't be resolved through the proxy, nor locally without leaking.
+		logging.CLog().Warn("Skipped the dns seeds behind the proxy.")
+		return
+	}
+	for _, domain := range node.config.DNSSeeds {
+		seeds, err := ResolveDNSSeeds(domain, node.config.DNSSeedSigner)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"domain": domain,
+				"err":    err,
+			}).Warn("Failed to resolve the dns seed.")
+			continue
+		}
+		for _, seed := range seeds {
+			if !InArray(seed.String(), bootNodeStrings(node.config.BootNodes)) {
+				node.config.BootNodes = append(node.config.BootNodes, seed)
+			}
+		}
+		logging.CLog().WithFields(logrus.Fields{
+			"domain": domain,
+			"seeds":  seeds,
+		}).Info("Resolved the dns seed.")
+	}
+}
+
+func bootNodeStrings(bootNodes []ma.Multiaddr) []string {
+	var result []string
+	for _, v := range bootNodes {
+		result = append(result, v.String())
+	}
+	return result
+}
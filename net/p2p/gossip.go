@@ -0,0 +1,263 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/nebulasio/go-nebulas/net/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// gossip topics
+const (
+	TopicBlocks    = "blocks"
+	TopicTxs       = "txs"
+	TopicConsensus = "consensus"
+)
+
+// const
+const (
+	// Subscribe is the message announcing the topics a node subscribes to.
+	Subscribe = "subscribe"
+
+	// CapabilityGossip is the capability of a node gossiping by topics.
+	CapabilityGossip = "gossip"
+
+	// MeshDegree is the number of peers a topic message is sent to,
+	// the mesh is refilled below MeshDegreeLow and trimmed above MeshDegreeHigh.
+	MeshDegree     = 8
+	MeshDegreeLow  = 6
+	MeshDegreeHigh = 12
+)
+
+var (
+	// key: message name, value: topic
+	messageTopics = make(map[string]string)
+	topicsLock    sync.RWMutex
+)
+
+// RegisterTopic gossip the messages of the name on the topic, instead of flooding them to all the peers.
+func RegisterTopic(msgName string, topic string) {
+	topicsLock.Lock()
+	defer topicsLock.Unlock()
+	messageTopics[msgName] = topic
+}
+
+func topicOf(msgName string) (string, bool) {
+	topicsLock.RLock()
+	defer topicsLock.RUnlock()
+	topic, ok := messageTopics[msgName]
+	return topic, ok
+}
+
+// gossip holds the topics the node subscribes to and the mesh of each topic,
+// the peers its messages are sent to.
+type gossip struct {
+	mu     sync.Mutex
+	topics map[string]bool
+	mesh   map[string]map[peer.ID]bool
+}
+
+func newGossip() *gossip {
+	return &gossip{
+		topics: map[string]bool{TopicBlocks: true, TopicTxs: true, TopicConsensus: true},
+		mesh:   make(map[string]map[peer.ID]bool),
+	}
+}
+
+func (g *gossip) subscriptions() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var topics []string
+	for topic := range g.topics {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+// Subscribe subscribe the node to a topic and tell the peers.
+func (ns *NetService) Subscribe(topic string) {
+	g := ns.node.gossip
+	g.mu.Lock()
+	g.topics[topic] = true
+	g.mu.Unlock()
+	ns.announceSubscriptions()
+}
+
+// Unsubscribe unsubscribe the node from a topic and tell the peers.
+func (ns *NetService) Unsubscribe(topic string) {
+	g := ns.node.gossip
+	g.mu.Lock()
+	delete(g.topics, topic)
+	g.mu.Unlock()
+	ns.announceSubscriptions()
+}
+
+func (ns *NetService) subscriptionData() ([]byte, error) {
+	return proto.Marshal(&netpb.Subscription{Topics: ns.node.gossip.subscriptions()})
+}
+
+func (ns *NetService) announceSubscriptions() {
+	data, err := ns.subscriptionData()
+	if err != nil {
+		return
+	}
+	ns.node.stream.Range(func(_, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		if streamStore.conn == SOK && streamStore.stats.supports(CapabilityGossip) {
+			go ns.sendMsg(Subscribe, data, streamStore.stream)
+		}
+		return true
+	})
+}
+
+// sendSubscriptions tell a peer just connected the topics of the node.
+func (ns *NetService) sendSubscriptions(streamStore *StreamStore) {
+	if !streamStore.stats.supports(CapabilityGossip) {
+		return
+	}
+	data, err := ns.subscriptionData()
+	if err != nil {
+		return
+	}
+	if err := ns.sendMsg(Subscribe, data, streamStore.stream); err != nil {
+		logging.VLog().Debug("send subscribe msg occurs error, ", err)
+	}
+}
+
+func (ns *NetService) handleSubscribeMsg(data []byte, key string) {
+	pb := new(netpb.Subscription)
+	if err := proto.Unmarshal(data, pb); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid": key,
+			"err": err,
+		}).Error("handle subscribe msg occurs error.")
+		ns.ReportPeer(key, InvalidMessage)
+		return
+	}
+	streamStore, ok := ns.node.stream.Load(key)
+	if !ok {
+		return
+	}
+	ps := streamStore.(*StreamStore).stats
+	ps.mu.Lock()
+	ps.topics = make(map[string]bool)
+	for _, topic := range pb.Topics {
+		ps.topics[topic] = true
+	}
+	ps.mu.Unlock()
+}
+
+// gossipPeers filter the peers a topic message is sent to:
+// the mesh peers of the topic, and the peers not gossiping which get every message.
+func (ns *NetService) gossipPeers(topic string, peers []peer.ID) []peer.ID {
+	node := ns.node
+	g := node.gossip
+
+	g.mu.Lock()
+	if len(g.mesh[topic]) < MeshDegreeLow {
+		ns.maintainMesh(topic)
+	}
+	mesh := g.mesh[topic]
+	var result []peer.ID
+	for _, p := range peers {
+		if mesh[p] {
+			result = append(result, p)
+			continue
+		}
+		if streamStore, ok := node.stream.Load(p.Pretty()); ok && !streamStore.(*StreamStore).stats.supports(CapabilityGossip) {
+			result = append(result, p)
+		}
+	}
+	g.mu.Unlock()
+	return result
+}
+
+// maintainMeshes drop the disconnected and unsubscribed peers from the meshes and refill them.
+func (ns *NetService) maintainMeshes() {
+	g := ns.node.gossip
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, topic := range []string{TopicBlocks, TopicTxs, TopicConsensus} {
+		ns.maintainMesh(topic)
+	}
+}
+
+// maintainMesh keep the mesh of a topic between MeshDegreeLow and MeshDegreeHigh peers, the lock is held.
+func (ns *NetService) maintainMesh(topic string) {
+	node := ns.node
+	g := node.gossip
+
+	var candidates []peer.ID
+	node.stream.Range(func(k, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		if streamStore.conn != SOK || !streamStore.stats.subscribes(topic) {
+			return true
+		}
+		if pid, err := peer.IDB58Decode(k.(string)); err == nil {
+			candidates = append(candidates, pid)
+		}
+		return true
+	})
+
+	mesh := make(map[peer.ID]bool)
+	for _, p := range candidates {
+		if g.mesh[topic][p] {
+			mesh[p] = true
+		}
+	}
+	if len(mesh) < MeshDegreeLow {
+		for _, i := range rand.Perm(len(candidates)) {
+			if len(mesh) >= MeshDegree {
+				break
+			}
+			mesh[candidates[i]] = true
+		}
+	}
+	if len(mesh) > MeshDegreeHigh {
+		for p := range mesh {
+			if len(mesh) <= MeshDegree {
+				break
+			}
+			delete(mesh, p)
+		}
+	}
+	g.mesh[topic] = mesh
+}
+
+func (ps *peerStats) supports(capability string) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return hasCapability(ps.capabilities, capability)
+}
+
+// subscribes return if the gossiping peer subscribes to the topic, all of them until it tells.
+func (ps *peerStats) subscribes(topic string) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if !hasCapability(ps.capabilities, CapabilityGossip) {
+		return false
+	}
+	return ps.topics == nil || ps.topics[topic]
+}
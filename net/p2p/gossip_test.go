@@ -0,0 +1,135 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/nebulasio/go-nebulas/net/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPeerID return the id of a new key.
+func testPeerID(t *testing.T) peer.ID {
+	_, pub, err := GenerateEd25519Key()
+	assert.Nil(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	assert.Nil(t, err)
+	return id
+}
+
+// connect add a peer connected to the node, gossiping the topics if any, or flooded if none.
+func connect(node *Node, id peer.ID, topics ...string) *StreamStore {
+	streamStore := NewStreamStore(id.Pretty(), SOK, nil)
+	if len(topics) > 0 {
+		streamStore.stats.capabilities = []string{CapabilityGossip}
+		streamStore.stats.topics = make(map[string]bool)
+		for _, topic := range topics {
+			streamStore.stats.topics[topic] = true
+		}
+	}
+	node.stream.Store(id.Pretty(), streamStore)
+	return streamStore
+}
+
+func TestGossip_Mesh(t *testing.T) {
+	ns := &NetService{node: &Node{stream: new(sync.Map), gossip: newGossip()}}
+
+	var peers, subscribed []peer.ID
+	for i := 0; i < 20; i++ {
+		id := testPeerID(t)
+		connect(ns.node, id, TopicBlocks, TopicTxs)
+		peers = append(peers, id)
+		subscribed = append(subscribed, id)
+	}
+	for i := 0; i < 4; i++ {
+		id := testPeerID(t)
+		connect(ns.node, id, TopicTxs)
+		peers = append(peers, id)
+	}
+	flooded := testPeerID(t)
+	connect(ns.node, flooded)
+	peers = append(peers, flooded)
+
+	// the message is sent to MeshDegree subscribers, and to the peers not gossiping.
+	transfer := ns.gossipPeers(TopicBlocks, peers)
+	assert.Equal(t, MeshDegree+1, len(transfer))
+	assert.Contains(t, transfer, flooded)
+	for _, p := range transfer {
+		if p != flooded {
+			assert.Contains(t, subscribed, p)
+		}
+	}
+
+	// the mesh is kept between the messages.
+	assert.Equal(t, transfer, ns.gossipPeers(TopicBlocks, peers))
+
+	// only the peers given are sent to, e.g. the ones which don't have the message yet.
+	assert.Equal(t, []peer.ID{transfer[0]}, ns.gossipPeers(TopicBlocks, transfer[:1]))
+
+	// the disconnected peers are dropped from the mesh and replaced.
+	for _, p := range transfer[:4] {
+		ns.node.stream.Delete(p.Pretty())
+	}
+	ns.maintainMeshes()
+	mesh := ns.node.gossip.mesh[TopicBlocks]
+	assert.Equal(t, MeshDegree, len(mesh))
+	for _, p := range transfer[4:] {
+		if p != flooded {
+			assert.True(t, mesh[p])
+		}
+	}
+	for _, p := range transfer[:4] {
+		assert.False(t, mesh[p])
+	}
+
+	// a mesh grown over MeshDegreeHigh is trimmed to MeshDegree.
+	for _, p := range subscribed {
+		if _, ok := ns.node.stream.Load(p.Pretty()); ok {
+			ns.node.gossip.mesh[TopicBlocks][p] = true
+		}
+	}
+	assert.True(t, len(ns.node.gossip.mesh[TopicBlocks]) > MeshDegreeHigh)
+	ns.maintainMeshes()
+	assert.Equal(t, MeshDegree, len(ns.node.gossip.mesh[TopicBlocks]))
+}
+
+func TestGossip_Subscribe(t *testing.T) {
+	ns := &NetService{node: &Node{stream: new(sync.Map), gossip: newGossip()}}
+
+	id := testPeerID(t)
+	streamStore := connect(ns.node, id, TopicBlocks)
+	// a gossiping peer subscribes to all the topics until it tells.
+	streamStore.stats.topics = nil
+	assert.True(t, streamStore.stats.subscribes(TopicConsensus))
+
+	data, err := proto.Marshal(&netpb.Subscription{Topics: []string{TopicTxs}})
+	assert.Nil(t, err)
+	ns.handleSubscribeMsg(data, id.Pretty())
+	assert.True(t, streamStore.stats.subscribes(TopicTxs))
+	assert.False(t, streamStore.stats.subscribes(TopicBlocks))
+	assert.Empty(t, ns.gossipPeers(TopicBlocks, []peer.ID{id}))
+	assert.Equal(t, []peer.ID{id}, ns.gossipPeers(TopicTxs, []peer.ID{id}))
+
+	// a peer not gossiping subscribes to nothing, it's flooded instead.
+	assert.False(t, connect(ns.node, testPeerID(t)).stats.subscribes(TopicTxs))
+}
@@ -0,0 +1,234 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"sync"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// errors
+var (
+	ErrInvalidCIDR   = errors.New("invalid CIDR, it should be like 10.0.0.0/8, fd00::/8 or an IP")
+	ErrAddrFiltered  = errors.New("peer address is not allowed by the IP filter")
+	ErrCIDRNotInList = errors.New("CIDR is in neither the allowlist nor the denylist")
+)
+
+// IPFilter decides the IPs the peers are connected at, by CIDR allow and deny lists.
+// An IP is refused when it is denied, or when the allowlist is not empty and it is not allowed.
+type IPFilter struct {
+	mu    sync.RWMutex
+	allow []*net.IPNet
+	deny  []*net.IPNet
+}
+
+// NewIPFilter create an IP filter of the lists.
+func NewIPFilter(allow []string, deny []string) (*IPFilter, error) {
+	f := new(IPFilter)
+	for _, v := range allow {
+		if err := f.Allow(v); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range deny {
+		if err := f.Deny(v); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// parseCIDR parse a CIDR, a single IP taken as its /32 or /128 one.
+func parseCIDR(cidr string) (*net.IPNet, error) {
+	if !strings.Contains(cidr, "/") {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return nil, ErrInvalidCIDR
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, ErrInvalidCIDR
+	}
+	return ipnet, nil
+}
+
+func addCIDR(list []*net.IPNet, ipnet *net.IPNet) []*net.IPNet {
+	for _, v := range list {
+		if v.String() == ipnet.String() {
+			return list
+		}
+	}
+	return append(list, ipnet)
+}
+
+func removeCIDR(list []*net.IPNet, ipnet *net.IPNet) ([]*net.IPNet, bool) {
+	for i, v := range list {
+		if v.String() == ipnet.String() {
+			return append(list[:i], list[i+1:]...), true
+		}
+	}
+	return list, false
+}
+
+// Allow add the CIDR to the allowlist.
+func (f *IPFilter) Allow(cidr string) error {
+	ipnet, err := parseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.allow = addCIDR(f.allow, ipnet)
+	return nil
+}
+
+// Deny add the CIDR to the denylist.
+func (f *IPFilter) Deny(cidr string) error {
+	ipnet, err := parseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deny = addCIDR(f.deny, ipnet)
+	return nil
+}
+
+// Remove remove the CIDR from the allowlist and the denylist.
+func (f *IPFilter) Remove(cidr string) error {
+	ipnet, err := parseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var allowed, denied bool
+	f.allow, allowed = removeCIDR(f.allow, ipnet)
+	f.deny, denied = removeCIDR(f.deny, ipnet)
+	if !allowed && !denied {
+		return ErrCIDRNotInList
+	}
+	return nil
+}
+
+// Lists return the allowlist and the denylist.
+func (f *IPFilter) Lists() ([]string, []string) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	var allow, deny []string
+	for _, v := range f.allow {
+		allow = append(allow, v.String())
+	}
+	for _, v := range f.deny {
+		deny = append(deny, v.String())
+	}
+	return allow, deny
+}
+
+// Allowed return if the peers at the IP are connected.
+func (f *IPFilter) Allowed(ip net.IP) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for _, v := range f.deny {
+		if v.Contains(ip) {
+			return false
+		}
+	}
+	if len(f.allow) == 0 {
+		return true
+	}
+	for _, v := range f.allow {
+		if v.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedAddr return if the peers at the multiaddr are connected,
+// the ones without an IP, e.g. dns4 ones, only when the allowlist is empty.
+func (f *IPFilter) AllowedAddr(addr ma.Multiaddr) bool {
+	value, err := addr.ValueForProtocol(ma.P_IP4)
+	if err != nil {
+		if value, err = addr.ValueForProtocol(ma.P_IP6); err != nil {
+			f.mu.RLock()
+			defer f.mu.RUnlock()
+			return len(f.allow) == 0
+		}
+	}
+	ip := net.ParseIP(value)
+	return ip != nil && f.Allowed(ip)
+}
+
+// IPFilter return the IP filter of the peer connections.
+func (node *Node) IPFilter() *IPFilter {
+	return node.ipFilter
+}
+
+// DropFilteredPeers disconnect the peers connected at the addresses not allowed any more.
+func (node *Node) DropFilteredPeers() {
+	node.stream.Range(func(k, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		addr := streamStore.stream.Conn().RemoteMultiaddr()
+		if node.ipFilter.AllowedAddr(addr) {
+			return true
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":  k,
+			"addr": addr,
+		}).Info("Dropped a peer refused by the IP filter.")
+		streamStore.stream.Close()
+		node.stream.Delete(k)
+		return true
+	})
+}
+
+// filterPeerAddrs keep the addresses of the peer allowed by the IP filter before dialing it.
+func (node *Node) filterPeerAddrs(pid peer.ID) error {
+	addrs := node.peerstore.Addrs(pid)
+	var allowed []ma.Multiaddr
+	for _, v := range addrs {
+		if node.ipFilter.AllowedAddr(v) {
+			allowed = append(allowed, v)
+		}
+	}
+	if len(allowed) == len(addrs) {
+		return nil
+	}
+	node.peerstore.ClearAddrs(pid)
+	if len(allowed) == 0 {
+		return ErrAddrFiltered
+	}
+	node.peerstore.AddAddrs(pid, allowed, peerstore.ProviderAddrTTL)
+	return nil
+}
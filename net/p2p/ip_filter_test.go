@@ -0,0 +1,86 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPFilter(t *testing.T) {
+	_, err := NewIPFilter([]string{"10.0.0.0/33"}, nil)
+	assert.Equal(t, ErrInvalidCIDR, err)
+	_, err = NewIPFilter(nil, []string{"host"})
+	assert.Equal(t, ErrInvalidCIDR, err)
+
+	// no list, every IP is allowed.
+	f, err := NewIPFilter(nil, nil)
+	assert.Nil(t, err)
+	assert.True(t, f.Allowed(net.ParseIP("1.2.3.4")))
+
+	// the denied IPs are refused, even if allowed.
+	assert.Nil(t, f.Deny("1.2.3.4"))
+	assert.Nil(t, f.Deny("fd00::/8"))
+	assert.False(t, f.Allowed(net.ParseIP("1.2.3.4")))
+	assert.False(t, f.Allowed(net.ParseIP("fd00::1")))
+	assert.True(t, f.Allowed(net.ParseIP("1.2.3.5")))
+	assert.True(t, f.AllowedAddr(testAddr(t, "/dns4/seed.nebulas.io/tcp/8680")))
+
+	// with an allowlist, only the IPs in it.
+	assert.Nil(t, f.Allow("1.2.3.0/24"))
+	assert.Nil(t, f.Allow("1.2.3.0/24"))
+	assert.False(t, f.Allowed(net.ParseIP("1.2.3.4")))
+	assert.True(t, f.Allowed(net.ParseIP("1.2.3.5")))
+	assert.False(t, f.Allowed(net.ParseIP("1.2.4.5")))
+	assert.True(t, f.AllowedAddr(testAddr(t, "/ip4/1.2.3.5/tcp/8680")))
+	assert.False(t, f.AllowedAddr(testAddr(t, "/ip6/fd00::1/tcp/8680")))
+	// the host names can't be checked against the allowlist.
+	assert.False(t, f.AllowedAddr(testAddr(t, "/dns4/seed.nebulas.io/tcp/8680")))
+
+	allow, deny := f.Lists()
+	assert.Equal(t, []string{"1.2.3.0/24"}, allow)
+	assert.Equal(t, []string{"1.2.3.4/32", "fd00::/8"}, deny)
+
+	assert.Nil(t, f.Remove("1.2.3.4"))
+	assert.Equal(t, ErrCIDRNotInList, f.Remove("1.2.3.4"))
+	assert.True(t, f.Allowed(net.ParseIP("1.2.3.4")))
+}
+
+func TestFilterPeerAddrs(t *testing.T) {
+	f, err := NewIPFilter(nil, []string{"10.0.0.0/8"})
+	assert.Nil(t, err)
+	node := &Node{peerstore: peerstore.NewPeerstore(), ipFilter: f}
+
+	pid := testPeerID(t)
+	allowed := testAddr(t, "/ip4/1.2.3.4/tcp/8680")
+	node.peerstore.AddAddrs(pid, []ma.Multiaddr{allowed, testAddr(t, "/ip4/10.0.0.1/tcp/8680")}, peerstore.PermanentAddrTTL)
+	assert.Nil(t, node.filterPeerAddrs(pid))
+	addrs := node.peerstore.Addrs(pid)
+	assert.Equal(t, 1, len(addrs))
+	assert.True(t, allowed.Equal(addrs[0]))
+
+	// a peer with no address allowed isn't dialed.
+	assert.Nil(t, f.Deny("1.2.3.4"))
+	assert.Equal(t, ErrAddrFiltered, node.filterPeerAddrs(pid))
+	assert.Empty(t, node.peerstore.Addrs(pid))
+}
@@ -0,0 +1,234 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// MaxKnownPeers is the maximum number of peers kept in the address book.
+	MaxKnownPeers = 256
+	// MaxKnownPeerDials is the number of the most recently seen peers dialed at start.
+	MaxKnownPeerDials = 16
+	// KnownPeerTTL is how long a peer not seen is kept in the address book.
+	KnownPeerTTL = 7 * 24 * time.Hour
+)
+
+var (
+	knownPeersFile = "KNOWNPEERS.json"
+)
+
+// KnownPeer is a peer recently connected and well-behaved, persisted across restarts.
+type KnownPeer struct {
+	ID       string `json:"id"`
+	Addr     string `json:"addr"`
+	LastSeen int64  `json:"last_seen"`
+}
+
+// knownPeers is the address book of the recently good peers.
+type knownPeers struct {
+	mu    sync.Mutex
+	peers map[string]*KnownPeer
+}
+
+func newKnownPeers() *knownPeers {
+	return &knownPeers{peers: make(map[string]*KnownPeer)}
+}
+
+// update add the peer to the address book, or refresh it.
+func (kp *knownPeers) update(id string, addr string, now time.Time) {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	kp.peers[id] = &KnownPeer{ID: id, Addr: addr, LastSeen: now.Unix()}
+}
+
+// remove drop the peer from the address book.
+func (kp *knownPeers) remove(id string) {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	delete(kp.peers, id)
+}
+
+// contains return if the peer is in the address book.
+func (kp *knownPeers) contains(id string) bool {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	_, ok := kp.peers[id]
+	return ok
+}
+
+// list return the peers seen within KnownPeerTTL, the most recent first, at most MaxKnownPeers.
+func (kp *knownPeers) list(now time.Time) []*KnownPeer {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+
+	var peers []*KnownPeer
+	for id, p := range kp.peers {
+		if now.Sub(time.Unix(p.LastSeen, 0)) > KnownPeerTTL {
+			delete(kp.peers, id)
+			continue
+		}
+		peers = append(peers, p)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].LastSeen > peers[j].LastSeen
+	})
+	if len(peers) > MaxKnownPeers {
+		for _, p := range peers[MaxKnownPeers:] {
+			delete(kp.peers, p.ID)
+		}
+		peers = peers[:MaxKnownPeers]
+	}
+	return peers
+}
+
+// KnownPeers return the address book of the recently good peers.
+func (node *Node) KnownPeers() []*KnownPeer {
+	return node.knownPeers.list(time.Now())
+}
+
+func (ns *NetService) getKnownPeersFilePath() string {
+	return path.Join(ns.node.config.RoutingTableDir, knownPeersFile)
+}
+
+// loadKnownPeers load the address book persisted by the last run.
+func (ns *NetService) loadKnownPeers() {
+	b, err := ioutil.ReadFile(ns.getKnownPeersFilePath())
+	if err != nil {
+		return
+	}
+	var peers []*KnownPeer
+	if err := json.Unmarshal(b, &peers); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Failed to load the known peers.")
+		return
+	}
+	kp := ns.node.knownPeers
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	for _, p := range peers {
+		kp.peers[p.ID] = p
+	}
+}
+
+// saveKnownPeers refresh the address book with the good peers connected and persist it.
+func (ns *NetService) saveKnownPeers() {
+	node := ns.node
+	now := time.Now()
+	node.stream.Range(func(key, value interface{}) bool {
+		id := key.(string)
+		if value.(*StreamStore).conn != SOK {
+			return true
+		}
+		pid, err := peer.IDB58Decode(id)
+		if err != nil {
+			return true
+		}
+		// a peer half way to a ban is not good any more.
+		if node.reputation.Score(id) <= -node.config.BanScore/2 {
+			node.knownPeers.remove(id)
+			return true
+		}
+		addrs := node.peerstore.Addrs(pid)
+		if len(addrs) == 0 || !isDialable(addrs[0]) {
+			return true
+		}
+		node.knownPeers.update(id, addrs[0].String()+"/ipfs/"+id, now)
+		return true
+	})
+
+	data, err := json.Marshal(node.knownPeers.list(now))
+	if err != nil {
+		return
+	}
+	if err := ioutil.WriteFile(ns.getKnownPeersFilePath(), data, 0644); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Failed to persist the known peers.")
+	}
+}
+
+// connectKnownPeers say hello to the most recently seen peers, return the number of them connected.
+func (ns *NetService) connectKnownPeers() int {
+	node := ns.node
+	peers := node.knownPeers.list(time.Now())
+	if len(peers) > MaxKnownPeerDials {
+		peers = peers[:MaxKnownPeerDials]
+	}
+
+	var count int
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, p := range peers {
+		wg.Add(1)
+		go func(p *KnownPeer) {
+			defer wg.Done()
+			if err := ns.helloKnownPeer(p); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"peer": p.Addr,
+					"err":  err,
+				}).Debug("Failed to say hello to a known peer.")
+				return
+			}
+			mu.Lock()
+			count++
+			mu.Unlock()
+		}(p)
+	}
+	wg.Wait()
+
+	logging.CLog().WithFields(logrus.Fields{
+		"known":     len(peers),
+		"connected": count,
+	}).Info("Connected to the known peers.")
+	return count
+}
+
+func (ns *NetService) helloKnownPeer(p *KnownPeer) error {
+	node := ns.node
+	multiaddr, err := ma.NewMultiaddr(p.Addr)
+	if err != nil {
+		return err
+	}
+	addr, id, err := ns.parseAddressFromMultiaddr(multiaddr)
+	if err != nil {
+		return err
+	}
+	node.peerstore.AddAddr(id, addr, peerstore.ProviderAddrTTL)
+	if err := ns.Hello(id); err != nil {
+		return err
+	}
+	node.peerstore.AddAddr(id, addr, peerstore.PermanentAddrTTL)
+	node.routeTable.Update(id)
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"net"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/net/messages"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// NATMappingTimeout is the time to wait for the router to map the listen ports.
+	NATMappingTimeout = 30 * time.Second
+)
+
+// AdvertisedAddrs return the addresses the node tells its peers to reach it at,
+// the external addresses mapped on the router included.
+func (node *Node) AdvertisedAddrs() []ma.Multiaddr {
+	var addrs []ma.Multiaddr
+	for _, addr := range node.host.Addrs() {
+		if !isDialable(addr) {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+func isDialable(addr ma.Multiaddr) bool {
+	value, err := addr.ValueForProtocol(ma.P_IP4)
+	if err != nil {
+		if value, err = addr.ValueForProtocol(ma.P_IP6); err != nil {
+			return false
+		}
+	}
+	ip := net.ParseIP(value)
+	// the IPv6 link-local addresses are only reachable on the local link, they are not advertised.
+	return ip != nil && !ip.IsLoopback() && !ip.IsUnspecified() && !ip.IsLinkLocalUnicast()
+}
+
+func (ns *NetService) newHelloMessage(pid peer.ID) *messages.HelloMessage {
+	node := ns.node
+	hello := messages.NewHelloMessage(node.id.String(), ClientVersion)
+	hello.ProtocolVersion = ProtocolVersion
+	hello.MinProtocolVersion = MinProtocolVersion
+	hello.Capabilities = node.Capabilities()
+	hello.NetworkProof = node.networkProof(node.id, pid)
+	for _, addr := range node.AdvertisedAddrs() {
+		hello.Addrs = append(hello.Addrs, addr.String())
+	}
+	return hello
+}
+
+// addAdvertisedAddrs keep the addresses a peer advertised, so they are shared with the others
+// instead of the address its connection came from, which is not listening if the peer is behind a NAT.
+func (ns *NetService) addAdvertisedAddrs(pid peer.ID, addrs []string) {
+	node := ns.node
+	for _, v := range addrs {
+		addr, err := ma.NewMultiaddr(v)
+		if err != nil || !isDialable(addr) {
+			logging.VLog().WithFields(logrus.Fields{
+				"pid":  pid.Pretty(),
+				"addr": v,
+			}).Debug("Ignored an invalid advertised address.")
+			continue
+		}
+		node.peerstore.AddAddr(pid, addr, peerstore.PermanentAddrTTL)
+	}
+}
+
+func (ns *NetService) waitNATMapping() {
+	node := ns.node
+	if node.natManager == nil {
+		return
+	}
+
+	select {
+	case <-node.natManager.Ready():
+	case <-time.After(NATMappingTimeout):
+		logging.CLog().Warn("No NAT device mapped the listen ports.")
+		return
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"addrs": node.AdvertisedAddrs(),
+	}).Info("Mapped the listen ports on the NAT device.")
+}
@@ -177,6 +177,32 @@ func (ns *NetService) streamHandler(s libnet.Stream) {
 	addrs := s.Conn().RemoteMultiaddr()
 	key := pid.Pretty()
 
+	if node.reputation.IsBanned(key) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   key,
+			"addrs": addrs,
+		}).Warn("Refused a banned peer.")
+		s.Close()
+		return
+	}
+	if !node.ipFilter.AllowedAddr(addrs) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   key,
+			"addrs": addrs,
+		}).Debug("Refused a peer by the IP filter.")
+		s.Close()
+		return
+	}
+	if err := verifyTransport(s); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   key,
+			"addrs": addrs,
+			"err":   err,
+		}).Warn("Refused an insecure connection.")
+		s.Close()
+		return
+	}
+
 	for {
 		select {
 		case <-ns.quitCh:
@@ -191,6 +217,8 @@ func (ns *NetService) streamHandler(s libnet.Stream) {
 				ns.Bye(pid, []ma.Multiaddr{addrs}, s, key)
 				return
 			}
+			node.downloadLimiter.Wait(key, n)
+			ns.countBytes(key, n, 0)
 			streamBuffer = append(streamBuffer, sdata[:n]...)
 
 			if tmpMsg == nil {
@@ -205,6 +233,7 @@ func (ns *NetService) streamHandler(s libnet.Stream) {
 						"err":   err,
 					}).Error("parse header error")
 					ns.Bye(pid, []ma.Multiaddr{addrs}, s, key)
+					ns.ReportPeer(key, ProtocolViolation)
 					return
 				}
 
@@ -223,6 +252,7 @@ func (ns *NetService) streamHandler(s libnet.Stream) {
 					"err":   err,
 				}).Error("parse data error")
 				ns.Bye(pid, []ma.Multiaddr{addrs}, s, key)
+				ns.ReportPeer(key, ProtocolViolation)
 				return
 			}
 			streamBuffer = streamBuffer[dataLength:]
@@ -251,8 +281,17 @@ func (ns *NetService) streamHandler(s libnet.Stream) {
 				ns.handleNetworkIDMsg(msg.data, pid, s)
 			case NetworkIDReply:
 				ns.handleReNetworkIDMsg(msg.data, pid)
+			case Ping:
+				ns.handlePingMsg(msg.data, s)
+			case Pong:
+				ns.handlePongMsg(msg.data, key)
+			case Subscribe:
+				ns.handleSubscribeMsg(msg.data, key)
+			case PexRequest:
+				ns.handlePexRequestMsg(msg.data, key)
+			case PexReply:
+				ns.handlePexReplyMsg(msg.data, key)
 			default:
-				var relayness []peer.ID
 				logging.VLog().WithFields(logrus.Fields{
 					"msgName": msg.msgName,
 					"pid":     pid.Pretty(),
@@ -271,15 +310,15 @@ func (ns *NetService) streamHandler(s libnet.Stream) {
 				if streamStore.(*StreamStore).conn != SOK {
 					logging.VLog().Error("peer not shake hand before send message.")
 					ns.Bye(pid, []ma.Multiaddr{addrs}, s, key)
+					ns.ReportPeer(key, ProtocolViolation)
 					return
 				}
+				if msg.msgName == SyncReply {
+					node.reputation.Fulfil(key)
+				}
 				ns.PutMessage(messages.NewBaseMessage(msg.msgName, pid.Pretty(), msg.data))
 
-				peers, exists := node.relayness.Get(byteutils.Uint32(msg.dataChecksum))
-				if exists {
-					relayness = peers.([]peer.ID)
-				}
-				node.relayness.Add(byteutils.Uint32(msg.dataChecksum), append(relayness, pid))
+				node.seen.Add(msg.msgName, byteutils.Uint32(msg.dataChecksum), pid)
 			}
 
 		}
@@ -328,6 +367,13 @@ func (ns *NetService) parseMsgData(nebMsg *NebMessage, streamBuffer []byte) erro
 
 	dataLength := byteutils.Uint32(nebMsg.dataLength)
 	nebMsg.data = streamBuffer[:dataLength]
+	if nebMsg.compressed() {
+		data, err := decompress(nebMsg.data)
+		if err != nil {
+			return err
+		}
+		nebMsg.data = data
+	}
 
 	dataChecksumA := crc32.ChecksumIEEE(nebMsg.data)
 	if dataChecksumA != byteutils.Uint32(nebMsg.dataChecksum) {
@@ -353,6 +399,7 @@ func (ns *NetService) handleHelloMsg(data []byte, pid peer.ID, s libnet.Stream,
 	pb := new(netpb.Hello)
 	if err := proto.Unmarshal(data, pb); err != nil {
 		logging.VLog().Error("handle hello msg occurs error: ", err)
+		node.reputation.Report(key, ProtocolViolation)
 		return result
 	}
 	if err := hello.FromProto(pb); err != nil {
@@ -367,9 +414,37 @@ func (ns *NetService) handleHelloMsg(data []byte, pid peer.ID, s libnet.Stream,
 		"ClientVersion": hello.ClientVersion,
 	}).Info("receive hello message.")
 
-	//Todo: clientVersion backwards compatible
-	if hello.NodeID == pid.String() && hello.ClientVersion == ClientVersion {
-		ok := messages.NewHelloMessage(node.id.String(), ClientVersion)
+	if !node.verifyNetworkProof(pid, hello.NetworkProof) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   pid.Pretty(),
+			"addrs": addrs.String(),
+			"err":   ErrNetworkTokenMismatch,
+		}).Warn("Refused a peer out of the private network.")
+		return result
+	}
+	if err := node.acceptInbound(key); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   pid.Pretty(),
+			"addrs": addrs.String(),
+			"err":   err,
+		}).Debug("Refused an inbound peer over the limit.")
+		return result
+	}
+
+	version, err := negotiateProtocolVersion(hello.ClientVersion, hello.ProtocolVersion, hello.MinProtocolVersion)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":                pid.Pretty(),
+			"clientVersion":      hello.ClientVersion,
+			"protocolVersion":    hello.ProtocolVersion,
+			"minProtocolVersion": hello.MinProtocolVersion,
+			"err":                err,
+		}).Warn("Refused a peer of an incompatible protocol.")
+		return result
+	}
+
+	if hello.NodeID == pid.String() {
+		ok := ns.newHelloMessage(pid)
 		pbok, err := ok.ToProto()
 		okdata, err := proto.Marshal(pbok)
 		if err != nil {
@@ -382,6 +457,7 @@ func (ns *NetService) handleHelloMsg(data []byte, pid peer.ID, s libnet.Stream,
 			addrs,
 			peerstore.PermanentAddrTTL,
 		)
+		ns.addAdvertisedAddrs(pid, hello.Addrs)
 
 		if err := ns.sendMsg(OK, okdata, s); err != nil {
 			logging.VLog().Error("send ok msg occurs error, ", err)
@@ -395,9 +471,12 @@ func (ns *NetService) handleHelloMsg(data []byte, pid peer.ID, s libnet.Stream,
 		}
 
 		streamStore := NewStreamStore(key, SOK, s)
+		streamStore.snappy = hasCapability(hello.Capabilities, CapabilitySnappy)
+		streamStore.stats.handshake(hello.ClientVersion, version, hello.Capabilities)
 		node.stream.Store(key, streamStore)
 		node.streamCache.Insert(streamStore)
 		node.routeTable.Update(pid)
+		ns.sendSubscriptions(streamStore)
 		result = true
 		return result
 	}
@@ -424,9 +503,32 @@ func (ns *NetService) handleOkMsg(data []byte, pid peer.ID, s libnet.Stream, add
 		logging.VLog().Error("handle ok msg occurs error: ", err)
 		return result
 	}
+	if !node.verifyNetworkProof(pid, ok.NetworkProof) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   pid.Pretty(),
+			"addrs": addrs.String(),
+			"err":   ErrNetworkTokenMismatch,
+		}).Warn("Refused a peer out of the private network.")
+		return result
+	}
+
+	version, err := negotiateProtocolVersion(ok.ClientVersion, ok.ProtocolVersion, ok.MinProtocolVersion)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":                pid.Pretty(),
+			"clientVersion":      ok.ClientVersion,
+			"protocolVersion":    ok.ProtocolVersion,
+			"minProtocolVersion": ok.MinProtocolVersion,
+			"err":                err,
+		}).Warn("Refused a peer of an incompatible protocol.")
+		return result
+	}
 
-	if ok.NodeID == pid.String() && ok.ClientVersion == ClientVersion {
+	if ok.NodeID == pid.String() {
 		streamStore := NewStreamStore(key, SOK, s)
+		streamStore.outbound = true
+		streamStore.snappy = hasCapability(ok.Capabilities, CapabilitySnappy)
+		streamStore.stats.handshake(ok.ClientVersion, version, ok.Capabilities)
 		node.stream.Store(key, streamStore)
 		node.streamCache.Insert(streamStore)
 		node.peerstore.AddAddr(
@@ -434,7 +536,9 @@ func (ns *NetService) handleOkMsg(data []byte, pid peer.ID, s libnet.Stream, add
 			addrs,
 			peerstore.PermanentAddrTTL,
 		)
+		ns.addAdvertisedAddrs(pid, ok.Addrs)
 		node.routeTable.Update(pid)
+		ns.sendSubscriptions(streamStore)
 
 		result = true
 		return result
@@ -464,13 +568,7 @@ func (ns *NetService) handleReNetworkIDMsg(data []byte, pid peer.ID) {
 }
 
 func (ns *NetService) handleNewHashMsg(data []byte, pid peer.ID) {
-	var relayness []peer.ID
-	node := ns.node
-	peers, exists := node.relayness.Get(byteutils.Uint32(data))
-	if exists {
-		relayness = peers.([]peer.ID)
-	}
-	node.relayness.Add(byteutils.Uint32(data), append(relayness, pid))
+	ns.node.seen.Announce(byteutils.Uint32(data), pid)
 }
 
 func (ns *NetService) handleSyncRouteMsg(data []byte, pid peer.ID, s libnet.Stream, addrs ma.Multiaddr, key string) bool {
@@ -605,6 +703,8 @@ func (ns *NetService) Bye(pid peer.ID, addrs []ma.Multiaddr, s libnet.Stream, ke
 	node := ns.node
 	ns.clearPeerStore(pid, addrs)
 	node.stream.Delete(key)
+	node.uploadLimiter.Remove(key)
+	node.downloadLimiter.Remove(key)
 	s.Close()
 }
 
@@ -619,21 +719,31 @@ func (ns *NetService) clearPeerStore(pid peer.ID, addrs []ma.Multiaddr) {
 // SendMsg send message to a peer
 func (ns *NetService) sendMsg(msgName string, msg []byte, stream libnet.Stream) error {
 
+	return ns.writeData(msgName, ns.buildData(msg, msgName), len(msg), stream)
+}
+
+func (ns *NetService) writeData(msgName string, totalData []byte, dataLength int, stream libnet.Stream) error {
 	logging.VLog().WithFields(logrus.Fields{
 		"msgName": msgName,
 	}).Info("SendMsg: send message to a peer.")
-	totalData := ns.buildData(msg, msgName)
-
+	key := stream.Conn().RemotePeer().Pretty()
+	// the urgent messages don't queue behind the bulk transfers, the ones after them wait for their bytes.
+	if isUrgentMessage(msgName) {
+		ns.node.uploadLimiter.Take(key, len(totalData))
+	} else {
+		ns.node.uploadLimiter.Wait(key, len(totalData))
+	}
 	if err := Write(stream, totalData); err != nil {
 		logging.VLog().Error("SendMsg: write data occurs error, ", err)
 		return err
 	}
+	ns.countBytes(key, 0, len(totalData))
 	packetsOut.Mark(1)
 	m, ok := net.PacketsOutByTypes.Load(msgName)
 	if ok {
 		m.(metrics.Meter).Mark(1)
 	}
-	netBytesOut.Mark(int64(len(msg)))
+	netBytesOut.Mark(int64(dataLength))
 	return nil
 }
 
@@ -649,7 +759,15 @@ func (ns *NetService) SendMsg(msgName string, msg []byte, target string) error {
 	if !ok {
 		return errors.New("handleSyncRouteMsg occrus error, stream does not exist")
 	}
-	return ns.sendMsg(msgName, msg, streamStore.(*StreamStore).stream)
+	ss := streamStore.(*StreamStore)
+	if ss.conn == SOK && !ss.supportsMessage(msgName) {
+		return ErrUnsupportedMessage
+	}
+	if data, compressed := ns.compress(msgName, msg, ss); compressed {
+		totalData := ns.buildPacket(data, crc32.ChecksumIEEE(msg), msgName, []byte{flagCompressed})
+		return ns.writeData(msgName, totalData, len(data), ss.stream)
+	}
+	return ns.sendMsg(msgName, msg, ss.stream)
 }
 
 func (ns *NetService) checkNetworkID(target string) bool {
@@ -670,6 +788,18 @@ func (ns *NetService) checkNetworkID(target string) bool {
 // Hello say hello to a peer
 func (ns *NetService) Hello(pid peer.ID) error {
 	node := ns.node
+	if node.reputation.IsBanned(pid.Pretty()) {
+		return ErrPeerBanned
+	}
+	if err := node.acceptOutbound(pid.Pretty()); err != nil {
+		return err
+	}
+	if err := node.filterPeerAddrs(pid); err != nil {
+		return err
+	}
+	if err := node.filterDiverseAddrs(pid); err != nil {
+		return err
+	}
 
 	stream, err := node.host.NewStream(
 		node.context,
@@ -679,8 +809,17 @@ func (ns *NetService) Hello(pid peer.ID) error {
 	if err != nil {
 		return err
 	}
+	// the stream dialed is checked before the hello is sent on it, like the ones accepted.
+	if err := verifyTransport(stream); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid": pid.Pretty(),
+			"err": err,
+		}).Warn("Refused an insecure connection.")
+		stream.Close()
+		return err
+	}
 
-	hello := messages.NewHelloMessage(node.id.String(), ClientVersion)
+	hello := ns.newHelloMessage(pid)
 	pb, _ := hello.ToProto()
 	data, err := proto.Marshal(pb)
 	if err != nil {
@@ -734,9 +873,11 @@ func buildHeader(chainID uint32, msgName string, version byte, dataLength uint32
 }
 
 func (ns *NetService) buildData(data []byte, msgName string) []byte {
+	return ns.buildPacket(data, crc32.ChecksumIEEE(data), msgName, []byte{0})
+}
+
+func (ns *NetService) buildPacket(data []byte, dataChecksum uint32, msgName string, reserved []byte) []byte {
 	node := ns.node
-	dataChecksum := crc32.ChecksumIEEE(data)
-	reserved := []byte{0}
 	metaHeader := buildHeader(node.config.ChainID, msgName, node.version, uint32(len(data)), dataChecksum, reserved)
 	headerChecksum := crc32.ChecksumIEEE(metaHeader)
 	metaHeader = append(metaHeader[:], byteutils.FromUint32(headerChecksum)...)
@@ -758,6 +899,7 @@ func (ns *NetService) Start() error {
 
 // Stop stop p2p manager.
 func (ns *NetService) Stop() {
+	ns.saveKnownPeers()
 	ns.dispatcher.Stop()
 	ns.quitCh <- true
 }
@@ -790,11 +932,19 @@ func (ns *NetService) start() error {
 	node.running = true
 
 	ns.registerNetManager()
+	ns.resolveDNSSeeds()
+
+	// the known peers of the last run are tried first, the seeds only when none of them answers.
+	ns.loadKnownPeers()
+	known := ns.connectKnownPeers()
 
 	// TODO: All fail handle
 	var success bool
 	var wg sync.WaitGroup
 	for _, bootNode := range node.config.BootNodes {
+		if known > 0 {
+			break
+		}
 		wg.Add(1)
 		go func(bootNode ma.Multiaddr) {
 			defer wg.Done()
@@ -810,9 +960,10 @@ func (ns *NetService) start() error {
 	}
 	wg.Wait()
 
-	if success || len(node.Config().BootNodes) == 0 {
+	if success || known > 0 || len(node.Config().BootNodes) == 0 {
 		go ns.discovery(node.context)
 		go ns.manageStreamStore()
+		go ns.waitNATMapping()
 		logging.CLog().Infof("net.start: node start and join to p2p network success and listening for connections on %s... ", node.config.Listen)
 	} else {
 		logging.VLog().Error("net.start: node start occurs error, say hello to bootNode fail")
@@ -822,6 +973,7 @@ func (ns *NetService) start() error {
 }
 
 func (ns *NetService) manageStreamStore() {
+	node := ns.node
 	second := 30 * time.Second
 	ticker := time.NewTicker(second)
 	for {
@@ -829,6 +981,15 @@ func (ns *NetService) manageStreamStore() {
 		case <-ticker.C:
 			ns.clearStreamStore()
 			ns.cleanPeerStore()
+			ns.checkPeerTimeouts()
+			ns.connectStaticPeers()
+			ns.pingPeers()
+			ns.maintainMeshes()
+			ns.rotatePeers()
+			ns.exchangePeers()
+			node.seen.Expire()
+		case <-node.staticPeerCh:
+			ns.connectStaticPeers()
 		case <-ns.quitCh:
 			return
 		}
@@ -848,17 +1009,31 @@ func (ns *NetService) cleanPeerStore() {
 
 func (ns *NetService) clearStreamStore() {
 	node := ns.node
-	// do clear streamStore only when the count of stream in cache exceed the cache size.
-	if ns.node.streamCache.Len() > ns.node.config.StreamStoreSize {
-		overflowSize := ns.node.streamCache.Len() - ns.node.config.StreamStoreSize
-		for i := 0; i < overflowSize; i++ {
-			streamStore := node.streamCache.PopMin().(*StreamStore)
-			key := streamStore.key
-
-			if streamStore, ok := node.stream.Load(key); ok {
-				streamStore.(*StreamStore).stream.Close()
-				node.stream.Delete(key)
-			}
+	// drop the oldest inbound peers over the limit, the outbound and trusted ones are kept.
+	inbound, _ := node.peerCounts()
+	overflowSize := inbound - node.config.MaxInboundPeers
+	if overflowSize <= 0 {
+		return
+	}
+
+	var kept []*StreamStore
+	defer func() {
+		for _, v := range kept {
+			node.streamCache.Insert(v)
+		}
+	}()
+	for overflowSize > 0 && node.streamCache.Len() > 0 {
+		streamStore := node.streamCache.PopMin().(*StreamStore)
+		key := streamStore.key
+		if streamStore.outbound || node.IsTrustedPeer(key) {
+			kept = append(kept, streamStore)
+			continue
+		}
+		overflowSize--
+
+		if streamStore, ok := node.stream.Load(key); ok {
+			streamStore.(*StreamStore).stream.Close()
+			node.stream.Delete(key)
 		}
 	}
 }
@@ -907,7 +1082,7 @@ func (ns *NetService) SayHello(bootNode ma.Multiaddr) error {
 		bootAddr,
 		peerstore.ProviderAddrTTL,
 	)
-	if node.host.Addrs()[0].String() != bootAddr.String() {
+	if !node.isSelfAddr(bootAddr) {
 		if err := ns.Hello(bootID); err != nil {
 			logging.VLog().WithFields(logrus.Fields{
 				"bootNode": bootNode,
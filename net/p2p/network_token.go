@@ -0,0 +1,54 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// errors
+var (
+	ErrNetworkTokenMismatch = errors.New("peer doesn't know the token of the private network")
+)
+
+// networkProof return the proof sent from the node to the peer that it knows the network token,
+// bound to both ids so it can't be replayed to another node. nil if the node is in the public network.
+func (node *Node) networkProof(from peer.ID, to peer.ID) []byte {
+	if len(node.config.NetworkToken) == 0 {
+		return nil
+	}
+	mac := hmac.New(sha256.New, []byte(node.config.NetworkToken))
+	mac.Write([]byte(from))
+	mac.Write([]byte(to))
+	return mac.Sum(nil)
+}
+
+// verifyNetworkProof return if the peer is in the same network as the node,
+// the public one with no proof, or the private one of the same token.
+func (node *Node) verifyNetworkProof(pid peer.ID, proof []byte) bool {
+	expected := node.networkProof(pid, node.id)
+	if expected == nil {
+		return len(proof) == 0
+	}
+	return hmac.Equal(expected, proof)
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkProof(t *testing.T) {
+	a, b, c := testPeerID(t), testPeerID(t), testPeerID(t)
+	nodeA := &Node{id: a, config: &Config{NetworkToken: "token"}}
+	nodeB := &Node{id: b, config: &Config{NetworkToken: "token"}}
+
+	assert.True(t, nodeB.verifyNetworkProof(a, nodeA.networkProof(a, b)))
+	// the proof is bound to both the ids.
+	assert.False(t, nodeB.verifyNetworkProof(c, nodeA.networkProof(a, b)))
+	assert.False(t, nodeB.verifyNetworkProof(a, nodeA.networkProof(a, c)))
+	assert.False(t, nodeB.verifyNetworkProof(a, nil))
+
+	// the nodes of another token or of the public network are refused, both ways.
+	other := &Node{id: a, config: &Config{NetworkToken: "other"}}
+	assert.False(t, nodeB.verifyNetworkProof(a, other.networkProof(a, b)))
+	public := &Node{id: a, config: &Config{}}
+	assert.Nil(t, public.networkProof(a, b))
+	assert.False(t, public.verifyNetworkProof(b, nodeB.networkProof(b, a)))
+	assert.True(t, public.verifyNetworkProof(b, nil))
+}
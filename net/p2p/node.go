@@ -24,7 +24,6 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
-	"fmt"
 	"io/ioutil"
 	mrand "math/rand"
 	"net"
@@ -68,10 +67,31 @@ type Node struct {
 	running       bool
 	synchronizing bool
 	syncList      []string
-	// key: datachecksum value: peer.ID
-	relayness      *lru.Cache
+	// the peers known to have a message, by message type and data checksum.
+	seen           *SeenCache
 	bootIds        []string
 	networkIDCache *lru.Cache
+	reputation     *Reputation
+	natManager     basichost.NATManager
+	// key: peer.ID, value: multiaddr
+	staticPeers  *sync.Map
+	trustedPeers *sync.Map
+	staticPeerCh chan bool
+
+	uploadLimiter   *RateLimiter
+	downloadLimiter *RateLimiter
+
+	gossip *gossip
+
+	knownPeers *knownPeers
+
+	ipFilter *IPFilter
+
+	asMap        *ASMap
+	lastRotation time.Time
+
+	pexBook *pexBook
+	lastPex time.Time
 }
 
 // StreamStore is for stream cache
@@ -80,6 +100,11 @@ type StreamStore struct {
 	conn      int
 	stream    libnet.Stream
 	timestamp int64
+	// the peer accepts the data compressed by snappy.
+	snappy bool
+	stats  *peerStats
+	// the connection was dialed by the node.
+	outbound bool
 }
 
 func less(a interface{}, b interface{}) bool {
@@ -90,7 +115,13 @@ func less(a interface{}, b interface{}) bool {
 
 // NewStreamStore return a new streamStore
 func NewStreamStore(key string, conn int, stream libnet.Stream) *StreamStore {
-	return &StreamStore{key, conn, stream, time.Now().Unix()}
+	return &StreamStore{
+		key:       key,
+		conn:      conn,
+		stream:    stream,
+		timestamp: time.Now().Unix(),
+		stats:     new(peerStats),
+	}
 }
 
 // NewNode start a local node and join the node to network
@@ -136,6 +167,11 @@ func (node *Node) SetSynchronizing(synchronizing bool) {
 	node.synchronizing = synchronizing
 }
 
+// PeerScores return the scores of the penalized peers.
+func (node *Node) PeerScores() []*PeerScore {
+	return node.reputation.Scores()
+}
+
 // GetStream return node stream.
 func (node *Node) GetStream() *sync.Map {
 	return node.stream
@@ -171,6 +207,7 @@ func (node *Node) generatePeerStore() error {
 		if len(node.Config().BootNodes) == 0 {
 			// seednode
 			randseedstr = letterBytes
+			logging.CLog().Warn("The seed node uses the well-known key, its identity can be spoofed. Set private_key on untrusted networks.")
 		} else {
 			randseedstr = randSeed(64)
 		}
@@ -235,34 +272,93 @@ func (node *Node) init() error {
 
 	var multiaddrs []multiaddr.Multiaddr
 	for _, v := range node.config.Listen {
-		tcpAddr, err := net.ResolveTCPAddr("tcp", v)
+		address, err := listenMultiaddr(v)
 		if err != nil {
 			return err
 		}
-
-		address, err := multiaddr.NewMultiaddr(
-			fmt.Sprintf(
-				"/ip4/%s/tcp/%d",
-				tcpAddr.IP,
-				tcpAddr.Port,
-			),
-		)
 		multiaddrs = append(multiaddrs, address)
 	}
+	// the quic connections would bypass the proxy, which only carries tcp.
+	quicEnabled := node.config.QUIC && len(node.config.Proxy) == 0
+	if node.config.QUIC && !quicEnabled {
+		logging.VLog().Warn("QUIC is disabled behind the proxy.")
+	}
+	if quicEnabled {
+		for _, v := range node.config.Listen {
+			address, err := quicListenMultiaddr(v)
+			if err != nil {
+				return err
+			}
+			multiaddrs = append(multiaddrs, address)
+		}
+	}
 
+	// listen after the proxy transport is added, so its dialer is picked before the tcp one.
 	network, err := swarm.NewNetwork(
 		ctx,
-		multiaddrs,
+		nil,
 		node.id,
 		node.peerstore,
 		nil,
 	)
-	node.relayness, err = lru.New(node.config.RelayCacheSize)
+	if err != nil {
+		return err
+	}
+	if len(node.config.Proxy) > 0 {
+		transport, err := newProxyTransport(node.config.Proxy)
+		if err != nil {
+			return err
+		}
+		if err := network.Swarm().AddTransport(transport); err != nil {
+			return err
+		}
+	}
+	if quicEnabled {
+		transport, err := newQUICTransport()
+		if err != nil {
+			return err
+		}
+		if err := network.Swarm().AddTransport(transport); err != nil {
+			return err
+		}
+	}
+	if err := network.Listen(multiaddrs...); err != nil {
+		return err
+	}
+	node.seen = NewSeenCache(node.config.RelayCacheSize, node.config.RelayCacheTTL)
 	node.networkIDCache, err = lru.New(node.config.StreamStoreSize)
+	node.reputation = NewReputation(node.config.BanScore, node.config.BanDuration)
+
+	node.uploadLimiter = NewRateLimiter(node.config.MaxUploadRate, node.config.PeerUploadRate)
+	node.downloadLimiter = NewRateLimiter(node.config.MaxDownloadRate, node.config.PeerDownloadRate)
+
+	node.gossip = newGossip()
+	node.knownPeers = newKnownPeers()
+	if node.ipFilter, err = NewIPFilter(node.config.AllowedIPs, node.config.DeniedIPs); err != nil {
+		return err
+	}
+
+	if len(node.config.ASMap) > 0 {
+		if node.asMap, err = LoadASMap(node.config.ASMap); err != nil {
+			return err
+		}
+	}
+	node.lastRotation = time.Now()
+	node.pexBook = newPexBook()
+
+	node.staticPeers = new(sync.Map)
+	node.trustedPeers = new(sync.Map)
+	node.staticPeerCh = make(chan bool, 1)
+	if err := node.initConfiguredPeers(); err != nil {
+		return err
+	}
 
 	options := &basichost.HostOpts{}
-	// add nat manager
-	options.NATManager = basichost.NewNATManager(network)
+	if node.config.EnableNAT {
+		// add nat manager, it maps the listen ports on the router by UPnP or NAT-PMP.
+		node.natManager = basichost.NewNATManager(network)
+		options.NATManager = node.natManager
+	}
 	node.host, err = basichost.NewHost(node.context, network, options)
 	return err
 }
@@ -0,0 +1,199 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	libnet "github.com/libp2p/go-libp2p-net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// const
+const (
+	Ping = "ping"
+	Pong = "pong"
+
+	// CapabilityPing is the capability of a node answering the ping messages.
+	CapabilityPing = "ping"
+)
+
+var (
+	peerCountGauge   = metrics.GetOrRegisterGauge("neb.net.peer.count", nil)
+	peerLatencyTimer = metrics.GetOrRegisterTimer("neb.net.peer.latency", nil)
+)
+
+// peerStats is the statistics of a connection.
+type peerStats struct {
+	mu            sync.Mutex
+	clientVersion string
+	// the protocol version negotiated in the handshake.
+	protocolVersion uint32
+	capabilities    []string
+	// the gossip topics the peer subscribes to, nil until it tells.
+	topics     map[string]bool
+	headHeight uint64
+	headHash   string
+	latency    time.Duration
+	bytesIn    int64
+	bytesOut   int64
+}
+
+// PeerStats is the statistics of a connected peer.
+type PeerStats struct {
+	ID              string
+	Addr            string
+	ClientVersion   string
+	ProtocolVersion uint32
+	ConnectedAt     time.Time
+	HeadHeight      uint64
+	HeadHash        string
+	Latency         time.Duration
+	BytesIn         int64
+	BytesOut        int64
+	Outbound        bool
+	// the score of a penalized peer, nil if it is not.
+	Score *PeerScore
+}
+
+func (ps *peerStats) handshake(clientVersion string, protocolVersion uint32, capabilities []string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.clientVersion = clientVersion
+	ps.protocolVersion = protocolVersion
+	ps.capabilities = capabilities
+}
+
+func (ps *peerStats) addBytes(in int, out int) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.bytesIn += int64(in)
+	ps.bytesOut += int64(out)
+}
+
+// PeerStats return the statistics of the connected peers, the longest connected first.
+func (node *Node) PeerStats() []*PeerStats {
+	scores := make(map[string]*PeerScore)
+	for _, v := range node.reputation.Scores() {
+		scores[v.ID] = v
+	}
+
+	var result []*PeerStats
+	node.stream.Range(func(k, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		if streamStore.conn != SOK {
+			return true
+		}
+		ps := streamStore.stats
+		ps.mu.Lock()
+		stats := &PeerStats{
+			ID:              k.(string),
+			Addr:            streamStore.stream.Conn().RemoteMultiaddr().String(),
+			ClientVersion:   ps.clientVersion,
+			ProtocolVersion: ps.protocolVersion,
+			ConnectedAt:     time.Unix(streamStore.timestamp, 0),
+			HeadHeight:      ps.headHeight,
+			HeadHash:        ps.headHash,
+			Latency:         ps.latency,
+			BytesIn:         ps.bytesIn,
+			BytesOut:        ps.bytesOut,
+			Outbound:        streamStore.outbound,
+			Score:           scores[k.(string)],
+		}
+		ps.mu.Unlock()
+		result = append(result, stats)
+		return true
+	})
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ConnectedAt.Before(result[j].ConnectedAt)
+	})
+	return result
+}
+
+// UpdatePeerHead record the latest block a peer sent.
+func (ns *NetService) UpdatePeerHead(id string, height uint64, hash string) {
+	streamStore, ok := ns.node.stream.Load(id)
+	if !ok {
+		return
+	}
+	ps := streamStore.(*StreamStore).stats
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if height >= ps.headHeight {
+		ps.headHeight = height
+		ps.headHash = hash
+	}
+}
+
+func (ns *NetService) countBytes(id string, in int, out int) {
+	if streamStore, ok := ns.node.stream.Load(id); ok {
+		streamStore.(*StreamStore).stats.addBytes(in, out)
+	}
+}
+
+// pingPeers send a ping to the peers answering it, to measure their latency.
+func (ns *NetService) pingPeers() {
+	node := ns.node
+	count := 0
+	node.stream.Range(func(_, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		if streamStore.conn != SOK {
+			return true
+		}
+		count++
+
+		ps := streamStore.stats
+		ps.mu.Lock()
+		ping := hasCapability(ps.capabilities, CapabilityPing)
+		ps.mu.Unlock()
+		if ping {
+			go ns.sendMsg(Ping, byteutils.FromInt64(time.Now().UnixNano()), streamStore.stream)
+		}
+		return true
+	})
+	peerCountGauge.Update(int64(count))
+}
+
+func (ns *NetService) handlePingMsg(data []byte, s libnet.Stream) {
+	if err := ns.sendMsg(Pong, data, s); err != nil {
+		logging.VLog().Debug("send pong msg occurs error, ", err)
+	}
+}
+
+func (ns *NetService) handlePongMsg(data []byte, key string) {
+	if len(data) != 8 {
+		return
+	}
+	latency := time.Since(time.Unix(0, byteutils.Int64(data)))
+	if latency < 0 {
+		return
+	}
+	peerLatencyTimer.Update(latency)
+
+	if streamStore, ok := ns.node.stream.Load(key); ok {
+		ps := streamStore.(*StreamStore).stats
+		ps.mu.Lock()
+		ps.latency = latency
+		ps.mu.Unlock()
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	netpb "github.com/nebulasio/go-nebulas/net/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// PexRequest is the message asking a peer for a sample of its known-good peers.
+	PexRequest = "pexrequest"
+	// PexReply is the message answering it with the signed records of the peers.
+	PexReply = "pexreply"
+
+	// CapabilityPex is the capability of a node exchanging the peer records.
+	CapabilityPex = "pex"
+
+	// PexInterval is the time between two exchanges with a random peer.
+	PexInterval = 5 * time.Minute
+	// PexSampleSize is the most records of other peers in a reply.
+	PexSampleSize = 32
+	// MaxPexRecords is the number of records kept.
+	MaxPexRecords = 1024
+	// MaxPexRecordAge is the age after which a record is neither trusted nor shared.
+	MaxPexRecordAge = 24 * time.Hour
+	// MaxPexClockDrift is how far in the future a record may be signed by a drifted clock.
+	MaxPexClockDrift = 5 * time.Minute
+	// pexDials is the most peers of a reply dialed at once.
+	pexDials = 4
+)
+
+// errors
+var (
+	ErrInvalidPeerRecord = errors.New("invalid signature of the peer record")
+	ErrStalePeerRecord   = errors.New("peer record is too old or signed in the future")
+)
+
+// Metrics of the peer exchange
+var (
+	pexReceivedCounter = metrics.GetOrRegisterCounter("neb.net.pex.received", nil)
+	pexInvalidCounter  = metrics.GetOrRegisterCounter("neb.net.pex.invalid", nil)
+)
+
+// pexBook keeps the verified records of the peers, by peer id.
+type pexBook struct {
+	mu      sync.Mutex
+	records map[string]*netpb.PeerRecord
+}
+
+func newPexBook() *pexBook {
+	return &pexBook{records: make(map[string]*netpb.PeerRecord)}
+}
+
+// add keep the record if it is newer than the one of the peer kept, the oldest records are dropped when full.
+func (pb *pexBook) add(record *netpb.PeerRecord) {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+
+	if kept, ok := pb.records[record.Id]; ok && kept.Timestamp >= record.Timestamp {
+		return
+	}
+	pb.records[record.Id] = record
+	for len(pb.records) > MaxPexRecords {
+		var oldest *netpb.PeerRecord
+		for _, v := range pb.records {
+			if oldest == nil || v.Timestamp < oldest.Timestamp {
+				oldest = v
+			}
+		}
+		delete(pb.records, oldest.Id)
+	}
+}
+
+// sample return at most n records younger than MaxPexRecordAge picked at random among the ones accepted.
+func (pb *pexBook) sample(n int, now time.Time, accept func(id string) bool) []*netpb.PeerRecord {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+
+	var fresh []*netpb.PeerRecord
+	for id, v := range pb.records {
+		if now.Sub(time.Unix(v.Timestamp, 0)) > MaxPexRecordAge {
+			delete(pb.records, id)
+			continue
+		}
+		if accept(id) {
+			fresh = append(fresh, v)
+		}
+	}
+	if len(fresh) <= n {
+		return fresh
+	}
+	result := make([]*netpb.PeerRecord, n)
+	for i, j := range rand.Perm(len(fresh))[:n] {
+		result[i] = fresh[j]
+	}
+	return result
+}
+
+func peerRecordDigest(id string, addrs []string, timestamp int64) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(id))
+	for _, v := range addrs {
+		hasher.Write([]byte(v))
+	}
+	hasher.Write(byteutils.FromInt64(timestamp))
+	return hasher.Sum(nil)
+}
+
+// signedRecord return the record of the node's advertised addresses, signed with its key.
+func (node *Node) signedRecord(now time.Time) (*netpb.PeerRecord, error) {
+	priv := node.peerstore.PrivKey(node.id)
+	pub, err := crypto.MarshalPublicKey(priv.GetPublic())
+	if err != nil {
+		return nil, err
+	}
+	record := &netpb.PeerRecord{
+		Id:        node.id.Pretty(),
+		Timestamp: now.Unix(),
+		PublicKey: pub,
+	}
+	for _, addr := range node.AdvertisedAddrs() {
+		record.Addrs = append(record.Addrs, addr.String())
+	}
+	if record.Signature, err = priv.Sign(peerRecordDigest(record.Id, record.Addrs, record.Timestamp)); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// verifyPeerRecord check the record is signed by the key of its peer id, and neither too old nor in the future.
+func verifyPeerRecord(record *netpb.PeerRecord, now time.Time) error {
+	signed := time.Unix(record.Timestamp, 0)
+	if now.Sub(signed) > MaxPexRecordAge || signed.Sub(now) > MaxPexClockDrift {
+		return ErrStalePeerRecord
+	}
+	id, err := peer.IDB58Decode(record.Id)
+	if err != nil {
+		return ErrInvalidPeerRecord
+	}
+	pub, err := crypto.UnmarshalPublicKey(record.PublicKey)
+	if err != nil || !id.MatchesPublicKey(pub) {
+		return ErrInvalidPeerRecord
+	}
+	ok, err := pub.Verify(peerRecordDigest(record.Id, record.Addrs, record.Timestamp), record.Signature)
+	if err != nil || !ok {
+		return ErrInvalidPeerRecord
+	}
+	return nil
+}
+
+// exchangePeers ask a random peer exchanging the records for its known-good peers, every PexInterval.
+func (ns *NetService) exchangePeers() {
+	node := ns.node
+	if time.Since(node.lastPex) < PexInterval {
+		return
+	}
+	node.lastPex = time.Now()
+
+	var candidates []string
+	node.stream.Range(func(k, v interface{}) bool {
+		streamStore := v.(*StreamStore)
+		if streamStore.conn != SOK {
+			return true
+		}
+		if streamStore.stats.supports(CapabilityPex) {
+			candidates = append(candidates, k.(string))
+		}
+		return true
+	})
+	if len(candidates) == 0 {
+		return
+	}
+	data, err := proto.Marshal(&netpb.PexRequest{Max: PexSampleSize})
+	if err != nil {
+		return
+	}
+	ns.SendMsg(PexRequest, data, candidates[rand.Intn(len(candidates))])
+}
+
+// isGoodPeer return if the records of the peer are shared: it is connected or in the address book,
+// and not half way to a ban.
+func (node *Node) isGoodPeer(id string) bool {
+	if node.reputation.Score(id) <= -node.config.BanScore/2 {
+		return false
+	}
+	return node.isConnected(id) || node.knownPeers.contains(id)
+}
+
+func (ns *NetService) handlePexRequestMsg(data []byte, key string) {
+	node := ns.node
+	if !node.isConnected(key) {
+		return
+	}
+	req := new(netpb.PexRequest)
+	if err := proto.Unmarshal(data, req); err != nil {
+		ns.ReportPeer(key, InvalidMessage)
+		return
+	}
+	max := int(req.Max)
+	if max > PexSampleSize {
+		max = PexSampleSize
+	}
+
+	now := time.Now()
+	self, err := node.signedRecord(now)
+	if err != nil {
+		return
+	}
+	reply := &netpb.PexReply{Records: []*netpb.PeerRecord{self}}
+	reply.Records = append(reply.Records, node.pexBook.sample(max, now, func(id string) bool {
+		return id != key && node.isGoodPeer(id)
+	})...)
+	if data, err = proto.Marshal(reply); err != nil {
+		return
+	}
+	ns.SendMsg(PexReply, data, key)
+}
+
+func (ns *NetService) handlePexReplyMsg(data []byte, key string) {
+	node := ns.node
+	if !node.isConnected(key) {
+		return
+	}
+	reply := new(netpb.PexReply)
+	if err := proto.Unmarshal(data, reply); err != nil {
+		ns.ReportPeer(key, InvalidMessage)
+		return
+	}
+	if len(reply.Records) > PexSampleSize+1 {
+		ns.ReportPeer(key, ProtocolViolation)
+		return
+	}
+
+	now := time.Now()
+	dials := 0
+	for _, record := range reply.Records {
+		if err := verifyPeerRecord(record, now); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"pid":    key,
+				"record": record.Id,
+				"err":    err,
+			}).Debug("Received an invalid peer record.")
+			pexInvalidCounter.Inc(1)
+			// the records are verified before they are shared, an invalid one is the fault of the sender.
+			ns.ReportPeer(key, InvalidMessage)
+			return
+		}
+		pexReceivedCounter.Inc(1)
+		if record.Id == node.id.Pretty() {
+			continue
+		}
+
+		id, _ := peer.IDB58Decode(record.Id)
+		var addrs []ma.Multiaddr
+		for _, v := range record.Addrs {
+			addr, err := ma.NewMultiaddr(v)
+			if err == nil && isDialable(addr) && node.ipFilter.AllowedAddr(addr) {
+				addrs = append(addrs, addr)
+			}
+		}
+		if len(addrs) == 0 {
+			continue
+		}
+		node.pexBook.add(record)
+		if node.isConnected(record.Id) || node.reputation.IsBanned(record.Id) {
+			continue
+		}
+		node.peerstore.AddAddrs(id, addrs, peerstore.ProviderAddrTTL)
+		if dials < pexDials && node.acceptOutbound(record.Id) == nil {
+			dials++
+			go ns.helloPexPeer(id)
+		}
+	}
+}
+
+func (ns *NetService) helloPexPeer(id peer.ID) {
+	if err := ns.Hello(id); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid": id.Pretty(),
+			"err": err,
+		}).Debug("Failed to say hello to a peer of the peer exchange.")
+		return
+	}
+	ns.node.routeTable.Update(id)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"sync"
+)
+
+// Protocol versions
+const (
+	// ProtocolVersion is the latest protocol iteration the node speaks.
+	ProtocolVersion uint32 = 2
+	// MinProtocolVersion is the oldest protocol iteration the node still speaks.
+	MinProtocolVersion uint32 = 1
+	// LegacyProtocolVersion is the version of the peers not telling it, the ones with the ClientVersion.
+	LegacyProtocolVersion uint32 = 1
+)
+
+// errors
+var (
+	ErrIncompatibleProtocol = errors.New("no protocol version in common with the peer")
+	ErrUnsupportedMessage   = errors.New("message is not in the protocol version of the peer")
+)
+
+// key: message name, value: the protocol version introducing it.
+var messageVersions = new(sync.Map)
+
+// RegisterMessageVersion declare the protocol version introducing the message,
+// it is only sent to the peers negotiated that version or a later one.
+func RegisterMessageVersion(name string, version uint32) {
+	messageVersions.Store(name, version)
+}
+
+func init() {
+	// the messages of the version 2.
+	for _, name := range []string{Ping, Pong, Subscribe} {
+		RegisterMessageVersion(name, 2)
+	}
+}
+
+func messageVersion(name string) uint32 {
+	if v, ok := messageVersions.Load(name); ok {
+		return v.(uint32)
+	}
+	return LegacyProtocolVersion
+}
+
+// negotiateProtocolVersion return the latest protocol version both the node and the peer speak.
+func negotiateProtocolVersion(clientVersion string, version uint32, minVersion uint32) (uint32, error) {
+	if version == 0 {
+		// the legacy peers only connect to the same client version.
+		if clientVersion != ClientVersion {
+			return 0, ErrIncompatibleProtocol
+		}
+		version, minVersion = LegacyProtocolVersion, LegacyProtocolVersion
+	}
+	negotiated := version
+	if negotiated > ProtocolVersion {
+		negotiated = ProtocolVersion
+	}
+	if negotiated < MinProtocolVersion || negotiated < minVersion {
+		return 0, ErrIncompatibleProtocol
+	}
+	return negotiated, nil
+}
+
+// supportsMessage return if the message is in the protocol version negotiated with the peer.
+func (ss *StreamStore) supportsMessage(name string) bool {
+	ss.stats.mu.Lock()
+	defer ss.stats.mu.Unlock()
+	return messageVersion(name) <= ss.stats.protocolVersion
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateProtocolVersion(t *testing.T) {
+	version, err := negotiateProtocolVersion(ClientVersion, ProtocolVersion, MinProtocolVersion)
+	assert.Nil(t, err)
+	assert.Equal(t, ProtocolVersion, version)
+
+	// a newer peer speaks the latest version of the node, an older one its own.
+	version, err = negotiateProtocolVersion("", ProtocolVersion+1, MinProtocolVersion)
+	assert.Nil(t, err)
+	assert.Equal(t, ProtocolVersion, version)
+	version, err = negotiateProtocolVersion("", MinProtocolVersion, MinProtocolVersion)
+	assert.Nil(t, err)
+	assert.Equal(t, MinProtocolVersion, version)
+
+	// a newer peer no longer speaking the version of the node.
+	_, err = negotiateProtocolVersion("", ProtocolVersion+2, ProtocolVersion+1)
+	assert.Equal(t, ErrIncompatibleProtocol, err)
+
+	// a legacy peer only if it's the same client version.
+	version, err = negotiateProtocolVersion(ClientVersion, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, LegacyProtocolVersion, version)
+	_, err = negotiateProtocolVersion("0.0.1", 0, 0)
+	assert.Equal(t, ErrIncompatibleProtocol, err)
+}
+
+func TestSupportsMessage(t *testing.T) {
+	ss := NewStreamStore("a", SOK, nil)
+	ss.stats.protocolVersion = LegacyProtocolVersion
+	assert.True(t, ss.supportsMessage(HELLO))
+	assert.False(t, ss.supportsMessage(Ping))
+	assert.False(t, ss.supportsMessage(Subscribe))
+
+	ss.stats.protocolVersion = 2
+	assert.True(t, ss.supportsMessage(HELLO))
+	assert.True(t, ss.supportsMessage(Ping))
+	assert.True(t, ss.supportsMessage(Subscribe))
+}
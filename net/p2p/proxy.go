@@ -0,0 +1,174 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+
+	tpt "github.com/libp2p/go-libp2p-transport"
+	ma "github.com/multiformats/go-multiaddr"
+	madns "github.com/multiformats/go-multiaddr-dns"
+	"golang.org/x/net/proxy"
+)
+
+// errors
+var (
+	ErrProxyListen        = errors.New("proxy transport can't listen")
+	ErrProxyUnsupportAddr = errors.New("address can't be dialed through the proxy")
+)
+
+// proxyTransport dials the outbound connections of tcp addresses through a SOCKS5 proxy,
+// the host names of the dns4 and dns6 addresses being resolved by the proxy.
+// The node still listens on its own transports.
+type proxyTransport struct {
+	dialer proxy.Dialer
+}
+
+// newProxyTransport create a transport dialing through the proxy of the url, e.g. "socks5://127.0.0.1:9050".
+func newProxyTransport(proxyURL string) (*proxyTransport, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	dialer, err := proxy.FromURL(u, proxy.Direct)
+	if err != nil {
+		return nil, err
+	}
+	return &proxyTransport{dialer: dialer}, nil
+}
+
+// Dialer return the dialer of the transport.
+func (t *proxyTransport) Dialer(laddr ma.Multiaddr, opts ...tpt.DialOpt) (tpt.Dialer, error) {
+	return &proxyDialer{transport: t}, nil
+}
+
+// Listen is not supported, the connections are only dialed through the proxy.
+func (t *proxyTransport) Listen(laddr ma.Multiaddr) (tpt.Listener, error) {
+	return nil, ErrProxyListen
+}
+
+// Matches return if the address can be dialed through the proxy.
+func (t *proxyTransport) Matches(addr ma.Multiaddr) bool {
+	_, err := proxyDialArgs(addr)
+	return err == nil
+}
+
+// proxyDialArgs return the "host:port" to ask the proxy for, the host name left unresolved.
+func proxyDialArgs(addr ma.Multiaddr) (string, error) {
+	port, err := addr.ValueForProtocol(ma.P_TCP)
+	if err != nil {
+		return "", ErrProxyUnsupportAddr
+	}
+	for _, code := range []int{ma.P_IP4, ma.P_IP6, madns.Dns4Protocol.Code, madns.Dns6Protocol.Code} {
+		if host, err := addr.ValueForProtocol(code); err == nil {
+			return net.JoinHostPort(host, port), nil
+		}
+	}
+	return "", ErrProxyUnsupportAddr
+}
+
+type proxyDialer struct {
+	transport *proxyTransport
+}
+
+// Dial dial the address through the proxy.
+func (d *proxyDialer) Dial(raddr ma.Multiaddr) (tpt.Conn, error) {
+	return d.DialContext(context.Background(), raddr)
+}
+
+// DialContext dial the address through the proxy, giving up when the context is done.
+func (d *proxyDialer) DialContext(ctx context.Context, raddr ma.Multiaddr) (tpt.Conn, error) {
+	address, err := proxyDialArgs(raddr)
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		conn, err := d.transport.dialer.Dial("tcp", address)
+		ch <- result{conn, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		// close the connection if it's made after all.
+		go func() {
+			if r := <-ch; r.conn != nil {
+				r.conn.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	case r := <-ch:
+		if r.err != nil {
+			return nil, r.err
+		}
+		return newProxyConn(r.conn, raddr, d.transport)
+	}
+}
+
+// Matches return if the address can be dialed through the proxy.
+func (d *proxyDialer) Matches(addr ma.Multiaddr) bool {
+	return d.transport.Matches(addr)
+}
+
+// proxyConn is a connection through the proxy, its remote address is the peer's rather than the proxy's.
+type proxyConn struct {
+	net.Conn
+	laddr     ma.Multiaddr
+	raddr     ma.Multiaddr
+	transport *proxyTransport
+}
+
+func newProxyConn(conn net.Conn, raddr ma.Multiaddr, transport *proxyTransport) (*proxyConn, error) {
+	host, port, err := net.SplitHostPort(conn.LocalAddr().String())
+	if err != nil {
+		return nil, err
+	}
+	proto := "ip4"
+	if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		proto = "ip6"
+	}
+	laddr, err := ma.NewMultiaddr("/" + proto + "/" + host + "/tcp/" + port)
+	if err != nil {
+		return nil, err
+	}
+	return &proxyConn{Conn: conn, laddr: laddr, raddr: raddr, transport: transport}, nil
+}
+
+// LocalMultiaddr return the local address of the connection to the proxy.
+func (c *proxyConn) LocalMultiaddr() ma.Multiaddr {
+	return c.laddr
+}
+
+// RemoteMultiaddr return the address of the peer dialed.
+func (c *proxyConn) RemoteMultiaddr() ma.Multiaddr {
+	return c.raddr
+}
+
+// Transport return the proxy transport.
+func (c *proxyConn) Transport() tpt.Transport {
+	return c.transport
+}
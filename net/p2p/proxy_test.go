@@ -0,0 +1,203 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSocks5 is a SOCKS5 proxy without authentication, connecting the host names it's asked for to the hosts
+// it resolves them to, and recording the addresses it's asked for.
+type testSocks5 struct {
+	listener net.Listener
+	hosts    map[string]string
+	requests chan string
+}
+
+func newTestSocks5(t *testing.T, hosts map[string]string) *testSocks5 {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	s := &testSocks5{listener: listener, hosts: hosts, requests: make(chan string, 16)}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *testSocks5) serve(conn net.Conn) {
+	defer conn.Close()
+	buf := make([]byte, 256)
+	// greeting: version, methods.
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return
+	}
+	if _, err := io.ReadFull(conn, buf[:buf[1]]); err != nil {
+		return
+	}
+	conn.Write([]byte{5, 0})
+
+	// request: version, connect, reserved, address type, address, port.
+	if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+		return
+	}
+	var host string
+	switch buf[3] {
+	case 1:
+		io.ReadFull(conn, buf[:4])
+		host = net.IP(buf[:4]).String()
+	case 3:
+		io.ReadFull(conn, buf[:1])
+		n := int(buf[0])
+		io.ReadFull(conn, buf[:n])
+		host = string(buf[:n])
+	case 4:
+		io.ReadFull(conn, buf[:16])
+		host = net.IP(buf[:16]).String()
+	}
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return
+	}
+	address := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(buf[:2]))))
+	s.requests <- address
+
+	target, ok := s.hosts[host]
+	if !ok {
+		// host unreachable.
+		conn.Write([]byte{5, 4, 0, 1, 0, 0, 0, 0, 0, 0})
+		return
+	}
+	remote, err := net.Dial("tcp", net.JoinHostPort(target, strconv.Itoa(int(binary.BigEndian.Uint16(buf[:2])))))
+	if err != nil {
+		conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+		return
+	}
+	defer remote.Close()
+	conn.Write([]byte{5, 0, 0, 1, 127, 0, 0, 1, 0, 0})
+	go io.Copy(remote, conn)
+	io.Copy(conn, remote)
+}
+
+// newTestEcho return a tcp server echoing what it reads.
+func newTestEcho(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener
+}
+
+func testAddr(t *testing.T, s string) ma.Multiaddr {
+	addr, err := ma.NewMultiaddr(s)
+	assert.Nil(t, err)
+	return addr
+}
+
+func TestProxyTransport(t *testing.T) {
+	echo := newTestEcho(t)
+	defer echo.Close()
+	_, port, _ := net.SplitHostPort(echo.Addr().String())
+	socks := newTestSocks5(t, map[string]string{"seed.nebulas.io": "127.0.0.1", "127.0.0.1": "127.0.0.1"})
+	defer socks.listener.Close()
+
+	transport, err := newProxyTransport("socks5://" + socks.listener.Addr().String())
+	assert.Nil(t, err)
+	_, err = transport.Listen(testAddr(t, "/ip4/0.0.0.0/tcp/8680"))
+	assert.Equal(t, ErrProxyListen, err)
+	assert.True(t, transport.Matches(testAddr(t, "/ip4/1.2.3.4/tcp/8680")))
+	assert.True(t, transport.Matches(testAddr(t, "/dns4/seed.nebulas.io/tcp/8680")))
+	assert.False(t, transport.Matches(testAddr(t, "/ip4/1.2.3.4/udp/8680/quic")))
+	dialer, err := transport.Dialer(nil)
+	assert.Nil(t, err)
+
+	// the host name is resolved by the proxy, not by the node.
+	raddr := testAddr(t, "/dns4/seed.nebulas.io/tcp/"+port)
+	conn, err := dialer.Dial(raddr)
+	assert.Nil(t, err)
+	assert.Equal(t, "seed.nebulas.io:"+port, <-socks.requests)
+	assert.True(t, raddr.Equal(conn.RemoteMultiaddr()))
+	assert.Equal(t, conn.Transport(), transport)
+	_, err = conn.Write([]byte("hello"))
+	assert.Nil(t, err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(buf))
+	conn.Close()
+
+	conn, err = dialer.Dial(testAddr(t, "/ip4/127.0.0.1/tcp/"+port))
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:"+port, <-socks.requests)
+	conn.Close()
+
+	// the proxy failing to connect the peer fails the dial.
+	_, err = dialer.Dial(testAddr(t, "/dns4/unknown.nebulas.io/tcp/"+port))
+	assert.NotNil(t, err)
+	assert.Equal(t, "unknown.nebulas.io:"+port, <-socks.requests)
+	_, err = dialer.Dial(testAddr(t, "/ip4/127.0.0.1/udp/"+port))
+	assert.Equal(t, ErrProxyUnsupportAddr, err)
+}
+
+func TestProxyTransport_Timeout(t *testing.T) {
+	// a proxy accepting the connections, never answering.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	transport, err := newProxyTransport("socks5://" + listener.Addr().String())
+	assert.Nil(t, err)
+	dialer, _ := transport.Dialer(nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = dialer.(*proxyDialer).DialContext(ctx, testAddr(t, "/dns4/seed.nebulas.io/tcp/8680"))
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
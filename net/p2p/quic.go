@@ -0,0 +1,311 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	tpt "github.com/libp2p/go-libp2p-transport"
+	quic "github.com/lucas-clemente/quic-go"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// const
+const (
+	// QUICProtocol is the ALPN protocol of the nebulas connections over QUIC.
+	QUICProtocol = "nebulas"
+	// QUICIdleTimeout is how long a quic session is kept once the peer stops answering.
+	QUICIdleTimeout = 2 * time.Minute
+)
+
+// errors
+var (
+	ErrQUICUnsupportAddr = errors.New("address can't be dialed over quic")
+)
+
+// quicTransport carries the connections over QUIC, one stream by connection. The swarm secures
+// and multiplexes them like the tcp ones, so the TLS of QUIC isn't trusted for the peer identity:
+// the certificate is a throwaway one, and isn't verified when dialing.
+type quicTransport struct {
+	tlsConfig *tls.Config
+	config    *quic.Config
+}
+
+// newQUICTransport create a transport of the udp addresses ending in /quic.
+func newQUICTransport() (*quicTransport, error) {
+	cert, err := generateQUICCertificate()
+	if err != nil {
+		return nil, err
+	}
+	return &quicTransport{
+		tlsConfig: &tls.Config{
+			Certificates:       []tls.Certificate{cert},
+			InsecureSkipVerify: true,
+			NextProtos:         []string{QUICProtocol},
+		},
+		config: &quic.Config{
+			HandshakeTimeout: 10 * time.Second,
+			IdleTimeout:      QUICIdleTimeout,
+			KeepAlive:        true,
+		},
+	}, nil
+}
+
+func generateQUICCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(10 * 365 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+// Dialer return the dialer of the transport.
+func (t *quicTransport) Dialer(laddr ma.Multiaddr, opts ...tpt.DialOpt) (tpt.Dialer, error) {
+	return &quicDialer{transport: t}, nil
+}
+
+// Listen listen on the udp address of the multiaddr.
+func (t *quicTransport) Listen(laddr ma.Multiaddr) (tpt.Listener, error) {
+	address, err := quicDialArgs(laddr)
+	if err != nil {
+		return nil, err
+	}
+	listener, err := quic.ListenAddr(address, t.tlsConfig, t.config)
+	if err != nil {
+		return nil, err
+	}
+	maddr, err := quicMultiaddr(listener.Addr())
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return &quicListener{listener: listener, laddr: maddr, transport: t}, nil
+}
+
+// Matches return if the address is a quic one.
+func (t *quicTransport) Matches(addr ma.Multiaddr) bool {
+	_, err := quicDialArgs(addr)
+	return err == nil
+}
+
+// quicDialArgs return the "host:port" of the udp address, if the multiaddr is a quic one.
+func quicDialArgs(addr ma.Multiaddr) (string, error) {
+	if _, err := addr.ValueForProtocol(ma.P_QUIC); err != nil {
+		return "", ErrQUICUnsupportAddr
+	}
+	port, err := addr.ValueForProtocol(ma.P_UDP)
+	if err != nil {
+		return "", ErrQUICUnsupportAddr
+	}
+	for _, code := range []int{ma.P_IP4, ma.P_IP6} {
+		if host, err := addr.ValueForProtocol(code); err == nil {
+			return net.JoinHostPort(host, port), nil
+		}
+	}
+	return "", ErrQUICUnsupportAddr
+}
+
+// quicMultiaddr return the quic multiaddr of the udp address.
+func quicMultiaddr(addr net.Addr) (ma.Multiaddr, error) {
+	udpAddr, ok := addr.(*net.UDPAddr)
+	if !ok {
+		return nil, ErrQUICUnsupportAddr
+	}
+	if ip4 := udpAddr.IP.To4(); ip4 != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/udp/%d/quic", ip4, udpAddr.Port))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/udp/%d/quic", udpAddr.IP, udpAddr.Port))
+}
+
+type quicDialer struct {
+	transport *quicTransport
+}
+
+// Dial dial the address over quic.
+func (d *quicDialer) Dial(raddr ma.Multiaddr) (tpt.Conn, error) {
+	return d.DialContext(context.Background(), raddr)
+}
+
+// DialContext dial the address over quic, giving up when the context is done.
+func (d *quicDialer) DialContext(ctx context.Context, raddr ma.Multiaddr) (tpt.Conn, error) {
+	address, err := quicDialArgs(raddr)
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		conn *quicConn
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		sess, err := quic.DialAddr(address, d.transport.tlsConfig, d.transport.config)
+		if err != nil {
+			ch <- result{nil, err}
+			return
+		}
+		stream, err := sess.OpenStreamSync()
+		if err != nil {
+			sess.Close(err)
+			ch <- result{nil, err}
+			return
+		}
+		conn, err := newQUICConn(sess, stream, d.transport)
+		ch <- result{conn, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		// close the connection if it's made after all.
+		go func() {
+			if r := <-ch; r.conn != nil {
+				r.conn.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	case r := <-ch:
+		if r.err != nil {
+			return nil, r.err
+		}
+		return r.conn, nil
+	}
+}
+
+// Matches return if the address is a quic one.
+func (d *quicDialer) Matches(addr ma.Multiaddr) bool {
+	return d.transport.Matches(addr)
+}
+
+type quicListener struct {
+	listener  quic.Listener
+	laddr     ma.Multiaddr
+	transport *quicTransport
+}
+
+// Accept wait for the next session, and its stream.
+func (l *quicListener) Accept() (tpt.Conn, error) {
+	for {
+		sess, err := l.listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		stream, err := sess.AcceptStream()
+		if err != nil {
+			// the dialer gave up before opening its stream, wait for the next one.
+			sess.Close(err)
+			continue
+		}
+		conn, err := newQUICConn(sess, stream, l.transport)
+		if err != nil {
+			sess.Close(err)
+			continue
+		}
+		return conn, nil
+	}
+}
+
+// Close stop listening.
+func (l *quicListener) Close() error {
+	return l.listener.Close()
+}
+
+// Addr return the udp address listened on.
+func (l *quicListener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// Multiaddr return the quic address listened on.
+func (l *quicListener) Multiaddr() ma.Multiaddr {
+	return l.laddr
+}
+
+// quicConn is the stream of a quic session, the session is closed with it.
+type quicConn struct {
+	quic.Stream
+	sess      quic.Session
+	laddr     ma.Multiaddr
+	raddr     ma.Multiaddr
+	transport *quicTransport
+}
+
+func newQUICConn(sess quic.Session, stream quic.Stream, transport *quicTransport) (*quicConn, error) {
+	laddr, err := quicMultiaddr(sess.LocalAddr())
+	if err != nil {
+		return nil, err
+	}
+	raddr, err := quicMultiaddr(sess.RemoteAddr())
+	if err != nil {
+		return nil, err
+	}
+	return &quicConn{Stream: stream, sess: sess, laddr: laddr, raddr: raddr, transport: transport}, nil
+}
+
+// Close close the stream and the session.
+func (c *quicConn) Close() error {
+	c.Stream.Close()
+	return c.sess.Close(nil)
+}
+
+// LocalAddr return the local udp address of the session.
+func (c *quicConn) LocalAddr() net.Addr {
+	return c.sess.LocalAddr()
+}
+
+// RemoteAddr return the remote udp address of the session.
+func (c *quicConn) RemoteAddr() net.Addr {
+	return c.sess.RemoteAddr()
+}
+
+// LocalMultiaddr return the local quic address of the session.
+func (c *quicConn) LocalMultiaddr() ma.Multiaddr {
+	return c.laddr
+}
+
+// RemoteMultiaddr return the remote quic address of the session.
+func (c *quicConn) RemoteMultiaddr() ma.Multiaddr {
+	return c.raddr
+}
+
+// Transport return the quic transport.
+func (c *quicConn) Transport() tpt.Transport {
+	return c.transport
+}
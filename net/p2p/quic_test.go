@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"io"
+	"testing"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQUICTransport(t *testing.T) {
+	transport, err := newQUICTransport()
+	assert.Nil(t, err)
+
+	tcp, _ := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/8680")
+	assert.False(t, transport.Matches(tcp))
+	laddr, _ := ma.NewMultiaddr("/ip4/127.0.0.1/udp/0/quic")
+	assert.True(t, transport.Matches(laddr))
+
+	listener, err := transport.Listen(laddr)
+	assert.Nil(t, err)
+	defer listener.Close()
+	assert.True(t, transport.Matches(listener.Multiaddr()))
+
+	// the listener echoes the first message of the connection.
+	accepted := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			accepted <- err
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 4)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			accepted <- err
+			return
+		}
+		_, err = conn.Write(buf)
+		accepted <- err
+	}()
+
+	dialer, err := transport.Dialer(laddr)
+	assert.Nil(t, err)
+	conn, err := dialer.Dial(listener.Multiaddr())
+	assert.Nil(t, err)
+	defer conn.Close()
+	assert.True(t, conn.RemoteMultiaddr().Equal(listener.Multiaddr()))
+
+	_, err = conn.Write([]byte("ping"))
+	assert.Nil(t, err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "ping", string(buf))
+	assert.Nil(t, <-accepted)
+
+	// the addresses not over quic aren't dialed.
+	_, err = dialer.Dial(tcp)
+	assert.Equal(t, ErrQUICUnsupportAddr, err)
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	rateLimitedCounter = metrics.GetOrRegisterCounter("neb.net.rate_limited", nil)
+)
+
+// tokenBucket limits the bytes per second, it holds the tokens of a second at most.
+// A transfer larger than the tokens left takes them in debt and waits for them to be refilled.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate int) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(rate),
+		tokens: float64(rate),
+		last:   time.Now(),
+	}
+}
+
+// take the tokens of n bytes, return the time to wait for them.
+func (b *tokenBucket) take(n int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// PeerBucketCooldown is how long the bucket of a disconnected peer is kept,
+// so the peer reconnecting doesn't get a full bucket and clear its debt.
+const PeerBucketCooldown = time.Minute
+
+// key: message name, value: true
+var urgentMessages = new(sync.Map)
+
+// RegisterUrgentMessage send the messages of the name without waiting for the upload limit, e.g. the blocks,
+// their bytes are still taken from the buckets and delay the other messages after.
+func RegisterUrgentMessage(name string) {
+	urgentMessages.Store(name, true)
+}
+
+func isUrgentMessage(name string) bool {
+	_, ok := urgentMessages.Load(name)
+	return ok
+}
+
+// RateLimiter limits the bytes per second transferred with each peer and with all of them.
+type RateLimiter struct {
+	peerRate int
+	global   *tokenBucket
+
+	mu    sync.Mutex
+	peers map[string]*tokenBucket
+	// key: peer id, value: the time the peer disconnected.
+	disconnected map[string]time.Time
+}
+
+// NewRateLimiter create a new rate limiter, a rate of 0 is unlimited.
+func NewRateLimiter(globalRate, peerRate int) *RateLimiter {
+	limiter := &RateLimiter{
+		peerRate:     peerRate,
+		peers:        make(map[string]*tokenBucket),
+		disconnected: make(map[string]time.Time),
+	}
+	if globalRate > 0 {
+		limiter.global = newTokenBucket(globalRate)
+	}
+	return limiter
+}
+
+// Take take the tokens of n bytes transferred with the peer, return the time to wait for them.
+func (l *RateLimiter) Take(id string, n int) time.Duration {
+	var delay time.Duration
+	if l.peerRate > 0 {
+		delay = l.bucket(id).take(n)
+	}
+	if l.global != nil {
+		if d := l.global.take(n); d > delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
+// Wait block until n bytes can be transferred with the peer.
+func (l *RateLimiter) Wait(id string, n int) {
+	if delay := l.Take(id, n); delay > 0 {
+		rateLimitedCounter.Inc(1)
+		time.Sleep(delay)
+	}
+}
+
+func (l *RateLimiter) bucket(id string) *tokenBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.disconnected, id)
+	bucket, ok := l.peers[id]
+	if !ok {
+		bucket = newTokenBucket(l.peerRate)
+		l.peers[id] = bucket
+	}
+	return bucket
+}
+
+// Remove forget the peer once it has been disconnected for PeerBucketCooldown.
+func (l *RateLimiter) Remove(id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if _, ok := l.peers[id]; ok {
+		l.disconnected[id] = now
+	}
+	for k, v := range l.disconnected {
+		if now.Sub(v) > PeerBucketCooldown {
+			delete(l.peers, k)
+			delete(l.disconnected, k)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(1000)
+
+	// a full bucket holds a second of tokens.
+	assert.Equal(t, bucket.take(600), time.Duration(0))
+	assert.Equal(t, bucket.take(400), time.Duration(0))
+
+	// the bytes over the tokens are taken in debt.
+	delay := bucket.take(500)
+	assert.True(t, delay > 400*time.Millisecond && delay <= 500*time.Millisecond)
+
+	time.Sleep(delay + 50*time.Millisecond)
+	assert.Equal(t, bucket.take(10), time.Duration(0))
+
+	// the tokens refilled never exceed a second.
+	bucket.last = bucket.last.Add(-10 * time.Second)
+	assert.Equal(t, bucket.take(1000), time.Duration(0))
+	assert.True(t, bucket.take(100) > 0)
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(0, 1000)
+	assert.Equal(t, limiter.Take("a", 1000), time.Duration(0))
+	assert.True(t, limiter.Take("a", 500) > 0)
+	// the peers have their own buckets.
+	assert.Equal(t, limiter.Take("b", 1000), time.Duration(0))
+
+	// the global bucket is shared by the peers.
+	limiter = NewRateLimiter(1000, 0)
+	assert.Equal(t, limiter.Take("a", 600), time.Duration(0))
+	assert.True(t, limiter.Take("b", 600) > 0)
+
+	// unlimited.
+	limiter = NewRateLimiter(0, 0)
+	assert.Equal(t, limiter.Take("a", 1<<30), time.Duration(0))
+}
+
+func TestRateLimiter_Remove(t *testing.T) {
+	limiter := NewRateLimiter(0, 1000)
+	limiter.Take("a", 1500)
+
+	// the debt survives a reconnection within the cooldown.
+	limiter.Remove("a")
+	assert.True(t, limiter.Take("a", 1) > 0)
+
+	limiter.Remove("a")
+	limiter.disconnected["a"] = time.Now().Add(-PeerBucketCooldown - time.Second)
+	limiter.Remove("b")
+	assert.Equal(t, len(limiter.peers), 0)
+	assert.Equal(t, limiter.Take("a", 1000), time.Duration(0))
+}
+
+func TestUrgentMessage(t *testing.T) {
+	RegisterUrgentMessage("urgent")
+	assert.True(t, isUrgentMessage("urgent"))
+	assert.False(t, isUrgentMessage("bulk"))
+}
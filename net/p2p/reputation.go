@@ -0,0 +1,283 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// errors
+var (
+	ErrPeerBanned = errors.New("peer is banned")
+)
+
+// PeerMisbehavior is the kind of misbehavior reported against a peer.
+type PeerMisbehavior int
+
+// peer misbehaviors
+const (
+	InvalidMessage PeerMisbehavior = iota
+	UselessBlock
+	Timeout
+	ProtocolViolation
+)
+
+// const
+const (
+	// PeerScoreRecovery is the score a penalized peer regains every stream store round.
+	PeerScoreRecovery = 1
+	// PeerResponseTimeout is the time a peer has to answer a sync request.
+	PeerResponseTimeout = 30 * time.Second
+)
+
+// penalties of the misbehaviors, deducted from the peer's score.
+var penalties = map[PeerMisbehavior]int32{
+	InvalidMessage:    20,
+	UselessBlock:      5,
+	Timeout:           10,
+	ProtocolViolation: 50,
+}
+
+func (m PeerMisbehavior) String() string {
+	switch m {
+	case InvalidMessage:
+		return "invalid message"
+	case UselessBlock:
+		return "useless block"
+	case Timeout:
+		return "timeout"
+	case ProtocolViolation:
+		return "protocol violation"
+	}
+	return "unknown"
+}
+
+// PeerScore is the reputation of a peer, a peer starts at 0 and is banned when it drops to -BanScore.
+type PeerScore struct {
+	ID                 string
+	Score              int32
+	InvalidMessages    uint32
+	UselessBlocks      uint32
+	Timeouts           uint32
+	ProtocolViolations uint32
+	BannedUntil        time.Time
+	LastMisbehavior    PeerMisbehavior
+	LastReported       time.Time
+}
+
+// Banned return if the peer is banned at the time.
+func (ps *PeerScore) Banned(now time.Time) bool {
+	return now.Before(ps.BannedUntil)
+}
+
+// Reputation scores the peers on their misbehaviors and bans the low-scoring ones.
+type Reputation struct {
+	mu          sync.Mutex
+	banScore    int32
+	banDuration time.Duration
+	scores      map[string]*PeerScore
+	// key: peer.ID, value: the time the request was sent.
+	pending map[string]time.Time
+}
+
+// NewReputation create a new reputation.
+func NewReputation(banScore int32, banDuration time.Duration) *Reputation {
+	return &Reputation{
+		banScore:    banScore,
+		banDuration: banDuration,
+		scores:      make(map[string]*PeerScore),
+		pending:     make(map[string]time.Time),
+	}
+}
+
+// Report penalize the peer for the misbehavior, return true if the peer is banned by it.
+func (r *Reputation) Report(id string, m PeerMisbehavior) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	ps, ok := r.scores[id]
+	if !ok {
+		ps = &PeerScore{ID: id}
+		r.scores[id] = ps
+	}
+	switch m {
+	case InvalidMessage:
+		ps.InvalidMessages++
+	case UselessBlock:
+		ps.UselessBlocks++
+	case Timeout:
+		ps.Timeouts++
+	case ProtocolViolation:
+		ps.ProtocolViolations++
+	}
+	ps.Score -= penalties[m]
+	ps.LastMisbehavior = m
+	ps.LastReported = now
+
+	if ps.Banned(now) || ps.Score > -r.banScore {
+		return false
+	}
+	ps.BannedUntil = now.Add(r.banDuration)
+	delete(r.pending, id)
+	return true
+}
+
+// IsBanned return if the peer is banned.
+func (r *Reputation) IsBanned(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ps, ok := r.scores[id]
+	return ok && ps.Banned(time.Now())
+}
+
+// Score return the score of the peer.
+func (r *Reputation) Score(id string) int32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ps, ok := r.scores[id]; ok {
+		return ps.Score
+	}
+	return 0
+}
+
+// Expect record a request sent to the peer which it should respond in time.
+func (r *Reputation) Expect(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.pending[id]; !ok {
+		r.pending[id] = time.Now()
+	}
+}
+
+// Fulfil record the peer responded to the request.
+func (r *Reputation) Fulfil(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.pending, id)
+}
+
+// Expired return the peers not responded in timeout and forget their requests.
+func (r *Reputation) Expired(timeout time.Duration) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ids []string
+	deadline := time.Now().Add(-timeout)
+	for id, sent := range r.pending {
+		if sent.Before(deadline) {
+			ids = append(ids, id)
+			delete(r.pending, id)
+		}
+	}
+	return ids
+}
+
+// Recover lift the expired bans and let the penalized peers regain their scores.
+func (r *Reputation) Recover() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for id, ps := range r.scores {
+		if ps.Banned(now) {
+			continue
+		}
+		if !ps.BannedUntil.IsZero() {
+			// the ban is over, give the peer a clean score.
+			ps.BannedUntil = time.Time{}
+			ps.Score = 0
+		}
+		if ps.Score < 0 {
+			ps.Score += PeerScoreRecovery
+		}
+		if ps.Score >= 0 {
+			delete(r.scores, id)
+		}
+	}
+}
+
+// Scores return the scores of the penalized peers, the lowest first.
+func (r *Reputation) Scores() []*PeerScore {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	scores := make([]*PeerScore, 0, len(r.scores))
+	for _, ps := range r.scores {
+		v := *ps
+		scores = append(scores, &v)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].Score < scores[j].Score
+	})
+	return scores
+}
+
+// ReportPeer penalize a peer for its misbehavior, disconnect and ban it if its score is too low.
+func (ns *NetService) ReportPeer(id string, m PeerMisbehavior) {
+	node := ns.node
+	if node.IsTrustedPeer(id) {
+		return
+	}
+	if !node.reputation.Report(id, m) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":         id,
+			"misbehavior": m.String(),
+		}).Debug("Penalized a peer.")
+		return
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"pid":         id,
+		"misbehavior": m.String(),
+		"duration":    node.config.BanDuration,
+	}).Warn("Banned a misbehaving peer.")
+	node.knownPeers.remove(id)
+
+	streamStore, ok := node.stream.Load(id)
+	if !ok {
+		return
+	}
+	pid, err := peer.IDB58Decode(id)
+	if err != nil {
+		return
+	}
+	s := streamStore.(*StreamStore).stream
+	ns.Bye(pid, []ma.Multiaddr{s.Conn().RemoteMultiaddr()}, s, id)
+}
+
+func (ns *NetService) checkPeerTimeouts() {
+	node := ns.node
+	for _, id := range node.reputation.Expired(PeerResponseTimeout) {
+		ns.ReportPeer(id, Timeout)
+	}
+	node.reputation.Recover()
+}
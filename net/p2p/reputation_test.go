@@ -0,0 +1,94 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReputation(t *testing.T) {
+	r := NewReputation(50, time.Hour)
+
+	assert.False(t, r.Report("a", UselessBlock))
+	assert.False(t, r.Report("a", Timeout))
+	assert.Equal(t, int32(-15), r.Score("a"))
+	assert.Equal(t, int32(0), r.Score("b"))
+
+	// the peer is banned once its score drops to -banScore, and only once.
+	assert.False(t, r.Report("a", InvalidMessage))
+	assert.True(t, r.Report("a", InvalidMessage))
+	assert.True(t, r.IsBanned("a"))
+	assert.False(t, r.Report("a", ProtocolViolation))
+	assert.False(t, r.IsBanned("b"))
+
+	assert.False(t, r.Report("b", UselessBlock))
+	scores := r.Scores()
+	assert.Equal(t, 2, len(scores))
+	assert.Equal(t, "a", scores[0].ID)
+	assert.Equal(t, uint32(2), scores[0].InvalidMessages)
+	assert.Equal(t, uint32(1), scores[0].UselessBlocks)
+	assert.Equal(t, uint32(1), scores[0].Timeouts)
+	assert.Equal(t, uint32(1), scores[0].ProtocolViolations)
+	assert.Equal(t, ProtocolViolation, scores[0].LastMisbehavior)
+	assert.Equal(t, "b", scores[1].ID)
+}
+
+func TestReputation_Recover(t *testing.T) {
+	r := NewReputation(50, time.Hour)
+	assert.True(t, r.Report("a", ProtocolViolation))
+	r.Report("b", UselessBlock)
+
+	// the banned peers keep their score, the others regain theirs.
+	r.Recover()
+	assert.True(t, r.IsBanned("a"))
+	assert.Equal(t, int32(-50), r.Score("a"))
+	assert.Equal(t, int32(-5+PeerScoreRecovery), r.Score("b"))
+
+	// the ban is over, the peer starts over.
+	r.scores["a"].BannedUntil = time.Now().Add(-time.Second)
+	r.Recover()
+	assert.False(t, r.IsBanned("a"))
+	assert.Equal(t, int32(0), r.Score("a"))
+	assert.Equal(t, 1, len(r.Scores()))
+
+	for i := 0; i < 5; i++ {
+		r.Recover()
+	}
+	assert.Empty(t, r.Scores())
+}
+
+func TestReputation_Expired(t *testing.T) {
+	r := NewReputation(50, time.Hour)
+	r.Expect("a")
+	r.Expect("b")
+	r.Expect("c")
+	r.Fulfil("b")
+	assert.Empty(t, r.Expired(time.Minute))
+
+	r.pending["a"] = time.Now().Add(-2 * time.Minute)
+	assert.Equal(t, []string{"a"}, r.Expired(time.Minute))
+	assert.Empty(t, r.Expired(time.Minute))
+
+	// the requests of a banned peer are forgotten.
+	assert.True(t, r.Report("c", ProtocolViolation))
+	assert.Empty(t, r.pending)
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+
+	libnet "github.com/libp2p/go-libp2p-net"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// errors
+var (
+	ErrInsecureTransport = errors.New("the connection is not authenticated by the peer's key")
+)
+
+// verifyTransport check the stream runs on a connection the swarm secured by secio:
+// an authenticated key exchange signed by the node keys, then the messages are encrypted and MACed.
+// The peer id is the hash of the public key the remote proved it owns, so it can't be spoofed.
+func verifyTransport(s libnet.Stream) error {
+	conn := s.Conn()
+	pub := conn.RemotePublicKey()
+	if pub == nil {
+		return ErrInsecureTransport
+	}
+	id, err := peer.IDFromPublicKey(pub)
+	if err != nil || id != conn.RemotePeer() {
+		return ErrInsecureTransport
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	libnet "github.com/libp2p/go-libp2p-net"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+// testConn is a connection claiming a remote peer and a key, the other methods are not implemented.
+type testConn struct {
+	libnet.Conn
+	remote peer.ID
+	pub    crypto.PubKey
+}
+
+func (c *testConn) RemotePeer() peer.ID {
+	return c.remote
+}
+
+func (c *testConn) RemotePublicKey() crypto.PubKey {
+	return c.pub
+}
+
+// testStream is a stream on a testConn, the other methods are not implemented.
+type testStream struct {
+	libnet.Stream
+	conn *testConn
+}
+
+func (s *testStream) Conn() libnet.Conn {
+	return s.conn
+}
+
+func TestVerifyTransport(t *testing.T) {
+	_, pub, err := GenerateEd25519Key()
+	assert.Nil(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	assert.Nil(t, err)
+	_, other, err := GenerateEd25519Key()
+	assert.Nil(t, err)
+
+	assert.Nil(t, verifyTransport(&testStream{conn: &testConn{remote: id, pub: pub}}))
+
+	// the connection not secured has no remote key.
+	assert.Equal(t, ErrInsecureTransport, verifyTransport(&testStream{conn: &testConn{remote: id}}))
+	// the remote claims a peer id not matching the key it proved.
+	assert.Equal(t, ErrInsecureTransport, verifyTransport(&testStream{conn: &testConn{remote: id, pub: other}}))
+}
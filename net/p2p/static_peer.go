@@ -0,0 +1,176 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"sort"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// errors
+var (
+	ErrInvalidPeerAddress = errors.New("invalid peer address, it should be a multiaddr with the peer id or a peer id")
+)
+
+// ConfiguredPeer is a static or trusted peer.
+type ConfiguredPeer struct {
+	ID      string
+	Addr    ma.Multiaddr
+	Static  bool
+	Trusted bool
+}
+
+// AddStaticPeer add a peer the node always keeps connected to, the address is a multiaddr with the peer id.
+func (node *Node) AddStaticPeer(address string) error {
+	addr, err := ma.NewMultiaddr(address)
+	if err != nil {
+		return ErrInvalidPeerAddress
+	}
+	id, err := peerIDFromMultiaddr(addr)
+	if err != nil {
+		return err
+	}
+	node.staticPeers.Store(id.Pretty(), addr)
+
+	// connect it now instead of waiting for the next round.
+	select {
+	case node.staticPeerCh <- true:
+	default:
+	}
+	return nil
+}
+
+// AddTrustedPeer add a peer exempt from the scoring, the bans and the connection limits,
+// the address is a peer id or a multiaddr with it.
+func (node *Node) AddTrustedPeer(address string) error {
+	id, err := peer.IDB58Decode(address)
+	if err != nil {
+		addr, err := ma.NewMultiaddr(address)
+		if err != nil {
+			return ErrInvalidPeerAddress
+		}
+		if id, err = peerIDFromMultiaddr(addr); err != nil {
+			return err
+		}
+	}
+	node.trustedPeers.Store(id.Pretty(), true)
+	return nil
+}
+
+// RemovePeer remove a peer from the static and trusted peers, the connection is kept.
+func (node *Node) RemovePeer(id string) {
+	node.staticPeers.Delete(id)
+	node.trustedPeers.Delete(id)
+}
+
+// IsTrustedPeer return if the peer is trusted.
+func (node *Node) IsTrustedPeer(id string) bool {
+	_, ok := node.trustedPeers.Load(id)
+	return ok
+}
+
+// ConfiguredPeers return the static and trusted peers.
+func (node *Node) ConfiguredPeers() []*ConfiguredPeer {
+	peers := make(map[string]*ConfiguredPeer)
+	node.staticPeers.Range(func(k, v interface{}) bool {
+		peers[k.(string)] = &ConfiguredPeer{ID: k.(string), Addr: v.(ma.Multiaddr), Static: true}
+		return true
+	})
+	node.trustedPeers.Range(func(k, _ interface{}) bool {
+		p, ok := peers[k.(string)]
+		if !ok {
+			p = &ConfiguredPeer{ID: k.(string)}
+			peers[k.(string)] = p
+		}
+		p.Trusted = true
+		return true
+	})
+
+	result := make([]*ConfiguredPeer, 0, len(peers))
+	for _, p := range peers {
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+func peerIDFromMultiaddr(addr ma.Multiaddr) (peer.ID, error) {
+	b58, err := addr.ValueForProtocol(ma.P_IPFS)
+	if err != nil {
+		return "", ErrInvalidPeerAddress
+	}
+	id, err := peer.IDB58Decode(b58)
+	if err != nil {
+		return "", ErrInvalidPeerAddress
+	}
+	return id, nil
+}
+
+func (node *Node) initConfiguredPeers() error {
+	for _, v := range node.config.StaticPeers {
+		if err := node.AddStaticPeer(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range node.config.TrustedPeers {
+		if err := node.AddTrustedPeer(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectStaticPeers say hello to the static peers not connected.
+func (ns *NetService) connectStaticPeers() {
+	node := ns.node
+	node.staticPeers.Range(func(k, v interface{}) bool {
+		key := k.(string)
+		if _, ok := node.stream.Load(key); ok {
+			return true
+		}
+		addr, id, err := ns.parseAddressFromMultiaddr(v.(ma.Multiaddr))
+		if err != nil || id == node.id {
+			return true
+		}
+
+		node.peerstore.AddAddr(id, addr, peerstore.PermanentAddrTTL)
+		if err := ns.Hello(id); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"pid":  key,
+				"addr": addr,
+				"err":  err,
+			}).Warn("Failed to connect the static peer.")
+			return true
+		}
+		node.routeTable.Update(id)
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":  key,
+			"addr": addr,
+		}).Info("Connected the static peer.")
+		return true
+	})
+}
@@ -62,7 +62,7 @@ func (ns *NetService) Sync(tail net.Serializable) error {
 		nodeID := allNode[i]
 		addrs := node.peerstore.PeerInfo(nodeID).Addrs
 		if len(addrs) > 0 {
-			if node.host.Addrs()[0] == addrs[0] {
+			if node.isSelfAddr(addrs[0]) {
 				logging.VLog().Warn("Sync: skip self")
 				continue
 			}
@@ -70,6 +70,7 @@ func (ns *NetService) Sync(tail net.Serializable) error {
 			key := nodeID.Pretty()
 			if _, ok := node.stream.Load(key); ok {
 				count++
+				node.reputation.Expect(key)
 				go func() {
 					ns.SendMsg(SyncBlock, data, key)
 				}()
@@ -41,4 +41,7 @@ type Manager interface {
 	BroadcastNetworkID([]byte)
 
 	BuildData([]byte, string) []byte
+
+	ReportPeer(string, PeerMisbehavior)
+	UpdatePeerHead(string, uint64, string)
 }
@@ -11,6 +11,10 @@ It has these top-level messages:
 	Hello
 	Peers
 	PeerInfo
+	Subscription
+	PeerRecord
+	PexRequest
+	PexReply
 */
 package netpb
 
@@ -33,6 +37,15 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type Hello struct {
 	NodeId        string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	ClientVersion string `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// the addresses the node is reachable at, its NAT mapped ones included.
+	Addrs []string `protobuf:"bytes,3,rep,name=addrs" json:"addrs,omitempty"`
+	// the optional protocol features the node supports, such as "snappy".
+	Capabilities []string `protobuf:"bytes,4,rep,name=capabilities" json:"capabilities,omitempty"`
+	// the proof the node knows the token of the private network, empty for the public one.
+	NetworkProof []byte `protobuf:"bytes,5,opt,name=network_proof,json=networkProof,proto3" json:"network_proof,omitempty"`
+	// the latest and the oldest protocol versions the node speaks, 0 for the legacy nodes.
+	ProtocolVersion    uint32 `protobuf:"varint,6,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	MinProtocolVersion uint32 `protobuf:"varint,7,opt,name=min_protocol_version,json=minProtocolVersion,proto3" json:"min_protocol_version,omitempty"`
 }
 
 func (m *Hello) Reset()                    { *m = Hello{} }
@@ -54,6 +67,41 @@ func (m *Hello) GetClientVersion() string {
 	return ""
 }
 
+func (m *Hello) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *Hello) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *Hello) GetNetworkProof() []byte {
+	if m != nil {
+		return m.NetworkProof
+	}
+	return nil
+}
+
+func (m *Hello) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *Hello) GetMinProtocolVersion() uint32 {
+	if m != nil {
+		return m.MinProtocolVersion
+	}
+	return 0
+}
+
 type Peers struct {
 	Peers []*PeerInfo `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
 }
@@ -94,26 +142,144 @@ func (m *PeerInfo) GetAddrs() []string {
 	return nil
 }
 
+type Subscription struct {
+	// the gossip topics the node subscribes to.
+	Topics []string `protobuf:"bytes,1,rep,name=topics" json:"topics,omitempty"`
+}
+
+func (m *Subscription) Reset()                    { *m = Subscription{} }
+func (m *Subscription) String() string            { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()               {}
+func (*Subscription) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{3} }
+
+func (m *Subscription) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+// the addresses a peer signed with its key, shared by the peer exchange.
+type PeerRecord struct {
+	Id    string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addrs []string `protobuf:"bytes,2,rep,name=addrs" json:"addrs,omitempty"`
+	// unix time in seconds the record was signed at.
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PublicKey []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *PeerRecord) Reset()                    { *m = PeerRecord{} }
+func (m *PeerRecord) String() string            { return proto.CompactTextString(m) }
+func (*PeerRecord) ProtoMessage()               {}
+func (*PeerRecord) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{4} }
+
+func (m *PeerRecord) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PeerRecord) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type PexRequest struct {
+	// the most records wanted.
+	Max uint32 `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *PexRequest) Reset()                    { *m = PexRequest{} }
+func (m *PexRequest) String() string            { return proto.CompactTextString(m) }
+func (*PexRequest) ProtoMessage()               {}
+func (*PexRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{5} }
+
+func (m *PexRequest) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type PexReply struct {
+	// the record of the replying peer first, then a sample of its known-good peers.
+	Records []*PeerRecord `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *PexReply) Reset()                    { *m = PexReply{} }
+func (m *PexReply) String() string            { return proto.CompactTextString(m) }
+func (*PexReply) ProtoMessage()               {}
+func (*PexReply) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{6} }
+
+func (m *PexReply) GetRecords() []*PeerRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Hello)(nil), "netpb.Hello")
 	proto.RegisterType((*Peers)(nil), "netpb.Peers")
 	proto.RegisterType((*PeerInfo)(nil), "netpb.PeerInfo")
+	proto.RegisterType((*Subscription)(nil), "netpb.Subscription")
+	proto.RegisterType((*PeerRecord)(nil), "netpb.PeerRecord")
+	proto.RegisterType((*PexRequest)(nil), "netpb.PexRequest")
+	proto.RegisterType((*PexReply)(nil), "netpb.PexReply")
 }
 
 func init() { proto.RegisterFile("message.proto", fileDescriptorMessage) }
 
 var fileDescriptorMessage = []byte{
-	// 177 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x8e, 0x41, 0xeb, 0x82, 0x40,
-	0x10, 0x47, 0x71, 0x65, 0xfd, 0xff, 0x9d, 0xd0, 0x60, 0x09, 0xda, 0xa3, 0x08, 0x82, 0xa7, 0x25,
-	0xea, 0x43, 0x94, 0xb7, 0xf0, 0xd0, 0x55, 0xb4, 0x9d, 0x62, 0xc1, 0x76, 0x64, 0x57, 0xfa, 0xfc,
-	0xa1, 0x4b, 0xdd, 0xe6, 0xf7, 0xde, 0xe1, 0x0d, 0x64, 0x2f, 0xf4, 0xbe, 0x7f, 0xa2, 0x9a, 0x1c,
-	0xcd, 0x24, 0xb8, 0xc5, 0x79, 0x1a, 0xca, 0x33, 0xf0, 0x0b, 0x8e, 0x23, 0x89, 0x3d, 0xfc, 0x59,
-	0xd2, 0xd8, 0x19, 0x2d, 0xa3, 0x22, 0xaa, 0xd3, 0x36, 0x59, 0x66, 0xa3, 0x45, 0x05, 0xf9, 0x7d,
-	0x34, 0x68, 0xe7, 0xee, 0x8d, 0xce, 0x1b, 0xb2, 0x92, 0xad, 0x3e, 0x0b, 0xf4, 0x16, 0x60, 0xa9,
-	0x80, 0x5f, 0x11, 0x9d, 0x17, 0x15, 0xf0, 0x69, 0x39, 0x64, 0x54, 0xc4, 0xf5, 0xe6, 0xb8, 0x55,
-	0x6b, 0x48, 0x2d, 0xb2, 0xb1, 0x0f, 0x6a, 0x83, 0x2d, 0x0f, 0xf0, 0xff, 0x45, 0x22, 0x07, 0xf6,
-	0xcb, 0x32, 0xa3, 0xc5, 0x0e, 0x78, 0xaf, 0xb5, 0xf3, 0x92, 0x15, 0x71, 0x9d, 0xb6, 0x61, 0x0c,
-	0xc9, 0xfa, 0xf8, 0xe9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x87, 0x8b, 0x73, 0xcc, 0xc9, 0x00, 0x00,
-	0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0x87, 0x95, 0xa4, 0xd9, 0x25, 0xc3, 0xa6, 0x2d, 0x56, 0x05, 0x3e, 0x00, 0x8a, 0x8c, 0x8a,
+	0x82, 0x90, 0xa2, 0x0a, 0x0e, 0xbc, 0x02, 0x15, 0x97, 0x95, 0x91, 0xb8, 0x46, 0xf9, 0x33, 0xad,
+	0xac, 0x26, 0xb6, 0xb1, 0x1d, 0xd8, 0x3d, 0x73, 0xe6, 0x9d, 0x91, 0x9d, 0x4d, 0xb7, 0x15, 0x97,
+	0xde, 0x3c, 0xdf, 0x8c, 0x27, 0xbf, 0x7c, 0x86, 0x7c, 0x44, 0x6b, 0x9b, 0x5b, 0xac, 0xb4, 0x51,
+	0x4e, 0x91, 0x54, 0xa2, 0xd3, 0x2d, 0xfb, 0x13, 0x43, 0xfa, 0x15, 0x87, 0x41, 0x91, 0x57, 0xb0,
+	0x96, 0xaa, 0xc7, 0x5a, 0xf4, 0x34, 0x2a, 0xa2, 0x32, 0xe3, 0x2b, 0x5f, 0x5e, 0xf7, 0xe4, 0x12,
+	0x4e, 0xbb, 0x41, 0xa0, 0x74, 0xf5, 0x2f, 0x34, 0x56, 0x28, 0x49, 0xe3, 0xd0, 0xcf, 0x67, 0xfa,
+	0x63, 0x86, 0xe4, 0x02, 0xd2, 0xa6, 0xef, 0x8d, 0xa5, 0x49, 0x91, 0x94, 0x19, 0x9f, 0x0b, 0xc2,
+	0x60, 0xd3, 0x35, 0xba, 0x69, 0xc5, 0x20, 0x9c, 0x40, 0x4b, 0x4f, 0x42, 0xf3, 0x11, 0x23, 0xef,
+	0x20, 0x97, 0xe8, 0x7e, 0x2b, 0x73, 0x57, 0x6b, 0xa3, 0xd4, 0x0d, 0x4d, 0x8b, 0xa8, 0xdc, 0xf0,
+	0xcd, 0x01, 0x6e, 0x3d, 0x23, 0x1f, 0xe0, 0x3c, 0x04, 0xef, 0xd4, 0x70, 0x9f, 0x63, 0x55, 0x44,
+	0x65, 0xce, 0xcf, 0x16, 0xbe, 0x24, 0xb9, 0x82, 0x8b, 0x51, 0xc8, 0xfa, 0xbf, 0xf1, 0x75, 0x18,
+	0x27, 0xa3, 0x90, 0xdb, 0xc7, 0x37, 0x58, 0x05, 0xe9, 0x16, 0xd1, 0x58, 0x72, 0x09, 0xa9, 0xf6,
+	0x07, 0x1a, 0x15, 0x49, 0xf9, 0xfc, 0xd3, 0x59, 0x15, 0x2c, 0x55, 0xbe, 0x79, 0x2d, 0x6f, 0x14,
+	0x9f, 0xbb, 0xec, 0x0a, 0x9e, 0x2d, 0x88, 0x9c, 0x42, 0x7c, 0xaf, 0x2c, 0x16, 0xfd, 0xd1, 0x43,
+	0xfc, 0xc0, 0x03, 0x7b, 0x0f, 0x9b, 0xef, 0x53, 0x6b, 0x3b, 0x23, 0xb4, 0xf3, 0x19, 0x5f, 0xc2,
+	0xca, 0x29, 0x2d, 0xba, 0xf9, 0x4b, 0x19, 0x3f, 0x54, 0xec, 0x6f, 0x04, 0xe0, 0x57, 0x73, 0xec,
+	0x94, 0xe9, 0x9f, 0xb6, 0x9c, 0xbc, 0x86, 0xcc, 0x89, 0x11, 0xad, 0x6b, 0x46, 0x4d, 0x93, 0x22,
+	0x2a, 0x13, 0x7e, 0x04, 0xe4, 0x0d, 0x80, 0x9e, 0xda, 0x41, 0x74, 0xf5, 0x1d, 0xee, 0xe9, 0x49,
+	0x70, 0x9b, 0xcd, 0xe4, 0x1b, 0xee, 0xfd, 0x65, 0x2b, 0x6e, 0x65, 0xe3, 0x26, 0x83, 0x07, 0xf3,
+	0x47, 0xc0, 0xde, 0xfa, 0x38, 0x3b, 0x8e, 0x3f, 0x27, 0xb4, 0x8e, 0x9c, 0x43, 0x32, 0x36, 0xbb,
+	0x90, 0x27, 0xe7, 0xfe, 0xc8, 0xbe, 0x78, 0x13, 0x3b, 0x8e, 0x7a, 0xd8, 0x93, 0x8f, 0xb0, 0x36,
+	0x21, 0xf6, 0xa2, 0xef, 0xc5, 0x03, 0x7d, 0xf3, 0x0f, 0xf1, 0x65, 0xa2, 0x5d, 0x85, 0xe7, 0xf9,
+	0xfc, 0x6f, 0x00, 0xac, 0xbf, 0x94, 0x1f, 0x97, 0x02, 0x00, 0x00,
 }
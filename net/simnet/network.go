@@ -0,0 +1,264 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package simnet is an in-memory network of simulated nodes for the unit tests of the modules
+// above the net layer, its nodes implement p2p.Manager so the sync and gossip of the core modules
+// are driven in a single process, with latencies and partitions injected. It stands in for the
+// p2p layer rather than testing it: the streams, handshakes and peer management aren't simulated.
+package simnet
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/net/messages"
+)
+
+// errors
+var (
+	ErrNodeExists   = errors.New("simulated node already exists")
+	ErrNodeNotFound = errors.New("simulated node not found")
+	ErrNotConnected = errors.New("simulated nodes are not connected")
+)
+
+// Network is an in-memory network of simulated nodes.
+type Network struct {
+	mu    sync.RWMutex
+	nodes map[string]*Node
+	links map[link]time.Duration
+	// key: node id, value: the partition group, the nodes of different groups can't reach each other.
+	groups map[string]int
+
+	latency  time.Duration
+	inflight sync.WaitGroup
+
+	delivered int64
+	dropped   int64
+}
+
+// defaultLatency marks the connections delayed by the default latency of the network.
+const defaultLatency time.Duration = -1
+
+// link is an undirected connection between two nodes, its ids sorted.
+type link struct {
+	a, b string
+}
+
+func newLink(a, b string) link {
+	if a > b {
+		a, b = b, a
+	}
+	return link{a, b}
+}
+
+// NewNetwork create an empty network, its messages delivered at once.
+func NewNetwork() *Network {
+	return &Network{
+		nodes:  make(map[string]*Node),
+		links:  make(map[link]time.Duration),
+		groups: make(map[string]int),
+	}
+}
+
+// AddNode add a node of the id to the network, not connected to any other.
+func (n *Network) AddNode(id string) (*Node, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if _, ok := n.nodes[id]; ok {
+		return nil, ErrNodeExists
+	}
+	node := newNode(id, n)
+	n.nodes[id] = node
+	return node, nil
+}
+
+// Node return the node of the id, nil if not found.
+func (n *Network) Node(id string) *Node {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.nodes[id]
+}
+
+// Connect connect the two nodes, the messages between them delayed by the default latency.
+func (n *Network) Connect(a, b string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.nodes[a] == nil || n.nodes[b] == nil {
+		return ErrNodeNotFound
+	}
+	if a == b {
+		return nil
+	}
+	if _, ok := n.links[newLink(a, b)]; !ok {
+		n.links[newLink(a, b)] = defaultLatency
+	}
+	return nil
+}
+
+// ConnectAll connect every node to all the others.
+func (n *Network) ConnectAll() {
+	n.mu.RLock()
+	var ids []string
+	for id := range n.nodes {
+		ids = append(ids, id)
+	}
+	n.mu.RUnlock()
+	for i := range ids {
+		for j := i + 1; j < len(ids); j++ {
+			n.Connect(ids[i], ids[j])
+		}
+	}
+}
+
+// Disconnect remove the connection of the two nodes.
+func (n *Network) Disconnect(a, b string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.links, newLink(a, b))
+}
+
+// SetLatency set the default latency of the connections.
+func (n *Network) SetLatency(latency time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.latency = latency
+}
+
+// SetLinkLatency set the latency of the connection of the two nodes.
+func (n *Network) SetLinkLatency(a, b string, latency time.Duration) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if _, ok := n.links[newLink(a, b)]; !ok {
+		return ErrNotConnected
+	}
+	n.links[newLink(a, b)] = latency
+	return nil
+}
+
+// Partition split the network into the groups of nodes, the nodes of different groups can't reach each other,
+// and the nodes in no group can reach only the others in no group.
+func (n *Network) Partition(groups ...[]string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.groups = make(map[string]int)
+	for i, group := range groups {
+		for _, id := range group {
+			n.groups[id] = i + 1
+		}
+	}
+}
+
+// Heal remove the partitions.
+func (n *Network) Heal() {
+	n.Partition()
+}
+
+// Peers return the ids of the nodes connected to the node.
+func (n *Network) Peers(id string) []string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	var peers []string
+	for l := range n.links {
+		if l.a == id {
+			peers = append(peers, l.b)
+		} else if l.b == id {
+			peers = append(peers, l.a)
+		}
+	}
+	return peers
+}
+
+// route return the target node and the latency of a message, nil if the target is unreachable.
+func (n *Network) route(from, to string) (*Node, time.Duration) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	latency, ok := n.links[newLink(from, to)]
+	if !ok || n.groups[from] != n.groups[to] {
+		return nil, 0
+	}
+	if latency == defaultLatency {
+		latency = n.latency
+	}
+	return n.nodes[to], latency
+}
+
+// send deliver the message to the target after the latency of their connection,
+// dropping it if they are disconnected or partitioned at the delivery.
+func (n *Network) send(from, to string, name string, data []byte) error {
+	if target, _ := n.route(from, to); target == nil {
+		n.count(false)
+		return ErrNotConnected
+	}
+
+	n.inflight.Add(1)
+	go func() {
+		defer n.inflight.Done()
+		_, latency := n.route(from, to)
+		time.Sleep(latency)
+		target, _ := n.route(from, to)
+		if target == nil {
+			n.count(false)
+			return
+		}
+		n.count(true)
+		target.receive(messages.NewBaseMessage(name, from, data))
+	}()
+	return nil
+}
+
+func (n *Network) count(delivered bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if delivered {
+		n.delivered++
+	} else {
+		n.dropped++
+	}
+}
+
+// Flush wait until the messages in flight are delivered or dropped,
+// the ones sent by the subscribers handling them after included if they are sent before it returns.
+func (n *Network) Flush() {
+	n.inflight.Wait()
+}
+
+// Stats return the numbers of the messages delivered and dropped.
+func (n *Network) Stats() (int64, int64) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.delivered, n.dropped
+}
+
+// Start start the dispatchers of all the nodes.
+func (n *Network) Start() {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for _, node := range n.nodes {
+		node.Start()
+	}
+}
+
+// Stop stop the dispatchers of all the nodes.
+func (n *Network) Stop() {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for _, node := range n.nodes {
+		node.Stop()
+	}
+}
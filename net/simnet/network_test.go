@@ -0,0 +1,98 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package simnet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/stretchr/testify/assert"
+)
+
+// received return the messages of the type delivered to the node.
+func received(node *Node, name string) chan net.Message {
+	ch := make(chan net.Message, 16)
+	node.Register(net.NewSubscriber(node, ch, name))
+	return ch
+}
+
+func TestNetwork_Route(t *testing.T) {
+	network := NewNetwork()
+	for _, id := range []string{"a", "b", "c"} {
+		_, err := network.AddNode(id)
+		assert.Nil(t, err)
+	}
+	_, err := network.AddNode("a")
+	assert.Equal(t, err, ErrNodeExists)
+	assert.Equal(t, network.Connect("a", "d"), ErrNodeNotFound)
+
+	assert.Nil(t, network.Connect("a", "b"))
+	assert.Equal(t, network.Peers("a"), []string{"b"})
+	ch := received(network.Node("b"), "ping")
+	network.Start()
+	defer network.Stop()
+
+	assert.Nil(t, network.Node("a").SendMsg("ping", []byte("1"), "b"))
+	network.Flush()
+	select {
+	case msg := <-ch:
+		assert.Equal(t, msg.MessageFrom(), "a")
+		assert.Equal(t, msg.Data(), []byte("1"))
+	case <-time.After(time.Second):
+		t.Fatal("message not delivered")
+	}
+
+	// the nodes not connected or partitioned can't reach each other.
+	assert.Equal(t, network.Node("a").SendMsg("ping", []byte("2"), "c"), ErrNotConnected)
+	network.Partition([]string{"a"}, []string{"b", "c"})
+	assert.Equal(t, network.Node("a").SendMsg("ping", []byte("3"), "b"), ErrNotConnected)
+	network.Heal()
+	assert.Nil(t, network.Node("a").SendMsg("ping", []byte("4"), "b"))
+	network.Flush()
+	<-ch
+
+	delivered, dropped := network.Stats()
+	assert.Equal(t, delivered, int64(2))
+	assert.Equal(t, dropped, int64(2))
+}
+
+func TestNetwork_Latency(t *testing.T) {
+	network := NewNetwork()
+	network.AddNode("a")
+	network.AddNode("b")
+	assert.Equal(t, network.SetLinkLatency("a", "b", time.Second), ErrNotConnected)
+	assert.Nil(t, network.Connect("a", "b"))
+	assert.Nil(t, network.SetLinkLatency("a", "b", 50*time.Millisecond))
+	network.Start()
+	defer network.Stop()
+
+	start := time.Now()
+	assert.Nil(t, network.Node("a").SendMsg("ping", nil, "b"))
+	network.Flush()
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+
+	// a message in flight is dropped if the nodes are partitioned before it arrives.
+	assert.Nil(t, network.Node("a").SendMsg("ping", nil, "b"))
+	network.Partition([]string{"a"}, []string{"b"})
+	network.Flush()
+	delivered, dropped := network.Stats()
+	assert.Equal(t, delivered, int64(1))
+	assert.Equal(t, dropped, int64(1))
+}
@@ -0,0 +1,217 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package simnet
+
+import (
+	"hash/crc32"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+)
+
+// Node is a simulated node, a p2p.Manager delivering the messages through its in-memory network.
+type Node struct {
+	id         string
+	network    *Network
+	dispatcher *net.Dispatcher
+
+	mu      sync.Mutex
+	reports map[string][]p2p.PeerMisbehavior
+	heads   map[string]uint64
+	// the message types subscribed, the dispatcher can't take the others.
+	registered map[string]bool
+
+	// the peers known to have a message.
+	seen *p2p.SeenCache
+}
+
+func newNode(id string, network *Network) *Node {
+	return &Node{
+		id:         id,
+		network:    network,
+		dispatcher: net.NewDispatcher(),
+		reports:    make(map[string][]p2p.PeerMisbehavior),
+		heads:      make(map[string]uint64),
+		registered: make(map[string]bool),
+		seen:       p2p.NewSeenCache(p2p.DefaultRelayCacheSize, p2p.DefaultRelayCacheTTL),
+	}
+}
+
+// ID return the id of the node, the sender of its messages.
+func (node *Node) ID() string {
+	return node.id
+}
+
+// Start start the dispatcher.
+func (node *Node) Start() error {
+	node.dispatcher.Start()
+	return nil
+}
+
+// Stop stop the dispatcher.
+func (node *Node) Stop() {
+	node.dispatcher.Stop()
+}
+
+// Node return nil, a simulated node has no p2p node.
+func (node *Node) Node() *p2p.Node {
+	return nil
+}
+
+// Sync send the sync request to all the peers.
+func (node *Node) Sync(tail net.Serializable) error {
+	data, err := marshal(tail)
+	if err != nil {
+		return err
+	}
+	peers := node.network.Peers(node.id)
+	if len(peers) == 0 {
+		return p2p.ErrNodeNotEnough
+	}
+	for _, id := range peers {
+		node.network.send(node.id, id, p2p.SyncBlock, data)
+	}
+	return nil
+}
+
+// SendSyncReply send the sync reply to the peer.
+func (node *Node) SendSyncReply(key string, blocks net.Serializable) {
+	data, err := marshal(blocks)
+	if err != nil {
+		return
+	}
+	node.network.send(node.id, key, p2p.SyncReply, data)
+}
+
+// Register register the subscribers.
+func (node *Node) Register(subscribers ...*net.Subscriber) {
+	node.mu.Lock()
+	for _, v := range subscribers {
+		for _, t := range v.MessageType() {
+			node.registered[t] = true
+		}
+	}
+	node.mu.Unlock()
+	node.dispatcher.Register(subscribers...)
+}
+
+// Deregister deregister the subscribers.
+func (node *Node) Deregister(subscribers ...*net.Subscriber) {
+	node.mu.Lock()
+	for _, v := range subscribers {
+		for _, t := range v.MessageType() {
+			delete(node.registered, t)
+		}
+	}
+	node.mu.Unlock()
+	node.dispatcher.Deregister(subscribers...)
+}
+
+// Broadcast send the message to all the peers.
+func (node *Node) Broadcast(name string, msg net.Serializable) {
+	node.distribute(name, msg)
+}
+
+// Relay send the message to the peers not known to have it.
+func (node *Node) Relay(name string, msg net.Serializable) {
+	node.distribute(name, msg)
+}
+
+func (node *Node) distribute(name string, msg net.Serializable) {
+	data, err := marshal(msg)
+	if err != nil {
+		return
+	}
+	checksum := crc32.ChecksumIEEE(data)
+	known := node.seen.Peers(name, checksum)
+	for _, id := range node.network.Peers(node.id) {
+		if p2p.InArray(peer.ID(id), known) {
+			continue
+		}
+		node.seen.Add(name, checksum, peer.ID(id))
+		node.network.send(node.id, id, name, data)
+	}
+}
+
+// SendMsg send the message to the peer.
+func (node *Node) SendMsg(name string, data []byte, target string) error {
+	return node.network.send(node.id, target, name, data)
+}
+
+// BroadcastNetworkID does nothing, the simulated nodes are all in the same network.
+func (node *Node) BroadcastNetworkID([]byte) {}
+
+// BuildData return the data as is, the simulated messages are not framed.
+func (node *Node) BuildData(data []byte, name string) []byte {
+	return data
+}
+
+// ReportPeer record the misbehavior of the peer.
+func (node *Node) ReportPeer(id string, m p2p.PeerMisbehavior) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.reports[id] = append(node.reports[id], m)
+}
+
+// Reports return the misbehaviors reported against the peer.
+func (node *Node) Reports(id string) []p2p.PeerMisbehavior {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return append([]p2p.PeerMisbehavior{}, node.reports[id]...)
+}
+
+// UpdatePeerHead record the latest block height of the peer.
+func (node *Node) UpdatePeerHead(id string, height uint64, hash string) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if height > node.heads[id] {
+		node.heads[id] = height
+	}
+}
+
+// PeerHead return the latest block height the peer sent.
+func (node *Node) PeerHead(id string) uint64 {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.heads[id]
+}
+
+// receive dispatch a message delivered by the network, the sender is known to have it after.
+func (node *Node) receive(msg net.Message) {
+	node.seen.Add(msg.MessageType(), crc32.ChecksumIEEE(msg.Data().([]byte)), peer.ID(msg.MessageFrom()))
+	node.mu.Lock()
+	registered := node.registered[msg.MessageType()]
+	node.mu.Unlock()
+	if registered {
+		node.dispatcher.PutMessage(msg)
+	}
+}
+
+func marshal(msg net.Serializable) ([]byte, error) {
+	pb, err := msg.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+var _ p2p.Manager = (*Node)(nil)
@@ -24,6 +24,17 @@ import "github.com/gogo/protobuf/proto"
 const (
 	MessageTypeSyncBlock = "syncblock"
 	MessageTypeSyncReply = "syncreply"
+	MessageTypeGetChunk  = "getchunk"
+	MessageTypeChunk     = "chunk"
+
+	MessageTypeGetTrieNodes = "gettrienodes"
+	MessageTypeTrieNodes    = "trienodes"
+
+	MessageTypeGetHeaders = "getheaders"
+	MessageTypeHeaders    = "headers"
+
+	MessageTypeLightRequest  = "lightrequest"
+	MessageTypeLightResponse = "lightresponse"
 )
 
 // MessageType a string for message type.
@@ -0,0 +1,59 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"encoding/json"
+)
+
+// ABIArgTypeAny is the type of the arguments not annotated by the contract.
+const ABIArgTypeAny = "any"
+
+// ABI describes the public functions of a contract, generated when it's deployed,
+// so that wallets can render the forms to call it.
+type ABI struct {
+	Functions []*ABIFunction `json:"functions"`
+}
+
+// ABIFunction is a public function of the contract.
+type ABIFunction struct {
+	Name    string    `json:"name"`
+	Args    []*ABIArg `json:"args"`
+	Payable bool      `json:"payable"`
+}
+
+// ABIArg is an argument of the function.
+type ABIArg struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ParseABI parses the ABI from its JSON.
+func ParseABI(data []byte) (*ABI, error) {
+	abi := new(ABI)
+	if err := json.Unmarshal(data, abi); err != nil {
+		return nil, err
+	}
+	return abi, nil
+}
+
+// ToBytes returns the JSON of the ABI.
+func (abi *ABI) ToBytes() ([]byte, error) {
+	return json.Marshal(abi)
+}
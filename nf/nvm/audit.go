@@ -0,0 +1,196 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Kinds of the audit events at the boundary of the engines.
+const (
+	AuditHostEnter = "host_enter"
+	AuditHostExit  = "host_exit"
+	AuditMemory    = "memory"
+	AuditTimeout   = "timeout"
+)
+
+// ErrExecutionKilled is the error of the executions killed by an audit hook.
+var ErrExecutionKilled = errors.New("execution killed by audit hook")
+
+// AuditEvent is an event at the boundary of an engine, a host function called by a contract,
+// the memory an execution took or an execution timed out.
+type AuditEvent struct {
+	Kind     string
+	Contract string
+	TxHash   string
+	// name of the host function, only for the host events.
+	Host string
+	// bytes of memory taken by the execution, only for the memory events.
+	Memory uint64
+	// whether the execution is a read-only call of the API, only those can be killed.
+	ReadOnly bool
+}
+
+// AuditHook observes the events of the executions, it returns an error to kill the execution.
+// The executions of blocks are never killed, since a policy of a node must not change the
+// state it agrees on with the others: the verdicts on them are only logged.
+type AuditHook interface {
+	Audit(event *AuditEvent) error
+}
+
+// AuditHookFunc adapts a function to an audit hook.
+type AuditHookFunc func(event *AuditEvent) error
+
+// Audit calls the function.
+func (f AuditHookFunc) Audit(event *AuditEvent) error {
+	return f(event)
+}
+
+var (
+	auditHooks   []AuditHook
+	auditHooksMu sync.RWMutex
+)
+
+// RegisterAuditHook installs the hook, the hooks audit the events in the order they are registered.
+func RegisterAuditHook(hook AuditHook) {
+	auditHooksMu.Lock()
+	defer auditHooksMu.Unlock()
+	auditHooks = append(auditHooks, hook)
+}
+
+// ResetAuditHooks removes all the audit hooks.
+func ResetAuditHooks() {
+	auditHooksMu.Lock()
+	defer auditHooksMu.Unlock()
+	auditHooks = nil
+}
+
+// audit passes the event of the execution to the hooks, it returns ErrExecutionKilled if a hook kills
+// the read-only execution.
+func (ctx *Context) audit(event *AuditEvent) error {
+	auditHooksMu.RLock()
+	hooks := auditHooks
+	auditHooksMu.RUnlock()
+	if len(hooks) == 0 || ctx == nil {
+		return nil
+	}
+
+	if ctx.contract != nil {
+		event.Contract = ctx.contract.Address().String()
+	}
+	if ctx.tx != nil {
+		event.TxHash = ctx.tx.Hash
+	}
+	event.ReadOnly = ctx.readOnly
+	for _, hook := range hooks {
+		err := hook.Audit(event)
+		if err == nil {
+			continue
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"kind":     event.Kind,
+			"contract": event.Contract,
+			"tx":       event.TxHash,
+			"host":     event.Host,
+			"readOnly": event.ReadOnly,
+			"err":      err,
+		}).Warn("Audit hook rejected the execution.")
+		if event.ReadOnly {
+			return ErrExecutionKilled
+		}
+	}
+	return nil
+}
+
+// AuditLogger logs the timeouts and the memory taken by the executions, and the host functions
+// called when the log level is debug.
+type AuditLogger struct{}
+
+// Audit logs the event.
+func (l *AuditLogger) Audit(event *AuditEvent) error {
+	entry := logging.VLog().WithFields(logrus.Fields{
+		"kind":     event.Kind,
+		"contract": event.Contract,
+		"tx":       event.TxHash,
+		"host":     event.Host,
+		"memory":   event.Memory,
+		"readOnly": event.ReadOnly,
+	})
+	switch event.Kind {
+	case AuditTimeout:
+		entry.Warn("Contract execution timed out.")
+	case AuditMemory:
+		entry.Info("Contract execution memory.")
+	default:
+		entry.Debug("Contract host function.")
+	}
+	return nil
+}
+
+// HostCallLimiter kills the executions of a contract once its host function calls in a second exceed the rate.
+type HostCallLimiter struct {
+	rate    uint64
+	windows map[string]*hostCallWindow
+	mu      sync.Mutex
+}
+
+type hostCallWindow struct {
+	start time.Time
+	calls uint64
+}
+
+// NewHostCallLimiter returns the limiter of the host function calls of each contract per second.
+func NewHostCallLimiter(rate uint64) *HostCallLimiter {
+	return &HostCallLimiter{
+		rate:    rate,
+		windows: make(map[string]*hostCallWindow),
+	}
+}
+
+// Audit counts the host function calls of the contract in the current second.
+func (l *HostCallLimiter) Audit(event *AuditEvent) error {
+	if event.Kind != AuditHostEnter {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	w, ok := l.windows[event.Contract]
+	if !ok || now.Sub(w.start) >= time.Second {
+		// drop the windows passed, so the idle contracts aren't kept.
+		for k, v := range l.windows {
+			if now.Sub(v.start) >= time.Second {
+				delete(l.windows, k)
+			}
+		}
+		w = &hostCallWindow{start: now}
+		l.windows[event.Contract] = w
+	}
+	w.calls++
+	if w.calls > l.rate {
+		return ErrExecutionKilled
+	}
+	return nil
+}
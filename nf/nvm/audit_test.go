@@ -0,0 +1,89 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditHooks(t *testing.T) {
+	defer ResetAuditHooks()
+
+	var events []*AuditEvent
+	RegisterAuditHook(AuditHookFunc(func(event *AuditEvent) error {
+		events = append(events, event)
+		if event.Kind == AuditHostEnter && event.Host == "storage_put" {
+			return errors.New("storage is read-only")
+		}
+		return nil
+	}))
+
+	run := func(readOnly bool) (*Context, error) {
+		mem, _ := storage.NewMemoryStorage()
+		context, _ := state.NewAccountState(nil, mem)
+		owner := context.GetOrCreateUserAccount([]byte("account1"))
+		contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+		ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+		if readOnly {
+			ctx.ReadOnly()
+		}
+		engine := NewEngine(ctx, SourceTypeWasm)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, DefaultLimitsOfTotalMemorySize)
+		return ctx, engine.DeployAndInit(testWasmModule("storage_put"), SourceTypeWasm, "")
+	}
+
+	// the verdicts on the executions of blocks are only logged.
+	ctx, err := run(false)
+	assert.Nil(t, err)
+	val, _ := ctx.contract.Get(hashStorageKey("key"))
+	assert.Equal(t, []byte("value"), val)
+	assert.Equal(t, 3, len(events))
+	assert.Equal(t, AuditHostEnter, events[0].Kind)
+	assert.Equal(t, ctx.contract.Address().String(), events[0].Contract)
+	assert.False(t, events[0].ReadOnly)
+	assert.Equal(t, AuditHostExit, events[1].Kind)
+	assert.Equal(t, AuditMemory, events[2].Kind)
+	assert.Equal(t, uint64(wasmPageSize), events[2].Memory)
+
+	// the read-only calls are killed.
+	events = nil
+	ctx, err = run(true)
+	assert.Equal(t, ErrExecutionKilled, err)
+	_, err = ctx.contract.Get(hashStorageKey("key"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.True(t, events[0].ReadOnly)
+}
+
+func TestHostCallLimiter(t *testing.T) {
+	limiter := NewHostCallLimiter(2)
+	enter := &AuditEvent{Kind: AuditHostEnter, Contract: "a"}
+	assert.Nil(t, limiter.Audit(enter))
+	assert.Nil(t, limiter.Audit(&AuditEvent{Kind: AuditHostExit, Contract: "a"}))
+	assert.Nil(t, limiter.Audit(enter))
+	assert.Equal(t, ErrExecutionKilled, limiter.Audit(enter))
+	// the contracts are limited apart.
+	assert.Nil(t, limiter.Audit(&AuditEvent{Kind: AuditHostEnter, Contract: "b"}))
+}
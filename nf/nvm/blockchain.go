@@ -24,7 +24,7 @@ import (
 	"encoding/json"
 	"unsafe"
 
-	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -32,10 +32,11 @@ import (
 // GetTxByHashFunc returns tx info by hash
 //export GetTxByHashFunc
 func GetTxByHashFunc(handler unsafe.Pointer, hash *C.char) *C.char {
-	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, _ := enterHostFunc(handler, "get_tx_by_hash")
 	if engine == nil || engine.ctx.block == nil {
 		return nil
 	}
+	defer engine.exitHostFunc("get_tx_by_hash")
 	tx, err := engine.ctx.SerializeTxByHash([]byte(C.GoString(hash)))
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -51,10 +52,11 @@ func GetTxByHashFunc(handler unsafe.Pointer, hash *C.char) *C.char {
 // GetAccountStateFunc returns account info by address
 //export GetAccountStateFunc
 func GetAccountStateFunc(handler unsafe.Pointer, address *C.char) *C.char {
-	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, _ := enterHostFunc(handler, "get_account_state")
 	if engine == nil || engine.ctx.block == nil {
 		return nil
 	}
+	defer engine.exitHostFunc("get_account_state")
 	addr := C.GoString(address)
 	valid := engine.ctx.block.VerifyAddress(addr)
 	if !valid {
@@ -77,54 +79,226 @@ func GetAccountStateFunc(handler unsafe.Pointer, address *C.char) *C.char {
 // TransferFunc transfer vale to address
 //export TransferFunc
 func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) int {
-	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, _ := enterHostFunc(handler, "transfer")
 	if engine == nil || engine.ctx.block == nil {
 		return 1
 	}
+	defer engine.exitHostFunc("transfer")
 
-	addr := C.GoString(to)
-	valid := engine.ctx.block.VerifyAddress(addr)
-	if !valid {
+	if err := engine.ctx.Transfer(C.GoString(to), C.GoString(v)); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"handler": uint64(uintptr(handler)),
 			"key":     C.GoString(to),
-		}).Error("TransferFunc parse address failed.")
+			"value":   C.GoString(v),
+			"err":     err,
+		}).Error("TransferFunc transfer failed.")
 		return 1
 	}
+	return 0
+}
 
-	toAcc := engine.ctx.state.GetOrCreateUserAccount([]byte(addr))
-
-	var (
-		amount *util.Uint128
-		err    error
-	)
-	amount = util.NewUint128FromString(C.GoString(v))
+// SelfDestructFunc destroys the contract and sends its balance to beneficiary
+//export SelfDestructFunc
+func SelfDestructFunc(handler unsafe.Pointer, beneficiary *C.char) int {
+	engine, _ := enterHostFunc(handler, "self_destruct")
+	if engine == nil || engine.ctx.block == nil {
+		return 1
+	}
+	defer engine.exitHostFunc("self_destruct")
 
-	// update balance
-	err = engine.ctx.contract.SubBalance(amount)
-	if err != nil {
+	if err := engine.ctx.SelfDestruct(C.GoString(beneficiary)); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
-			"handler": uint64(uintptr(handler)),
-			"key":     C.GoString(to),
-			"err":     err,
-		}).Error("TransferFunc SubBalance failed.")
+			"handler":     uint64(uintptr(handler)),
+			"beneficiary": C.GoString(beneficiary),
+			"err":         err,
+		}).Error("SelfDestructFunc self-destruct failed.")
 		return 1
 	}
+	return 0
+}
 
-	toAcc.AddBalance(amount)
+// NonReentrantFunc fails the whole execution if the contract is re-entered, even if the contract catches it
+//export NonReentrantFunc
+func NonReentrantFunc(handler unsafe.Pointer) int {
+	engine, _ := enterHostFunc(handler, "non_reentrant")
+	if engine == nil || engine.ctx.block == nil {
+		return 1
+	}
+	defer engine.exitHostFunc("non_reentrant")
+
+	if err := engine.ctx.NonReentrant(); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler":  uint64(uintptr(handler)),
+			"contract": engine.ctx.contract.Address().String(),
+			"err":      err,
+		}).Error("NonReentrantFunc reentrant call detected.")
+		if engine.callErr == nil {
+			engine.callErr = err
+		}
+		return 1
+	}
 	return 0
 }
 
 // VerifyAddressFunc verify address is valid
 //export VerifyAddressFunc
 func VerifyAddressFunc(handler unsafe.Pointer, address *C.char) int {
-	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, _ := enterHostFunc(handler, "verify_address")
 	if engine == nil || engine.ctx.block == nil {
 		return 0
 	}
+	defer engine.exitHostFunc("verify_address")
 
 	if engine.ctx.block.VerifyAddress(C.GoString(address)) {
 		return 1
 	}
 	return 0
 }
+
+// RunContractSourceFunc calls function of another contract, returns the JSON of its result
+//export RunContractSourceFunc
+func RunContractSourceFunc(handler unsafe.Pointer, address *C.char, funcName *C.char, args *C.char) *C.char {
+	engine, _ := enterHostFunc(handler, "call_contract")
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+	defer engine.exitHostFunc("call_contract")
+
+	// forward all the remaining gas to the callee.
+	var gasLimit uint64
+	used := uint64(engine.v8engine.stats.count_of_executed_instructions)
+	if engine.limitsOfExecutionInstructions > 0 {
+		if used >= engine.limitsOfExecutionInstructions {
+			return nil
+		}
+		gasLimit = engine.limitsOfExecutionInstructions - used
+	}
+
+	result, gas, err := RunContract(engine.ctx, C.GoString(address), C.GoString(funcName), C.GoString(args), gasLimit, engine.limitsOfTotalMemorySize)
+	engine.v8engine.stats.count_of_executed_instructions += C.size_t(gas)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler":  uint64(uintptr(handler)),
+			"address":  C.GoString(address),
+			"function": C.GoString(funcName),
+			"err":      err,
+		}).Error("RunContractSourceFunc call contract failed.")
+		if engine.callErr == nil {
+			engine.callErr = err
+		}
+		return nil
+	}
+	return C.CString(result)
+}
+
+// DeployContractFunc deploys a contract from the running contract, returns the address of the new contract
+//export DeployContractFunc
+func DeployContractFunc(handler unsafe.Pointer, source *C.char, sourceType *C.char, codeHash *C.char, args *C.char) *C.char {
+	engine, _ := enterHostFunc(handler, "deploy_contract")
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+	defer engine.exitHostFunc("deploy_contract")
+
+	// forward all the remaining gas to the new contract.
+	var gasLimit uint64
+	used := uint64(engine.v8engine.stats.count_of_executed_instructions)
+	if engine.limitsOfExecutionInstructions > 0 {
+		if used >= engine.limitsOfExecutionInstructions {
+			return nil
+		}
+		gasLimit = engine.limitsOfExecutionInstructions - used
+	}
+
+	address, gas, err := CreateContract(engine.ctx, C.GoString(source), C.GoString(sourceType), C.GoString(codeHash), C.GoString(args), gasLimit, engine.limitsOfTotalMemorySize)
+	engine.v8engine.stats.count_of_executed_instructions += C.size_t(gas)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler":  uint64(uintptr(handler)),
+			"codeHash": C.GoString(codeHash),
+			"err":      err,
+		}).Error("DeployContractFunc deploy contract failed.")
+		if engine.callErr == nil {
+			engine.callErr = err
+		}
+		return nil
+	}
+	return C.CString(address)
+}
+
+// RunPrecompileFunc runs the precompiled contract with the hex input, returns the hex output
+//export RunPrecompileFunc
+func RunPrecompileFunc(handler unsafe.Pointer, name *C.char, input *C.char) *C.char {
+	engine, _ := enterHostFunc(handler, "precompile")
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+	defer engine.exitHostFunc("precompile")
+	data, err := byteutils.FromHex(C.GoString(input))
+	if err != nil {
+		return nil
+	}
+
+	var gasLimit uint64
+	used := uint64(engine.v8engine.stats.count_of_executed_instructions)
+	if engine.limitsOfExecutionInstructions > 0 {
+		if used >= engine.limitsOfExecutionInstructions {
+			return nil
+		}
+		gasLimit = engine.limitsOfExecutionInstructions - used
+	}
+
+	output, gas, err := RunPrecompile(C.GoString(name), data, gasLimit)
+	engine.v8engine.stats.count_of_executed_instructions += C.size_t(gas)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"name":    C.GoString(name),
+			"err":     err,
+		}).Error("RunPrecompileFunc run precompile failed.")
+		return nil
+	}
+	return C.CString(byteutils.Hex(output))
+}
+
+// RandomFunc returns a random hex hash derived from the block's seed
+//export RandomFunc
+func RandomFunc(handler unsafe.Pointer, seed *C.char) *C.char {
+	engine, _ := enterHostFunc(handler, "random")
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+	defer engine.exitHostFunc("random")
+
+	random, err := engine.ctx.Random(C.GoString(seed))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"seed":    C.GoString(seed),
+			"err":     err,
+		}).Error("RandomFunc generate random failed.")
+		return nil
+	}
+	return C.CString(random)
+}
+
+// GetBlockHashFunc returns the hash of the recent block at height
+//export GetBlockHashFunc
+func GetBlockHashFunc(handler unsafe.Pointer, height C.ulonglong) *C.char {
+	engine, _ := enterHostFunc(handler, "get_block_hash")
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+	defer engine.exitHostFunc("get_block_hash")
+
+	hash, err := engine.ctx.BlockHash(uint64(height))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"height":  uint64(height),
+			"err":     err,
+		}).Error("GetBlockHashFunc get block hash failed.")
+		return nil
+	}
+	return C.CString(hash)
+}
@@ -23,6 +23,7 @@ package nvm
 
 // logger.
 void V8Log(int level, const char *msg);
+void ConsoleLogFunc(void *handler, int level, const char *msg);
 
 // require.
 char *RequireDelegateFunc(void *handler, const char *filename, size_t *lineOffset);
@@ -31,20 +32,36 @@ char *RequireDelegateFunc(void *handler, const char *filename, size_t *lineOffse
 char *StorageGetFunc(void *handler, const char *key);
 int StoragePutFunc(void *handler, const char *key, const char *value);
 int StorageDelFunc(void *handler, const char *key);
+char *StorageKeysFunc(void *handler, const char *field);
 
 // blockchain.
 char *GetTxByHashFunc(void *handler, const char *hash);
 char *GetAccountStateFunc(void *handler, const char *address);
 int TransferFunc(void *handler, const char *to, const char *value);
 int VerifyAddressFunc(void *handler, const char *address);
+char *RunContractSourceFunc(void *handler, const char *address, const char *funcName, const char *args);
+char *RandomFunc(void *handler, const char *seed);
+char *GetBlockHashFunc(void *handler, unsigned long long height);
+int SelfDestructFunc(void *handler, const char *beneficiary);
+char *RunPrecompileFunc(void *handler, const char *name, const char *input);
+char *RequestOracleFunc(void *handler, const char *query, const char *callback);
+int NonReentrantFunc(void *handler);
+char *DeployContractFunc(void *handler, const char *source, const char *sourceType, const char *codeHash, const char *args);
+
+// tracing.
+void TraceStepFunc(void *engine, int line, const char *function, size_t gas);
 
 // event.
 void EventTriggerFunc(void *handler, const char *topic, const char *data);
+int EventEmitFunc(void *handler, const char *name, const char *indexed, const char *data);
 
 // The gateway functions.
 void V8Log_cgo(int level, const char *msg) {
 	V8Log(level, msg);
 };
+void ConsoleLogFunc_cgo(void *handler, int level, const char *msg) {
+	ConsoleLogFunc(handler, level, msg);
+};
 
 char *RequireDelegateFunc_cgo(void *handler, const char *filename, size_t *lineOffset) {
 	return RequireDelegateFunc(handler, filename, lineOffset);
@@ -59,6 +76,9 @@ int StoragePutFunc_cgo(void *handler, const char *key, const char *value) {
 int StorageDelFunc_cgo(void *handler, const char *key) {
 	return StorageDelFunc(handler, key);
 };
+char *StorageKeysFunc_cgo(void *handler, const char *field) {
+	return StorageKeysFunc(handler, field);
+};
 
 char *GetTxByHashFunc_cgo(void *handler, const char *hash) {
 	return GetTxByHashFunc(handler, hash);
@@ -72,10 +92,41 @@ int TransferFunc_cgo(void *handler, const char *to, const char *value) {
 int VerifyAddressFunc_cgo(void *handler, const char *address) {
 	return VerifyAddressFunc(handler, address);
 };
+char *RunContractSourceFunc_cgo(void *handler, const char *address, const char *funcName, const char *args) {
+	return RunContractSourceFunc(handler, address, funcName, args);
+};
+char *RandomFunc_cgo(void *handler, const char *seed) {
+	return RandomFunc(handler, seed);
+};
+char *GetBlockHashFunc_cgo(void *handler, unsigned long long height) {
+	return GetBlockHashFunc(handler, height);
+};
+int SelfDestructFunc_cgo(void *handler, const char *beneficiary) {
+	return SelfDestructFunc(handler, beneficiary);
+};
+char *RunPrecompileFunc_cgo(void *handler, const char *name, const char *input) {
+	return RunPrecompileFunc(handler, name, input);
+};
+char *RequestOracleFunc_cgo(void *handler, const char *query, const char *callback) {
+	return RequestOracleFunc(handler, query, callback);
+};
+int NonReentrantFunc_cgo(void *handler) {
+	return NonReentrantFunc(handler);
+};
+char *DeployContractFunc_cgo(void *handler, const char *source, const char *sourceType, const char *codeHash, const char *args) {
+	return DeployContractFunc(handler, source, sourceType, codeHash, args);
+};
+
+void TraceStepFunc_cgo(void *engine, int line, const char *function, size_t gas) {
+	TraceStepFunc(engine, line, function, gas);
+};
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data) {
 	EventTriggerFunc(handler, topic, data);
 };
+int EventEmitFunc_cgo(void *handler, const char *name, const char *indexed, const char *data) {
+	return EventEmitFunc(handler, name, indexed, data);
+};
 
 */
 import "C"
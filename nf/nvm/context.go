@@ -20,19 +20,37 @@ package nvm
 
 import (
 	"encoding/json"
-
 	"errors"
+	"math/big"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/sha3"
 )
 
 const (
 	// DefaultLimitsOfTotalMemorySize default limits of total memory size
 	DefaultLimitsOfTotalMemorySize uint64 = 40 * 1000 * 1000
+
+	// MaxIndexedFields is the max number of indexed fields of a log
+	MaxIndexedFields = 3
+)
+
+// Errors of the blockchain functions for contracts
+var (
+	ErrInvalidTransferAddress = errors.New("invalid address to transfer")
+	ErrInvalidTransferValue   = errors.New("invalid value to transfer")
+	ErrInvalidLogName         = errors.New("invalid name of log")
+	ErrInvalidIndexedFields   = errors.New("indexed fields of log must be an array")
+	ErrTooManyIndexedFields   = errors.New("too many indexed fields of log")
+	ErrMissingContextBlock    = errors.New("no block in context")
+	ErrInvalidBeneficiary     = errors.New("invalid beneficiary of self-destruct")
+	ErrContractDestructed     = errors.New("contract has self-destructed")
 )
 
 // Block interface breaks cycle import dependency and hides unused services.
@@ -41,9 +59,19 @@ type Block interface {
 	Nonce() uint64
 	Hash() byteutils.Hash
 	Height() uint64
+	Timestamp() int64
+	ParentHash() byteutils.Hash
+	AncestorHash(height uint64) (byteutils.Hash, error)
 	VerifyAddress(str string) bool
 	SerializeTxByHash(hash byteutils.Hash) (proto.Message, error)
 	RecordEvent(txHash byteutils.Hash, topic, data string) error
+	ContractSource(contract state.Account) (owner byteutils.Hash, source, sourceType string, err error)
+	ContractLibraries(contract state.Account) (map[string]string, error)
+	LibrarySource(address string) (string, error)
+	RandomSeed() (byteutils.Hash, error)
+	ContractAddress(creator byteutils.Hash, nonce uint64) (byteutils.Hash, error)
+	ContractCode(codeHash byteutils.Hash) (source, sourceType string, libraries map[string]string, err error)
+	SetContractCode(contract state.Account, source, sourceType string, libraries map[string]string, abi *ABI) error
 }
 
 // AccountState context account state
@@ -54,10 +82,12 @@ type AccountState struct {
 
 // ContextBlock warpper block
 type ContextBlock struct {
-	Coinbase string `json:"coinbase"`
-	Nonce    uint64 `json:"nonce"`
-	Hash     string `json:"hash"`
-	Height   uint64 `json:"height"`
+	Coinbase   string `json:"coinbase"`
+	Nonce      uint64 `json:"nonce"`
+	Hash       string `json:"hash"`
+	Height     uint64 `json:"height"`
+	Timestamp  int64  `json:"timestamp"`
+	ParentHash string `json:"parentHash"`
 }
 
 // ContextTransaction warpper transaction
@@ -79,6 +109,67 @@ type Context struct {
 	owner    state.Account
 	contract state.Account
 	state    state.AccountState
+	// contracts calling this one in nested calls, the outermost first.
+	callers []byteutils.Hash
+	// transfers and logs of the contracts, shared by the nested calls.
+	effects *contractEffects
+	// whether the result of the function is returned, as the nested calls do.
+	keepResult bool
+	// records the steps of the execution when debugging, shared by the nested calls.
+	tracer *Tracer
+	// read-only calls of the API run on the warm isolates of the engine pool.
+	readOnly bool
+	// addresses of the libraries linked by the contract, by the names it requires them.
+	libraries map[string]string
+}
+
+// contractEffects are recorded in the block after the execution succeeds.
+type contractEffects struct {
+	transfers []*ContractTransfer
+	logs      []*ContractLog
+	destructs []*ContractDestruct
+	// count of randoms generated, so each call in the execution gets a different one.
+	randoms uint64
+	// console output of the contracts, only recorded in dev mode.
+	console []*ConsoleOutput
+	// queries of the contracts for the oracle operators.
+	oracleRequests []*OracleRequest
+	// keys of contract storage written, indexed by the contract and key.
+	stateDiffs     []*StateDiff
+	stateDiffIndex map[string]*StateDiff
+	// contracts deployed by the contracts.
+	creations []*ContractCreation
+}
+
+// ConsoleOutput is a line written to the console by a contract.
+type ConsoleOutput struct {
+	Address string `json:"address"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// consoleLevels are the names of the console levels, the same as V8Log.
+var consoleLevels = map[int]string{1: "debug", 2: "warn", 3: "info", 4: "error"}
+
+// ContractLog is an event emitted by a contract, filtered by the address and topics.
+type ContractLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+// ContractTransfer is a transfer sent from the balance of a contract.
+type ContractTransfer struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Value string `json:"value"`
+}
+
+// ContractDestruct is a self-destruct of a contract, its balance is sent to the beneficiary.
+type ContractDestruct struct {
+	Address     string `json:"address"`
+	Beneficiary string `json:"beneficiary"`
+	Value       string `json:"value"`
 }
 
 // NewContext create a engine context
@@ -89,10 +180,27 @@ func NewContext(block Block, tx *ContextTransaction, owner state.Account, contra
 		owner:    owner,
 		contract: contract,
 		state:    state,
+		effects:  new(contractEffects),
 	}
 	return ctx
 }
 
+// KeepResult makes the engine return the result of the function, for the simulated calls.
+func (ctx *Context) KeepResult() {
+	ctx.keepResult = true
+}
+
+// ReadOnly makes the engines of the execution and its nested calls take the isolates from the pool,
+// for the calls whose changes are discarded.
+func (ctx *Context) ReadOnly() {
+	ctx.readOnly = true
+}
+
+// Trace makes the engine record the steps of the execution in the tracer.
+func (ctx *Context) Trace(tracer *Tracer) {
+	ctx.tracer = tracer
+}
+
 // State returns account state
 func (ctx *Context) State() state.AccountState {
 	return ctx.state
@@ -108,19 +216,184 @@ func (ctx *Context) Contract() state.Account {
 	return ctx.contract
 }
 
+// Transfer sends value from the balance of contract to address.
+func (ctx *Context) Transfer(to, value string) error {
+	if ctx.block == nil || !ctx.block.VerifyAddress(to) {
+		return ErrInvalidTransferAddress
+	}
+	addr, err := byteutils.FromHex(to)
+	if err != nil {
+		return ErrInvalidTransferAddress
+	}
+	amount, ok := util.NewUint128().FromString(value)
+	if !ok || amount.Validate() != nil {
+		return ErrInvalidTransferValue
+	}
+	if err := ctx.contract.SubBalance(amount); err != nil {
+		return err
+	}
+	ctx.state.GetOrCreateUserAccount(addr).AddBalance(amount)
+
+	ctx.effects.transfers = append(ctx.effects.transfers, &ContractTransfer{
+		From:  ctx.contract.Address().String(),
+		To:    to,
+		Value: amount.String(),
+	})
+	return nil
+}
+
+// Transfers returns the transfers sent by the contracts in the execution, including the nested calls.
+func (ctx *Context) Transfers() []*ContractTransfer {
+	return ctx.effects.transfers
+}
+
+// SelfDestruct sends all the balance of contract to the beneficiary and destroys the contract
+// after the execution succeeds, its storage is dropped and it can't be called any more.
+func (ctx *Context) SelfDestruct(beneficiary string) error {
+	if ctx.block == nil || !ctx.block.VerifyAddress(beneficiary) {
+		return ErrInvalidBeneficiary
+	}
+	addr, err := byteutils.FromHex(beneficiary)
+	if err != nil || addr.Equals(ctx.contract.Address()) {
+		return ErrInvalidBeneficiary
+	}
+	address := ctx.contract.Address().String()
+	for _, v := range ctx.effects.destructs {
+		if v.Address == address {
+			return ErrContractDestructed
+		}
+	}
+
+	value := util.NewUint128FromBigInt(new(big.Int).Set(ctx.contract.Balance().Int))
+	if err := ctx.contract.SubBalance(value); err != nil {
+		return err
+	}
+	ctx.state.GetOrCreateUserAccount(addr).AddBalance(value)
+
+	ctx.effects.destructs = append(ctx.effects.destructs, &ContractDestruct{
+		Address:     address,
+		Beneficiary: beneficiary,
+		Value:       value.String(),
+	})
+	return nil
+}
+
+// Destructs returns the self-destructs of the contracts in the execution, including the nested calls.
+func (ctx *Context) Destructs() []*ContractDestruct {
+	return ctx.effects.destructs
+}
+
+// EmitLog emits a log of contract, topics are the name and the values of the indexed fields.
+func (ctx *Context) EmitLog(name, indexed, data string) error {
+	if len(name) == 0 {
+		return ErrInvalidLogName
+	}
+	topics := []string{name}
+	if len(indexed) > 0 {
+		var fields []json.RawMessage
+		if err := json.Unmarshal([]byte(indexed), &fields); err != nil {
+			return ErrInvalidIndexedFields
+		}
+		if len(fields) > MaxIndexedFields {
+			return ErrTooManyIndexedFields
+		}
+		for _, v := range fields {
+			topics = append(topics, indexedTopic(v))
+		}
+	}
+	ctx.effects.logs = append(ctx.effects.logs, &ContractLog{
+		Address: ctx.contract.Address().String(),
+		Topics:  topics,
+		Data:    data,
+	})
+	return nil
+}
+
+// indexedTopic returns the string itself for a string field, otherwise the JSON of the field.
+func indexedTopic(field json.RawMessage) string {
+	var str string
+	if err := json.Unmarshal(field, &str); err == nil {
+		return str
+	}
+	return string(field)
+}
+
+// Logs returns the logs emitted by the contracts in the execution, including the nested calls.
+func (ctx *Context) Logs() []*ContractLog {
+	return ctx.effects.logs
+}
+
+// ConsoleLog writes the console output of contract to the node log, and records it for the execution.
+func (ctx *Context) ConsoleLog(level int, msg string) {
+	levelName, ok := consoleLevels[level]
+	if !ok {
+		levelName = consoleLevels[4]
+	}
+	output := &ConsoleOutput{
+		Address: ctx.contract.Address().String(),
+		Level:   levelName,
+		Message: msg,
+	}
+	ctx.effects.console = append(ctx.effects.console, output)
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tx":       ctx.tx.Hash,
+		"contract": output.Address,
+		"level":    output.Level,
+	}).Info(msg)
+}
+
+// Console returns the console output of the contracts in the execution, including the nested calls.
+func (ctx *Context) Console() []*ConsoleOutput {
+	return ctx.effects.console
+}
+
+// Random returns a random hex hash derived from the seed signed by the block's proposer,
+// which can't be predicted before the block is proposed or chosen by the proposer.
+func (ctx *Context) Random(seed string) (string, error) {
+	if ctx.block == nil {
+		return "", ErrMissingContextBlock
+	}
+	blockSeed, err := ctx.block.RandomSeed()
+	if err != nil {
+		return "", err
+	}
+	hasher := sha3.New256()
+	hasher.Write(blockSeed)
+	hasher.Write([]byte(ctx.tx.Hash))
+	hasher.Write([]byte(seed))
+	hasher.Write(byteutils.FromUint64(ctx.effects.randoms))
+	ctx.effects.randoms++
+	return byteutils.Hex(hasher.Sum(nil)), nil
+}
+
+// BlockHash returns the hash of the block at height, only the recent blocks are visible.
+func (ctx *Context) BlockHash(height uint64) (string, error) {
+	if ctx.block == nil {
+		return "", ErrMissingContextBlock
+	}
+	hash, err := ctx.block.AncestorHash(height)
+	if err != nil {
+		return "", err
+	}
+	return hash.String(), nil
+}
+
 // SerializeContextBlock Serialize current block
 func (ctx *Context) SerializeContextBlock() ([]byte, error) {
 
 	if ctx.block != nil {
 		block := &ContextBlock{
-			Coinbase: ctx.block.CoinbaseHash().String(),
-			Nonce:    ctx.block.Nonce(),
-			Hash:     ctx.block.Hash().String(),
-			Height:   ctx.block.Height(),
+			Coinbase:   ctx.block.CoinbaseHash().String(),
+			Nonce:      ctx.block.Nonce(),
+			Hash:       ctx.block.Hash().String(),
+			Height:     ctx.block.Height(),
+			Timestamp:  ctx.block.Timestamp(),
+			ParentHash: ctx.block.ParentHash().String(),
 		}
 		return json.Marshal(block)
 	}
-	return nil, errors.New("no block in context")
+	return nil, ErrMissingContextBlock
 }
 
 // SerializeContextTx Serialize current tx
@@ -0,0 +1,237 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext_Transfer(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
+	contractAddr, _ := byteutils.FromHex("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4")
+	contract, _ := context.CreateContractAccount(contractAddr, nil)
+	contract.AddBalance(util.NewUint128FromInt(100))
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	to := "22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09"
+	toAddr, _ := byteutils.FromHex(to)
+
+	tests := []struct {
+		name    string
+		to      string
+		value   string
+		wantErr error
+	}{
+		{"normal", to, "30", nil},
+		{"invalid address", "not hex", "30", ErrInvalidTransferAddress},
+		{"negative value", to, "-30", ErrInvalidTransferValue},
+		{"invalid value", to, "thirty", ErrInvalidTransferValue},
+		{"insufficient balance", to, "71", state.ErrBalanceInsufficient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, ctx.Transfer(tt.to, tt.value))
+		})
+	}
+
+	assert.Equal(t, "70", contract.Balance().String())
+	assert.Equal(t, "30", context.GetOrCreateUserAccount(toAddr).Balance().String())
+	assert.Equal(t, []*ContractTransfer{{From: contractAddr.String(), To: to, Value: "30"}}, ctx.Transfers())
+}
+
+func TestContext_SelfDestruct(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
+	contractAddr, _ := byteutils.FromHex("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4")
+	contract, _ := context.CreateContractAccount(contractAddr, nil)
+	contract.AddBalance(util.NewUint128FromInt(100))
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	beneficiary := "22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09"
+	beneficiaryAddr, _ := byteutils.FromHex(beneficiary)
+
+	assert.Equal(t, ErrInvalidBeneficiary, ctx.SelfDestruct("not hex"))
+	assert.Equal(t, ErrInvalidBeneficiary, ctx.SelfDestruct(contractAddr.String()))
+	assert.Nil(t, ctx.SelfDestruct(beneficiary))
+	assert.Equal(t, ErrContractDestructed, ctx.SelfDestruct(beneficiary))
+
+	assert.Equal(t, "0", contract.Balance().String())
+	assert.Equal(t, "100", context.GetOrCreateUserAccount(beneficiaryAddr).Balance().String())
+	assert.Equal(t, []*ContractDestruct{{Address: contractAddr.String(), Beneficiary: beneficiary, Value: "100"}}, ctx.Destructs())
+}
+
+func TestContext_EmitLog(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
+	contractAddr, _ := byteutils.FromHex("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4")
+	contract, _ := context.CreateContractAccount(contractAddr, nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	tests := []struct {
+		name    string
+		event   string
+		indexed string
+		data    string
+		wantErr error
+	}{
+		{"normal", "Transfer", `["alice", 3]`, `{"value":"10"}`, nil},
+		{"no indexed", "Approval", "", `null`, nil},
+		{"empty name", "", `[]`, `null`, ErrInvalidLogName},
+		{"not array", "Transfer", `{"from":"alice"}`, `null`, ErrInvalidIndexedFields},
+		{"too many indexed", "Transfer", `[1, 2, 3, 4]`, `null`, ErrTooManyIndexedFields},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, ctx.EmitLog(tt.event, tt.indexed, tt.data))
+		})
+	}
+
+	assert.Equal(t, []*ContractLog{
+		{Address: contractAddr.String(), Topics: []string{"Transfer", "alice", "3"}, Data: `{"value":"10"}`},
+		{Address: contractAddr.String(), Topics: []string{"Approval"}, Data: `null`},
+	}, ctx.Logs())
+}
+
+func TestContext_StateDiffs(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
+	contractAddr, _ := byteutils.FromHex("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4")
+	contract, _ := context.CreateContractAccount(contractAddr, nil)
+	assert.Nil(t, storagePut(contract, "kept", []byte("a")))
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	put := func(key, value string) {
+		ctx.recordStateDiff(contract, key, []byte(value))
+		assert.Nil(t, storagePut(contract, key, []byte(value)))
+	}
+	put("kept", "b")
+	put("kept", "a")
+	put("added", "c")
+	put("added", "d")
+	ctx.recordStateDiff(contract, "kept", nil)
+	assert.Nil(t, storageDel(contract, "kept"))
+
+	assert.Equal(t, []*StateDiff{
+		{Contract: contractAddr.String(), Key: "kept", OldHash: stateValueHash([]byte("a")), NewHash: ""},
+		{Contract: contractAddr.String(), Key: "added", OldHash: "", NewHash: stateValueHash([]byte("d"))},
+	}, ctx.StateDiffs())
+}
+
+func TestContext_NonReentrant(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+	assert.Nil(t, ctx.NonReentrant())
+
+	ctx.callers = []byteutils.Hash{[]byte("account3")}
+	assert.Nil(t, ctx.NonReentrant())
+	ctx.callers = []byteutils.Hash{[]byte("account2"), []byte("account3")}
+	assert.Equal(t, ErrReentrantCall, ctx.NonReentrant())
+}
+
+type mockUnsignedBlock struct {
+	mockBlock
+}
+
+func (m *mockUnsignedBlock) RandomSeed() (byteutils.Hash, error) {
+	return nil, errors.New("block random is not signed yet")
+}
+
+func TestContext_Random(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
+	contractAddr, _ := byteutils.FromHex("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4")
+	contract, _ := context.CreateContractAccount(contractAddr, nil)
+
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+	first, err := ctx.Random("lottery")
+	assert.Nil(t, err)
+	assert.Equal(t, 64, len(first))
+	second, err := ctx.Random("lottery")
+	assert.Nil(t, err)
+	assert.NotEqual(t, first, second)
+
+	// the same block, tx and seed always replay the same randoms.
+	replay := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+	random, _ := replay.Random("lottery")
+	assert.Equal(t, first, random)
+	random, _ = replay.Random("dice")
+	assert.NotEqual(t, second, random)
+
+	unsigned := NewContext(new(mockUnsignedBlock), testContextTransaction(), owner, contract, context)
+	_, err = unsigned.Random("lottery")
+	assert.NotNil(t, err)
+}
+
+func TestContext_BlockHash(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
+	contractAddr, _ := byteutils.FromHex("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4")
+	contract, _ := context.CreateContractAccount(contractAddr, nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	hash, err := ctx.BlockHash(1)
+	assert.Nil(t, err)
+	assert.Equal(t, ctx.block.ParentHash().String(), hash)
+	_, err = ctx.BlockHash(2)
+	assert.NotNil(t, err)
+
+	data, err := ctx.SerializeContextBlock()
+	assert.Nil(t, err)
+	block := new(ContextBlock)
+	assert.Nil(t, json.Unmarshal(data, block))
+	assert.Equal(t, int64(1520000000), block.Timestamp)
+	assert.Equal(t, hash, block.ParentHash)
+
+	_, err = NewContext(nil, testContextTransaction(), owner, contract, context).BlockHash(1)
+	assert.Equal(t, ErrMissingContextBlock, err)
+}
+
+func TestContext_ConsoleLog(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
+	contractAddr, _ := byteutils.FromHex("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4")
+	contract, _ := context.CreateContractAccount(contractAddr, nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	ctx.ConsoleLog(3, "balance: 10")
+	ctx.ConsoleLog(7, "unknown level")
+	assert.Equal(t, []*ConsoleOutput{
+		{Address: contractAddr.String(), Level: "info", Message: "balance: 10"},
+		{Address: contractAddr.String(), Level: "error", Message: "unknown level"},
+	}, ctx.Console())
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxContractCallDepth is the default max number of contracts on the stack of nested calls.
+const MaxContractCallDepth = 8
+
+// Errors of nested contract calls
+var (
+	ErrExceedCallDepth     = errors.New("out of resource: exceed max depth of contract calls")
+	ErrReentrantCall       = errors.New("reentrant contract call is not allowed")
+	ErrInvalidCallContract = errors.New("invalid contract to call")
+)
+
+// NonReentrant fails the call if the running contract is already on the stack of its callers,
+// so a function guarded by it can't be entered again before it returns.
+func (ctx *Context) NonReentrant() error {
+	for _, v := range ctx.callers {
+		if v.Equals(ctx.contract.Address()) {
+			return ErrReentrantCall
+		}
+	}
+	return nil
+}
+
+// RunContract calls the function of the contract at address from the contract running in ctx.
+// The callee executes at most gasLimit instructions, it returns the JSON of the function's
+// result and the instructions executed. A contract can't be called again while it's on the stack.
+func RunContract(ctx *Context, address, function, args string, gasLimit, memLimit uint64) (string, uint64, error) {
+	if ctx.block == nil {
+		return "", 0, ErrInvalidCallContract
+	}
+	callers := make([]byteutils.Hash, len(ctx.callers), len(ctx.callers)+1)
+	copy(callers, ctx.callers)
+	callers = append(callers, ctx.contract.Address())
+	if uint64(len(callers)) >= ExecutionLimitsAt(ctx.block.Height()).MaxCallDepth {
+		return "", 0, ErrExceedCallDepth
+	}
+
+	addr, err := byteutils.FromHex(address)
+	if err != nil || !ctx.block.VerifyAddress(address) {
+		return "", 0, ErrInvalidCallContract
+	}
+	for _, v := range callers {
+		if v.Equals(addr) {
+			return "", 0, ErrReentrantCall
+		}
+	}
+	contract, err := ctx.state.GetContractAccount(addr)
+	if err != nil {
+		return "", 0, ErrInvalidCallContract
+	}
+	owner, source, sourceType, err := ctx.block.ContractSource(contract)
+	if err != nil {
+		return "", 0, err
+	}
+	libraries, err := ctx.block.ContractLibraries(contract)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// the callee sees the calling contract as the sender, without value.
+	tx := *ctx.tx
+	tx.From = ctx.contract.Address().String()
+	tx.To = address
+	tx.Value = "0"
+	nested := NewContext(ctx.block, &tx, ctx.state.GetOrCreateUserAccount(owner), contract, ctx.state)
+	nested.callers = callers
+	nested.effects = ctx.effects
+	nested.tracer = ctx.tracer
+	nested.readOnly = ctx.readOnly
+	nested.libraries = libraries
+
+	engine := NewEngine(nested, sourceType)
+	defer engine.Dispose()
+
+	engine.SetExecutionLimits(gasLimit, memLimit)
+	err = engine.Call(source, sourceType, function, args)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"from":     tx.From,
+		"to":       address,
+		"function": function,
+		"depth":    len(callers),
+		"gas":      engine.ExecutionInstructions(),
+		"err":      err,
+	}).Debug("Called contract from contract.")
+	return engine.Result(), engine.ExecutionInstructions(), err
+}
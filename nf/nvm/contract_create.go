@@ -0,0 +1,147 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors of contracts deploying contracts
+var (
+	ErrInvalidCreateCode    = errors.New("invalid code of contract to deploy")
+	ErrCreateCodeTooLarge   = errors.New("code of contract to deploy is too large")
+	ErrInvalidCreateContext = errors.New("contract can't be deployed out of a block")
+)
+
+// ContractCreation is a contract deployed by another contract.
+type ContractCreation struct {
+	Creator string `json:"creator"`
+	Address string `json:"address"`
+}
+
+// CreateContract deploys a new contract from the contract running in ctx, with the source,
+// or with the code at codeHash if the source is empty, and runs its init with args.
+// The new contract executes at most gasLimit instructions besides the gas of its code,
+// it returns the address of the new contract and the gas used.
+func CreateContract(ctx *Context, source, sourceType, codeHash, args string, gasLimit, memLimit uint64) (string, uint64, error) {
+	if ctx.block == nil {
+		return "", 0, ErrInvalidCreateContext
+	}
+	callers := make([]byteutils.Hash, len(ctx.callers), len(ctx.callers)+1)
+	copy(callers, ctx.callers)
+	callers = append(callers, ctx.contract.Address())
+	if uint64(len(callers)) >= ExecutionLimitsAt(ctx.block.Height()).MaxCallDepth {
+		return "", 0, ErrExceedCallDepth
+	}
+
+	var libraries map[string]string
+	if len(codeHash) > 0 {
+		if len(source) > 0 {
+			return "", 0, ErrInvalidCreateCode
+		}
+		h, err := byteutils.FromHex(codeHash)
+		if err != nil {
+			return "", 0, ErrInvalidCreateCode
+		}
+		if source, sourceType, libraries, err = ctx.block.ContractCode(h); err != nil {
+			return "", 0, err
+		}
+	}
+	if len(source) == 0 {
+		return "", 0, ErrInvalidCreateCode
+	}
+	switch sourceType {
+	case SourceTypeJavaScript, SourceTypeTypeScript, SourceTypeWasm:
+	default:
+		return "", 0, ErrInvalidCreateCode
+	}
+
+	table := GasTableAt(ctx.block.Height())
+	if table.MaxCodeSize > 0 && len(source) > int(table.MaxCodeSize) {
+		return "", 0, ErrCreateCodeTooLarge
+	}
+	codeGas := table.CodeGas(len(source)).Uint64()
+	if gasLimit > 0 {
+		if codeGas >= gasLimit {
+			return "", gasLimit, ErrInsufficientGas
+		}
+		gasLimit -= codeGas
+	}
+
+	// the address is derived from the creator and its nonce, which only counts the contracts it deployed.
+	creator := ctx.contract
+	addr, err := ctx.block.ContractAddress(creator.Address(), creator.Nonce())
+	if err != nil {
+		return "", 0, err
+	}
+	creator.IncrNonce()
+	txHash, err := byteutils.FromHex(ctx.tx.Hash)
+	if err != nil {
+		return "", 0, err
+	}
+	contract, err := ctx.state.CreateContractAccount(addr, txHash)
+	if err != nil {
+		return "", 0, err
+	}
+	contract.SetRentHeight(ctx.block.Height())
+
+	// the new contract sees the creator as the sender, without value.
+	tx := *ctx.tx
+	tx.From = creator.Address().String()
+	tx.To = addr.String()
+	tx.Value = "0"
+	nested := NewContext(ctx.block, &tx, ctx.owner, contract, ctx.state)
+	nested.callers = callers
+	nested.effects = ctx.effects
+	nested.tracer = ctx.tracer
+	nested.readOnly = ctx.readOnly
+	nested.libraries = libraries
+
+	engine := NewEngine(nested, sourceType)
+	defer engine.Dispose()
+
+	engine.SetExecutionLimits(gasLimit, memLimit)
+	err = engine.DeployAndInit(source, sourceType, args)
+	gas := codeGas + engine.ExecutionInstructions()
+	if err == nil {
+		err = ctx.block.SetContractCode(contract, source, sourceType, libraries, engine.ABI())
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"creator": tx.From,
+		"address": tx.To,
+		"depth":   len(callers),
+		"gas":     gas,
+		"err":     err,
+	}).Debug("Deployed contract from contract.")
+	if err != nil {
+		return "", gas, err
+	}
+	ctx.effects.creations = append(ctx.effects.creations, &ContractCreation{Creator: tx.From, Address: tx.To})
+	return tx.To, gas, nil
+}
+
+// Creations returns the contracts deployed by the contracts in the execution, including the nested calls.
+func (ctx *Context) Creations() []*ContractCreation {
+	return ctx.effects.creations
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+// Engine executes the contracts of a source type.
+type Engine interface {
+	SetExecutionLimits(limitsOfExecutionInstructions, limitsOfTotalMemorySize uint64)
+	ExecutionInstructions() uint64
+	DeployAndInit(source, sourceType, args string) error
+	Call(source, sourceType, function, args string) error
+	Result() string
+	ABI() *ABI
+	Dispose()
+}
+
+// NewEngine return the engine running the contracts of the source type.
+func NewEngine(ctx *Context, sourceType string) Engine {
+	if sourceType == SourceTypeWasm {
+		return NewWasmEngine(ctx)
+	}
+	return NewV8Engine(ctx)
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+/*
+#include "v8/engine.h"
+*/
+import "C"
+
+import (
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultV8EnginePoolSize is the number of warm V8 isolates kept for the read-only calls.
+const DefaultV8EnginePoolSize = 8
+
+// v8enginePool keeps the idle V8 isolates of the read-only calls, so the calls of the API
+// run in parallel without paying the creation of an isolate each.
+type v8enginePool struct {
+	idle chan *C.V8Engine
+}
+
+var (
+	enginePool   = &v8enginePool{idle: make(chan *C.V8Engine, DefaultV8EnginePoolSize)}
+	enginePoolMu sync.RWMutex
+)
+
+// SetV8EnginePoolSize sets the number of warm V8 isolates kept for the read-only calls,
+// DefaultV8EnginePoolSize if 0.
+func SetV8EnginePoolSize(size int) {
+	if size <= 0 {
+		size = DefaultV8EnginePoolSize
+	}
+
+	enginePoolMu.Lock()
+	old := enginePool
+	enginePool = &v8enginePool{idle: make(chan *C.V8Engine, size)}
+	enginePoolMu.Unlock()
+
+	old.drain()
+	logging.CLog().WithFields(logrus.Fields{
+		"size": size,
+	}).Info("Set V8 engine pool size.")
+}
+
+// acquireV8Engine returns an idle isolate of the pool, or a new one if none is idle.
+func acquireV8Engine() *C.V8Engine {
+	enginePoolMu.RLock()
+	defer enginePoolMu.RUnlock()
+
+	select {
+	case e := <-enginePool.idle:
+		return e
+	default:
+		return C.CreateEngine()
+	}
+}
+
+// releaseV8Engine resets the isolate and keeps it in the pool, or deletes it if the pool is full.
+func releaseV8Engine(e *C.V8Engine) {
+	C.ResetEngine(e)
+
+	enginePoolMu.RLock()
+	defer enginePoolMu.RUnlock()
+
+	select {
+	case enginePool.idle <- e:
+	default:
+		C.DeleteEngine(e)
+	}
+}
+
+// drain deletes the idle isolates of the pool.
+func (p *v8enginePool) drain() {
+	for {
+		select {
+		case e := <-p.idle:
+			C.DeleteEngine(e)
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestV8EnginePool(t *testing.T) {
+	source := `var Counter = function () {
+    LocalContractStorage.defineProperty(this, "count");
+};
+Counter.prototype = {
+    init: function () {},
+    incr: function (n) {
+        var count = this.count || 0;
+        this.count = count + n;
+        return this.count;
+    }
+};
+module.exports = Counter;
+`
+	SetV8EnginePoolSize(2)
+	defer SetV8EnginePoolSize(0)
+
+	call := func(readOnly bool) (string, uint64) {
+		mem, _ := storage.NewMemoryStorage()
+		context, _ := state.NewAccountState(nil, mem)
+		owner := context.GetOrCreateUserAccount([]byte("account1"))
+		contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+		ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+		ctx.KeepResult()
+		if readOnly {
+			ctx.ReadOnly()
+		}
+
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 100000000)
+		assert.Nil(t, engine.Call(source, "js", "incr", "[2]"))
+		return engine.Result(), engine.ExecutionInstructions()
+	}
+
+	// pooled isolates run the calls as new ones do.
+	result, instructions := call(false)
+	assert.Equal(t, 0, len(enginePool.idle))
+	for i := 0; i < 3; i++ {
+		r, n := call(true)
+		assert.Equal(t, result, r)
+		assert.Equal(t, instructions, n)
+		assert.Equal(t, 1, len(enginePool.idle))
+	}
+
+	// concurrent calls keep at most the pool size of idle isolates.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, n := call(true)
+			assert.Equal(t, result, r)
+			assert.Equal(t, instructions, n)
+		}()
+	}
+	wg.Wait()
+	assert.True(t, len(enginePool.idle) <= 2)
+}
@@ -27,19 +27,32 @@ package nvm
 
 // Forward declaration.
 void V8Log_cgo(int level, const char *msg);
+void ConsoleLogFunc_cgo(void *handler, int level, const char *msg);
 
 char *RequireDelegateFunc_cgo(void *handler, const char *filename, size_t *lineOffset);
 
 char *StorageGetFunc_cgo(void *handler, const char *key);
 int StoragePutFunc_cgo(void *handler, const char *key, const char *value);
 int StorageDelFunc_cgo(void *handler, const char *key);
+char *StorageKeysFunc_cgo(void *handler, const char *field);
 
 char *GetTxByHashFunc_cgo(void *handler, const char *hash);
 char *GetAccountStateFunc_cgo(void *handler, const char *address);
 int TransferFunc_cgo(void *handler, const char *to, const char *value);
 int VerifyAddressFunc_cgo(void *handler, const char *address);
+char *RunContractSourceFunc_cgo(void *handler, const char *address, const char *funcName, const char *args);
+char *RandomFunc_cgo(void *handler, const char *seed);
+char *GetBlockHashFunc_cgo(void *handler, unsigned long long height);
+int SelfDestructFunc_cgo(void *handler, const char *beneficiary);
+char *RunPrecompileFunc_cgo(void *handler, const char *name, const char *input);
+char *RequestOracleFunc_cgo(void *handler, const char *query, const char *callback);
+int NonReentrantFunc_cgo(void *handler);
+char *DeployContractFunc_cgo(void *handler, const char *source, const char *sourceType, const char *codeHash, const char *args);
+
+void TraceStepFunc_cgo(void *engine, int line, const char *function, size_t gas);
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data);
+int EventEmitFunc_cgo(void *handler, const char *name, const char *indexed, const char *data);
 
 */
 import "C"
@@ -69,7 +82,7 @@ var (
 	ErrDisallowCallPrivateFunction    = errors.New("disallow call private function")
 	ErrExecutionTimeout               = errors.New("execution timeout")
 	ErrInsufficientGas                = errors.New("insufficient gas")
-	ErrExceedMemoryLimits             = errors.New("exceed memory limits")
+	ErrExceedMemoryLimits             = errors.New("out of resource: exceed memory limits")
 	ErrInjectTracingInstructionFailed = errors.New("inject tracing instructions failed")
 	ErrTranspileTypeScriptFailed      = errors.New("transpile TypeScript failed")
 	ErrUnsupportedSourceType          = errors.New("unsupported source type")
@@ -97,6 +110,22 @@ type V8Engine struct {
 	actualTotalMemorySize              uint64
 	lcsHandler                         uint64
 	gcsHandler                         uint64
+	result                             string
+	abi                                *ABI
+	// gas table of the block, injected into the contracts by the instruction counter.
+	gasTable *GasTable
+	// version of the Decimal library of the block.
+	mathLib uint32
+	// whether the builtins are guarded by the deterministic sandbox in the block.
+	sandbox bool
+	// whether the TypeScript contracts deployed in the block are type checked.
+	typeCheck bool
+	// wall-clock time after which the execution is terminated.
+	timeout time.Duration
+	// the first failure of the contracts called by this one, which fails the execution.
+	callErr error
+	// whether the isolate is taken from the pool of the read-only calls.
+	pooled bool
 }
 
 // InitV8Engine initialize the v8 engine.
@@ -104,19 +133,22 @@ func InitV8Engine() {
 	C.Initialize()
 
 	// Logger.
-	C.InitializeLogger((C.LogFunc)(unsafe.Pointer(C.V8Log_cgo)))
+	C.InitializeLogger((C.LogFunc)(unsafe.Pointer(C.V8Log_cgo)), (C.ConsoleLogFunc)(unsafe.Pointer(C.ConsoleLogFunc_cgo)))
 
 	// Require.
 	C.InitializeRequireDelegate((C.RequireDelegate)(unsafe.Pointer(C.RequireDelegateFunc_cgo)))
 
 	// Storage.
-	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)))
+	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)), (C.StorageKeysFunc)(unsafe.Pointer(C.StorageKeysFunc_cgo)))
 
 	// Blockchain.
-	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)))
+	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)), (C.RunContractSourceFunc)(unsafe.Pointer(C.RunContractSourceFunc_cgo)), (C.RandomFunc)(unsafe.Pointer(C.RandomFunc_cgo)), (C.GetBlockHashFunc)(unsafe.Pointer(C.GetBlockHashFunc_cgo)), (C.SelfDestructFunc)(unsafe.Pointer(C.SelfDestructFunc_cgo)), (C.RunPrecompileFunc)(unsafe.Pointer(C.RunPrecompileFunc_cgo)), (C.RequestOracleFunc)(unsafe.Pointer(C.RequestOracleFunc_cgo)), (C.NonReentrantFunc)(unsafe.Pointer(C.NonReentrantFunc_cgo)), (C.DeployContractFunc)(unsafe.Pointer(C.DeployContractFunc_cgo)))
 
 	// Event.
-	C.InitializeEvent((C.EventTriggerFunc)(unsafe.Pointer(C.EventTriggerFunc_cgo)))
+	C.InitializeEvent((C.EventTriggerFunc)(unsafe.Pointer(C.EventTriggerFunc_cgo)), (C.EventEmitFunc)(unsafe.Pointer(C.EventEmitFunc_cgo)))
+
+	// Tracer.
+	C.InitializeTracer((C.TraceStepFunc)(unsafe.Pointer(C.TraceStepFunc_cgo)))
 }
 
 // DisposeV8Engine dispose the v8 engine.
@@ -130,15 +162,36 @@ func NewV8Engine(ctx *Context) *V8Engine {
 		InitV8Engine()
 	})
 
+	pooled := ctx != nil && ctx.readOnly
+	var v8engine *C.V8Engine
+	if pooled {
+		v8engine = acquireV8Engine()
+	} else {
+		v8engine = C.CreateEngine()
+	}
+
 	engine := &V8Engine{
 		ctx:                                ctx,
 		modules:                            NewModules(),
-		v8engine:                           C.CreateEngine(),
+		v8engine:                           v8engine,
+		pooled:                             pooled,
 		enableLimits:                       false,
 		limitsOfExecutionInstructions:      0,
 		limitsOfTotalMemorySize:            0,
 		actualCountOfExecutionInstructions: 0,
 		actualTotalMemorySize:              0,
+		gasTable:                           DefaultGasTable,
+		mathLib:                            MathLibVersionAt(0),
+		sandbox:                            SandboxAt(0),
+		typeCheck:                          TypeCheckAt(0),
+		timeout:                            ExecutionLimitsAt(0).Timeout,
+	}
+	if ctx != nil && ctx.block != nil {
+		engine.gasTable = GasTableAt(ctx.block.Height())
+		engine.mathLib = MathLibVersionAt(ctx.block.Height())
+		engine.sandbox = SandboxAt(ctx.block.Height())
+		engine.typeCheck = TypeCheckAt(ctx.block.Height())
+		engine.timeout = ExecutionLimitsAt(ctx.block.Height()).Timeout
 	}
 
 	(func() {
@@ -173,6 +226,10 @@ func (e *V8Engine) Dispose() {
 	delete(engines, e.v8engine)
 	enginesLock.Unlock()
 
+	if e.pooled {
+		releaseV8Engine(e.v8engine)
+		return
+	}
 	C.DeleteEngine(e.v8engine)
 }
 
@@ -205,7 +262,7 @@ func (e *V8Engine) SetExecutionLimits(limitsOfExecutionInstructions, limitsOfTot
 	e.enableLimits = limitsOfExecutionInstructions != 0 || limitsOfTotalMemorySize != 0
 
 	// V8 needs at least 6M heap memory.
-	if limitsOfTotalMemorySize > 0 && limitsOfTotalMemorySize < 6000000 {
+	if limitsOfTotalMemorySize > 0 && limitsOfTotalMemorySize < MinLimitsOfTotalMemorySize {
 		logging.VLog().Warnf("V8 needs at least 6M (6000000) heap memory, your limitsOfTotalMemorySize (%d) is too low.", limitsOfTotalMemorySize)
 	}
 }
@@ -217,11 +274,25 @@ func (e *V8Engine) ExecutionInstructions() uint64 {
 
 // TranspileTypeScript transpile typescript to javascript and return it.
 func (e *V8Engine) TranspileTypeScript(source string) (string, int, error) {
+	return e.transpileTypeScript(source, false)
+}
+
+// transpileTypeScript transpile typescript to javascript, type checking it first if typeCheck is true.
+// The type check isn't metered, so it's refused for the sources larger than MaxTypeCheckSourceSize.
+func (e *V8Engine) transpileTypeScript(source string, typeCheck bool) (string, int, error) {
+	if typeCheck && len(source) > MaxTypeCheckSourceSize {
+		return "", 0, ErrContractSourceTooLarge
+	}
+
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
 
+	cTypeCheck := C.int(0)
+	if typeCheck {
+		cTypeCheck = 1
+	}
 	lineOffset := C.int(0)
-	jsSource := C.TranspileTypeScriptModule(e.v8engine, cSource, &lineOffset)
+	jsSource := C.TranspileTypeScriptModule(e.v8engine, cSource, cTypeCheck, &lineOffset)
 	if jsSource == nil {
 		return "", 0, ErrTranspileTypeScriptFailed
 	}
@@ -233,11 +304,17 @@ func (e *V8Engine) TranspileTypeScript(source string) (string, int, error) {
 
 // InjectTracingInstructions process the source to inject tracing instructions.
 func (e *V8Engine) InjectTracingInstructions(source string) (string, int, error) {
+	return e.injectTracingInstructions(source, e.gasTable.String())
+}
+
+func (e *V8Engine) injectTracingInstructions(source, gasTable string) (string, int, error) {
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
+	cGasTable := C.CString(gasTable)
+	defer C.free(unsafe.Pointer(cGasTable))
 
 	lineOffset := C.int(0)
-	traceableCSource := C.InjectTracingInstructions(e.v8engine, cSource, &lineOffset)
+	traceableCSource := C.InjectTracingInstructions(e.v8engine, cSource, cGasTable, &lineOffset)
 	if traceableCSource == nil {
 		return "", 0, ErrInjectTracingInstructionFailed
 	}
@@ -268,12 +345,15 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
-	var ret C.int
+	var (
+		ret     C.int
+		cResult *C.char
+	)
 
 	done := make(chan bool, 1)
 	go func() {
-		ret = C.RunScriptSource(e.v8engine, cSource, C.int(sourceLineOffset), C.uintptr_t(e.lcsHandler),
-			C.uintptr_t(e.gcsHandler))
+		ret = C.RunScriptSourceWithResult(e.v8engine, cSource, C.int(sourceLineOffset), C.uintptr_t(e.lcsHandler),
+			C.uintptr_t(e.gcsHandler), &cResult)
 		done <- true
 	}()
 
@@ -282,7 +362,7 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 		if ret != 0 {
 			err = ErrExecutionFailed
 		}
-	case <-time.After(10 * time.Second):
+	case <-time.After(e.timeout):
 		C.TerminateExecution(e.v8engine)
 		err = ErrExecutionTimeout
 
@@ -290,10 +370,24 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 		select {
 		case <-done:
 		}
+		e.ctx.audit(&AuditEvent{Kind: AuditTimeout})
+	}
+
+	if cResult != nil {
+		e.result = C.GoString(cResult)
+		C.free(unsafe.Pointer(cResult))
+	}
+
+	// state changed by a failed callee can't be reverted alone, fail the whole execution.
+	if e.callErr != nil && (err == nil || err == ErrExecutionFailed) {
+		err = e.callErr
 	}
 
 	// collect tracing stats.
 	e.CollectTracingStats()
+	if killed := e.ctx.audit(&AuditEvent{Kind: AuditMemory, Memory: e.actualTotalMemorySize}); killed != nil && err == nil {
+		err = killed
+	}
 
 	if e.enableLimits {
 		// check limits.
@@ -308,10 +402,19 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (err err
 			e.actualCountOfExecutionInstructions = e.limitsOfExecutionInstructions
 		}
 	}
-
 	return
 }
 
+// Result returns the JSON of the value returned by the function, only kept in nested and simulated calls.
+func (e *V8Engine) Result() string {
+	return e.result
+}
+
+// ABI returns the ABI of the contract deployed by the engine, nil if not deployed.
+func (e *V8Engine) ABI() *ABI {
+	return e.abi
+}
+
 // Call function in a script
 func (e *V8Engine) Call(source, sourceType, function, args string) error {
 	if publicFuncNameChecker.MatchString(function) == false || strings.EqualFold("init", function) == true {
@@ -320,27 +423,31 @@ func (e *V8Engine) Call(source, sourceType, function, args string) error {
 	return e.RunContractScript(source, sourceType, function, args)
 }
 
-// DeployAndInit a contract
+// DeployAndInit a contract, the TypeScript contracts are type checked before deployed from the type check height.
 func (e *V8Engine) DeployAndInit(source, sourceType, args string) error {
-	return e.RunContractScript(source, sourceType, "init", args)
+	return e.runContractScript(source, sourceType, "init", args, true)
 }
 
 // RunContractScript execute script in Smart Contract's way.
 func (e *V8Engine) RunContractScript(source, sourceType, function, args string) error {
+	return e.runContractScript(source, sourceType, function, args, false)
+}
+
+func (e *V8Engine) runContractScript(source, sourceType, function, args string, deploy bool) error {
 	var runnableSource string
 	var sourceLineOffset int
 	var err error
 
 	switch sourceType {
 	case SourceTypeJavaScript:
-		runnableSource, sourceLineOffset, err = e.prepareRunnableContractScript(source, function, args)
+		runnableSource, sourceLineOffset, err = e.prepareRunnableContractScript(source, function, args, deploy)
 	case SourceTypeTypeScript:
 		// transpile to javascript.
-		jsSource, _, err := e.TranspileTypeScript(source)
+		jsSource, _, err := e.transpileTypeScript(source, deploy && e.typeCheck)
 		if err != nil {
 			return err
 		}
-		runnableSource, sourceLineOffset, err = e.prepareRunnableContractScript(jsSource, function, args)
+		runnableSource, sourceLineOffset, err = e.prepareRunnableContractScript(jsSource, function, args, deploy)
 	default:
 		return ErrUnsupportedSourceType
 	}
@@ -349,14 +456,36 @@ func (e *V8Engine) RunContractScript(source, sourceType, function, args string)
 		return err
 	}
 
-	return e.RunScriptSource(runnableSource, sourceLineOffset)
+	if !deploy {
+		return e.RunScriptSource(runnableSource, sourceLineOffset)
+	}
+
+	// describe the deployed contract after init succeeds.
+	runnableSource += "var __result = JSON.stringify(require(\"abi.js\").describe(__contract));\n"
+	if err := e.RunScriptSource(runnableSource, sourceLineOffset); err != nil {
+		return err
+	}
+	abi, err := ParseABI([]byte(e.result))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to parse the contract ABI.")
+		return ErrExecutionFailed
+	}
+	e.abi, e.result = abi, ""
+	return nil
 }
 
 // AddModule add module.
 func (e *V8Engine) AddModule(id, source string, sourceLineOffset int) error {
 	// inject tracing instruction when enable limits.
 	if e.enableLimits {
-		traceableSource, lineOffset, err := e.InjectTracingInstructions(source)
+		// the lines of the contract are only passed to the counter when traced.
+		gasTable := e.gasTable.String()
+		if e.ctx != nil && e.ctx.tracer != nil {
+			gasTable = e.gasTable.tracingString()
+		}
+		traceableSource, lineOffset, err := e.injectTracingInstructions(source, gasTable)
 		if err != nil {
 			logging.VLog().WithFields(logrus.Fields{
 				"err": err,
@@ -371,7 +500,7 @@ func (e *V8Engine) AddModule(id, source string, sourceLineOffset int) error {
 	return nil
 }
 
-func (e *V8Engine) prepareRunnableContractScript(source, function, args string) (string, int, error) {
+func (e *V8Engine) prepareRunnableContractScript(source, function, args string, deploy bool) (string, int, error) {
 	sourceLineOffset := 0
 
 	// add module.
@@ -379,17 +508,34 @@ func (e *V8Engine) prepareRunnableContractScript(source, function, args string)
 	if err := e.AddModule(ModuleID, source, sourceLineOffset); err != nil {
 		return "", 0, err
 	}
+	if err := e.addLibraries(); err != nil {
+		return "", 0, err
+	}
 
 	// prepare for execute.
 	blockJSON, _ := e.ctx.SerializeContextBlock()
 	txJSON, _ := e.ctx.SerializeContextTx()
-	var runnableSource string
 
+	var call string
 	if len(args) > 0 {
-		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance, JSON.parse(\"%s\"));\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function, formatArgs(args))
+		call = fmt.Sprintf("__instance[\"%s\"].apply(__instance, JSON.parse(\"%s\"))", function, formatArgs(args))
 	} else {
-		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance);\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function)
+		call = fmt.Sprintf("__instance[\"%s\"].apply(__instance)", function)
+	}
+	// the result of nested calls is returned to the calling contract.
+	if len(e.ctx.callers) > 0 || e.ctx.keepResult {
+		call = fmt.Sprintf("var __result = JSON.stringify(%s)", call)
 	}
+	// only the deploy runs init, the other calls are guarded.
+	if !deploy {
+		call = fmt.Sprintf("require(\"abi.js\").guard(__contract, __instance, \"%s\");\n %s", function, call)
+	}
+	// the sandbox is installed before the contract is loaded, so it can't keep the builtins.
+	var sandbox string
+	if e.sandbox {
+		sandbox = "require(\"sandbox.js\").guard(this, function () { return Blockchain.block ? Blockchain.block.timestamp * 1000 : 0; });\n "
+	}
+	runnableSource := fmt.Sprintf("%svar Decimal = require(\"decimal.js\")(%d);\n var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n %s;\n", sandbox, e.mathLib, ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), call)
 	return runnableSource, 0, nil
 }
 
@@ -431,6 +577,36 @@ func getEngineByEngineHandler(handler unsafe.Pointer) *V8Engine {
 	return engines[v8engine]
 }
 
+// enterHostFunc looks up the engine of the storage handler and audits the entry of the host function,
+// it returns nil if the engine is not found or an audit hook kills the execution.
+func enterHostFunc(handler unsafe.Pointer, host string) (*V8Engine, state.Account) {
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	if engine == nil {
+		return nil, nil
+	}
+	if engine.audit(&AuditEvent{Kind: AuditHostEnter, Host: host}) != nil {
+		return nil, nil
+	}
+	return engine, storage
+}
+
+// exitHostFunc audits the exit of the host function.
+func (e *V8Engine) exitHostFunc(host string) {
+	e.audit(&AuditEvent{Kind: AuditHostExit, Host: host})
+}
+
+// audit terminates the execution if an audit hook kills it, the engine fails with ErrExecutionKilled.
+func (e *V8Engine) audit(event *AuditEvent) error {
+	err := e.ctx.audit(event)
+	if err != nil {
+		if e.callErr == nil {
+			e.callErr = err
+		}
+		C.TerminateExecution(e.v8engine)
+	}
+	return err
+}
+
 func formatArgs(s string) string {
 	s = strings.Replace(s, "\\", "\\\\", -1)
 	s = strings.Replace(s, "\n", "\\n", -1)
@@ -21,6 +21,8 @@ package nvm
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -32,6 +34,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -63,6 +66,21 @@ func (m *mockBlock) Height() uint64 {
 	return 2
 }
 
+func (m *mockBlock) Timestamp() int64 {
+	return 1520000000
+}
+
+func (m *mockBlock) ParentHash() byteutils.Hash {
+	return []byte("a5c1d0e3b7f29d8e4c6b1a0f9e8d7c6b")
+}
+
+func (m *mockBlock) AncestorHash(height uint64) (byteutils.Hash, error) {
+	if height != 1 {
+		return nil, errors.New("block height is out of the recent blocks window")
+	}
+	return m.ParentHash(), nil
+}
+
 func (m *mockBlock) VerifyAddress(str string) bool {
 	return true
 }
@@ -71,6 +89,34 @@ func (m *mockBlock) RecordEvent(txHash byteutils.Hash, topic, data string) error
 	return nil
 }
 
+func (m *mockBlock) ContractSource(contract state.Account) (byteutils.Hash, string, string, error) {
+	return nil, "", "", ErrInvalidCallContract
+}
+
+func (m *mockBlock) ContractLibraries(contract state.Account) (map[string]string, error) {
+	return nil, nil
+}
+
+func (m *mockBlock) LibrarySource(address string) (string, error) {
+	return "", ErrInvalidLibrary
+}
+
+func (m *mockBlock) RandomSeed() (byteutils.Hash, error) {
+	return []byte("0f9d4fb7c8b9b5e7d3a1c2e4f6a8b0c2"), nil
+}
+
+func (m *mockBlock) ContractAddress(creator byteutils.Hash, nonce uint64) (byteutils.Hash, error) {
+	return hash.Sha3256(creator, byteutils.FromUint64(nonce))[8:], nil
+}
+
+func (m *mockBlock) ContractCode(codeHash byteutils.Hash) (string, string, map[string]string, error) {
+	return "", "", nil, ErrInvalidCreateCode
+}
+
+func (m *mockBlock) SetContractCode(contract state.Account, source, sourceType string, libraries map[string]string, abi *ABI) error {
+	return nil
+}
+
 func (m *mockBlock) SerializeTxByHash(hash byteutils.Hash) (proto.Message, error) {
 	from, _ := byteutils.FromHex("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf")
 	to, _ := byteutils.FromHex("22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09")
@@ -114,6 +160,7 @@ func TestRunScriptSource(t *testing.T) {
 		{"test/test_storage_handlers.js", nil},
 		{"test/test_storage_class.js", nil},
 		{"test/test_storage.js", nil},
+		{"test/test_storage_keys.js", nil},
 		{"test/test_eval.js", ErrExecutionFailed},
 	}
 
@@ -427,6 +474,60 @@ func TestFunctionNameCheck(t *testing.T) {
 	}
 }
 
+func TestFunctionVisibility(t *testing.T) {
+	source := `var Vault = function () {
+    LocalContractStorage.defineProperty(this, "owner");
+};
+Vault.prototype = {
+    init: function () {
+        this.owner = Blockchain.transaction.from;
+    },
+    reset: function () {
+        this.init();
+    },
+    owned: function () {
+        return this.owner;
+    },
+    audit: function () {}
+};
+Vault.exported = ["reset", "owned"];
+module.exports = Vault;
+`
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 100000000)
+	assert.Nil(t, engine.DeployAndInit(source, "js", ""))
+	abi := engine.ABI()
+	engine.Dispose()
+	assert.Equal(t, 2, len(abi.Functions))
+	assert.Equal(t, "owned", abi.Functions[0].Name)
+	assert.Equal(t, "reset", abi.Functions[1].Name)
+
+	tests := []struct {
+		function    string
+		expectedErr error
+	}{
+		{"owned", nil},
+		{"audit", ErrExecutionFailed},
+		{"reset", ErrExecutionFailed},
+		{"init", ErrDisallowCallPrivateFunction},
+		{"_audit", ErrDisallowCallPrivateFunction},
+	}
+	for _, tt := range tests {
+		t.Run(tt.function, func(t *testing.T) {
+			engine := NewV8Engine(ctx)
+			engine.SetExecutionLimits(100000, 100000000)
+			assert.Equal(t, tt.expectedErr, engine.Call(source, "js", tt.function, ""))
+			engine.Dispose()
+		})
+	}
+}
+
 func TestMultiEngine(t *testing.T) {
 	mem, _ := storage.NewMemoryStorage()
 	context, _ := state.NewAccountState(nil, mem)
@@ -548,6 +649,168 @@ func TestTypeScriptExecution(t *testing.T) {
 	}
 }
 
+func TestTypeScriptTypeCheck(t *testing.T) {
+	source := `class Counter {
+    count: number;
+    init() {
+    }
+    reset() {
+        this.count = "zero";
+    }
+}
+module.exports = Counter;
+`
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+	defer SetTypeCheckHeight(0)
+
+	// the contracts deployed before the type check height are only transpiled.
+	SetTypeCheckHeight(3)
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 100000000)
+	assert.Nil(t, engine.DeployAndInit(source, "ts", ""))
+	engine.Dispose()
+
+	// type errors fail the deployment, the calls of deployed contracts are only transpiled.
+	SetTypeCheckHeight(2)
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 100000000)
+	assert.Equal(t, ErrTranspileTypeScriptFailed, engine.DeployAndInit(source, "ts", ""))
+	engine.Dispose()
+
+	// the sources too large to be type checked are refused before the checker runs.
+	large := source + "// " + strings.Repeat("x", MaxTypeCheckSourceSize) + "\n"
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 100000000)
+	assert.Equal(t, ErrContractSourceTooLarge, engine.DeployAndInit(large, "ts", ""))
+	engine.Dispose()
+
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 100000000)
+	assert.Nil(t, engine.Call(source, "ts", "reset", ""))
+	engine.Dispose()
+}
+
+func TestContractABI(t *testing.T) {
+	source := `var Vault = function () {};
+Vault.prototype = {
+    init: function () {},
+    save: function (height, memo) {},
+    balanceOf: function (addr) {},
+    _audit: function () {}
+};
+Vault.abi = {save: {args: ["number"], payable: true}};
+module.exports = Vault;
+`
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 100000000)
+	assert.Nil(t, engine.DeployAndInit(source, "js", ""))
+	abi := engine.ABI()
+	engine.Dispose()
+
+	data, err := abi.ToBytes()
+	assert.Nil(t, err)
+	assert.Equal(t, `{"functions":[{"name":"balanceOf","args":[{"name":"addr","type":"any"}],"payable":false},`+
+		`{"name":"save","args":[{"name":"height","type":"number"},{"name":"memo","type":"any"}],"payable":true}]}`, string(data))
+
+	// the calls don't generate the ABI.
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 100000000)
+	assert.Nil(t, engine.Call(source, "js", "save", "[1]"))
+	assert.Nil(t, engine.ABI())
+	engine.Dispose()
+}
+
+func TestDecimal(t *testing.T) {
+	source := `var Fund = function () {};
+Fund.prototype = {
+    init: function () {},
+    share: function (total, parts) {
+        return new Decimal(total).div(parts).toString();
+    },
+    random: function () {
+        return Decimal.random();
+    }
+};
+module.exports = Fund;
+`
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+	ctx.KeepResult()
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 100000000)
+	assert.Nil(t, engine.Call(source, "js", "share", `["0.3", 3]`))
+	assert.Equal(t, `"0.1"`, engine.Result())
+	engine.Dispose()
+
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 100000000)
+	assert.Nil(t, engine.Call(source, "js", "share", `["1", 3]`))
+	assert.Equal(t, `"0.333333333333333333"`, engine.Result())
+	engine.Dispose()
+
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 100000000)
+	assert.NotNil(t, engine.Call(source, "js", "random", ""))
+	engine.Dispose()
+}
+
+// TestConformance replays the conformance contracts against the golden results, which every platform must reproduce,
+// the cases with a sandbox height above the test block run before the sandbox.
+func TestConformance(t *testing.T) {
+	data, err := ioutil.ReadFile("test/conformance/cases.json")
+	require.Nil(t, err)
+	var cases []struct {
+		Contract      string `json:"contract"`
+		Function      string `json:"function"`
+		Args          string `json:"args"`
+		SandboxHeight uint64 `json:"sandboxHeight"`
+		Result        string `json:"result"`
+		Error         bool   `json:"error"`
+	}
+	require.Nil(t, json.Unmarshal(data, &cases))
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+
+	for _, tt := range cases {
+		t.Run(tt.Contract+"/"+tt.Function, func(t *testing.T) {
+			source, err := ioutil.ReadFile("test/conformance/" + tt.Contract)
+			require.Nil(t, err)
+
+			SetSandboxHeight(tt.SandboxHeight)
+			defer SetSandboxHeight(0)
+			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+			ctx.KeepResult()
+			engine := NewV8Engine(ctx)
+			engine.SetExecutionLimits(100000, 100000000)
+			err = engine.Call(string(source), "js", tt.Function, tt.Args)
+			if tt.Error {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.Result, engine.Result())
+			}
+			engine.Dispose()
+		})
+	}
+}
+
 func TestRunMozillaJSTestSuite(t *testing.T) {
 	mem, _ := storage.NewMemoryStorage()
 	context, _ := state.NewAccountState(nil, mem)
@@ -740,3 +1003,91 @@ func TestEvent(t *testing.T) {
 		})
 	}
 }
+
+type mockCallBlock struct {
+	mockBlock
+	sources   map[string]string
+	libraries map[string]map[string]string
+}
+
+func (m *mockCallBlock) ContractLibraries(contract state.Account) (map[string]string, error) {
+	return m.libraries[contract.Address().String()], nil
+}
+
+func (m *mockCallBlock) LibrarySource(address string) (string, error) {
+	source, ok := m.sources[address]
+	if !ok {
+		return "", ErrInvalidLibrary
+	}
+	return source, nil
+}
+
+func (m *mockCallBlock) ContractCode(codeHash byteutils.Hash) (string, string, map[string]string, error) {
+	source, ok := m.sources[codeHash.String()]
+	if !ok {
+		return "", "", nil, ErrInvalidCreateCode
+	}
+	return source, SourceTypeJavaScript, nil, nil
+}
+
+func (m *mockCallBlock) SetContractCode(contract state.Account, source, sourceType string, libraries map[string]string, abi *ABI) error {
+	m.sources[contract.Address().String()] = source
+	return nil
+}
+
+func (m *mockCallBlock) ContractSource(contract state.Account) (byteutils.Hash, string, string, error) {
+	source, ok := m.sources[contract.Address().String()]
+	if !ok {
+		return nil, "", "", ErrInvalidCallContract
+	}
+	return nil, source, SourceTypeJavaScript, nil
+}
+
+func TestRunContractSource(t *testing.T) {
+	callerAddr, _ := byteutils.FromHex("16464b93292d7c99099d4d982a05140f12779f5e299d6eb4")
+	calleeAddr, _ := byteutils.FromHex("22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09")
+	callerSource, err := ioutil.ReadFile("test/contract_caller.js")
+	assert.Nil(t, err, "filepath read error")
+	calleeSource, err := ioutil.ReadFile("test/contract_callee.js")
+	assert.Nil(t, err, "filepath read error")
+	block := &mockCallBlock{sources: map[string]string{
+		callerAddr.String(): string(callerSource),
+		calleeAddr.String(): string(calleeSource),
+	}}
+
+	tests := []struct {
+		name        string
+		function    string
+		args        string
+		expectedErr error
+	}{
+		{"call", "call", fmt.Sprintf("[\"%s\", 2]", calleeAddr), nil},
+		{"reentrant", "reenter", fmt.Sprintf("[\"%s\"]", calleeAddr), ErrReentrantCall},
+		{"guarded", "guarded", fmt.Sprintf("[\"%s\"]", calleeAddr), nil},
+		{"clone", "clone", fmt.Sprintf("[\"%s\"]", calleeAddr), nil},
+		{"clone unknown code", "clone", "[\"8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf\"]", ErrInvalidCreateCode},
+		{"not contract", "call", "[\"8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf\", 2]", ErrInvalidCallContract},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mem, _ := storage.NewMemoryStorage()
+			context, _ := state.NewAccountState(nil, mem)
+			owner := context.GetOrCreateUserAccount([]byte("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"))
+			caller, _ := context.CreateContractAccount(callerAddr, nil)
+			context.CreateContractAccount(calleeAddr, nil)
+
+			tx := testContextTransaction()
+			tx.To = callerAddr.String()
+			ctx := NewContext(block, tx, owner, caller, context)
+			engine := NewV8Engine(ctx)
+			engine.SetExecutionLimits(100000, 10000000)
+			err := engine.Call(string(callerSource), SourceTypeJavaScript, tt.function, tt.args)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.function == "clone" && err == nil {
+				assert.Equal(t, 1, len(ctx.Creations()))
+			}
+			engine.Dispose()
+		})
+	}
+}
@@ -0,0 +1,596 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/perlin-network/life/exec"
+	"github.com/sirupsen/logrus"
+)
+
+// SourceTypeWasm is the source type of WebAssembly contracts, whose source is the hex of the module.
+const SourceTypeWasm = "wasm"
+
+// Errors in wasm engine
+var (
+	ErrInvalidWasmModule       = errors.New("invalid wasm module")
+	ErrWasmFunctionNotFound    = errors.New("wasm function not found")
+	ErrWasmMemoryAccess        = errors.New("wasm memory access out of bounds")
+	ErrWasmUnknownHostFunction = errors.New("unknown wasm host function")
+)
+
+// wasm host module and limits.
+const (
+	wasmHostModule    = "env"
+	wasmPageSize      = 65536
+	wasmMaxTableSize  = 1024
+	wasmMaxValueSlots = 65536
+	wasmMaxCallDepth  = 256
+	wasmDefaultPages  = 1
+	wasmDefaultTable  = 64
+)
+
+// Gas of the host functions, charged besides the instructions.
+const (
+	wasmGasStorageGet  = 100
+	wasmGasStoragePut  = 200
+	wasmGasStorageDel  = 100
+	wasmGasBlockchain  = 100
+	wasmGasEvent       = 100
+	wasmGasCallExtern  = 500
+	wasmGasLog         = 10
+	wasmGasPerByte     = 1
+	wasmGasInstruction = 1
+	wasmGasCall        = 5
+	wasmGasGrowMemory  = 1000
+)
+
+// wasmGasPolicy charges every wasm instruction deterministically, calls and memory growth cost more.
+type wasmGasPolicy struct{}
+
+func (p *wasmGasPolicy) GetCost(key string) int64 {
+	switch key {
+	case "call", "call_indirect":
+		return wasmGasCall
+	case "grow_memory":
+		return wasmGasGrowMemory
+	}
+	return wasmGasInstruction
+}
+
+// WasmEngine runs WebAssembly contracts, the host functions in module "env" mirror the
+// contract API of V8 engine, strings are passed as pointer and length in the linear memory.
+type WasmEngine struct {
+	ctx                                *Context
+	args                               string
+	result                             string
+	abi                                *ABI
+	limitsOfExecutionInstructions      uint64
+	limitsOfTotalMemorySize            uint64
+	actualCountOfExecutionInstructions uint64
+}
+
+// NewWasmEngine return new WasmEngine instance.
+func NewWasmEngine(ctx *Context) *WasmEngine {
+	return &WasmEngine{ctx: ctx}
+}
+
+// Dispose dispose all resources.
+func (e *WasmEngine) Dispose() {}
+
+// Context returns engine context
+func (e *WasmEngine) Context() *Context {
+	return e.ctx
+}
+
+// SetExecutionLimits set execution limits of wasm engine, prevent Halting Problem.
+func (e *WasmEngine) SetExecutionLimits(limitsOfExecutionInstructions, limitsOfTotalMemorySize uint64) {
+	e.limitsOfExecutionInstructions = limitsOfExecutionInstructions
+	e.limitsOfTotalMemorySize = limitsOfTotalMemorySize
+}
+
+// ExecutionInstructions returns the gas used by the execution.
+func (e *WasmEngine) ExecutionInstructions() uint64 {
+	return e.actualCountOfExecutionInstructions
+}
+
+// Call function in a module
+func (e *WasmEngine) Call(source, sourceType, function, args string) error {
+	if publicFuncNameChecker.MatchString(function) == false || strings.EqualFold("init", function) == true {
+		return ErrDisallowCallPrivateFunction
+	}
+	return e.RunContractModule(source, sourceType, function, args)
+}
+
+// Result returns the result set by the function
+func (e *WasmEngine) Result() string {
+	return e.result
+}
+
+// ABI returns the exported functions of the module deployed by the engine, nil if not deployed.
+func (e *WasmEngine) ABI() *ABI {
+	return e.abi
+}
+
+// DeployAndInit a contract
+func (e *WasmEngine) DeployAndInit(source, sourceType, args string) error {
+	return e.RunContractModule(source, sourceType, "init", args)
+}
+
+// RunContractModule instantiates the module and runs the exported function.
+func (e *WasmEngine) RunContractModule(source, sourceType, function, args string) (err error) {
+	if sourceType != SourceTypeWasm {
+		return ErrUnsupportedSourceType
+	}
+	code, err := byteutils.FromHex(source)
+	if err != nil {
+		return ErrInvalidWasmModule
+	}
+	e.args = args
+
+	maxPages := 0
+	if e.limitsOfTotalMemorySize > 0 {
+		maxPages = int(e.limitsOfTotalMemorySize / wasmPageSize)
+	}
+	config := exec.VMConfig{
+		MaxMemoryPages:       maxPages,
+		MaxTableSize:         wasmMaxTableSize,
+		MaxValueSlots:        wasmMaxValueSlots,
+		MaxCallStackDepth:    wasmMaxCallDepth,
+		DefaultMemoryPages:   wasmDefaultPages,
+		DefaultTableSize:     wasmDefaultTable,
+		GasLimit:             e.limitsOfExecutionInstructions,
+		DisableFloatingPoint: true,
+	}
+
+	// unknown imports, malformed modules and host functions out of gas panic in the vm.
+	defer func() {
+		if r := recover(); r != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"function": function,
+				"err":      r,
+			}).Error("Failed to run wasm contract.")
+			err = ErrExecutionFailed
+			if cause, ok := r.(error); ok && (IsOutOfResource(cause) || cause == ErrExecutionKilled) {
+				err = cause
+			}
+			if r == ErrInsufficientGas {
+				e.actualCountOfExecutionInstructions = e.limitsOfExecutionInstructions
+				err = ErrInsufficientGas
+			}
+		}
+	}()
+
+	vm, err := exec.NewVirtualMachine(code, config, &wasmResolver{engine: e}, &wasmGasPolicy{})
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to instantiate wasm module.")
+		return ErrInvalidWasmModule
+	}
+	entry, ok := vm.GetFunctionExport(function)
+	if !ok {
+		return ErrWasmFunctionNotFound
+	}
+
+	_, err = vm.Run(entry)
+	e.actualCountOfExecutionInstructions = vm.Gas
+	if e.limitsOfExecutionInstructions > 0 && vm.Gas > e.limitsOfExecutionInstructions {
+		e.actualCountOfExecutionInstructions = e.limitsOfExecutionInstructions
+		return ErrInsufficientGas
+	}
+	if killed := e.ctx.audit(&AuditEvent{Kind: AuditMemory, Memory: uint64(len(vm.Memory))}); killed != nil && err == nil {
+		return killed
+	}
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"function": function,
+			"err":      err,
+		}).Error("Failed to run wasm contract.")
+		return ErrExecutionFailed
+	}
+	if function == "init" {
+		e.abi = wasmModuleABI(vm)
+	}
+	return nil
+}
+
+// wasmModuleABI describes the exported functions of the module, whose arguments are read by the host function "args".
+func wasmModuleABI(vm *exec.VirtualMachine) *ABI {
+	var names []string
+	for name, entry := range vm.Module.Base.Export.Entries {
+		if entry.Kind != wasm.ExternalFunction || name == "init" || !publicFuncNameChecker.MatchString(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	abi := &ABI{Functions: []*ABIFunction{}}
+	for _, name := range names {
+		abi.Functions = append(abi.Functions, &ABIFunction{Name: name, Args: []*ABIArg{}})
+	}
+	return abi
+}
+
+// wasmResolver resolves the imports of contracts to host functions.
+type wasmResolver struct {
+	engine *WasmEngine
+}
+
+// ResolveFunc returns the host function, panics if not found.
+func (r *wasmResolver) ResolveFunc(module, field string) exec.FunctionImport {
+	if module == wasmHostModule {
+		if fn, ok := wasmHostFunctions[field]; ok {
+			return func(vm *exec.VirtualMachine) int64 {
+				if err := r.engine.ctx.audit(&AuditEvent{Kind: AuditHostEnter, Host: field}); err != nil {
+					panic(err)
+				}
+				ret := fn(r.engine, vm)
+				if err := r.engine.ctx.audit(&AuditEvent{Kind: AuditHostExit, Host: field}); err != nil {
+					panic(err)
+				}
+				return ret
+			}
+		}
+	}
+	panic(fmt.Errorf("%s: %s.%s", ErrWasmUnknownHostFunction, module, field))
+}
+
+// ResolveGlobal panics, no globals are imported.
+func (r *wasmResolver) ResolveGlobal(module, field string) int64 {
+	panic(fmt.Errorf("%s: %s.%s", ErrWasmUnknownHostFunction, module, field))
+}
+
+type wasmHostFunction func(e *WasmEngine, vm *exec.VirtualMachine) int64
+
+var wasmHostFunctions = map[string]wasmHostFunction{
+	// storage_get(key, keyLen, value, valueCap) returns value length, -1 if not found.
+	"storage_get": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		key := wasmString(vm, 0, 1)
+		charge(vm, wasmGasStorageGet+wasmGasPerByte*uint64(len(key)))
+		val, err := e.ctx.contract.Get(hashStorageKey(key))
+		if err != nil {
+			if err != ErrKeyNotFound {
+				logging.VLog().WithFields(logrus.Fields{
+					"key": key,
+					"err": err,
+				}).Error("StorageGetFunc get key failed.")
+			}
+			return -1
+		}
+		return wasmOutput(vm, 2, 3, val)
+	},
+	// storage_put(key, keyLen, value, valueLen) returns 0 if succeed.
+	"storage_put": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		key, val := wasmString(vm, 0, 1), wasmBytes(vm, 2, 3)
+		charge(vm, wasmGasStoragePut+wasmGasPerByte*uint64(len(key)+len(val)))
+		e.ctx.recordStateDiff(e.ctx.contract, key, val)
+		if err := storagePut(e.ctx.contract, key, val); err != nil && err != ErrKeyNotFound {
+			logging.VLog().WithFields(logrus.Fields{
+				"key": key,
+				"err": err,
+			}).Error("StoragePutFunc put key failed.")
+			return 1
+		}
+		return 0
+	},
+	// storage_del(key, keyLen) returns 0 if succeed.
+	"storage_del": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		key := wasmString(vm, 0, 1)
+		charge(vm, wasmGasStorageDel+wasmGasPerByte*uint64(len(key)))
+		e.ctx.recordStateDiff(e.ctx.contract, key, nil)
+		if err := storageDel(e.ctx.contract, key); err != nil && err != ErrKeyNotFound {
+			logging.VLog().WithFields(logrus.Fields{
+				"key": key,
+				"err": err,
+			}).Warn("StorageDelFunc del key failed.")
+			return 1
+		}
+		return 0
+	},
+	// storage_keys(field, fieldLen, out, outCap) returns the length of the JSON array of the keys in the map.
+	"storage_keys": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		field := wasmString(vm, 0, 1)
+		charge(vm, wasmGasStorageGet+wasmGasPerByte*uint64(len(field)))
+		keys, err := storageKeys(e.ctx.contract, field)
+		if err != nil {
+			return -1
+		}
+		data, err := json.Marshal(keys)
+		if err != nil {
+			return -1
+		}
+		return wasmOutput(vm, 2, 3, data)
+	},
+	// block(out, outCap) returns the length of the block json.
+	"block": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		charge(vm, wasmGasBlockchain)
+		data, err := e.ctx.SerializeContextBlock()
+		if err != nil {
+			return -1
+		}
+		return wasmOutput(vm, 0, 1, data)
+	},
+	// transaction(out, outCap) returns the length of the transaction json.
+	"transaction": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		charge(vm, wasmGasBlockchain)
+		data, err := e.ctx.SerializeContextTx()
+		if err != nil {
+			return -1
+		}
+		return wasmOutput(vm, 0, 1, data)
+	},
+	// args(out, outCap) returns the length of the args json.
+	"args": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		return wasmOutput(vm, 0, 1, []byte(e.args))
+	},
+	// get_tx_by_hash(hash, hashLen, out, outCap) returns the length of the tx json, -1 if not found.
+	"get_tx_by_hash": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		hash := wasmBytes(vm, 0, 1)
+		charge(vm, wasmGasBlockchain)
+		tx, err := e.ctx.SerializeTxByHash(hash)
+		if err != nil {
+			return -1
+		}
+		return wasmOutput(vm, 2, 3, tx)
+	},
+	// get_account_state(addr, addrLen, out, outCap) returns the length of the state json, -1 if invalid.
+	"get_account_state": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		addr := wasmString(vm, 0, 1)
+		charge(vm, wasmGasBlockchain)
+		data, ok := e.accountState(addr)
+		if !ok {
+			return -1
+		}
+		return wasmOutput(vm, 2, 3, data)
+	},
+	// transfer(to, toLen, value, valueLen) returns 0 if succeed.
+	"transfer": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		to, value := wasmString(vm, 0, 1), wasmString(vm, 2, 3)
+		charge(vm, wasmGasBlockchain)
+		return e.transfer(to, value)
+	},
+	// self_destruct(beneficiary, beneficiaryLen) returns 0 if succeed.
+	"self_destruct": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		beneficiary := wasmString(vm, 0, 1)
+		charge(vm, wasmGasBlockchain)
+		if err := e.ctx.SelfDestruct(beneficiary); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"beneficiary": beneficiary,
+				"err":         err,
+			}).Error("SelfDestructFunc self-destruct failed.")
+			return 1
+		}
+		return 0
+	},
+	// non_reentrant() fails the call if the contract is re-entered.
+	"non_reentrant": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		charge(vm, wasmGasBlockchain)
+		if err := e.ctx.NonReentrant(); err != nil {
+			panic(err)
+		}
+		return 0
+	},
+	// verify_address(addr, addrLen) returns 1 if valid.
+	"verify_address": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		addr := wasmString(vm, 0, 1)
+		charge(vm, wasmGasBlockchain)
+		if e.ctx.block != nil && e.ctx.block.VerifyAddress(addr) {
+			return 1
+		}
+		return 0
+	},
+	// event_trigger(topic, topicLen, data, dataLen)
+	"event_trigger": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		topic, data := wasmString(vm, 0, 1), wasmString(vm, 2, 3)
+		charge(vm, wasmGasEvent+wasmGasPerByte*uint64(len(topic)+len(data)))
+		txHash, _ := byteutils.FromHex(e.ctx.tx.Hash)
+		e.ctx.block.RecordEvent(txHash, EventNameSpaceContract+"."+topic, data)
+		return 0
+	},
+	// result(data, dataLen) sets the JSON result returned to the calling contract.
+	"result": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		data := wasmString(vm, 0, 1)
+		charge(vm, wasmGasPerByte*uint64(len(data)))
+		e.result = data
+		return 0
+	},
+	// call_contract(addr, addrLen, func, funcLen, args, argsLen, out, outCap) returns the length of
+	// the callee's result, a failed call fails the caller.
+	"call_contract": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		addr, function, args := wasmString(vm, 0, 1), wasmString(vm, 2, 3), wasmString(vm, 4, 5)
+		charge(vm, wasmGasCallExtern)
+		var gasLimit uint64
+		if vm.Config.GasLimit > 0 {
+			gasLimit = vm.Config.GasLimit - vm.Gas
+		}
+		result, gas, err := RunContract(e.ctx, addr, function, args, gasLimit, e.limitsOfTotalMemorySize)
+		charge(vm, gas)
+		if err != nil {
+			panic(err)
+		}
+		return wasmOutput(vm, 6, 7, []byte(result))
+	},
+	// deploy_contract(src, srcLen, type, typeLen, hash, hashLen, args, argsLen, out, outCap) returns the length
+	// of the new contract's address, the code is the source or the code at the hash, a failed deploy fails the caller.
+	"deploy_contract": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		source, sourceType, codeHash := wasmString(vm, 0, 1), wasmString(vm, 2, 3), wasmString(vm, 4, 5)
+		args := wasmString(vm, 6, 7)
+		charge(vm, wasmGasCallExtern)
+		var gasLimit uint64
+		if vm.Config.GasLimit > 0 {
+			gasLimit = vm.Config.GasLimit - vm.Gas
+		}
+		address, gas, err := CreateContract(e.ctx, source, sourceType, codeHash, args, gasLimit, e.limitsOfTotalMemorySize)
+		charge(vm, gas)
+		if err != nil {
+			panic(err)
+		}
+		return wasmOutput(vm, 8, 9, []byte(address))
+	},
+	// get_block_hash(height, out, outCap) returns the length of the block hash, -1 if not in the recent blocks.
+	"get_block_hash": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		height := uint64(vm.GetCurrentFrame().Locals[0])
+		charge(vm, wasmGasBlockchain)
+		hash, err := e.ctx.BlockHash(height)
+		if err != nil {
+			return -1
+		}
+		return wasmOutput(vm, 1, 2, []byte(hash))
+	},
+	// random(seed, seedLen, out, outCap) returns the length of the random hex hash, -1 if failed.
+	"random": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		seed := wasmString(vm, 0, 1)
+		charge(vm, wasmGasBlockchain+wasmGasPerByte*uint64(len(seed)))
+		random, err := e.ctx.Random(seed)
+		if err != nil {
+			return -1
+		}
+		return wasmOutput(vm, 2, 3, []byte(random))
+	},
+	// precompile(name, nameLen, in, inLen, out, outCap) returns the length of the output, -1 if failed.
+	"precompile": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		name, input := wasmString(vm, 0, 1), wasmBytes(vm, 2, 3)
+		charge(vm, wasmGasBlockchain)
+		var gasLimit uint64
+		if vm.Config.GasLimit > 0 {
+			gasLimit = vm.Config.GasLimit - vm.Gas
+		}
+		output, gas, err := RunPrecompile(name, input, gasLimit)
+		charge(vm, gas)
+		if err != nil {
+			return -1
+		}
+		return wasmOutput(vm, 4, 5, output)
+	},
+	// event_emit(name, nameLen, indexed, indexedLen, data, dataLen) returns 0 if succeed,
+	// indexed is the JSON array of the indexed fields.
+	"event_emit": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		name, indexed, data := wasmString(vm, 0, 1), wasmString(vm, 2, 3), wasmString(vm, 4, 5)
+		charge(vm, wasmGasEvent+wasmGasPerByte*uint64(len(name)+len(indexed)+len(data)))
+		if err := e.ctx.EmitLog(name, indexed, data); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"name": name,
+				"err":  err,
+			}).Error("Event.emit failed.")
+			return 1
+		}
+		return 0
+	},
+	// log(level, msg, msgLen), levels are the same as V8Log.
+	"log": func(e *WasmEngine, vm *exec.VirtualMachine) int64 {
+		level, msg := int(vm.GetCurrentFrame().Locals[0]), wasmString(vm, 1, 2)
+		charge(vm, wasmGasLog)
+		if devMode {
+			e.ctx.ConsoleLog(level, msg)
+		} else {
+			wasmLog(level, msg)
+		}
+		return 0
+	},
+}
+
+func (e *WasmEngine) accountState(addr string) ([]byte, bool) {
+	if e.ctx.block == nil || !e.ctx.block.VerifyAddress(addr) {
+		logging.VLog().WithFields(logrus.Fields{
+			"key": addr,
+		}).Error("GetAccountStateFunc parse address failed.")
+		return nil, false
+	}
+	acc := e.ctx.state.GetOrCreateUserAccount([]byte(addr))
+	data, err := json.Marshal(&AccountState{
+		Nonce:   acc.Nonce(),
+		Balance: acc.Balance().String(),
+	})
+	return data, err == nil
+}
+
+func (e *WasmEngine) transfer(to, value string) int64 {
+	if err := e.ctx.Transfer(to, value); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"key":   to,
+			"value": value,
+			"err":   err,
+		}).Error("TransferFunc transfer failed.")
+		return 1
+	}
+	return 0
+}
+
+// charge adds the gas of host functions, and stops the execution before the
+// host function takes effect if the gas exceeds the limit.
+func charge(vm *exec.VirtualMachine, gas uint64) {
+	vm.Gas += gas
+	if vm.Config.GasLimit > 0 && vm.Gas > vm.Config.GasLimit {
+		panic(ErrInsufficientGas)
+	}
+}
+
+// wasmBytes reads the bytes at the pointer and length in the locals of the current frame.
+func wasmBytes(vm *exec.VirtualMachine, ptrLocal, lenLocal int) []byte {
+	frame := vm.GetCurrentFrame()
+	ptr, size := uint64(uint32(frame.Locals[ptrLocal])), uint64(uint32(frame.Locals[lenLocal]))
+	if ptr+size > uint64(len(vm.Memory)) {
+		panic(ErrWasmMemoryAccess)
+	}
+	data := make([]byte, size)
+	copy(data, vm.Memory[ptr:ptr+size])
+	return data
+}
+
+func wasmString(vm *exec.VirtualMachine, ptrLocal, lenLocal int) string {
+	return string(wasmBytes(vm, ptrLocal, lenLocal))
+}
+
+// wasmOutput writes data to the buffer in the locals if it fits, returns the length of data,
+// so the contract can retry with a larger buffer.
+func wasmOutput(vm *exec.VirtualMachine, ptrLocal, capLocal int, data []byte) int64 {
+	frame := vm.GetCurrentFrame()
+	ptr, capacity := uint64(uint32(frame.Locals[ptrLocal])), uint64(uint32(frame.Locals[capLocal]))
+	if ptr+capacity > uint64(len(vm.Memory)) {
+		panic(ErrWasmMemoryAccess)
+	}
+	charge(vm, wasmGasPerByte*uint64(len(data)))
+	if uint64(len(data)) <= capacity {
+		copy(vm.Memory[ptr:], data)
+	}
+	return int64(len(data))
+}
+
+func wasmLog(level int, msg string) {
+	switch level {
+	case 1:
+		logging.VLog().Debug(msg)
+	case 2:
+		logging.VLog().Warn(msg)
+	case 3:
+		logging.VLog().Info(msg)
+	default:
+		logging.VLog().Error(msg)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// testWasmModule imports env.<hostFunc> and exports "init", which puts "value" at "key":
+//
+//	(module
+//	  (import "env" "storage_put" (func (param i32 i32 i32 i32) (result i32)))
+//	  (memory 1)
+//	  (data (i32.const 0) "keyvalue")
+//	  (func (export "init") (drop (call 0 (i32.const 0) (i32.const 3) (i32.const 3) (i32.const 5)))))
+func testWasmModule(hostFunc string) string {
+	module := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, // magic & version
+		0x01, 0x0c, 0x02, 0x60, 0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x01, 0x7f, 0x60, 0x00, 0x00, // types
+	}
+	imports := append([]byte{0x01, 0x03, 'e', 'n', 'v', byte(len(hostFunc))}, []byte(hostFunc)...)
+	imports = append(imports, 0x00, 0x00)
+	module = append(module, 0x02, byte(len(imports)))
+	module = append(module, imports...)
+	module = append(module,
+		0x03, 0x02, 0x01, 0x01, // functions
+		0x05, 0x03, 0x01, 0x00, 0x01, // memory
+		0x07, 0x08, 0x01, 0x04, 'i', 'n', 'i', 't', 0x00, 0x01, // exports
+		0x0a, 0x0f, 0x01, 0x0d, 0x00, 0x41, 0x00, 0x41, 0x03, 0x41, 0x03, 0x41, 0x05, 0x10, 0x00, 0x1a, 0x0b, // code
+		0x0b, 0x0e, 0x01, 0x00, 0x41, 0x00, 0x0b, 0x08, 'k', 'e', 'y', 'v', 'a', 'l', 'u', 'e', // data
+	)
+	return byteutils.Hex(module)
+}
+
+func TestWasmEngine(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		function string
+		limits   uint64
+		err      error
+		stored   bool
+	}{
+		{"init", testWasmModule("storage_put"), "init", 100000, nil, true},
+		{"insufficient gas", testWasmModule("storage_put"), "init", 10, ErrInsufficientGas, false},
+		{"unknown host function", testWasmModule("storage_add"), "init", 100000, ErrExecutionFailed, false},
+		{"invalid hex", "0x", "init", 100000, ErrInvalidWasmModule, false},
+		{"invalid module", byteutils.Hex([]byte("not a module")), "init", 100000, ErrInvalidWasmModule, false},
+		{"missing function", testWasmModule("storage_put"), "call", 100000, ErrWasmFunctionNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mem, _ := storage.NewMemoryStorage()
+			context, _ := state.NewAccountState(nil, mem)
+			owner := context.GetOrCreateUserAccount([]byte("account1"))
+			contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+			engine := NewEngine(ctx, SourceTypeWasm)
+			engine.SetExecutionLimits(tt.limits, DefaultLimitsOfTotalMemorySize)
+			if tt.function == "init" {
+				assert.Equal(t, tt.err, engine.DeployAndInit(tt.source, SourceTypeWasm, ""))
+			} else {
+				assert.Equal(t, tt.err, engine.(*WasmEngine).RunContractModule(tt.source, SourceTypeWasm, tt.function, ""))
+			}
+			val, err := contract.Get(hashStorageKey("key"))
+			if tt.stored {
+				assert.Nil(t, err)
+				assert.Equal(t, []byte("value"), val)
+				assert.True(t, engine.ExecutionInstructions() > 0)
+			} else {
+				assert.NotNil(t, err)
+			}
+			engine.Dispose()
+		})
+	}
+
+	engine := NewWasmEngine(nil)
+	assert.Equal(t, ErrDisallowCallPrivateFunction, engine.Call(testWasmModule("storage_put"), SourceTypeWasm, "init", ""))
+	assert.Equal(t, ErrUnsupportedSourceType, engine.DeployAndInit(testWasmModule("storage_put"), SourceTypeJavaScript, ""))
+}
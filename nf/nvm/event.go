@@ -48,6 +48,10 @@ func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char) {
 		}).Error("Event.Trigger delegate handler does not found.")
 		return
 	}
+	if e.audit(&AuditEvent{Kind: AuditHostEnter, Host: "event_trigger"}) != nil {
+		return
+	}
+	defer e.exitHostFunc("event_trigger")
 
 	logging.VLog().WithFields(logrus.Fields{
 		"category": 0, // ChainEventCategory.
@@ -59,3 +63,36 @@ func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char) {
 	contractTopic := EventNameSpaceContract + "." + gTopic
 	e.ctx.block.RecordEvent(txHash, contractTopic, gData)
 }
+
+// EventEmitFunc export EventEmitFunc
+//export EventEmitFunc
+func EventEmitFunc(handler unsafe.Pointer, name, indexed, data *C.char) int {
+	gName := C.GoString(name)
+	gIndexed := C.GoString(indexed)
+	gData := C.GoString(data)
+
+	e := getEngineByEngineHandler(handler)
+	if e == nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"name":    gName,
+			"indexed": gIndexed,
+			"data":    gData,
+		}).Error("Event.emit delegate handler does not found.")
+		return 1
+	}
+	if e.audit(&AuditEvent{Kind: AuditHostEnter, Host: "event_emit"}) != nil {
+		return 1
+	}
+	defer e.exitHostFunc("event_emit")
+
+	if err := e.ctx.EmitLog(gName, gIndexed, gData); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"name":    gName,
+			"indexed": gIndexed,
+			"data":    gData,
+			"err":     err,
+		}).Error("Event.emit failed.")
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MinLimitsOfTotalMemorySize is the least heap V8 needs to run a contract.
+const MinLimitsOfTotalMemorySize uint64 = 6000000
+
+// Errors of execution limits forks
+var (
+	ErrInvalidExecutionLimitsFork = errors.New("execution limits fork must increase the height")
+	ErrInvalidExecutionLimits     = errors.New("invalid execution limits")
+)
+
+// ExecutionLimits bound each contract call, every node enforces the same limits from the block height.
+type ExecutionLimits struct {
+	Height uint64
+	// wall-clock time after which the execution is terminated.
+	Timeout time.Duration
+	// max instructions counted in one call whatever its gas limit, no limit if 0.
+	MaxInstructions uint64
+	// max heap size of the engine in bytes.
+	MaxMemorySize uint64
+	// max number of contracts on the stack of nested calls.
+	MaxCallDepth uint64
+}
+
+// DefaultExecutionLimits are the limits from the genesis if none are configured.
+var DefaultExecutionLimits = &ExecutionLimits{
+	Height:          0,
+	Timeout:         10 * time.Second,
+	MaxInstructions: 0,
+	MaxMemorySize:   DefaultLimitsOfTotalMemorySize,
+	MaxCallDepth:    MaxContractCallDepth,
+}
+
+var (
+	executionLimits   = []*ExecutionLimits{DefaultExecutionLimits}
+	executionLimitsMu sync.RWMutex
+)
+
+// ExecutionLimitsAt returns the execution limits for the block at height.
+func ExecutionLimitsAt(height uint64) *ExecutionLimits {
+	executionLimitsMu.RLock()
+	defer executionLimitsMu.RUnlock()
+
+	for i := len(executionLimits) - 1; i > 0; i-- {
+		if height >= executionLimits[i].Height {
+			return executionLimits[i]
+		}
+	}
+	return executionLimits[0]
+}
+
+// SetExecutionLimitsForks installs the execution limits scheduled in the genesis conf,
+// each fork changes the limits set in it and keeps the others, a fork at height 0 replaces the defaults.
+func SetExecutionLimitsForks(forks []*corepb.ExecutionLimitsFork) error {
+	limits := []*ExecutionLimits{DefaultExecutionLimits}
+	for i, v := range forks {
+		last := limits[len(limits)-1]
+		if i > 0 && v.Height <= last.Height {
+			return ErrInvalidExecutionLimitsFork
+		}
+		if v.MaxMemorySize > 0 && v.MaxMemorySize < MinLimitsOfTotalMemorySize {
+			return ErrInvalidExecutionLimits
+		}
+		l := &ExecutionLimits{
+			Height:          v.Height,
+			Timeout:         last.Timeout,
+			MaxInstructions: last.MaxInstructions,
+			MaxMemorySize:   last.MaxMemorySize,
+			MaxCallDepth:    last.MaxCallDepth,
+		}
+		if v.TimeoutMs > 0 {
+			l.Timeout = time.Duration(v.TimeoutMs) * time.Millisecond
+		}
+		if v.MaxInstructions > 0 {
+			l.MaxInstructions = v.MaxInstructions
+		}
+		if v.MaxMemorySize > 0 {
+			l.MaxMemorySize = v.MaxMemorySize
+		}
+		if v.MaxCallDepth > 0 {
+			l.MaxCallDepth = v.MaxCallDepth
+		}
+		if v.Height == 0 {
+			limits[0] = l
+		} else {
+			limits = append(limits, l)
+		}
+	}
+
+	executionLimitsMu.Lock()
+	defer executionLimitsMu.Unlock()
+	executionLimits = limits
+
+	for _, v := range forks {
+		logging.CLog().WithFields(logrus.Fields{
+			"height":          v.Height,
+			"timeoutMs":       v.TimeoutMs,
+			"maxInstructions": v.MaxInstructions,
+			"maxMemorySize":   v.MaxMemorySize,
+			"maxCallDepth":    v.MaxCallDepth,
+		}).Info("Execution limits scheduled.")
+	}
+	return nil
+}
+
+// IsOutOfResource returns whether the execution error is a call exceeding the memory or call depth
+// limits, which fails the whole transaction even if the calling contract catches it.
+func IsOutOfResource(err error) bool {
+	return err == ErrExceedMemoryLimits || err == ErrExceedCallDepth
+}
+
+// Instructions returns the instructions a call with the gas limit can execute.
+func (l *ExecutionLimits) Instructions(gasLimit uint64) uint64 {
+	if l.MaxInstructions > 0 && gasLimit > l.MaxInstructions {
+		return l.MaxInstructions
+	}
+	return gasLimit
+}
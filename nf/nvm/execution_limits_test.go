@@ -0,0 +1,87 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetExecutionLimitsForks(t *testing.T) {
+	defer SetExecutionLimitsForks(nil)
+
+	assert.Nil(t, SetExecutionLimitsForks([]*corepb.ExecutionLimitsFork{
+		{Height: 0, TimeoutMs: 5000},
+		{Height: 100, MaxInstructions: 1000, MaxMemorySize: 20000000, MaxCallDepth: 4},
+	}))
+	limits := ExecutionLimitsAt(99)
+	assert.Equal(t, 5*time.Second, limits.Timeout)
+	assert.Equal(t, uint64(0), limits.MaxInstructions)
+	assert.Equal(t, DefaultLimitsOfTotalMemorySize, limits.MaxMemorySize)
+	assert.Equal(t, uint64(MaxContractCallDepth), limits.MaxCallDepth)
+	assert.Equal(t, uint64(5000), limits.Instructions(5000))
+
+	limits = ExecutionLimitsAt(100)
+	assert.Equal(t, 5*time.Second, limits.Timeout)
+	assert.Equal(t, uint64(20000000), limits.MaxMemorySize)
+	assert.Equal(t, uint64(4), limits.MaxCallDepth)
+	assert.Equal(t, uint64(1000), limits.Instructions(5000))
+	assert.Equal(t, uint64(500), limits.Instructions(500))
+
+	assert.Equal(t, ErrInvalidExecutionLimitsFork, SetExecutionLimitsForks([]*corepb.ExecutionLimitsFork{{Height: 100}, {Height: 100}}))
+	assert.Equal(t, ErrInvalidExecutionLimits, SetExecutionLimitsForks([]*corepb.ExecutionLimitsFork{{Height: 100, MaxMemorySize: 1000}}))
+	assert.Equal(t, uint64(1000), ExecutionLimitsAt(100).MaxInstructions)
+}
+
+func TestExecutionCallDepth(t *testing.T) {
+	defer SetExecutionLimitsForks(nil)
+	assert.Nil(t, SetExecutionLimitsForks([]*corepb.ExecutionLimitsFork{{Height: 0, MaxCallDepth: 2}}))
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+	ctx.callers = []byteutils.Hash{[]byte("account3")}
+
+	_, _, err := RunContract(ctx, "22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09", "get", "[]", 1000, DefaultLimitsOfTotalMemorySize)
+	assert.Equal(t, ErrExceedCallDepth, err)
+	assert.True(t, IsOutOfResource(err))
+	assert.True(t, IsOutOfResource(ErrExceedMemoryLimits))
+	assert.False(t, IsOutOfResource(ErrInsufficientGas))
+}
+
+func TestExecutionTimeout(t *testing.T) {
+	defer SetExecutionLimitsForks(nil)
+	assert.Nil(t, SetExecutionLimitsForks([]*corepb.ExecutionLimitsFork{{Height: 0, TimeoutMs: 100}}))
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	engine := NewV8Engine(NewContext(testContextBlock(), testContextTransaction(), owner, contract, context))
+	defer engine.Dispose()
+	assert.Equal(t, ErrExecutionTimeout, engine.RunScriptSource("while (true) {}", 0))
+}
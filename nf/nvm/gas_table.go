@@ -0,0 +1,199 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors of gas tables
+var (
+	ErrInvalidGasTableFork  = errors.New("gas table fork must increase the version and height")
+	ErrUnknownGasExpression = errors.New("unknown expression in gas table")
+)
+
+// GasTable is the gas charged by the V8 instruction counter, which is injected into contracts.
+type GasTable struct {
+	Version uint32 `json:"version"`
+	// the table takes effect from the block height.
+	Height uint64 `json:"-"`
+	// gas of the tracked expressions, keyed by the type of the syntax node.
+	Expressions map[string]uint32 `json:"expressions"`
+	StorageByte uint32            `json:"storageByte"`
+	EventByte   uint32            `json:"eventByte"`
+	EventBase   uint32            `json:"eventBase"`
+	// max bytes of the contract source, no limit if 0.
+	MaxCodeSize uint32 `json:"-"`
+	// gas of the contract source, CodeByte for each byte plus the square of the size
+	// divided by CodeQuadDivisor, charged before the deployment runs.
+	CodeByte        uint32 `json:"-"`
+	CodeQuadDivisor uint32 `json:"-"`
+	// gas refunded for each byte of storage freed by a self-destructed contract.
+	DestructRefundByte uint32 `json:"-"`
+}
+
+// DefaultGasTable is the gas table from the genesis, same as the defaults in instruction_counter.js.
+var DefaultGasTable = &GasTable{
+	Version: 0,
+	Height:  0,
+	Expressions: map[string]uint32{
+		"CallExpression":        8,
+		"AssignmentExpression":  3,
+		"BinaryExpression":      3,
+		"UpdateExpression":      3,
+		"UnaryExpression":       3,
+		"LogicalExpression":     3,
+		"MemberExpression":      4,
+		"NewExpression":         8,
+		"ThrowStatement":        6,
+		"MetaProperty":          4,
+		"ConditionalExpression": 3,
+		"YieldExpression":       6,
+	},
+	StorageByte: 1,
+	EventByte:   1,
+	EventBase:   20,
+}
+
+var (
+	gasTables   = []*GasTable{DefaultGasTable}
+	gasTablesMu sync.RWMutex
+)
+
+// GasTableAt returns the gas table for the block at height.
+func GasTableAt(height uint64) *GasTable {
+	gasTablesMu.RLock()
+	defer gasTablesMu.RUnlock()
+
+	for i := len(gasTables) - 1; i > 0; i-- {
+		if height >= gasTables[i].Height {
+			return gasTables[i]
+		}
+	}
+	return gasTables[0]
+}
+
+// SetGasTables installs the repricing of gas scheduled in the genesis conf,
+// each fork changes the gas in the previous table and keeps the others.
+func SetGasTables(forks []*corepb.GasTableFork) error {
+	tables := []*GasTable{DefaultGasTable}
+	for _, v := range forks {
+		last := tables[len(tables)-1]
+		if v.Version <= last.Version || v.Height <= last.Height {
+			return ErrInvalidGasTableFork
+		}
+		table := &GasTable{
+			Version:            v.Version,
+			Height:             v.Height,
+			Expressions:        make(map[string]uint32),
+			StorageByte:        last.StorageByte,
+			EventByte:          last.EventByte,
+			EventBase:          last.EventBase,
+			MaxCodeSize:        last.MaxCodeSize,
+			CodeByte:           last.CodeByte,
+			CodeQuadDivisor:    last.CodeQuadDivisor,
+			DestructRefundByte: last.DestructRefundByte,
+		}
+		for name, gas := range last.Expressions {
+			table.Expressions[name] = gas
+		}
+		for _, e := range v.Expressions {
+			if _, ok := table.Expressions[e.Expression]; !ok {
+				return ErrUnknownGasExpression
+			}
+			table.Expressions[e.Expression] = e.Gas
+		}
+		if v.StorageByte > 0 {
+			table.StorageByte = v.StorageByte
+		}
+		if v.EventByte > 0 {
+			table.EventByte = v.EventByte
+		}
+		if v.EventBase > 0 {
+			table.EventBase = v.EventBase
+		}
+		if v.MaxCodeSize > 0 {
+			table.MaxCodeSize = v.MaxCodeSize
+		}
+		if v.CodeByte > 0 {
+			table.CodeByte = v.CodeByte
+		}
+		if v.CodeQuadDivisor > 0 {
+			table.CodeQuadDivisor = v.CodeQuadDivisor
+		}
+		if v.DestructRefundByte > 0 {
+			table.DestructRefundByte = v.DestructRefundByte
+		}
+		tables = append(tables, table)
+	}
+
+	gasTablesMu.Lock()
+	defer gasTablesMu.Unlock()
+	gasTables = tables
+
+	for _, v := range tables[1:] {
+		logging.CLog().WithFields(logrus.Fields{
+			"version": v.Version,
+			"height":  v.Height,
+		}).Info("Gas table scheduled.")
+	}
+	return nil
+}
+
+// CodeGas returns the gas of the contract source of size bytes, growing superlinearly
+// so that enormous contracts can't bloat the state cheaply.
+func (t *GasTable) CodeGas(size int) *util.Uint128 {
+	n := big.NewInt(int64(size))
+	gas := new(big.Int).Mul(n, big.NewInt(int64(t.CodeByte)))
+	if t.CodeQuadDivisor > 0 {
+		quad := new(big.Int).Mul(n, n)
+		gas.Add(gas, quad.Div(quad, big.NewInt(int64(t.CodeQuadDivisor))))
+	}
+	return util.NewUint128FromBigInt(gas)
+}
+
+// DestructRefund returns the gas refunded for the storage of size bytes freed by a self-destruct.
+func (t *GasTable) DestructRefund(size uint64) *util.Uint128 {
+	gas := new(big.Int).SetUint64(size)
+	gas.Mul(gas, big.NewInt(int64(t.DestructRefundByte)))
+	return util.NewUint128FromBigInt(gas)
+}
+
+// String returns the JSON of the table passed to instruction_counter.js.
+func (t *GasTable) String() string {
+	data, _ := json.Marshal(t)
+	return string(data)
+}
+
+// tracingString returns the JSON of the table telling the instruction counter to pass the source lines.
+func (t *GasTable) tracingString() string {
+	data, _ := json.Marshal(struct {
+		*GasTable
+		Trace bool `json:"trace"`
+	}{t, true})
+	return string(data)
+}
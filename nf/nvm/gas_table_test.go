@@ -0,0 +1,100 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetGasTables(t *testing.T) {
+	defer SetGasTables(nil)
+
+	tests := []struct {
+		name    string
+		forks   []*corepb.GasTableFork
+		wantErr error
+	}{
+		{"no fork", nil, nil},
+		{"version not increased", []*corepb.GasTableFork{{Version: 0, Height: 100}}, ErrInvalidGasTableFork},
+		{"height not increased", []*corepb.GasTableFork{{Version: 1, Height: 100}, {Version: 2, Height: 100}}, ErrInvalidGasTableFork},
+		{"unknown expression", []*corepb.GasTableFork{{Version: 1, Height: 100, Expressions: []*corepb.ExpressionGas{{Expression: "Identifier", Gas: 1}}}}, ErrUnknownGasExpression},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, SetGasTables(tt.forks))
+		})
+	}
+
+	assert.Nil(t, SetGasTables([]*corepb.GasTableFork{
+		{Version: 1, Height: 100, Expressions: []*corepb.ExpressionGas{{Expression: "CallExpression", Gas: 20}}},
+		{Version: 2, Height: 200, StorageByte: 5},
+	}))
+	assert.Equal(t, DefaultGasTable, GasTableAt(99))
+
+	table := GasTableAt(100)
+	assert.Equal(t, uint32(1), table.Version)
+	assert.Equal(t, uint32(20), table.Expressions["CallExpression"])
+	assert.Equal(t, DefaultGasTable.Expressions["NewExpression"], table.Expressions["NewExpression"])
+	assert.Equal(t, DefaultGasTable.StorageByte, table.StorageByte)
+	assert.Equal(t, uint32(8), DefaultGasTable.Expressions["CallExpression"])
+
+	table = GasTableAt(1000)
+	assert.Equal(t, uint32(2), table.Version)
+	assert.Equal(t, uint32(20), table.Expressions["CallExpression"])
+	assert.Equal(t, uint32(5), table.StorageByte)
+	assert.Equal(t, DefaultGasTable.EventBase, table.EventBase)
+}
+
+func TestGasTable_CodeGas(t *testing.T) {
+	defer SetGasTables(nil)
+
+	assert.Equal(t, util.NewUint128(), DefaultGasTable.CodeGas(1000))
+
+	assert.Nil(t, SetGasTables([]*corepb.GasTableFork{
+		{Version: 1, Height: 100, MaxCodeSize: 4096, CodeByte: 2, CodeQuadDivisor: 10},
+		{Version: 2, Height: 200, CodeByte: 3},
+	}))
+	table := GasTableAt(100)
+	assert.Equal(t, uint32(4096), table.MaxCodeSize)
+	// 1000 * 2 + 1000 * 1000 / 10
+	assert.Equal(t, util.NewUint128FromInt(102000), table.CodeGas(1000))
+	// double the size costs more than double the gas.
+	assert.Equal(t, util.NewUint128FromInt(404000), table.CodeGas(2000))
+
+	table = GasTableAt(200)
+	assert.Equal(t, uint32(4096), table.MaxCodeSize)
+	assert.Equal(t, util.NewUint128FromInt(103000), table.CodeGas(1000))
+}
+
+func TestGasTable_DestructRefund(t *testing.T) {
+	defer SetGasTables(nil)
+
+	assert.Equal(t, util.NewUint128(), DefaultGasTable.DestructRefund(1000))
+
+	assert.Nil(t, SetGasTables([]*corepb.GasTableFork{
+		{Version: 1, Height: 100, DestructRefundByte: 5},
+		{Version: 2, Height: 200, CodeByte: 3},
+	}))
+	assert.Equal(t, util.NewUint128FromInt(5000), GasTableAt(100).DestructRefund(1000))
+	assert.Equal(t, util.NewUint128FromInt(5000), GasTableAt(200).DestructRefund(1000))
+}
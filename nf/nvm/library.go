@@ -0,0 +1,69 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors of contract libraries
+var (
+	ErrInvalidLibrary = errors.New("invalid contract library")
+)
+
+// libraryNameRe matches the names a contract requires its libraries by, which never collide
+// with the .js modules of the runtime.
+var libraryNameRe = regexp.MustCompile("^[a-z][a-z0-9_]*$")
+
+// IsLibraryName returns whether the name can link a library.
+func IsLibraryName(name string) bool {
+	return libraryNameRe.MatchString(name)
+}
+
+// LinkLibraries makes the libraries available to the contract, which requires the library
+// deployed at the address by its name, e.g. require("safemath").
+func (ctx *Context) LinkLibraries(libraries map[string]string) {
+	ctx.libraries = libraries
+}
+
+// addLibraries adds the modules of the libraries linked by the contract.
+func (e *V8Engine) addLibraries() error {
+	for name, address := range e.ctx.libraries {
+		if !IsLibraryName(name) || e.ctx.block == nil {
+			return ErrInvalidLibrary
+		}
+		source, err := e.ctx.block.LibrarySource(address)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"name":    name,
+				"address": address,
+				"err":     err,
+			}).Debug("Failed to load the contract library.")
+			return ErrInvalidLibrary
+		}
+		if err := e.AddModule(name, source, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
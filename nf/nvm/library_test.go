@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractLibrary(t *testing.T) {
+	library := `exports.add = function (a, b) {
+    var c = a + b;
+    if (c < a) {
+        throw new Error("overflow");
+    }
+    return c;
+};
+`
+	source := `var SafeMath = require("safemath");
+var Counter = function () {
+    LocalContractStorage.defineProperty(this, "count");
+};
+Counter.prototype = {
+    init: function (n) {
+        this.count = SafeMath.add(0, n);
+    },
+    incr: function (n) {
+        this.count = SafeMath.add(this.count, n);
+        return this.count;
+    }
+};
+module.exports = Counter;
+`
+	libraryAddr, _ := byteutils.FromHex("22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09")
+	block := &mockCallBlock{sources: map[string]string{libraryAddr.String(): library}}
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+
+	tests := []struct {
+		name        string
+		libraries   map[string]string
+		expectedErr error
+	}{
+		{"linked", map[string]string{"safemath": libraryAddr.String()}, nil},
+		{"not linked", nil, ErrExecutionFailed},
+		{"not library", map[string]string{"safemath": "8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"}, ErrInvalidLibrary},
+		{"invalid name", map[string]string{"safemath.js": libraryAddr.String()}, ErrInvalidLibrary},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := NewContext(block, testContextTransaction(), owner, contract, context)
+			ctx.LinkLibraries(tt.libraries)
+			ctx.KeepResult()
+
+			engine := NewV8Engine(ctx)
+			engine.SetExecutionLimits(100000, 100000000)
+			assert.Equal(t, tt.expectedErr, engine.DeployAndInit(source, SourceTypeJavaScript, "[1]"))
+			engine.Dispose()
+			if tt.expectedErr != nil {
+				return
+			}
+
+			engine = NewV8Engine(ctx)
+			engine.SetExecutionLimits(100000, 100000000)
+			assert.Nil(t, engine.Call(source, SourceTypeJavaScript, "incr", "[2]"))
+			assert.Equal(t, "3", engine.Result())
+			engine.Dispose()
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-interpreter/wagon/wasm"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MaxContractSourceSize is the max bytes of the contract source passing the lint.
+const MaxContractSourceSize = 128 * 1024
+
+// Errors in lint
+var (
+	ErrContractSourceTooLarge = errors.New("contract source is too large")
+	ErrContractLintFailed     = errors.New("contract source failed the lint")
+)
+
+// LintContract checks the source of a contract before it's deployed, fast and deterministic,
+// rejecting the sources too large, not parsed or using the banned globals.
+func LintContract(source, sourceType string) error {
+	if len(source) > MaxContractSourceSize {
+		return ErrContractSourceTooLarge
+	}
+
+	switch sourceType {
+	case SourceTypeJavaScript:
+		engine := NewV8Engine(nil)
+		defer engine.Dispose()
+		return engine.lintScript(source)
+	case SourceTypeTypeScript:
+		engine := NewV8Engine(nil)
+		defer engine.Dispose()
+		jsSource, _, err := engine.TranspileTypeScript(source)
+		if err != nil {
+			return err
+		}
+		return engine.lintScript(jsSource)
+	case SourceTypeWasm:
+		return lintWasmModule(source)
+	default:
+		return ErrUnsupportedSourceType
+	}
+}
+
+// lintScript parses the script with lib/lint.js, without running it.
+func (e *V8Engine) lintScript(source string) error {
+	script := fmt.Sprintf("var __result = JSON.stringify(require(\"lint.js\").lint(\"%s\"));\n", formatArgs(source))
+	if err := e.RunScriptSource(script, 0); err != nil {
+		return err
+	}
+	var problems []string
+	if err := json.Unmarshal([]byte(e.result), &problems); err != nil {
+		return ErrExecutionFailed
+	}
+	e.result = ""
+	return lintProblems(problems)
+}
+
+// lintWasmModule parses the module and checks it imports only the host functions and exports init.
+func lintWasmModule(source string) error {
+	code, err := byteutils.FromHex(source)
+	if err != nil {
+		return ErrInvalidWasmModule
+	}
+	module, err := wasm.ReadModule(bytes.NewReader(code), nil)
+	if err != nil {
+		return lintProblems([]string{err.Error()})
+	}
+
+	var problems []string
+	if module.Import != nil {
+		for _, entry := range module.Import.Entries {
+			if _, ok := wasmHostFunctions[entry.FieldName]; entry.ModuleName != wasmHostModule || !ok {
+				problems = append(problems, fmt.Sprintf("import %s.%s is not allowed.", entry.ModuleName, entry.FieldName))
+			}
+		}
+	}
+	if module.Export == nil {
+		problems = append(problems, "init is not exported.")
+	} else if entry, ok := module.Export.Entries["init"]; !ok || entry.Kind != wasm.ExternalFunction {
+		problems = append(problems, "init is not exported.")
+	}
+	return lintProblems(problems)
+}
+
+func lintProblems(problems []string) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s: %s", ErrContractLintFailed, strings.Join(problems, " "))
+}
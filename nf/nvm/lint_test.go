@@ -0,0 +1,61 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintContract(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     string
+		sourceType string
+		err        error
+	}{
+		{"js", "var a = {Date: 1}; a.eval = function () {}; module.exports = a;", "js", nil},
+		{"ts", "class A { save(height: number) {} }\nmodule.exports = A;", "ts", nil},
+		{"wasm", testWasmModule("storage_put"), "wasm", nil},
+		{"syntax error", "var a = ;", "js", ErrContractLintFailed},
+		{"eval", "eval('1');", "js", ErrContractLintFailed},
+		{"date", "var now = new Date();", "js", ErrContractLintFailed},
+		{"random", "var r = Math.random();", "js", ErrContractLintFailed},
+		{"native", "_native_storage_handlers.lcs;", "js", ErrContractLintFailed},
+		{"ts syntax error", "class A {", "ts", ErrTranspileTypeScriptFailed},
+		{"unknown import", testWasmModule("storage_add"), "wasm", ErrContractLintFailed},
+		{"invalid wasm", "0x", "wasm", ErrInvalidWasmModule},
+		{"too large", strings.Repeat(" ", MaxContractSourceSize+1), "js", ErrContractSourceTooLarge},
+		{"unsupported", "var a;", "py", ErrUnsupportedSourceType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := LintContract(tt.source, tt.sourceType)
+			if tt.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+				assert.True(t, strings.HasPrefix(err.Error(), tt.err.Error()), err.Error())
+			}
+		})
+	}
+}
@@ -21,9 +21,19 @@ package nvm
 import "C"
 
 import (
+	"unsafe"
+
 	"github.com/nebulasio/go-nebulas/util/logging"
 )
 
+// devMode records the console output of contracts for debugging.
+var devMode = false
+
+// SetDevMode enables recording the console output of contracts, for the dev chain only.
+func SetDevMode(enabled bool) {
+	devMode = enabled
+}
+
 // V8Log export V8Log
 //export V8Log
 func V8Log(level int, msg *C.char) {
@@ -42,3 +52,14 @@ func V8Log(level int, msg *C.char) {
 		logging.VLog().Error(s)
 	}
 }
+
+// ConsoleLogFunc export ConsoleLogFunc
+//export ConsoleLogFunc
+func ConsoleLogFunc(handler unsafe.Pointer, level int, msg *C.char) {
+	e := getEngineByEngineHandler(handler)
+	if e == nil || !devMode {
+		V8Log(level, msg)
+		return
+	}
+	e.ctx.ConsoleLog(level, C.GoString(msg))
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxMathLibVersion is the latest version of the Decimal library in decimal.js.
+const MaxMathLibVersion = 1
+
+// Errors of math library forks
+var (
+	ErrInvalidMathLibFork = errors.New("math library fork must increase the version and height")
+	ErrUnknownMathLib     = errors.New("unknown version of math library")
+)
+
+// mathLibFork is the version of the Decimal library used from the block height.
+type mathLibFork struct {
+	version uint32
+	height  uint64
+}
+
+var (
+	mathLibs   = []*mathLibFork{{version: 1, height: 0}}
+	mathLibsMu sync.RWMutex
+)
+
+// MathLibVersionAt returns the version of the Decimal library for the block at height.
+func MathLibVersionAt(height uint64) uint32 {
+	mathLibsMu.RLock()
+	defer mathLibsMu.RUnlock()
+
+	for i := len(mathLibs) - 1; i > 0; i-- {
+		if height >= mathLibs[i].height {
+			return mathLibs[i].version
+		}
+	}
+	return mathLibs[0].version
+}
+
+// SetMathLibs installs the versions of the Decimal library scheduled in the genesis conf,
+// the first version is used from the genesis.
+func SetMathLibs(forks []*corepb.MathLibFork) error {
+	libs := []*mathLibFork{{version: 1, height: 0}}
+	for _, v := range forks {
+		last := libs[len(libs)-1]
+		if v.Version <= last.version || v.Height <= last.height {
+			return ErrInvalidMathLibFork
+		}
+		if v.Version > MaxMathLibVersion {
+			return ErrUnknownMathLib
+		}
+		libs = append(libs, &mathLibFork{version: v.Version, height: v.Height})
+	}
+
+	mathLibsMu.Lock()
+	defer mathLibsMu.Unlock()
+	mathLibs = libs
+
+	for _, v := range libs[1:] {
+		logging.CLog().WithFields(logrus.Fields{
+			"version": v.version,
+			"height":  v.height,
+		}).Info("Math library scheduled.")
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvmtest
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Errors
+var (
+	ErrUnknownContract    = errors.New("contract is not deployed in the world")
+	ErrUnknownTransaction = errors.New("transaction is not sent in the world")
+	ErrOutOfBlockWindow   = errors.New("block height is out of the recent blocks window")
+	ErrDestroyedContract  = errors.New("contract has self-destructed in the world")
+	ErrUnknownLibrary     = errors.New("library is not deployed in the world")
+)
+
+// BlockHashWindow is the number of recent blocks whose hashes are visible to contracts.
+const BlockHashWindow = 256
+
+// Block is the mocked block executing the contracts, its fields can be changed between calls.
+type Block struct {
+	Coinbase   byteutils.Hash
+	BlockNonce uint64
+	BlockHash  byteutils.Hash
+	Number     uint64
+	Time       int64
+	Parent     byteutils.Hash
+	Seed       byteutils.Hash
+
+	world  *World
+	events []*Event
+}
+
+// Event is an event recorded by a contract with Event.Trigger.
+type Event struct {
+	TxHash string
+	Topic  string
+	Data   string
+}
+
+func newBlock(w *World) *Block {
+	return &Block{
+		Coinbase:   w.newAddress().Bytes(),
+		BlockNonce: 1,
+		BlockHash:  hash.Sha3256([]byte("block"), byteutils.FromUint64(2)),
+		Number:     2,
+		Time:       1520000000,
+		Parent:     hash.Sha3256([]byte("block"), byteutils.FromUint64(1)),
+		Seed:       hash.Sha3256([]byte("seed")),
+		world:      w,
+	}
+}
+
+// CoinbaseHash returns the coinbase of block.
+func (b *Block) CoinbaseHash() byteutils.Hash {
+	return b.Coinbase
+}
+
+// Nonce returns the nonce of block.
+func (b *Block) Nonce() uint64 {
+	return b.BlockNonce
+}
+
+// Hash returns the hash of block.
+func (b *Block) Hash() byteutils.Hash {
+	return b.BlockHash
+}
+
+// Height returns the height of block.
+func (b *Block) Height() uint64 {
+	return b.Number
+}
+
+// Timestamp returns the timestamp of block.
+func (b *Block) Timestamp() int64 {
+	return b.Time
+}
+
+// ParentHash returns the hash of the parent block.
+func (b *Block) ParentHash() byteutils.Hash {
+	return b.Parent
+}
+
+// AncestorHash returns the parent hash for the parent height, and a hash derived from
+// the height for the other recent blocks.
+func (b *Block) AncestorHash(height uint64) (byteutils.Hash, error) {
+	if height >= b.Number || height+BlockHashWindow < b.Number {
+		return nil, ErrOutOfBlockWindow
+	}
+	if height+1 == b.Number {
+		return b.Parent, nil
+	}
+	return hash.Sha3256([]byte("block"), byteutils.FromUint64(height)), nil
+}
+
+// VerifyAddress returns whether the string is a valid address.
+func (b *Block) VerifyAddress(str string) bool {
+	_, err := core.AddressParse(str)
+	return err == nil
+}
+
+// SerializeTxByHash returns the transaction sent in the world.
+func (b *Block) SerializeTxByHash(hash byteutils.Hash) (proto.Message, error) {
+	tx, ok := b.world.txs[hash.Hex()]
+	if !ok {
+		// the contracts may pass the hex string of the hash.
+		tx, ok = b.world.txs[byteutils.HexHash(hash)]
+	}
+	if !ok {
+		return nil, ErrUnknownTransaction
+	}
+	return tx, nil
+}
+
+// RecordEvent records the event of the transaction.
+func (b *Block) RecordEvent(txHash byteutils.Hash, topic, data string) error {
+	b.events = append(b.events, &Event{TxHash: txHash.String(), Topic: topic, Data: data})
+	return nil
+}
+
+// ContractSource returns the creator and the code of contract deployed in the world.
+func (b *Block) ContractSource(contract state.Account) (byteutils.Hash, string, string, error) {
+	if contract.Destroyed() {
+		return nil, "", "", ErrDestroyedContract
+	}
+	code, ok := b.world.codes[contract.CodePlace().Hex()]
+	if !ok {
+		return nil, "", "", ErrUnknownContract
+	}
+	return code.owner, code.source, code.sourceType, nil
+}
+
+// ContractAddress returns the address of the contract deployed by the creator contract with its nonce.
+func (b *Block) ContractAddress(creator byteutils.Hash, nonce uint64) (byteutils.Hash, error) {
+	addr, err := core.NewContractAddressFromHash(hash.Sha3256(creator, byteutils.FromUint64(nonce)))
+	if err != nil {
+		return nil, err
+	}
+	return addr.Bytes(), nil
+}
+
+// ContractCode returns the code at the hash, the hash of the transaction deploying a contract in the world
+// or of the code deployed by a contract.
+func (b *Block) ContractCode(codeHash byteutils.Hash) (string, string, map[string]string, error) {
+	code, ok := b.world.codes[codeHash.Hex()]
+	if !ok {
+		return "", "", nil, ErrUnknownContract
+	}
+	return code.source, code.sourceType, nil, nil
+}
+
+// SetContractCode keeps the code of the contract deployed by a contract, by the hash of the code,
+// its owner is the sender of the transaction deploying it.
+func (b *Block) SetContractCode(contract state.Account, source, sourceType string, libraries map[string]string, abi *nvm.ABI) error {
+	tx, ok := b.world.txs[contract.BirthPlace().Hex()]
+	if !ok {
+		return ErrUnknownTransaction
+	}
+	codeHash := hash.Sha3256([]byte(sourceType), []byte(source))
+	b.world.codes[codeHash.Hex()] = &contractCode{owner: tx.From, source: source, sourceType: sourceType}
+	contract.SetCodePlace(codeHash)
+	return nil
+}
+
+// ContractLibraries returns the libraries linked by contract, the world deploys no libraries.
+func (b *Block) ContractLibraries(contract state.Account) (map[string]string, error) {
+	return nil, nil
+}
+
+// LibrarySource returns the source of the library at address, the world deploys no libraries.
+func (b *Block) LibrarySource(address string) (string, error) {
+	return "", ErrUnknownLibrary
+}
+
+// RandomSeed returns the random seed of block.
+func (b *Block) RandomSeed() (byteutils.Hash, error) {
+	return b.Seed, nil
+}
@@ -0,0 +1,317 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvmtest
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Errors
+var (
+	ErrInvalidSender       = errors.New("invalid sender address")
+	ErrInvalidValue        = errors.New("invalid value, must be a uint128")
+	ErrInsufficientBalance = errors.New("insufficient balance of the sender")
+)
+
+// DefaultGasLimit is the gas limit of the transactions without one.
+const DefaultGasLimit uint64 = 1000000
+
+// World is an in-memory world state to deploy and call contracts in, without running a node.
+type World struct {
+	block     *Block
+	state     state.AccountState
+	txs       map[byteutils.HexHash]*corepb.Transaction
+	codes     map[byteutils.HexHash]*contractCode
+	addresses uint64
+}
+
+// contractCode is the code of a contract deployed in the world.
+type contractCode struct {
+	owner      byteutils.Hash
+	source     string
+	sourceType string
+}
+
+// Tx is the transaction deploying or calling a contract, the zero Value and GasLimit take the defaults.
+type Tx struct {
+	From     string
+	Value    string
+	GasLimit uint64
+}
+
+// Receipt is the result of a contract execution, the state changes of a failed one are discarded.
+type Receipt struct {
+	TxHash   string
+	Contract string
+	// Gas is the gas of the instructions executed by the contract.
+	Gas       uint64
+	Err       error
+	Events    []*Event
+	Logs      []*nvm.ContractLog
+	Transfers []*nvm.ContractTransfer
+	Destructs []*nvm.ContractDestruct
+	// ABI is the ABI generated when the contract is deployed.
+	ABI *nvm.ABI
+}
+
+// NewWorld returns an empty world.
+func NewWorld() (*World, error) {
+	mem, err := storage.NewMemoryStorage()
+	if err != nil {
+		return nil, err
+	}
+	accState, err := state.NewAccountState(nil, mem)
+	if err != nil {
+		return nil, err
+	}
+	w := &World{
+		state: accState,
+		txs:   make(map[byteutils.HexHash]*corepb.Transaction),
+		codes: make(map[byteutils.HexHash]*contractCode),
+	}
+	w.block = newBlock(w)
+	return w, nil
+}
+
+// Block returns the block executing the contracts, changes to it apply to the later executions.
+func (w *World) Block() *Block {
+	return w.block
+}
+
+// NewAddress returns a new account address.
+func (w *World) NewAddress() string {
+	return w.newAddress().String()
+}
+
+func (w *World) newAddress() *core.Address {
+	w.addresses++
+	addr, _ := core.NewAddress(hash.Sha3256([]byte("account"), byteutils.FromUint64(w.addresses))[:core.AddressDataLength])
+	return addr
+}
+
+// AddBalance adds value to the balance of the account.
+func (w *World) AddBalance(address, value string) error {
+	addr, err := core.AddressParse(address)
+	if err != nil {
+		return err
+	}
+	amount, ok := util.NewUint128().FromString(value)
+	if !ok || amount.Validate() != nil {
+		return ErrInvalidValue
+	}
+	w.state.BeginBatch()
+	w.state.GetOrCreateUserAccount(addr.Bytes()).AddBalance(amount)
+	w.state.Commit()
+	return nil
+}
+
+// Balance returns the balance of the account.
+func (w *World) Balance(address string) (string, error) {
+	addr, err := core.AddressParse(address)
+	if err != nil {
+		return "", err
+	}
+	return w.state.GetOrCreateUserAccount(addr.Bytes()).Balance().String(), nil
+}
+
+// Storage returns the raw value of the key in the storage of contract, the key is the
+// field name of a property or "@" + Map + "[" + key + "]" of a Map.
+func (w *World) Storage(contract, key string) (string, error) {
+	addr, err := core.AddressParse(contract)
+	if err != nil {
+		return "", err
+	}
+	acc, err := w.state.GetContractAccount(addr.Bytes())
+	if err != nil {
+		return "", ErrUnknownContract
+	}
+	value, err := nvm.StorageGet(acc, key)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// Deploy deploys the contract from the sender and runs its init function with the args.
+func (w *World) Deploy(tx *Tx, source, sourceType, args string) (*Receipt, error) {
+	from, err := core.AddressParse(tx.From)
+	if err != nil {
+		return nil, ErrInvalidSender
+	}
+	nonce := w.state.GetOrCreateUserAccount(from.Bytes()).Nonce() + 1
+	to, err := core.NewContractAddressFromHash(hash.Sha3256(from.Bytes(), byteutils.FromUint64(nonce)))
+	if err != nil {
+		return nil, err
+	}
+	code := &contractCode{owner: from.Bytes(), source: source, sourceType: sourceType}
+	return w.execute(tx, to, code, "", args)
+}
+
+// Call calls the function of the contract with the args.
+func (w *World) Call(tx *Tx, contract, function, args string) (*Receipt, error) {
+	to, err := core.AddressParse(contract)
+	if err != nil {
+		return nil, err
+	}
+	return w.execute(tx, to, nil, function, args)
+}
+
+// execute runs the contract in a batch of the state, which is discarded if the execution fails.
+// The code is deployed to the address if it's not nil, otherwise the function is called.
+func (w *World) execute(tx *Tx, to *core.Address, code *contractCode, function, args string) (*Receipt, error) {
+	from, err := core.AddressParse(tx.From)
+	if err != nil {
+		return nil, ErrInvalidSender
+	}
+	value := util.NewUint128()
+	if len(tx.Value) > 0 {
+		var ok bool
+		if value, ok = util.NewUint128().FromString(tx.Value); !ok || value.Validate() != nil {
+			return nil, ErrInvalidValue
+		}
+	}
+	gasLimit := tx.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultGasLimit
+	}
+	if w.state.GetOrCreateUserAccount(from.Bytes()).Balance().Cmp(value.Int) < 0 {
+		return nil, ErrInsufficientBalance
+	}
+
+	// the nonce is used even if the execution fails.
+	w.state.BeginBatch()
+	fromAcc := w.state.GetOrCreateUserAccount(from.Bytes())
+	fromAcc.IncrNonce()
+	nonce := fromAcc.Nonce()
+	w.state.Commit()
+
+	txHash := byteutils.Hash(hash.Sha3256(from.Bytes(), to.Bytes(), byteutils.FromUint64(nonce)))
+	pbValue, _ := value.ToFixedSizeByteSlice()
+	pbGasLimit, _ := util.NewUint128FromInt(int64(gasLimit)).ToFixedSizeByteSlice()
+	pbGasPrice, _ := util.NewUint128FromInt(1).ToFixedSizeByteSlice()
+	w.txs[txHash.Hex()] = &corepb.Transaction{
+		Hash:      txHash,
+		From:      from.Bytes(),
+		To:        to.Bytes(),
+		Value:     pbValue,
+		Nonce:     nonce,
+		Timestamp: w.block.Time,
+		GasPrice:  pbGasPrice,
+		GasLimit:  pbGasLimit,
+	}
+	ctxTx := &nvm.ContextTransaction{
+		Hash:      txHash.String(),
+		From:      from.String(),
+		To:        to.String(),
+		Value:     value.String(),
+		Nonce:     nonce,
+		Timestamp: w.block.Time,
+		GasPrice:  "1",
+		GasLimit:  util.NewUint128FromInt(int64(gasLimit)).String(),
+	}
+
+	w.state.BeginBatch()
+	var contract state.Account
+	deploy := code != nil
+	if deploy {
+		if contract, err = w.state.CreateContractAccount(to.Bytes(), txHash); err != nil {
+			w.state.RollBack()
+			return nil, err
+		}
+		w.codes[txHash.Hex()] = code
+	} else {
+		if contract, err = w.state.GetContractAccount(to.Bytes()); err != nil {
+			w.state.RollBack()
+			return nil, ErrUnknownContract
+		}
+		if code = w.codes[contract.CodePlace().Hex()]; code == nil {
+			w.state.RollBack()
+			return nil, ErrUnknownContract
+		}
+		if contract.Destroyed() {
+			w.state.RollBack()
+			return nil, ErrDestroyedContract
+		}
+	}
+
+	nvmctx := nvm.NewContext(w.block, ctxTx, w.state.GetOrCreateUserAccount(code.owner), contract, w.state)
+	engine := nvm.NewEngine(nvmctx, code.sourceType)
+	defer engine.Dispose()
+	limits := nvm.ExecutionLimitsAt(w.block.Height())
+	engine.SetExecutionLimits(limits.Instructions(gasLimit), limits.MaxMemorySize)
+
+	events := len(w.block.events)
+	if deploy {
+		err = engine.DeployAndInit(code.source, code.sourceType, args)
+	} else {
+		err = engine.Call(code.source, code.sourceType, function, args)
+	}
+	receipt := &Receipt{
+		TxHash:   txHash.String(),
+		Contract: to.String(),
+		Gas:      engine.ExecutionInstructions(),
+		Err:      err,
+	}
+	if err != nil {
+		w.state.RollBack()
+		w.block.events = w.block.events[:events]
+		if deploy {
+			delete(w.codes, txHash.Hex())
+		}
+		return receipt, nil
+	}
+
+	// the value is transferred after the execution succeeds, the same as on chain.
+	if err := w.state.GetOrCreateUserAccount(from.Bytes()).SubBalance(value); err != nil {
+		w.state.RollBack()
+		return nil, err
+	}
+	contract.AddBalance(value)
+	for _, v := range nvmctx.Destructs() {
+		if err := w.destroy(v.Address); err != nil {
+			w.state.RollBack()
+			return nil, err
+		}
+	}
+	w.state.Commit()
+
+	receipt.Events = w.block.events[events:]
+	receipt.Logs = nvmctx.Logs()
+	receipt.Transfers = nvmctx.Transfers()
+	receipt.Destructs = nvmctx.Destructs()
+	receipt.ABI = engine.ABI()
+	return receipt, nil
+}
+
+func (w *World) destroy(address string) error {
+	addr, err := byteutils.FromHex(address)
+	if err != nil {
+		return err
+	}
+	return w.state.DestroyContract(addr)
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvmtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const counterContract = `'use strict';
+
+var Counter = function () {
+    LocalContractStorage.defineProperty(this, "count");
+    LocalContractStorage.defineMapProperty(this, "callers");
+};
+
+Counter.prototype = {
+    init: function (count) {
+        this.count = count;
+    },
+    incr: function (step) {
+        if (step <= 0) {
+            throw new Error("step must be positive.");
+        }
+        this.count += step;
+        this.callers.set(Blockchain.transaction.from, Blockchain.block.height);
+        Event.Trigger("incr", {count: this.count});
+        return this.count;
+    }
+};
+
+module.exports = Counter;
+`
+
+const vaultContract = `'use strict';
+
+var Vault = function () {
+    LocalContractStorage.defineProperty(this, "owner");
+};
+
+Vault.prototype = {
+    init: function () {
+        this.owner = Blockchain.transaction.from;
+    },
+    close: function () {
+        Blockchain.selfDestruct(this.owner);
+    }
+};
+
+module.exports = Vault;
+`
+
+func TestWorld(t *testing.T) {
+	w, err := NewWorld()
+	assert.Nil(t, err)
+	sender := w.NewAddress()
+	assert.Nil(t, w.AddBalance(sender, "100"))
+
+	receipt, err := w.Deploy(&Tx{From: sender}, counterContract, "js", "[1]")
+	assert.Nil(t, err)
+	assert.Nil(t, receipt.Err)
+	contract := receipt.Contract
+	count, err := w.Storage(contract, "count")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", count)
+
+	w.Block().Number = 10
+	receipt, err = w.Call(&Tx{From: sender, Value: "30"}, contract, "incr", "[2]")
+	assert.Nil(t, err)
+	assert.Nil(t, receipt.Err)
+	assert.True(t, receipt.Gas > 0)
+	assert.Equal(t, 1, len(receipt.Events))
+	assert.Equal(t, "chain.contract.incr", receipt.Events[0].Topic)
+	assert.Equal(t, `{"count":3}`, receipt.Events[0].Data)
+	height, err := w.Storage(contract, "@callers["+sender+"]")
+	assert.Nil(t, err)
+	assert.Equal(t, "10", height)
+	balance, _ := w.Balance(contract)
+	assert.Equal(t, "30", balance)
+
+	// a failed call changes nothing but the nonce.
+	receipt, err = w.Call(&Tx{From: sender, Value: "30"}, contract, "incr", "[0]")
+	assert.Nil(t, err)
+	assert.NotNil(t, receipt.Err)
+	assert.Equal(t, 0, len(receipt.Events))
+	count, _ = w.Storage(contract, "count")
+	assert.Equal(t, "3", count)
+	balance, _ = w.Balance(sender)
+	assert.Equal(t, "70", balance)
+
+	// the gas limit stops the execution.
+	receipt, err = w.Call(&Tx{From: sender, GasLimit: 1}, contract, "incr", "[1]")
+	assert.Nil(t, err)
+	assert.NotNil(t, receipt.Err)
+
+	_, err = w.Call(&Tx{From: sender, Value: "1000"}, contract, "incr", "[1]")
+	assert.Equal(t, ErrInsufficientBalance, err)
+	_, err = w.Call(&Tx{From: sender}, w.NewAddress(), "incr", "[1]")
+	assert.Equal(t, ErrUnknownContract, err)
+}
+
+func TestWorld_SelfDestruct(t *testing.T) {
+	w, err := NewWorld()
+	assert.Nil(t, err)
+	sender := w.NewAddress()
+	assert.Nil(t, w.AddBalance(sender, "100"))
+
+	receipt, err := w.Deploy(&Tx{From: sender, Value: "30"}, vaultContract, "js", "")
+	assert.Nil(t, err)
+	assert.Nil(t, receipt.Err)
+	contract := receipt.Contract
+	balance, _ := w.Balance(sender)
+	assert.Equal(t, "70", balance)
+
+	receipt, err = w.Call(&Tx{From: sender}, contract, "close", "")
+	assert.Nil(t, err)
+	assert.Nil(t, receipt.Err)
+	assert.Equal(t, 1, len(receipt.Destructs))
+	assert.Equal(t, sender, receipt.Destructs[0].Beneficiary)
+	assert.Equal(t, "30", receipt.Destructs[0].Value)
+	balance, _ = w.Balance(sender)
+	assert.Equal(t, "100", balance)
+	_, err = w.Storage(contract, "owner")
+	assert.NotNil(t, err)
+
+	_, err = w.Call(&Tx{From: sender}, contract, "close", "")
+	assert.Equal(t, ErrDestroyedContract, err)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+
+import (
+	"errors"
+	"regexp"
+	"unsafe"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/sha3"
+)
+
+// MaxOracleQuerySize is the max bytes of the query of an oracle request.
+const MaxOracleQuerySize = 1024
+
+// Errors of oracle requests
+var (
+	ErrInvalidOracleQuery    = errors.New("oracle query must be 1 to 1024 bytes")
+	ErrInvalidOracleCallback = errors.New("oracle callback must be a private function")
+)
+
+// oracleCallbackChecker matches the private functions, which only the chain can call back.
+var oracleCallbackChecker = regexp.MustCompile("^_[A-Za-z0-9_$]+$")
+
+// OracleRequest is a query of a contract for the oracle operators, whose answer is delivered
+// to the callback of the contract.
+type OracleRequest struct {
+	ID       string `json:"id"`
+	Contract string `json:"contract"`
+	Query    string `json:"query"`
+	Callback string `json:"callback"`
+}
+
+// IsOracleCallback returns whether the function can receive the answers of oracle requests.
+func IsOracleCallback(function string) bool {
+	return oracleCallbackChecker.MatchString(function)
+}
+
+// RequestOracle records the request of the contract, and returns its id which is unique in the chain.
+func (ctx *Context) RequestOracle(query, callback string) (string, error) {
+	if ctx.block == nil {
+		return "", ErrMissingContextBlock
+	}
+	if len(query) == 0 || len(query) > MaxOracleQuerySize {
+		return "", ErrInvalidOracleQuery
+	}
+	if !IsOracleCallback(callback) {
+		return "", ErrInvalidOracleCallback
+	}
+
+	hasher := sha3.New256()
+	hasher.Write([]byte(ctx.tx.Hash))
+	hasher.Write(ctx.contract.Address())
+	hasher.Write(byteutils.FromUint64(uint64(len(ctx.effects.oracleRequests))))
+	request := &OracleRequest{
+		ID:       byteutils.Hex(hasher.Sum(nil)),
+		Contract: ctx.contract.Address().String(),
+		Query:    query,
+		Callback: callback,
+	}
+	ctx.effects.oracleRequests = append(ctx.effects.oracleRequests, request)
+	return request.ID, nil
+}
+
+// OracleRequests returns the oracle requests of the contract and the contracts it called.
+func (ctx *Context) OracleRequests() []*OracleRequest {
+	return ctx.effects.oracleRequests
+}
+
+// Callback runs the private callback of the contract, for the answers delivered by the chain.
+func (e *V8Engine) Callback(source, sourceType, function, args string) error {
+	if !IsOracleCallback(function) {
+		return ErrDisallowCallPrivateFunction
+	}
+	return e.RunContractScript(source, sourceType, function, args)
+}
+
+// RequestOracleFunc records the oracle request of the contract, charged as the storage it takes
+//export RequestOracleFunc
+func RequestOracleFunc(handler unsafe.Pointer, query *C.char, callback *C.char) *C.char {
+	engine, _ := enterHostFunc(handler, "request_oracle")
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+	defer engine.exitHostFunc("request_oracle")
+
+	gQuery, gCallback := C.GoString(query), C.GoString(callback)
+	id, err := engine.ctx.RequestOracle(gQuery, gCallback)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler":  uint64(uintptr(handler)),
+			"callback": gCallback,
+			"err":      err,
+		}).Error("RequestOracleFunc request oracle failed.")
+		return nil
+	}
+	engine.v8engine.stats.count_of_executed_instructions += C.size_t(uint64(len(gQuery)+len(gCallback)) * uint64(engine.gasTable.StorageByte))
+	return C.CString(id)
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOracleRequest(t *testing.T) {
+	source := `var Contract = function () {
+    LocalContractStorage.defineProperty(this, "answer");
+};
+Contract.prototype = {
+    init: function () {},
+    ask: function (query, callback) {
+        return Blockchain.requestOracle(query, callback);
+    },
+    _answer: function (id, answer) {
+        this.answer = answer;
+        return id;
+    }
+};
+module.exports = Contract;
+`
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+
+	tests := []struct {
+		name        string
+		args        string
+		expectedErr error
+	}{
+		{"request", `["price of NAS", "_answer"]`, nil},
+		{"empty query", `["", "_answer"]`, ErrExecutionFailed},
+		{"long query", `["` + strings.Repeat("q", MaxOracleQuerySize+1) + `", "_answer"]`, ErrExecutionFailed},
+		{"public callback", `["price of NAS", "answer"]`, ErrExecutionFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+			ctx.KeepResult()
+
+			engine := NewV8Engine(ctx)
+			engine.SetExecutionLimits(100000, 100000000)
+			assert.Equal(t, tt.expectedErr, engine.Call(source, SourceTypeJavaScript, "ask", tt.args))
+			engine.Dispose()
+			if tt.expectedErr != nil {
+				assert.Empty(t, ctx.OracleRequests())
+				return
+			}
+
+			requests := ctx.OracleRequests()
+			assert.Equal(t, 1, len(requests))
+			assert.Equal(t, `"`+requests[0].ID+`"`, engine.Result())
+			assert.Equal(t, "price of NAS", requests[0].Query)
+			assert.Equal(t, "_answer", requests[0].Callback)
+
+			// the answer is delivered to the private callback only.
+			engine = NewV8Engine(ctx)
+			engine.SetExecutionLimits(100000, 100000000)
+			assert.Equal(t, ErrDisallowCallPrivateFunction, engine.Callback(source, SourceTypeJavaScript, "answer", `["id", "0.42"]`))
+			assert.Nil(t, engine.Callback(source, SourceTypeJavaScript, "_answer", `["id", "0.42"]`))
+			assert.Equal(t, `"id"`, engine.Result())
+			engine.Dispose()
+		})
+	}
+}
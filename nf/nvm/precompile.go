@@ -0,0 +1,161 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"golang.org/x/crypto/bn256"
+	"golang.org/x/crypto/ed25519"
+)
+
+// Errors of precompiled contracts
+var (
+	ErrUnknownPrecompile      = errors.New("unknown precompiled contract")
+	ErrInvalidPrecompileInput = errors.New("invalid input of precompiled contract")
+)
+
+// Precompile is a native function callable from contracts, charged by the size of its input.
+type Precompile interface {
+	Gas(input []byte) uint64
+	Run(input []byte) ([]byte, error)
+}
+
+// Precompiles are the native functions callable from contracts by name.
+var Precompiles = map[string]Precompile{
+	"sha256":       &linearPrecompile{base: 60, word: 12, run: runSha256},
+	"ripemd160":    &linearPrecompile{base: 600, word: 120, run: runRipemd160},
+	"ecrecover":    &linearPrecompile{base: 3000, run: runEcrecover},
+	"ed25519":      &linearPrecompile{base: 2000, word: 12, run: runEd25519Verify},
+	"bn256Pairing": &bn256PairingPrecompile{},
+}
+
+// RunPrecompile runs the precompile with the input if its gas doesn't exceed the gas limit,
+// it returns the output and the gas charged. No limit is checked if gasLimit is 0.
+func RunPrecompile(name string, input []byte, gasLimit uint64) ([]byte, uint64, error) {
+	p, ok := Precompiles[name]
+	if !ok {
+		return nil, 0, ErrUnknownPrecompile
+	}
+	gas := p.Gas(input)
+	if gasLimit > 0 && gas > gasLimit {
+		return nil, gas, ErrInsufficientGas
+	}
+	output, err := p.Run(input)
+	return output, gas, err
+}
+
+// linearPrecompile charges the base gas plus the gas of each 32 bytes word of the input.
+type linearPrecompile struct {
+	base uint64
+	word uint64
+	run  func(input []byte) ([]byte, error)
+}
+
+func (p *linearPrecompile) Gas(input []byte) uint64 {
+	return p.base + p.word*uint64((len(input)+31)/32)
+}
+
+func (p *linearPrecompile) Run(input []byte) ([]byte, error) {
+	return p.run(input)
+}
+
+func runSha256(input []byte) ([]byte, error) {
+	return hash.Sha256(input), nil
+}
+
+func runRipemd160(input []byte) ([]byte, error) {
+	return hash.Ripemd160(input), nil
+}
+
+// runEcrecover takes the 32 bytes hash and the 65 bytes signature, and returns the 65 bytes
+// uncompressed public key of the signer, empty if it can't be recovered.
+func runEcrecover(input []byte) ([]byte, error) {
+	if len(input) != 32+65 {
+		return nil, ErrInvalidPrecompileInput
+	}
+	pub, err := secp256k1.RecoverECDSAPublicKey(input[:32], input[32:])
+	if err != nil {
+		return []byte{}, nil
+	}
+	return secp256k1.FromECDSAPublicKey(pub)
+}
+
+// runEd25519Verify takes the 32 bytes public key, the 64 bytes signature and the message,
+// and returns 1 if the signature is valid, otherwise 0.
+func runEd25519Verify(input []byte) ([]byte, error) {
+	if len(input) < ed25519.PublicKeySize+ed25519.SignatureSize {
+		return nil, ErrInvalidPrecompileInput
+	}
+	pub := ed25519.PublicKey(input[:ed25519.PublicKeySize])
+	sig := input[ed25519.PublicKeySize : ed25519.PublicKeySize+ed25519.SignatureSize]
+	if ed25519.Verify(pub, input[ed25519.PublicKeySize+ed25519.SignatureSize:], sig) {
+		return []byte{1}, nil
+	}
+	return []byte{0}, nil
+}
+
+// bn256 pairs are a 64 bytes G1 point followed by a 128 bytes G2 point.
+const (
+	bn256G1Size   = 64
+	bn256PairSize = bn256G1Size + 128
+)
+
+// bn256PairingPrecompile checks that the product of the pairings of the pairs is one,
+// the gas grows with the number of pairs.
+type bn256PairingPrecompile struct{}
+
+func (p *bn256PairingPrecompile) Gas(input []byte) uint64 {
+	return 100000 + 80000*uint64(len(input)/bn256PairSize)
+}
+
+// Run returns 32 bytes, 1 in the last byte if the pairing check succeeds.
+func (p *bn256PairingPrecompile) Run(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%bn256PairSize != 0 {
+		return nil, ErrInvalidPrecompileInput
+	}
+	var product *bn256.GT
+	for i := 0; i < len(input); i += bn256PairSize {
+		g1, ok := new(bn256.G1).Unmarshal(input[i : i+bn256G1Size])
+		if !ok {
+			return nil, ErrInvalidPrecompileInput
+		}
+		g2, ok := new(bn256.G2).Unmarshal(input[i+bn256G1Size : i+bn256PairSize])
+		if !ok {
+			return nil, ErrInvalidPrecompileInput
+		}
+		pair := bn256.Pair(g1, g2)
+		if product == nil {
+			product = pair
+		} else {
+			product.Add(product, pair)
+		}
+	}
+
+	output := make([]byte, 32)
+	one := new(bn256.GT).ScalarMult(product, big.NewInt(0))
+	if bytes.Equal(product.Marshal(), one.Marshal()) {
+		output[31] = 1
+	}
+	return output, nil
+}
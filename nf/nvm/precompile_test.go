@@ -0,0 +1,104 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bn256"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestRunPrecompile(t *testing.T) {
+	output, gas, err := RunPrecompile("sha256", []byte("abc"), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(72), gas)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", byteutils.Hex(output))
+
+	output, _, err = RunPrecompile("ripemd160", []byte("abc"), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc", byteutils.Hex(output))
+
+	_, _, err = RunPrecompile("sha256", []byte("abc"), 10)
+	assert.Equal(t, ErrInsufficientGas, err)
+	_, _, err = RunPrecompile("keccak", []byte("abc"), 0)
+	assert.Equal(t, ErrUnknownPrecompile, err)
+}
+
+func TestRunPrecompile_Ecrecover(t *testing.T) {
+	priv := secp256k1.NewECDSAPrivateKey()
+	pub, _ := secp256k1.FromECDSAPublicKey(&priv.PublicKey)
+	msg := hash.Sha3256([]byte("message"))
+	sig, _ := secp256k1.Sign(msg, priv)
+
+	output, _, err := RunPrecompile("ecrecover", append(msg, sig...), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, pub, output)
+
+	sig[64] = 9
+	output, _, err = RunPrecompile("ecrecover", append(msg, sig...), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(output))
+
+	_, _, err = RunPrecompile("ecrecover", msg, 0)
+	assert.Equal(t, ErrInvalidPrecompileInput, err)
+}
+
+func TestRunPrecompile_Ed25519(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	msg := []byte("message")
+	input := append(append(append([]byte{}, pub...), ed25519.Sign(priv, msg)...), msg...)
+
+	output, _, err := RunPrecompile("ed25519", input, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1}, output)
+
+	input[len(input)-1] = 'x'
+	output, _, err = RunPrecompile("ed25519", input, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0}, output)
+}
+
+func TestRunPrecompile_Bn256Pairing(t *testing.T) {
+	a, b := big.NewInt(3), big.NewInt(5)
+	// e(a*G1, b*G2) * e(-ab*G1, G2) = 1
+	g1 := new(bn256.G1).ScalarBaseMult(a)
+	g2 := new(bn256.G2).ScalarBaseMult(b)
+	h1 := new(bn256.G1).ScalarBaseMult(new(big.Int).Mul(a, b))
+	h1.Neg(h1)
+	h2 := new(bn256.G2).ScalarBaseMult(big.NewInt(1))
+	input := append(append(append(g1.Marshal(), g2.Marshal()...), h1.Marshal()...), h2.Marshal()...)
+
+	output, gas, err := RunPrecompile("bn256Pairing", input, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(260000), gas)
+	assert.Equal(t, byte(1), output[31])
+
+	output, _, err = RunPrecompile("bn256Pairing", input[:bn256PairSize], 0)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(0), output[31])
+
+	_, _, err = RunPrecompile("bn256Pairing", input[:100], 0)
+	assert.Equal(t, ErrInvalidPrecompileInput, err)
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	sandboxHeight   uint64
+	sandboxHeightMu sync.RWMutex
+)
+
+// SandboxAt returns whether the contracts of the block at height run in the deterministic sandbox,
+// the blocks before it are replayed with the builtins of V8.
+func SandboxAt(height uint64) bool {
+	sandboxHeightMu.RLock()
+	defer sandboxHeightMu.RUnlock()
+
+	return height >= sandboxHeight
+}
+
+// SetSandboxHeight installs the height of the deterministic sandbox scheduled in the genesis conf.
+func SetSandboxHeight(height uint64) {
+	sandboxHeightMu.Lock()
+	defer sandboxHeightMu.Unlock()
+	sandboxHeight = height
+
+	if height > 0 {
+		logging.CLog().WithFields(logrus.Fields{
+			"height": height,
+		}).Info("Sandbox scheduled.")
+	}
+}
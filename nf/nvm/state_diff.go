@@ -0,0 +1,71 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// StateDiff is a key of contract storage written by an execution, with the hashes of its value
+// before the execution and after, empty if the key doesn't exist.
+type StateDiff struct {
+	Contract string `json:"contract"`
+	Key      string `json:"key"`
+	OldHash  string `json:"old_hash"`
+	NewHash  string `json:"new_hash"`
+}
+
+func stateValueHash(value []byte) string {
+	if value == nil {
+		return ""
+	}
+	return byteutils.Hex(hash.Sha3256(value))
+}
+
+// recordStateDiff records the write of the key in the storage, the new value is nil for a delete.
+// The old value is read only on the first write of the key in the execution.
+func (ctx *Context) recordStateDiff(storage state.Account, key string, value []byte) {
+	id := storage.Address().String() + key
+	diff, ok := ctx.effects.stateDiffIndex[id]
+	if !ok {
+		old, err := storage.Get(hashStorageKey(key))
+		if err != nil {
+			old = nil
+		}
+		diff = &StateDiff{
+			Contract: storage.Address().String(),
+			Key:      key,
+			OldHash:  stateValueHash(old),
+		}
+		if ctx.effects.stateDiffIndex == nil {
+			ctx.effects.stateDiffIndex = make(map[string]*StateDiff)
+		}
+		ctx.effects.stateDiffIndex[id] = diff
+		ctx.effects.stateDiffs = append(ctx.effects.stateDiffs, diff)
+	}
+	diff.NewHash = stateValueHash(value)
+}
+
+// StateDiffs returns the keys of contract storage written by the contracts in the execution,
+// including the nested calls, in the order of their first writes.
+func (ctx *Context) StateDiffs() []*StateDiff {
+	return ctx.effects.stateDiffs
+}
@@ -21,10 +21,12 @@ package nvm
 import "C"
 
 import (
+	"encoding/json"
 	"regexp"
 	"unsafe"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -60,15 +62,75 @@ func hashStorageKey(key string) []byte {
 	return trie.HashDomains(domainKey, itemKey)
 }
 
+// StorageGet returns the value of the key in the storage, the key is the same as the one in contract.
+func StorageGet(storage state.Account, key string) ([]byte, error) {
+	return storage.Get(hashStorageKey(key))
+}
+
+// storageKeyIndex return the key of the index to the raw ItemKey of a Map-ItemKey, nil for an ItemKey.
+// The hashed keys can't be reversed, so the ItemKeys of a Map are indexed in the domain "@" + Map,
+// which never collides with the Maps whose names can't start with "@".
+func storageKeyIndex(key string) ([]byte, string) {
+	matches := keyPattern.FindAllStringSubmatch(key, -1)
+	if matches == nil {
+		return nil, ""
+	}
+	return trie.HashDomains("@"+matches[0][1], matches[0][2]), matches[0][2]
+}
+
+// storagePut puts the value and indexes the ItemKey of a Map.
+func storagePut(storage state.Account, key string, value []byte) error {
+	if err := storage.Put(hashStorageKey(key), value); err != nil {
+		return err
+	}
+	if index, itemKey := storageKeyIndex(key); index != nil {
+		return storage.Put(index, []byte(itemKey))
+	}
+	return nil
+}
+
+// storageDel deletes the value and the index to the ItemKey of a Map.
+func storageDel(storage state.Account, key string) error {
+	if err := storage.Del(hashStorageKey(key)); err != nil {
+		return err
+	}
+	if index, _ := storageKeyIndex(key); index != nil {
+		if err := storage.Del(index); err != nil && err != ErrKeyNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+// storageKeys returns the ItemKeys of the Map in the order of their hashes.
+func storageKeys(storage state.Account, field string) ([]string, error) {
+	keys := []string{}
+	iter, err := storage.Iterator(trie.HashDomainsPrefix("@" + field))
+	if err != nil && err != ErrKeyNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return keys, nil
+	}
+	exist, err := iter.Next()
+	for exist {
+		keys = append(keys, string(iter.Value()))
+		exist, err = iter.Next()
+	}
+	return keys, err
+}
+
 // StorageGetFunc export StorageGetFunc
 //export StorageGetFunc
 func StorageGetFunc(handler unsafe.Pointer, key *C.char) *C.char {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := enterHostFunc(handler, "storage_get")
 	if storage == nil {
 		return nil
 	}
+	defer engine.exitHostFunc("storage_get")
 
 	val, err := storage.Get([]byte(hashStorageKey(C.GoString(key))))
+	engine.traceStorage(TraceOpStorageGet, C.GoString(key), string(val), 0)
 	if err != nil {
 		if err != ErrKeyNotFound {
 			logging.VLog().WithFields(logrus.Fields{
@@ -86,12 +148,16 @@ func StorageGetFunc(handler unsafe.Pointer, key *C.char) *C.char {
 // StoragePutFunc export StoragePutFunc
 //export StoragePutFunc
 func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := enterHostFunc(handler, "storage_put")
 	if storage == nil {
 		return 1
 	}
+	defer engine.exitHostFunc("storage_put")
+	engine.traceStorage(TraceOpStoragePut, C.GoString(key), C.GoString(value),
+		uint64(len(C.GoString(key))+len(C.GoString(value)))*uint64(engine.gasTable.StorageByte))
+	engine.ctx.recordStateDiff(storage, C.GoString(key), []byte(C.GoString(value)))
 
-	err := storage.Put([]byte(hashStorageKey(C.GoString(key))), []byte(C.GoString(value)))
+	err := storagePut(storage, C.GoString(key), []byte(C.GoString(value)))
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
 			"handler": uint64(uintptr(handler)),
@@ -106,12 +172,15 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char) int {
 // StorageDelFunc export StorageDelFunc
 //export StorageDelFunc
 func StorageDelFunc(handler unsafe.Pointer, key *C.char) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := enterHostFunc(handler, "storage_del")
 	if storage == nil {
 		return 1
 	}
+	defer engine.exitHostFunc("storage_del")
+	engine.traceStorage(TraceOpStorageDel, C.GoString(key), "", 0)
+	engine.ctx.recordStateDiff(storage, C.GoString(key), nil)
 
-	err := storage.Del([]byte(hashStorageKey(C.GoString(key))))
+	err := storageDel(storage, C.GoString(key))
 
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
@@ -124,3 +193,28 @@ func StorageDelFunc(handler unsafe.Pointer, key *C.char) int {
 
 	return 0
 }
+
+// StorageKeysFunc export StorageKeysFunc
+//export StorageKeysFunc
+func StorageKeysFunc(handler unsafe.Pointer, field *C.char) *C.char {
+	engine, storage := enterHostFunc(handler, "storage_keys")
+	if storage == nil {
+		return nil
+	}
+	defer engine.exitHostFunc("storage_keys")
+
+	keys, err := storageKeys(storage, C.GoString(field))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"field":   C.GoString(field),
+			"err":     err,
+		}).Error("StorageKeysFunc iterate keys failed.")
+		return nil
+	}
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return nil
+	}
+	return C.CString(string(data))
+}
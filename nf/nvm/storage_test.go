@@ -0,0 +1,60 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageKeys(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+
+	keys, err := storageKeys(contract, "balances")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, keys)
+
+	assert.Nil(t, storagePut(contract, "@balances[alice]", []byte("10")))
+	assert.Nil(t, storagePut(contract, "@balances[bob]", []byte("20")))
+	assert.Nil(t, storagePut(contract, "@balances[carol]", []byte("30")))
+	assert.Nil(t, storagePut(contract, "@names[alice]", []byte("\"Alice\"")))
+	assert.Nil(t, storagePut(contract, "totalSupply", []byte("60")))
+	assert.Nil(t, storageDel(contract, "@balances[carol]"))
+
+	keys, err = storageKeys(contract, "balances")
+	assert.Nil(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"alice", "bob"}, keys)
+
+	keys, err = storageKeys(contract, "names")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"alice"}, keys)
+
+	// the values are still stored at the hashed keys.
+	val, err := contract.Get(hashStorageKey("@balances[bob]"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("20"), val)
+	assert.Equal(t, ErrKeyNotFound, storageDel(contract, "@balances[carol]"))
+}
@@ -38,6 +38,9 @@ class DepositeContent {
 }
 
 class BankVaultContract {
+    // defined by LocalContractStorage in constructor.
+    bankVault: any;
+
     constructor() {
         LocalContractStorage.defineMapProperty(this, "bankVault", {
             parse(text: string): DepositeContent {
@@ -0,0 +1,48 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+var DateContract = function () {};
+
+DateContract.prototype = {
+    init: function () {},
+    now: function () {
+        return [Date.now(), new Date().getTime()];
+    },
+    local: function () {
+        var d = new Date(2018, 2, 1, 10, 30);
+        return [d.getTime(), d.getHours(), d.getTimezoneOffset(), d.toString(), d.toLocaleDateString()];
+    },
+    parse: function () {
+        return [Date.parse("2018-03-01"), Date.parse("2018-03-01T10:30:00"), Date.parse("2018-03-01T10:30:00+08:00"), Date.parse("March 1, 2018")];
+    },
+    random: function () {
+        return Math.random();
+    },
+    escape: function () {
+        var Native = Object.getPrototypeOf(Date.prototype).constructor;
+        var d = new Date(2018, 2, 1, 10, 30);
+        return [Native === Object, typeof Native.now, d.constructor.now(), Object.getPrototypeOf(d).getHours.call(d), "" + d];
+    },
+    builtins: function () {
+        return [typeof Math.random(), Date.parse("March 1, 2018") > 0];
+    }
+};
+
+module.exports = DateContract;
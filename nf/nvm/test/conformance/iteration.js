@@ -0,0 +1,63 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+var IterationContract = function () {};
+
+IterationContract.prototype = {
+    init: function () {},
+    keys: function () {
+        var o = {b: 1, 2: 1, a: 1, 1: 1, "-1": 1, "01": 1};
+        o.c = 1;
+        delete o.b;
+        o.b = 1;
+        var keys = [];
+        for (var k in o) {
+            keys.push(k);
+        }
+        return [keys, Object.keys(o), JSON.stringify(o)];
+    },
+    collections: function () {
+        var m = new Map([["z", 1], ["a", 2]]);
+        m.set(3, 3);
+        m.delete("z");
+        m.set("z", 4);
+        var s = new Set([5, 1, 3]);
+        s.add(1);
+        return [Array.from(m.keys()), Array.from(s)];
+    },
+    sort: function () {
+        var items = [];
+        for (var i = 0; i < 20; i++) {
+            items.push({key: i % 3, index: i});
+        }
+        // Array.prototype.sort isn't stable in the bundled V8, break the ties explicitly.
+        items.sort(function (a, b) {
+            return a.key - b.key || a.index - b.index;
+        });
+        return items.map(function (item) {
+            return item.index;
+        });
+    },
+    math: function () {
+        return [Math.sin(1e10), Math.exp(0.5), Math.sqrt(2), 0.1 + 0.2, (123.456).toFixed(1), (1e21).toString()];
+    }
+};
+
+module.exports = IterationContract;
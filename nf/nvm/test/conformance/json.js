@@ -0,0 +1,43 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+var JSONContract = function () {};
+
+JSONContract.prototype = {
+    init: function () {},
+    keys: function () {
+        var a = {b: 1, a: {d: 2, c: 3}, 10: 4, 9: 5, "-1": 6};
+        var b = {"-1": 6, 9: 5, 10: 4, a: {c: 3, d: 2}, b: 1};
+        return [JSON.stringify(a), JSON.stringify(a) === JSON.stringify(b)];
+    },
+    values: function () {
+        return JSON.stringify([undefined, function () {}, NaN, -0, 1e21, 1e-7, 0.1 + 0.2, new BigNumber("1e30"), new Date(0), {u: undefined}]);
+    },
+    format: function () {
+        return [JSON.stringify({b: [1], a: {}}, null, 2), JSON.stringify({b: 1, a: 2, c: 3}, ["c", "a"])];
+    },
+    circular: function () {
+        var o = {};
+        o.self = o;
+        return JSON.stringify(o);
+    }
+};
+
+module.exports = JSONContract;
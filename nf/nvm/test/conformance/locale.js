@@ -0,0 +1,41 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+var LocaleContract = function () {};
+
+LocaleContract.prototype = {
+    init: function () {},
+    compare: function () {
+        return ["a".localeCompare("B"), "B".localeCompare("a"), "ä".localeCompare("z"), "a".localeCompare("a")];
+    },
+    sort: function () {
+        return ["zebra", "Äpfel", "apple", "Zoo"].sort(function (a, b) {
+            return a.localeCompare(b);
+        });
+    },
+    convert: function () {
+        return ["ıi".toLocaleUpperCase(), "İI".toLocaleLowerCase(), (1234567.891).toLocaleString(), [1e6, 0.5].toLocaleString()];
+    },
+    intl: function () {
+        return typeof Intl;
+    }
+};
+
+module.exports = LocaleContract;
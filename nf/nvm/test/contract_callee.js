@@ -0,0 +1,25 @@
+'use strict';
+
+var CalleeContract = function () {
+    LocalContractStorage.defineProperties(this, {
+        count: null
+    });
+};
+
+CalleeContract.prototype = {
+    init: function () {
+        this.count = 0;
+    },
+    incr: function (n) {
+        this.count = (this.count || 0) + n;
+        return {
+            count: this.count,
+            sender: Blockchain.transaction.from
+        };
+    },
+    back: function (address) {
+        return Blockchain.runContractSource(address, "incr", [1]);
+    }
+};
+
+module.exports = CalleeContract;
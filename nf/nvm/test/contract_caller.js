@@ -0,0 +1,42 @@
+'use strict';
+
+var CallerContract = function () {
+};
+
+CallerContract.prototype = {
+    init: function () {
+    },
+    incr: function (n) {
+        return n;
+    },
+    call: function (address, n) {
+        var ret = Blockchain.runContractSource(address, "incr", [n]);
+        if (ret.count !== n) {
+            throw new Error("unexpected count " + ret.count);
+        }
+        if (ret.sender !== Blockchain.transaction.to) {
+            throw new Error("unexpected sender " + ret.sender);
+        }
+    },
+    reenter: function (address) {
+        try {
+            Blockchain.runContractSource(address, "back", [Blockchain.transaction.to]);
+        } catch (e) {
+            // the failed call still fails the transaction.
+        }
+    },
+    clone: function (codeHash) {
+        var address = Blockchain.deployContract({codeHash: codeHash});
+        var ret = Blockchain.runContractSource(address, "incr", [2]);
+        if (ret.count !== 2) {
+            throw new Error("unexpected count " + ret.count);
+        }
+        return address;
+    },
+    guarded: function (address) {
+        Blockchain.nonReentrant();
+        return Blockchain.runContractSource(address, "incr", [1]);
+    }
+};
+
+module.exports = CallerContract;
@@ -33,3 +33,15 @@ console.log("transfer:" + ok)
 
 var result = Blockchain.verifyAddress("70e30fcae5e7f4b2460faaa9e5b1bd912332ebb5");
 console.log("verifyAddress:" + result)
+
+var random = Blockchain.random("lottery");
+console.log("random:" + random)
+if (random.length !== 64 || random === Blockchain.random("lottery")) {
+    throw new Error("random should be a different hash each time");
+}
+
+var parentHash = Blockchain.getBlockHash(1);
+console.log("getBlockHash:" + parentHash)
+if (parentHash === null || Blockchain.getBlockHash(2) !== null) {
+    throw new Error("only the hashes of recent blocks are visible");
+}
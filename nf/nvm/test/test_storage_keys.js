@@ -0,0 +1,50 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+var Bank = function () {
+    LocalContractStorage.defineMapProperty(this, "balances");
+    LocalContractStorage.defineMapProperty(this, "names");
+};
+
+var bank = new Bank();
+bank.balances.set("alice", 10);
+bank.balances.set("bob", 20);
+bank.balances.set("alex", 30);
+bank.balances.set("carol", 40);
+bank.balances.del("carol");
+bank.names.set("alice", "Alice");
+
+var keys = bank.balances.keys().sort();
+if (JSON.stringify(keys) !== JSON.stringify(["alex", "alice", "bob"])) {
+    throw new Error("keys of balances should be alex, alice and bob, actual is " + keys);
+}
+
+keys = bank.balances.keys("al").sort();
+if (JSON.stringify(keys) !== JSON.stringify(["alex", "alice"])) {
+    throw new Error("keys of balances with prefix al should be alex and alice, actual is " + keys);
+}
+
+keys = LocalContractStorage.keys("names");
+if (JSON.stringify(keys) !== JSON.stringify(["alice"])) {
+    throw new Error("keys of names should be alice, actual is " + keys);
+}
+
+if (LocalContractStorage.keys("empty").length !== 0) {
+    throw new Error("keys of an empty map should be empty.");
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+
+import (
+	"sort"
+	"sync"
+	"unsafe"
+)
+
+// Operations of the trace steps
+const (
+	TraceOpLine       = "line"
+	TraceOpStorageGet = "storage_get"
+	TraceOpStoragePut = "storage_put"
+	TraceOpStorageDel = "storage_del"
+)
+
+// TraceStep is a step of a traced execution, the instructions counted on a source line or a storage access.
+type TraceStep struct {
+	Contract string `json:"contract"`
+	Op       string `json:"op"`
+	// line of the contract source and the function on it, only for the line steps.
+	Line     int    `json:"line,omitempty"`
+	Function string `json:"function,omitempty"`
+	Gas      uint64 `json:"gas"`
+	Key      string `json:"key,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+// Tracer records the steps of an execution and the nested calls in it, in the order they run.
+type Tracer struct {
+	steps []*TraceStep
+	mu    sync.Mutex
+}
+
+// NewTracer returns a new tracer.
+func NewTracer() *Tracer {
+	return &Tracer{steps: []*TraceStep{}}
+}
+
+// Steps returns the steps recorded.
+func (t *Tracer) Steps() []*TraceStep {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.steps
+}
+
+func (t *Tracer) record(step *TraceStep) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.steps = append(t.steps, step)
+}
+
+// GasProfileEntry is the gas counted by the instructions or by a kind of storage access in a function of a contract.
+type GasProfileEntry struct {
+	Contract string `json:"contract"`
+	// name of the function, empty for the code out of any function.
+	Function string `json:"function"`
+	Op       string `json:"op"`
+	Gas      uint64 `json:"gas"`
+	// count of the steps, e.g. how many times the storage is written.
+	Count uint64 `json:"count"`
+}
+
+// Profile attributes the gas of the steps to the functions of the contracts, the most expensive first.
+// The storage accesses are attributed to the function of the contract's last line step.
+func (t *Tracer) Profile() []*GasProfileEntry {
+	profile := []*GasProfileEntry{}
+	entries := make(map[GasProfileEntry]*GasProfileEntry)
+	functions := make(map[string]string)
+	for _, step := range t.Steps() {
+		if step.Op == TraceOpLine {
+			functions[step.Contract] = step.Function
+		}
+		key := GasProfileEntry{Contract: step.Contract, Function: functions[step.Contract], Op: step.Op}
+		entry, ok := entries[key]
+		if !ok {
+			entry = &GasProfileEntry{Contract: key.Contract, Function: key.Function, Op: key.Op}
+			entries[key] = entry
+			profile = append(profile, entry)
+		}
+		entry.Gas += step.Gas
+		entry.Count++
+	}
+	sort.SliceStable(profile, func(i, j int) bool {
+		return profile[i].Gas > profile[j].Gas
+	})
+	return profile
+}
+
+// traceStorage records the storage access of the contract if the execution is traced.
+func (e *V8Engine) traceStorage(op, key, value string, gas uint64) {
+	if e.ctx.tracer == nil {
+		return
+	}
+	e.ctx.tracer.record(&TraceStep{
+		Contract: e.ctx.contract.Address().String(),
+		Op:       op,
+		Gas:      gas,
+		Key:      key,
+		Value:    value,
+	})
+}
+
+// TraceStepFunc export TraceStepFunc
+//export TraceStepFunc
+func TraceStepFunc(handler unsafe.Pointer, line C.int, function *C.char, gas C.size_t) {
+	engine := getEngineByEngineHandler(handler)
+	if engine == nil || engine.ctx.tracer == nil {
+		return
+	}
+	engine.ctx.tracer.record(&TraceStep{
+		Contract: engine.ctx.contract.Address().String(),
+		Op:       TraceOpLine,
+		Line:     int(line),
+		Function: C.GoString(function),
+		Gas:      uint64(gas),
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracer(t *testing.T) {
+	source := `var Counter = function () {
+    LocalContractStorage.defineProperty(this, "count");
+};
+Counter.prototype = {
+    init: function () {},
+    incr: function (n) {
+        var count = this.count || 0;
+        this.count = count + n;
+    }
+};
+module.exports = Counter;
+`
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+	tracer := NewTracer()
+	ctx.Trace(tracer)
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 100000000)
+	assert.Nil(t, engine.Call(source, "js", "incr", "[2]"))
+	engine.Dispose()
+
+	lines := make(map[int]bool)
+	var ops []string
+	for _, step := range tracer.Steps() {
+		assert.Equal(t, contract.Address().String(), step.Contract)
+		if step.Op == TraceOpLine {
+			lines[step.Line] = true
+			assert.True(t, step.Gas > 0)
+			if step.Line == 7 || step.Line == 8 {
+				assert.Equal(t, "incr", step.Function)
+			}
+		} else {
+			ops = append(ops, step.Op)
+		}
+	}
+	assert.True(t, lines[7])
+	assert.True(t, lines[8])
+	assert.Equal(t, []string{TraceOpStorageGet, TraceOpStoragePut}, ops)
+
+	// untraced executions record nothing.
+	steps := len(tracer.Steps())
+	ctx.Trace(nil)
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 100000000)
+	assert.Nil(t, engine.Call(source, "js", "incr", "[2]"))
+	engine.Dispose()
+	assert.Equal(t, steps, len(tracer.Steps()))
+}
+
+func TestTracerProfile(t *testing.T) {
+	tracer := NewTracer()
+	tracer.record(&TraceStep{Contract: "a", Op: TraceOpLine, Line: 1, Gas: 10})
+	tracer.record(&TraceStep{Contract: "a", Op: TraceOpLine, Line: 3, Function: "save", Gas: 5})
+	tracer.record(&TraceStep{Contract: "a", Op: TraceOpStoragePut, Gas: 100})
+	tracer.record(&TraceStep{Contract: "b", Op: TraceOpLine, Line: 2, Function: "save", Gas: 7})
+	tracer.record(&TraceStep{Contract: "a", Op: TraceOpStoragePut, Gas: 100})
+	tracer.record(&TraceStep{Contract: "a", Op: TraceOpLine, Line: 4, Function: "save", Gas: 5})
+
+	assert.Equal(t, []*GasProfileEntry{
+		{Contract: "a", Function: "save", Op: TraceOpStoragePut, Gas: 200, Count: 2},
+		{Contract: "a", Function: "", Op: TraceOpLine, Gas: 10, Count: 1},
+		{Contract: "a", Function: "save", Op: TraceOpLine, Gas: 10, Count: 2},
+		{Contract: "b", Function: "save", Op: TraceOpLine, Gas: 7, Count: 1},
+	}, tracer.Profile())
+	assert.Equal(t, []*GasProfileEntry{}, NewTracer().Profile())
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MaxTypeCheckSourceSize is the max bytes of the TypeScript source type checked on deployment.
+// The checker runs outside the instruction counter, so its work is bounded by the size of the source.
+const MaxTypeCheckSourceSize = 32 * 1024
+
+var (
+	typeCheckHeight   uint64
+	typeCheckHeightMu sync.RWMutex
+)
+
+// TypeCheckAt returns whether the TypeScript contracts deployed in the block at height are type checked,
+// they are only transpiled before it or if no height is scheduled.
+func TypeCheckAt(height uint64) bool {
+	typeCheckHeightMu.RLock()
+	defer typeCheckHeightMu.RUnlock()
+
+	return typeCheckHeight > 0 && height >= typeCheckHeight
+}
+
+// SetTypeCheckHeight installs the height of the TypeScript type check scheduled in the genesis conf.
+func SetTypeCheckHeight(height uint64) {
+	typeCheckHeightMu.Lock()
+	defer typeCheckHeightMu.Unlock()
+	typeCheckHeight = height
+
+	if height > 0 {
+		logging.CLog().WithFields(logrus.Fields{
+			"height": height,
+		}).Info("TypeScript type check scheduled.")
+	}
+}
@@ -66,3 +66,7 @@ size_t ArrayBufferAllocator::total_available_size() {
 size_t ArrayBufferAllocator::peak_allocated_size() {
   return this->peak_allocated_size_;
 }
+
+void ArrayBufferAllocator::reset_peak_allocated_size() {
+  this->peak_allocated_size_ = this->total_allocated_size_;
+}
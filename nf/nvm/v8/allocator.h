@@ -51,6 +51,8 @@ public:
 
   size_t peak_allocated_size();
 
+  void reset_peak_allocated_size();
+
 private:
   size_t total_allocated_size_;
   size_t peak_allocated_size_;
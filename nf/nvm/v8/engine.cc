@@ -32,6 +32,7 @@
 #include <v8.h>
 
 #include <assert.h>
+#include <string.h>
 
 using namespace v8;
 
@@ -40,6 +41,10 @@ static Platform *platformPtr = NULL;
 void PrintException(Local<Context> context, TryCatch &trycatch);
 void EngineLimitsCheckDelegate(Isolate *isolate, size_t count,
                                void *listenerContext);
+void EngineTraceDelegate(Isolate *isolate, int line, const char *function,
+                         size_t gas, void *listenerContext);
+
+static TraceStepFunc sTraceStep = NULL;
 
 #define STRINGIZE2(s) #s
 #define STRINGIZE(s) STRINGIZE2(s)
@@ -70,8 +75,11 @@ void Initialize() {
 
   // Initialize V8Engine.
   SetInstructionCounterIncrListener(EngineLimitsCheckDelegate);
+  SetInstructionCounterTraceListener(EngineTraceDelegate);
 }
 
+void InitializeTracer(TraceStepFunc step) { sTraceStep = step; }
+
 void Dispose() {
   V8::Dispose();
   V8::ShutdownPlatform();
@@ -98,6 +106,23 @@ V8Engine *CreateEngine() {
   return e;
 }
 
+// reset the engine for the next execution, collecting the garbage of the
+// previous one so its memory is not counted again.
+void ResetEngine(V8Engine *e) {
+  Isolate *isolate = static_cast<Isolate *>(e->isolate);
+  isolate->CancelTerminateExecution();
+  isolate->LowMemoryNotification();
+
+  static_cast<ArrayBufferAllocator *>(e->allocator)
+      ->reset_peak_allocated_size();
+
+  e->limits_of_executed_instructions = 0;
+  e->limits_of_total_memory_size = 0;
+  e->is_requested_terminate_execution = 0;
+  e->testing = 0;
+  memset(&(e->stats), 0, sizeof(V8EngineStats));
+}
+
 void DeleteEngine(V8Engine *e) {
   Isolate *isolate = static_cast<Isolate *>(e->isolate);
   isolate->Dispose();
@@ -133,14 +158,26 @@ int ExecuteSourceDataDelegate(Isolate *isolate, const char *source,
     return 1;
   }
 
+  // the contract runner saves the JSON of the returned value in __result.
+  if (delegateContext != NULL) {
+    char **result = static_cast<char **>(delegateContext);
+    Local<Value> val =
+        context->Global()->Get(String::NewFromUtf8(isolate, "__result"));
+    if (val->IsString()) {
+      *result = strdup(*String::Utf8Value(val));
+    }
+  }
+
   return 0;
 }
 
 char *InjectTracingInstructions(V8Engine *e, const char *source,
+                                const char *gas_table,
                                 int *source_line_offset) {
   TracingContext tContext;
   tContext.source_line_offset = 0;
   tContext.tracable_source = NULL;
+  tContext.gas_table = gas_table;
 
   Execute(e, source, 0, 0L, 0L, InjectTracingInstructionDelegate,
           (void *)&tContext);
@@ -150,8 +187,9 @@ char *InjectTracingInstructions(V8Engine *e, const char *source,
 }
 
 char *TranspileTypeScriptModule(V8Engine *e, const char *source,
-                                int *source_line_offset) {
+                                int type_check, int *source_line_offset) {
   TypeScriptContext tContext;
+  tContext.type_check = type_check;
   tContext.source_line_offset = 0;
   tContext.js_source = NULL;
 
@@ -167,6 +205,13 @@ int RunScriptSource(V8Engine *e, const char *source, int source_line_offset,
                  (void *)gcsHandler, ExecuteSourceDataDelegate, NULL);
 }
 
+int RunScriptSourceWithResult(V8Engine *e, const char *source,
+                              int source_line_offset, uintptr_t lcsHandler,
+                              uintptr_t gcsHandler, char **result) {
+  return Execute(e, source, source_line_offset, (void *)lcsHandler,
+                 (void *)gcsHandler, ExecuteSourceDataDelegate, result);
+}
+
 int Execute(V8Engine *e, const char *source, int source_line_offset,
             void *lcsHandler, void *gcsHandler, ExecutionDelegate delegate,
             void *delegateContext) {
@@ -305,6 +350,13 @@ void EngineLimitsCheckDelegate(Isolate *isolate, size_t count,
   }
 }
 
+void EngineTraceDelegate(Isolate *isolate, int line, const char *function,
+                         size_t gas, void *listenerContext) {
+  if (sTraceStep != NULL) {
+    sTraceStep(listenerContext, line, function, gas);
+  }
+}
+
 int IsEngineLimitsExceeded(V8Engine *e) {
   // TODO: read memory stats everytime may impact the performance.
   ReadMemoryStatistics(e);
@@ -42,32 +42,62 @@ enum LogLevel {
 
 // log
 typedef void (*LogFunc)(int level, const char *msg);
+typedef void (*ConsoleLogFunc)(void *handler, int level, const char *msg);
 EXPORT const char *GetLogLevelText(int level);
-EXPORT void InitializeLogger(LogFunc f);
+EXPORT void InitializeLogger(LogFunc f, ConsoleLogFunc console);
 
 // event.
 typedef void (*EventTriggerFunc)(void *handler, const char *topic,
                                  const char *data);
-EXPORT void InitializeEvent(EventTriggerFunc trigger);
+typedef int (*EventEmitFunc)(void *handler, const char *name,
+                             const char *indexed, const char *data);
+EXPORT void InitializeEvent(EventTriggerFunc trigger, EventEmitFunc emit);
 
 // storage
 typedef char *(*StorageGetFunc)(void *handler, const char *key);
 typedef int (*StoragePutFunc)(void *handler, const char *key,
                               const char *value);
 typedef int (*StorageDelFunc)(void *handler, const char *key);
+typedef char *(*StorageKeysFunc)(void *handler, const char *field);
 EXPORT void InitializeStorage(StorageGetFunc get, StoragePutFunc put,
-                              StorageDelFunc del);
+                              StorageDelFunc del, StorageKeysFunc keys);
 
 // blockchain
 typedef char *(*GetTxByHashFunc)(void *handler, const char *hash);
 typedef char *(*GetAccountStateFunc)(void *handler, const char *address);
 typedef int (*TransferFunc)(void *handler, const char *to, const char *value);
 typedef int (*VerifyAddressFunc)(void *handler, const char *address);
+typedef char *(*RunContractSourceFunc)(void *handler, const char *address,
+                                       const char *funcName, const char *args);
+typedef char *(*RandomFunc)(void *handler, const char *seed);
+typedef char *(*GetBlockHashFunc)(void *handler, unsigned long long height);
+typedef int (*SelfDestructFunc)(void *handler, const char *beneficiary);
+typedef char *(*RunPrecompileFunc)(void *handler, const char *name,
+                                   const char *input);
+typedef char *(*RequestOracleFunc)(void *handler, const char *query,
+                                   const char *callback);
+typedef int (*NonReentrantFunc)(void *handler);
+typedef char *(*DeployContractFunc)(void *handler, const char *source,
+                                    const char *sourceType,
+                                    const char *codeHash, const char *args);
 
 EXPORT void InitializeBlockchain(GetTxByHashFunc getTx,
                                  GetAccountStateFunc getAccount,
                                  TransferFunc transfer,
-                                 VerifyAddressFunc verifyAddress);
+                                 VerifyAddressFunc verifyAddress,
+                                 RunContractSourceFunc runContract,
+                                 RandomFunc random,
+                                 GetBlockHashFunc getBlockHash,
+                                 SelfDestructFunc selfDestruct,
+                                 RunPrecompileFunc runPrecompile,
+                                 RequestOracleFunc requestOracle,
+                                 NonReentrantFunc nonReentrant,
+                                 DeployContractFunc deployContract);
+
+// tracing
+typedef void (*TraceStepFunc)(void *engine, int line, const char *function,
+                              size_t gas);
+EXPORT void InitializeTracer(TraceStepFunc step);
 
 // version
 EXPORT char *GetV8Version();
@@ -111,10 +141,17 @@ EXPORT int RunScriptSource(V8Engine *e, const char *source,
                            int source_line_offset, uintptr_t lcsHandler,
                            uintptr_t gcsHandler);
 
+EXPORT int RunScriptSourceWithResult(V8Engine *e, const char *source,
+                                     int source_line_offset,
+                                     uintptr_t lcsHandler, uintptr_t gcsHandler,
+                                     char **result);
+
 EXPORT char *InjectTracingInstructions(V8Engine *e, const char *source,
+                                       const char *gas_table,
                                        int *source_line_offset);
 
 EXPORT char *TranspileTypeScriptModule(V8Engine *e, const char *source,
+                                       int type_check,
                                        int *source_line_offset);
 
 EXPORT int IsEngineLimitsExceeded(V8Engine *e);
@@ -123,6 +160,8 @@ EXPORT void ReadMemoryStatistics(V8Engine *e);
 
 EXPORT void TerminateExecution(V8Engine *e);
 
+EXPORT void ResetEngine(V8Engine *e);
+
 EXPORT void DeleteEngine(V8Engine *e);
 
 #ifdef __cplusplus
@@ -0,0 +1,92 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+
+'use strict';
+
+var PublicFuncName = /^[a-zA-Z$][A-Za-z0-9_$]*$/;
+var ArgsList = /^[^(]*\(([^)]*)\)/;
+var Comments = /\/\*[\s\S]*?\*\/|\/\/.*$/mg;
+
+// parse the names of the arguments from the source of the function.
+var argNames = function (fn) {
+    var m = ArgsList.exec(Function.prototype.toString.call(fn).replace(Comments, ""));
+    if (!m) {
+        return [];
+    }
+    var names = [];
+    m[1].split(",").forEach(function (arg) {
+        var name = arg.split("=")[0].trim();
+        if (name.length > 0) {
+            names.push(name);
+        }
+    });
+    return names;
+};
+
+// whether the function can be called from outside, the contract may only export the
+// functions listed in the optional static property "exported", e.g.
+//   Contract.exported = ["save", "takeout"];
+var exported = function (contract, name) {
+    var names = contract.exported;
+    return !Array.isArray(names) || names.indexOf(name) >= 0;
+};
+
+// describe the public functions of the contract, the types of the arguments and
+// the payable flags are declared in the optional static property "abi", e.g.
+//   Contract.abi = {save: {args: ["number"], payable: true}};
+exports["describe"] = function (contract) {
+    var annotations = contract.abi || {};
+    var names = [];
+    for (var proto = contract.prototype; proto && proto !== Object.prototype; proto = Object.getPrototypeOf(proto)) {
+        Object.getOwnPropertyNames(proto).forEach(function (name) {
+            if (name === "constructor" || name === "init" || !PublicFuncName.test(name) ||
+                !exported(contract, name) || names.indexOf(name) >= 0 || typeof proto[name] !== "function") {
+                return;
+            }
+            names.push(name);
+        });
+    }
+    names.sort();
+
+    var functions = names.map(function (name) {
+        var annotation = annotations[name] || {};
+        var types = annotation.args || [];
+        var args = argNames(contract.prototype[name]).map(function (arg, i) {
+            return {name: arg, type: typeof types[i] === "string" ? types[i] : "any"};
+        });
+        return {name: name, args: args, payable: annotation.payable === true};
+    });
+    return {functions: functions};
+};
+
+// guard the call of the function from outside: it must be exported, and init can't
+// run again once the contract is deployed. The private functions are only called back
+// by the chain, e.g. with the answers of the oracle.
+exports["guard"] = function (contract, instance, name) {
+    if (name.charAt(0) !== "_" && !exported(contract, name)) {
+        throw new Error("function " + name + " is not exported.");
+    }
+    if (Object.isExtensible(instance)) {
+        Object.defineProperty(instance, "init", {
+            value: function () {
+                throw new Error("init can only run at deploy.");
+            }
+        });
+    }
+};
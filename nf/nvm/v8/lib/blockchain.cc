@@ -24,14 +24,37 @@ static GetTxByHashFunc sGetTxByHash = NULL;
 static GetAccountStateFunc sGetAccountState = NULL;
 static TransferFunc sTransfer = NULL;
 static VerifyAddressFunc sVerifyAddress = NULL;
+static RunContractSourceFunc sRunContractSource = NULL;
+static RandomFunc sRandom = NULL;
+static GetBlockHashFunc sGetBlockHash = NULL;
+static SelfDestructFunc sSelfDestruct = NULL;
+static RunPrecompileFunc sRunPrecompile = NULL;
+static RequestOracleFunc sRequestOracle = NULL;
+static NonReentrantFunc sNonReentrant = NULL;
+static DeployContractFunc sDeployContract = NULL;
 
 void InitializeBlockchain(GetTxByHashFunc getTx,
                           GetAccountStateFunc getAccount,
-                          TransferFunc transfer, VerifyAddressFunc verifyAddress) {
+                          TransferFunc transfer, VerifyAddressFunc verifyAddress,
+                          RunContractSourceFunc runContract, RandomFunc random,
+                          GetBlockHashFunc getBlockHash,
+                          SelfDestructFunc selfDestruct,
+                          RunPrecompileFunc runPrecompile,
+                          RequestOracleFunc requestOracle,
+                          NonReentrantFunc nonReentrant,
+                          DeployContractFunc deployContract) {
   sGetTxByHash = getTx;
   sGetAccountState = getAccount;
   sTransfer = transfer;
   sVerifyAddress = verifyAddress;
+  sRunContractSource = runContract;
+  sRandom = random;
+  sGetBlockHash = getBlockHash;
+  sSelfDestruct = selfDestruct;
+  sRunPrecompile = runPrecompile;
+  sRequestOracle = requestOracle;
+  sNonReentrant = nonReentrant;
+  sDeployContract = deployContract;
 }
 
 void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
@@ -59,6 +82,46 @@ void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                PropertyAttribute::ReadOnly));
 
+  blockTpl->Set(String::NewFromUtf8(isolate, "runContractSource"),
+                FunctionTemplate::New(isolate, RunContractSourceCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
+  blockTpl->Set(String::NewFromUtf8(isolate, "random"),
+                FunctionTemplate::New(isolate, RandomCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
+  blockTpl->Set(String::NewFromUtf8(isolate, "getBlockHash"),
+                FunctionTemplate::New(isolate, GetBlockHashCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
+  blockTpl->Set(String::NewFromUtf8(isolate, "selfDestruct"),
+                FunctionTemplate::New(isolate, SelfDestructCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
+  blockTpl->Set(String::NewFromUtf8(isolate, "runPrecompile"),
+                FunctionTemplate::New(isolate, RunPrecompileCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
+  blockTpl->Set(String::NewFromUtf8(isolate, "requestOracle"),
+                FunctionTemplate::New(isolate, RequestOracleCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
+  blockTpl->Set(String::NewFromUtf8(isolate, "nonReentrant"),
+                FunctionTemplate::New(isolate, NonReentrantCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
+  blockTpl->Set(String::NewFromUtf8(isolate, "deployContract"),
+                FunctionTemplate::New(isolate, DeployContractCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
   Local<Object> instance = blockTpl->NewInstance(context).ToLocalChecked();
   instance->SetInternalField(0, External::New(isolate, handler));
 
@@ -177,3 +240,247 @@ void VerifyAddressCallback(const FunctionCallbackInfo<Value> &info) {
   int ret = sVerifyAddress(handler->Value(), *String::Utf8Value(address->ToString()));
   info.GetReturnValue().Set(ret);
 }
+
+// RunContractSourceCallback
+void RunContractSourceCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 3) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.runContractSource() requires 3 arguments"));
+    return;
+  }
+
+  Local<Value> address = info[0];
+  if (!address->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "address must be string"));
+    return;
+  }
+
+  Local<Value> funcName = info[1];
+  if (!funcName->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "funcName must be string"));
+    return;
+  }
+
+  Local<Value> args = info[2];
+  if (!args->IsString()) {
+    isolate->ThrowException(String::NewFromUtf8(isolate, "args must be string"));
+    return;
+  }
+
+  char *value = sRunContractSource(
+      handler->Value(), *String::Utf8Value(address->ToString()),
+      *String::Utf8Value(funcName->ToString()),
+      *String::Utf8Value(args->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
+
+// RandomCallback
+void RandomCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 1) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.random() requires 1 argument"));
+    return;
+  }
+
+  Local<Value> seed = info[0];
+  if (!seed->IsString()) {
+    isolate->ThrowException(String::NewFromUtf8(isolate, "seed must be string"));
+    return;
+  }
+
+  char *value = sRandom(handler->Value(), *String::Utf8Value(seed->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
+
+// GetBlockHashCallback
+void GetBlockHashCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 1) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.getBlockHash() requires 1 argument"));
+    return;
+  }
+
+  Local<Value> height = info[0];
+  if (!height->IsNumber() || height->NumberValue() < 0) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "height must be non-negative number"));
+    return;
+  }
+
+  char *value = sGetBlockHash(handler->Value(),
+                              (unsigned long long)height->IntegerValue());
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
+
+// SelfDestructCallback
+void SelfDestructCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 1) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.selfDestruct() requires 1 argument"));
+    return;
+  }
+
+  Local<Value> beneficiary = info[0];
+  if (!beneficiary->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "beneficiary must be string"));
+    return;
+  }
+
+  int ret = sSelfDestruct(handler->Value(),
+                          *String::Utf8Value(beneficiary->ToString()));
+  info.GetReturnValue().Set(ret);
+}
+
+// RunPrecompileCallback
+void RunPrecompileCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 2) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.runPrecompile() requires 2 arguments"));
+    return;
+  }
+
+  Local<Value> name = info[0];
+  if (!name->IsString()) {
+    isolate->ThrowException(String::NewFromUtf8(isolate, "name must be string"));
+    return;
+  }
+
+  Local<Value> input = info[1];
+  if (!input->IsString()) {
+    isolate->ThrowException(String::NewFromUtf8(isolate, "input must be string"));
+    return;
+  }
+
+  char *value = sRunPrecompile(handler->Value(),
+                               *String::Utf8Value(name->ToString()),
+                               *String::Utf8Value(input->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
+
+// RequestOracleCallback
+void RequestOracleCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 2) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.requestOracle() requires 2 arguments"));
+    return;
+  }
+
+  Local<Value> query = info[0];
+  if (!query->IsString()) {
+    isolate->ThrowException(String::NewFromUtf8(isolate, "query must be string"));
+    return;
+  }
+
+  Local<Value> callback = info[1];
+  if (!callback->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "callback must be string"));
+    return;
+  }
+
+  char *value = sRequestOracle(handler->Value(),
+                               *String::Utf8Value(query->ToString()),
+                               *String::Utf8Value(callback->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
+
+// NonReentrantCallback
+void NonReentrantCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 0) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.nonReentrant() requires no argument"));
+    return;
+  }
+
+  int ret = sNonReentrant(handler->Value());
+  info.GetReturnValue().Set(ret);
+}
+
+// DeployContractCallback
+void DeployContractCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 4) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.deployContract() requires 4 arguments"));
+    return;
+  }
+
+  for (int i = 0; i < 4; i++) {
+    if (!info[i]->IsString()) {
+      isolate->ThrowException(
+          String::NewFromUtf8(isolate, "arguments must be string"));
+      return;
+    }
+  }
+
+  char *value = sDeployContract(handler->Value(),
+                                *String::Utf8Value(info[0]->ToString()),
+                                *String::Utf8Value(info[1]->ToString()),
+                                *String::Utf8Value(info[2]->ToString()),
+                                *String::Utf8Value(info[3]->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
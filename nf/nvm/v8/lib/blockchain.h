@@ -32,5 +32,13 @@ void GetTransactionByHashCallback(const FunctionCallbackInfo<Value> &info);
 void GetAccountStateCallback(const FunctionCallbackInfo<Value> &info);
 void TransferCallback(const FunctionCallbackInfo<Value> &info);
 void VerifyAddressCallback(const FunctionCallbackInfo<Value> &info);
+void RunContractSourceCallback(const FunctionCallbackInfo<Value> &info);
+void RandomCallback(const FunctionCallbackInfo<Value> &info);
+void GetBlockHashCallback(const FunctionCallbackInfo<Value> &info);
+void SelfDestructCallback(const FunctionCallbackInfo<Value> &info);
+void RunPrecompileCallback(const FunctionCallbackInfo<Value> &info);
+void RequestOracleCallback(const FunctionCallbackInfo<Value> &info);
+void NonReentrantCallback(const FunctionCallbackInfo<Value> &info);
+void DeployContractCallback(const FunctionCallbackInfo<Value> &info);
 
 #endif //_NEBULAS_NF_NVM_V8_LIB_BLOCKCHAIN_H_
@@ -71,8 +71,64 @@ Blockchain.prototype = {
     transfer: function (address, value) {
         return this.nativeBlockchain.transfer(address, value.toString());
     },
+    selfDestruct: function (beneficiary) {
+        if (this.nativeBlockchain.selfDestruct(beneficiary) !== 0) {
+            throw new Error("self-destruct failed.");
+        }
+    },
     verifyAddress: function (address) {
         return this.nativeBlockchain.verifyAddress(address);
+    },
+    runContractSource: function (address, funcName, args) {
+        var ret = this.nativeBlockchain.runContractSource(address, funcName, JSON.stringify(args || []));
+        if (ret === null) {
+            throw new Error("call contract " + address + " failed.");
+        }
+        return ret.length > 0 ? JSON.parse(ret) : undefined;
+    },
+    getBlockHash: function (height) {
+        return this.nativeBlockchain.getBlockHash(height);
+    },
+    precompile: function (name, input) {
+        var ret = this.nativeBlockchain.runPrecompile(name, input === undefined ? "" : input.toString());
+        if (ret === null) {
+            throw new Error("run precompile " + name + " failed.");
+        }
+        return ret;
+    },
+    // request the oracle operators to answer the query, the answer is delivered to the
+    // private callback of the contract with the id of the request returned.
+    requestOracle: function (query, callback) {
+        var ret = this.nativeBlockchain.requestOracle(query.toString(), callback);
+        if (ret === null) {
+            throw new Error("request oracle failed.");
+        }
+        return ret;
+    },
+    // deploy a contract with the code {source, sourceType}, or {codeHash} of a deployed one, and run
+    // its init with the args, returns the address of the new contract.
+    deployContract: function (code, args) {
+        code = code || {};
+        var ret = this.nativeBlockchain.deployContract(code.source || "", code.sourceType || "js",
+            code.codeHash || "", JSON.stringify(args || []));
+        if (ret === null) {
+            throw new Error("deploy contract failed.");
+        }
+        return ret;
+    },
+    // guard a function against being entered again by the contracts it calls, the whole
+    // transaction fails if it is.
+    nonReentrant: function () {
+        if (this.nativeBlockchain.nonReentrant() !== 0) {
+            throw new Error("reentrant contract call is not allowed.");
+        }
+    },
+    random: function (seed) {
+        var ret = this.nativeBlockchain.random(seed === undefined ? "" : seed.toString());
+        if (ret === null) {
+            throw new Error("generate random failed.");
+        }
+        return ret;
     }
 };
 
@@ -0,0 +1,68 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+// Decimal is the deterministic arbitrary-precision decimal of contracts, built on the bundled
+// bignumber.js. A version is frozen once a hard fork schedules it, changes add a new version.
+var BigNumber = require('bignumber.js');
+
+var versions = {
+    1: {
+        DECIMAL_PLACES: 18,
+        ROUNDING_MODE: BigNumber.ROUND_HALF_EVEN,
+        EXPONENTIAL_AT: [-7, 21],
+        RANGE: [-1000000, 1000000],
+        ERRORS: true,
+        CRYPTO: false,
+        MODULO_MODE: BigNumber.ROUND_DOWN,
+        POW_PRECISION: 64
+    }
+};
+
+var decimals = {};
+
+// create returns the constructor of the version, its config can be read but not changed,
+// and the random numbers are removed.
+function create(version) {
+    var Decimal = BigNumber.another(versions[version]),
+        config = Decimal.config;
+
+    Decimal.config = Decimal.set = function () {
+        if (arguments.length > 0) {
+            throw new Error("Decimal config is frozen.");
+        }
+        return config.call(Decimal);
+    };
+    Decimal.random = function () {
+        throw new Error("Decimal.random is not deterministic, use Blockchain.random.");
+    };
+    Decimal.another = undefined;
+    Decimal.version = version;
+    return Object.freeze(Decimal);
+}
+
+module.exports = function (version) {
+    if (!versions.hasOwnProperty(version)) {
+        throw new Error("unknown Decimal version " + version + ".");
+    }
+    if (decimals[version] === undefined) {
+        decimals[version] = create(version);
+    }
+    return decimals[version];
+};
@@ -23,14 +23,23 @@
 #include "instruction_counter.h"
 
 static EventTriggerFunc TRIGGER = NULL;
+static EventEmitFunc EMIT = NULL;
 
-void InitializeEvent(EventTriggerFunc trigger) { TRIGGER = trigger; }
+void InitializeEvent(EventTriggerFunc trigger, EventEmitFunc emit) {
+  TRIGGER = trigger;
+  EMIT = emit;
+}
 
 void NewNativeEventFunction(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
   globalTpl->Set(String::NewFromUtf8(isolate, "_native_event_trigger"),
                  FunctionTemplate::New(isolate, EventTriggerCallback),
                  static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                 PropertyAttribute::ReadOnly));
+
+  globalTpl->Set(String::NewFromUtf8(isolate, "_native_event_emit"),
+                 FunctionTemplate::New(isolate, EventEmitCallback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
 }
 
 void EventTriggerCallback(const FunctionCallbackInfo<Value> &info) {
@@ -72,3 +81,45 @@ void EventTriggerCallback(const FunctionCallbackInfo<Value> &info) {
 
   TRIGGER(e, *sTopic, *sData);
 }
+
+void EventEmitCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Context> context = isolate->GetCurrentContext();
+
+  if (info.Length() < 3) {
+    isolate->ThrowException(Exception::Error(
+        String::NewFromUtf8(isolate, "_native_event_emit: mssing params")));
+    return;
+  }
+
+  for (int i = 0; i < 3; i++) {
+    if (!info[i]->IsString()) {
+      isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+          isolate, "_native_event_emit: params must be string")));
+      return;
+    }
+  }
+  Local<Value> name = info[0];
+  Local<Value> indexed = info[1];
+  Local<Value> data = info[2];
+
+  // record event usage.
+  RecordEventUsage(isolate, context,
+                   name->ToString()->Utf8Length() +
+                       indexed->ToString()->Utf8Length() +
+                       data->ToString()->Utf8Length());
+
+  if (EMIT == NULL) {
+    return;
+  }
+
+  V8Engine *e = GetV8EngineInstance(context);
+  String::Utf8Value sName(name);
+  String::Utf8Value sIndexed(indexed);
+  String::Utf8Value sData(data);
+
+  if (EMIT(e, *sName, *sIndexed, *sData) != 0) {
+    isolate->ThrowException(Exception::Error(
+        String::NewFromUtf8(isolate, "_native_event_emit: invalid event")));
+  }
+}
@@ -21,3 +21,8 @@
 exports["Trigger"] = function (topic, data) {
     _native_event_trigger(topic, JSON.stringify(data));
 };
+
+// emit an event whose indexed fields are the topics to filter the logs.
+exports["emit"] = function (name, indexed, data) {
+    _native_event_emit(name, JSON.stringify(indexed || []), JSON.stringify(data));
+};
@@ -49,3 +49,42 @@ char *GetAccountState(void *handler, const char *address) {
 int Transfer(void *handler, const char *to, const char *value) { return 1; }
 
 int VerifyAddress(void *handler, const char *address) { return 1; }
+
+int SelfDestruct(void *handler, const char *beneficiary) { return 1; }
+
+char *RunPrecompile(void *handler, const char *name, const char *input) {
+  return NULL;
+}
+
+char *RequestOracle(void *handler, const char *query, const char *callback) {
+  return NULL;
+}
+
+int NonReentrant(void *handler) { return 0; }
+
+char *DeployContract(void *handler, const char *source, const char *sourceType,
+                     const char *codeHash, const char *args) {
+  return NULL;
+}
+
+char *RunContractSource(void *handler, const char *address,
+                        const char *funcName, const char *args) {
+  return NULL;
+}
+
+char *Random(void *handler, const char *seed) {
+  char *ret = NULL;
+  string value =
+      "3c6e5d0e4ddb4a2e8ed1b3d8bdcb22d2a4e1e6f2ab3a0b2a5e52dcbd7c8a9f01";
+  ret = (char *)calloc(value.length() + 1, sizeof(char));
+  strncpy(ret, value.c_str(), value.length());
+  return ret;
+}
+
+char *GetBlockHash(void *handler, unsigned long long height) {
+  char *ret = NULL;
+  string value = "c7174759e86c59dcb7df87def82f61eb";
+  ret = (char *)calloc(value.length() + 1, sizeof(char));
+  strncpy(ret, value.c_str(), value.length());
+  return ret;
+}
@@ -24,5 +24,15 @@ char *GetTxByHash(void *handler, const char *hash);
 char *GetAccountState(void *handler, const char *address);
 int Transfer(void *handler, const char *to, const char *value);
 int VerifyAddress(void *handler, const char *address);
+char *RunContractSource(void *handler, const char *address,
+                        const char *funcName, const char *args);
+char *Random(void *handler, const char *seed);
+char *GetBlockHash(void *handler, unsigned long long height);
+int SelfDestruct(void *handler, const char *beneficiary);
+char *RunPrecompile(void *handler, const char *name, const char *input);
+char *RequestOracle(void *handler, const char *query, const char *callback);
+int NonReentrant(void *handler);
+char *DeployContract(void *handler, const char *source, const char *sourceType,
+                     const char *codeHash, const char *args);
 
 #endif //_NEBULAS_NF_NVM_V8_LIB_FAKE_BLOCKCHAIN_H_
@@ -23,6 +23,7 @@
 static char sInstructionCounter[] = "_instruction_counter";
 
 static InstructionCounterIncrListener sListener = NULL;
+static InstructionCounterTraceListener sTraceListener = NULL;
 
 void NewInstructionCounterInstance(Isolate *isolate, Local<Context> context,
                                    size_t *counter, void *listenerContext) {
@@ -81,6 +82,14 @@ void IncrCounterCallback(const FunctionCallbackInfo<Value> &info) {
   size_t *cnt = static_cast<size_t *>(count->Value());
   *cnt += val;
 
+  // the source line and function of the instructions are only passed when
+  // traced.
+  if (sTraceListener != NULL && info.Length() > 2 && info[1]->IsNumber()) {
+    String::Utf8Value function(info[2]);
+    sTraceListener(isolate, info[1]->Int32Value(), *function, val,
+                   listenerContext->Value());
+  }
+
   if (sListener != NULL) {
     sListener(isolate, *cnt, listenerContext->Value());
   }
@@ -101,6 +110,11 @@ void SetInstructionCounterIncrListener(
   sListener = listener;
 }
 
+void SetInstructionCounterTraceListener(
+    InstructionCounterTraceListener listener) {
+  sTraceListener = listener;
+}
+
 void RecordStorageUsage(Isolate *isolate, Local<Context> context,
                         size_t key_length, size_t value_length) {
   Local<Object> global = context->Global();
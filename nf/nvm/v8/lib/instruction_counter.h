@@ -28,6 +28,12 @@ typedef void (*InstructionCounterIncrListener)(Isolate *isolate, size_t count,
                                                void *context);
 void SetInstructionCounterIncrListener(InstructionCounterIncrListener listener);
 
+typedef void (*InstructionCounterTraceListener)(Isolate *isolate, int line,
+                                                const char *function,
+                                                size_t value, void *context);
+void SetInstructionCounterTraceListener(
+    InstructionCounterTraceListener listener);
+
 void NewInstructionCounterInstance(Isolate *isolate, Local<Context> context,
                                    size_t *counter, void *listenerContext);
 
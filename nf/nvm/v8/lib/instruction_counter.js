@@ -55,20 +55,20 @@ function traverse(object, visitor, master, injection_context_from_parent) {
     }
 };
 
-// calculate and record the storage usage.
+// calculate and record the storage usage, STORAGE_BYTE is replaced by the gas table.
 var storIncrFunc = function (key_len, value_len) {
-    var incr_val = Math.ceil(key_len + value_len);
+    var incr_val = Math.ceil((key_len + value_len) * STORAGE_BYTE);
     _instruction_counter.incr(incr_val);
 };
 
-// calculate and record the event usage.
+// calculate and record the event usage, EVENT_BYTE and EVENT_BASE are replaced by the gas table.
 var eventIncrFunc = function (data_len) {
-    const EVENT_INCR = 20;
-    var incr_val = Math.ceil(data_len) + EVENT_INCR;
+    var incr_val = Math.ceil(data_len * EVENT_BYTE) + EVENT_BASE;
     _instruction_counter.incr(incr_val);
 };
 
 // key is the Expression, value is the count of instruction of the Expression.
+// these are the defaults, the chain may reprice them with the gas table of the block.
 const TrackingExpressions = {
     CallExpression: 8,
     AssignmentExpression: 3,
@@ -84,6 +84,14 @@ const TrackingExpressions = {
     YieldExpression: 6,
 };
 
+const DefaultGasTable = {
+    version: 0,
+    expressions: TrackingExpressions,
+    storageByte: 1,
+    eventByte: 1,
+    eventBase: 20,
+};
+
 const InjectableExpressions = {
     ExpressionStatement: 1,
     VariableDeclaration: 1,
@@ -98,43 +106,54 @@ const InjectionType = {
     INNER_BEGINNING_NOT_AND_OR: "INNER_BEGINNING_NOT_AND_OR",
 };
 
+// incrCall returns the call counting the instructions, with the source line and function of them when traced.
+function incrCall(value, line, func) {
+    if (line > 0) {
+        return "_instruction_counter.incr(" + value + ", " + line + ", " + JSON.stringify(func || "") + ")";
+    }
+    return "_instruction_counter.incr(" + value + ")";
+};
+
 const InjectionCodeGenerators = {
-    StorageAndEventUsageFunc: function () {
-        return "_instruction_counter.storIncr = " + storIncrFunc.toString() + ";\n" +
-            "_instruction_counter.eventIncr = " + eventIncrFunc.toString() + ";\n";
+    StorageAndEventUsageFunc: function (value, gas_table) {
+        var stor_incr = storIncrFunc.toString().replace("STORAGE_BYTE", gas_table.storageByte);
+        var event_incr = eventIncrFunc.toString().replace("EVENT_BYTE", gas_table.eventByte)
+            .replace("EVENT_BASE", gas_table.eventBase);
+        return "_instruction_counter.storIncr = " + stor_incr + ";\n" +
+            "_instruction_counter.eventIncr = " + event_incr + ";\n";
     },
-    CounterIncrFunc: function (value) {
-        return "_instruction_counter.incr(" + value + ");";
+    CounterIncrFunc: function (value, gas_table, line, func) {
+        return incrCall(value, line, func) + ";";
     },
-    BlockStatementBeginAndCounterIncrFunc: function (value) {
+    BlockStatementBeginAndCounterIncrFunc: function (value, gas_table, line, func) {
         if (value > 0) {
-            return "{_instruction_counter.incr(" + value + ");"
+            return "{" + incrCall(value, line, func) + ";"
         } else {
             return "{";
         }
     },
-    BlockStatementEndAndCounterIncrFunc: function (value) {
+    BlockStatementEndAndCounterIncrFunc: function (value, gas_table, line, func) {
         if (value > 0) {
-            return "_instruction_counter.incr(" + value + ");}"
+            return incrCall(value, line, func) + ";}"
         } else {
             return "}";
         }
     },
-    BlockStatementBeginAndCounterIncrFuncAndReturn: function (value) {
+    BlockStatementBeginAndCounterIncrFuncAndReturn: function (value, gas_table, line, func) {
         if (value > 0) {
-            return "{_instruction_counter.incr(" + value + "); return "
+            return "{" + incrCall(value, line, func) + "; return "
         } else {
             return "{return ";
         }
     },
-    BeginInnerCounterIncrFunc: function (value) {
-        return "_instruction_counter.incr(" + value + ") && (";
+    BeginInnerCounterIncrFunc: function (value, gas_table, line, func) {
+        return incrCall(value, line, func) + " && (";
     },
     EndInnerCounterIncrFunc: function (value) {
         return ")";
     },
-    CounterIncrFuncUsingNotAndLogicalOrFunc: function (value) {
-        return "!_instruction_counter.incr(" + value + ") || ";
+    CounterIncrFuncUsingNotAndLogicalOrFunc: function (value, gas_table, line, func) {
+        return "!" + incrCall(value, line, func) + " || ";
     },
 };
 
@@ -156,7 +175,69 @@ function record_injection_info(injection_records, pos, value, injection_func) {
     item.value += value;
 };
 
-function processScript(source) {
+// lineOfPosition returns the function mapping a position in the source to its line, from 1.
+function lineOfPosition(source) {
+    var starts = [0];
+    for (var i = 0; i < source.length; i++) {
+        if (source[i] === "\n") {
+            starts.push(i + 1);
+        }
+    }
+    return function (pos) {
+        var lo = 0,
+            hi = starts.length - 1;
+        while (lo < hi) {
+            var mid = (lo + hi + 1) >> 1;
+            if (starts[mid] <= pos) {
+                lo = mid;
+            } else {
+                hi = mid - 1;
+            }
+        }
+        return lo + 1;
+    };
+};
+
+// functionName returns the name of the function node, from the property, variable or member it's assigned to.
+function functionName(source, node, parents) {
+    var parent = parents[0].node;
+    if (parent) {
+        if ((parent.type === "Property" || parent.type === "MethodDefinition") && parent.value === node) {
+            return source.slice(parent.key.range[0], parent.key.range[1]);
+        }
+        if (parent.type === "VariableDeclarator" && parent.init === node) {
+            return source.slice(parent.id.range[0], parent.id.range[1]);
+        }
+        if (parent.type === "AssignmentExpression" && parent.right === node) {
+            return source.slice(parent.left.range[0], parent.left.range[1]);
+        }
+    }
+    if (node.id) {
+        return node.id.name;
+    }
+    return "(anonymous)";
+};
+
+// functionOfPosition returns the function mapping a position in the source to the name of
+// the innermost function containing it, empty out of any function.
+function functionOfPosition(functions) {
+    return function (pos) {
+        var name = "",
+            size = Infinity;
+        functions.forEach(function (f) {
+            if (f.start < pos && pos < f.end && f.end - f.start < size) {
+                name = f.name;
+                size = f.end - f.start;
+            }
+        });
+        return name;
+    };
+};
+
+// processScript injects the instruction counter into the source,
+// the counter is also told the line and function of the instructions if gas_table.trace is set.
+function processScript(source, gas_table) {
+    gas_table = gas_table || DefaultGasTable;
     var injection_records = new Map();
     var record_injection = function (pos, value, injection_func) {
         return record_injection_info(injection_records, pos, value, injection_func);
@@ -184,11 +265,12 @@ function processScript(source) {
 
     var setStorageAndEventUsageFuncInjection = false;
     var source_line_offset = 0;
+    var functions = [];
 
     traverse(ast, function (node, parents, injection_context_from_parent) {
         // get the ast begin offset, after comments before first statement.
         if (!setStorageAndEventUsageFuncInjection) {
-            source_line_offset = -9;
+            source_line_offset = -8;
             record_injection(node.range[0], 0, InjectionCodeGenerators.StorageAndEventUsageFunc);
             setStorageAndEventUsageFuncInjection = true;
         }
@@ -196,6 +278,18 @@ function processScript(source) {
         // throw error when "_instruction_counter" was redefined in source.
         disallowRedefineOfInstructionCounter(node, parents);
 
+        if (gas_table.trace && node.type in {
+                FunctionDeclaration: "",
+                FunctionExpression: "",
+                ArrowFunctionExpression: "",
+            }) {
+            functions.push({
+                name: functionName(source, node, parents),
+                start: node.range[0],
+                end: node.range[1],
+            });
+        }
+
         // 1. flag find the injection point, eg a Expression/Statement can inject code directly.
         if (node.type == "IfStatement") {
             ensure_block_statement(node.consequent);
@@ -282,7 +376,7 @@ function processScript(source) {
         } else {
 
             // Other Expressions.
-            var tracing_val = TrackingExpressions[node.type];
+            var tracing_val = gas_table.expressions[node.type];
             if (!tracing_val) {
                 // not the tracking expression, ignore.
                 return;
@@ -360,11 +454,15 @@ function processScript(source) {
     });
 
 
+    var line_of = gas_table.trace ? lineOfPosition(source) : function () {
+        return 0;
+    };
+    var function_of = functionOfPosition(functions);
     var start_offset = 0,
         traceable_source = "";
     ordered_records.forEach(function (record) {
         traceable_source += source.slice(start_offset, record.pos);
-        traceable_source += record.func(record.value);
+        traceable_source += record.func(record.value, gas_table, line_of(record.pos), function_of(record.pos));
         start_offset = record.pos;
     });
     traceable_source += source.slice(start_offset);
@@ -0,0 +1,91 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+
+'use strict';
+
+const module_path_prefix = (typeof process !== 'undefined') && (process.release.name === 'node') ? './' : '';
+const esprima = require(module_path_prefix + 'esprima.js');
+
+// globals the contracts must not use, nondeterministic or escaping the sandbox.
+const BannedGlobals = ["eval", "Function", "Date", "WebAssembly"];
+const BannedMembers = {
+    Math: ["random"]
+};
+const NativeGlobal = /^_native_|^_instruction_counter$/;
+
+// whether the identifier refers to a variable, not a property name.
+function isReference(node, parent, key) {
+    if (!parent) {
+        return true;
+    }
+    if (parent.type === "MemberExpression" && key === "property") {
+        return parent.computed;
+    }
+    if ((parent.type === "Property" || parent.type === "MethodDefinition") && key === "key") {
+        return parent.computed;
+    }
+    return true;
+}
+
+function walk(node, parent, key, visitor) {
+    visitor(node, parent, key);
+    for (var k in node) {
+        if (!node.hasOwnProperty(k)) {
+            continue;
+        }
+        var child = node[k];
+        if (Array.isArray(child)) {
+            child.forEach(function (c) {
+                if (c && typeof c.type === "string") {
+                    walk(c, node, k, visitor);
+                }
+            });
+        } else if (child && typeof child.type === "string") {
+            walk(child, node, k, visitor);
+        }
+    }
+}
+
+// lint returns the problems found in the source of a contract, empty if none.
+function lint(source) {
+    var ast;
+    try {
+        ast = esprima.parseScript(source, {
+            loc: true
+        });
+    } catch (e) {
+        return [e.message];
+    }
+
+    var problems = [];
+    walk(ast, null, null, function (node, parent, key) {
+        if (node.type === "Identifier" && isReference(node, parent, key)) {
+            if (BannedGlobals.indexOf(node.name) >= 0 || NativeGlobal.test(node.name)) {
+                problems.push("Line " + node.loc.start.line + ": " + node.name + " is not allowed.");
+            }
+        } else if (node.type === "MemberExpression" && !node.computed && node.object.type === "Identifier" &&
+            BannedMembers.hasOwnProperty(node.object.name) &&
+            BannedMembers[node.object.name].indexOf(node.property.name) >= 0) {
+            problems.push("Line " + node.loc.start.line + ": " + node.object.name + "." + node.property.name + " is not allowed.");
+        }
+    });
+    return problems;
+}
+
+exports["lint"] = lint;
@@ -18,11 +18,13 @@
 //
 
 #include "log_callback.h"
+#include "global.h"
 #include "logger.h"
 
 #include <stdarg.h>
 
 static LogFunc LOG = NULL;
+static ConsoleLogFunc CONSOLE = NULL;
 static const char *LogLevelText[] = {"DEBUG", "WARN", "INFO", "ERROR"};
 
 const char *GetLogLevelText(int level) {
@@ -35,7 +37,10 @@ const char *GetLogLevelText(int level) {
   return LogLevelText[level - 1];
 };
 
-void InitializeLogger(LogFunc log) { LOG = log; }
+void InitializeLogger(LogFunc log, ConsoleLogFunc console) {
+  LOG = log;
+  CONSOLE = console;
+}
 
 void NewNativeLogFunction(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
   globalTpl->Set(String::NewFromUtf8(isolate, "_native_log"),
@@ -66,11 +71,18 @@ void LogCallback(const FunctionCallbackInfo<Value> &info) {
     return;
   }
 
-  if (LOG == NULL) {
+  String::Utf8Value m(msg);
+
+  // the output of contracts goes to the engine executing them.
+  V8Engine *e = GetV8EngineInstance(isolate->GetCurrentContext());
+  if (CONSOLE != NULL && e != NULL) {
+    CONSOLE(e, (level->ToInt32())->Int32Value(), *m);
     return;
   }
 
-  String::Utf8Value m(msg);
+  if (LOG == NULL) {
+    return;
+  }
   LOG((level->ToInt32())->Int32Value(), *m);
 }
 
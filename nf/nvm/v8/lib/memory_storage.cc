@@ -80,3 +80,32 @@ int StorageDel(void *handler, const char *key) {
 
   return 0;
 }
+
+char *StorageKeys(void *handler, const char *field) {
+  string prefix = genKey(handler, "@");
+  prefix.append(field);
+  prefix.append("[");
+
+  string keys = "[";
+  mapMutex.lock();
+  for (auto it = memoryMap.begin(); it != memoryMap.end(); it++) {
+    const string &sKey = it->first;
+    if (sKey.compare(0, prefix.length(), prefix) != 0 ||
+        sKey[sKey.length() - 1] != ']') {
+      continue;
+    }
+    if (keys.length() > 1) {
+      keys.append(",");
+    }
+    keys.append("\"");
+    keys.append(sKey.substr(prefix.length(),
+                            sKey.length() - prefix.length() - 1));
+    keys.append("\"");
+  }
+  mapMutex.unlock();
+  keys.append("]");
+
+  char *ret = (char *)calloc(keys.length() + 1, sizeof(char));
+  strncpy(ret, keys.c_str(), keys.length());
+  return ret;
+}
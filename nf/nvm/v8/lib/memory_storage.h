@@ -26,5 +26,6 @@ void DeleteStorageHandler(void *handler);
 char *StorageGet(void *handler, const char *key);
 int StoragePut(void *handler, const char *key, const char *value);
 int StorageDel(void *handler, const char *key);
+char *StorageKeys(void *handler, const char *field);
 
 #endif // _NEBULAS_NF_NVM_V8_LIB_MEMORY_STORAGE_H_
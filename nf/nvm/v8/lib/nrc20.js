@@ -0,0 +1,171 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+
+'use strict';
+
+// NRC20 is the standard token of the contracts, a contract may export it as it is:
+//   module.exports = require("nrc20.js");
+// or extend it:
+//   var NRC20 = require("nrc20.js");
+//   var Token = function () { NRC20.call(this); };
+//   Token.prototype = Object.create(NRC20.prototype);
+// Each transfer emits the log "Transfer" indexed by from and to, which nodes index
+// as token transfers.
+
+var amountParser = {
+    parse: function (text) {
+        return new BigNumber(text);
+    },
+    stringify: function (o) {
+        return o.toString(10);
+    }
+};
+
+// parse the amount of tokens, a non-negative integer.
+var toAmount = function (value) {
+    var amount = new BigNumber(value);
+    if (amount.isNaN() || !amount.isInteger() || amount.lt(0)) {
+        throw new Error("invalid value.");
+    }
+    return amount;
+};
+
+var checkAddress = function (address) {
+    if (!Blockchain.verifyAddress(address)) {
+        throw new Error("invalid address.");
+    }
+};
+
+var NRC20 = function () {
+    LocalContractStorage.defineProperties(this, {
+        _name: null,
+        _symbol: null,
+        _decimals: null,
+        _totalSupply: amountParser
+    });
+    LocalContractStorage.defineMapProperties(this, {
+        "balances": amountParser,
+        "allowed": amountParser
+    });
+};
+
+NRC20.prototype = {
+    init: function (name, symbol, decimals, totalSupply) {
+        decimals = decimals | 0;
+        if (decimals < 0 || decimals > 18) {
+            throw new Error("decimals must be 0 to 18.");
+        }
+        var supply = toAmount(totalSupply).mul(new BigNumber(10).pow(decimals));
+        this._name = name;
+        this._symbol = symbol;
+        this._decimals = decimals;
+        this._totalSupply = supply;
+
+        var from = Blockchain.transaction.from;
+        this.balances.set(from, supply);
+        this._transferEvent("", from, supply);
+    },
+
+    name: function () {
+        return this._name;
+    },
+
+    symbol: function () {
+        return this._symbol;
+    },
+
+    decimals: function () {
+        return this._decimals;
+    },
+
+    totalSupply: function () {
+        return this._totalSupply.toString(10);
+    },
+
+    balanceOf: function (owner) {
+        var balance = this.balances.get(owner);
+        return balance instanceof BigNumber ? balance.toString(10) : "0";
+    },
+
+    transfer: function (to, value) {
+        checkAddress(to);
+        this._move(Blockchain.transaction.from, to, toAmount(value));
+    },
+
+    transferFrom: function (from, to, value) {
+        checkAddress(to);
+        var spender = Blockchain.transaction.from;
+        var amount = toAmount(value);
+        var allowed = this.allowed.get(this._allowedKey(from, spender)) || new BigNumber(0);
+        if (allowed.lt(amount)) {
+            throw new Error("transfer exceeds the allowance.");
+        }
+        this._move(from, to, amount);
+        this.allowed.set(this._allowedKey(from, spender), allowed.sub(amount));
+    },
+
+    // approve replaces the allowance only if it is still currentValue, so that a
+    // spender can't spend both the old and the new allowance.
+    approve: function (spender, currentValue, value) {
+        checkAddress(spender);
+        var owner = Blockchain.transaction.from;
+        var allowed = this.allowed.get(this._allowedKey(owner, spender)) || new BigNumber(0);
+        if (!allowed.eq(toAmount(currentValue))) {
+            throw new Error("current allowance mismatch.");
+        }
+        var amount = toAmount(value);
+        this.allowed.set(this._allowedKey(owner, spender), amount);
+        Event.emit("Approve", [owner, spender], {owner: owner, spender: spender, value: amount.toString(10)});
+    },
+
+    allowance: function (owner, spender) {
+        var allowed = this.allowed.get(this._allowedKey(owner, spender));
+        return allowed instanceof BigNumber ? allowed.toString(10) : "0";
+    },
+
+    _allowedKey: function (owner, spender) {
+        return owner + "_" + spender;
+    },
+
+    _move: function (from, to, amount) {
+        var balance = this.balances.get(from) || new BigNumber(0);
+        if (balance.lt(amount)) {
+            throw new Error("insufficient balance.");
+        }
+        this.balances.set(from, balance.sub(amount));
+        this.balances.set(to, (this.balances.get(to) || new BigNumber(0)).plus(amount));
+        this._transferEvent(from, to, amount);
+    },
+
+    _transferEvent: function (from, to, amount) {
+        Event.emit("Transfer", [from, to], {from: from, to: to, value: amount.toString(10)});
+    }
+};
+
+NRC20.exported = ["name", "symbol", "decimals", "totalSupply", "balanceOf", "transfer", "transferFrom", "approve", "allowance"];
+
+NRC20.abi = {
+    transfer: {args: ["string", "string"]},
+    transferFrom: {args: ["string", "string", "string"]},
+    approve: {args: ["string", "string", "string"]},
+    allowance: {args: ["string", "string"]},
+    balanceOf: {args: ["string"]}
+};
+
+module.exports = NRC20;
@@ -0,0 +1,198 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+
+'use strict';
+
+// NRC721 is the standard non-fungible token of the contracts, each token is identified by
+// a string id. A contract may export it as it is, only the deployer can mint tokens:
+//   module.exports = require("nrc721.js");
+// or extend it and mint tokens with _mint. Each transfer emits the log "Transfer" indexed
+// by from and to, which nodes index as token transfers.
+
+var checkAddress = function (address) {
+    if (!Blockchain.verifyAddress(address)) {
+        throw new Error("invalid address.");
+    }
+};
+
+var checkTokenId = function (tokenId) {
+    if (typeof tokenId !== "string" || tokenId.length === 0) {
+        throw new Error("token id must be a non-empty string.");
+    }
+};
+
+var NRC721 = function () {
+    LocalContractStorage.defineProperties(this, {
+        _name: null,
+        _symbol: null,
+        _minter: null
+    });
+    LocalContractStorage.defineMapProperties(this, {
+        "tokenOwner": null,
+        "ownedTokens": null,
+        "tokenApprovals": null,
+        "operatorApprovals": null
+    });
+};
+
+NRC721.prototype = {
+    init: function (name, symbol) {
+        this._name = name;
+        this._symbol = symbol;
+        this._minter = Blockchain.transaction.from;
+    },
+
+    name: function () {
+        return this._name;
+    },
+
+    symbol: function () {
+        return this._symbol;
+    },
+
+    balanceOf: function (owner) {
+        return this.tokensOfOwner(owner).length;
+    },
+
+    ownerOf: function (tokenId) {
+        var owner = this.tokenOwner.get(tokenId);
+        if (!owner) {
+            throw new Error("token not found.");
+        }
+        return owner;
+    },
+
+    tokensOfOwner: function (owner) {
+        return this.ownedTokens.get(owner) || [];
+    },
+
+    approve: function (to, tokenId) {
+        var owner = this.ownerOf(tokenId);
+        var sender = Blockchain.transaction.from;
+        if (sender !== owner && !this.isApprovedForAll(owner, sender)) {
+            throw new Error("only the owner or its operators can approve.");
+        }
+        if (to) {
+            checkAddress(to);
+            this.tokenApprovals.set(tokenId, to);
+        } else {
+            this.tokenApprovals.del(tokenId);
+        }
+        Event.emit("Approve", [owner, to || ""], {owner: owner, approved: to || "", tokenId: tokenId});
+    },
+
+    getApproved: function (tokenId) {
+        this.ownerOf(tokenId);
+        return this.tokenApprovals.get(tokenId) || "";
+    },
+
+    setApprovalForAll: function (operator, approved) {
+        checkAddress(operator);
+        var owner = Blockchain.transaction.from;
+        if (approved) {
+            this.operatorApprovals.set(owner + "_" + operator, true);
+        } else {
+            this.operatorApprovals.del(owner + "_" + operator);
+        }
+        Event.emit("ApprovalForAll", [owner, operator], {owner: owner, operator: operator, approved: !!approved});
+    },
+
+    isApprovedForAll: function (owner, operator) {
+        return this.operatorApprovals.get(owner + "_" + operator) === true;
+    },
+
+    transferFrom: function (from, to, tokenId) {
+        checkAddress(to);
+        var owner = this.ownerOf(tokenId);
+        if (owner !== from) {
+            throw new Error("token is not owned by from.");
+        }
+        var sender = Blockchain.transaction.from;
+        if (sender !== owner && sender !== this.tokenApprovals.get(tokenId) && !this.isApprovedForAll(owner, sender)) {
+            throw new Error("only the owner, its approved or operators can transfer.");
+        }
+        this.tokenApprovals.del(tokenId);
+        this._removeToken(from, tokenId);
+        this._addToken(to, tokenId);
+        this._transferEvent(from, to, tokenId);
+    },
+
+    mint: function (to, tokenId) {
+        if (Blockchain.transaction.from !== this._minter) {
+            throw new Error("only the minter can mint.");
+        }
+        this._mint(to, tokenId);
+    },
+
+    _mint: function (to, tokenId) {
+        checkAddress(to);
+        checkTokenId(tokenId);
+        if (this.tokenOwner.get(tokenId)) {
+            throw new Error("token already exists.");
+        }
+        this._addToken(to, tokenId);
+        this._transferEvent("", to, tokenId);
+    },
+
+    _burn: function (tokenId) {
+        var owner = this.ownerOf(tokenId);
+        this.tokenApprovals.del(tokenId);
+        this._removeToken(owner, tokenId);
+        this.tokenOwner.del(tokenId);
+        this._transferEvent(owner, "", tokenId);
+    },
+
+    _addToken: function (owner, tokenId) {
+        var tokens = this.tokensOfOwner(owner);
+        tokens.push(tokenId);
+        this.ownedTokens.set(owner, tokens);
+        this.tokenOwner.set(tokenId, owner);
+    },
+
+    _removeToken: function (owner, tokenId) {
+        var tokens = this.tokensOfOwner(owner);
+        tokens.splice(tokens.indexOf(tokenId), 1);
+        if (tokens.length > 0) {
+            this.ownedTokens.set(owner, tokens);
+        } else {
+            this.ownedTokens.del(owner);
+        }
+    },
+
+    _transferEvent: function (from, to, tokenId) {
+        Event.emit("Transfer", [from, to], {from: from, to: to, tokenId: tokenId});
+    }
+};
+
+NRC721.exported = ["name", "symbol", "balanceOf", "ownerOf", "tokensOfOwner", "approve", "getApproved",
+    "setApprovalForAll", "isApprovedForAll", "transferFrom", "mint"];
+
+NRC721.abi = {
+    balanceOf: {args: ["string"]},
+    ownerOf: {args: ["string"]},
+    tokensOfOwner: {args: ["string"]},
+    approve: {args: ["string", "string"]},
+    getApproved: {args: ["string"]},
+    setApprovalForAll: {args: ["string", "boolean"]},
+    isApprovedForAll: {args: ["string", "string"]},
+    transferFrom: {args: ["string", "string", "string"]},
+    mint: {args: ["string", "string"]}
+};
+
+module.exports = NRC721;
@@ -0,0 +1,235 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+// sandbox replaces the nondeterministic builtins, so a contract gives the same result on every node
+// whatever its platform, time zone or locale.
+
+// ISO 8601 dates, the date-time ones without a zone are read as UTC instead of the local time.
+var isoDate = /^([+-]\d{6}|\d{4})(-\d{2}(-\d{2})?)?(T\d{2}:\d{2}(:\d{2}(\.\d{1,3})?)?(Z|[+-]\d{2}:\d{2})?)?$/;
+
+function parseISODate(NativeDate, str) {
+    var m = isoDate.exec(str);
+    if (m === null) {
+        return NaN;
+    }
+    if (m[4] !== undefined && m[7] === undefined) {
+        str += "Z";
+    }
+    return NativeDate.parse(str);
+}
+
+// localMethods of Date read and write the local time, which is UTC in contracts.
+var localMethods = ["Date", "Day", "FullYear", "Hours", "Milliseconds", "Minutes", "Month", "Seconds"];
+
+// guardDate replaces Date with one whose current time is the block's, and whose local time is UTC.
+function guardDate(global, now) {
+    var NativeDate = global.Date;
+
+    var Date = function (a, b, c, d, e, f, g) {
+        if (!(this instanceof Date)) {
+            return new Date().toString();
+        }
+        var date;
+        if (arguments.length === 0) {
+            date = new NativeDate(now());
+        } else if (arguments.length === 1) {
+            date = new NativeDate(typeof a === "string" ? parseISODate(NativeDate, a) : a);
+        } else {
+            date = new NativeDate(NativeDate.UTC.apply(null, arguments));
+        }
+        Object.setPrototypeOf(date, Date.prototype);
+        return date;
+    };
+    // the prototype doesn't inherit from the native one, whose constructor is the native Date, with the
+    // wall-clock now, and whose local time methods depend on the time zone. Its methods are copied instead.
+    Date.prototype = {};
+    Object.getOwnPropertyNames(NativeDate.prototype).concat(Object.getOwnPropertySymbols(NativeDate.prototype)).forEach(function (key) {
+        if (key !== "constructor") {
+            Object.defineProperty(Date.prototype, key, Object.getOwnPropertyDescriptor(NativeDate.prototype, key));
+        }
+    });
+    Object.defineProperty(Date.prototype, "constructor", {value: Date, writable: true, configurable: true});
+    Date.now = function () {
+        return now();
+    };
+    Date.UTC = NativeDate.UTC;
+    Date.parse = function (str) {
+        return parseISODate(NativeDate, String(str));
+    };
+
+    localMethods.forEach(function (name) {
+        Date.prototype["get" + name] = NativeDate.prototype["getUTC" + name];
+        if (name !== "Day") {
+            Date.prototype["set" + name] = NativeDate.prototype["setUTC" + name];
+        }
+    });
+    Date.prototype.getTimezoneOffset = function () {
+        return 0;
+    };
+    ["toString", "toDateString", "toTimeString", "toLocaleString", "toLocaleDateString", "toLocaleTimeString"].forEach(function (name) {
+        Date.prototype[name] = NativeDate.prototype.toISOString;
+    });
+
+    global.Date = Date;
+}
+
+// guardLocale replaces the locale dependent string and number methods with the locale independent ones.
+function guardLocale(global) {
+    String.prototype.localeCompare = function (that) {
+        var a = String(this), b = String(that);
+        return a < b ? -1 : (a > b ? 1 : 0);
+    };
+    String.prototype.toLocaleUpperCase = String.prototype.toUpperCase;
+    String.prototype.toLocaleLowerCase = String.prototype.toLowerCase;
+    Number.prototype.toLocaleString = Number.prototype.toString;
+    Array.prototype.toLocaleString = Array.prototype.toString;
+    delete global.Intl;
+}
+
+// canonicalNumber formats a number like the spec's Number::toString, which V8 follows, with -0 as 0
+// and the non-finite numbers as null.
+function canonicalNumber(value) {
+    if (!isFinite(value)) {
+        return "null";
+    }
+    return value === 0 ? "0" : String(value);
+}
+
+// canonicalStringify serializes like JSON.stringify, except that the keys of objects are sorted by
+// their code units instead of following the order they were added in.
+function canonicalStringify(nativeStringify) {
+    return function stringify(value, replacer, space) {
+        var gap = "", propertyList = null, stack = [];
+
+        if (Array.isArray(replacer)) {
+            propertyList = [];
+            replacer.forEach(function (item) {
+                if (typeof item === "number" || item instanceof Number || item instanceof String) {
+                    item = String(item);
+                }
+                if (typeof item === "string" && propertyList.indexOf(item) < 0) {
+                    propertyList.push(item);
+                }
+            });
+            propertyList.sort();
+            replacer = null;
+        } else if (typeof replacer !== "function") {
+            replacer = null;
+        }
+
+        if (space instanceof Number) {
+            space = Number(space);
+        } else if (space instanceof String) {
+            space = String(space);
+        }
+        if (typeof space === "number") {
+            gap = "          ".slice(0, Math.max(0, Math.min(10, Math.floor(space))));
+        } else if (typeof space === "string") {
+            gap = space.slice(0, 10);
+        }
+
+        function serialize(holder, key, indent) {
+            var value = holder[key];
+            if (value !== null && (typeof value === "object" || typeof value === "bigint") &&
+                typeof value.toJSON === "function") {
+                value = value.toJSON(key);
+            }
+            if (replacer !== null) {
+                value = replacer.call(holder, key, value);
+            }
+            if (value instanceof Number) {
+                value = Number(value);
+            } else if (value instanceof String) {
+                value = String(value);
+            } else if (value instanceof Boolean) {
+                value = value.valueOf();
+            }
+
+            if (value === null) {
+                return "null";
+            }
+            switch (typeof value) {
+                case "boolean":
+                    return value ? "true" : "false";
+                case "string":
+                    return nativeStringify(value);
+                case "number":
+                    return canonicalNumber(value);
+                case "bigint":
+                    throw new TypeError("Do not know how to serialize a BigInt");
+                case "object":
+                    return serializeObject(value, indent);
+            }
+            return undefined;
+        }
+
+        function serializeObject(value, indent) {
+            if (stack.indexOf(value) >= 0) {
+                throw new TypeError("Converting circular structure to JSON");
+            }
+            stack.push(value);
+            var stepback = indent, items = [], isArray = Array.isArray(value);
+            indent += gap;
+
+            if (isArray) {
+                for (var i = 0; i < value.length; i++) {
+                    var item = serialize(value, String(i), indent);
+                    items.push(item === undefined ? "null" : item);
+                }
+            } else {
+                var keys = propertyList !== null ? propertyList : Object.keys(value).sort();
+                keys.forEach(function (k) {
+                    var item = serialize(value, k, indent);
+                    if (item !== undefined) {
+                        items.push(nativeStringify(k) + (gap === "" ? ":" : ": ") + item);
+                    }
+                });
+            }
+            stack.pop();
+
+            var open = isArray ? "[" : "{", close = isArray ? "]" : "}";
+            if (items.length === 0) {
+                return open + close;
+            }
+            if (gap === "") {
+                return open + items.join(",") + close;
+            }
+            return open + "\n" + indent + items.join(",\n" + indent) + "\n" + stepback + close;
+        }
+
+        return serialize({"": value}, "", "");
+    };
+}
+
+// guardJSON replaces JSON.stringify with the canonical one, so the storage, events and results of
+// contracts don't depend on the order their objects were built in.
+function guardJSON(global) {
+    global.JSON.stringify = canonicalStringify(global.JSON.stringify);
+}
+
+// guard installs the guards into the global, now returns the milliseconds of the block's timestamp.
+exports.guard = function (global, now) {
+    Math.random = function () {
+        throw new Error("Math.random is not deterministic, use Blockchain.random.");
+    };
+    guardDate(global, now);
+    guardLocale(global);
+    guardJSON(global);
+};
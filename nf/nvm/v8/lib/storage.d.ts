@@ -76,6 +76,10 @@ interface ContractStorage {
     // the value will be serialized to string by calling `descriptor.stringify`.
     // return 0 for success, otherwise failure.
     set(key: string, value: any): number;
+
+    // return the keys set in the StorageMap named `fieldName` and starting with `prefix`,
+    // in the order of their hashes.
+    keys(fieldName: string, prefix?: string): string[];
 }
 
 interface StorageMapConstructor {
@@ -96,6 +100,9 @@ interface StorageMap {
     // the value will be serialized to string by calling `descriptor.stringify`.
     // return 0 for success, otherwise failure.
     set(key: string, value: any): number;
+
+    // return the keys set in the map and starting with `prefix`, in the order of their hashes.
+    keys(prefix?: string): string[];
 }
 
 declare const lcs: ContractStorage;
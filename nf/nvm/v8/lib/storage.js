@@ -124,6 +124,9 @@ StorageMap.prototype = {
     set: function (key, value) {
         var val = this.stringify(value);
         return this.contractStorage.rawSet(combineStorageMapKey(this.fieldName, key), val);
+    },
+    keys: function (prefix) {
+        return this.contractStorage.keys(this.fieldName, prefix);
     }
 };
 StorageMap.prototype.put = StorageMap.prototype.set;
@@ -158,6 +161,19 @@ ContractStorage.prototype = {
     set: function (key, value) {
         return this.rawSet(key, JSON.stringify(value));
     },
+    keys: function (fieldName, prefix) {
+        var keys = this.nativeStorage.keys(fieldName);
+        if (keys === null) {
+            throw new Error("keys of " + fieldName + " failed.");
+        }
+        keys = JSON.parse(keys);
+        if (prefix) {
+            keys = keys.filter(function (key) {
+                return key.indexOf(prefix) === 0;
+            });
+        }
+        return keys;
+    },
     defineProperty: function (obj, fieldName, descriptor) {
         if (!obj || !fieldName) {
             throw new Error("defineProperty requires at least two parameters.");
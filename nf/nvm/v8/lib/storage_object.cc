@@ -22,10 +22,12 @@
 #include "instruction_counter.h"
 #include "logger.h"
 #include <math.h>
+#include <string.h>
 
 static StorageGetFunc GET = NULL;
 static StoragePutFunc PUT = NULL;
 static StorageDelFunc DEL = NULL;
+static StorageKeysFunc KEYS = NULL;
 
 void NewStorageType(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
   Local<FunctionTemplate> type =
@@ -55,6 +57,11 @@ void NewStorageType(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
       FunctionTemplate::New(isolate, StorageDelCallback),
       static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                      PropertyAttribute::ReadOnly));
+  instanceTpl->Set(
+      String::NewFromUtf8(isolate, "keys"),
+      FunctionTemplate::New(isolate, StorageKeysCallback),
+      static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                     PropertyAttribute::ReadOnly));
 
   globalTpl->Set(className, type,
                  static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
@@ -86,10 +93,11 @@ void NewStorageTypeInstance(Isolate *isolate, Local<Context> context,
 }
 
 void InitializeStorage(StorageGetFunc get, StoragePutFunc put,
-                       StorageDelFunc del) {
+                       StorageDelFunc del, StorageKeysFunc keys) {
   GET = get;
   PUT = put;
   DEL = del;
+  KEYS = keys;
 }
 
 void StorageConstructor(const FunctionCallbackInfo<Value> &info) {
@@ -196,3 +204,34 @@ void StorageDelCallback(const FunctionCallbackInfo<Value> &info) {
   int ret = DEL(handler->Value(), *String::Utf8Value(key->ToString()));
   info.GetReturnValue().Set(ret);
 }
+
+void StorageKeysCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 1) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Storage.keys() requires only 1 argument"));
+    return;
+  }
+
+  Local<Value> field = info[0];
+  if (!field->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "field must be string"));
+    return;
+  }
+
+  char *value = KEYS(handler->Value(), *String::Utf8Value(field->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+    return;
+  }
+  info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+
+  // record storage usage, the keys are read like the values.
+  Local<Context> context = isolate->GetCurrentContext();
+  RecordStorageUsage(isolate, context, 0, strlen(value));
+  free(value);
+}
@@ -32,5 +32,6 @@ void StorageConstructor(const FunctionCallbackInfo<Value> &info);
 void StorageGetCallback(const FunctionCallbackInfo<Value> &info);
 void StoragePutCallback(const FunctionCallbackInfo<Value> &info);
 void StorageDelCallback(const FunctionCallbackInfo<Value> &info);
+void StorageKeysCallback(const FunctionCallbackInfo<Value> &info);
 
 #endif // _NEBULAS_NF_NVM_V8_LIB_STORAGE_OBJECT_H_
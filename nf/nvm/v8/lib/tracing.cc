@@ -32,7 +32,7 @@ static char inject_tracer_source_template[] =
     "(function(){\n"
     "const instCounter = require(\"instruction_counter.js\");\n"
     "const source = \"%s\";\n"
-    "return instCounter.processScript(source);\n"
+    "return instCounter.processScript(source, %s);\n"
     "})();";
 
 int InjectTracingInstructionDelegate(Isolate *isolate, const char *source,
@@ -49,7 +49,10 @@ int InjectTracingInstructionDelegate(Isolate *isolate, const char *source,
   s = ReplaceAll(s, "\"", "\\\"");
 
   char *injectTracerSource = NULL;
-  asprintf(&injectTracerSource, inject_tracer_source_template, s.c_str());
+  const char *gasTable =
+      tContext->gas_table != NULL ? tContext->gas_table : "undefined";
+  asprintf(&injectTracerSource, inject_tracer_source_template, s.c_str(),
+           gasTable);
 
   // Create a string containing the JavaScript source code.
   Local<String> src =
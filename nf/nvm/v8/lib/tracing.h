@@ -27,6 +27,8 @@ using namespace v8;
 typedef struct {
   int source_line_offset;
   char *tracable_source;
+  // JSON of the gas table, the defaults of instruction_counter.js if NULL.
+  const char *gas_table;
 } TracingContext;
 
 int InjectTracingInstructionDelegate(Isolate *isolate, const char *source,
@@ -21,28 +21,138 @@ var compilerOptions = {
     module: ts.ModuleKind.CommonJS,
 };
 
-function transpileModule(input) {
-    var ret = ts.transpileModule(input, {
-        compilerOptions: compilerOptions,
-        reportDiagnostics: true,
-        fileName: "_contract.ts",
-    });
+// the contract is type checked against these declarations only, instead of the default lib
+// and the environment of the node, so the result is the same on every node.
+var checkOptions = {
+    module: ts.ModuleKind.CommonJS,
+    target: ts.ScriptTarget.ES5,
+    noLib: true,
+    noEmit: true,
+    noResolve: true,
+};
+
+var contractFileName = "_contract.ts";
+var libFileName = "_nvm_lib.d.ts";
+
+var libSource = [
+    "interface Object { constructor: Function; toString(): string; hasOwnProperty(v: string): boolean; }",
+    "interface ObjectConstructor { (value?: any): any; keys(o: any): string[]; assign(target: any, ...sources: any[]): any; freeze<T>(o: T): T; defineProperty(o: any, p: string, attributes: any): any; }",
+    "declare var Object: ObjectConstructor;",
+    "interface Function { apply(thisArg: any, argArray?: any): any; call(thisArg: any, ...argArray: any[]): any; bind(thisArg: any, ...argArray: any[]): any; prototype: any; readonly length: number; }",
+    "declare var Function: { (...args: string[]): Function; prototype: Function; };",
+    "interface IArguments { [index: number]: any; length: number; }",
+    "interface Boolean { valueOf(): boolean; }",
+    "declare var Boolean: { (value?: any): boolean; };",
+    "interface Number { toString(radix?: number): string; toFixed(fractionDigits?: number): string; valueOf(): number; }",
+    "declare var Number: { (value?: any): number; isInteger(n: number): boolean; MAX_SAFE_INTEGER: number; MIN_SAFE_INTEGER: number; };",
+    "interface String { readonly length: number; [index: number]: string; charAt(pos: number): string; charCodeAt(index: number): number; concat(...strings: string[]): string; indexOf(s: string, position?: number): number; lastIndexOf(s: string, position?: number): number; match(regexp: string | RegExp): string[] | null; replace(searchValue: string | RegExp, replaceValue: string): string; search(regexp: string | RegExp): number; slice(start?: number, end?: number): string; split(separator: string | RegExp, limit?: number): string[]; substring(start: number, end?: number): string; substr(from: number, length?: number): string; toLowerCase(): string; toUpperCase(): string; trim(): string; startsWith(s: string, position?: number): boolean; endsWith(s: string, position?: number): boolean; }",
+    "declare var String: { (value?: any): string; fromCharCode(...codes: number[]): string; };",
+    "interface RegExp { test(string: string): boolean; exec(string: string): string[] | null; readonly source: string; }",
+    "declare var RegExp: { new (pattern: string | RegExp, flags?: string): RegExp; (pattern: string | RegExp, flags?: string): RegExp; };",
+    "interface Array<T> { length: number; [n: number]: T; toString(): string; push(...items: T[]): number; pop(): T | undefined; shift(): T | undefined; unshift(...items: T[]): number; concat(...items: (T | T[])[]): T[]; join(separator?: string): string; reverse(): T[]; slice(start?: number, end?: number): T[]; splice(start: number, deleteCount?: number, ...items: T[]): T[]; sort(compareFn?: (a: T, b: T) => number): this; indexOf(searchElement: T, fromIndex?: number): number; every(callbackfn: (value: T, index: number, array: T[]) => boolean): boolean; some(callbackfn: (value: T, index: number, array: T[]) => boolean): boolean; forEach(callbackfn: (value: T, index: number, array: T[]) => void): void; map<U>(callbackfn: (value: T, index: number, array: T[]) => U): U[]; filter(callbackfn: (value: T, index: number, array: T[]) => boolean): T[]; reduce<U>(callbackfn: (previousValue: U, currentValue: T, currentIndex: number, array: T[]) => U, initialValue: U): U; find(predicate: (value: T, index: number, obj: T[]) => boolean): T | undefined; }",
+    "declare var Array: { new <T>(...items: T[]): T[]; <T>(...items: T[]): T[]; isArray(arg: any): arg is any[]; };",
+    "interface TemplateStringsArray extends Array<string> { readonly raw: string[]; }",
+    "interface Error { name: string; message: string; stack?: string; }",
+    "declare var Error: { new (message?: string): Error; (message?: string): Error; };",
+    "declare var TypeError: { new (message?: string): Error; (message?: string): Error; };",
+    "declare var RangeError: { new (message?: string): Error; (message?: string): Error; };",
+    "declare var JSON: { parse(text: string): any; stringify(value: any, replacer?: any, space?: string | number): string; };",
+    "declare var Math: { abs(x: number): number; ceil(x: number): number; floor(x: number): number; round(x: number): number; max(...values: number[]): number; min(...values: number[]): number; pow(x: number, y: number): number; sqrt(x: number): number; };",
+    "declare function parseInt(s: string, radix?: number): number;",
+    "declare function parseFloat(string: string): number;",
+    "declare function isNaN(number: number): boolean;",
+    "declare var NaN: number;",
+    "declare var Infinity: number;",
+    "interface PropertyDescriptor { configurable?: boolean; enumerable?: boolean; value?: any; writable?: boolean; get?(): any; set?(v: any): void; }",
+    "interface TypedPropertyDescriptor<T> { enumerable?: boolean; configurable?: boolean; writable?: boolean; value?: T; get?: () => T; set?: (value: T) => void; }",
+    "declare var require: (id: string) => any;",
+    "declare var module: { exports: any };",
+    "declare var exports: any;",
+    "declare var console: { debug(...args: any[]): void; warn(...args: any[]): void; info(...args: any[]): void; log(...args: any[]): void; error(...args: any[]): void; };",
+    "declare var BigNumber: any;",
+    "type BigNumber = any;",
+    "declare var Decimal: any;",
+    "type Decimal = any;",
+    "declare var Blockchain: any;",
+    "declare var Event: any;",
+    "declare var LocalContractStorage: any;",
+    "declare var GlobalContractStorage: any;",
+    "declare var ContractStorage: any;",
+    "declare var StorageMap: any;",
+].join("\n");
 
-    if (ret.diagnostics.length > 0) {
-        ret.diagnostics.forEach(diagnostic => {
-            var message = ts.flattenDiagnosticMessageText(diagnostic.messageText, '\n');
+// checkModule reports the syntactic and semantic errors of the contract.
+function checkModule(input) {
+    var files = {};
+    files[contractFileName] = input;
+    files[libFileName] = libSource;
 
-            if (diagnostic.file) {
-                var {
-                    line,
-                    character
-                } = diagnostic.file.getLineAndCharacterOfPosition(diagnostic.start);
-                message = diagnostic.file.fileName + ":" + (line + 1) + ":" + (character + 1) + ": " + message;
+    var host = {
+        getSourceFile: function (fileName, languageVersion) {
+            if (files[fileName] === undefined) {
+                return undefined;
             }
-            throw new Error("fail to transpile TypeScript: " + message);
-        });
+            return ts.createSourceFile(fileName, files[fileName], languageVersion);
+        },
+        getDefaultLibFileName: function () {
+            return libFileName;
+        },
+        writeFile: function () {},
+        getCurrentDirectory: function () {
+            return "";
+        },
+        getDirectories: function () {
+            return [];
+        },
+        getCanonicalFileName: function (fileName) {
+            return fileName;
+        },
+        useCaseSensitiveFileNames: function () {
+            return true;
+        },
+        getNewLine: function () {
+            return "\n";
+        },
+        fileExists: function (fileName) {
+            return files[fileName] !== undefined;
+        },
+        readFile: function (fileName) {
+            return files[fileName];
+        },
+    };
+
+    var program = ts.createProgram([libFileName, contractFileName], checkOptions, host);
+    throwDiagnostics("fail to type check TypeScript: ", ts.getPreEmitDiagnostics(program));
+}
+
+function throwDiagnostics(prefix, diagnostics) {
+    diagnostics.forEach(diagnostic => {
+        var message = ts.flattenDiagnosticMessageText(diagnostic.messageText, '\n');
+
+        if (diagnostic.file) {
+            var {
+                line,
+                character
+            } = diagnostic.file.getLineAndCharacterOfPosition(diagnostic.start);
+            message = diagnostic.file.fileName + ":" + (line + 1) + ":" + (character + 1) + ": " + message;
+        }
+        throw new Error(prefix + message);
+    });
+}
+
+// transpileModule transpiles the contract to JavaScript, it's type checked first if typeCheck is true.
+function transpileModule(input, typeCheck) {
+    if (typeCheck) {
+        checkModule(input);
     }
 
+    var ret = ts.transpileModule(input, {
+        compilerOptions: compilerOptions,
+        reportDiagnostics: true,
+        fileName: contractFileName,
+    });
+    throwDiagnostics("fail to transpile TypeScript: ", ret.diagnostics);
+
     return {
         jsSource: ret.outputText,
         lineOffset: 0,
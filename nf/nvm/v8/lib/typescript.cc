@@ -29,7 +29,7 @@ static char ts_transpile_source_template[] =
     "(function(){\n"
     "const tsc = require(\"tsc.js\");\n"
     "const source = \"%s\";\n"
-    "return tsc.transpileModule(source);\n"
+    "return tsc.transpileModule(source, %s);\n"
     "})();";
 
 int TypeScriptTranspileDelegate(Isolate *isolate, const char *source,
@@ -46,7 +46,8 @@ int TypeScriptTranspileDelegate(Isolate *isolate, const char *source,
   s = ReplaceAll(s, "\"", "\\\"");
 
   char *runnableSource = NULL;
-  asprintf(&runnableSource, ts_transpile_source_template, s.c_str());
+  asprintf(&runnableSource, ts_transpile_source_template, s.c_str(),
+           tContext->type_check ? "true" : "false");
 
   // Create a string containing the JavaScript source code.
   Local<String> src =
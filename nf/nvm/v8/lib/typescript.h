@@ -26,6 +26,7 @@
 using namespace v8;
 
 typedef struct {
+  int type_check;
   int source_line_offset;
   char *js_source;
 } TypeScriptContext;
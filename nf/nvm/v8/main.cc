@@ -56,6 +56,12 @@ void eventTriggerFunc(void *handler, const char *topic, const char *data) {
   fprintf(stdout, "[Event] [%s] %s\n", topic, data);
 }
 
+int eventEmitFunc(void *handler, const char *name, const char *indexed,
+                  const char *data) {
+  fprintf(stdout, "[Event] [%s] %s %s\n", name, indexed, data);
+  return 0;
+}
+
 void help(const char *name) {
   printf("%s [-c <concurrency>] [-i] [-li <number>] [-lm <number>] <Javascript "
          "File>\n",
@@ -85,7 +91,8 @@ void RunScriptSourceDelegate(V8Engine *e, const char *data,
     e->limits_of_executed_instructions = limits_of_executed_instructions;
     e->limits_of_total_memory_size = limits_of_total_memory_size;
 
-    char *traceableSource = InjectTracingInstructions(e, data, &lineOffset);
+    char *traceableSource =
+        InjectTracingInstructions(e, data, NULL, &lineOffset);
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
     } else {
@@ -168,7 +175,7 @@ void ExecuteScript(const char *filename, V8ExecutionDelegate delegate) {
   if (filenameLen > 3 && filename[filenameLen - 3] == '.' &&
       filename[filenameLen - 2] == 't' && filename[filenameLen - 1] == 's') {
     size = 0;
-    char *jsSource = TranspileTypeScriptModule(e, source, &lineOffset);
+    char *jsSource = TranspileTypeScriptModule(e, source, 0, &lineOffset);
     if (jsSource == NULL) {
       fprintf(stderr, "%s is not a valid TypeScript file.\n", filename);
       free(source);
@@ -180,7 +187,8 @@ void ExecuteScript(const char *filename, V8ExecutionDelegate delegate) {
 
   // inject tracing code.
   if (enable_tracer_injection) {
-    char *traceableSource = InjectTracingInstructions(e, source, &lineOffset);
+    char *traceableSource =
+        InjectTracingInstructions(e, source, NULL, &lineOffset);
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
       free(source);
@@ -217,11 +225,14 @@ int main(int argc, const char *argv[]) {
   }
 
   Initialize();
-  InitializeLogger(logFunc);
+  InitializeLogger(logFunc, NULL);
   InitializeRequireDelegate(RequireDelegateFunc);
-  InitializeStorage(StorageGet, StoragePut, StorageDel);
-  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress);
-  InitializeEvent(eventTriggerFunc);
+  InitializeStorage(StorageGet, StoragePut, StorageDel, StorageKeys);
+  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress,
+                       RunContractSource, Random, GetBlockHash, SelfDestruct,
+                       RunPrecompile, RequestOracle, NonReentrant,
+                       DeployContract);
+  InitializeEvent(eventTriggerFunc, eventEmitFunc);
 
   int argcIdx = 1;
   const char *filename = NULL;
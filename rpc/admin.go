@@ -0,0 +1,173 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// MaxPendingTransactions is the most txs of the pool returned by GetPendingTransactions.
+const MaxPendingTransactions = 1000
+
+// errors
+var (
+	ErrAdminNotLocal = errors.New("admin service must listen on a loopback address")
+)
+
+// redacted is the value of the secrets of the config returned by GetConfig.
+const redacted = "<redacted>"
+
+// checkLocalListen check the address listens on a loopback interface only.
+func checkLocalListen(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return ErrAdminNotLocal
+	}
+	return nil
+}
+
+// runAdminGateway serve the admin service over http on the admin listen addresses.
+func runAdminGateway(ctx context.Context, config *nebletpb.RPCConfig) error {
+	mux := runtime.NewServeMux()
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if err := rpcpb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, config.AdminListen, opts); err != nil {
+		return err
+	}
+	for _, v := range config.AdminHttpListen {
+		if err := http.ListenAndServe(v, mux); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPendingTransactions return the txs of the pool, by sender and nonce.
+func (s *APIService) GetPendingTransactions(ctx context.Context, req *rpcpb.PendingTransactionsRequest) (*rpcpb.PendingTransactionsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"limit": req.Limit,
+		"api":   "/v1/admin/pool/pending",
+	}).Info("Rpc request.")
+
+	limit := int(req.Limit)
+	if limit == 0 || limit > MaxPendingTransactions {
+		limit = MaxPendingTransactions
+	}
+	pool := s.server.Neblet().BlockChain().TransactionPool()
+	all := pool.Pending(0)
+	resp := &rpcpb.PendingTransactionsResponse{Total: uint32(len(all))}
+	if len(all) > limit {
+		all = all[:limit]
+	}
+	for _, tx := range all {
+		resp.Transactions = append(resp.Transactions, toPendingTxResponse(tx))
+	}
+	return resp, nil
+}
+
+// FlushTransactionPool drop all the txs of the pool.
+func (s *APIService) FlushTransactionPool(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.FlushTransactionPoolResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/pool/flush",
+	}).Info("Rpc request.")
+
+	dropped := s.server.Neblet().BlockChain().TransactionPool().Flush()
+	logging.CLog().WithFields(logrus.Fields{
+		"dropped": dropped,
+	}).Warn("Flushed the transaction pool.")
+	return &rpcpb.FlushTransactionPoolResponse{Dropped: uint32(dropped)}, nil
+}
+
+// SetHead rewind the canonical chain to its block at the height.
+func (s *APIService) SetHead(ctx context.Context, req *rpcpb.SetHeadRequest) (*rpcpb.SetHeadResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"height": req.Height,
+		"api":    "/v1/admin/setHead",
+	}).Info("Rpc request.")
+
+	bc := s.server.Neblet().BlockChain()
+	if err := bc.SetHead(req.Height); err != nil {
+		return nil, err
+	}
+	tail := bc.TailBlock()
+	return &rpcpb.SetHeadResponse{Hash: tail.Hash().String(), Height: tail.Height()}, nil
+}
+
+// SetLogLevel change the level of the verbose log, return the current one if the level is empty.
+func (s *APIService) SetLogLevel(ctx context.Context, req *rpcpb.LogLevelRequest) (*rpcpb.LogLevelResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"level": req.Level,
+		"api":   "/v1/admin/logLevel",
+	}).Info("Rpc request.")
+
+	if len(req.Level) > 0 {
+		if err := logging.SetLevel(req.Level); err != nil {
+			return nil, err
+		}
+	}
+	return &rpcpb.LogLevelResponse{Level: logging.Level()}, nil
+}
+
+// GetConfig return the config of the node in the protobuf text format, the secrets redacted.
+func (s *APIService) GetConfig(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.ConfigResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/config",
+	}).Info("Rpc request.")
+
+	config := s.server.Neblet().Config()
+	config = *proto.Clone(&config).(*nebletpb.Config)
+	redactConfig(&config)
+	return &rpcpb.ConfigResponse{Config: proto.MarshalTextString(&config)}, nil
+}
+
+// redactConfig hide the passphrases, the network token and the api keys.
+func redactConfig(config *nebletpb.Config) {
+	if chain := config.Chain; chain != nil {
+		if len(chain.Passphrase) > 0 {
+			chain.Passphrase = redacted
+		}
+		if len(chain.BackupPassphrase) > 0 {
+			chain.BackupPassphrase = redacted
+		}
+	}
+	if network := config.Network; network != nil && len(network.NetworkToken) > 0 {
+		network.NetworkToken = redacted
+	}
+	if rpc := config.Rpc; rpc != nil {
+		for i := range rpc.ApiKeys {
+			rpc.ApiKeys[i] = redacted
+		}
+	}
+}
@@ -20,20 +20,31 @@ type APIServer struct {
 
 	rpcServer *grpc.Server
 
+	// adminServer serves the admin service apart on admin_listen, nil if it's served by rpcServer.
+	adminServer *grpc.Server
+
 	rpcConfig *nebletpb.RPCConfig
+
+	filters *filterManager
 }
 
 // NewAPIServer creates a new RPC server and registers the API endpoints.
 func NewAPIServer(neblet Neblet) *APIServer {
 	cfg := neblet.Config().Rpc
 
-	rpc := grpc.NewServer()
+	rpc := grpc.NewServer(grpc.UnaryInterceptor(relayInterceptor(neblet)), grpc.StreamInterceptor(relayStreamInterceptor(neblet)))
 
-	srv := &APIServer{neblet: neblet, rpcServer: rpc, rpcConfig: cfg}
-	api := &APIService{srv}
+	filters := newFilterManager(neblet, time.Duration(cfg.FilterTimeout)*time.Second, int(cfg.MaxFilters))
+	srv := &APIServer{neblet: neblet, rpcServer: rpc, rpcConfig: cfg, filters: filters}
+	api := &APIService{server: srv, filters: filters}
 
 	rpcpb.RegisterApiServiceServer(rpc, api)
-	rpcpb.RegisterAdminServiceServer(rpc, api)
+	if len(cfg.AdminListen) > 0 {
+		srv.adminServer = grpc.NewServer(grpc.UnaryInterceptor(relayInterceptor(neblet)), grpc.StreamInterceptor(relayStreamInterceptor(neblet)))
+		rpcpb.RegisterAdminServiceServer(srv.adminServer, api)
+	} else {
+		rpcpb.RegisterAdminServiceServer(rpc, api)
+	}
 	// Register reflection service on gRPC server.
 	// TODO: Enable reflection only for testing mode.
 	reflection.Register(rpc)
@@ -44,9 +55,26 @@ func NewAPIServer(neblet Neblet) *APIServer {
 // Start starts the rpc server and serves incoming requests.
 func (s *APIServer) Start() error {
 	logging.CLog().Info("Starting RPC Server")
+	if err := s.filters.setTrustedProxies(s.rpcConfig.TrustedProxies); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"proxies": s.rpcConfig.TrustedProxies,
+			"err":     err,
+		}).Error("Failed to start RPC Server")
+		return err
+	}
+	if s.adminServer != nil {
+		if err := checkLocalListen(s.rpcConfig.AdminListen); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"listen": s.rpcConfig.AdminListen,
+				"err":    err,
+			}).Error("Failed to start the admin RPC Server")
+			return err
+		}
+		go s.start(s.adminServer, s.rpcConfig.AdminListen)
+	}
 	if len(s.rpcConfig.RpcListen) > 0 {
 		for _, v := range s.rpcConfig.RpcListen {
-			err := s.start(v)
+			err := s.start(s.rpcServer, v)
 			if err != nil {
 				logging.CLog().WithFields(logrus.Fields{
 					"err": err,
@@ -64,7 +92,7 @@ func (s *APIServer) Start() error {
 	return nil
 }
 
-func (s *APIServer) start(addr string) error {
+func (s *APIServer) start(server *grpc.Server, addr string) error {
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -73,7 +101,7 @@ func (s *APIServer) start(addr string) error {
 		return err
 	}
 	logging.CLog().Info("Launched RPC server at: ", addr)
-	if err := s.rpcServer.Serve(listener); err != nil {
+	if err := server.Serve(listener); err != nil {
 		logging.CLog().WithFields(logrus.Fields{
 			"err": err,
 		}).Error("Failed to serve RPC Server")
@@ -86,11 +114,8 @@ func (s *APIServer) start(addr string) error {
 func (s *APIServer) RunGateway() error {
 	//todo make sure rpc server has run before gateway start.
 	time.Sleep(3 * time.Second)
-	rpcListen := s.rpcConfig.RpcListen[0]
-	gatewayListen := s.rpcConfig.HttpListen
-	httpModule := s.rpcConfig.HttpModule
-	logging.CLog().Info("Starting api gateway server bind rpc-server: ", rpcListen, " to:", gatewayListen)
-	if err := Run(rpcListen, gatewayListen, httpModule); err != nil {
+	logging.CLog().Info("Starting api gateway server bind rpc-server: ", s.rpcConfig.RpcListen[0], " to:", s.rpcConfig.HttpListen)
+	if err := Run(s.rpcConfig); err != nil {
 		logging.CLog().Error("RPC server gateway failed to serve: ", err)
 		return err
 	}
@@ -101,6 +126,10 @@ func (s *APIServer) RunGateway() error {
 func (s *APIServer) Stop() {
 	logging.CLog().Info("Stopping RPC server at: ", s.rpcConfig.RpcListen)
 	s.rpcServer.Stop()
+	if s.adminServer != nil {
+		s.adminServer.Stop()
+	}
+	s.filters.stop()
 }
 
 // Neblet returns weak reference to Neblet.
@@ -22,7 +22,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto/hash"
 	nnet "github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -40,9 +43,14 @@ import (
 	"golang.org/x/net/context"
 )
 
+// MaxLogsBlockRange is the max number of blocks searched by a page of GetLogs, GetEvents, GetTokenTransfers and GetTransactionsByAddress.
+const MaxLogsBlockRange = 1000
+
 // APIService implements the RPC API service interface.
 type APIService struct {
 	server Server
+
+	filters *filterManager
 }
 
 // GetNebState is the RPC API handler.
@@ -150,6 +158,7 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 	logging.VLog().WithFields(logrus.Fields{
 		"address": req.Address,
 		"block":   req.Block,
+		"height":  req.Height,
 		"api":     "/v1/user/accountstate",
 	}).Info("Rpc request.")
 
@@ -160,22 +169,27 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 		return nil, err
 	}
 
-	block := neb.BlockChain().TailBlock()
-	if len(req.Block) > 0 {
-		blockHash, err := byteutils.FromHex(req.Block)
-		if err != nil {
-			return nil, err
-		}
-		block = neb.BlockChain().GetBlock(blockHash)
-		if block == nil {
-			return nil, errors.New("block hash not found")
-		}
+	block, err := stateAt(neb.BlockChain(), req.Block, req.Height)
+	if err != nil {
+		return nil, err
 	}
 
 	balance := block.GetBalance(addr.Bytes())
 	nonce := block.GetNonce(addr.Bytes())
 
-	return &rpcpb.GetAccountStateResponse{Balance: balance.String(), Nonce: fmt.Sprintf("%d", nonce)}, nil
+	return &rpcpb.GetAccountStateResponse{Balance: balance.String(), Nonce: fmt.Sprintf("%d", nonce), Height: block.Height()}, nil
+}
+
+// stateAt return the block whose state is queried, of the hex hash if given, else at the height if not 0, else the tail.
+func stateAt(bc *core.BlockChain, block string, height uint64) (*core.Block, error) {
+	var blockHash byteutils.Hash
+	if len(block) > 0 {
+		var err error
+		if blockHash, err = byteutils.FromHex(block); err != nil {
+			return nil, err
+		}
+	}
+	return bc.StateAt(blockHash, height)
 }
 
 // GetDynasty is the RPC API handler.
@@ -225,7 +239,8 @@ func (s *APIService) GetDelegateVoters(ctx context.Context, req *rpcpb.GetDelega
 		return nil, err
 	}
 	for exist {
-		voter := byteutils.Hex(iter.Value())
+		delegator, _, _ := core.ParseDelegation(iter.Value())
+		voter := byteutils.Hex(delegator)
 		voters = append(voters, voter)
 		exist, err = iter.Next()
 		if err != nil {
@@ -244,13 +259,39 @@ func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.Transaction
 	return s.sendTransaction(req)
 }
 
-// Call is the RPC API handler.
-func (s *APIService) Call(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+// Call is the RPC API handler, it runs the contract function against the state of the block
+// without sending a transaction, so the nonce, signature and balance are not required.
+func (s *APIService) Call(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.CallResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/call",
+		"block":  req.Block,
+		"height": req.Height,
+		"api":    "/v1/user/call",
 	}).Info("Rpc request.")
 
-	return s.sendTransaction(req)
+	neb := s.server.Neblet()
+	block, err := stateAt(neb.BlockChain(), req.Block, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := parseTransaction(neb, req)
+	if err != nil {
+		return nil, err
+	}
+	var result *core.SimulateResult
+	if req.Profile {
+		result, err = block.ProfileCall(tx)
+	} else {
+		result, err = block.SimulateCall(tx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.CallResponse{Result: result.Result, EstimateGas: result.GasUsed.String(), Profile: toGasProfile(result.Profile), Height: block.Height()}
+	if result.Err != nil {
+		resp.ExecuteErr = result.Err.Error()
+	}
+	return resp, nil
 }
 
 func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
@@ -300,18 +341,44 @@ func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transa
 		payloadType string
 		payload     []byte
 	)
-	if reqTx.Contract != nil && len(reqTx.Contract.Source) > 0 {
+	if reqTx.Contract != nil && len(reqTx.Contract.Source) > 0 && reqTx.Contract.Upgrade {
+		payloadType = core.TxPayloadUpgradeType
+		payload, err = core.NewUpgradePayload(reqTx.Contract.Source, reqTx.Contract.SourceType).ToBytes()
+	} else if reqTx.Contract != nil && len(reqTx.Contract.Source) > 0 {
 		payloadType = core.TxPayloadDeployType
-		payload, err = core.NewDeployPayload(reqTx.Contract.Source, reqTx.Contract.SourceType, reqTx.Contract.Args).ToBytes()
+		deploy := core.NewDeployPayload(reqTx.Contract.Source, reqTx.Contract.SourceType, reqTx.Contract.Args)
+		deploy.Admin = reqTx.Contract.Admin
+		payload, err = deploy.ToBytes()
 	} else if reqTx.Contract != nil && len(reqTx.Contract.Function) > 0 {
 		payloadType = core.TxPayloadCallType
 		payload, err = core.NewCallPayload(reqTx.Contract.Function, reqTx.Contract.Args).ToBytes()
 	} else if reqTx.Candidate != nil {
 		payloadType = core.TxPayloadCandidateType
-		payload, err = core.NewCandidatePayload(reqTx.Candidate.Action).ToBytes()
+		candidate := core.NewCandidatePayload(reqTx.Candidate.Action)
+		if reqTx.Candidate.Action == core.CommissionAction || reqTx.Candidate.Commission > 0 {
+			candidate = core.NewCandidateCommissionPayload(reqTx.Candidate.Action, reqTx.Candidate.Commission)
+		}
+		if reqTx.Candidate.Action == core.SignerAction {
+			candidate = core.NewCandidateSignerPayload(reqTx.Candidate.Signer)
+		}
+		payload, err = candidate.ToBytes()
 	} else if reqTx.Delegate != nil {
 		payloadType = core.TxPayloadDelegateType
-		payload, err = core.NewDelegatePayload(reqTx.Delegate.Action, reqTx.Delegate.Delegatee).ToBytes()
+		delegate := core.NewDelegatePayload(reqTx.Delegate.Action, reqTx.Delegate.Delegatee)
+		for _, v := range reqTx.Delegate.Delegatees {
+			delegate.Delegatees = append(delegate.Delegatees, &core.DelegateWeight{Delegatee: v.Delegatee, Weight: v.Weight})
+		}
+		payload, err = delegate.ToBytes()
+	} else if reqTx.Oracle != nil {
+		payloadType = core.TxPayloadOracleType
+		payload, err = core.NewOraclePayload(reqTx.Oracle.Request, reqTx.Oracle.Answer).ToBytes()
+	} else if len(reqTx.Calls) > 0 {
+		payloadType = core.TxPayloadMultiCallType
+		calls := []*core.ContractCall{}
+		for _, v := range reqTx.Calls {
+			calls = append(calls, &core.ContractCall{Contract: v.Contract, Function: v.Function, Args: v.Args, Value: v.Value})
+		}
+		payload, err = core.NewMultiCallPayload(calls).ToBytes()
 	} else {
 		payloadType = core.TxPayloadBinaryType
 	}
@@ -332,12 +399,8 @@ func (s *APIService) SendRawTransaction(ctx context.Context, req *rpcpb.SendRawT
 	// Validate and sign the tx, then submit it to the tx pool.
 	neb := s.server.Neblet()
 
-	pbTx := new(corepb.Transaction)
-	if err := proto.Unmarshal(req.GetData(), pbTx); err != nil {
-		return nil, err
-	}
-	tx := new(core.Transaction)
-	if err := tx.FromProto(pbTx); err != nil {
+	tx, err := decodeRawTransaction(req)
+	if err != nil {
 		return nil, err
 	}
 
@@ -393,6 +456,10 @@ func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTr
 		"api":  "/v1/user/getTransactionReceipt",
 	}).Info("Rpc request.")
 
+	p, err := newProjection(&rpcpb.TransactionReceiptResponse{}, req.Fields)
+	if err != nil {
+		return nil, err
+	}
 	neb := s.server.Neblet()
 	bhash, _ := byteutils.FromHex(req.GetHash())
 	tx := neb.BlockChain().GetTransaction(bhash)
@@ -420,6 +487,34 @@ func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTr
 		}
 		receipt.ContractAddress = contractAddr.String()
 	}
+	// skip the fetches of the fields not asked for, they are the heavy part of the receipt.
+	if p.has("logs") {
+		logs, err := neb.BlockChain().TailBlock().FetchLogs(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range logs {
+			receipt.Logs = append(receipt.Logs, &rpcpb.ContractLog{
+				Address: v.Address,
+				Topics:  v.Topics,
+				Data:    v.Data,
+				TxHash:  receipt.Hash,
+			})
+		}
+	}
+	if p.has("state_diffs") {
+		diffs, err := neb.BlockChain().TailBlock().FetchStateDiffs(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		receipt.StateDiffs = toStateDiffs(diffs)
+	}
+	if p.has("execute_error") {
+		if receipt.ExecuteError, err = neb.BlockChain().TailBlock().FetchExecutionError(tx.Hash()); err != nil {
+			return nil, err
+		}
+	}
+	p.apply(receipt)
 	return receipt, nil
 }
 
@@ -540,6 +635,19 @@ func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_
 		}
 	})()
 
+	// replay the kept events after registering, so that no event is missed between them.
+	if store := neb.BlockChain().EventStore(); req.FromHeight > 0 && store != nil {
+		events, err := store.Replay(req.FromHeight, 0, req.Topic)
+		if err != nil {
+			return err
+		}
+		for _, v := range events {
+			if err := gs.Send(&rpcpb.SubscribeResponse{MsgType: v.Topic, Data: v.Data, Height: v.Height, BlockHash: v.BlockHash}); err != nil {
+				return err
+			}
+		}
+	}
+
 	netEventCh := make(chan nnet.Message, 128)
 	net := neb.NetManager()
 	net.Register(nnet.NewSubscriber(s, netEventCh, core.MessageTypeNewBlock))
@@ -590,6 +698,96 @@ func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_
 	}
 }
 
+// SubscribeNewBlock stream the blocks linked to the canonical chain and reverted from it.
+func (s *APIService) SubscribeNewBlock(req *rpcpb.NonParamsRequest, gs rpcpb.ApiService_SubscribeNewBlockServer) error {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "SubscribeNewBlock",
+	}).Info("Rpc request.")
+
+	bc := s.server.Neblet().BlockChain()
+	ch := make(chan *core.ChainHeadEvent, 128)
+	bc.SubscribeChainHead(ch)
+	defer bc.UnsubscribeChainHead(ch)
+
+	for {
+		select {
+		case <-gs.Context().Done():
+			return gs.Context().Err()
+		case e := <-ch:
+			if err := gs.Send(toNewBlockResponse(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeEvents stream the chain events of the topics, the kept ones from the height replayed first.
+func (s *APIService) SubscribeEvents(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_SubscribeEventsServer) error {
+	logging.VLog().WithFields(logrus.Fields{
+		"topic": req.Topic,
+		"api":   "SubscribeEvents",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+	ch := make(chan *core.Event, 128)
+	emitter := neb.EventEmitter()
+	for _, v := range req.Topic {
+		emitter.Register(v, ch)
+	}
+	defer (func() {
+		for _, v := range req.Topic {
+			emitter.Deregister(v, ch)
+		}
+	})()
+
+	// replay the kept events after registering, so that no event is missed between them.
+	if store := neb.BlockChain().EventStore(); req.FromHeight > 0 && store != nil {
+		events, err := store.Replay(req.FromHeight, 0, req.Topic)
+		if err != nil {
+			return err
+		}
+		for _, v := range events {
+			if err := gs.Send(&rpcpb.SubscribeResponse{MsgType: v.Topic, Data: v.Data, Height: v.Height, BlockHash: v.BlockHash}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-gs.Context().Done():
+			return gs.Context().Err()
+		case e := <-ch:
+			if err := gs.Send(&rpcpb.SubscribeResponse{MsgType: e.Topic, Data: e.Data}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribePendingTx stream the transactions entering the pool.
+func (s *APIService) SubscribePendingTx(req *rpcpb.NonParamsRequest, gs rpcpb.ApiService_SubscribePendingTxServer) error {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "SubscribePendingTx",
+	}).Info("Rpc request.")
+
+	pool := s.server.Neblet().BlockChain().TransactionPool()
+	ch := make(chan *core.Transaction, 1024)
+	pool.SubscribePending(ch)
+	defer pool.UnsubscribePending(ch)
+
+	for {
+		select {
+		case <-gs.Context().Done():
+			return gs.Context().Err()
+		case tx := <-ch:
+			if err := gs.Send(toPendingTxResponse(tx)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // GetGasPrice get gas price from chain.
 func (s *APIService) GetGasPrice(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GasPriceResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
@@ -621,11 +819,39 @@ func (s *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequ
 	if err != nil {
 		return nil, err
 	}
-	estimateGas, err := neb.BlockChain().EstimateGas(tx)
+	var result *core.SimulateResult
+	if req.Profile {
+		result, err = neb.BlockChain().ProfileTransaction(tx)
+	} else {
+		result, err = neb.BlockChain().SimulateTransaction(tx)
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.EstimateGasResponse{EstimateGas: estimateGas.String()}, nil
+	resp := &rpcpb.EstimateGasResponse{
+		EstimateGas: result.GasUsed.String(),
+		GasLimit:    result.GasLimit.String(),
+		Result:      result.Result,
+		Profile:     toGasProfile(result.Profile),
+	}
+	if result.Err != nil {
+		resp.ExecuteErr = result.Err.Error()
+	}
+	return resp, nil
+}
+
+func toGasProfile(profile []*nvm.GasProfileEntry) []*rpcpb.GasProfileEntry {
+	var entries []*rpcpb.GasProfileEntry
+	for _, v := range profile {
+		entries = append(entries, &rpcpb.GasProfileEntry{
+			Contract: v.Contract,
+			Function: v.Function,
+			Op:       v.Op,
+			Gas:      v.Gas,
+			Count:    v.Count,
+		})
+	}
+	return entries
 }
 
 // GetEventsByHash return events by tx hash.
@@ -658,6 +884,486 @@ func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.GetTransact
 
 }
 
+// GetLogs return a page of the contract logs matching the filter on the canonical chain, the oldest first.
+func (s *APIService) GetLogs(ctx context.Context, req *rpcpb.GetLogsRequest) (*rpcpb.GetLogsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"from":   req.FromHeight,
+		"to":     req.ToHeight,
+		"cursor": req.Cursor,
+		"api":    "/v1/user/getLogs",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+	p, err := walkPage(neb.BlockChain(), req.FromHeight, req.ToHeight, req.Cursor, req.Limit, func(block *core.Block) ([]interface{}, error) {
+		if !block.Bloom().MayMatch(req.Address, req.Topics) {
+			return nil, nil
+		}
+		var matched []interface{}
+		for _, tx := range block.Transactions() {
+			result, err := block.FetchLogs(tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range result {
+				if !core.MatchLog(v, req.Address, req.Topics) {
+					continue
+				}
+				matched = append(matched, &rpcpb.ContractLog{
+					Address:     v.Address,
+					Topics:      v.Topics,
+					Data:        v.Data,
+					TxHash:      tx.Hash().String(),
+					BlockHash:   block.Hash().String(),
+					BlockHeight: block.Height(),
+				})
+			}
+		}
+		return matched, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetLogsResponse{Logs: []*rpcpb.ContractLog{}, NextCursor: p.next, Reorged: p.reorged}
+	for _, v := range p.items {
+		resp.Logs = append(resp.Logs, v.(*rpcpb.ContractLog))
+	}
+	return resp, nil
+}
+
+// GetEvents return a page of the chain events of the transactions matching the filter on the canonical chain, the oldest first.
+func (s *APIService) GetEvents(ctx context.Context, req *rpcpb.GetEventsRequest) (*rpcpb.GetEventsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"from":   req.FromHeight,
+		"to":     req.ToHeight,
+		"topics": req.Topics,
+		"cursor": req.Cursor,
+		"api":    "/v1/user/getEvents",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+	var addr *core.Address
+	if len(req.Address) > 0 {
+		var err error
+		if addr, err = core.AddressParse(req.Address); err != nil {
+			return nil, err
+		}
+	}
+	topics := make(map[string]bool)
+	for _, v := range req.Topics {
+		topics[v] = true
+	}
+	p, err := walkPage(neb.BlockChain(), req.FromHeight, req.ToHeight, req.Cursor, req.Limit, func(block *core.Block) ([]interface{}, error) {
+		var matched []interface{}
+		for _, tx := range block.Transactions() {
+			if addr != nil && !tx.From().Equals(addr) && !tx.To().Equals(addr) {
+				continue
+			}
+			events, err := block.FetchEvents(tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range events {
+				if len(topics) > 0 && !topics[v.Topic] {
+					continue
+				}
+				matched = append(matched, &rpcpb.ChainEvent{
+					Topic:       v.Topic,
+					Data:        v.Data,
+					TxHash:      tx.Hash().String(),
+					BlockHash:   block.Hash().String(),
+					BlockHeight: block.Height(),
+				})
+			}
+		}
+		return matched, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetEventsResponse{Events: []*rpcpb.ChainEvent{}, NextCursor: p.next, Reorged: p.reorged}
+	for _, v := range p.items {
+		resp.Events = append(resp.Events, v.(*rpcpb.ChainEvent))
+	}
+	return resp, nil
+}
+
+// GetTokenTransfers return a page of the NRC20 and NRC721 token transfers matching the filter on the canonical chain, the oldest first.
+func (s *APIService) GetTokenTransfers(ctx context.Context, req *rpcpb.GetTokenTransfersRequest) (*rpcpb.GetTokenTransfersResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"from":   req.FromHeight,
+		"to":     req.ToHeight,
+		"cursor": req.Cursor,
+		"api":    "/v1/user/getTokenTransfers",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+	p, err := walkPage(neb.BlockChain(), req.FromHeight, req.ToHeight, req.Cursor, req.Limit, func(block *core.Block) ([]interface{}, error) {
+		if !block.Bloom().MayMatch(req.Contract, []string{core.TokenTransferLog, req.Address}) {
+			return nil, nil
+		}
+		var matched []interface{}
+		for _, tx := range block.Transactions() {
+			result, err := block.FetchTokenTransfers(tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range result {
+				if len(req.Contract) > 0 && v.Contract != req.Contract {
+					continue
+				}
+				if len(req.Address) > 0 && v.From != req.Address && v.To != req.Address {
+					continue
+				}
+				matched = append(matched, &rpcpb.TokenTransfer{
+					Contract:    v.Contract,
+					From:        v.From,
+					To:          v.To,
+					Value:       v.Value,
+					TokenId:     v.TokenID,
+					TxHash:      tx.Hash().String(),
+					BlockHash:   block.Hash().String(),
+					BlockHeight: block.Height(),
+				})
+			}
+		}
+		return matched, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetTokenTransfersResponse{Transfers: []*rpcpb.TokenTransfer{}, NextCursor: p.next, Reorged: p.reorged}
+	for _, v := range p.items {
+		resp.Transfers = append(resp.Transfers, v.(*rpcpb.TokenTransfer))
+	}
+	return resp, nil
+}
+
+// GetTransactionsByAddress return a page of the transactions sent or received by the address on the canonical chain, the oldest first.
+func (s *APIService) GetTransactionsByAddress(ctx context.Context, req *rpcpb.GetTransactionsByAddressRequest) (*rpcpb.GetTransactionsByAddressResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address": req.Address,
+		"from":    req.FromHeight,
+		"to":      req.ToHeight,
+		"cursor":  req.Cursor,
+		"api":     "/v1/user/getTransactionsByAddress",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	p, err := walkPage(neb.BlockChain(), req.FromHeight, req.ToHeight, req.Cursor, req.Limit, func(block *core.Block) ([]interface{}, error) {
+		var matched []interface{}
+		for _, tx := range block.Transactions() {
+			if !tx.From().Equals(addr) && !tx.To().Equals(addr) {
+				continue
+			}
+			matched = append(matched, &rpcpb.AddressTransaction{
+				Hash:        tx.Hash().String(),
+				From:        tx.From().String(),
+				To:          tx.To().String(),
+				Value:       tx.Value().String(),
+				Nonce:       tx.Nonce(),
+				Timestamp:   tx.Timestamp(),
+				Type:        tx.Type(),
+				BlockHash:   block.Hash().String(),
+				BlockHeight: block.Height(),
+			})
+		}
+		return matched, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetTransactionsByAddressResponse{Transactions: []*rpcpb.AddressTransaction{}, NextCursor: p.next, Reorged: p.reorged}
+	for _, v := range p.items {
+		resp.Transactions = append(resp.Transactions, v.(*rpcpb.AddressTransaction))
+	}
+	return resp, nil
+}
+
+// NewFilter install a filter of the client, its changes are kept until polled by GetFilterChanges.
+func (s *APIService) NewFilter(ctx context.Context, req *rpcpb.NewFilterRequest) (*rpcpb.NewFilterResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"type":   req.Type,
+		"topics": req.Topics,
+		"api":    "/v1/user/newFilter",
+	}).Info("Rpc request.")
+
+	id, err := s.filters.install(s.filters.client(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.NewFilterResponse{Id: id}, nil
+}
+
+// GetFilterChanges return the changes of the filter since the last poll.
+func (s *APIService) GetFilterChanges(ctx context.Context, req *rpcpb.FilterRequest) (*rpcpb.FilterChangesResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"id":  req.Id,
+		"api": "/v1/user/getFilterChanges",
+	}).Info("Rpc request.")
+
+	return s.filters.poll(s.filters.client(ctx), req.Id)
+}
+
+// UninstallFilter remove the filter of the client.
+func (s *APIService) UninstallFilter(ctx context.Context, req *rpcpb.FilterRequest) (*rpcpb.UninstallFilterResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"id":  req.Id,
+		"api": "/v1/user/uninstallFilter",
+	}).Info("Rpc request.")
+
+	return &rpcpb.UninstallFilterResponse{Result: s.filters.uninstall(s.filters.client(ctx), req.Id)}, nil
+}
+
+// GetTokenBalance return the balance of the account in the NRC20 token at the tail block.
+func (s *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetTokenBalanceResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"contract": req.Contract,
+		"address":  req.Address,
+		"api":      "/v1/user/getTokenBalance",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+
+	contract, err := core.AddressParse(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block, err := stateAt(neb.BlockChain(), req.Block, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := block.GetTokenBalance(contract, owner)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetTokenBalanceResponse{
+		Name:     balance.Name,
+		Symbol:   balance.Symbol,
+		Decimals: balance.Decimals,
+		Balance:  balance.Balance,
+	}, nil
+}
+
+// GetTokenHoldings return the ids of the tokens owned by the account in the NRC721 token at the tail block.
+func (s *APIService) GetTokenHoldings(ctx context.Context, req *rpcpb.GetTokenHoldingsRequest) (*rpcpb.GetTokenHoldingsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"contract": req.Contract,
+		"address":  req.Address,
+		"api":      "/v1/user/getTokenHoldings",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+
+	contract, err := core.AddressParse(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block, err := stateAt(neb.BlockChain(), req.Block, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	holdings, err := block.GetTokenHoldings(contract, owner)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetTokenHoldingsResponse{
+		Name:     holdings.Name,
+		Symbol:   holdings.Symbol,
+		TokenIds: holdings.TokenIDs,
+	}, nil
+}
+
+// GetContractAbi return the ABI of the contract generated when it was deployed.
+func (s *APIService) GetContractAbi(ctx context.Context, req *rpcpb.GetContractAbiRequest) (*rpcpb.GetContractAbiResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address": req.Address,
+		"api":     "/v1/user/getContractAbi",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	hash, abi, err := neb.BlockChain().TailBlock().GetContractABI(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	functions := []*rpcpb.AbiFunction{}
+	for _, fn := range abi.Functions {
+		args := []*rpcpb.AbiArg{}
+		for _, arg := range fn.Args {
+			args = append(args, &rpcpb.AbiArg{Name: arg.Name, Type: arg.Type})
+		}
+		functions = append(functions, &rpcpb.AbiFunction{Name: fn.Name, Args: args, Payable: fn.Payable})
+	}
+	return &rpcpb.GetContractAbiResponse{Hash: hash.String(), Functions: functions}, nil
+}
+
+// VerifyContract checks the source and deploy args match the contract code on chain, and keeps the verification.
+func (s *APIService) VerifyContract(ctx context.Context, req *rpcpb.VerifyContractRequest) (*rpcpb.ContractVerificationResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address": req.Address,
+		"api":     "/v1/user/verifyContract",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	verification, err := neb.BlockChain().VerifyContractSource(addr.Bytes(), req.Source, req.SourceType, req.Args)
+	if err != nil {
+		return nil, err
+	}
+	return toContractVerification(verification), nil
+}
+
+// GetContractVerification return the verified source of the contract.
+func (s *APIService) GetContractVerification(ctx context.Context, req *rpcpb.GetContractAbiRequest) (*rpcpb.ContractVerificationResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address": req.Address,
+		"api":     "/v1/user/getContractVerification",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	verification, err := neb.BlockChain().GetContractVerification(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return toContractVerification(verification), nil
+}
+
+func toContractVerification(verification *core.ContractVerification) *rpcpb.ContractVerificationResponse {
+	if verification == nil {
+		return &rpcpb.ContractVerificationResponse{}
+	}
+	resp := &rpcpb.ContractVerificationResponse{
+		Verified:   true,
+		CodeHash:   verification.CodeHash,
+		Source:     verification.Source,
+		SourceType: verification.SourceType,
+		Args:       verification.Args,
+		Height:     verification.Height,
+	}
+	names := []string{}
+	for name := range verification.Libraries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resp.Libraries = append(resp.Libraries, &rpcpb.LinkedLibrary{Name: name, Address: verification.Libraries[name]})
+	}
+	return resp
+}
+
+// GetConsensusState return the state of the dpos consensus.
+func (s *APIService) GetConsensusState(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GetConsensusStateResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/user/consensusState",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+	tail := neb.BlockChain().TailBlock()
+
+	// the current slot never goes before the tail.
+	now := time.Now().Unix()
+	slot := now - now%neb.BlockChain().BlockIntervalAt(now)
+	if slot < tail.Timestamp() {
+		slot = tail.Timestamp()
+	}
+	dynastyContext, err := tail.NextDynastyContext(slot - tail.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+	nextDynastyContext, err := tail.NextDynastyContext(slot + tail.BlockIntervalAt(slot) - tail.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+
+	delegatees, err := core.TraverseDynasty(dynastyContext.DynastyTrie)
+	if err != nil {
+		return nil, err
+	}
+	dynasty := []string{}
+	for _, v := range delegatees {
+		dynasty = append(dynasty, v.String())
+	}
+
+	votes, err := dynastyContext.TallyVotes()
+	if err != nil {
+		return nil, err
+	}
+	candidates := core.Candidates{}
+	for k, v := range votes {
+		addr, err := core.AddressParse(k)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, &core.Candidate{Address: addr, Votes: v})
+	}
+	sort.Sort(candidates)
+	delegateVotes := []*rpcpb.DelegateVotes{}
+	for _, v := range candidates {
+		delegateVotes = append(delegateVotes, &rpcpb.DelegateVotes{Delegatee: v.Address.String(), Votes: v.Votes.String()})
+	}
+
+	resp := &rpcpb.GetConsensusStateResponse{}
+	resp.DynastyId = dynastyContext.TimeStamp / core.DynastyInterval
+	resp.Dynasty = dynasty
+	resp.Proposer = dynastyContext.Proposer.String()
+	resp.Timestamp = dynastyContext.TimeStamp
+	resp.NextProposer = nextDynastyContext.Proposer.String()
+	resp.NextTimestamp = nextDynastyContext.TimeStamp
+	resp.Votes = delegateVotes
+	resp.IsMining = neb.Consensus().CanMining()
+	resp.Miner = neb.Config().Chain.Miner
+	return resp, nil
+}
+
+// GetSyncState return the progress of the sync.
+func (s *APIService) GetSyncState(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.SyncStateResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/user/syncState",
+	}).Info("Rpc request.")
+
+	progress := s.server.Neblet().SyncManager().Progress()
+	return &rpcpb.SyncStateResponse{
+		Syncing:        progress.Syncing,
+		Stage:          progress.Stage,
+		StartingHeight: progress.StartingHeight,
+		CurrentHeight:  progress.CurrentHeight,
+		HighestHeight:  progress.HighestHeight,
+		DownloadRate:   progress.DownloadRate,
+		VerifyRate:     progress.VerifyRate,
+		Eta:            int64(progress.ETA / time.Second),
+	}, nil
+}
+
 // ChangeNetworkID change the network id
 func (s *APIService) ChangeNetworkID(ctx context.Context, req *rpcpb.ChangeNetworkIDRequest) (*rpcpb.ChangeNetworkIDResponse, error) {
 	logging.VLog().WithFields(logrus.Fields{
@@ -670,3 +1376,244 @@ func (s *APIService) ChangeNetworkID(ctx context.Context, req *rpcpb.ChangeNetwo
 	neb.NetManager().BroadcastNetworkID(byteutils.FromUint32(req.NetworkId))
 	return &rpcpb.ChangeNetworkIDResponse{Result: true}, nil
 }
+
+// TraceTransaction re-executes the contract transaction in its block and returns the steps of the contracts
+func (s *APIService) TraceTransaction(ctx context.Context, req *rpcpb.TraceTransactionRequest) (*rpcpb.TraceTransactionResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"block": req.Block,
+		"hash":  req.Hash,
+		"api":   "/v1/admin/traceTransaction",
+	}).Info("Rpc request.")
+
+	txHash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	neb := s.server.Neblet()
+	var blockHash byteutils.Hash
+	if len(req.Block) > 0 {
+		if blockHash, err = byteutils.FromHex(req.Block); err != nil {
+			return nil, err
+		}
+	} else {
+		block, err := neb.BlockChain().FindTransactionBlock(txHash)
+		if err != nil {
+			return nil, err
+		}
+		blockHash = block.Hash()
+	}
+
+	result, err := neb.BlockChain().TraceTransaction(blockHash, txHash)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.TraceTransactionResponse{GasUsed: result.GasUsed.String(), Block: blockHash.String(), Events: []*rpcpb.Event{}}
+	for _, v := range result.Steps {
+		resp.Steps = append(resp.Steps, &rpcpb.TraceStep{
+			Contract: v.Contract,
+			Op:       v.Op,
+			Line:     uint32(v.Line),
+			Gas:      v.Gas,
+			Key:      v.Key,
+			Value:    v.Value,
+			Function: v.Function,
+		})
+	}
+	if result.Err != nil {
+		resp.ExecuteErr = result.Err.Error()
+	}
+	resp.StateDiffs = toStateDiffs(result.StateDiffs)
+	for _, v := range result.Events {
+		resp.Events = append(resp.Events, &rpcpb.Event{Topic: v.Topic, Data: v.Data})
+	}
+	return resp, nil
+}
+
+// GetPeerScores return the scores of the penalized peers
+func (s *APIService) GetPeerScores(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.PeerScoresResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/peerScores",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+	resp := &rpcpb.PeerScoresResponse{}
+	for _, v := range neb.NetManager().Node().PeerScores() {
+		resp.Peers = append(resp.Peers, toPeerScore(v))
+	}
+	return resp, nil
+}
+
+func toPeerScore(v *p2p.PeerScore) *rpcpb.PeerScore {
+	score := &rpcpb.PeerScore{
+		Id:                 v.ID,
+		Score:              v.Score,
+		InvalidMessages:    v.InvalidMessages,
+		UselessBlocks:      v.UselessBlocks,
+		Timeouts:           v.Timeouts,
+		ProtocolViolations: v.ProtocolViolations,
+		LastMisbehavior:    v.LastMisbehavior.String(),
+		LastReported:       v.LastReported.Unix(),
+	}
+	if !v.BannedUntil.IsZero() {
+		score.BannedUntil = v.BannedUntil.Unix()
+	}
+	return score
+}
+
+// GetPeerStats return the statistics of the connected peers
+func (s *APIService) GetPeerStats(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.PeerStatsResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/peerStats",
+	}).Info("Rpc request.")
+
+	neb := s.server.Neblet()
+	resp := &rpcpb.PeerStatsResponse{}
+	for _, v := range neb.NetManager().Node().PeerStats() {
+		stats := &rpcpb.PeerStats{
+			Id:                 v.ID,
+			Address:            v.Addr,
+			ClientVersion:      v.ClientVersion,
+			ConnectionDuration: int64(time.Since(v.ConnectedAt).Seconds()),
+			HeadHeight:         v.HeadHeight,
+			HeadHash:           v.HeadHash,
+			Latency:            int64(v.Latency / time.Millisecond),
+			BytesIn:            v.BytesIn,
+			BytesOut:           v.BytesOut,
+			Outbound:           v.Outbound,
+			ProtocolVersion:    v.ProtocolVersion,
+		}
+		if v.Score != nil {
+			stats.Score = toPeerScore(v.Score)
+		}
+		resp.Peers = append(resp.Peers, stats)
+	}
+	return resp, nil
+}
+
+// AddPeer add a static or trusted peer
+func (s *APIService) AddPeer(ctx context.Context, req *rpcpb.AddPeerRequest) (*rpcpb.ChangePeerResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"address": req.Address,
+		"static":  req.Static,
+		"trusted": req.Trusted,
+		"api":     "/v1/admin/peer/add",
+	}).Info("Rpc request.")
+
+	if !req.Static && !req.Trusted {
+		return nil, errors.New("the peer should be static or trusted")
+	}
+
+	node := s.server.Neblet().NetManager().Node()
+	if req.Static {
+		if err := node.AddStaticPeer(req.Address); err != nil {
+			return nil, err
+		}
+	}
+	if req.Trusted {
+		if err := node.AddTrustedPeer(req.Address); err != nil {
+			return nil, err
+		}
+	}
+	return &rpcpb.ChangePeerResponse{Result: true}, nil
+}
+
+// RemovePeer remove a peer from the static and trusted peers
+func (s *APIService) RemovePeer(ctx context.Context, req *rpcpb.RemovePeerRequest) (*rpcpb.ChangePeerResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"id":  req.Id,
+		"api": "/v1/admin/peer/remove",
+	}).Info("Rpc request.")
+
+	s.server.Neblet().NetManager().Node().RemovePeer(req.Id)
+	return &rpcpb.ChangePeerResponse{Result: true}, nil
+}
+
+// AddIPFilter add a CIDR to the IP allowlist or denylist
+func (s *APIService) AddIPFilter(ctx context.Context, req *rpcpb.IPFilterRequest) (*rpcpb.IPFilterResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"cidr": req.Cidr,
+		"deny": req.Deny,
+		"api":  "/v1/admin/ipFilter/add",
+	}).Info("Rpc request.")
+
+	node := s.server.Neblet().NetManager().Node()
+	filter := node.IPFilter()
+	if req.Deny {
+		if err := filter.Deny(req.Cidr); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := filter.Allow(req.Cidr); err != nil {
+			return nil, err
+		}
+	}
+	node.DropFilteredPeers()
+	return toIPFilterResponse(filter), nil
+}
+
+// RemoveIPFilter remove a CIDR from the IP allowlist and denylist
+func (s *APIService) RemoveIPFilter(ctx context.Context, req *rpcpb.IPFilterRequest) (*rpcpb.IPFilterResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"cidr": req.Cidr,
+		"api":  "/v1/admin/ipFilter/remove",
+	}).Info("Rpc request.")
+
+	node := s.server.Neblet().NetManager().Node()
+	filter := node.IPFilter()
+	if err := filter.Remove(req.Cidr); err != nil {
+		return nil, err
+	}
+	// removing the last allowed CIDR of the allowlist may disallow the peers not matched by the others.
+	node.DropFilteredPeers()
+	return toIPFilterResponse(filter), nil
+}
+
+// GetIPFilter return the IP allowlist and denylist
+func (s *APIService) GetIPFilter(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.IPFilterResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/ipFilter",
+	}).Info("Rpc request.")
+
+	return toIPFilterResponse(s.server.Neblet().NetManager().Node().IPFilter()), nil
+}
+
+func toIPFilterResponse(filter *p2p.IPFilter) *rpcpb.IPFilterResponse {
+	allow, deny := filter.Lists()
+	return &rpcpb.IPFilterResponse{Allow: allow, Deny: deny}
+}
+
+// GetPeers return the static and trusted peers
+func (s *APIService) GetPeers(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.PeersResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/v1/admin/peers",
+	}).Info("Rpc request.")
+
+	node := s.server.Neblet().NetManager().Node()
+	resp := &rpcpb.PeersResponse{}
+	for _, v := range node.ConfiguredPeers() {
+		peer := &rpcpb.ConfiguredPeer{
+			Id:      v.ID,
+			Static:  v.Static,
+			Trusted: v.Trusted,
+		}
+		if v.Addr != nil {
+			peer.Address = v.Addr.String()
+		}
+		_, peer.Connected = node.GetStream().Load(v.ID)
+		resp.Peers = append(resp.Peers, peer)
+	}
+	return resp, nil
+}
+
+func toStateDiffs(diffs []*nvm.StateDiff) []*rpcpb.StateDiff {
+	result := []*rpcpb.StateDiff{}
+	for _, v := range diffs {
+		result = append(result, &rpcpb.StateDiff{
+			Contract: v.Contract,
+			Key:      v.Key,
+			OldHash:  v.OldHash,
+			NewHash:  v.NewHash,
+		})
+	}
+	return result
+}
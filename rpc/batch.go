@@ -0,0 +1,212 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// const
+const (
+	// BatchPath is the path of the batch requests.
+	BatchPath = "/v1/batch"
+
+	// DefaultMaxBatchSize is the most requests in a batch.
+	DefaultMaxBatchSize = 100
+	// DefaultBatchConcurrency is the number of the requests of a batch executed at once.
+	DefaultBatchConcurrency = 8
+
+	// maxBatchBodySize is the largest body of a batch, 16M.
+	maxBatchBodySize = 16 * 1024 * 1024
+)
+
+// errors
+var (
+	ErrInvalidBatch  = errors.New("batch must be a non-empty array of requests")
+	ErrBatchTooLarge = errors.New("too many requests in the batch")
+	ErrNestedBatch   = errors.New("batch can't contain a batch request")
+)
+
+var (
+	batchRequestMeter = metrics.GetOrRegisterMeter("neb.rpc.batch.request", nil)
+	batchCallMeter    = metrics.GetOrRegisterMeter("neb.rpc.batch.call", nil)
+)
+
+// BatchRequest is a request of a batch, e.g. {"id": 1, "method": "POST", "url": "/v1/user/accountstate", "body": {"address": "n1..."}}.
+type BatchRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// BatchResponse is the response of a request of a batch, its result if the status is 2xx, its error otherwise.
+type BatchResponse struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Status int             `json:"status"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// batchLimits return the most requests in a batch and the ones executed at once of the config.
+func batchLimits(config *nebletpb.RPCConfig) (int, int) {
+	maxSize, concurrency := DefaultMaxBatchSize, DefaultBatchConcurrency
+	if config.MaxBatchSize > 0 {
+		maxSize = int(config.MaxBatchSize)
+	}
+	if config.BatchConcurrency > 0 {
+		concurrency = int(config.BatchConcurrency)
+	}
+	return maxSize, concurrency
+}
+
+// batchHandler serve the arrays of requests posted to BatchPath, each one by the handler,
+// at most concurrency at once, and reply their responses in order.
+func batchHandler(h http.Handler, maxSize int, concurrency int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != BatchPath || r.Method != "POST" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		batchRequestMeter.Mark(1)
+
+		var reqs []*BatchRequest
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBatchBodySize))
+		if err != nil || json.Unmarshal(body, &reqs) != nil || len(reqs) == 0 {
+			writeHTTPError(w, http.StatusBadRequest, ErrInvalidBatch)
+			return
+		}
+		if len(reqs) > maxSize {
+			writeHTTPError(w, http.StatusRequestEntityTooLarge, ErrBatchTooLarge)
+			return
+		}
+		batchCallMeter.Mark(int64(len(reqs)))
+
+		resps := make([]*BatchResponse, len(reqs))
+		sem := make(chan bool, concurrency)
+		wg := new(sync.WaitGroup)
+		for i, req := range reqs {
+			wg.Add(1)
+			sem <- true
+			go func(i int, req *BatchRequest) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				resps[i] = serveBatchRequest(h, r, req)
+			}(i, req)
+		}
+		wg.Wait()
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resps)
+	})
+}
+
+// serveBatchRequest serve a request of the batch with the headers of the batch, e.g. its api key.
+func serveBatchRequest(h http.Handler, batch *http.Request, req *BatchRequest) *BatchResponse {
+	resp := &BatchResponse{ID: req.ID}
+	if !strings.HasPrefix(req.URL, "/") || strings.HasPrefix(req.URL, BatchPath) {
+		resp.Status = http.StatusBadRequest
+		resp.Error, _ = json.Marshal(map[string]string{"error": ErrNestedBatch.Error()})
+		return resp
+	}
+	method := strings.ToUpper(req.Method)
+	if len(method) == 0 {
+		method = "POST"
+	}
+
+	r, err := http.NewRequest(method, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		resp.Status = http.StatusBadRequest
+		resp.Error, _ = json.Marshal(map[string]string{"error": err.Error()})
+		return resp
+	}
+	r = r.WithContext(batch.Context())
+	for k, v := range batch.Header {
+		r.Header[k] = v
+	}
+	r.RemoteAddr = batch.RemoteAddr
+	r.ContentLength = int64(len(req.Body))
+
+	rec := newBatchRecorder()
+	h.ServeHTTP(rec, r)
+
+	resp.Status = rec.status
+	if resp.Status == 0 {
+		resp.Status = http.StatusOK
+	}
+	data := bytes.TrimSpace(rec.body.Bytes())
+	if !json.Valid(data) {
+		data, _ = json.Marshal(string(data))
+	}
+	if resp.Status >= 200 && resp.Status < 300 {
+		resp.Result = data
+	} else {
+		resp.Error = data
+	}
+	return resp
+}
+
+func writeHTTPError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+// batchRecorder keeps the response of a request of the batch.
+type batchRecorder struct {
+	header http.Header
+	status int
+	body   *bytes.Buffer
+}
+
+func newBatchRecorder() *batchRecorder {
+	return &batchRecorder{header: make(http.Header), body: new(bytes.Buffer)}
+}
+
+// Header return the headers of the response.
+func (rec *batchRecorder) Header() http.Header {
+	return rec.header
+}
+
+// Write keep the body of the response.
+func (rec *batchRecorder) Write(data []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.body.Write(data)
+}
+
+// WriteHeader keep the status of the response.
+func (rec *batchRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func postBatch(h http.Handler, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", BatchPath, strings.NewReader(body)))
+	return w
+}
+
+func TestBatchHandler_Order(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the later requests finish first.
+		if r.URL.Path == "/first" {
+			time.Sleep(50 * time.Millisecond)
+		}
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Write([]byte(`{"path":"` + r.URL.Path + `"}`))
+	})
+
+	w := postBatch(batchHandler(h, 10, 4), `[{"id":1,"url":"/first"},{"id":"b","url":"/second"},{"id":3,"url":"/missing"}]`)
+	assert.Equal(t, w.Code, http.StatusOK)
+	var resps []*BatchResponse
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resps))
+	assert.Equal(t, len(resps), 3)
+	assert.Equal(t, string(resps[0].ID), "1")
+	assert.Equal(t, string(resps[0].Result), `{"path":"/first"}`)
+	assert.Equal(t, string(resps[1].ID), `"b"`)
+	assert.Equal(t, string(resps[1].Result), `{"path":"/second"}`)
+	assert.Equal(t, resps[2].Status, http.StatusNotFound)
+	assert.Nil(t, resps[2].Result)
+	assert.Equal(t, string(resps[2].Error), `{"path":"/missing"}`)
+}
+
+func TestBatchHandler_EmptyResponse(t *testing.T) {
+	// a handler writing nothing replies 200 with an empty result.
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	w := postBatch(batchHandler(h, 10, 4), `[{"url":"/empty"}]`)
+	var resps []*BatchResponse
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resps))
+	assert.Equal(t, resps[0].Status, http.StatusOK)
+	assert.Equal(t, string(resps[0].Result), `""`)
+	assert.Nil(t, resps[0].Error)
+}
+
+func TestBatchHandler_Nested(t *testing.T) {
+	served := 0
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	})
+
+	w := postBatch(batchHandler(h, 10, 1), `[{"url":"/v1/batch","body":[]},{"url":"v1/user/nebstate"}]`)
+	var resps []*BatchResponse
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resps))
+	for _, resp := range resps {
+		assert.Equal(t, resp.Status, http.StatusBadRequest)
+		assert.Equal(t, string(resp.Error), `{"error":"`+ErrNestedBatch.Error()+`"}`)
+	}
+	assert.Equal(t, served, 0)
+}
+
+func TestBatchHandler_Size(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	handler := batchHandler(h, 2, 1)
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"empty", `[]`, http.StatusBadRequest},
+		{"not array", `{"url":"/v1/user/nebstate"}`, http.StatusBadRequest},
+		{"full", `[{"url":"/a"},{"url":"/b"}]`, http.StatusOK},
+		{"too large", `[{"url":"/a"},{"url":"/b"},{"url":"/c"}]`, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, postBatch(handler, tt.body).Code, tt.status)
+		})
+	}
+
+	// the requests other than the batch go to the handler.
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", BatchPath, nil))
+	assert.Equal(t, w.Code, http.StatusOK)
+}
+
+func TestBatchHandler_Concurrency(t *testing.T) {
+	var (
+		mu            sync.Mutex
+		running, most int
+		concurrency   = 3
+		requests      = make([]string, 20)
+	)
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		running++
+		if running > most {
+			most = running
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+	})
+	for i := range requests {
+		requests[i] = `{"url":"/a"}`
+	}
+
+	w := postBatch(batchHandler(h, len(requests), concurrency), "["+strings.Join(requests, ",")+"]")
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.True(t, most > 1)
+	assert.True(t, most <= concurrency)
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+)
+
+var (
+	defaultCORSMethods = []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
+	defaultCORSHeaders = []string{"Content-Type", "Accept", APIKeyHeader}
+)
+
+// corsPolicy is the origins allowed to call the gateway from a browser, with the methods and headers.
+type corsPolicy struct {
+	origins []string
+	methods string
+	headers string
+}
+
+// newCORSPolicy return the cors policy of the config, any origin is allowed if none is given.
+func newCORSPolicy(config *nebletpb.RPCConfig) *corsPolicy {
+	policy := &corsPolicy{
+		origins: config.CorsAllowedOrigins,
+		methods: strings.Join(defaultCORSMethods, ","),
+		headers: strings.Join(defaultCORSHeaders, ","),
+	}
+	if len(policy.origins) == 0 {
+		policy.origins = []string{"*"}
+	}
+	if len(config.CorsAllowedMethods) > 0 {
+		methods := make([]string, len(config.CorsAllowedMethods))
+		for i, v := range config.CorsAllowedMethods {
+			methods[i] = strings.ToUpper(v)
+		}
+		policy.methods = strings.Join(methods, ",")
+	}
+	if len(config.CorsAllowedHeaders) > 0 {
+		policy.headers = strings.Join(config.CorsAllowedHeaders, ",")
+	}
+	return policy
+}
+
+// allowOrigin return true if the origin matches one of the policy, "*" matches any,
+// and "https://*.example.com" any subdomain of example.com over https.
+func (p *corsPolicy) allowOrigin(origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, v := range p.origins {
+		pattern := strings.ToLower(v)
+		if pattern == "*" || pattern == origin {
+			return true
+		}
+		if i := strings.Index(pattern, "*"); i >= 0 {
+			prefix, suffix := pattern[:i], pattern[i+1:]
+			if len(origin) > len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSPolicy_AllowOrigin(t *testing.T) {
+	policy := newCORSPolicy(&nebletpb.RPCConfig{})
+	assert.True(t, policy.allowOrigin("https://wallet.example.com"))
+
+	policy = newCORSPolicy(&nebletpb.RPCConfig{CorsAllowedOrigins: []string{"https://wallet.example.com", "https://*.nebulas.io"}})
+	assert.True(t, policy.allowOrigin("https://wallet.example.com"))
+	assert.True(t, policy.allowOrigin("HTTPS://Wallet.Example.com"))
+	assert.True(t, policy.allowOrigin("https://explorer.nebulas.io"))
+	assert.True(t, policy.allowOrigin("https://a.b.nebulas.io"))
+	assert.False(t, policy.allowOrigin("https://nebulas.io"))
+	assert.False(t, policy.allowOrigin("https://.nebulas.io"))
+	assert.False(t, policy.allowOrigin("http://explorer.nebulas.io"))
+	assert.False(t, policy.allowOrigin("https://explorer.nebulas.io.evil.com"))
+	assert.False(t, policy.allowOrigin("https://example.com"))
+}
+
+func TestAllowCORS(t *testing.T) {
+	served := 0
+	h := allowCORS(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	}), newCORSPolicy(&nebletpb.RPCConfig{
+		CorsAllowedOrigins: []string{"https://*.nebulas.io"},
+		CorsAllowedMethods: []string{"get", "post"},
+		CorsAllowedHeaders: []string{"Content-Type", "Authorization"},
+	}))
+	request := func(method, origin string, preflight bool) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(method, "/v1/user/nebstate", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		if preflight {
+			r.Header.Set("Access-Control-Request-Method", "POST")
+		}
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	// the preflight of an allowed origin is answered by the policy, not served.
+	w := request("OPTIONS", "https://explorer.nebulas.io", true)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://explorer.nebulas.io", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET,POST", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Content-Type,Authorization", w.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "Origin", w.Header().Get("Vary"))
+	assert.Equal(t, 0, served)
+
+	// the preflight of another origin is refused.
+	w = request("OPTIONS", "https://example.com", true)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, 0, served)
+
+	// the requests are served, with the cors headers only for the allowed origins.
+	w = request("POST", "https://explorer.nebulas.io", false)
+	assert.Equal(t, "https://explorer.nebulas.io", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Methods"))
+	w = request("POST", "https://example.com", false)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	w = request("POST", "", false)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	// an OPTIONS which is not a preflight.
+	request("OPTIONS", "https://explorer.nebulas.io", false)
+	assert.Equal(t, 4, served)
+
+	// the default policy allows any origin, with the default methods and headers.
+	h = allowCORS(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), newCORSPolicy(&nebletpb.RPCConfig{}))
+	w = request("OPTIONS", "https://example.com", true)
+	assert.Equal(t, "https://example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET,HEAD,POST,PUT,DELETE", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Content-Type,Accept,"+APIKeyHeader, w.Header().Get("Access-Control-Allow-Headers"))
+}
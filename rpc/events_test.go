@@ -0,0 +1,158 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+// mockServer is a server of the neblet, the other methods are not implemented.
+// chainNeb is a neblet serving only its chain.
+type chainNeb struct {
+	Neblet
+	chain *core.BlockChain
+}
+
+func (n *chainNeb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+type mockServer struct {
+	Server
+	neb Neblet
+}
+
+func (s *mockServer) Neblet() Neblet {
+	return s.neb
+}
+
+// mockTxChain return a chain whose genesis gives the balance to the address of the key.
+func mockTxChain(t *testing.T, key keystore.PrivateKey) *core.BlockChain {
+	pubdata, _ := key.PublicKey().Encoded()
+	from, _ := core.NewAddressFromPublicKey(pubdata)
+	genesis := mockGenesisConf()
+	genesis.TokenDistribution = []*corepb.GenesisTokenDistribution{
+		&corepb.GenesisTokenDistribution{Address: from.String(), Value: "10000000000000000000000"},
+	}
+	stor, _ := storage.NewMemoryStorage()
+	bc, err := core.NewBlockChain(&mockNeb{genesis: genesis, storage: stor, emitter: core.NewEventEmitter(1024)})
+	assert.Nil(t, err)
+	bc.SetConsensusHandler(mockConsensus{})
+	return bc
+}
+
+// mockTxBlock mint a block on the tail packing the transaction signed by the key, and make it the tail.
+func mockTxBlock(t *testing.T, bc *core.BlockChain, key keystore.PrivateKey, tx *core.Transaction) *core.Block {
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	assert.Nil(t, signature.InitSign(key))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Nil(t, bc.TransactionPool().Push(tx))
+
+	pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	coinbase, _ := core.NewAddressFromPublicKey(pubdata)
+	parent := bc.TailBlock()
+	block, err := bc.NewBlockFromParent(coinbase, parent)
+	assert.Nil(t, err)
+	dynasty, err := parent.NextDynastyContext(core.BlockInterval)
+	assert.Nil(t, err)
+	assert.Nil(t, block.LoadDynastyContext(dynasty))
+	block.CollectTransactions(1)
+	assert.Equal(t, 1, len(block.Transactions()))
+	block.SetMiner(coinbase)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, bc.BlockPool().Push(block))
+	assert.Nil(t, bc.SetTailBlock(block))
+	return block
+}
+
+func TestAPIService_GetEvents(t *testing.T) {
+	key := secp256k1.GeneratePrivateKey()
+	bc := mockTxChain(t, key)
+	pubdata, _ := key.PublicKey().Encoded()
+	from, _ := core.NewAddressFromPublicKey(pubdata)
+	pubdata, _ = secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	to, _ := core.NewAddressFromPublicKey(pubdata)
+	pubdata, _ = secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	contract, _ := core.NewAddressFromPublicKey(pubdata)
+	gasLimit := util.NewUint128FromInt(200000)
+
+	tx1 := core.NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, gasLimit)
+	mockTxBlock(t, bc, key, tx1)
+	// the call payload can't be loaded, the transaction fails.
+	tx2 := core.NewTransaction(bc.ChainID(), from, contract, util.NewUint128FromInt(0), 2, core.TxPayloadCallType, []byte("call"), core.TransactionGasPrice, gasLimit)
+	mockTxBlock(t, bc, key, tx2)
+	tx3 := core.NewTransaction(bc.ChainID(), from, contract, util.NewUint128FromInt(1), 3, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, gasLimit)
+	mockTxBlock(t, bc, key, tx3)
+
+	api := &APIService{server: &mockServer{neb: &chainNeb{chain: bc}}}
+	events := func(req *rpcpb.GetEventsRequest) []string {
+		resp, err := api.GetEvents(context.Background(), req)
+		assert.Nil(t, err)
+		var hashes []string
+		for _, v := range resp.Events {
+			hashes = append(hashes, v.TxHash)
+		}
+		return hashes
+	}
+
+	failed := []string{core.TopicExecuteTxFailed}
+	succeeded := []string{core.TopicExecuteTxSuccess}
+	executed := []string{core.TopicExecuteTxFailed, core.TopicExecuteTxSuccess}
+	assert.Equal(t, []string{tx2.Hash().String()}, events(&rpcpb.GetEventsRequest{Topics: failed}))
+	assert.Equal(t, []string{tx1.Hash().String(), tx3.Hash().String()}, events(&rpcpb.GetEventsRequest{Topics: succeeded}))
+	assert.Equal(t, []string{tx3.Hash().String()}, events(&rpcpb.GetEventsRequest{FromHeight: 4, Topics: succeeded}))
+	assert.Equal(t, []string{tx1.Hash().String()}, events(&rpcpb.GetEventsRequest{ToHeight: 3, Topics: succeeded}))
+
+	// the transactions sent or received by the address.
+	assert.Equal(t, []string{tx1.Hash().String()}, events(&rpcpb.GetEventsRequest{Address: to.String(), Topics: executed}))
+	assert.Equal(t, []string{tx2.Hash().String(), tx3.Hash().String()}, events(&rpcpb.GetEventsRequest{Address: contract.String(), Topics: executed}))
+	assert.Equal(t, []string{tx3.Hash().String()}, events(&rpcpb.GetEventsRequest{Address: contract.String(), Topics: succeeded}))
+
+	// paged by cursor.
+	resp, err := api.GetEvents(context.Background(), &rpcpb.GetEventsRequest{Topics: executed, Limit: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(resp.Events))
+	assert.Equal(t, tx1.Hash().String(), resp.Events[0].TxHash)
+	assert.Equal(t, uint64(2), resp.Events[0].BlockHeight)
+	assert.Equal(t, core.TopicExecuteTxFailed, resp.Events[1].Topic)
+	assert.NotEmpty(t, resp.NextCursor)
+	resp, err = api.GetEvents(context.Background(), &rpcpb.GetEventsRequest{Topics: executed, Limit: 2, Cursor: resp.NextCursor})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.Events))
+	assert.Equal(t, tx3.Hash().String(), resp.Events[0].TxHash)
+	assert.Equal(t, bc.TailBlock().Hash().String(), resp.Events[0].BlockHash)
+	assert.Empty(t, resp.NextCursor)
+
+	_, err = api.GetEvents(context.Background(), &rpcpb.GetEventsRequest{Address: "invalid"})
+	assert.NotNil(t, err)
+	_, err = api.GetEvents(context.Background(), &rpcpb.GetEventsRequest{FromHeight: 5})
+	assert.Equal(t, ErrInvalidBlockRange, err)
+}
@@ -0,0 +1,463 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// filter types
+const (
+	FilterTypeBlock   = "block"
+	FilterTypePending = "pending"
+	FilterTypeEvent   = "event"
+	FilterTypeLog     = "log"
+)
+
+// const
+const (
+	// DefaultFilterTimeout is how long a filter is kept without being polled.
+	DefaultFilterTimeout = 5 * time.Minute
+	// DefaultMaxFiltersPerClient is the most filters a client ip can install.
+	DefaultMaxFiltersPerClient = 16
+	// MaxFilterChanges is the most changes kept between two polls of a filter, the oldest are dropped.
+	MaxFilterChanges = 10000
+)
+
+// errors
+var (
+	ErrInvalidFilterType = errors.New("filter type must be block, pending, event or log")
+	ErrFilterNotFound    = errors.New("filter not found")
+	ErrTooManyFilters    = errors.New("too many filters installed by the client")
+	ErrEmptyFilterTopics = errors.New("event filter must have topics")
+	ErrInvalidProxy      = errors.New("trusted proxy must be an IP or a CIDR")
+)
+
+var (
+	filterInstalledGauge = metrics.GetOrRegisterGauge("neb.rpc.filter.installed", nil)
+	filterExpiredCounter = metrics.GetOrRegisterCounter("neb.rpc.filter.expired", nil)
+)
+
+// filter keep the changes matching it since the last poll.
+type filter struct {
+	id      string
+	client  string
+	typ     string
+	address string
+	topics  []string
+
+	mu       sync.Mutex
+	lastPoll time.Time
+	changes  *rpcpb.FilterChangesResponse
+	count    int
+
+	headCh    chan *core.ChainHeadEvent
+	pendingCh chan *core.Transaction
+	eventCh   chan *core.Event
+	quitCh    chan bool
+}
+
+// filterManager keep the filters installed by the clients polling for changes, which can't hold a stream.
+type filterManager struct {
+	neblet    Neblet
+	timeout   time.Duration
+	maxFilter int
+	// the proxies whose X-Forwarded-For is trusted.
+	proxies []*net.IPNet
+
+	mu      sync.Mutex
+	filters map[string]*filter
+	clients map[string]int
+}
+
+func newFilterManager(neblet Neblet, timeout time.Duration, maxFilter int) *filterManager {
+	if timeout <= 0 {
+		timeout = DefaultFilterTimeout
+	}
+	if maxFilter <= 0 {
+		maxFilter = DefaultMaxFiltersPerClient
+	}
+	return &filterManager{
+		neblet:    neblet,
+		timeout:   timeout,
+		maxFilter: maxFilter,
+		filters:   make(map[string]*filter),
+		clients:   make(map[string]int),
+	}
+}
+
+// install subscribe a new filter of the client, and return its id.
+func (m *filterManager) install(client string, req *rpcpb.NewFilterRequest) (string, error) {
+	typ := strings.ToLower(req.Type)
+	switch typ {
+	case FilterTypeBlock, FilterTypePending, FilterTypeLog:
+	case FilterTypeEvent:
+		if len(req.Topics) == 0 {
+			return "", ErrEmptyFilterTopics
+		}
+	default:
+		return "", ErrInvalidFilterType
+	}
+	// the events and logs are emitted by the execution, which a relay skips.
+	if (typ == FilterTypeEvent || typ == FilterTypeLog) && m.neblet.BlockChain().RelayMode() {
+		return "", core.ErrRelayNoState
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	f := &filter{
+		id:       hex.EncodeToString(id),
+		client:   client,
+		typ:      typ,
+		address:  req.Address,
+		topics:   req.Topics,
+		lastPoll: time.Now(),
+		changes:  newFilterChanges(),
+		quitCh:   make(chan bool, 1),
+	}
+
+	m.mu.Lock()
+	if m.clients[client] >= m.maxFilter {
+		m.mu.Unlock()
+		return "", ErrTooManyFilters
+	}
+	m.clients[client]++
+	m.filters[f.id] = f
+	filterInstalledGauge.Update(int64(len(m.filters)))
+	m.mu.Unlock()
+
+	bc := m.neblet.BlockChain()
+	switch typ {
+	case FilterTypeBlock, FilterTypeLog:
+		f.headCh = make(chan *core.ChainHeadEvent, 128)
+		bc.SubscribeChainHead(f.headCh)
+	case FilterTypePending:
+		f.pendingCh = make(chan *core.Transaction, 1024)
+		bc.TransactionPool().SubscribePending(f.pendingCh)
+	case FilterTypeEvent:
+		f.eventCh = make(chan *core.Event, 128)
+		for _, v := range f.topics {
+			m.neblet.EventEmitter().Register(v, f.eventCh)
+		}
+	}
+	go m.loop(f)
+	return f.id, nil
+}
+
+// poll return the changes of the filter since the last poll, only to the client installing it.
+func (m *filterManager) poll(client string, id string) (*rpcpb.FilterChangesResponse, error) {
+	f := m.get(client, id)
+	if f == nil {
+		return nil, ErrFilterNotFound
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	changes := f.changes
+	f.changes, f.count = newFilterChanges(), 0
+	f.lastPoll = time.Now()
+	return changes, nil
+}
+
+// uninstall remove the filter of the client, return false if not found.
+func (m *filterManager) uninstall(client string, id string) bool {
+	f := m.get(client, id)
+	if f == nil {
+		return false
+	}
+	return m.remove(f)
+}
+
+func (m *filterManager) get(client string, id string) *filter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f, ok := m.filters[id]
+	if !ok || f.client != client {
+		return nil
+	}
+	return f
+}
+
+func (m *filterManager) remove(f *filter) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.filters[f.id]; !ok {
+		return false
+	}
+	delete(m.filters, f.id)
+	if m.clients[f.client]--; m.clients[f.client] <= 0 {
+		delete(m.clients, f.client)
+	}
+	filterInstalledGauge.Update(int64(len(m.filters)))
+	f.quitCh <- true
+	return true
+}
+
+// stop remove all the filters.
+func (m *filterManager) stop() {
+	m.mu.Lock()
+	filters := make([]*filter, 0, len(m.filters))
+	for _, f := range m.filters {
+		filters = append(filters, f)
+	}
+	m.mu.Unlock()
+	for _, f := range filters {
+		m.remove(f)
+	}
+}
+
+// loop keep the changes of the filter until it's uninstalled, or expires without being polled.
+func (m *filterManager) loop(f *filter) {
+	bc := m.neblet.BlockChain()
+	defer func() {
+		switch {
+		case f.headCh != nil:
+			bc.UnsubscribeChainHead(f.headCh)
+		case f.pendingCh != nil:
+			bc.TransactionPool().UnsubscribePending(f.pendingCh)
+		case f.eventCh != nil:
+			for _, v := range f.topics {
+				m.neblet.EventEmitter().Deregister(v, f.eventCh)
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(m.timeout / 10)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.quitCh:
+			return
+		case <-ticker.C:
+			f.mu.Lock()
+			expired := time.Since(f.lastPoll) > m.timeout
+			f.mu.Unlock()
+			if expired {
+				filterExpiredCounter.Inc(1)
+				logging.VLog().WithFields(logrus.Fields{
+					"id":     f.id,
+					"client": f.client,
+					"type":   f.typ,
+				}).Debug("Filter expired.")
+				m.remove(f)
+				return
+			}
+		case e := <-f.headCh:
+			if f.typ == FilterTypeBlock {
+				f.add(func(c *rpcpb.FilterChangesResponse) int {
+					c.Blocks = append(c.Blocks, toNewBlockResponse(e))
+					return 1
+				})
+				continue
+			}
+			logs, err := matchBlockLogs(e, f.address, f.topics)
+			if err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"id":    f.id,
+					"block": e.Block,
+					"err":   err,
+				}).Debug("Failed to fetch the logs of the filter.")
+				continue
+			}
+			if len(logs) > 0 {
+				f.add(func(c *rpcpb.FilterChangesResponse) int {
+					c.Logs = append(c.Logs, logs...)
+					return len(logs)
+				})
+			}
+		case tx := <-f.pendingCh:
+			f.add(func(c *rpcpb.FilterChangesResponse) int {
+				c.Transactions = append(c.Transactions, toPendingTxResponse(tx))
+				return 1
+			})
+		case e := <-f.eventCh:
+			f.add(func(c *rpcpb.FilterChangesResponse) int {
+				c.Events = append(c.Events, &rpcpb.SubscribeResponse{MsgType: e.Topic, Data: e.Data})
+				return 1
+			})
+		}
+	}
+}
+
+// add keep the changes unless the filter already has MaxFilterChanges, then they are dropped
+// and the next poll is told so.
+func (f *filter) add(fn func(*rpcpb.FilterChangesResponse) int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.count >= MaxFilterChanges {
+		f.changes.Overflowed = true
+		return
+	}
+	f.count += fn(f.changes)
+}
+
+func newFilterChanges() *rpcpb.FilterChangesResponse {
+	return &rpcpb.FilterChangesResponse{
+		Blocks:       []*rpcpb.NewBlockResponse{},
+		Transactions: []*rpcpb.PendingTxResponse{},
+		Events:       []*rpcpb.SubscribeResponse{},
+		Logs:         []*rpcpb.ContractLog{},
+	}
+}
+
+// matchBlockLogs return the logs of the block matching the filter, removed ones if the block was reverted.
+func matchBlockLogs(e *core.ChainHeadEvent, address string, topics []string) ([]*rpcpb.ContractLog, error) {
+	block := e.Block
+	if !block.Bloom().MayMatch(address, topics) {
+		return nil, nil
+	}
+	var logs []*rpcpb.ContractLog
+	for _, tx := range block.Transactions() {
+		result, err := block.FetchLogs(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range result {
+			if !core.MatchLog(v, address, topics) {
+				continue
+			}
+			logs = append(logs, &rpcpb.ContractLog{
+				Address:     v.Address,
+				Topics:      v.Topics,
+				Data:        v.Data,
+				TxHash:      tx.Hash().String(),
+				BlockHash:   block.Hash().String(),
+				BlockHeight: block.Height(),
+				Removed:     e.Reverted,
+			})
+		}
+	}
+	return logs, nil
+}
+
+func toNewBlockResponse(e *core.ChainHeadEvent) *rpcpb.NewBlockResponse {
+	block := e.Block
+	resp := &rpcpb.NewBlockResponse{
+		Hash:       block.Hash().String(),
+		ParentHash: block.ParentHash().String(),
+		Height:     block.Height(),
+		Timestamp:  block.Timestamp(),
+		Coinbase:   block.Coinbase().String(),
+		TxCount:    uint32(len(block.Transactions())),
+		Reverted:   e.Reverted,
+	}
+	if miner := block.Miner(); miner != nil {
+		resp.Miner = miner.String()
+	}
+	return resp
+}
+
+func toPendingTxResponse(tx *core.Transaction) *rpcpb.PendingTxResponse {
+	return &rpcpb.PendingTxResponse{
+		Hash:      tx.Hash().String(),
+		From:      tx.From().String(),
+		To:        tx.To().String(),
+		Value:     tx.Value().String(),
+		Nonce:     tx.Nonce(),
+		Timestamp: tx.Timestamp(),
+		Type:      tx.Type(),
+		GasPrice:  tx.GasPrice().String(),
+		GasLimit:  tx.GasLimit().String(),
+	}
+}
+
+// setTrustedProxies set the proxies whose X-Forwarded-For is trusted, IPs or CIDRs.
+func (m *filterManager) setTrustedProxies(proxies []string) error {
+	var list []*net.IPNet
+	for _, v := range proxies {
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return ErrInvalidProxy
+			}
+			list = append(list, &net.IPNet{IP: ip, Mask: net.CIDRMask(8*len(ip), 8*len(ip))})
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(v)
+		if err != nil {
+			return ErrInvalidProxy
+		}
+		list = append(list, ipnet)
+	}
+	m.proxies = list
+	return nil
+}
+
+// trusted return whether the ip is the gateway of the node, on the loopback, or a trusted proxy.
+func (m *filterManager) trusted(ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	if addr.IsLoopback() {
+		return true
+	}
+	for _, v := range m.proxies {
+		if v.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// client return the ip of the client of the request, the peer of the connection unless it's a trusted proxy.
+// The gateway and the proxies append the ip they were called from to the X-Forwarded-For, so it's followed
+// from the last one as long as the hops are trusted, the ones before can be forged by the client.
+func (m *filterManager) client(ctx context.Context) string {
+	client := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		client = host
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return client
+	}
+	var hops []string
+	for _, v := range md["x-forwarded-for"] {
+		for _, ip := range strings.Split(v, ",") {
+			if ip = strings.TrimSpace(ip); len(ip) > 0 {
+				hops = append(hops, ip)
+			}
+		}
+	}
+	for i := len(hops) - 1; i >= 0 && m.trusted(client); i-- {
+		client = hops[i]
+	}
+	return client
+}
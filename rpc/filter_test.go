@@ -0,0 +1,133 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestFilterManager_Client(t *testing.T) {
+	m := newFilterManager(nil, 0, 0)
+	request := func(from string, forwarded ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(from), Port: 51510}})
+		for _, v := range forwarded {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", v))
+		}
+		return ctx
+	}
+
+	// a direct client can't choose its key.
+	assert.Equal(t, "8.8.8.8", m.client(request("8.8.8.8")))
+	assert.Equal(t, "8.8.8.8", m.client(request("8.8.8.8", "1.2.3.4")))
+	// the gateway of the node forwards the ip it was called from.
+	assert.Equal(t, "1.2.3.4", m.client(request("127.0.0.1", "1.2.3.4")))
+	assert.Equal(t, "1.2.3.4", m.client(request("127.0.0.1", "5.6.7.8, 1.2.3.4")))
+	// the hops before an untrusted one are ignored.
+	assert.Equal(t, "10.0.0.2", m.client(request("127.0.0.1", "1.2.3.4, 10.0.0.2")))
+
+	assert.Equal(t, ErrInvalidProxy, m.setTrustedProxies([]string{"proxy"}))
+	assert.Equal(t, ErrInvalidProxy, m.setTrustedProxies([]string{"10.0.0.0/33"}))
+	assert.Nil(t, m.setTrustedProxies([]string{"10.0.0.0/24", "192.168.1.1"}))
+	assert.Equal(t, "1.2.3.4", m.client(request("127.0.0.1", "1.2.3.4, 10.0.0.2")))
+	assert.Equal(t, "1.2.3.4", m.client(request("192.168.1.1", "1.2.3.4, 10.0.0.2")))
+	assert.Equal(t, "192.168.1.2", m.client(request("192.168.1.2", "1.2.3.4")))
+	// a trusted proxy called directly is the client.
+	assert.Equal(t, "192.168.1.1", m.client(request("192.168.1.1")))
+}
+
+func TestFilterManager_Install(t *testing.T) {
+	bc := mockChain(t, 0)
+	m := newFilterManager(&chainNeb{chain: bc}, time.Minute, 2)
+	defer m.stop()
+
+	_, err := m.install("1.2.3.4", &rpcpb.NewFilterRequest{Type: "tx"})
+	assert.Equal(t, ErrInvalidFilterType, err)
+	_, err = m.install("1.2.3.4", &rpcpb.NewFilterRequest{Type: FilterTypeEvent})
+	assert.Equal(t, ErrEmptyFilterTopics, err)
+
+	id, err := m.install("1.2.3.4", &rpcpb.NewFilterRequest{Type: "Block"})
+	assert.Nil(t, err)
+	block := mockBlock(t, bc, bc.TailBlock(), core.BlockInterval)
+
+	var changes *rpcpb.FilterChangesResponse
+	for i := 0; i < 100; i++ {
+		changes, err = m.poll("1.2.3.4", id)
+		assert.Nil(t, err)
+		if len(changes.Blocks) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 1, len(changes.Blocks))
+	assert.Equal(t, block.Hash().String(), changes.Blocks[0].Hash)
+	assert.Equal(t, block.Height(), changes.Blocks[0].Height)
+
+	// the changes are only returned once, and only to the client installing the filter.
+	changes, err = m.poll("1.2.3.4", id)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(changes.Blocks))
+	_, err = m.poll("5.6.7.8", id)
+	assert.Equal(t, ErrFilterNotFound, err)
+	assert.False(t, m.uninstall("5.6.7.8", id))
+
+	_, err = m.install("1.2.3.4", &rpcpb.NewFilterRequest{Type: FilterTypePending})
+	assert.Nil(t, err)
+	_, err = m.install("1.2.3.4", &rpcpb.NewFilterRequest{Type: FilterTypePending})
+	assert.Equal(t, ErrTooManyFilters, err)
+	_, err = m.install("5.6.7.8", &rpcpb.NewFilterRequest{Type: FilterTypePending})
+	assert.Nil(t, err)
+
+	assert.True(t, m.uninstall("1.2.3.4", id))
+	assert.False(t, m.uninstall("1.2.3.4", id))
+	_, err = m.poll("1.2.3.4", id)
+	assert.Equal(t, ErrFilterNotFound, err)
+	_, err = m.install("1.2.3.4", &rpcpb.NewFilterRequest{Type: FilterTypeBlock})
+	assert.Nil(t, err)
+}
+
+func TestFilterManager_Expire(t *testing.T) {
+	bc := mockChain(t, 0)
+	m := newFilterManager(&chainNeb{chain: bc}, 50*time.Millisecond, 1)
+	defer m.stop()
+
+	polled, err := m.install("1.2.3.4", &rpcpb.NewFilterRequest{Type: FilterTypeBlock})
+	assert.Nil(t, err)
+	idle, err := m.install("5.6.7.8", &rpcpb.NewFilterRequest{Type: FilterTypeBlock})
+	assert.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		time.Sleep(20 * time.Millisecond)
+		_, err = m.poll("1.2.3.4", polled)
+		assert.Nil(t, err)
+	}
+	_, err = m.poll("5.6.7.8", idle)
+	assert.Equal(t, ErrFilterNotFound, err)
+
+	// the expired filter doesn't count against its client anymore.
+	_, err = m.install("5.6.7.8", &rpcpb.NewFilterRequest{Type: FilterTypeBlock})
+	assert.Nil(t, err)
+}
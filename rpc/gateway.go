@@ -3,10 +3,12 @@ package rpc
 import (
 	"flag"
 	"net/http"
-	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
@@ -18,25 +20,84 @@ const (
 )
 
 // Run start gateway proxy to mapping grpc to http.
-func Run(rpcListen string, gatewayListen []string, httpModule []string) error {
+func Run(config *nebletpb.RPCConfig) error {
+	rpcListen := config.RpcListen[0]
+	gatewayListen := config.HttpListen
+	httpModule := config.HttpModule
+
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	sunset, err := v1Sunset(config.V1Sunset)
+	if err != nil {
+		return err
+	}
+	v1Mux, v2Mux := newGatewayMuxes()
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 	echoEndpoint := flag.String("rpc", rpcListen, "")
-	for _, v := range httpModule {
-		switch v {
-		case API:
-			rpcpb.RegisterApiServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
-		case Admin:
-			rpcpb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
+	for _, mux := range []*runtime.ServeMux{v1Mux, v2Mux} {
+		for _, v := range httpModule {
+			switch v {
+			case API:
+				rpcpb.RegisterApiServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
+			case Admin:
+				// the separate admin service has its own gateway.
+				if len(config.AdminListen) == 0 {
+					rpcpb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
+				}
+			}
+		}
+	}
+	if len(config.AdminListen) > 0 && len(config.AdminHttpListen) > 0 {
+		for _, v := range config.AdminHttpListen {
+			if err := checkLocalListen(v); err != nil {
+				return err
+			}
 		}
+		go func() {
+			if err := runAdminGateway(ctx, config); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"err": err,
+				}).Error("Admin gateway failed to serve.")
+			}
+		}()
+	}
+	if len(config.IpcPath) > 0 {
+		go func() {
+			if err := runIPCGateway(ctx, config); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"path": config.IpcPath,
+					"err":  err,
+				}).Error("IPC gateway failed to serve.")
+			}
+		}()
+	}
+
+	maxBatchSize, batchConcurrency := batchLimits(config)
+	ipLimit := newIPLimit(config)
+	apiKeys, err := parseAPIKeys(config.ApiKeys, ipLimit)
+	if err != nil {
+		return err
+	}
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return err
 	}
+	// the requests of a batch are limited one by one.
+	mux := versionHandler(rawTransactionHandler(v1Mux), rawTransactionHandler(v2Mux), sunset)
+	handler := allowCORS(batchHandler(rateLimitHandler(mux, NewRateLimiter(), ipLimit, apiKeys), maxBatchSize, batchConcurrency), newCORSPolicy(config))
 
 	for _, v := range gatewayListen {
-		err := http.ListenAndServe(v, allowCORS(mux))
+		if tlsConfig != nil {
+			server := &http.Server{Addr: v, Handler: handler, TLSConfig: tlsConfig}
+			// the certificate is given by the tls config.
+			if err := server.ListenAndServeTLS("", ""); err != nil {
+				return err
+			}
+			continue
+		}
+		err := http.ListenAndServe(v, handler)
 		if err != nil {
 			return err
 		}
@@ -45,12 +106,23 @@ func Run(rpcListen string, gatewayListen []string, httpModule []string) error {
 	return nil
 }
 
-func allowCORS(h http.Handler) http.Handler {
+func allowCORS(h http.Handler, policy *corsPolicy) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if origin := r.Header.Get("Origin"); origin != "" {
+			preflight := r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != ""
+			if !policy.allowOrigin(origin) {
+				// the browser refuses the response without the cors headers.
+				if preflight {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				h.ServeHTTP(w, r)
+				return
+			}
 			w.Header().Set("Access-Control-Allow-Origin", origin)
-			if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
-				preflightHandler(w, r)
+			w.Header().Add("Vary", "Origin")
+			if preflight {
+				preflightHandler(w, r, policy)
 				return
 			}
 		}
@@ -58,10 +130,8 @@ func allowCORS(h http.Handler) http.Handler {
 	})
 }
 
-func preflightHandler(w http.ResponseWriter, r *http.Request) {
-	headers := []string{"Content-Type", "Accept"}
-	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
-	methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
-	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
+func preflightHandler(w http.ResponseWriter, r *http.Request, policy *corsPolicy) {
+	w.Header().Set("Access-Control-Allow-Headers", policy.headers)
+	w.Header().Set("Access-Control-Allow-Methods", policy.methods)
 	return
 }
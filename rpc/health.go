@@ -0,0 +1,118 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// MaxReadyTailAge is the oldest tail block of a ready node, an older one means it's behind or the chain stalls.
+const MaxReadyTailAge = 5 * time.Minute
+
+// health reasons
+const (
+	reasonDBUnavailable = "storage is not readable"
+	reasonSyncing       = "node is syncing"
+	reasonNoPeers       = "node has no peers"
+	reasonStaleTail     = "tail block is too old"
+)
+
+// Health return whether the node is alive, its storage readable.
+func (s *APIService) Health(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.HealthResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/health",
+	}).Debug("Rpc request.")
+
+	resp := s.probe()
+	resp.Reasons = []string{}
+	if !resp.DbAvailable {
+		resp.Reasons = append(resp.Reasons, reasonDBUnavailable)
+	}
+	resp.Ok = len(resp.Reasons) == 0
+	return resp, nil
+}
+
+// Ready return whether the node is ready to serve: its storage readable, not syncing,
+// connected to peers and its tail block recent.
+func (s *APIService) Ready(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.HealthResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"api": "/ready",
+	}).Debug("Rpc request.")
+
+	resp := s.probe()
+	resp.Reasons = []string{}
+	if !resp.DbAvailable {
+		resp.Reasons = append(resp.Reasons, reasonDBUnavailable)
+	}
+	if resp.Syncing {
+		resp.Reasons = append(resp.Reasons, reasonSyncing)
+	}
+	if resp.PeerCount == 0 {
+		resp.Reasons = append(resp.Reasons, reasonNoPeers)
+	}
+	if time.Duration(resp.TailAge)*time.Second > MaxReadyTailAge {
+		resp.Reasons = append(resp.Reasons, reasonStaleTail)
+	}
+	resp.Ok = len(resp.Reasons) == 0
+	return resp, nil
+}
+
+// probe return the state of the node checked by the probes.
+func (s *APIService) probe() *rpcpb.HealthResponse {
+	neb := s.server.Neblet()
+	bc := neb.BlockChain()
+	tail := bc.TailBlock()
+
+	resp := &rpcpb.HealthResponse{
+		Height:  tail.Height(),
+		TailAge: time.Now().Unix() - tail.Timestamp(),
+	}
+	if resp.TailAge < 0 {
+		resp.TailAge = 0
+	}
+	_, err := bc.Storage().Get(tail.Hash())
+	resp.DbAvailable = err == nil
+	if sm := neb.SyncManager(); sm != nil {
+		resp.Syncing = sm.Progress().Syncing
+	}
+	if nm := neb.NetManager(); nm != nil && nm.Node() != nil {
+		resp.PeerCount = getStreamCount(nm.Node().GetStream())
+		resp.Syncing = resp.Syncing || nm.Node().GetSynchronizing()
+	}
+	if c := neb.Consensus(); c != nil {
+		resp.Mining = c.CanMining()
+	}
+	return resp
+}
+
+// healthStatus set the status of the failed probes to 503 on the gateway,
+// so the load balancers and orchestrators can tell them without reading the body.
+func healthStatus(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if health, ok := resp.(*rpcpb.HealthResponse); ok && !health.Ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	return nil
+}
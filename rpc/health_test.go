@@ -0,0 +1,114 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	nsync "github.com/nebulasio/go-nebulas/sync"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+// miningConsensus is a consensus only telling it's mining.
+type miningConsensus struct {
+	consensus.Consensus
+}
+
+func (c *miningConsensus) CanMining() bool {
+	return true
+}
+
+// probedNeb is a neblet of a chain, neither syncing nor connected to peers.
+type probedNeb struct {
+	chainNeb
+}
+
+func (n *probedNeb) NetManager() p2p.Manager {
+	return nil
+}
+
+func (n *probedNeb) Consensus() consensus.Consensus {
+	return &miningConsensus{}
+}
+
+func (n *probedNeb) SyncManager() *nsync.Manager {
+	return nil
+}
+
+func TestAPIService_Health(t *testing.T) {
+	bc := mockChain(t, 2)
+	api := &APIService{server: &mockServer{neb: &probedNeb{chainNeb{chain: bc}}}}
+
+	resp, err := api.Health(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.True(t, resp.Ok)
+	assert.Empty(t, resp.Reasons)
+	assert.True(t, resp.DbAvailable)
+	assert.True(t, resp.Mining)
+	assert.Equal(t, bc.TailBlock().Height(), resp.Height)
+
+	// the node is alive, but not ready without peers, and with a tail of long ago.
+	resp, err = api.Ready(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.False(t, resp.Ok)
+	assert.Equal(t, []string{reasonNoPeers, reasonStaleTail}, resp.Reasons)
+	assert.True(t, time.Duration(resp.TailAge)*time.Second > MaxReadyTailAge)
+
+	now := time.Now().Unix()
+	mockBlock(t, bc, bc.TailBlock(), now-now%core.BlockInterval-bc.TailBlock().Timestamp())
+	resp, err = api.Ready(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{reasonNoPeers}, resp.Reasons)
+	assert.True(t, resp.TailAge <= core.BlockInterval)
+
+	// the storage lost the tail block.
+	assert.Nil(t, bc.Storage().Del(bc.TailBlock().Hash()))
+	resp, err = api.Health(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.False(t, resp.Ok)
+	assert.False(t, resp.DbAvailable)
+	assert.Equal(t, []string{reasonDBUnavailable}, resp.Reasons)
+	resp, err = api.Ready(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{reasonDBUnavailable, reasonNoPeers}, resp.Reasons)
+}
+
+func TestHealthStatus(t *testing.T) {
+	// the failed probes are 503 on the gateway.
+	w := httptest.NewRecorder()
+	assert.Nil(t, healthStatus(context.Background(), w, &rpcpb.HealthResponse{Ok: false}))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = httptest.NewRecorder()
+	assert.Nil(t, healthStatus(context.Background(), w, &rpcpb.HealthResponse{Ok: true}))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// the other responses are left alone.
+	w = httptest.NewRecorder()
+	assert.Nil(t, healthStatus(context.Background(), w, &rpcpb.GetNebStateResponse{}))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
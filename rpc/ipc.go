@@ -0,0 +1,123 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// DefaultIPCMode is the permission of the ipc socket, only the user running the node can connect.
+const DefaultIPCMode = 0600
+
+// errors
+var (
+	ErrInvalidIPCMode = errors.New("ipc_mode must be an octal file permission, e.g. 0600")
+	ErrIPCPathInUse   = errors.New("ipc_path exists and is not a socket")
+)
+
+// ipcMode return the permission of the ipc socket in the config.
+func ipcMode(config *nebletpb.RPCConfig) (os.FileMode, error) {
+	if len(config.IpcMode) == 0 {
+		return DefaultIPCMode, nil
+	}
+	mode, err := strconv.ParseUint(config.IpcMode, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, ErrInvalidIPCMode
+	}
+	return os.FileMode(mode), nil
+}
+
+// listenIPC listen on the unix socket of the path with the mode, the socket left by a previous run is removed.
+func listenIPC(path string, mode os.FileMode) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, ErrIPCPathInUse
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+// runIPCGateway serve the api and admin modules of the gateway on the unix socket of ipc_path.
+// The socket is only reachable by the local users its permission allows, so the requests aren't rate limited.
+func runIPCGateway(ctx context.Context, config *nebletpb.RPCConfig) error {
+	mode, err := ipcMode(config)
+	if err != nil {
+		return err
+	}
+	sunset, err := v1Sunset(config.V1Sunset)
+	if err != nil {
+		return err
+	}
+	v1Mux, v2Mux := newGatewayMuxes()
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	adminEndpoint := config.RpcListen[0]
+	if len(config.AdminListen) > 0 {
+		adminEndpoint = config.AdminListen
+	}
+	for _, mux := range []*runtime.ServeMux{v1Mux, v2Mux} {
+		if err := rpcpb.RegisterApiServiceHandlerFromEndpoint(ctx, mux, config.RpcListen[0], opts); err != nil {
+			return err
+		}
+		if err := rpcpb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, adminEndpoint, opts); err != nil {
+			return err
+		}
+	}
+
+	maxBatchSize, batchConcurrency := batchLimits(config)
+	mux := versionHandler(rawTransactionHandler(v1Mux), rawTransactionHandler(v2Mux), sunset)
+	handler := batchHandler(mux, maxBatchSize, batchConcurrency)
+
+	listener, err := listenIPC(config.IpcPath, mode)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(config.IpcPath)
+	logging.CLog().WithFields(logrus.Fields{
+		"path": config.IpcPath,
+		"mode": mode,
+	}).Info("Launched IPC gateway.")
+	return http.Serve(listener, handler)
+}
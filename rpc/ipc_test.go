@@ -0,0 +1,108 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPCMode(t *testing.T) {
+	tests := []struct {
+		mode     string
+		expected os.FileMode
+		err      error
+	}{
+		{"", DefaultIPCMode, nil},
+		{"0660", 0660, nil},
+		{"600", 0600, nil},
+		{"0777", 0777, nil},
+		{"1777", 0, ErrInvalidIPCMode},
+		{"0680", 0, ErrInvalidIPCMode},
+		{"rw", 0, ErrInvalidIPCMode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			mode, err := ipcMode(&nebletpb.RPCConfig{IpcMode: tt.mode})
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, mode)
+		})
+	}
+}
+
+func TestListenIPC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ipc")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "data", "neb.ipc")
+
+	listener, err := listenIPC(path, DefaultIPCMode)
+	assert.Nil(t, err)
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.True(t, info.Mode()&os.ModeSocket != 0)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// the gateway is served on the socket.
+	go http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	}))
+	client := &http.Client{Transport: &http.Transport{
+		Dial: func(network, addr string) (net.Conn, error) {
+			return net.Dial("unix", path)
+		},
+	}}
+	resp, err := client.Get("http://unix/v1/user/nebstate")
+	assert.Nil(t, err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "/v1/user/nebstate", string(body))
+	listener.Close()
+
+	// the socket left by a crashed run is replaced, with the new mode.
+	stale, err := net.Listen("unix", path)
+	assert.Nil(t, err)
+	// the listener removes its socket when closed, leave it behind as a crash would.
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+	_, err = os.Lstat(path)
+	assert.Nil(t, err)
+	listener, err = listenIPC(path, 0660)
+	assert.Nil(t, err)
+	info, err = os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0660), info.Mode().Perm())
+	listener.Close()
+
+	// a file which is not a socket isn't removed.
+	file := filepath.Join(dir, "neb.ipc")
+	assert.Nil(t, ioutil.WriteFile(file, []byte("data"), 0600))
+	_, err = listenIPC(file, DefaultIPCMode)
+	assert.Equal(t, ErrIPCPathInUse, err)
+	data, err := ioutil.ReadFile(file)
+	assert.Nil(t, err)
+	assert.Equal(t, "data", string(data))
+}
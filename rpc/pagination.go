@@ -0,0 +1,171 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/core"
+)
+
+// const
+const (
+	// DefaultPageLimit is the number of items of a page if the limit isn't given.
+	DefaultPageLimit = 100
+	// MaxPageLimit is the most items of a page.
+	MaxPageLimit = 1000
+
+	// pageFetchSize is the number of blocks fetched at once while walking a page.
+	pageFetchSize = 64
+)
+
+// errors
+var (
+	ErrInvalidCursor     = errors.New("invalid cursor")
+	ErrInvalidBlockRange = errors.New("invalid block range")
+)
+
+// pageCursor is the position of the next item of a list: its block, and its index among the items of the block.
+// The hash of the block tells if a reorg replaced it since.
+type pageCursor struct {
+	height uint64
+	hash   string
+	offset int
+}
+
+func (c *pageCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s:%d", c.height, c.hash, c.offset)))
+}
+
+func parsePageCursor(s string) (*pageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	fields := strings.Split(string(data), ":")
+	if len(fields) != 3 {
+		return nil, ErrInvalidCursor
+	}
+	height, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	offset, err := strconv.Atoi(fields[2])
+	if err != nil || offset < 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &pageCursor{height: height, hash: fields[1], offset: offset}, nil
+}
+
+// page is a page of a list of the items of the blocks of the canonical chain, the oldest first.
+type page struct {
+	items []interface{}
+	// next is the cursor of the next page, empty at the end of the list.
+	next string
+	// reorged tells the block of the cursor was replaced by a reorg, the page starts at the new block of its height:
+	// the items of the replaced block already listed are void.
+	reorged bool
+}
+
+// walkPage list at most limit items of the canonical blocks from the height from to the height to, the tail if 0,
+// or from the cursor. A page searches at most MaxLogsBlockRange blocks, and may hold fewer items than the limit.
+func walkPage(bc *core.BlockChain, from, to uint64, cursor string, limit uint32, items func(*core.Block) ([]interface{}, error)) (*page, error) {
+	tail := bc.TailBlock().Height()
+	if to == 0 || to > tail {
+		to = tail
+	}
+	if from == 0 {
+		from = 1
+	}
+	if from > to {
+		return nil, ErrInvalidBlockRange
+	}
+	max := DefaultPageLimit
+	if limit > 0 {
+		max = int(limit)
+	}
+	if max > MaxPageLimit {
+		max = MaxPageLimit
+	}
+
+	p := &page{}
+	offset := 0
+	if len(cursor) > 0 {
+		c, err := parsePageCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.height < from || c.height > to {
+			return nil, ErrInvalidCursor
+		}
+		from, offset = c.height, c.offset
+		if block := bc.GetBlockOnCanonicalChainByHeight(c.height); block == nil || block.Hash().String() != c.hash {
+			p.reorged, offset = true, 0
+		}
+	}
+
+	height := from
+	for height <= to {
+		if height-from >= MaxLogsBlockRange {
+			p.next = (&pageCursor{height: height, hash: hashAtHeight(bc, height), offset: 0}).String()
+			return p, nil
+		}
+		count := pageFetchSize
+		if to-height+1 < uint64(count) {
+			count = int(to - height + 1)
+		}
+		if from+MaxLogsBlockRange-height < uint64(count) {
+			count = int(from + MaxLogsBlockRange - height)
+		}
+		blocks := bc.FetchBlocksInCanonicalChain(height, count)
+		if len(blocks) == 0 {
+			break
+		}
+		for _, block := range blocks {
+			result, err := items(block)
+			if err != nil {
+				return nil, err
+			}
+			if offset > len(result) {
+				offset = len(result)
+			}
+			for i := offset; i < len(result); i++ {
+				if len(p.items) == max {
+					p.next = (&pageCursor{height: block.Height(), hash: block.Hash().String(), offset: i}).String()
+					return p, nil
+				}
+				p.items = append(p.items, result[i])
+			}
+			offset = 0
+			height = block.Height() + 1
+		}
+	}
+	return p, nil
+}
+
+func hashAtHeight(bc *core.BlockChain, height uint64) string {
+	if block := bc.GetBlockOnCanonicalChainByHeight(height); block != nil {
+		return block.Hash().String()
+	}
+	return ""
+}
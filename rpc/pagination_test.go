@@ -0,0 +1,214 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockNeb struct {
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *core.EventEmitter
+}
+
+func (n *mockNeb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *mockNeb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *mockNeb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *mockNeb) StartSync() {}
+
+type mockConsensus struct{}
+
+func (c mockConsensus) FastVerifyBlock(block *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c mockConsensus) VerifyBlock(block *core.Block, parent *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func mockGenesisConf() *corepb.Genesis {
+	return &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{ChainId: 100},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{
+				Dynasty: []string{
+					"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+					"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+					"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+					"48f981ed38910f1232c1bab124f650c482a57271632db9e3",
+					"59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232",
+					"75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f",
+				},
+			},
+		},
+		TokenDistribution: []*corepb.GenesisTokenDistribution{
+			&corepb.GenesisTokenDistribution{
+				Address: "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+				Value:   "10000000000000000000000",
+			},
+		},
+	}
+}
+
+// mockChain return a chain of blocks minted one per slot after the genesis.
+func mockChain(t *testing.T, blocks int) *core.BlockChain {
+	stor, _ := storage.NewMemoryStorage()
+	bc, err := core.NewBlockChain(&mockNeb{genesis: mockGenesisConf(), storage: stor, emitter: core.NewEventEmitter(1024)})
+	assert.Nil(t, err)
+	bc.SetConsensusHandler(mockConsensus{})
+	for i := 0; i < blocks; i++ {
+		mockBlock(t, bc, bc.TailBlock(), core.BlockInterval)
+	}
+	return bc
+}
+
+// mockBlock mint a block on the parent some seconds after it, and make it the tail.
+func mockBlock(t *testing.T, bc *core.BlockChain, parent *core.Block, elapsed int64) *core.Block {
+	pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	coinbase, _ := core.NewAddressFromPublicKey(pubdata)
+
+	block, err := bc.NewBlockFromParent(coinbase, parent)
+	assert.Nil(t, err)
+	context, err := parent.NextDynastyContext(elapsed)
+	assert.Nil(t, err)
+	assert.Nil(t, block.LoadDynastyContext(context))
+	block.SetMiner(coinbase)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, bc.BlockPool().Push(block))
+	assert.Nil(t, bc.SetTailBlock(block))
+	return block
+}
+
+// twoItems list two items of each block.
+func twoItems(block *core.Block) ([]interface{}, error) {
+	return []interface{}{fmt.Sprintf("%d.0", block.Height()), fmt.Sprintf("%d.1", block.Height())}, nil
+}
+
+func TestPageCursor(t *testing.T) {
+	c := &pageCursor{height: 12, hash: "a1b2", offset: 3}
+	parsed, err := parsePageCursor(c.String())
+	assert.Nil(t, err)
+	assert.Equal(t, parsed, c)
+
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+	for _, v := range []string{
+		"not base64!",
+		encode("12:a1b2"),
+		encode("x:a1b2:0"),
+		encode("12:a1b2:-1"),
+		encode("12:a1b2:99999999999999999999999"),
+	} {
+		_, err := parsePageCursor(v)
+		assert.Equal(t, err, ErrInvalidCursor, v)
+	}
+}
+
+func TestWalkPage(t *testing.T) {
+	bc := mockChain(t, 10)
+
+	var all []interface{}
+	for height := uint64(1); height <= bc.TailBlock().Height(); height++ {
+		all = append(all, fmt.Sprintf("%d.0", height), fmt.Sprintf("%d.1", height))
+	}
+
+	// the pages walked by their cursors list all the items once.
+	var (
+		items  []interface{}
+		cursor string
+	)
+	for {
+		p, err := walkPage(bc, 1, 0, cursor, 3, twoItems)
+		assert.Nil(t, err)
+		assert.False(t, p.reorged)
+		assert.True(t, len(p.items) <= 3)
+		items = append(items, p.items...)
+		if len(p.next) == 0 {
+			break
+		}
+		cursor = p.next
+	}
+	assert.Equal(t, items, all)
+
+	p, err := walkPage(bc, 2, 4, "", 0, twoItems)
+	assert.Nil(t, err)
+	assert.Equal(t, p.items, all[2:8])
+	assert.Equal(t, p.next, "")
+
+	_, err = walkPage(bc, 5, 4, "", 0, twoItems)
+	assert.Equal(t, err, ErrInvalidBlockRange)
+	_, err = walkPage(bc, 5, 0, (&pageCursor{height: 4, hash: hashAtHeight(bc, 4)}).String(), 0, twoItems)
+	assert.Equal(t, err, ErrInvalidCursor)
+
+	// an offset past the items of its block goes on with the next block.
+	p, err = walkPage(bc, 1, 4, (&pageCursor{height: 3, hash: hashAtHeight(bc, 3), offset: 1 << 40}).String(), 0, twoItems)
+	assert.Nil(t, err)
+	assert.Equal(t, p.items, all[6:8])
+
+	// a cursor of a block replaced by a reorg starts at the new block of its height.
+	cursor = (&pageCursor{height: 5, hash: hashAtHeight(bc, 5), offset: 1}).String()
+	parent := bc.FetchBlocksInCanonicalChain(4, 1)[0]
+	fork := mockBlock(t, bc, parent, bc.TailBlock().Timestamp()+core.BlockInterval-parent.Timestamp())
+	p, err = walkPage(bc, 1, 0, cursor, 0, twoItems)
+	assert.Nil(t, err)
+	assert.True(t, p.reorged)
+	assert.Equal(t, p.items, []interface{}{"5.0", "5.1"})
+	assert.Equal(t, hashAtHeight(bc, 5), fork.Hash().String())
+}
+
+func TestWalkPage_BlockRange(t *testing.T) {
+	bc := mockChain(t, MaxLogsBlockRange+10)
+	none := func(block *core.Block) ([]interface{}, error) {
+		return nil, nil
+	}
+
+	// a page stops after searching MaxLogsBlockRange blocks, its cursor goes on with the next one.
+	p, err := walkPage(bc, 1, 0, "", 0, none)
+	assert.Nil(t, err)
+	assert.Equal(t, len(p.items), 0)
+	c, err := parsePageCursor(p.next)
+	assert.Nil(t, err)
+	assert.Equal(t, c, &pageCursor{height: 1 + MaxLogsBlockRange, hash: hashAtHeight(bc, 1+MaxLogsBlockRange), offset: 0})
+
+	p, err = walkPage(bc, 1, 0, p.next, 0, twoItems)
+	assert.Nil(t, err)
+	assert.Equal(t, len(p.items), 2*(int(bc.TailBlock().Height())-MaxLogsBlockRange))
+	assert.Equal(t, p.next, "")
+}
@@ -9,10 +9,35 @@ It is generated from these files:
 
 It has these top-level messages:
 	SubscribeRequest
+	PendingTransactionsRequest
+	PendingTransactionsResponse
+	FlushTransactionPoolResponse
+	SetHeadRequest
+	SetHeadResponse
+	LogLevelRequest
+	LogLevelResponse
+	ConfigResponse
 	ChangeNetworkIDRequest
 	ChangeNetworkIDResponse
+	PeerScoresResponse
+	PeerScore
+	PeerStatsResponse
+	PeerStats
+	AddPeerRequest
+	RemovePeerRequest
+	ChangePeerResponse
+	IPFilterRequest
+	IPFilterResponse
+	PeersResponse
+	ConfiguredPeer
+	TraceTransactionRequest
+	TraceTransactionResponse
+	TraceStep
 	SubscribeResponse
+	NewBlockResponse
+	PendingTxResponse
 	NonParamsRequest
+	HealthResponse
 	NodeInfoResponse
 	StatisticsNodeInfoResponse
 	RouteTable
@@ -24,16 +49,23 @@ It has these top-level messages:
 	GetDelegateVotersRequest
 	GetDelegateVotersResponse
 	TransactionRequest
+	ContractCallRequest
 	ContractRequest
 	CandidateRequest
 	DelegateRequest
+	OracleAnswerRequest
+	DelegateWeight
 	SendRawTransactionRequest
 	SendTransactionResponse
 	GetBlockByHashRequest
 	GetTransactionByHashRequest
+	GetBlockRequest
+	BlockResponse
+	BlockTransaction
 	BlockDumpRequest
 	BlockDumpResponse
 	TransactionReceiptResponse
+	StateDiff
 	NewAccountRequest
 	NewAccountResponse
 	UnlockAccountRequest
@@ -45,8 +77,41 @@ It has these top-level messages:
 	SendTransactionPassphraseResponse
 	GasPriceResponse
 	EstimateGasResponse
+	CallResponse
+	GasProfileEntry
 	EventsResponse
 	Event
+	ContractLog
+	GetLogsRequest
+	GetLogsResponse
+	GetEventsRequest
+	ChainEvent
+	GetEventsResponse
+	GetTokenTransfersRequest
+	TokenTransfer
+	GetTokenTransfersResponse
+	NewFilterRequest
+	NewFilterResponse
+	FilterRequest
+	FilterChangesResponse
+	UninstallFilterResponse
+	GetTransactionsByAddressRequest
+	AddressTransaction
+	GetTransactionsByAddressResponse
+	GetTokenBalanceRequest
+	GetTokenBalanceResponse
+	GetTokenHoldingsRequest
+	GetTokenHoldingsResponse
+	GetContractAbiRequest
+	GetContractAbiResponse
+	AbiFunction
+	AbiArg
+	VerifyContractRequest
+	ContractVerificationResponse
+	LinkedLibrary
+	GetConsensusStateResponse
+	DelegateVotes
+	SyncStateResponse
 */
 package rpcpb
 
@@ -75,6 +140,8 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // Request message of Subscribe rpc
 type SubscribeRequest struct {
 	Topic []string `protobuf:"bytes,1,rep,name=topic" json:"topic,omitempty"`
+	// replay the kept events of the canonical blocks from the height before the new events, none if 0.
+	FromHeight uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
 }
 
 func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
@@ -89,1198 +156,4460 @@ func (m *SubscribeRequest) GetTopic() []string {
 	return nil
 }
 
-// Request message of change networkID.
-type ChangeNetworkIDRequest struct {
-	NetworkId uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+func (m *SubscribeRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
 }
 
-func (m *ChangeNetworkIDRequest) Reset()                    { *m = ChangeNetworkIDRequest{} }
-func (m *ChangeNetworkIDRequest) String() string            { return proto.CompactTextString(m) }
-func (*ChangeNetworkIDRequest) ProtoMessage()               {}
-func (*ChangeNetworkIDRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{1} }
+// Request message of GetPendingTransactions rpc.
+type PendingTransactionsRequest struct {
+	// most transactions returned, 1000 if 0, at most 1000.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
 
-func (m *ChangeNetworkIDRequest) GetNetworkId() uint32 {
+func (m *PendingTransactionsRequest) Reset()                    { *m = PendingTransactionsRequest{} }
+func (m *PendingTransactionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PendingTransactionsRequest) ProtoMessage()               {}
+func (*PendingTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{1} }
+
+func (m *PendingTransactionsRequest) GetLimit() uint32 {
 	if m != nil {
-		return m.NetworkId
+		return m.Limit
 	}
 	return 0
 }
 
-// Response message of change networkID.
-type ChangeNetworkIDResponse struct {
-	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+// Response message of GetPendingTransactions rpc.
+type PendingTransactionsResponse struct {
+	// transactions of the pool by sender and nonce.
+	Transactions []*PendingTxResponse `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+	// number of the transactions of the pool.
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (m *ChangeNetworkIDResponse) Reset()                    { *m = ChangeNetworkIDResponse{} }
-func (m *ChangeNetworkIDResponse) String() string            { return proto.CompactTextString(m) }
-func (*ChangeNetworkIDResponse) ProtoMessage()               {}
-func (*ChangeNetworkIDResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{2} }
+func (m *PendingTransactionsResponse) Reset()                    { *m = PendingTransactionsResponse{} }
+func (m *PendingTransactionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*PendingTransactionsResponse) ProtoMessage()               {}
+func (*PendingTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{2} }
 
-func (m *ChangeNetworkIDResponse) GetResult() bool {
+func (m *PendingTransactionsResponse) GetTransactions() []*PendingTxResponse {
 	if m != nil {
-		return m.Result
+		return m.Transactions
 	}
-	return false
+	return nil
 }
 
-// Request message of Subscribe rpc
-type SubscribeResponse struct {
-	MsgType string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
-	Data    string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *PendingTransactionsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
 
-func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
-func (m *SubscribeResponse) String() string            { return proto.CompactTextString(m) }
-func (*SubscribeResponse) ProtoMessage()               {}
-func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{3} }
+// Response message of FlushTransactionPool rpc.
+type FlushTransactionPoolResponse struct {
+	// number of the transactions dropped.
+	Dropped uint32 `protobuf:"varint,1,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
 
-func (m *SubscribeResponse) GetMsgType() string {
+func (m *FlushTransactionPoolResponse) Reset()                    { *m = FlushTransactionPoolResponse{} }
+func (m *FlushTransactionPoolResponse) String() string            { return proto.CompactTextString(m) }
+func (*FlushTransactionPoolResponse) ProtoMessage()               {}
+func (*FlushTransactionPoolResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{3} }
+
+func (m *FlushTransactionPoolResponse) GetDropped() uint32 {
 	if m != nil {
-		return m.MsgType
+		return m.Dropped
 	}
-	return ""
+	return 0
 }
 
-func (m *SubscribeResponse) GetData() string {
+// Request message of SetHead rpc.
+type SetHeadRequest struct {
+	// height of the new head in the canonical chain.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SetHeadRequest) Reset()                    { *m = SetHeadRequest{} }
+func (m *SetHeadRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetHeadRequest) ProtoMessage()               {}
+func (*SetHeadRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{4} }
+
+func (m *SetHeadRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.Data
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-// Request message of non params.
-type NonParamsRequest struct {
+// Response message of SetHead rpc.
+type SetHeadResponse struct {
+	// Hex string of the hash of the new head.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// height of the new head.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *NonParamsRequest) Reset()                    { *m = NonParamsRequest{} }
-func (m *NonParamsRequest) String() string            { return proto.CompactTextString(m) }
-func (*NonParamsRequest) ProtoMessage()               {}
-func (*NonParamsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{4} }
+func (m *SetHeadResponse) Reset()                    { *m = SetHeadResponse{} }
+func (m *SetHeadResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetHeadResponse) ProtoMessage()               {}
+func (*SetHeadResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{5} }
 
-// Response message of node info.
-type NodeInfoResponse struct {
-	// the node ID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// the block chainID.
-	ChainId uint32 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// the node version.
-	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	// Number of peers currenly connected.
-	PeerCount uint32 `protobuf:"varint,4,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
-	// the node synchronized status.
-	Synchronized bool `protobuf:"varint,5,opt,name=synchronized,proto3" json:"synchronized,omitempty"`
-	// the node route table bucket size.
-	BucketSize int32 `protobuf:"varint,6,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
-	// the node relay cache size.
-	RelayCacheSize int32 `protobuf:"varint,7,opt,name=relay_cache_size,json=relayCacheSize,proto3" json:"relay_cache_size,omitempty"`
-	// the node stream store size.
-	StreamStoreSize int32 `protobuf:"varint,8,opt,name=stream_store_size,json=streamStoreSize,proto3" json:"stream_store_size,omitempty"`
-	// the node stream store extend size.
-	StreamStoreExtendSize int32 `protobuf:"varint,9,opt,name=stream_store_extend_size,json=streamStoreExtendSize,proto3" json:"stream_store_extend_size,omitempty"`
-	// the network protocol version.
-	ProtocolVersion string        `protobuf:"bytes,10,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
-	RouteTable      []*RouteTable `protobuf:"bytes,11,rep,name=route_table,json=routeTable" json:"route_table,omitempty"`
+func (m *SetHeadResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-func (m *NodeInfoResponse) Reset()                    { *m = NodeInfoResponse{} }
-func (m *NodeInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*NodeInfoResponse) ProtoMessage()               {}
-func (*NodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{5} }
+func (m *SetHeadResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
 
-func (m *NodeInfoResponse) GetId() string {
+// Request message of SetLogLevel rpc.
+type LogLevelRequest struct {
+	// panic, fatal, error, warn, info or debug, unchanged if empty.
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (m *LogLevelRequest) Reset()                    { *m = LogLevelRequest{} }
+func (m *LogLevelRequest) String() string            { return proto.CompactTextString(m) }
+func (*LogLevelRequest) ProtoMessage()               {}
+func (*LogLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{6} }
+
+func (m *LogLevelRequest) GetLevel() string {
 	if m != nil {
-		return m.Id
+		return m.Level
 	}
 	return ""
 }
 
-func (m *NodeInfoResponse) GetChainId() uint32 {
+// Response message of SetLogLevel rpc.
+type LogLevelResponse struct {
+	// level of the log.
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (m *LogLevelResponse) Reset()                    { *m = LogLevelResponse{} }
+func (m *LogLevelResponse) String() string            { return proto.CompactTextString(m) }
+func (*LogLevelResponse) ProtoMessage()               {}
+func (*LogLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{7} }
+
+func (m *LogLevelResponse) GetLevel() string {
 	if m != nil {
-		return m.ChainId
+		return m.Level
 	}
-	return 0
+	return ""
 }
 
-func (m *NodeInfoResponse) GetVersion() uint32 {
+// Response message of GetConfig rpc.
+type ConfigResponse struct {
+	// config in the protobuf text format.
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *ConfigResponse) Reset()                    { *m = ConfigResponse{} }
+func (m *ConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*ConfigResponse) ProtoMessage()               {}
+func (*ConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{8} }
+
+func (m *ConfigResponse) GetConfig() string {
 	if m != nil {
-		return m.Version
+		return m.Config
 	}
-	return 0
+	return ""
 }
 
-func (m *NodeInfoResponse) GetPeerCount() uint32 {
+// Request message of change networkID.
+type ChangeNetworkIDRequest struct {
+	NetworkId uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+}
+
+func (m *ChangeNetworkIDRequest) Reset()                    { *m = ChangeNetworkIDRequest{} }
+func (m *ChangeNetworkIDRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangeNetworkIDRequest) ProtoMessage()               {}
+func (*ChangeNetworkIDRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{9} }
+
+func (m *ChangeNetworkIDRequest) GetNetworkId() uint32 {
 	if m != nil {
-		return m.PeerCount
+		return m.NetworkId
 	}
 	return 0
 }
 
-func (m *NodeInfoResponse) GetSynchronized() bool {
+// Response message of change networkID.
+type ChangeNetworkIDResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *ChangeNetworkIDResponse) Reset()                    { *m = ChangeNetworkIDResponse{} }
+func (m *ChangeNetworkIDResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChangeNetworkIDResponse) ProtoMessage()               {}
+func (*ChangeNetworkIDResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{10} }
+
+func (m *ChangeNetworkIDResponse) GetResult() bool {
 	if m != nil {
-		return m.Synchronized
+		return m.Result
 	}
 	return false
 }
 
-func (m *NodeInfoResponse) GetBucketSize() int32 {
+// Response message of GetPeerScores rpc.
+type PeerScoresResponse struct {
+	Peers []*PeerScore `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *PeerScoresResponse) Reset()                    { *m = PeerScoresResponse{} }
+func (m *PeerScoresResponse) String() string            { return proto.CompactTextString(m) }
+func (*PeerScoresResponse) ProtoMessage()               {}
+func (*PeerScoresResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{11} }
+
+func (m *PeerScoresResponse) GetPeers() []*PeerScore {
 	if m != nil {
-		return m.BucketSize
+		return m.Peers
 	}
-	return 0
+	return nil
 }
 
-func (m *NodeInfoResponse) GetRelayCacheSize() int32 {
+type PeerScore struct {
+	// the peer ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the peer score, banned when it drops to -ban_score.
+	Score int32 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// count of the misbehaviors.
+	InvalidMessages    uint32 `protobuf:"varint,3,opt,name=invalid_messages,json=invalidMessages,proto3" json:"invalid_messages,omitempty"`
+	UselessBlocks      uint32 `protobuf:"varint,4,opt,name=useless_blocks,json=uselessBlocks,proto3" json:"useless_blocks,omitempty"`
+	Timeouts           uint32 `protobuf:"varint,5,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	ProtocolViolations uint32 `protobuf:"varint,6,opt,name=protocol_violations,json=protocolViolations,proto3" json:"protocol_violations,omitempty"`
+	// unix time the ban is lifted at, 0 if not banned.
+	BannedUntil int64 `protobuf:"varint,7,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+	// the latest misbehavior and the unix time it was reported at.
+	LastMisbehavior string `protobuf:"bytes,8,opt,name=last_misbehavior,json=lastMisbehavior,proto3" json:"last_misbehavior,omitempty"`
+	LastReported    int64  `protobuf:"varint,9,opt,name=last_reported,json=lastReported,proto3" json:"last_reported,omitempty"`
+}
+
+func (m *PeerScore) Reset()                    { *m = PeerScore{} }
+func (m *PeerScore) String() string            { return proto.CompactTextString(m) }
+func (*PeerScore) ProtoMessage()               {}
+func (*PeerScore) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{12} }
+
+func (m *PeerScore) GetId() string {
 	if m != nil {
-		return m.RelayCacheSize
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *NodeInfoResponse) GetStreamStoreSize() int32 {
+func (m *PeerScore) GetScore() int32 {
 	if m != nil {
-		return m.StreamStoreSize
+		return m.Score
 	}
 	return 0
 }
 
-func (m *NodeInfoResponse) GetStreamStoreExtendSize() int32 {
+func (m *PeerScore) GetInvalidMessages() uint32 {
 	if m != nil {
-		return m.StreamStoreExtendSize
+		return m.InvalidMessages
 	}
 	return 0
 }
 
-func (m *NodeInfoResponse) GetProtocolVersion() string {
+func (m *PeerScore) GetUselessBlocks() uint32 {
 	if m != nil {
-		return m.ProtocolVersion
+		return m.UselessBlocks
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeInfoResponse) GetRouteTable() []*RouteTable {
+func (m *PeerScore) GetTimeouts() uint32 {
 	if m != nil {
-		return m.RouteTable
+		return m.Timeouts
 	}
-	return nil
-}
-
-type StatisticsNodeInfoResponse struct {
-	NodeID    string `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
-	Height    uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
-	Hash      string `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
-	PeerCount uint32 `protobuf:"varint,4,opt,name=PeerCount,proto3" json:"PeerCount,omitempty"`
+	return 0
 }
 
-func (m *StatisticsNodeInfoResponse) Reset()                    { *m = StatisticsNodeInfoResponse{} }
-func (m *StatisticsNodeInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*StatisticsNodeInfoResponse) ProtoMessage()               {}
-func (*StatisticsNodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{6} }
-
-func (m *StatisticsNodeInfoResponse) GetNodeID() string {
+func (m *PeerScore) GetProtocolViolations() uint32 {
 	if m != nil {
-		return m.NodeID
+		return m.ProtocolViolations
 	}
-	return ""
+	return 0
 }
 
-func (m *StatisticsNodeInfoResponse) GetHeight() uint64 {
+func (m *PeerScore) GetBannedUntil() int64 {
 	if m != nil {
-		return m.Height
+		return m.BannedUntil
 	}
 	return 0
 }
 
-func (m *StatisticsNodeInfoResponse) GetHash() string {
+func (m *PeerScore) GetLastMisbehavior() string {
 	if m != nil {
-		return m.Hash
+		return m.LastMisbehavior
 	}
 	return ""
 }
 
-func (m *StatisticsNodeInfoResponse) GetPeerCount() uint32 {
+func (m *PeerScore) GetLastReported() int64 {
 	if m != nil {
-		return m.PeerCount
+		return m.LastReported
 	}
 	return 0
 }
 
-type RouteTable struct {
-	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address []string `protobuf:"bytes,2,rep,name=address" json:"address,omitempty"`
+// Response message of GetPeerStats rpc.
+type PeerStatsResponse struct {
+	Peers []*PeerStats `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
 }
 
-func (m *RouteTable) Reset()                    { *m = RouteTable{} }
-func (m *RouteTable) String() string            { return proto.CompactTextString(m) }
-func (*RouteTable) ProtoMessage()               {}
-func (*RouteTable) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{7} }
+func (m *PeerStatsResponse) Reset()                    { *m = PeerStatsResponse{} }
+func (m *PeerStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*PeerStatsResponse) ProtoMessage()               {}
+func (*PeerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{13} }
 
-func (m *RouteTable) GetId() string {
+func (m *PeerStatsResponse) GetPeers() []*PeerStats {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type PeerStats struct {
+	// the peer ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the address of the connection.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the client version given in the handshake.
+	ClientVersion string `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// seconds since the connection.
+	ConnectionDuration int64 `protobuf:"varint,4,opt,name=connection_duration,json=connectionDuration,proto3" json:"connection_duration,omitempty"`
+	// the latest block the peer sent.
+	HeadHeight uint64 `protobuf:"varint,5,opt,name=head_height,json=headHeight,proto3" json:"head_height,omitempty"`
+	HeadHash   string `protobuf:"bytes,6,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"`
+	// round-trip time of the latest ping in milliseconds, 0 if not measured.
+	Latency int64 `protobuf:"varint,7,opt,name=latency,proto3" json:"latency,omitempty"`
+	// bytes received from and sent to the peer.
+	BytesIn  int64 `protobuf:"varint,8,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut int64 `protobuf:"varint,9,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	// the score of a penalized peer, null if it is not.
+	Score *PeerScore `protobuf:"bytes,10,opt,name=score" json:"score,omitempty"`
+	// the connection was dialed by the node.
+	Outbound bool `protobuf:"varint,11,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	// the protocol version negotiated in the handshake.
+	ProtocolVersion uint32 `protobuf:"varint,12,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+}
+
+func (m *PeerStats) Reset()                    { *m = PeerStats{} }
+func (m *PeerStats) String() string            { return proto.CompactTextString(m) }
+func (*PeerStats) ProtoMessage()               {}
+func (*PeerStats) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{14} }
+
+func (m *PeerStats) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *RouteTable) GetAddress() []string {
+func (m *PeerStats) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
-	return nil
+	return ""
 }
 
-// Response message of GetNebState rpc.
-type GetNebStateResponse struct {
-	// Block chain id
-	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Current neb tail hash
-	Tail string `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
-	// Neb coinbase
-	Coinbase string `protobuf:"bytes,3,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
-	// Number of peers currenly connected
-	PeerCount uint32 `protobuf:"varint,4,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
-	// Neb mine status, minging is true ,otherwise false
-	IsMining bool `protobuf:"varint,5,opt,name=is_mining,json=isMining,proto3" json:"is_mining,omitempty"`
-	// The current neb protocol version.
-	ProtocolVersion string `protobuf:"bytes,6,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
-	// The peer sync status.
-	Synchronized bool   `protobuf:"varint,7,opt,name=synchronized,proto3" json:"synchronized,omitempty"`
-	Version      string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+func (m *PeerStats) GetClientVersion() string {
+	if m != nil {
+		return m.ClientVersion
+	}
+	return ""
 }
 
-func (m *GetNebStateResponse) Reset()                    { *m = GetNebStateResponse{} }
-func (m *GetNebStateResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetNebStateResponse) ProtoMessage()               {}
-func (*GetNebStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{8} }
+func (m *PeerStats) GetConnectionDuration() int64 {
+	if m != nil {
+		return m.ConnectionDuration
+	}
+	return 0
+}
 
-func (m *GetNebStateResponse) GetChainId() uint32 {
+func (m *PeerStats) GetHeadHeight() uint64 {
 	if m != nil {
-		return m.ChainId
+		return m.HeadHeight
 	}
 	return 0
 }
 
-func (m *GetNebStateResponse) GetTail() string {
+func (m *PeerStats) GetHeadHash() string {
 	if m != nil {
-		return m.Tail
+		return m.HeadHash
 	}
 	return ""
 }
 
-func (m *GetNebStateResponse) GetCoinbase() string {
+func (m *PeerStats) GetLatency() int64 {
 	if m != nil {
-		return m.Coinbase
+		return m.Latency
 	}
-	return ""
+	return 0
 }
 
-func (m *GetNebStateResponse) GetPeerCount() uint32 {
+func (m *PeerStats) GetBytesIn() int64 {
 	if m != nil {
-		return m.PeerCount
+		return m.BytesIn
 	}
 	return 0
 }
 
-func (m *GetNebStateResponse) GetIsMining() bool {
+func (m *PeerStats) GetBytesOut() int64 {
 	if m != nil {
-		return m.IsMining
+		return m.BytesOut
 	}
-	return false
+	return 0
 }
 
-func (m *GetNebStateResponse) GetProtocolVersion() string {
+func (m *PeerStats) GetScore() *PeerScore {
 	if m != nil {
-		return m.ProtocolVersion
+		return m.Score
 	}
-	return ""
+	return nil
 }
 
-func (m *GetNebStateResponse) GetSynchronized() bool {
+func (m *PeerStats) GetOutbound() bool {
 	if m != nil {
-		return m.Synchronized
+		return m.Outbound
 	}
 	return false
 }
 
-func (m *GetNebStateResponse) GetVersion() string {
+func (m *PeerStats) GetProtocolVersion() uint32 {
 	if m != nil {
-		return m.Version
+		return m.ProtocolVersion
 	}
-	return ""
+	return 0
 }
 
-// Response message of Accounts rpc.
-type AccountsResponse struct {
-	// Account list
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+// Request message of AddPeer rpc.
+type AddPeerRequest struct {
+	// multiaddr with the peer id, or the peer id of a trusted only peer.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// always reconnect to the peer.
+	Static bool `protobuf:"varint,2,opt,name=static,proto3" json:"static,omitempty"`
+	// exempt the peer from the scoring, the bans and the connection limits.
+	Trusted bool `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
 }
 
-func (m *AccountsResponse) Reset()                    { *m = AccountsResponse{} }
-func (m *AccountsResponse) String() string            { return proto.CompactTextString(m) }
-func (*AccountsResponse) ProtoMessage()               {}
-func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{9} }
+func (m *AddPeerRequest) Reset()                    { *m = AddPeerRequest{} }
+func (m *AddPeerRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddPeerRequest) ProtoMessage()               {}
+func (*AddPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{15} }
 
-func (m *AccountsResponse) GetAddresses() []string {
+func (m *AddPeerRequest) GetAddress() string {
 	if m != nil {
-		return m.Addresses
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-// Request message of GetAccountState rpc.
-type GetAccountStateRequest struct {
-	// Hex string of the account addresss.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Hex string block number, or one of "latest", "earliest" or "pending". If not specified, use "latest".
-	Block string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+func (m *AddPeerRequest) GetStatic() bool {
+	if m != nil {
+		return m.Static
+	}
+	return false
 }
 
-func (m *GetAccountStateRequest) Reset()                    { *m = GetAccountStateRequest{} }
-func (m *GetAccountStateRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetAccountStateRequest) ProtoMessage()               {}
-func (*GetAccountStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{10} }
+func (m *AddPeerRequest) GetTrusted() bool {
+	if m != nil {
+		return m.Trusted
+	}
+	return false
+}
 
-func (m *GetAccountStateRequest) GetAddress() string {
+// Request message of RemovePeer rpc.
+type RemovePeerRequest struct {
+	// the peer ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *RemovePeerRequest) Reset()                    { *m = RemovePeerRequest{} }
+func (m *RemovePeerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemovePeerRequest) ProtoMessage()               {}
+func (*RemovePeerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{16} }
+
+func (m *RemovePeerRequest) GetId() string {
 	if m != nil {
-		return m.Address
+		return m.Id
 	}
 	return ""
 }
 
-func (m *GetAccountStateRequest) GetBlock() string {
+// Response message of AddPeer and RemovePeer rpc.
+type ChangePeerResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *ChangePeerResponse) Reset()                    { *m = ChangePeerResponse{} }
+func (m *ChangePeerResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChangePeerResponse) ProtoMessage()               {}
+func (*ChangePeerResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{17} }
+
+func (m *ChangePeerResponse) GetResult() bool {
 	if m != nil {
-		return m.Block
+		return m.Result
 	}
-	return ""
+	return false
 }
 
-// Response message of GetAccountState rpc.
-type GetAccountStateResponse struct {
-	// Current balance in unit of 1/(10^18) nas.
-	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	// Current transaction count.
-	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+// Request message of AddIPFilter and RemoveIPFilter rpc.
+type IPFilterRequest struct {
+	// CIDR like 10.0.0.0/8, or a single IP.
+	Cidr string `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	// add the CIDR to the denylist instead of the allowlist.
+	Deny bool `protobuf:"varint,2,opt,name=deny,proto3" json:"deny,omitempty"`
 }
 
-func (m *GetAccountStateResponse) Reset()                    { *m = GetAccountStateResponse{} }
-func (m *GetAccountStateResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetAccountStateResponse) ProtoMessage()               {}
-func (*GetAccountStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{11} }
+func (m *IPFilterRequest) Reset()                    { *m = IPFilterRequest{} }
+func (m *IPFilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*IPFilterRequest) ProtoMessage()               {}
+func (*IPFilterRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{18} }
 
-func (m *GetAccountStateResponse) GetBalance() string {
+func (m *IPFilterRequest) GetCidr() string {
 	if m != nil {
-		return m.Balance
+		return m.Cidr
 	}
 	return ""
 }
 
-func (m *GetAccountStateResponse) GetNonce() string {
+func (m *IPFilterRequest) GetDeny() bool {
 	if m != nil {
-		return m.Nonce
+		return m.Deny
 	}
-	return ""
+	return false
 }
 
-// Response message of GetDynastyRequest rpc
-type GetDynastyResponse struct {
-	Delegatees []string `protobuf:"bytes,1,rep,name=delegatees" json:"delegatees,omitempty"`
+// Response message of the IP filter rpcs.
+type IPFilterResponse struct {
+	Allow []string `protobuf:"bytes,1,rep,name=allow" json:"allow,omitempty"`
+	Deny  []string `protobuf:"bytes,2,rep,name=deny" json:"deny,omitempty"`
 }
 
-func (m *GetDynastyResponse) Reset()                    { *m = GetDynastyResponse{} }
-func (m *GetDynastyResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetDynastyResponse) ProtoMessage()               {}
-func (*GetDynastyResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{12} }
+func (m *IPFilterResponse) Reset()                    { *m = IPFilterResponse{} }
+func (m *IPFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*IPFilterResponse) ProtoMessage()               {}
+func (*IPFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{19} }
 
-func (m *GetDynastyResponse) GetDelegatees() []string {
+func (m *IPFilterResponse) GetAllow() []string {
 	if m != nil {
-		return m.Delegatees
+		return m.Allow
 	}
 	return nil
 }
 
-// Response message of GetDelegateVoters rpc
-type GetDelegateVotersRequest struct {
-	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
-}
-
-func (m *GetDelegateVotersRequest) Reset()                    { *m = GetDelegateVotersRequest{} }
-func (m *GetDelegateVotersRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetDelegateVotersRequest) ProtoMessage()               {}
-func (*GetDelegateVotersRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{13} }
-
-func (m *GetDelegateVotersRequest) GetDelegatee() string {
+func (m *IPFilterResponse) GetDeny() []string {
 	if m != nil {
-		return m.Delegatee
+		return m.Deny
 	}
-	return ""
+	return nil
 }
 
-// Response message of GetDelegateVoters rpc
-type GetDelegateVotersResponse struct {
-	Voters []string `protobuf:"bytes,1,rep,name=voters" json:"voters,omitempty"`
+// Response message of GetPeers rpc.
+type PeersResponse struct {
+	Peers []*ConfiguredPeer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
 }
 
-func (m *GetDelegateVotersResponse) Reset()                    { *m = GetDelegateVotersResponse{} }
-func (m *GetDelegateVotersResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetDelegateVotersResponse) ProtoMessage()               {}
-func (*GetDelegateVotersResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{14} }
+func (m *PeersResponse) Reset()                    { *m = PeersResponse{} }
+func (m *PeersResponse) String() string            { return proto.CompactTextString(m) }
+func (*PeersResponse) ProtoMessage()               {}
+func (*PeersResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{20} }
 
-func (m *GetDelegateVotersResponse) GetVoters() []string {
+func (m *PeersResponse) GetPeers() []*ConfiguredPeer {
 	if m != nil {
-		return m.Voters
+		return m.Peers
 	}
 	return nil
 }
 
-// Request message of SendTransaction rpc.
-type TransactionRequest struct {
-	// Hex string of the sender account addresss.
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	// Hex string of the receiver account addresss.
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	// Amount of value sending with this transaction.
-	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	// Transaction nonce.
-	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// gasPrice sending with this transaction.
-	GasPrice string `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	// gasLimit sending with this transaction.
-	GasLimit string `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	// contract sending with this transaction
-	Contract *ContractRequest `protobuf:"bytes,7,opt,name=contract" json:"contract,omitempty"`
-	// candidate application sending with this transaction.
-	Candidate *CandidateRequest `protobuf:"bytes,8,opt,name=candidate" json:"candidate,omitempty"`
-	// delegate vote sending with this transaction.
-	Delegate *DelegateRequest `protobuf:"bytes,9,opt,name=delegate" json:"delegate,omitempty"`
+type ConfiguredPeer struct {
+	// the peer ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// multiaddr of a static peer.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Static  bool   `protobuf:"varint,3,opt,name=static,proto3" json:"static,omitempty"`
+	Trusted bool   `protobuf:"varint,4,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	// the peer is connected now.
+	Connected bool `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
 }
 
-func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
-func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*TransactionRequest) ProtoMessage()               {}
-func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{15} }
+func (m *ConfiguredPeer) Reset()                    { *m = ConfiguredPeer{} }
+func (m *ConfiguredPeer) String() string            { return proto.CompactTextString(m) }
+func (*ConfiguredPeer) ProtoMessage()               {}
+func (*ConfiguredPeer) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{21} }
 
-func (m *TransactionRequest) GetFrom() string {
+func (m *ConfiguredPeer) GetId() string {
 	if m != nil {
-		return m.From
+		return m.Id
 	}
 	return ""
 }
 
-func (m *TransactionRequest) GetTo() string {
+func (m *ConfiguredPeer) GetAddress() string {
 	if m != nil {
-		return m.To
+		return m.Address
 	}
 	return ""
 }
 
-func (m *TransactionRequest) GetValue() string {
+func (m *ConfiguredPeer) GetStatic() bool {
 	if m != nil {
-		return m.Value
+		return m.Static
 	}
-	return ""
+	return false
 }
 
-func (m *TransactionRequest) GetNonce() uint64 {
+func (m *ConfiguredPeer) GetTrusted() bool {
 	if m != nil {
-		return m.Nonce
+		return m.Trusted
 	}
-	return 0
+	return false
 }
 
-func (m *TransactionRequest) GetGasPrice() string {
+func (m *ConfiguredPeer) GetConnected() bool {
 	if m != nil {
-		return m.GasPrice
+		return m.Connected
 	}
-	return ""
+	return false
 }
 
-func (m *TransactionRequest) GetGasLimit() string {
+// Request message of TraceTransaction rpc.
+type TraceTransactionRequest struct {
+	// Hex string of the block hash including the transaction, searched in the canonical chain if empty.
+	Block string `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// Hex string of the transaction hash.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *TraceTransactionRequest) Reset()                    { *m = TraceTransactionRequest{} }
+func (m *TraceTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*TraceTransactionRequest) ProtoMessage()               {}
+func (*TraceTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{22} }
+
+func (m *TraceTransactionRequest) GetBlock() string {
 	if m != nil {
-		return m.GasLimit
+		return m.Block
 	}
 	return ""
 }
 
-func (m *TransactionRequest) GetContract() *ContractRequest {
+func (m *TraceTransactionRequest) GetHash() string {
 	if m != nil {
-		return m.Contract
+		return m.Hash
 	}
-	return nil
+	return ""
 }
 
-func (m *TransactionRequest) GetCandidate() *CandidateRequest {
+// Response message of TraceTransaction rpc.
+type TraceTransactionResponse struct {
+	// steps of the contracts in the order they run.
+	Steps []*TraceStep `protobuf:"bytes,1,rep,name=steps" json:"steps,omitempty"`
+	// gas used by the transaction.
+	GasUsed string `protobuf:"bytes,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error failing the execution, empty if succeeded.
+	ExecuteErr string `protobuf:"bytes,3,opt,name=execute_err,json=executeErr,proto3" json:"execute_err,omitempty"`
+	// keys of contract storage written by the transaction, empty if failed.
+	StateDiffs []*StateDiff `protobuf:"bytes,4,rep,name=state_diffs,json=stateDiffs" json:"state_diffs,omitempty"`
+	// events emitted by the transaction, empty if failed.
+	Events []*Event `protobuf:"bytes,5,rep,name=events" json:"events,omitempty"`
+	// Hex string of the block hash including the transaction.
+	Block string `protobuf:"bytes,6,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *TraceTransactionResponse) Reset()                    { *m = TraceTransactionResponse{} }
+func (m *TraceTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*TraceTransactionResponse) ProtoMessage()               {}
+func (*TraceTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{23} }
+
+func (m *TraceTransactionResponse) GetSteps() []*TraceStep {
 	if m != nil {
-		return m.Candidate
+		return m.Steps
 	}
 	return nil
 }
 
-func (m *TransactionRequest) GetDelegate() *DelegateRequest {
+func (m *TraceTransactionResponse) GetGasUsed() string {
 	if m != nil {
-		return m.Delegate
+		return m.GasUsed
 	}
-	return nil
-}
-
-type ContractRequest struct {
-	// contract source code.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// contract source type, support javascript and typescript
-	SourceType string `protobuf:"bytes,2,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
-	// call contract function name
-	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
-	// the params of contract.
-	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+	return ""
 }
 
-func (m *ContractRequest) Reset()                    { *m = ContractRequest{} }
-func (m *ContractRequest) String() string            { return proto.CompactTextString(m) }
-func (*ContractRequest) ProtoMessage()               {}
-func (*ContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{16} }
-
-func (m *ContractRequest) GetSource() string {
+func (m *TraceTransactionResponse) GetExecuteErr() string {
 	if m != nil {
-		return m.Source
+		return m.ExecuteErr
 	}
 	return ""
 }
 
-func (m *ContractRequest) GetSourceType() string {
+func (m *TraceTransactionResponse) GetStateDiffs() []*StateDiff {
 	if m != nil {
-		return m.SourceType
+		return m.StateDiffs
 	}
-	return ""
+	return nil
 }
 
-func (m *ContractRequest) GetFunction() string {
+func (m *TraceTransactionResponse) GetEvents() []*Event {
 	if m != nil {
-		return m.Function
+		return m.Events
 	}
-	return ""
+	return nil
 }
 
-func (m *ContractRequest) GetArgs() string {
+func (m *TraceTransactionResponse) GetBlock() string {
 	if m != nil {
-		return m.Args
+		return m.Block
 	}
 	return ""
 }
 
-type CandidateRequest struct {
-	// candidate action.
-	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+type TraceStep struct {
+	// Hex string of the contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// line, storage_get, storage_put or storage_del.
+	Op string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	// line of the contract source, only for the line steps.
+	Line uint32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// gas charged by the step.
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	// key and value of the storage accessed.
+	Key   string `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	// function on the line, only for the line steps.
+	Function string `protobuf:"bytes,7,opt,name=function,proto3" json:"function,omitempty"`
+}
+
+func (m *TraceStep) Reset()                    { *m = TraceStep{} }
+func (m *TraceStep) String() string            { return proto.CompactTextString(m) }
+func (*TraceStep) ProtoMessage()               {}
+func (*TraceStep) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{24} }
+
+func (m *TraceStep) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func (m *CandidateRequest) Reset()                    { *m = CandidateRequest{} }
-func (m *CandidateRequest) String() string            { return proto.CompactTextString(m) }
-func (*CandidateRequest) ProtoMessage()               {}
-func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{17} }
-
-func (m *CandidateRequest) GetAction() string {
+func (m *TraceStep) GetOp() string {
 	if m != nil {
-		return m.Action
+		return m.Op
 	}
 	return ""
 }
 
-type DelegateRequest struct {
-	// delegate action.
-	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
-	// delegatee.
-	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+func (m *TraceStep) GetLine() uint32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
 }
 
-func (m *DelegateRequest) Reset()                    { *m = DelegateRequest{} }
-func (m *DelegateRequest) String() string            { return proto.CompactTextString(m) }
-func (*DelegateRequest) ProtoMessage()               {}
-func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{18} }
-
-func (m *DelegateRequest) GetAction() string {
+func (m *TraceStep) GetGas() uint64 {
 	if m != nil {
-		return m.Action
+		return m.Gas
 	}
-	return ""
+	return 0
 }
 
-func (m *DelegateRequest) GetDelegatee() string {
+func (m *TraceStep) GetKey() string {
 	if m != nil {
-		return m.Delegatee
+		return m.Key
 	}
 	return ""
 }
 
-// Request message of SendRawTransactionRequest rpc.
-type SendRawTransactionRequest struct {
-	// Signed data of transaction
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (m *TraceStep) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
-func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*SendRawTransactionRequest) ProtoMessage()               {}
-func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{19} }
-
-func (m *SendRawTransactionRequest) GetData() []byte {
+func (m *TraceStep) GetFunction() string {
 	if m != nil {
-		return m.Data
+		return m.Function
 	}
-	return nil
+	return ""
 }
 
-// Response message of SendTransaction rpc.
-type SendTransactionResponse struct {
-	// Hex string of transaction hash.
-	Txhash          string `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
-	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	Result          bool   `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+// Request message of Subscribe rpc
+type SubscribeResponse struct {
+	MsgType string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	Data    string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// height of the block emitting the replayed event, 0 for the new events.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the hash of the block emitting the replayed event.
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
-func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SendTransactionResponse) ProtoMessage()               {}
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{20} }
+func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()               {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{25} }
 
-func (m *SendTransactionResponse) GetTxhash() string {
+func (m *SubscribeResponse) GetMsgType() string {
 	if m != nil {
-		return m.Txhash
+		return m.MsgType
 	}
 	return ""
 }
 
-func (m *SendTransactionResponse) GetContractAddress() string {
+func (m *SubscribeResponse) GetData() string {
 	if m != nil {
-		return m.ContractAddress
+		return m.Data
 	}
 	return ""
 }
 
-func (m *SendTransactionResponse) GetResult() bool {
+func (m *SubscribeResponse) GetHeight() uint64 {
 	if m != nil {
-		return m.Result
+		return m.Height
 	}
-	return false
+	return 0
 }
 
-// Request message of GetBlockByHash rpc.
-type GetBlockByHashRequest struct {
-	// Hex string of block hash.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+func (m *SubscribeResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
 }
 
-func (m *GetBlockByHashRequest) Reset()                    { *m = GetBlockByHashRequest{} }
-func (m *GetBlockByHashRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetBlockByHashRequest) ProtoMessage()               {}
-func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{21} }
-
-func (m *GetBlockByHashRequest) GetHash() string {
+// Response message of SubscribeNewBlock rpc.
+type NewBlockResponse struct {
+	// Hex string of the block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the parent block hash.
+	ParentHash string `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	// Block height.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Block timestamp.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hex string of the coinbase address.
+	Coinbase string `protobuf:"bytes,5,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	// Hex string of the miner address.
+	Miner string `protobuf:"bytes,6,opt,name=miner,proto3" json:"miner,omitempty"`
+	// Number of the transactions of the block.
+	TxCount uint32 `protobuf:"varint,7,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// true if the block is reverted from the canonical chain by a fork.
+	Reverted bool `protobuf:"varint,8,opt,name=reverted,proto3" json:"reverted,omitempty"`
+}
+
+func (m *NewBlockResponse) Reset()                    { *m = NewBlockResponse{} }
+func (m *NewBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*NewBlockResponse) ProtoMessage()               {}
+func (*NewBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{26} }
+
+func (m *NewBlockResponse) GetHash() string {
 	if m != nil {
 		return m.Hash
 	}
 	return ""
 }
 
-// Request message of GetTransactionByHash rpc.
-type GetTransactionByHashRequest struct {
-	// Hex string of transaction hash.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+func (m *NewBlockResponse) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
 }
 
-func (m *GetTransactionByHashRequest) Reset()         { *m = GetTransactionByHashRequest{} }
-func (m *GetTransactionByHashRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTransactionByHashRequest) ProtoMessage()    {}
-func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{22}
+func (m *NewBlockResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *GetTransactionByHashRequest) GetHash() string {
+func (m *NewBlockResponse) GetTimestamp() int64 {
 	if m != nil {
-		return m.Hash
+		return m.Timestamp
 	}
-	return ""
+	return 0
 }
 
-// Request message of BlockDump.
-type BlockDumpRequest struct {
-	// the count of blocks to dump before current tail.
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+func (m *NewBlockResponse) GetCoinbase() string {
+	if m != nil {
+		return m.Coinbase
+	}
+	return ""
 }
 
-func (m *BlockDumpRequest) Reset()                    { *m = BlockDumpRequest{} }
-func (m *BlockDumpRequest) String() string            { return proto.CompactTextString(m) }
-func (*BlockDumpRequest) ProtoMessage()               {}
-func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{23} }
-
-func (m *BlockDumpRequest) GetCount() int32 {
+func (m *NewBlockResponse) GetMiner() string {
 	if m != nil {
-		return m.Count
+		return m.Miner
 	}
-	return 0
+	return ""
 }
 
-// Response message of BlockDump.
-type BlockDumpResponse struct {
-	// block dump info.
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (m *NewBlockResponse) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
 }
 
-func (m *BlockDumpResponse) Reset()                    { *m = BlockDumpResponse{} }
-func (m *BlockDumpResponse) String() string            { return proto.CompactTextString(m) }
-func (*BlockDumpResponse) ProtoMessage()               {}
-func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{24} }
-
-func (m *BlockDumpResponse) GetData() string {
+func (m *NewBlockResponse) GetReverted() bool {
 	if m != nil {
-		return m.Data
+		return m.Reverted
 	}
-	return ""
+	return false
 }
 
-// Response message of TransactionReceipt.
-type TransactionReceiptResponse struct {
+// Response message of SubscribePendingTx rpc.
+type PendingTxResponse struct {
 	// Hex string of tx hash.
-	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ChainId uint32 `protobuf:"varint,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	// Hex string of the sender account addresss.
-	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
 	// Hex string of the receiver account addresss.
-	To    string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Transaction value.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 	// Transaction nonce.
-	Nonce           uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Timestamp       int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Type            string `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
-	Data            string `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
-	GasPrice        string `protobuf:"bytes,10,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	GasLimit        string `protobuf:"bytes,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	ContractAddress string `protobuf:"bytes,12,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-}
-
-func (m *TransactionReceiptResponse) Reset()         { *m = TransactionReceiptResponse{} }
-func (m *TransactionReceiptResponse) String() string { return proto.CompactTextString(m) }
-func (*TransactionReceiptResponse) ProtoMessage()    {}
-func (*TransactionReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{25}
-}
-
-func (m *TransactionReceiptResponse) GetHash() string {
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Transaction timestamp.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Transaction type.
+	Type string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	// Transaction gas price.
+	GasPrice string `protobuf:"bytes,8,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// Transaction gas limit.
+	GasLimit string `protobuf:"bytes,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *PendingTxResponse) Reset()                    { *m = PendingTxResponse{} }
+func (m *PendingTxResponse) String() string            { return proto.CompactTextString(m) }
+func (*PendingTxResponse) ProtoMessage()               {}
+func (*PendingTxResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{27} }
+
+func (m *PendingTxResponse) GetHash() string {
 	if m != nil {
 		return m.Hash
 	}
 	return ""
 }
 
-func (m *TransactionReceiptResponse) GetChainId() uint32 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
-}
-
-func (m *TransactionReceiptResponse) GetFrom() string {
+func (m *PendingTxResponse) GetFrom() string {
 	if m != nil {
 		return m.From
 	}
 	return ""
 }
 
-func (m *TransactionReceiptResponse) GetTo() string {
+func (m *PendingTxResponse) GetTo() string {
 	if m != nil {
 		return m.To
 	}
 	return ""
 }
 
-func (m *TransactionReceiptResponse) GetValue() string {
+func (m *PendingTxResponse) GetValue() string {
 	if m != nil {
 		return m.Value
 	}
 	return ""
 }
 
-func (m *TransactionReceiptResponse) GetNonce() uint64 {
+func (m *PendingTxResponse) GetNonce() uint64 {
 	if m != nil {
 		return m.Nonce
 	}
 	return 0
 }
 
-func (m *TransactionReceiptResponse) GetTimestamp() int64 {
+func (m *PendingTxResponse) GetTimestamp() int64 {
 	if m != nil {
 		return m.Timestamp
 	}
 	return 0
 }
 
-func (m *TransactionReceiptResponse) GetType() string {
+func (m *PendingTxResponse) GetType() string {
 	if m != nil {
 		return m.Type
 	}
 	return ""
 }
 
-func (m *TransactionReceiptResponse) GetData() string {
+func (m *PendingTxResponse) GetGasPrice() string {
 	if m != nil {
-		return m.Data
+		return m.GasPrice
 	}
 	return ""
 }
 
-func (m *TransactionReceiptResponse) GetGasPrice() string {
+func (m *PendingTxResponse) GetGasLimit() string {
 	if m != nil {
-		return m.GasPrice
+		return m.GasLimit
 	}
 	return ""
 }
 
-func (m *TransactionReceiptResponse) GetGasLimit() string {
+// Request message of non params.
+type NonParamsRequest struct {
+}
+
+func (m *NonParamsRequest) Reset()                    { *m = NonParamsRequest{} }
+func (m *NonParamsRequest) String() string            { return proto.CompactTextString(m) }
+func (*NonParamsRequest) ProtoMessage()               {}
+func (*NonParamsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{28} }
+
+// Response message of Health and Ready rpc.
+type HealthResponse struct {
+	// true if the probe passes.
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	// why the probe fails, empty if ok.
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons" json:"reasons,omitempty"`
+	// true if the storage is readable.
+	DbAvailable bool `protobuf:"varint,3,opt,name=db_available,json=dbAvailable,proto3" json:"db_available,omitempty"`
+	// true while the node syncs the chain.
+	Syncing   bool   `protobuf:"varint,4,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	PeerCount uint32 `protobuf:"varint,5,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	// true if the node can mine.
+	Mining bool `protobuf:"varint,6,opt,name=mining,proto3" json:"mining,omitempty"`
+	// height of the tail block.
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// seconds since the timestamp of the tail block.
+	TailAge int64 `protobuf:"varint,8,opt,name=tail_age,json=tailAge,proto3" json:"tail_age,omitempty"`
+}
+
+func (m *HealthResponse) Reset()                    { *m = HealthResponse{} }
+func (m *HealthResponse) String() string            { return proto.CompactTextString(m) }
+func (*HealthResponse) ProtoMessage()               {}
+func (*HealthResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{29} }
+
+func (m *HealthResponse) GetOk() bool {
 	if m != nil {
-		return m.GasLimit
+		return m.Ok
 	}
-	return ""
+	return false
 }
 
-func (m *TransactionReceiptResponse) GetContractAddress() string {
+func (m *HealthResponse) GetReasons() []string {
 	if m != nil {
-		return m.ContractAddress
+		return m.Reasons
 	}
-	return ""
+	return nil
 }
 
-type NewAccountRequest struct {
-	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+func (m *HealthResponse) GetDbAvailable() bool {
+	if m != nil {
+		return m.DbAvailable
+	}
+	return false
 }
 
-func (m *NewAccountRequest) Reset()                    { *m = NewAccountRequest{} }
-func (m *NewAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*NewAccountRequest) ProtoMessage()               {}
-func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{26} }
+func (m *HealthResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
 
-func (m *NewAccountRequest) GetPassphrase() string {
+func (m *HealthResponse) GetPeerCount() uint32 {
 	if m != nil {
-		return m.Passphrase
+		return m.PeerCount
 	}
-	return ""
+	return 0
 }
 
-type NewAccountResponse struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *HealthResponse) GetMining() bool {
+	if m != nil {
+		return m.Mining
+	}
+	return false
 }
 
-func (m *NewAccountResponse) Reset()                    { *m = NewAccountResponse{} }
-func (m *NewAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*NewAccountResponse) ProtoMessage()               {}
-func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{27} }
+func (m *HealthResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
 
-func (m *NewAccountResponse) GetAddress() string {
+func (m *HealthResponse) GetTailAge() int64 {
 	if m != nil {
-		return m.Address
+		return m.TailAge
 	}
-	return ""
+	return 0
 }
 
-type UnlockAccountRequest struct {
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+// Response message of node info.
+type NodeInfoResponse struct {
+	// the node ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the block chainID.
+	ChainId uint32 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the node version.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Number of peers currenly connected.
+	PeerCount uint32 `protobuf:"varint,4,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	// the node synchronized status.
+	Synchronized bool `protobuf:"varint,5,opt,name=synchronized,proto3" json:"synchronized,omitempty"`
+	// the node route table bucket size.
+	BucketSize int32 `protobuf:"varint,6,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	// the node relay cache size.
+	RelayCacheSize int32 `protobuf:"varint,7,opt,name=relay_cache_size,json=relayCacheSize,proto3" json:"relay_cache_size,omitempty"`
+	// the node stream store size.
+	StreamStoreSize int32 `protobuf:"varint,8,opt,name=stream_store_size,json=streamStoreSize,proto3" json:"stream_store_size,omitempty"`
+	// the node stream store extend size.
+	StreamStoreExtendSize int32 `protobuf:"varint,9,opt,name=stream_store_extend_size,json=streamStoreExtendSize,proto3" json:"stream_store_extend_size,omitempty"`
+	// the network protocol version.
+	ProtocolVersion string        `protobuf:"bytes,10,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	RouteTable      []*RouteTable `protobuf:"bytes,11,rep,name=route_table,json=routeTable" json:"route_table,omitempty"`
 }
 
-func (m *UnlockAccountRequest) Reset()                    { *m = UnlockAccountRequest{} }
-func (m *UnlockAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*UnlockAccountRequest) ProtoMessage()               {}
-func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{28} }
+func (m *NodeInfoResponse) Reset()                    { *m = NodeInfoResponse{} }
+func (m *NodeInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*NodeInfoResponse) ProtoMessage()               {}
+func (*NodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{30} }
 
-func (m *UnlockAccountRequest) GetAddress() string {
+func (m *NodeInfoResponse) GetId() string {
 	if m != nil {
-		return m.Address
+		return m.Id
 	}
 	return ""
 }
 
-func (m *UnlockAccountRequest) GetPassphrase() string {
+func (m *NodeInfoResponse) GetChainId() uint32 {
 	if m != nil {
-		return m.Passphrase
+		return m.ChainId
 	}
-	return ""
+	return 0
 }
 
-type UnlockAccountResponse struct {
-	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *NodeInfoResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *UnlockAccountResponse) Reset()                    { *m = UnlockAccountResponse{} }
-func (m *UnlockAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*UnlockAccountResponse) ProtoMessage()               {}
-func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{29} }
+func (m *NodeInfoResponse) GetPeerCount() uint32 {
+	if m != nil {
+		return m.PeerCount
+	}
+	return 0
+}
 
-func (m *UnlockAccountResponse) GetResult() bool {
+func (m *NodeInfoResponse) GetSynchronized() bool {
 	if m != nil {
-		return m.Result
+		return m.Synchronized
 	}
 	return false
 }
 
-type LockAccountRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *NodeInfoResponse) GetBucketSize() int32 {
+	if m != nil {
+		return m.BucketSize
+	}
+	return 0
 }
 
-func (m *LockAccountRequest) Reset()                    { *m = LockAccountRequest{} }
-func (m *LockAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*LockAccountRequest) ProtoMessage()               {}
-func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{30} }
-
-func (m *LockAccountRequest) GetAddress() string {
+func (m *NodeInfoResponse) GetRelayCacheSize() int32 {
 	if m != nil {
-		return m.Address
+		return m.RelayCacheSize
 	}
-	return ""
+	return 0
 }
 
-type LockAccountResponse struct {
-	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *NodeInfoResponse) GetStreamStoreSize() int32 {
+	if m != nil {
+		return m.StreamStoreSize
+	}
+	return 0
 }
 
-func (m *LockAccountResponse) Reset()                    { *m = LockAccountResponse{} }
-func (m *LockAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*LockAccountResponse) ProtoMessage()               {}
-func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{31} }
-
-func (m *LockAccountResponse) GetResult() bool {
+func (m *NodeInfoResponse) GetStreamStoreExtendSize() int32 {
 	if m != nil {
-		return m.Result
+		return m.StreamStoreExtendSize
 	}
-	return false
+	return 0
 }
 
-type SignTransactionResponse struct {
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (m *NodeInfoResponse) GetProtocolVersion() string {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return ""
 }
 
-func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
-func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SignTransactionResponse) ProtoMessage()               {}
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{32} }
-
-func (m *SignTransactionResponse) GetData() []byte {
+func (m *NodeInfoResponse) GetRouteTable() []*RouteTable {
 	if m != nil {
-		return m.Data
+		return m.RouteTable
 	}
 	return nil
 }
 
-type SendTransactionPassphraseRequest struct {
-	// transaction struct
-	Transaction *TransactionRequest `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
-	// from account passphrase
-	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+type StatisticsNodeInfoResponse struct {
+	NodeID    string `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	Hash      string `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	PeerCount uint32 `protobuf:"varint,4,opt,name=PeerCount,proto3" json:"PeerCount,omitempty"`
 }
 
-func (m *SendTransactionPassphraseRequest) Reset()         { *m = SendTransactionPassphraseRequest{} }
-func (m *SendTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
-func (*SendTransactionPassphraseRequest) ProtoMessage()    {}
-func (*SendTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{33}
+func (m *StatisticsNodeInfoResponse) Reset()                    { *m = StatisticsNodeInfoResponse{} }
+func (m *StatisticsNodeInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*StatisticsNodeInfoResponse) ProtoMessage()               {}
+func (*StatisticsNodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{31} }
+
+func (m *StatisticsNodeInfoResponse) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
 }
 
-func (m *SendTransactionPassphraseRequest) GetTransaction() *TransactionRequest {
+func (m *StatisticsNodeInfoResponse) GetHeight() uint64 {
 	if m != nil {
-		return m.Transaction
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-func (m *SendTransactionPassphraseRequest) GetPassphrase() string {
+func (m *StatisticsNodeInfoResponse) GetHash() string {
 	if m != nil {
-		return m.Passphrase
+		return m.Hash
 	}
 	return ""
 }
 
-type SendTransactionPassphraseResponse struct {
-	// Hex string of transaction hash.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+func (m *StatisticsNodeInfoResponse) GetPeerCount() uint32 {
+	if m != nil {
+		return m.PeerCount
+	}
+	return 0
 }
 
-func (m *SendTransactionPassphraseResponse) Reset()         { *m = SendTransactionPassphraseResponse{} }
-func (m *SendTransactionPassphraseResponse) String() string { return proto.CompactTextString(m) }
-func (*SendTransactionPassphraseResponse) ProtoMessage()    {}
-func (*SendTransactionPassphraseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApiRpc, []int{34}
+type RouteTable struct {
+	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address []string `protobuf:"bytes,2,rep,name=address" json:"address,omitempty"`
 }
 
-func (m *SendTransactionPassphraseResponse) GetHash() string {
+func (m *RouteTable) Reset()                    { *m = RouteTable{} }
+func (m *RouteTable) String() string            { return proto.CompactTextString(m) }
+func (*RouteTable) ProtoMessage()               {}
+func (*RouteTable) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{32} }
+
+func (m *RouteTable) GetId() string {
 	if m != nil {
-		return m.Hash
+		return m.Id
 	}
 	return ""
 }
 
-type GasPriceResponse struct {
-	GasPrice string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+func (m *RouteTable) GetAddress() []string {
+	if m != nil {
+		return m.Address
+	}
+	return nil
 }
 
-func (m *GasPriceResponse) Reset()                    { *m = GasPriceResponse{} }
-func (m *GasPriceResponse) String() string            { return proto.CompactTextString(m) }
-func (*GasPriceResponse) ProtoMessage()               {}
-func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{35} }
+// Response message of GetNebState rpc.
+type GetNebStateResponse struct {
+	// Block chain id
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Current neb tail hash
+	Tail string `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	// Neb coinbase
+	Coinbase string `protobuf:"bytes,3,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	// Number of peers currenly connected
+	PeerCount uint32 `protobuf:"varint,4,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	// Neb mine status, minging is true ,otherwise false
+	IsMining bool `protobuf:"varint,5,opt,name=is_mining,json=isMining,proto3" json:"is_mining,omitempty"`
+	// The current neb protocol version.
+	ProtocolVersion string `protobuf:"bytes,6,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// The peer sync status.
+	Synchronized bool   `protobuf:"varint,7,opt,name=synchronized,proto3" json:"synchronized,omitempty"`
+	Version      string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+}
 
-func (m *GasPriceResponse) GetGasPrice() string {
+func (m *GetNebStateResponse) Reset()                    { *m = GetNebStateResponse{} }
+func (m *GetNebStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetNebStateResponse) ProtoMessage()               {}
+func (*GetNebStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{33} }
+
+func (m *GetNebStateResponse) GetChainId() uint32 {
 	if m != nil {
-		return m.GasPrice
+		return m.ChainId
 	}
-	return ""
+	return 0
 }
 
-type EstimateGasResponse struct {
-	EstimateGas string `protobuf:"bytes,1,opt,name=estimate_gas,json=estimateGas,proto3" json:"estimate_gas,omitempty"`
+func (m *GetNebStateResponse) GetTail() string {
+	if m != nil {
+		return m.Tail
+	}
+	return ""
 }
 
-func (m *EstimateGasResponse) Reset()                    { *m = EstimateGasResponse{} }
-func (m *EstimateGasResponse) String() string            { return proto.CompactTextString(m) }
-func (*EstimateGasResponse) ProtoMessage()               {}
-func (*EstimateGasResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{36} }
-
-func (m *EstimateGasResponse) GetEstimateGas() string {
+func (m *GetNebStateResponse) GetCoinbase() string {
 	if m != nil {
-		return m.EstimateGas
+		return m.Coinbase
 	}
 	return ""
 }
 
-type EventsResponse struct {
-	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+func (m *GetNebStateResponse) GetPeerCount() uint32 {
+	if m != nil {
+		return m.PeerCount
+	}
+	return 0
 }
 
-func (m *EventsResponse) Reset()                    { *m = EventsResponse{} }
-func (m *EventsResponse) String() string            { return proto.CompactTextString(m) }
-func (*EventsResponse) ProtoMessage()               {}
-func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{37} }
-
-func (m *EventsResponse) GetEvents() []*Event {
+func (m *GetNebStateResponse) GetIsMining() bool {
 	if m != nil {
-		return m.Events
+		return m.IsMining
 	}
-	return nil
+	return false
 }
 
-type Event struct {
-	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *GetNebStateResponse) GetProtocolVersion() string {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return ""
 }
 
-func (m *Event) Reset()                    { *m = Event{} }
-func (m *Event) String() string            { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()               {}
-func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{38} }
-
-func (m *Event) GetTopic() string {
+func (m *GetNebStateResponse) GetSynchronized() bool {
 	if m != nil {
-		return m.Topic
+		return m.Synchronized
 	}
-	return ""
+	return false
 }
 
-func (m *Event) GetData() string {
+func (m *GetNebStateResponse) GetVersion() string {
 	if m != nil {
-		return m.Data
+		return m.Version
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
-	proto.RegisterType((*ChangeNetworkIDRequest)(nil), "rpcpb.ChangeNetworkIDRequest")
-	proto.RegisterType((*ChangeNetworkIDResponse)(nil), "rpcpb.ChangeNetworkIDResponse")
-	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
-	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
-	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
-	proto.RegisterType((*StatisticsNodeInfoResponse)(nil), "rpcpb.StatisticsNodeInfoResponse")
-	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
-	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
-	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
-	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
-	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
-	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
-	proto.RegisterType((*GetDelegateVotersRequest)(nil), "rpcpb.GetDelegateVotersRequest")
-	proto.RegisterType((*GetDelegateVotersResponse)(nil), "rpcpb.GetDelegateVotersResponse")
-	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
-	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
-	proto.RegisterType((*CandidateRequest)(nil), "rpcpb.CandidateRequest")
-	proto.RegisterType((*DelegateRequest)(nil), "rpcpb.DelegateRequest")
-	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
-	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
-	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
-	proto.RegisterType((*GetTransactionByHashRequest)(nil), "rpcpb.GetTransactionByHashRequest")
-	proto.RegisterType((*BlockDumpRequest)(nil), "rpcpb.BlockDumpRequest")
-	proto.RegisterType((*BlockDumpResponse)(nil), "rpcpb.BlockDumpResponse")
-	proto.RegisterType((*TransactionReceiptResponse)(nil), "rpcpb.TransactionReceiptResponse")
-	proto.RegisterType((*NewAccountRequest)(nil), "rpcpb.NewAccountRequest")
-	proto.RegisterType((*NewAccountResponse)(nil), "rpcpb.NewAccountResponse")
-	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
-	proto.RegisterType((*UnlockAccountResponse)(nil), "rpcpb.UnlockAccountResponse")
-	proto.RegisterType((*LockAccountRequest)(nil), "rpcpb.LockAccountRequest")
-	proto.RegisterType((*LockAccountResponse)(nil), "rpcpb.LockAccountResponse")
-	proto.RegisterType((*SignTransactionResponse)(nil), "rpcpb.SignTransactionResponse")
-	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
-	proto.RegisterType((*SendTransactionPassphraseResponse)(nil), "rpcpb.SendTransactionPassphraseResponse")
-	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
-	proto.RegisterType((*EstimateGasResponse)(nil), "rpcpb.EstimateGasResponse")
-	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
-	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+// Response message of Accounts rpc.
+type AccountsResponse struct {
+	// Account list
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for ApiService service
+func (m *AccountsResponse) Reset()                    { *m = AccountsResponse{} }
+func (m *AccountsResponse) String() string            { return proto.CompactTextString(m) }
+func (*AccountsResponse) ProtoMessage()               {}
+func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{34} }
 
-type ApiServiceClient interface {
-	// Return the state of the neb.
-	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
-	// Return the p2p node info.
-	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
-	// Return the dump info of blockchain.
-	BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error)
-	// Accounts return account list.
-	Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	// Return the state of the account.
-	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
-	// Verify, sign, and send the transaction.
-	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Call smart contract.
-	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Submit the signed transaction.
-	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Get block header info by the block hash.
-	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error)
-	// Get transactionReceipt info by tansaction hash.
-	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error)
-	// Subscribe message
-	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
-	// Get GasPrice
-	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
-	// EstimateGas
-	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
-	GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+func (m *AccountsResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-type apiServiceClient struct {
-	cc *grpc.ClientConn
+// Request message of GetAccountState rpc.
+type GetAccountStateRequest struct {
+	// Hex string of the account addresss.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of the block hash whose state is queried, the tail if empty.
+	Block string `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	// height of the block of the canonical chain whose state is queried, instead of block, the tail if 0.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
-	return &apiServiceClient{cc}
-}
+func (m *GetAccountStateRequest) Reset()                    { *m = GetAccountStateRequest{} }
+func (m *GetAccountStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAccountStateRequest) ProtoMessage()               {}
+func (*GetAccountStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{35} }
 
-func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error) {
-	out := new(GetNebStateResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebState", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAccountStateRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
+}
+
+func (m *GetAccountStateRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *GetAccountStateRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetAccountState rpc.
+type GetAccountStateResponse struct {
+	// Current balance in unit of 1/(10^18) nas.
+	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Current transaction count.
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// height of the block whose state is queried.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetAccountStateResponse) Reset()                    { *m = GetAccountStateResponse{} }
+func (m *GetAccountStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAccountStateResponse) ProtoMessage()               {}
+func (*GetAccountStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{36} }
+
+func (m *GetAccountStateResponse) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *GetAccountStateResponse) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *GetAccountStateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetDynastyRequest rpc
+type GetDynastyResponse struct {
+	Delegatees []string `protobuf:"bytes,1,rep,name=delegatees" json:"delegatees,omitempty"`
+}
+
+func (m *GetDynastyResponse) Reset()                    { *m = GetDynastyResponse{} }
+func (m *GetDynastyResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDynastyResponse) ProtoMessage()               {}
+func (*GetDynastyResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{37} }
+
+func (m *GetDynastyResponse) GetDelegatees() []string {
+	if m != nil {
+		return m.Delegatees
+	}
+	return nil
+}
+
+// Response message of GetDelegateVoters rpc
+type GetDelegateVotersRequest struct {
+	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+}
+
+func (m *GetDelegateVotersRequest) Reset()                    { *m = GetDelegateVotersRequest{} }
+func (m *GetDelegateVotersRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDelegateVotersRequest) ProtoMessage()               {}
+func (*GetDelegateVotersRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{38} }
+
+func (m *GetDelegateVotersRequest) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+// Response message of GetDelegateVoters rpc
+type GetDelegateVotersResponse struct {
+	Voters []string `protobuf:"bytes,1,rep,name=voters" json:"voters,omitempty"`
+}
+
+func (m *GetDelegateVotersResponse) Reset()                    { *m = GetDelegateVotersResponse{} }
+func (m *GetDelegateVotersResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDelegateVotersResponse) ProtoMessage()               {}
+func (*GetDelegateVotersResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{39} }
+
+func (m *GetDelegateVotersResponse) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+// Request message of SendTransaction rpc.
+type TransactionRequest struct {
+	// Hex string of the sender account addresss.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Hex string of the receiver account addresss.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Amount of value sending with this transaction.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Transaction nonce.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gasPrice sending with this transaction.
+	GasPrice string `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// gasLimit sending with this transaction.
+	GasLimit string `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// contract sending with this transaction
+	Contract *ContractRequest `protobuf:"bytes,7,opt,name=contract" json:"contract,omitempty"`
+	// candidate application sending with this transaction.
+	Candidate *CandidateRequest `protobuf:"bytes,8,opt,name=candidate" json:"candidate,omitempty"`
+	// delegate vote sending with this transaction.
+	Delegate *DelegateRequest `protobuf:"bytes,9,opt,name=delegate" json:"delegate,omitempty"`
+	// Hex string of the block hash whose state the call runs against, the tail if empty. Only used by Call.
+	Block string `protobuf:"bytes,10,opt,name=block,proto3" json:"block,omitempty"`
+	// profile the gas of the contract functions. Only used by Call and EstimateGas.
+	Profile bool `protobuf:"varint,11,opt,name=profile,proto3" json:"profile,omitempty"`
+	// answer of an oracle operator to a request of the contract at to address.
+	Oracle *OracleAnswerRequest `protobuf:"bytes,12,opt,name=oracle" json:"oracle,omitempty"`
+	// contract calls executed in order in one transaction, sent to the sender without value.
+	Calls []*ContractCallRequest `protobuf:"bytes,13,rep,name=calls" json:"calls,omitempty"`
+	// height of the block of the canonical chain whose state the call runs against, instead of block. Only used by Call.
+	Height uint64 `protobuf:"varint,14,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
+func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransactionRequest) ProtoMessage()               {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{40} }
+
+func (m *TransactionRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetContract() *ContractRequest {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetCandidate() *CandidateRequest {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDelegate() *DelegateRequest {
+	if m != nil {
+		return m.Delegate
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetProfile() bool {
+	if m != nil {
+		return m.Profile
+	}
+	return false
+}
+
+func (m *TransactionRequest) GetOracle() *OracleAnswerRequest {
+	if m != nil {
+		return m.Oracle
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetCalls() []*ContractCallRequest {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ContractCallRequest struct {
+	// Hex string of the contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	Args     string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	// Amount of value sent to the contract with the call.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ContractCallRequest) Reset()                    { *m = ContractCallRequest{} }
+func (m *ContractCallRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContractCallRequest) ProtoMessage()               {}
+func (*ContractCallRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{41} }
+
+func (m *ContractCallRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractCallRequest) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *ContractCallRequest) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+func (m *ContractCallRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type ContractRequest struct {
+	// contract source code.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// contract source type, support javascript and typescript
+	SourceType string `protobuf:"bytes,2,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// call contract function name
+	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
+	// the params of contract.
+	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+	// the address allowed to upgrade the deployed contract, not upgradeable if empty.
+	Admin string `protobuf:"bytes,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	// replace the code of contract at to address with source, only by its admin.
+	Upgrade bool `protobuf:"varint,6,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+}
+
+func (m *ContractRequest) Reset()                    { *m = ContractRequest{} }
+func (m *ContractRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContractRequest) ProtoMessage()               {}
+func (*ContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{42} }
+
+func (m *ContractRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetUpgrade() bool {
+	if m != nil {
+		return m.Upgrade
+	}
+	return false
+}
+
+type CandidateRequest struct {
+	// candidate action.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// commission percentage of block reward, declared with login or commission action.
+	Commission uint32 `protobuf:"varint,2,opt,name=commission,proto3" json:"commission,omitempty"`
+	// key signing blocks for the candidate, registered with signer action.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *CandidateRequest) Reset()                    { *m = CandidateRequest{} }
+func (m *CandidateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CandidateRequest) ProtoMessage()               {}
+func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{43} }
+
+func (m *CandidateRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *CandidateRequest) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *CandidateRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type DelegateRequest struct {
+	// delegate action.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// delegatee.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// delegatees with weights, split the vote instead of delegatee.
+	Delegatees []*DelegateWeight `protobuf:"bytes,3,rep,name=delegatees" json:"delegatees,omitempty"`
+}
+
+func (m *DelegateRequest) Reset()                    { *m = DelegateRequest{} }
+func (m *DelegateRequest) String() string            { return proto.CompactTextString(m) }
+func (*DelegateRequest) ProtoMessage()               {}
+func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{44} }
+
+func (m *DelegateRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *DelegateRequest) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *DelegateRequest) GetDelegatees() []*DelegateWeight {
+	if m != nil {
+		return m.Delegatees
+	}
+	return nil
+}
+
+type OracleAnswerRequest struct {
+	// id of the oracle request.
+	Request string `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// answer delivered to the callback of the request.
+	Answer string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (m *OracleAnswerRequest) Reset()                    { *m = OracleAnswerRequest{} }
+func (m *OracleAnswerRequest) String() string            { return proto.CompactTextString(m) }
+func (*OracleAnswerRequest) ProtoMessage()               {}
+func (*OracleAnswerRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{45} }
+
+func (m *OracleAnswerRequest) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *OracleAnswerRequest) GetAnswer() string {
+	if m != nil {
+		return m.Answer
+	}
+	return ""
+}
+
+type DelegateWeight struct {
+	// delegatee.
+	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// weight of the vote given to the delegatee.
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *DelegateWeight) Reset()                    { *m = DelegateWeight{} }
+func (m *DelegateWeight) String() string            { return proto.CompactTextString(m) }
+func (*DelegateWeight) ProtoMessage()               {}
+func (*DelegateWeight) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{46} }
+
+func (m *DelegateWeight) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *DelegateWeight) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// Request message of SendRawTransactionRequest rpc.
+type SendRawTransactionRequest struct {
+	// Signed data of transaction, protobuf bytes, or the hex or base64 string of them if encoding says so.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Hex or base64 string of the signed data of transaction, instead of data.
+	Raw string `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
+	// Encoding of the transaction, hex, base64 or proto, detected if empty.
+	Encoding string `protobuf:"bytes,3,opt,name=encoding,proto3" json:"encoding,omitempty"`
+}
+
+func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
+func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionRequest) ProtoMessage()               {}
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{47} }
+
+func (m *SendRawTransactionRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SendRawTransactionRequest) GetRaw() string {
+	if m != nil {
+		return m.Raw
+	}
+	return ""
+}
+
+func (m *SendRawTransactionRequest) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+// Response message of SendTransaction rpc.
+type SendTransactionResponse struct {
+	// Hex string of transaction hash.
+	Txhash          string `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Result          bool   `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
+func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendTransactionResponse) ProtoMessage()               {}
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{48} }
+
+func (m *SendTransactionResponse) GetTxhash() string {
+	if m != nil {
+		return m.Txhash
+	}
+	return ""
+}
+
+func (m *SendTransactionResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SendTransactionResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+// Request message of GetBlockByHash rpc.
+type GetBlockByHashRequest struct {
+	// Hex string of block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetBlockByHashRequest) Reset()                    { *m = GetBlockByHashRequest{} }
+func (m *GetBlockByHashRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockByHashRequest) ProtoMessage()               {}
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{49} }
+
+func (m *GetBlockByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// Request message of GetTransactionByHash rpc.
+type GetTransactionByHashRequest struct {
+	// Hex string of transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// names of the fields of the response returned, all if empty. Only used by GetTransactionReceipt.
+	Fields []string `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *GetTransactionByHashRequest) Reset()         { *m = GetTransactionByHashRequest{} }
+func (m *GetTransactionByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionByHashRequest) ProtoMessage()    {}
+func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{50}
+}
+
+func (m *GetTransactionByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetTransactionByHashRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// Request message of GetBlock rpc.
+type GetBlockRequest struct {
+	// Hex string of the block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// height of the block of the canonical chain, instead of hash.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// names of the fields of the response returned, all if empty.
+	Fields []string `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty"`
+	// transactions returned, full, hashes or count, full if empty.
+	TxMode string `protobuf:"bytes,4,opt,name=tx_mode,json=txMode,proto3" json:"tx_mode,omitempty"`
+}
+
+func (m *GetBlockRequest) Reset()                    { *m = GetBlockRequest{} }
+func (m *GetBlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockRequest) ProtoMessage()               {}
+func (*GetBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{51} }
+
+func (m *GetBlockRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetBlockRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *GetBlockRequest) GetTxMode() string {
+	if m != nil {
+		return m.TxMode
+	}
+	return ""
+}
+
+// Response message of GetBlock rpc.
+type BlockResponse struct {
+	// Hex string of the block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the parent block hash.
+	ParentHash string `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Height     uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp  int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hex string of the coinbase address.
+	Coinbase string `protobuf:"bytes,5,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	// Hex string of the miner address.
+	Miner   string `protobuf:"bytes,6,opt,name=miner,proto3" json:"miner,omitempty"`
+	ChainId uint32 `protobuf:"varint,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Hex string of the state, transactions and events roots.
+	StateRoot  string `protobuf:"bytes,8,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	TxsRoot    string `protobuf:"bytes,9,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
+	EventsRoot string `protobuf:"bytes,10,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
+	// count of the transactions of the block.
+	TxCount uint32 `protobuf:"varint,11,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// transactions of the block, only with the tx_mode full.
+	Transactions []*BlockTransaction `protobuf:"bytes,12,rep,name=transactions" json:"transactions,omitempty"`
+	// Hex string of the transaction hashes, only with the tx_mode hashes.
+	TxHashes []string `protobuf:"bytes,13,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+}
+
+func (m *BlockResponse) Reset()                    { *m = BlockResponse{} }
+func (m *BlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*BlockResponse) ProtoMessage()               {}
+func (*BlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{52} }
+
+func (m *BlockResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetCoinbase() string {
+	if m != nil {
+		return m.Coinbase
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetTxsRoot() string {
+	if m != nil {
+		return m.TxsRoot
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetEventsRoot() string {
+	if m != nil {
+		return m.EventsRoot
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetTransactions() []*BlockTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *BlockResponse) GetTxHashes() []string {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+type BlockTransaction struct {
+	// Hex string of tx hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the sender and receiver account addresses.
+	From      string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value     string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Nonce     uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Timestamp int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type      string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	// Hex string of the payload.
+	Data     string `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	GasPrice string `protobuf:"bytes,9,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasLimit string `protobuf:"bytes,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *BlockTransaction) Reset()                    { *m = BlockTransaction{} }
+func (m *BlockTransaction) String() string            { return proto.CompactTextString(m) }
+func (*BlockTransaction) ProtoMessage()               {}
+func (*BlockTransaction) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{53} }
+
+func (m *BlockTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockTransaction) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *BlockTransaction) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *BlockTransaction) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *BlockTransaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockTransaction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockTransaction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *BlockTransaction) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *BlockTransaction) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *BlockTransaction) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+// Request message of BlockDump.
+type BlockDumpRequest struct {
+	// the count of blocks to dump before current tail.
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *BlockDumpRequest) Reset()                    { *m = BlockDumpRequest{} }
+func (m *BlockDumpRequest) String() string            { return proto.CompactTextString(m) }
+func (*BlockDumpRequest) ProtoMessage()               {}
+func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{54} }
+
+func (m *BlockDumpRequest) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Response message of BlockDump.
+type BlockDumpResponse struct {
+	// block dump info.
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *BlockDumpResponse) Reset()                    { *m = BlockDumpResponse{} }
+func (m *BlockDumpResponse) String() string            { return proto.CompactTextString(m) }
+func (*BlockDumpResponse) ProtoMessage()               {}
+func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{55} }
+
+func (m *BlockDumpResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// Response message of TransactionReceipt.
+type TransactionReceiptResponse struct {
+	// Hex string of tx hash.
+	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChainId uint32 `protobuf:"varint,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// Hex string of the sender account addresss.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Hex string of the receiver account addresss.
+	To    string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// Transaction nonce.
+	Nonce           uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Timestamp       int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type            string `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Data            string `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	GasPrice        string `protobuf:"bytes,10,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasLimit        string `protobuf:"bytes,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	ContractAddress string `protobuf:"bytes,12,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// logs emitted by contracts in the transaction.
+	Logs []*ContractLog `protobuf:"bytes,13,rep,name=logs" json:"logs,omitempty"`
+	// keys of contract storage written by the transaction.
+	StateDiffs []*StateDiff `protobuf:"bytes,14,rep,name=state_diffs,json=stateDiffs" json:"state_diffs,omitempty"`
+	// error failing the execution, empty if it succeeded. Calls exceeding the memory or call depth
+	// limits fail with an "out of resource" error.
+	ExecuteError string `protobuf:"bytes,15,opt,name=execute_error,json=executeError,proto3" json:"execute_error,omitempty"`
+}
+
+func (m *TransactionReceiptResponse) Reset()         { *m = TransactionReceiptResponse{} }
+func (m *TransactionReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactionReceiptResponse) ProtoMessage()    {}
+func (*TransactionReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{56}
+}
+
+func (m *TransactionReceiptResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *TransactionReceiptResponse) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransactionReceiptResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TransactionReceiptResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *TransactionReceiptResponse) GetLogs() []*ContractLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *TransactionReceiptResponse) GetStateDiffs() []*StateDiff {
+	if m != nil {
+		return m.StateDiffs
+	}
+	return nil
+}
+
+func (m *TransactionReceiptResponse) GetExecuteError() string {
+	if m != nil {
+		return m.ExecuteError
+	}
+	return ""
+}
+
+type StateDiff struct {
+	// Hex string of the contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// key of the contract storage.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Hex string of the sha3 hash of the value before the transaction, empty if the key didn't exist.
+	OldHash string `protobuf:"bytes,3,opt,name=old_hash,json=oldHash,proto3" json:"old_hash,omitempty"`
+	// Hex string of the sha3 hash of the value after the transaction, empty if the key is deleted.
+	NewHash string `protobuf:"bytes,4,opt,name=new_hash,json=newHash,proto3" json:"new_hash,omitempty"`
+}
+
+func (m *StateDiff) Reset()                    { *m = StateDiff{} }
+func (m *StateDiff) String() string            { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()               {}
+func (*StateDiff) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{57} }
+
+func (m *StateDiff) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *StateDiff) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StateDiff) GetOldHash() string {
+	if m != nil {
+		return m.OldHash
+	}
+	return ""
+}
+
+func (m *StateDiff) GetNewHash() string {
+	if m != nil {
+		return m.NewHash
+	}
+	return ""
+}
+
+type NewAccountRequest struct {
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *NewAccountRequest) Reset()                    { *m = NewAccountRequest{} }
+func (m *NewAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewAccountRequest) ProtoMessage()               {}
+func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{58} }
+
+func (m *NewAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type NewAccountResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *NewAccountResponse) Reset()                    { *m = NewAccountResponse{} }
+func (m *NewAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*NewAccountResponse) ProtoMessage()               {}
+func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{59} }
+
+func (m *NewAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type UnlockAccountRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *UnlockAccountRequest) Reset()                    { *m = UnlockAccountRequest{} }
+func (m *UnlockAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnlockAccountRequest) ProtoMessage()               {}
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{60} }
+
+func (m *UnlockAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UnlockAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type UnlockAccountResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *UnlockAccountResponse) Reset()                    { *m = UnlockAccountResponse{} }
+func (m *UnlockAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnlockAccountResponse) ProtoMessage()               {}
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{61} }
+
+func (m *UnlockAccountResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type LockAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *LockAccountRequest) Reset()                    { *m = LockAccountRequest{} }
+func (m *LockAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockAccountRequest) ProtoMessage()               {}
+func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{62} }
+
+func (m *LockAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type LockAccountResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *LockAccountResponse) Reset()                    { *m = LockAccountResponse{} }
+func (m *LockAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*LockAccountResponse) ProtoMessage()               {}
+func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{63} }
+
+func (m *LockAccountResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type SignTransactionResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
+func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignTransactionResponse) ProtoMessage()               {}
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{64} }
+
+func (m *SignTransactionResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type SendTransactionPassphraseRequest struct {
+	// transaction struct
+	Transaction *TransactionRequest `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+	// from account passphrase
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *SendTransactionPassphraseRequest) Reset()         { *m = SendTransactionPassphraseRequest{} }
+func (m *SendTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
+func (*SendTransactionPassphraseRequest) ProtoMessage()    {}
+func (*SendTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{65}
+}
+
+func (m *SendTransactionPassphraseRequest) GetTransaction() *TransactionRequest {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *SendTransactionPassphraseRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type SendTransactionPassphraseResponse struct {
+	// Hex string of transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SendTransactionPassphraseResponse) Reset()         { *m = SendTransactionPassphraseResponse{} }
+func (m *SendTransactionPassphraseResponse) String() string { return proto.CompactTextString(m) }
+func (*SendTransactionPassphraseResponse) ProtoMessage()    {}
+func (*SendTransactionPassphraseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorApiRpc, []int{66}
+}
+
+func (m *SendTransactionPassphraseResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type GasPriceResponse struct {
+	GasPrice string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+}
+
+func (m *GasPriceResponse) Reset()                    { *m = GasPriceResponse{} }
+func (m *GasPriceResponse) String() string            { return proto.CompactTextString(m) }
+func (*GasPriceResponse) ProtoMessage()               {}
+func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{67} }
+
+func (m *GasPriceResponse) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+type EstimateGasResponse struct {
+	// gas the transaction would use in the next block.
+	EstimateGas string `protobuf:"bytes,1,opt,name=estimate_gas,json=estimateGas,proto3" json:"estimate_gas,omitempty"`
+	// gas of the contract functions and storage accesses, the most expensive first, only if profiled.
+	Profile []*GasProfileEntry `protobuf:"bytes,2,rep,name=profile" json:"profile,omitempty"`
+	// JSON of the value returned by the contract function, empty if not a call.
+	Result string `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	// error failing the execution, empty if succeeded.
+	ExecuteErr string `protobuf:"bytes,4,opt,name=execute_err,json=executeErr,proto3" json:"execute_err,omitempty"`
+	// least gas limit the transaction needs, the estimate_gas before the refunds.
+	GasLimit string `protobuf:"bytes,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *EstimateGasResponse) Reset()                    { *m = EstimateGasResponse{} }
+func (m *EstimateGasResponse) String() string            { return proto.CompactTextString(m) }
+func (*EstimateGasResponse) ProtoMessage()               {}
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{68} }
+
+func (m *EstimateGasResponse) GetEstimateGas() string {
+	if m != nil {
+		return m.EstimateGas
+	}
+	return ""
+}
+
+func (m *EstimateGasResponse) GetProfile() []*GasProfileEntry {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *EstimateGasResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *EstimateGasResponse) GetExecuteErr() string {
+	if m != nil {
+		return m.ExecuteErr
+	}
+	return ""
+}
+
+func (m *EstimateGasResponse) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+// Response message of Call rpc.
+type CallResponse struct {
+	// JSON of the value returned by the contract function.
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// gas the call would use when sent in a transaction.
+	EstimateGas string `protobuf:"bytes,2,opt,name=estimate_gas,json=estimateGas,proto3" json:"estimate_gas,omitempty"`
+	// error failing the call, empty if succeeded.
+	ExecuteErr string `protobuf:"bytes,3,opt,name=execute_err,json=executeErr,proto3" json:"execute_err,omitempty"`
+	// gas of the contract functions and storage accesses, the most expensive first, only if profiled.
+	Profile []*GasProfileEntry `protobuf:"bytes,4,rep,name=profile" json:"profile,omitempty"`
+	// height of the block whose state the call runs against.
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CallResponse) Reset()                    { *m = CallResponse{} }
+func (m *CallResponse) String() string            { return proto.CompactTextString(m) }
+func (*CallResponse) ProtoMessage()               {}
+func (*CallResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{69} }
+
+func (m *CallResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *CallResponse) GetEstimateGas() string {
+	if m != nil {
+		return m.EstimateGas
+	}
+	return ""
+}
+
+func (m *CallResponse) GetExecuteErr() string {
+	if m != nil {
+		return m.ExecuteErr
+	}
+	return ""
+}
+
+func (m *CallResponse) GetProfile() []*GasProfileEntry {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *CallResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GasProfileEntry struct {
+	// Hex string of the contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// name of the function, empty for the code out of any function.
+	Function string `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	// line for the instructions, storage_get, storage_put or storage_del for the storage accesses.
+	Op  string `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	// count of the steps, e.g. how many times the storage is written.
+	Count uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GasProfileEntry) Reset()                    { *m = GasProfileEntry{} }
+func (m *GasProfileEntry) String() string            { return proto.CompactTextString(m) }
+func (*GasProfileEntry) ProtoMessage()               {}
+func (*GasProfileEntry) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{70} }
+
+func (m *GasProfileEntry) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *GasProfileEntry) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type EventsResponse struct {
+	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+}
+
+func (m *EventsResponse) Reset()                    { *m = EventsResponse{} }
+func (m *EventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()               {}
+func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{71} }
+
+func (m *EventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type Event struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{72} }
+
+func (m *Event) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Event) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// A log emitted by contract.
+type ContractLog struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the name and the indexed fields of the log.
+	Topics []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	// JSON of the log data.
+	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Hex string of the transaction hash.
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Hex string of the block hash.
+	BlockHash   string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// true if the log was in a block reverted by a reorg, only in filter changes.
+	Removed bool `protobuf:"varint,7,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *ContractLog) Reset()                    { *m = ContractLog{} }
+func (m *ContractLog) String() string            { return proto.CompactTextString(m) }
+func (*ContractLog) ProtoMessage()               {}
+func (*ContractLog) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{73} }
+
+func (m *ContractLog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractLog) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *ContractLog) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ContractLog) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ContractLog) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *ContractLog) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ContractLog) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+// Request message of GetLogs rpc.
+type GetLogsRequest struct {
+	// the first block height to search.
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// the last block height to search, the tail if 0.
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// Hex string of the contract address, any if empty.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// topics in position, empty ones match any.
+	Topics []string `protobuf:"bytes,4,rep,name=topics" json:"topics,omitempty"`
+	// most logs of the page, 100 if 0, at most 1000.
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor of the page, the next_cursor of the previous one, empty for the first one.
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *GetLogsRequest) Reset()                    { *m = GetLogsRequest{} }
+func (m *GetLogsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetLogsRequest) ProtoMessage()               {}
+func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{74} }
+
+func (m *GetLogsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetLogsRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// Response message of GetLogs rpc.
+type GetLogsResponse struct {
+	Logs []*ContractLog `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
+	// cursor of the next page, empty at the end of the list.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// true if the block of the cursor was replaced by a reorg, the logs already listed of that block are void.
+	Reorged bool `protobuf:"varint,3,opt,name=reorged,proto3" json:"reorged,omitempty"`
+}
+
+func (m *GetLogsResponse) Reset()                    { *m = GetLogsResponse{} }
+func (m *GetLogsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetLogsResponse) ProtoMessage()               {}
+func (*GetLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{75} }
+
+func (m *GetLogsResponse) GetLogs() []*ContractLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *GetLogsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *GetLogsResponse) GetReorged() bool {
+	if m != nil {
+		return m.Reorged
+	}
+	return false
+}
+
+// Request message of GetEvents rpc.
+type GetEventsRequest struct {
+	// the first block height to search.
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// the last block height to search, the tail if 0.
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// Hex string of the sender or receiver address of the transactions, any if empty.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// topics of the events, any if empty.
+	Topics []string `protobuf:"bytes,4,rep,name=topics" json:"topics,omitempty"`
+	// most events of the page, 100 if 0, at most 1000.
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor of the page, the next_cursor of the previous one, empty for the first one.
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *GetEventsRequest) Reset()                    { *m = GetEventsRequest{} }
+func (m *GetEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetEventsRequest) ProtoMessage()               {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{76} }
+
+func (m *GetEventsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetEventsRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *GetEventsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetEventsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ChainEvent struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// JSON of the event data.
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Hex string of the transaction hash.
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Hex string of the block hash.
+	BlockHash   string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *ChainEvent) Reset()                    { *m = ChainEvent{} }
+func (m *ChainEvent) String() string            { return proto.CompactTextString(m) }
+func (*ChainEvent) ProtoMessage()               {}
+func (*ChainEvent) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{77} }
+
+func (m *ChainEvent) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ChainEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ChainEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ChainEvent) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *ChainEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Response message of GetEvents rpc.
+type GetEventsResponse struct {
+	Events []*ChainEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	// cursor of the next page, empty at the end of the list.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// true if the block of the cursor was replaced by a reorg, the events already listed of that block are void.
+	Reorged bool `protobuf:"varint,3,opt,name=reorged,proto3" json:"reorged,omitempty"`
+}
+
+func (m *GetEventsResponse) Reset()                    { *m = GetEventsResponse{} }
+func (m *GetEventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetEventsResponse) ProtoMessage()               {}
+func (*GetEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{78} }
+
+func (m *GetEventsResponse) GetEvents() []*ChainEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *GetEventsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *GetEventsResponse) GetReorged() bool {
+	if m != nil {
+		return m.Reorged
+	}
+	return false
+}
+
+// Request message of GetTokenTransfers rpc.
+type GetTokenTransfersRequest struct {
+	// the first block height to search.
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// the last block height to search, the tail if 0.
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// Hex string of the token contract address, any if empty.
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Hex string of the sender or receiver address, any if empty.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// most transfers of the page, 100 if 0, at most 1000.
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor of the page, the next_cursor of the previous one, empty for the first one.
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *GetTokenTransfersRequest) Reset()                    { *m = GetTokenTransfersRequest{} }
+func (m *GetTokenTransfersRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTokenTransfersRequest) ProtoMessage()               {}
+func (*GetTokenTransfersRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{79} }
+
+func (m *GetTokenTransfersRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetTokenTransfersRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetTokenTransfersRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *GetTokenTransfersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetTokenTransfersRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetTokenTransfersRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type TokenTransfer struct {
+	// Hex string of the token contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Hex string of the sender address, empty for minted tokens.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Hex string of the receiver address.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// amount of the NRC20 tokens in the smallest unit.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Hex string of the transaction hash.
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Hex string of the block hash.
+	BlockHash   string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// id of the NRC721 token.
+	TokenId string `protobuf:"bytes,8,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *TokenTransfer) Reset()                    { *m = TokenTransfer{} }
+func (m *TokenTransfer) String() string            { return proto.CompactTextString(m) }
+func (*TokenTransfer) ProtoMessage()               {}
+func (*TokenTransfer) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{80} }
+
+func (m *TokenTransfer) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *TokenTransfer) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TokenTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TokenTransfer) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TokenTransfer) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TokenTransfer) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TokenTransfer) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TokenTransfer) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// Response message of GetTokenTransfers rpc.
+type GetTokenTransfersResponse struct {
+	Transfers []*TokenTransfer `protobuf:"bytes,1,rep,name=transfers" json:"transfers,omitempty"`
+	// cursor of the next page, empty at the end of the list.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// true if the block of the cursor was replaced by a reorg, the transfers already listed of that block are void.
+	Reorged bool `protobuf:"varint,3,opt,name=reorged,proto3" json:"reorged,omitempty"`
+}
+
+func (m *GetTokenTransfersResponse) Reset()                    { *m = GetTokenTransfersResponse{} }
+func (m *GetTokenTransfersResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTokenTransfersResponse) ProtoMessage()               {}
+func (*GetTokenTransfersResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{81} }
+
+func (m *GetTokenTransfersResponse) GetTransfers() []*TokenTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *GetTokenTransfersResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *GetTokenTransfersResponse) GetReorged() bool {
+	if m != nil {
+		return m.Reorged
+	}
+	return false
+}
+
+// Request message of NewFilter rpc.
+type NewFilterRequest struct {
+	// type of the filter, block, pending, event or log.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// topics of the events, or the log topics in position, empty ones match any.
+	Topics []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	// Hex string of the contract address of the logs, any if empty.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *NewFilterRequest) Reset()                    { *m = NewFilterRequest{} }
+func (m *NewFilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewFilterRequest) ProtoMessage()               {}
+func (*NewFilterRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{82} }
+
+func (m *NewFilterRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *NewFilterRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *NewFilterRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Response message of NewFilter rpc.
+type NewFilterResponse struct {
+	// id of the filter, expiring if not polled in the filter timeout.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *NewFilterResponse) Reset()                    { *m = NewFilterResponse{} }
+func (m *NewFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*NewFilterResponse) ProtoMessage()               {}
+func (*NewFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{83} }
+
+func (m *NewFilterResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Request message of GetFilterChanges and UninstallFilter rpc.
+type FilterRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *FilterRequest) Reset()                    { *m = FilterRequest{} }
+func (m *FilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*FilterRequest) ProtoMessage()               {}
+func (*FilterRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{84} }
+
+func (m *FilterRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Response message of GetFilterChanges rpc, only the changes of the filter type are filled.
+type FilterChangesResponse struct {
+	Blocks       []*NewBlockResponse  `protobuf:"bytes,1,rep,name=blocks" json:"blocks,omitempty"`
+	Transactions []*PendingTxResponse `protobuf:"bytes,2,rep,name=transactions" json:"transactions,omitempty"`
+	Events       []*SubscribeResponse `protobuf:"bytes,3,rep,name=events" json:"events,omitempty"`
+	Logs         []*ContractLog       `protobuf:"bytes,4,rep,name=logs" json:"logs,omitempty"`
+	// true if changes were dropped as too many were kept since the last poll.
+	Overflowed bool `protobuf:"varint,5,opt,name=overflowed,proto3" json:"overflowed,omitempty"`
+}
+
+func (m *FilterChangesResponse) Reset()                    { *m = FilterChangesResponse{} }
+func (m *FilterChangesResponse) String() string            { return proto.CompactTextString(m) }
+func (*FilterChangesResponse) ProtoMessage()               {}
+func (*FilterChangesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{85} }
+
+func (m *FilterChangesResponse) GetBlocks() []*NewBlockResponse {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *FilterChangesResponse) GetTransactions() []*PendingTxResponse {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *FilterChangesResponse) GetEvents() []*SubscribeResponse {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *FilterChangesResponse) GetLogs() []*ContractLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *FilterChangesResponse) GetOverflowed() bool {
+	if m != nil {
+		return m.Overflowed
+	}
+	return false
+}
+
+// Response message of UninstallFilter rpc.
+type UninstallFilterResponse struct {
+	// false if the filter wasn't found.
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *UninstallFilterResponse) Reset()                    { *m = UninstallFilterResponse{} }
+func (m *UninstallFilterResponse) String() string            { return proto.CompactTextString(m) }
+func (*UninstallFilterResponse) ProtoMessage()               {}
+func (*UninstallFilterResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{86} }
+
+func (m *UninstallFilterResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+// Request message of GetTransactionsByAddress rpc.
+type GetTransactionsByAddressRequest struct {
+	// Hex string of the sender or receiver address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the first block height to search.
+	FromHeight uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// the last block height to search, the tail if 0.
+	ToHeight uint64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// most transactions of the page, 100 if 0, at most 1000.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor of the page, the next_cursor of the previous one, empty for the first one.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *GetTransactionsByAddressRequest) Reset()                    { *m = GetTransactionsByAddressRequest{} }
+func (m *GetTransactionsByAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTransactionsByAddressRequest) ProtoMessage()               {}
+func (*GetTransactionsByAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{87} }
+
+func (m *GetTransactionsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetTransactionsByAddressRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetTransactionsByAddressRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetTransactionsByAddressRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetTransactionsByAddressRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type AddressTransaction struct {
+	// Hex string of tx hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the sender account addresss.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Hex string of the receiver account addresss.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Transaction value.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Transaction nonce.
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Transaction timestamp.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Transaction type.
+	Type string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	// Hex string of the block hash.
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Block height.
+	BlockHeight uint64 `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *AddressTransaction) Reset()                    { *m = AddressTransaction{} }
+func (m *AddressTransaction) String() string            { return proto.CompactTextString(m) }
+func (*AddressTransaction) ProtoMessage()               {}
+func (*AddressTransaction) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{88} }
+
+func (m *AddressTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *AddressTransaction) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *AddressTransaction) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *AddressTransaction) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *AddressTransaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AddressTransaction) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *AddressTransaction) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Response message of GetTransactionsByAddress rpc.
+type GetTransactionsByAddressResponse struct {
+	Transactions []*AddressTransaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+	// cursor of the next page, empty at the end of the list.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// true if the block of the cursor was replaced by a reorg, the transactions already listed of that block are void.
+	Reorged bool `protobuf:"varint,3,opt,name=reorged,proto3" json:"reorged,omitempty"`
+}
+
+func (m *GetTransactionsByAddressResponse) Reset()                    { *m = GetTransactionsByAddressResponse{} }
+func (m *GetTransactionsByAddressResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTransactionsByAddressResponse) ProtoMessage()               {}
+func (*GetTransactionsByAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{89} }
+
+func (m *GetTransactionsByAddressResponse) GetTransactions() []*AddressTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *GetTransactionsByAddressResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *GetTransactionsByAddressResponse) GetReorged() bool {
+	if m != nil {
+		return m.Reorged
+	}
+	return false
+}
+
+// Request message of GetTokenBalance rpc.
+type GetTokenBalanceRequest struct {
+	// Hex string of the token contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of the block hash whose state is queried, the tail if empty.
+	Block string `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	// height of the block of the canonical chain whose state is queried, instead of block, the tail if 0.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetTokenBalanceRequest) Reset()                    { *m = GetTokenBalanceRequest{} }
+func (m *GetTokenBalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTokenBalanceRequest) ProtoMessage()               {}
+func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{90} }
+
+func (m *GetTokenBalanceRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetTokenBalance rpc.
+type GetTokenBalanceResponse struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol   string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// balance in the smallest unit of the token.
+	Balance string `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *GetTokenBalanceResponse) Reset()                    { *m = GetTokenBalanceResponse{} }
+func (m *GetTokenBalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTokenBalanceResponse) ProtoMessage()               {}
+func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{91} }
+
+func (m *GetTokenBalanceResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *GetTokenBalanceResponse) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+// Request message of GetTokenHoldings rpc.
+type GetTokenHoldingsRequest struct {
+	// Hex string of the token contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of the block hash whose state is queried, the tail if empty.
+	Block string `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	// height of the block of the canonical chain whose state is queried, instead of block, the tail if 0.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetTokenHoldingsRequest) Reset()                    { *m = GetTokenHoldingsRequest{} }
+func (m *GetTokenHoldingsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTokenHoldingsRequest) ProtoMessage()               {}
+func (*GetTokenHoldingsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{92} }
+
+func (m *GetTokenHoldingsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *GetTokenHoldingsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetTokenHoldingsRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *GetTokenHoldingsRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetTokenHoldings rpc.
+type GetTokenHoldingsResponse struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// ids of the tokens owned by the account.
+	TokenIds []string `protobuf:"bytes,3,rep,name=token_ids,json=tokenIds" json:"token_ids,omitempty"`
+}
+
+func (m *GetTokenHoldingsResponse) Reset()                    { *m = GetTokenHoldingsResponse{} }
+func (m *GetTokenHoldingsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTokenHoldingsResponse) ProtoMessage()               {}
+func (*GetTokenHoldingsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{93} }
+
+func (m *GetTokenHoldingsResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetTokenHoldingsResponse) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GetTokenHoldingsResponse) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+// Request message of GetContractAbi rpc.
+type GetContractAbiRequest struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetContractAbiRequest) Reset()                    { *m = GetContractAbiRequest{} }
+func (m *GetContractAbiRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractAbiRequest) ProtoMessage()               {}
+func (*GetContractAbiRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{94} }
+
+func (m *GetContractAbiRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Response message of GetContractAbi rpc.
+type GetContractAbiResponse struct {
+	// Hex string of the abi hash kept in the contract account.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// the public functions of the contract.
+	Functions []*AbiFunction `protobuf:"bytes,2,rep,name=functions" json:"functions,omitempty"`
+}
+
+func (m *GetContractAbiResponse) Reset()                    { *m = GetContractAbiResponse{} }
+func (m *GetContractAbiResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetContractAbiResponse) ProtoMessage()               {}
+func (*GetContractAbiResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{95} }
+
+func (m *GetContractAbiResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetContractAbiResponse) GetFunctions() []*AbiFunction {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+type AbiFunction struct {
+	Name string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args []*AbiArg `protobuf:"bytes,2,rep,name=args" json:"args,omitempty"`
+	// whether the function accepts the value of the transaction.
+	Payable bool `protobuf:"varint,3,opt,name=payable,proto3" json:"payable,omitempty"`
+}
+
+func (m *AbiFunction) Reset()                    { *m = AbiFunction{} }
+func (m *AbiFunction) String() string            { return proto.CompactTextString(m) }
+func (*AbiFunction) ProtoMessage()               {}
+func (*AbiFunction) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{96} }
+
+func (m *AbiFunction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AbiFunction) GetArgs() []*AbiArg {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *AbiFunction) GetPayable() bool {
+	if m != nil {
+		return m.Payable
+	}
+	return false
+}
+
+type AbiArg struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the type annotated by the contract, "any" if not annotated.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *AbiArg) Reset()                    { *m = AbiArg{} }
+func (m *AbiArg) String() string            { return proto.CompactTextString(m) }
+func (*AbiArg) ProtoMessage()               {}
+func (*AbiArg) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{97} }
+
+func (m *AbiArg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AbiArg) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// Request message of VerifyContract rpc.
+type VerifyContractRequest struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the original source, line endings and trailing whitespace don't matter.
+	Source     string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SourceType string `protobuf:"bytes,3,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// the args of the deploy transaction.
+	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *VerifyContractRequest) Reset()                    { *m = VerifyContractRequest{} }
+func (m *VerifyContractRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifyContractRequest) ProtoMessage()               {}
+func (*VerifyContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{98} }
+
+func (m *VerifyContractRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerifyContractRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *VerifyContractRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *VerifyContractRequest) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+// Response message of VerifyContract and GetContractVerification rpc.
+type ContractVerificationResponse struct {
+	// whether the source of the contract is verified, false if never verified or upgraded since.
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Hex string of the hash of the verified code, the deploy or upgrade transaction hash.
+	CodeHash   string `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Source     string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	SourceType string `protobuf:"bytes,4,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	Args       string `protobuf:"bytes,5,opt,name=args,proto3" json:"args,omitempty"`
+	// the libraries linked by the contract.
+	Libraries []*LinkedLibrary `protobuf:"bytes,6,rep,name=libraries" json:"libraries,omitempty"`
+	// the height of the tail block the source was verified against.
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ContractVerificationResponse) Reset()                    { *m = ContractVerificationResponse{} }
+func (m *ContractVerificationResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContractVerificationResponse) ProtoMessage()               {}
+func (*ContractVerificationResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{99} }
+
+func (m *ContractVerificationResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *ContractVerificationResponse) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *ContractVerificationResponse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ContractVerificationResponse) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *ContractVerificationResponse) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+func (m *ContractVerificationResponse) GetLibraries() []*LinkedLibrary {
+	if m != nil {
+		return m.Libraries
+	}
+	return nil
+}
+
+func (m *ContractVerificationResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type LinkedLibrary struct {
+	// the name the contract requires the library by.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Hex string of the library address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *LinkedLibrary) Reset()                    { *m = LinkedLibrary{} }
+func (m *LinkedLibrary) String() string            { return proto.CompactTextString(m) }
+func (*LinkedLibrary) ProtoMessage()               {}
+func (*LinkedLibrary) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{100} }
+
+func (m *LinkedLibrary) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LinkedLibrary) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Response message of GetConsensusState rpc.
+type GetConsensusStateResponse struct {
+	// Current dynasty id.
+	DynastyId int64 `protobuf:"varint,1,opt,name=dynasty_id,json=dynastyId,proto3" json:"dynasty_id,omitempty"`
+	// Hex string of delegatees in current dynasty.
+	Dynasty []string `protobuf:"bytes,2,rep,name=dynasty" json:"dynasty,omitempty"`
+	// Hex string of the proposer in current slot.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// Timestamp of current slot.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hex string of the proposer in next slot.
+	NextProposer string `protobuf:"bytes,5,opt,name=next_proposer,json=nextProposer,proto3" json:"next_proposer,omitempty"`
+	// Timestamp of next slot.
+	NextTimestamp int64 `protobuf:"varint,6,opt,name=next_timestamp,json=nextTimestamp,proto3" json:"next_timestamp,omitempty"`
+	// Votes of each candidate, sorted by votes.
+	Votes []*DelegateVotes `protobuf:"bytes,7,rep,name=votes" json:"votes,omitempty"`
+	// Neb mine status, minging is true ,otherwise false
+	IsMining bool `protobuf:"varint,8,opt,name=is_mining,json=isMining,proto3" json:"is_mining,omitempty"`
+	// Hex string of the neb miner.
+	Miner string `protobuf:"bytes,9,opt,name=miner,proto3" json:"miner,omitempty"`
+}
+
+func (m *GetConsensusStateResponse) Reset()                    { *m = GetConsensusStateResponse{} }
+func (m *GetConsensusStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetConsensusStateResponse) ProtoMessage()               {}
+func (*GetConsensusStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{101} }
+
+func (m *GetConsensusStateResponse) GetDynastyId() int64 {
+	if m != nil {
+		return m.DynastyId
+	}
+	return 0
+}
+
+func (m *GetConsensusStateResponse) GetDynasty() []string {
+	if m != nil {
+		return m.Dynasty
+	}
+	return nil
+}
+
+func (m *GetConsensusStateResponse) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *GetConsensusStateResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GetConsensusStateResponse) GetNextProposer() string {
+	if m != nil {
+		return m.NextProposer
+	}
+	return ""
+}
+
+func (m *GetConsensusStateResponse) GetNextTimestamp() int64 {
+	if m != nil {
+		return m.NextTimestamp
+	}
+	return 0
+}
+
+func (m *GetConsensusStateResponse) GetVotes() []*DelegateVotes {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *GetConsensusStateResponse) GetIsMining() bool {
+	if m != nil {
+		return m.IsMining
+	}
+	return false
+}
+
+func (m *GetConsensusStateResponse) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+type DelegateVotes struct {
+	// Hex string of the delegatee.
+	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// Votes in unit of 1/(10^18) nas.
+	Votes string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *DelegateVotes) Reset()                    { *m = DelegateVotes{} }
+func (m *DelegateVotes) String() string            { return proto.CompactTextString(m) }
+func (*DelegateVotes) ProtoMessage()               {}
+func (*DelegateVotes) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{102} }
+
+func (m *DelegateVotes) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *DelegateVotes) GetVotes() string {
+	if m != nil {
+		return m.Votes
+	}
+	return ""
+}
+
+// Response message of GetSyncState rpc.
+type SyncStateResponse struct {
+	// Neb sync status, syncing is true, otherwise false.
+	Syncing bool `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	// Stage of the sync: idle, blocks, headers or state.
+	Stage string `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	// Height of the tail when the sync started.
+	StartingHeight uint64 `protobuf:"varint,3,opt,name=starting_height,json=startingHeight,proto3" json:"starting_height,omitempty"`
+	// Height of the tail.
+	CurrentHeight uint64 `protobuf:"varint,4,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// Highest height reached by the peers.
+	HighestHeight uint64 `protobuf:"varint,5,opt,name=highest_height,json=highestHeight,proto3" json:"highest_height,omitempty"`
+	// Blocks downloaded and verified per second over the last minute.
+	DownloadRate float64 `protobuf:"fixed64,6,opt,name=download_rate,json=downloadRate,proto3" json:"download_rate,omitempty"`
+	VerifyRate   float64 `protobuf:"fixed64,7,opt,name=verify_rate,json=verifyRate,proto3" json:"verify_rate,omitempty"`
+	// Seconds left to reach the highest height, 0 if unknown.
+	Eta int64 `protobuf:"varint,8,opt,name=eta,proto3" json:"eta,omitempty"`
+}
+
+func (m *SyncStateResponse) Reset()                    { *m = SyncStateResponse{} }
+func (m *SyncStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*SyncStateResponse) ProtoMessage()               {}
+func (*SyncStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApiRpc, []int{103} }
+
+func (m *SyncStateResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncStateResponse) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *SyncStateResponse) GetStartingHeight() uint64 {
+	if m != nil {
+		return m.StartingHeight
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetCurrentHeight() uint64 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetHighestHeight() uint64 {
+	if m != nil {
+		return m.HighestHeight
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetDownloadRate() float64 {
+	if m != nil {
+		return m.DownloadRate
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetVerifyRate() float64 {
+	if m != nil {
+		return m.VerifyRate
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetEta() int64 {
+	if m != nil {
+		return m.Eta
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
+	proto.RegisterType((*PendingTransactionsRequest)(nil), "rpcpb.PendingTransactionsRequest")
+	proto.RegisterType((*PendingTransactionsResponse)(nil), "rpcpb.PendingTransactionsResponse")
+	proto.RegisterType((*FlushTransactionPoolResponse)(nil), "rpcpb.FlushTransactionPoolResponse")
+	proto.RegisterType((*SetHeadRequest)(nil), "rpcpb.SetHeadRequest")
+	proto.RegisterType((*SetHeadResponse)(nil), "rpcpb.SetHeadResponse")
+	proto.RegisterType((*LogLevelRequest)(nil), "rpcpb.LogLevelRequest")
+	proto.RegisterType((*LogLevelResponse)(nil), "rpcpb.LogLevelResponse")
+	proto.RegisterType((*ConfigResponse)(nil), "rpcpb.ConfigResponse")
+	proto.RegisterType((*ChangeNetworkIDRequest)(nil), "rpcpb.ChangeNetworkIDRequest")
+	proto.RegisterType((*ChangeNetworkIDResponse)(nil), "rpcpb.ChangeNetworkIDResponse")
+	proto.RegisterType((*PeerScoresResponse)(nil), "rpcpb.PeerScoresResponse")
+	proto.RegisterType((*PeerScore)(nil), "rpcpb.PeerScore")
+	proto.RegisterType((*PeerStatsResponse)(nil), "rpcpb.PeerStatsResponse")
+	proto.RegisterType((*PeerStats)(nil), "rpcpb.PeerStats")
+	proto.RegisterType((*AddPeerRequest)(nil), "rpcpb.AddPeerRequest")
+	proto.RegisterType((*RemovePeerRequest)(nil), "rpcpb.RemovePeerRequest")
+	proto.RegisterType((*ChangePeerResponse)(nil), "rpcpb.ChangePeerResponse")
+	proto.RegisterType((*IPFilterRequest)(nil), "rpcpb.IPFilterRequest")
+	proto.RegisterType((*IPFilterResponse)(nil), "rpcpb.IPFilterResponse")
+	proto.RegisterType((*PeersResponse)(nil), "rpcpb.PeersResponse")
+	proto.RegisterType((*ConfiguredPeer)(nil), "rpcpb.ConfiguredPeer")
+	proto.RegisterType((*TraceTransactionRequest)(nil), "rpcpb.TraceTransactionRequest")
+	proto.RegisterType((*TraceTransactionResponse)(nil), "rpcpb.TraceTransactionResponse")
+	proto.RegisterType((*TraceStep)(nil), "rpcpb.TraceStep")
+	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
+	proto.RegisterType((*NewBlockResponse)(nil), "rpcpb.NewBlockResponse")
+	proto.RegisterType((*PendingTxResponse)(nil), "rpcpb.PendingTxResponse")
+	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
+	proto.RegisterType((*HealthResponse)(nil), "rpcpb.HealthResponse")
+	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
+	proto.RegisterType((*StatisticsNodeInfoResponse)(nil), "rpcpb.StatisticsNodeInfoResponse")
+	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
+	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
+	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
+	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
+	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
+	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
+	proto.RegisterType((*GetDelegateVotersRequest)(nil), "rpcpb.GetDelegateVotersRequest")
+	proto.RegisterType((*GetDelegateVotersResponse)(nil), "rpcpb.GetDelegateVotersResponse")
+	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
+	proto.RegisterType((*ContractCallRequest)(nil), "rpcpb.ContractCallRequest")
+	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
+	proto.RegisterType((*CandidateRequest)(nil), "rpcpb.CandidateRequest")
+	proto.RegisterType((*DelegateRequest)(nil), "rpcpb.DelegateRequest")
+	proto.RegisterType((*OracleAnswerRequest)(nil), "rpcpb.OracleAnswerRequest")
+	proto.RegisterType((*DelegateWeight)(nil), "rpcpb.DelegateWeight")
+	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
+	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
+	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
+	proto.RegisterType((*GetTransactionByHashRequest)(nil), "rpcpb.GetTransactionByHashRequest")
+	proto.RegisterType((*GetBlockRequest)(nil), "rpcpb.GetBlockRequest")
+	proto.RegisterType((*BlockResponse)(nil), "rpcpb.BlockResponse")
+	proto.RegisterType((*BlockTransaction)(nil), "rpcpb.BlockTransaction")
+	proto.RegisterType((*BlockDumpRequest)(nil), "rpcpb.BlockDumpRequest")
+	proto.RegisterType((*BlockDumpResponse)(nil), "rpcpb.BlockDumpResponse")
+	proto.RegisterType((*TransactionReceiptResponse)(nil), "rpcpb.TransactionReceiptResponse")
+	proto.RegisterType((*StateDiff)(nil), "rpcpb.StateDiff")
+	proto.RegisterType((*NewAccountRequest)(nil), "rpcpb.NewAccountRequest")
+	proto.RegisterType((*NewAccountResponse)(nil), "rpcpb.NewAccountResponse")
+	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
+	proto.RegisterType((*UnlockAccountResponse)(nil), "rpcpb.UnlockAccountResponse")
+	proto.RegisterType((*LockAccountRequest)(nil), "rpcpb.LockAccountRequest")
+	proto.RegisterType((*LockAccountResponse)(nil), "rpcpb.LockAccountResponse")
+	proto.RegisterType((*SignTransactionResponse)(nil), "rpcpb.SignTransactionResponse")
+	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
+	proto.RegisterType((*SendTransactionPassphraseResponse)(nil), "rpcpb.SendTransactionPassphraseResponse")
+	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
+	proto.RegisterType((*EstimateGasResponse)(nil), "rpcpb.EstimateGasResponse")
+	proto.RegisterType((*CallResponse)(nil), "rpcpb.CallResponse")
+	proto.RegisterType((*GasProfileEntry)(nil), "rpcpb.GasProfileEntry")
+	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
+	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+	proto.RegisterType((*ContractLog)(nil), "rpcpb.ContractLog")
+	proto.RegisterType((*GetLogsRequest)(nil), "rpcpb.GetLogsRequest")
+	proto.RegisterType((*GetLogsResponse)(nil), "rpcpb.GetLogsResponse")
+	proto.RegisterType((*GetEventsRequest)(nil), "rpcpb.GetEventsRequest")
+	proto.RegisterType((*ChainEvent)(nil), "rpcpb.ChainEvent")
+	proto.RegisterType((*GetEventsResponse)(nil), "rpcpb.GetEventsResponse")
+	proto.RegisterType((*GetTokenTransfersRequest)(nil), "rpcpb.GetTokenTransfersRequest")
+	proto.RegisterType((*TokenTransfer)(nil), "rpcpb.TokenTransfer")
+	proto.RegisterType((*GetTokenTransfersResponse)(nil), "rpcpb.GetTokenTransfersResponse")
+	proto.RegisterType((*NewFilterRequest)(nil), "rpcpb.NewFilterRequest")
+	proto.RegisterType((*NewFilterResponse)(nil), "rpcpb.NewFilterResponse")
+	proto.RegisterType((*FilterRequest)(nil), "rpcpb.FilterRequest")
+	proto.RegisterType((*FilterChangesResponse)(nil), "rpcpb.FilterChangesResponse")
+	proto.RegisterType((*UninstallFilterResponse)(nil), "rpcpb.UninstallFilterResponse")
+	proto.RegisterType((*GetTransactionsByAddressRequest)(nil), "rpcpb.GetTransactionsByAddressRequest")
+	proto.RegisterType((*AddressTransaction)(nil), "rpcpb.AddressTransaction")
+	proto.RegisterType((*GetTransactionsByAddressResponse)(nil), "rpcpb.GetTransactionsByAddressResponse")
+	proto.RegisterType((*GetTokenBalanceRequest)(nil), "rpcpb.GetTokenBalanceRequest")
+	proto.RegisterType((*GetTokenBalanceResponse)(nil), "rpcpb.GetTokenBalanceResponse")
+	proto.RegisterType((*GetTokenHoldingsRequest)(nil), "rpcpb.GetTokenHoldingsRequest")
+	proto.RegisterType((*GetTokenHoldingsResponse)(nil), "rpcpb.GetTokenHoldingsResponse")
+	proto.RegisterType((*GetContractAbiRequest)(nil), "rpcpb.GetContractAbiRequest")
+	proto.RegisterType((*GetContractAbiResponse)(nil), "rpcpb.GetContractAbiResponse")
+	proto.RegisterType((*AbiFunction)(nil), "rpcpb.AbiFunction")
+	proto.RegisterType((*AbiArg)(nil), "rpcpb.AbiArg")
+	proto.RegisterType((*VerifyContractRequest)(nil), "rpcpb.VerifyContractRequest")
+	proto.RegisterType((*ContractVerificationResponse)(nil), "rpcpb.ContractVerificationResponse")
+	proto.RegisterType((*LinkedLibrary)(nil), "rpcpb.LinkedLibrary")
+	proto.RegisterType((*GetConsensusStateResponse)(nil), "rpcpb.GetConsensusStateResponse")
+	proto.RegisterType((*DelegateVotes)(nil), "rpcpb.DelegateVotes")
+	proto.RegisterType((*SyncStateResponse)(nil), "rpcpb.SyncStateResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiService service
+
+type ApiServiceClient interface {
+	// Return the state of the neb.
+	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
+	// Return whether the node is alive, its storage readable. The HTTP status is 503 if not.
+	Health(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	// Return whether the node is ready to serve, synced with peers. The HTTP status is 503 if not.
+	Ready(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	// Return the p2p node info.
+	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	// Return the dump info of blockchain.
+	BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error)
+	// Accounts return account list.
+	Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	// Return the state of the account.
+	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
+	// Verify, sign, and send the transaction.
+	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Run a smart contract function against the state of a block without sending a transaction.
+	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error)
+	// Submit the signed transaction.
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Return the block by hash or height, with only the fields asked for.
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Get block header info by the block hash.
+	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error)
+	// Get transactionReceipt info by tansaction hash.
+	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error)
+	// Subscribe message
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
+	// Stream the blocks linked to the canonical chain and reverted from it, for the gRPC clients.
+	SubscribeNewBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (ApiService_SubscribeNewBlockClient, error)
+	// Stream the chain events of the topics, the kept ones replayed first, for the gRPC clients.
+	SubscribeEvents(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeEventsClient, error)
+	// Stream the transactions entering the pool, for the gRPC clients.
+	SubscribePendingTx(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (ApiService_SubscribePendingTxClient, error)
+	// Get GasPrice
+	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// EstimateGas
+	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	// Return the contract logs matching the filter.
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	// Return the chain events of the transactions matching the filter.
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	// Return the transfers of NRC20 and NRC721 tokens matching the filter.
+	GetTokenTransfers(ctx context.Context, in *GetTokenTransfersRequest, opts ...grpc.CallOption) (*GetTokenTransfersResponse, error)
+	// Return the transactions sent or received by an address in a block range, by page.
+	GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*GetTransactionsByAddressResponse, error)
+	// Install a filter keeping the new blocks, pending transactions, events or logs until polled.
+	NewFilter(ctx context.Context, in *NewFilterRequest, opts ...grpc.CallOption) (*NewFilterResponse, error)
+	// Return the changes of the filter since the last poll.
+	GetFilterChanges(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*FilterChangesResponse, error)
+	// Remove the filter.
+	UninstallFilter(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*UninstallFilterResponse, error)
+	// Return the balance of the account in an NRC20 token.
+	GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error)
+	// Return the ids of the tokens owned by the account in an NRC721 token.
+	GetTokenHoldings(ctx context.Context, in *GetTokenHoldingsRequest, opts ...grpc.CallOption) (*GetTokenHoldingsResponse, error)
+	// Return the ABI of the contract generated when it was deployed.
+	GetContractAbi(ctx context.Context, in *GetContractAbiRequest, opts ...grpc.CallOption) (*GetContractAbiResponse, error)
+	// Verify the source and deploy args of the contract match its code on chain, and keep the verification.
+	VerifyContract(ctx context.Context, in *VerifyContractRequest, opts ...grpc.CallOption) (*ContractVerificationResponse, error)
+	// Return the verified source of the contract.
+	GetContractVerification(ctx context.Context, in *GetContractAbiRequest, opts ...grpc.CallOption) (*ContractVerificationResponse, error)
+	// Return the state of the dpos consensus.
+	GetConsensusState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetConsensusStateResponse, error)
+	// Return the progress of the sync.
+	GetSyncState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*SyncStateResponse, error)
+}
+
+type apiServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
+	return &apiServiceClient{cc}
+}
+
+func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error) {
+	out := new(GetNebStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Health(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Health", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Ready(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Ready", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *apiServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error) {
@@ -1289,427 +4618,1067 @@ func (c *apiServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest, o
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *apiServiceClient) BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error) {
+	out := new(BlockDumpResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/BlockDump", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Accounts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
+	out := new(GetAccountStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Call", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error) {
+	out := new(corepb.Block)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error) {
+	out := new(TransactionReceiptResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) SubscribeNewBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (ApiService_SubscribeNewBlockClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[1], c.cc, "/rpcpb.ApiService/SubscribeNewBlock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeNewBlockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeNewBlockClient interface {
+	Recv() (*NewBlockResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeNewBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeNewBlockClient) Recv() (*NewBlockResponse, error) {
+	m := new(NewBlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) SubscribeEvents(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[2], c.cc, "/rpcpb.ApiService/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeEventsClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeEventsClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) SubscribePendingTx(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (ApiService_SubscribePendingTxClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[3], c.cc, "/rpcpb.ApiService/SubscribePendingTx", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribePendingTxClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribePendingTxClient interface {
+	Recv() (*PendingTxResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribePendingTxClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribePendingTxClient) Recv() (*PendingTxResponse, error) {
+	m := new(PendingTxResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	out := new(EventsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
+	out := new(GetLogsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTokenTransfers(ctx context.Context, in *GetTokenTransfersRequest, opts ...grpc.CallOption) (*GetTokenTransfersResponse, error) {
+	out := new(GetTokenTransfersResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTokenTransfers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*GetTransactionsByAddressResponse, error) {
+	out := new(GetTransactionsByAddressResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionsByAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) NewFilter(ctx context.Context, in *NewFilterRequest, opts ...grpc.CallOption) (*NewFilterResponse, error) {
+	out := new(NewFilterResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/NewFilter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetFilterChanges(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*FilterChangesResponse, error) {
+	out := new(FilterChangesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetFilterChanges", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UninstallFilter(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*UninstallFilterResponse, error) {
+	out := new(UninstallFilterResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/UninstallFilter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error) {
+	out := new(GetTokenBalanceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTokenBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTokenHoldings(ctx context.Context, in *GetTokenHoldingsRequest, opts ...grpc.CallOption) (*GetTokenHoldingsResponse, error) {
+	out := new(GetTokenHoldingsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTokenHoldings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractAbi(ctx context.Context, in *GetContractAbiRequest, opts ...grpc.CallOption) (*GetContractAbiResponse, error) {
+	out := new(GetContractAbiResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractAbi", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) VerifyContract(ctx context.Context, in *VerifyContractRequest, opts ...grpc.CallOption) (*ContractVerificationResponse, error) {
+	out := new(ContractVerificationResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/VerifyContract", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractVerification(ctx context.Context, in *GetContractAbiRequest, opts ...grpc.CallOption) (*ContractVerificationResponse, error) {
+	out := new(ContractVerificationResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractVerification", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetConsensusState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetConsensusStateResponse, error) {
+	out := new(GetConsensusStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetConsensusState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetSyncState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*SyncStateResponse, error) {
+	out := new(SyncStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetSyncState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ApiService service
+
+type ApiServiceServer interface {
+	// Return the state of the neb.
+	GetNebState(context.Context, *NonParamsRequest) (*GetNebStateResponse, error)
+	// Return whether the node is alive, its storage readable. The HTTP status is 503 if not.
+	Health(context.Context, *NonParamsRequest) (*HealthResponse, error)
+	// Return whether the node is ready to serve, synced with peers. The HTTP status is 503 if not.
+	Ready(context.Context, *NonParamsRequest) (*HealthResponse, error)
+	// Return the p2p node info.
+	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
+	// Return the dump info of blockchain.
+	BlockDump(context.Context, *BlockDumpRequest) (*BlockDumpResponse, error)
+	// Accounts return account list.
+	Accounts(context.Context, *NonParamsRequest) (*AccountsResponse, error)
+	// Return the state of the account.
+	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
+	// Verify, sign, and send the transaction.
+	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
+	// Run a smart contract function against the state of a block without sending a transaction.
+	Call(context.Context, *TransactionRequest) (*CallResponse, error)
+	// Submit the signed transaction.
+	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendTransactionResponse, error)
+	// Return the block by hash or height, with only the fields asked for.
+	GetBlock(context.Context, *GetBlockRequest) (*BlockResponse, error)
+	// Get block header info by the block hash.
+	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*corepb.Block, error)
+	// Get transactionReceipt info by tansaction hash.
+	GetTransactionReceipt(context.Context, *GetTransactionByHashRequest) (*TransactionReceiptResponse, error)
+	// Subscribe message
+	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
+	// Stream the blocks linked to the canonical chain and reverted from it, for the gRPC clients.
+	SubscribeNewBlock(*NonParamsRequest, ApiService_SubscribeNewBlockServer) error
+	// Stream the chain events of the topics, the kept ones replayed first, for the gRPC clients.
+	SubscribeEvents(*SubscribeRequest, ApiService_SubscribeEventsServer) error
+	// Stream the transactions entering the pool, for the gRPC clients.
+	SubscribePendingTx(*NonParamsRequest, ApiService_SubscribePendingTxServer) error
+	// Get GasPrice
+	GetGasPrice(context.Context, *NonParamsRequest) (*GasPriceResponse, error)
+	// EstimateGas
+	EstimateGas(context.Context, *TransactionRequest) (*EstimateGasResponse, error)
+	GetEventsByHash(context.Context, *GetTransactionByHashRequest) (*EventsResponse, error)
+	// Return the contract logs matching the filter.
+	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	// Return the chain events of the transactions matching the filter.
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	// Return the transfers of NRC20 and NRC721 tokens matching the filter.
+	GetTokenTransfers(context.Context, *GetTokenTransfersRequest) (*GetTokenTransfersResponse, error)
+	// Return the transactions sent or received by an address in a block range, by page.
+	GetTransactionsByAddress(context.Context, *GetTransactionsByAddressRequest) (*GetTransactionsByAddressResponse, error)
+	// Install a filter keeping the new blocks, pending transactions, events or logs until polled.
+	NewFilter(context.Context, *NewFilterRequest) (*NewFilterResponse, error)
+	// Return the changes of the filter since the last poll.
+	GetFilterChanges(context.Context, *FilterRequest) (*FilterChangesResponse, error)
+	// Remove the filter.
+	UninstallFilter(context.Context, *FilterRequest) (*UninstallFilterResponse, error)
+	// Return the balance of the account in an NRC20 token.
+	GetTokenBalance(context.Context, *GetTokenBalanceRequest) (*GetTokenBalanceResponse, error)
+	// Return the ids of the tokens owned by the account in an NRC721 token.
+	GetTokenHoldings(context.Context, *GetTokenHoldingsRequest) (*GetTokenHoldingsResponse, error)
+	// Return the ABI of the contract generated when it was deployed.
+	GetContractAbi(context.Context, *GetContractAbiRequest) (*GetContractAbiResponse, error)
+	// Verify the source and deploy args of the contract match its code on chain, and keep the verification.
+	VerifyContract(context.Context, *VerifyContractRequest) (*ContractVerificationResponse, error)
+	// Return the verified source of the contract.
+	GetContractVerification(context.Context, *GetContractAbiRequest) (*ContractVerificationResponse, error)
+	// Return the state of the dpos consensus.
+	GetConsensusState(context.Context, *NonParamsRequest) (*GetConsensusStateResponse, error)
+	// Return the progress of the sync.
+	GetSyncState(context.Context, *NonParamsRequest) (*SyncStateResponse, error)
+}
+
+func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
+	s.RegisterService(&_ApiService_serviceDesc, srv)
+}
+
+func _ApiService_GetNebState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNebState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetNebState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNebState(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Health(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_Ready_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Ready(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/Ready",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Ready(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).NodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/NodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).NodeInfo(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BlockDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BlockDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/BlockDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BlockDump(ctx, req.(*BlockDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Accounts(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetAccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountState(ctx, req.(*GetAccountStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SendTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error) {
-	out := new(BlockDumpResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/BlockDump", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Call(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
-	out := new(AccountsResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Accounts", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SendRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
-	out := new(GetAccountStateResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountState", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHashRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Call", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(ApiServiceServer).Subscribe(m, &apiServiceSubscribeServer{stream})
 }
 
-func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*corepb.Block, error) {
-	out := new(corepb.Block)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ApiService_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
 }
 
-func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionReceiptResponse, error) {
-	out := new(TransactionReceiptResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type apiServiceSubscribeServer struct {
+	grpc.ServerStream
 }
 
-func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &apiServiceSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (x *apiServiceSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribeNewBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NonParamsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return x, nil
+	return srv.(ApiServiceServer).SubscribeNewBlock(m, &apiServiceSubscribeNewBlockServer{stream})
 }
 
-type ApiService_SubscribeClient interface {
-	Recv() (*SubscribeResponse, error)
-	grpc.ClientStream
+type ApiService_SubscribeNewBlockServer interface {
+	Send(*NewBlockResponse) error
+	grpc.ServerStream
 }
 
-type apiServiceSubscribeClient struct {
-	grpc.ClientStream
+type apiServiceSubscribeNewBlockServer struct {
+	grpc.ServerStream
 }
 
-func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
-	m := new(SubscribeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (x *apiServiceSubscribeNewBlockServer) Send(m *NewBlockResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
-	out := new(GasPriceResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func _ApiService_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(ApiServiceServer).SubscribeEvents(m, &apiServiceSubscribeEventsServer{stream})
 }
 
-func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
-	out := new(EstimateGasResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ApiService_SubscribeEventsServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
 }
 
-func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
-	out := new(EventsResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type apiServiceSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeEventsServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribePendingTx_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NonParamsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(ApiServiceServer).SubscribePendingTx(m, &apiServiceSubscribePendingTxServer{stream})
 }
 
-// Server API for ApiService service
+type ApiService_SubscribePendingTxServer interface {
+	Send(*PendingTxResponse) error
+	grpc.ServerStream
+}
 
-type ApiServiceServer interface {
-	// Return the state of the neb.
-	GetNebState(context.Context, *NonParamsRequest) (*GetNebStateResponse, error)
-	// Return the p2p node info.
-	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
-	// Return the dump info of blockchain.
-	BlockDump(context.Context, *BlockDumpRequest) (*BlockDumpResponse, error)
-	// Accounts return account list.
-	Accounts(context.Context, *NonParamsRequest) (*AccountsResponse, error)
-	// Return the state of the account.
-	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
-	// Verify, sign, and send the transaction.
-	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
-	// Call smart contract.
-	Call(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
-	// Submit the signed transaction.
-	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendTransactionResponse, error)
-	// Get block header info by the block hash.
-	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*corepb.Block, error)
-	// Get transactionReceipt info by tansaction hash.
-	GetTransactionReceipt(context.Context, *GetTransactionByHashRequest) (*TransactionReceiptResponse, error)
-	// Subscribe message
-	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
-	// Get GasPrice
-	GetGasPrice(context.Context, *NonParamsRequest) (*GasPriceResponse, error)
-	// EstimateGas
-	EstimateGas(context.Context, *TransactionRequest) (*EstimateGasResponse, error)
-	GetEventsByHash(context.Context, *GetTransactionByHashRequest) (*EventsResponse, error)
+type apiServiceSubscribePendingTxServer struct {
+	grpc.ServerStream
 }
 
-func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
-	s.RegisterService(&_ApiService_serviceDesc, srv)
+func (x *apiServiceSubscribePendingTxServer) Send(m *PendingTxResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _ApiService_GetNebState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_GetGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetNebState(ctx, in)
+		return srv.(ApiServiceServer).GetGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetGasPrice(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).EstimateGas(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetEventsByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEventsByHash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetNebState",
+		FullMethod: "/rpcpb.ApiService/GetEventsByHash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetNebState(ctx, req.(*NonParamsRequest))
+		return srv.(ApiServiceServer).GetEventsByHash(ctx, req.(*GetTransactionByHashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
+func _ApiService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).NodeInfo(ctx, in)
+		return srv.(ApiServiceServer).GetLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/NodeInfo",
+		FullMethod: "/rpcpb.ApiService/GetLogs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).NodeInfo(ctx, req.(*NonParamsRequest))
+		return srv.(ApiServiceServer).GetLogs(ctx, req.(*GetLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_BlockDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockDumpRequest)
+func _ApiService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).BlockDump(ctx, in)
+		return srv.(ApiServiceServer).GetEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/BlockDump",
+		FullMethod: "/rpcpb.ApiService/GetEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).BlockDump(ctx, req.(*BlockDumpRequest))
+		return srv.(ApiServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
+func _ApiService_GetTokenTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenTransfersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).Accounts(ctx, in)
+		return srv.(ApiServiceServer).GetTokenTransfers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/Accounts",
+		FullMethod: "/rpcpb.ApiService/GetTokenTransfers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Accounts(ctx, req.(*NonParamsRequest))
+		return srv.(ApiServiceServer).GetTokenTransfers(ctx, req.(*GetTokenTransfersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetAccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountStateRequest)
+func _ApiService_GetTransactionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsByAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAccountState(ctx, in)
+		return srv.(ApiServiceServer).GetTransactionsByAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetAccountState",
+		FullMethod: "/rpcpb.ApiService/GetTransactionsByAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAccountState(ctx, req.(*GetAccountStateRequest))
+		return srv.(ApiServiceServer).GetTransactionsByAddress(ctx, req.(*GetTransactionsByAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+func _ApiService_NewFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewFilterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SendTransaction(ctx, in)
+		return srv.(ApiServiceServer).NewFilter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/SendTransaction",
+		FullMethod: "/rpcpb.ApiService/NewFilter",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SendTransaction(ctx, req.(*TransactionRequest))
+		return srv.(ApiServiceServer).NewFilter(ctx, req.(*NewFilterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+func _ApiService_GetFilterChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).Call(ctx, in)
+		return srv.(ApiServiceServer).GetFilterChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/Call",
+		FullMethod: "/rpcpb.ApiService/GetFilterChanges",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Call(ctx, req.(*TransactionRequest))
+		return srv.(ApiServiceServer).GetFilterChanges(ctx, req.(*FilterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendRawTransactionRequest)
+func _ApiService_UninstallFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).UninstallFilter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/SendRawTransaction",
+		FullMethod: "/rpcpb.ApiService/UninstallFilter",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+		return srv.(ApiServiceServer).UninstallFilter(ctx, req.(*FilterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHashRequest)
+func _ApiService_GetTokenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenBalanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockByHash(ctx, in)
+		return srv.(ApiServiceServer).GetTokenBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetBlockByHash",
+		FullMethod: "/rpcpb.ApiService/GetTokenBalance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+		return srv.(ApiServiceServer).GetTokenBalance(ctx, req.(*GetTokenBalanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionByHashRequest)
+func _ApiService_GetTokenHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenHoldingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, in)
+		return srv.(ApiServiceServer).GetTokenHoldings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetTransactionReceipt",
+		FullMethod: "/rpcpb.ApiService/GetTokenHoldings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, req.(*GetTransactionByHashRequest))
+		return srv.(ApiServiceServer).GetTokenHoldings(ctx, req.(*GetTokenHoldingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _ApiService_GetContractAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractAbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(ApiServiceServer).Subscribe(m, &apiServiceSubscribeServer{stream})
-}
-
-type ApiService_SubscribeServer interface {
-	Send(*SubscribeResponse) error
-	grpc.ServerStream
-}
-
-type apiServiceSubscribeServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractAbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractAbi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractAbi(ctx, req.(*GetContractAbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *apiServiceSubscribeServer) Send(m *SubscribeResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _ApiService_VerifyContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).VerifyContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/VerifyContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).VerifyContract(ctx, req.(*VerifyContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
+func _ApiService_GetContractVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractAbiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetGasPrice(ctx, in)
+		return srv.(ApiServiceServer).GetContractVerification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetGasPrice",
+		FullMethod: "/rpcpb.ApiService/GetContractVerification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetGasPrice(ctx, req.(*NonParamsRequest))
+		return srv.(ApiServiceServer).GetContractVerification(ctx, req.(*GetContractAbiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+func _ApiService_GetConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).EstimateGas(ctx, in)
+		return srv.(ApiServiceServer).GetConsensusState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/EstimateGas",
+		FullMethod: "/rpcpb.ApiService/GetConsensusState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).EstimateGas(ctx, req.(*TransactionRequest))
+		return srv.(ApiServiceServer).GetConsensusState(ctx, req.(*NonParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetEventsByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionByHashRequest)
+func _ApiService_GetSyncState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetEventsByHash(ctx, in)
+		return srv.(ApiServiceServer).GetSyncState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetEventsByHash",
+		FullMethod: "/rpcpb.ApiService/GetSyncState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetEventsByHash(ctx, req.(*GetTransactionByHashRequest))
+		return srv.(ApiServiceServer).GetSyncState(ctx, req.(*NonParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1722,6 +5691,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNebState",
 			Handler:    _ApiService_GetNebState_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _ApiService_Health_Handler,
+		},
+		{
+			MethodName: "Ready",
+			Handler:    _ApiService_Ready_Handler,
+		},
 		{
 			MethodName: "NodeInfo",
 			Handler:    _ApiService_NodeInfo_Handler,
@@ -1750,6 +5727,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendRawTransaction",
 			Handler:    _ApiService_SendRawTransaction_Handler,
 		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _ApiService_GetBlock_Handler,
+		},
 		{
 			MethodName: "GetBlockByHash",
 			Handler:    _ApiService_GetBlockByHash_Handler,
@@ -1770,6 +5751,62 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventsByHash",
 			Handler:    _ApiService_GetEventsByHash_Handler,
 		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _ApiService_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _ApiService_GetEvents_Handler,
+		},
+		{
+			MethodName: "GetTokenTransfers",
+			Handler:    _ApiService_GetTokenTransfers_Handler,
+		},
+		{
+			MethodName: "GetTransactionsByAddress",
+			Handler:    _ApiService_GetTransactionsByAddress_Handler,
+		},
+		{
+			MethodName: "NewFilter",
+			Handler:    _ApiService_NewFilter_Handler,
+		},
+		{
+			MethodName: "GetFilterChanges",
+			Handler:    _ApiService_GetFilterChanges_Handler,
+		},
+		{
+			MethodName: "UninstallFilter",
+			Handler:    _ApiService_UninstallFilter_Handler,
+		},
+		{
+			MethodName: "GetTokenBalance",
+			Handler:    _ApiService_GetTokenBalance_Handler,
+		},
+		{
+			MethodName: "GetTokenHoldings",
+			Handler:    _ApiService_GetTokenHoldings_Handler,
+		},
+		{
+			MethodName: "GetContractAbi",
+			Handler:    _ApiService_GetContractAbi_Handler,
+		},
+		{
+			MethodName: "VerifyContract",
+			Handler:    _ApiService_VerifyContract_Handler,
+		},
+		{
+			MethodName: "GetContractVerification",
+			Handler:    _ApiService_GetContractVerification_Handler,
+		},
+		{
+			MethodName: "GetConsensusState",
+			Handler:    _ApiService_GetConsensusState_Handler,
+		},
+		{
+			MethodName: "GetSyncState",
+			Handler:    _ApiService_GetSyncState_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1777,6 +5814,21 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ApiService_Subscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeNewBlock",
+			Handler:       _ApiService_SubscribeNewBlock_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _ApiService_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePendingTx",
+			Handler:       _ApiService_SubscribePendingTx_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_rpc.proto",
 }
@@ -1798,91 +5850,244 @@ type AdminServiceClient interface {
 	GetDynasty(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error)
 	GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error)
 	ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error)
+	TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error)
+	// Return the scores of the penalized peers.
+	GetPeerScores(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PeerScoresResponse, error)
+	// Return the statistics of the connected peers.
+	GetPeerStats(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PeerStatsResponse, error)
+	// Add a static or trusted peer.
+	AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*ChangePeerResponse, error)
+	// Remove a peer from the static and trusted peers.
+	RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*ChangePeerResponse, error)
+	// Return the static and trusted peers.
+	GetPeers(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PeersResponse, error)
+	// Add a CIDR to the IP allowlist or denylist of the peer connections.
+	AddIPFilter(ctx context.Context, in *IPFilterRequest, opts ...grpc.CallOption) (*IPFilterResponse, error)
+	// Remove a CIDR from the IP allowlist and denylist.
+	RemoveIPFilter(ctx context.Context, in *IPFilterRequest, opts ...grpc.CallOption) (*IPFilterResponse, error)
+	// Return the IP allowlist and denylist.
+	GetIPFilter(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*IPFilterResponse, error)
+	// Return the transactions of the pool.
+	GetPendingTransactions(ctx context.Context, in *PendingTransactionsRequest, opts ...grpc.CallOption) (*PendingTransactionsResponse, error)
+	// Drop all the transactions of the pool.
+	FlushTransactionPool(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*FlushTransactionPoolResponse, error)
+	// Rewind the canonical chain to its block at a height.
+	SetHead(ctx context.Context, in *SetHeadRequest, opts ...grpc.CallOption) (*SetHeadResponse, error)
+	// Change the level of the log, or return it.
+	SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	// Return the config of the node, the secrets redacted.
+	GetConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) NewAccount(ctx context.Context, in *NewAccountRequest, opts ...grpc.CallOption) (*NewAccountResponse, error) {
+	out := new(NewAccountResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/NewAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error) {
+	out := new(UnlockAccountResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/UnlockAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error) {
+	out := new(LockAccountResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/LockAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SignTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
+	out := new(SignTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SignTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SendTransactionWithPassphrase(ctx context.Context, in *SendTransactionPassphraseRequest, opts ...grpc.CallOption) (*SendTransactionPassphraseResponse, error) {
+	out := new(SendTransactionPassphraseResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SendTransactionWithPassphrase", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StatisticsNodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*StatisticsNodeInfoResponse, error) {
+	out := new(StatisticsNodeInfoResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StatisticsNodeInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynasty(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error) {
+	out := new(GetDynastyResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDynasty", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error) {
+	out := new(GetDelegateVotersResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDelegateVoters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error) {
+	out := new(ChangeNetworkIDResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/ChangeNetworkID", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceTransactionResponse, error) {
+	out := new(TraceTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/TraceTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPeerScores(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PeerScoresResponse, error) {
+	out := new(PeerScoresResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetPeerScores", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPeerStats(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PeerStatsResponse, error) {
+	out := new(PeerStatsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetPeerStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type adminServiceClient struct {
-	cc *grpc.ClientConn
+func (c *adminServiceClient) AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*ChangePeerResponse, error) {
+	out := new(ChangePeerResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/AddPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
-	return &adminServiceClient{cc}
+func (c *adminServiceClient) RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*ChangePeerResponse, error) {
+	out := new(ChangePeerResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/RemovePeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *adminServiceClient) NewAccount(ctx context.Context, in *NewAccountRequest, opts ...grpc.CallOption) (*NewAccountResponse, error) {
-	out := new(NewAccountResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/NewAccount", in, out, c.cc, opts...)
+func (c *adminServiceClient) GetPeers(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PeersResponse, error) {
+	out := new(PeersResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetPeers", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error) {
-	out := new(UnlockAccountResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/UnlockAccount", in, out, c.cc, opts...)
+func (c *adminServiceClient) AddIPFilter(ctx context.Context, in *IPFilterRequest, opts ...grpc.CallOption) (*IPFilterResponse, error) {
+	out := new(IPFilterResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/AddIPFilter", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminServiceClient) LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error) {
-	out := new(LockAccountResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/LockAccount", in, out, c.cc, opts...)
+func (c *adminServiceClient) RemoveIPFilter(ctx context.Context, in *IPFilterRequest, opts ...grpc.CallOption) (*IPFilterResponse, error) {
+	out := new(IPFilterResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/RemoveIPFilter", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminServiceClient) SignTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
-	out := new(SignTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SignTransaction", in, out, c.cc, opts...)
+func (c *adminServiceClient) GetIPFilter(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*IPFilterResponse, error) {
+	out := new(IPFilterResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetIPFilter", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminServiceClient) SendTransactionWithPassphrase(ctx context.Context, in *SendTransactionPassphraseRequest, opts ...grpc.CallOption) (*SendTransactionPassphraseResponse, error) {
-	out := new(SendTransactionPassphraseResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SendTransactionWithPassphrase", in, out, c.cc, opts...)
+func (c *adminServiceClient) GetPendingTransactions(ctx context.Context, in *PendingTransactionsRequest, opts ...grpc.CallOption) (*PendingTransactionsResponse, error) {
+	out := new(PendingTransactionsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetPendingTransactions", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminServiceClient) StatisticsNodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*StatisticsNodeInfoResponse, error) {
-	out := new(StatisticsNodeInfoResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StatisticsNodeInfo", in, out, c.cc, opts...)
+func (c *adminServiceClient) FlushTransactionPool(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*FlushTransactionPoolResponse, error) {
+	out := new(FlushTransactionPoolResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/FlushTransactionPool", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminServiceClient) GetDynasty(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error) {
-	out := new(GetDynastyResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDynasty", in, out, c.cc, opts...)
+func (c *adminServiceClient) SetHead(ctx context.Context, in *SetHeadRequest, opts ...grpc.CallOption) (*SetHeadResponse, error) {
+	out := new(SetHeadResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SetHead", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminServiceClient) GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error) {
-	out := new(GetDelegateVotersResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDelegateVoters", in, out, c.cc, opts...)
+func (c *adminServiceClient) SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SetLogLevel", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminServiceClient) ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error) {
-	out := new(ChangeNetworkIDResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/ChangeNetworkID", in, out, c.cc, opts...)
+func (c *adminServiceClient) GetConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
+	out := new(ConfigResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetConfig", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1906,6 +6111,33 @@ type AdminServiceServer interface {
 	GetDynasty(context.Context, *NonParamsRequest) (*GetDynastyResponse, error)
 	GetDelegateVoters(context.Context, *GetDelegateVotersRequest) (*GetDelegateVotersResponse, error)
 	ChangeNetworkID(context.Context, *ChangeNetworkIDRequest) (*ChangeNetworkIDResponse, error)
+	TraceTransaction(context.Context, *TraceTransactionRequest) (*TraceTransactionResponse, error)
+	// Return the scores of the penalized peers.
+	GetPeerScores(context.Context, *NonParamsRequest) (*PeerScoresResponse, error)
+	// Return the statistics of the connected peers.
+	GetPeerStats(context.Context, *NonParamsRequest) (*PeerStatsResponse, error)
+	// Add a static or trusted peer.
+	AddPeer(context.Context, *AddPeerRequest) (*ChangePeerResponse, error)
+	// Remove a peer from the static and trusted peers.
+	RemovePeer(context.Context, *RemovePeerRequest) (*ChangePeerResponse, error)
+	// Return the static and trusted peers.
+	GetPeers(context.Context, *NonParamsRequest) (*PeersResponse, error)
+	// Add a CIDR to the IP allowlist or denylist of the peer connections.
+	AddIPFilter(context.Context, *IPFilterRequest) (*IPFilterResponse, error)
+	// Remove a CIDR from the IP allowlist and denylist.
+	RemoveIPFilter(context.Context, *IPFilterRequest) (*IPFilterResponse, error)
+	// Return the IP allowlist and denylist.
+	GetIPFilter(context.Context, *NonParamsRequest) (*IPFilterResponse, error)
+	// Return the transactions of the pool.
+	GetPendingTransactions(context.Context, *PendingTransactionsRequest) (*PendingTransactionsResponse, error)
+	// Drop all the transactions of the pool.
+	FlushTransactionPool(context.Context, *NonParamsRequest) (*FlushTransactionPoolResponse, error)
+	// Rewind the canonical chain to its block at a height.
+	SetHead(context.Context, *SetHeadRequest) (*SetHeadResponse, error)
+	// Change the level of the log, or return it.
+	SetLogLevel(context.Context, *LogLevelRequest) (*LogLevelResponse, error)
+	// Return the config of the node, the secrets redacted.
+	GetConfig(context.Context, *NonParamsRequest) (*ConfigResponse, error)
 }
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
@@ -2074,6 +6306,258 @@ func _AdminService_ChangeNetworkID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_TraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TraceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/TraceTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TraceTransaction(ctx, req.(*TraceTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPeerScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPeerScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetPeerScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPeerScores(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPeerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPeerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetPeerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPeerStats(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/AddPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddPeer(ctx, req.(*AddPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemovePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemovePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RemovePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemovePeer(ctx, req.(*RemovePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPeers(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddIPFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddIPFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/AddIPFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddIPFilter(ctx, req.(*IPFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveIPFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveIPFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RemoveIPFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveIPFilter(ctx, req.(*IPFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetIPFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetIPFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetIPFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetIPFilter(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPendingTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetPendingTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPendingTransactions(ctx, req.(*PendingTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_FlushTransactionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).FlushTransactionPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/FlushTransactionPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).FlushTransactionPool(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/SetHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetHead(ctx, req.(*SetHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetConfig(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -2114,6 +6598,62 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeNetworkID",
 			Handler:    _AdminService_ChangeNetworkID_Handler,
 		},
+		{
+			MethodName: "TraceTransaction",
+			Handler:    _AdminService_TraceTransaction_Handler,
+		},
+		{
+			MethodName: "GetPeerScores",
+			Handler:    _AdminService_GetPeerScores_Handler,
+		},
+		{
+			MethodName: "GetPeerStats",
+			Handler:    _AdminService_GetPeerStats_Handler,
+		},
+		{
+			MethodName: "AddPeer",
+			Handler:    _AdminService_AddPeer_Handler,
+		},
+		{
+			MethodName: "RemovePeer",
+			Handler:    _AdminService_RemovePeer_Handler,
+		},
+		{
+			MethodName: "GetPeers",
+			Handler:    _AdminService_GetPeers_Handler,
+		},
+		{
+			MethodName: "AddIPFilter",
+			Handler:    _AdminService_AddIPFilter_Handler,
+		},
+		{
+			MethodName: "RemoveIPFilter",
+			Handler:    _AdminService_RemoveIPFilter_Handler,
+		},
+		{
+			MethodName: "GetIPFilter",
+			Handler:    _AdminService_GetIPFilter_Handler,
+		},
+		{
+			MethodName: "GetPendingTransactions",
+			Handler:    _AdminService_GetPendingTransactions_Handler,
+		},
+		{
+			MethodName: "FlushTransactionPool",
+			Handler:    _AdminService_FlushTransactionPool_Handler,
+		},
+		{
+			MethodName: "SetHead",
+			Handler:    _AdminService_SetHead_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _AdminService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _AdminService_GetConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api_rpc.proto",
@@ -2122,131 +6662,344 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api_rpc.proto", fileDescriptorApiRpc) }
 
 var fileDescriptorApiRpc = []byte{
-	// 2015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0x06, 0xa9, 0x3f, 0xb2, 0xa8, 0xdf, 0xb6, 0x25, 0x91, 0xb4, 0x24, 0xcb, 0xed, 0x0d, 0x96,
-	0xab, 0xc0, 0xe2, 0x9a, 0x4e, 0xd6, 0x0b, 0xe7, 0x64, 0xcb, 0x86, 0x2c, 0xc0, 0x11, 0x84, 0x91,
-	0xb3, 0x7b, 0x58, 0x2c, 0x88, 0xe6, 0x4c, 0x7b, 0x38, 0x30, 0x39, 0x33, 0x3b, 0xdd, 0x94, 0x56,
-	0x0a, 0x90, 0x00, 0x01, 0x72, 0xc8, 0x39, 0x6f, 0x90, 0x43, 0x80, 0x3c, 0x44, 0x0e, 0x39, 0xe4,
-	0x09, 0xf2, 0x0a, 0x79, 0x90, 0xa0, 0x6b, 0xba, 0xe7, 0x9f, 0xd6, 0x2e, 0x72, 0x9b, 0xaa, 0xae,
-	0xae, 0xaa, 0xae, 0xae, 0xfa, 0xaa, 0xa6, 0x61, 0x8d, 0x85, 0xde, 0x30, 0x0a, 0xed, 0xe3, 0x30,
-	0x0a, 0x64, 0x40, 0x96, 0xa2, 0xd0, 0x0e, 0x47, 0xdd, 0x3d, 0x37, 0x08, 0xdc, 0x09, 0xef, 0xb3,
-	0xd0, 0xeb, 0x33, 0xdf, 0x0f, 0x24, 0x93, 0x5e, 0xe0, 0x8b, 0x58, 0xa8, 0xfb, 0xcc, 0xf5, 0xe4,
-	0x78, 0x36, 0x3a, 0xb6, 0x83, 0x69, 0xdf, 0xe7, 0xa3, 0xd9, 0x84, 0x09, 0x2f, 0xe8, 0xbb, 0xc1,
-	0x13, 0x4d, 0xf4, 0xed, 0x20, 0xe2, 0xfd, 0x70, 0xd4, 0x1f, 0x4d, 0x02, 0xfb, 0x63, 0xbc, 0x89,
-	0xf6, 0x60, 0xf3, 0x72, 0x36, 0x12, 0x76, 0xe4, 0x8d, 0xb8, 0xc5, 0x7f, 0x98, 0x71, 0x21, 0xc9,
-	0x7d, 0x58, 0x92, 0x41, 0xe8, 0xd9, 0xed, 0xda, 0xe1, 0x42, 0xaf, 0x69, 0xc5, 0x04, 0x7d, 0x0e,
-	0x3b, 0x27, 0x63, 0xe6, 0xbb, 0xfc, 0x9c, 0xcb, 0xeb, 0x20, 0xfa, 0x78, 0xf6, 0xda, 0xc8, 0xef,
-	0x03, 0xf8, 0x31, 0x6f, 0xe8, 0x39, 0xed, 0xda, 0x61, 0xad, 0xb7, 0x66, 0x35, 0x35, 0xe7, 0xcc,
-	0xa1, 0x4f, 0x61, 0xb7, 0xb4, 0x51, 0x84, 0x81, 0x2f, 0x38, 0xd9, 0x81, 0xe5, 0x88, 0x8b, 0xd9,
-	0x44, 0xe2, 0xae, 0x86, 0xa5, 0x29, 0xfa, 0x0a, 0xb6, 0x32, 0x5e, 0x69, 0xe1, 0x0e, 0x34, 0xa6,
-	0xc2, 0x1d, 0xca, 0x9b, 0x90, 0xa3, 0x78, 0xd3, 0x5a, 0x99, 0x0a, 0xf7, 0xfd, 0x4d, 0xc8, 0x09,
-	0x81, 0x45, 0x87, 0x49, 0xd6, 0xae, 0x23, 0x1b, 0xbf, 0x29, 0x81, 0xcd, 0xf3, 0xc0, 0xbf, 0x60,
-	0x11, 0x9b, 0x0a, 0xed, 0x29, 0xfd, 0xc7, 0x82, 0x62, 0x3a, 0xfc, 0xcc, 0xff, 0x10, 0x24, 0x7a,
-	0xd7, 0xa1, 0xae, 0xdd, 0x6e, 0x5a, 0x75, 0xcf, 0x51, 0x76, 0xec, 0x31, 0xf3, 0x7c, 0x75, 0x98,
-	0x3a, 0x1e, 0x66, 0x05, 0xe9, 0x33, 0x87, 0xb4, 0x61, 0xe5, 0x8a, 0x47, 0xc2, 0x0b, 0xfc, 0xf6,
-	0x42, 0xbc, 0xa2, 0x49, 0x15, 0x83, 0x90, 0xf3, 0x68, 0x68, 0x07, 0x33, 0x5f, 0xb6, 0x17, 0xe3,
-	0x18, 0x28, 0xce, 0x89, 0x62, 0x10, 0x0a, 0xab, 0xe2, 0xc6, 0xb7, 0xc7, 0x51, 0xe0, 0x7b, 0xb7,
-	0xdc, 0x69, 0x2f, 0xe1, 0x71, 0x73, 0x3c, 0xf2, 0x10, 0x5a, 0xa3, 0x99, 0xfd, 0x91, 0xcb, 0xa1,
-	0xf0, 0x6e, 0x79, 0x7b, 0xf9, 0xb0, 0xd6, 0x5b, 0xb2, 0x20, 0x66, 0x5d, 0x7a, 0xb7, 0x9c, 0xf4,
-	0x60, 0x33, 0xe2, 0x13, 0x76, 0x33, 0xb4, 0x99, 0x3d, 0xe6, 0xb1, 0xd4, 0x0a, 0x4a, 0xad, 0x23,
-	0xff, 0x44, 0xb1, 0x51, 0xf2, 0x08, 0xb6, 0x84, 0x8c, 0x38, 0x9b, 0x0e, 0x85, 0x0c, 0x22, 0x2d,
-	0xda, 0x40, 0xd1, 0x8d, 0x78, 0xe1, 0x52, 0xf1, 0x51, 0xf6, 0x39, 0xb4, 0x73, 0xb2, 0xfc, 0x47,
-	0xc9, 0x7d, 0x27, 0xde, 0xd2, 0xc4, 0x2d, 0xdb, 0x99, 0x2d, 0x6f, 0x70, 0x15, 0x37, 0x7e, 0x01,
-	0x9b, 0x98, 0x43, 0x76, 0x30, 0x19, 0x9a, 0xa8, 0x00, 0x46, 0x71, 0xc3, 0xf0, 0xbf, 0xd1, 0xd1,
-	0x19, 0x40, 0x2b, 0x0a, 0x66, 0x92, 0x0f, 0x25, 0x1b, 0x4d, 0x78, 0xbb, 0x75, 0xb8, 0xd0, 0x6b,
-	0x0d, 0xb6, 0x8e, 0x31, 0xab, 0x8f, 0x2d, 0xb5, 0xf2, 0x5e, 0x2d, 0x58, 0x10, 0x25, 0xdf, 0xf4,
-	0x0f, 0xd0, 0xbd, 0x54, 0x09, 0x2e, 0xa4, 0x67, 0x8b, 0xd2, 0xa5, 0xed, 0xc0, 0x32, 0xf2, 0x5e,
-	0xeb, 0x8b, 0xd3, 0x94, 0xe2, 0xbf, 0xe5, 0x9e, 0x3b, 0x96, 0x78, 0x75, 0x8b, 0x96, 0xa6, 0x54,
-	0x86, 0xbc, 0x65, 0x62, 0x8c, 0xd7, 0xd6, 0xb4, 0xf0, 0x9b, 0xec, 0x41, 0xf3, 0xc2, 0xdc, 0x90,
-	0xb9, 0xb2, 0x84, 0x41, 0xbf, 0x02, 0x48, 0x3d, 0x2b, 0x25, 0x49, 0x1b, 0x56, 0x98, 0xe3, 0x44,
-	0x5c, 0x88, 0x76, 0x1d, 0xab, 0xc4, 0x90, 0xf4, 0xcf, 0x75, 0xb8, 0x77, 0xca, 0xe5, 0x39, 0x1f,
-	0x29, 0xf7, 0x73, 0xe9, 0x9b, 0xa4, 0x55, 0x2d, 0x9f, 0x56, 0x04, 0x16, 0x25, 0xf3, 0x26, 0x26,
-	0x7d, 0xd5, 0x37, 0xe9, 0x42, 0xc3, 0x0e, 0x3c, 0x7f, 0xc4, 0x04, 0xd7, 0x4e, 0x27, 0xf4, 0x5d,
-	0xc9, 0xf6, 0x00, 0x9a, 0x9e, 0x18, 0x4e, 0x3d, 0xdf, 0xf3, 0x5d, 0x9d, 0x69, 0x0d, 0x4f, 0xfc,
-	0x16, 0xe9, 0xca, 0x5b, 0x5b, 0xae, 0xbe, 0xb5, 0x62, 0xd2, 0xae, 0x54, 0x24, 0x6d, 0xa6, 0x22,
-	0x1a, 0x71, 0x4d, 0x6a, 0x92, 0x7e, 0x09, 0x9b, 0x2f, 0x6d, 0xf4, 0x50, 0x24, 0x31, 0xd8, 0x83,
-	0xa6, 0x0e, 0x13, 0x17, 0x1a, 0x5d, 0x52, 0x06, 0x7d, 0x0b, 0x3b, 0xa7, 0x5c, 0xea, 0x4d, 0x3a,
-	0x78, 0x31, 0xc2, 0x64, 0xa2, 0xad, 0x2b, 0x5f, 0x93, 0x0a, 0xab, 0x10, 0xce, 0x74, 0xec, 0x62,
-	0x82, 0x9e, 0xc1, 0x6e, 0x49, 0x93, 0x76, 0xa1, 0x0d, 0x2b, 0x23, 0x36, 0x61, 0xbe, 0x9d, 0x80,
-	0x88, 0x26, 0x95, 0x2a, 0x3f, 0x50, 0x7c, 0xad, 0x0a, 0x09, 0xfa, 0x2b, 0x20, 0xa7, 0x5c, 0xbe,
-	0xbe, 0xf1, 0x99, 0x90, 0x37, 0x89, 0x96, 0x03, 0x00, 0x87, 0x4f, 0xb8, 0xcb, 0x24, 0x4f, 0x4e,
-	0x92, 0xe1, 0xd0, 0xaf, 0xa1, 0xad, 0x76, 0x69, 0xc6, 0x37, 0x81, 0xe4, 0x91, 0x01, 0x21, 0x15,
-	0x84, 0x44, 0x52, 0xfb, 0x90, 0x32, 0xe8, 0x33, 0xe8, 0x54, 0xec, 0x4c, 0xb3, 0xfe, 0x0a, 0x39,
-	0xda, 0xa4, 0xa6, 0xe8, 0x3f, 0xeb, 0x40, 0xde, 0x47, 0xcc, 0x17, 0xcc, 0x56, 0x1d, 0xc1, 0x58,
-	0x22, 0xb0, 0xf8, 0x21, 0x0a, 0xa6, 0xda, 0x08, 0x7e, 0xab, 0x44, 0x96, 0x81, 0x3e, 0x62, 0x5d,
-	0x06, 0xea, 0xd4, 0x57, 0x6c, 0x32, 0x33, 0x49, 0x16, 0x13, 0x69, 0x2c, 0x16, 0xb1, 0x8a, 0x62,
-	0x42, 0x25, 0x96, 0xcb, 0xc4, 0x30, 0x8c, 0x3c, 0x9b, 0x63, 0x62, 0x35, 0xad, 0x86, 0xcb, 0xc4,
-	0x85, 0xa2, 0xcd, 0xe2, 0xc4, 0x9b, 0x7a, 0x52, 0x67, 0x94, 0x5a, 0x7c, 0xa7, 0x68, 0x32, 0x50,
-	0xd9, 0xec, 0xcb, 0x88, 0xd9, 0x12, 0xd3, 0xa8, 0x35, 0xd8, 0xd1, 0xd5, 0x7f, 0xa2, 0xd9, 0xda,
-	0x67, 0x2b, 0x91, 0x23, 0xbf, 0x86, 0xa6, 0xcd, 0x7c, 0xc7, 0x73, 0x98, 0x8c, 0xc1, 0xab, 0x35,
-	0xd8, 0x35, 0x9b, 0x0c, 0xdf, 0xec, 0x4a, 0x25, 0x95, 0x29, 0x13, 0x4d, 0xc4, 0xaf, 0xd4, 0x94,
-	0x09, 0x6a, 0x62, 0xca, 0xc8, 0xd1, 0x5b, 0xd8, 0x28, 0xf8, 0xa1, 0x42, 0x2d, 0x82, 0x59, 0x94,
-	0xa4, 0x89, 0xa6, 0x14, 0x4a, 0xc7, 0x5f, 0x71, 0x23, 0x8a, 0x03, 0x09, 0x31, 0x0b, 0x7b, 0x51,
-	0x17, 0x1a, 0x1f, 0x66, 0x3e, 0xde, 0x83, 0x29, 0x5c, 0x43, 0xab, 0x0b, 0x61, 0x91, 0x2b, 0x30,
-	0xaa, 0x4d, 0x0b, 0xbf, 0xe9, 0x11, 0x6c, 0x16, 0x8f, 0xa3, 0x8c, 0xc7, 0x37, 0x69, 0x8c, 0xc7,
-	0x14, 0x3d, 0x85, 0x8d, 0xc2, 0x21, 0xe6, 0x89, 0xe6, 0xb3, 0xac, 0x5e, 0xcc, 0xb2, 0x3e, 0x74,
-	0x2e, 0xb9, 0xef, 0x58, 0xec, 0xba, 0x3a, 0x6d, 0xb0, 0x9b, 0x2a, 0x85, 0xab, 0xba, 0x9b, 0x4a,
-	0xd8, 0x55, 0x1b, 0x72, 0xd2, 0x69, 0x52, 0xca, 0x1f, 0xc7, 0x0a, 0x5c, 0xb5, 0x07, 0x31, 0xa5,
-	0x90, 0xc6, 0xdc, 0xe5, 0x30, 0xc5, 0x4a, 0x44, 0x1a, 0xc3, 0x7f, 0xa9, 0xab, 0x38, 0x9d, 0x03,
-	0x16, 0x72, 0x73, 0xc0, 0x2f, 0x61, 0xfb, 0x94, 0xcb, 0x57, 0xaa, 0xa6, 0x5f, 0xdd, 0x28, 0xcc,
-	0xce, 0xb8, 0x98, 0xb1, 0x88, 0xdf, 0xf4, 0x29, 0x3c, 0x38, 0xe5, 0x32, 0xe3, 0xe1, 0xdd, 0x5b,
-	0x7a, 0xb0, 0x89, 0xca, 0x5f, 0xcf, 0xa6, 0x61, 0x66, 0xfa, 0x89, 0x71, 0xb5, 0x86, 0xcd, 0x2f,
-	0x26, 0xe8, 0xe7, 0xb0, 0x95, 0x91, 0xd4, 0x27, 0xcf, 0x06, 0xca, 0x8c, 0x1d, 0xff, 0xae, 0x43,
-	0x37, 0x17, 0x25, 0x9b, 0x7b, 0xa1, 0xcc, 0x6e, 0x29, 0x7a, 0xa1, 0x20, 0x49, 0x77, 0x82, 0xe2,
-	0xbc, 0x61, 0x0a, 0x78, 0xa1, 0x54, 0xc0, 0x8b, 0xe5, 0x02, 0x5e, 0xaa, 0x2c, 0xe0, 0xe5, 0x6c,
-	0x01, 0xef, 0x41, 0x53, 0x7a, 0x53, 0x2e, 0x24, 0x9b, 0x86, 0x58, 0x87, 0x0b, 0x56, 0xca, 0xc0,
-	0x36, 0xa4, 0x72, 0xba, 0xa1, 0xdb, 0x50, 0x76, 0xb2, 0x6a, 0xa6, 0x47, 0xcc, 0xc3, 0x00, 0x7c,
-	0x0a, 0x06, 0x5a, 0x05, 0x18, 0xa8, 0x4a, 0x89, 0xd5, 0xca, 0x94, 0xa0, 0xcf, 0x60, 0xeb, 0x9c,
-	0x5f, 0x6b, 0x08, 0x37, 0x77, 0x73, 0x00, 0x10, 0x32, 0x21, 0xc2, 0x71, 0xa4, 0xda, 0x62, 0x1c,
-	0xc3, 0x0c, 0x87, 0x1e, 0x03, 0xc9, 0x6e, 0x4a, 0x21, 0xbf, 0xba, 0x7b, 0xd0, 0x0b, 0xb8, 0xff,
-	0x3b, 0x5f, 0x5d, 0x6b, 0xc1, 0xce, 0xfc, 0x7e, 0x93, 0xf7, 0xa0, 0x5e, 0xf2, 0xa0, 0x0f, 0xdb,
-	0x05, 0x8d, 0x77, 0x8c, 0xba, 0xc7, 0x40, 0xde, 0xfd, 0x0c, 0x07, 0xe8, 0x13, 0xb8, 0xf7, 0xee,
-	0x67, 0xa8, 0x7f, 0x02, 0xbb, 0x97, 0x9e, 0xeb, 0x57, 0xd5, 0x6d, 0x55, 0x99, 0xff, 0x11, 0x0e,
-	0x0b, 0x65, 0x7e, 0x91, 0x9c, 0xcd, 0xf8, 0xf6, 0x1b, 0x68, 0xc9, 0x74, 0x1d, 0xb7, 0xb7, 0x06,
-	0x1d, 0x8d, 0xb1, 0x65, 0x38, 0xb1, 0xb2, 0xd2, 0x77, 0xc6, 0xef, 0x39, 0x3c, 0xfa, 0x84, 0x03,
-	0xf3, 0x8b, 0x88, 0xf6, 0x61, 0xf3, 0x54, 0xe7, 0x60, 0x22, 0x97, 0x4b, 0xd4, 0x5a, 0x3e, 0x51,
-	0xe9, 0xd7, 0x70, 0xef, 0x8d, 0x90, 0xde, 0x94, 0x49, 0x7e, 0xca, 0xd2, 0x16, 0xfb, 0x08, 0x56,
-	0xb9, 0x66, 0x0f, 0x5d, 0x66, 0xc2, 0xdf, 0xe2, 0xa9, 0x28, 0xfd, 0x0a, 0xd6, 0xdf, 0x5c, 0xf1,
-	0xec, 0x5c, 0xf3, 0x19, 0x2c, 0x73, 0xe4, 0x60, 0x5f, 0x6e, 0x0d, 0x56, 0x75, 0x34, 0x50, 0xcc,
-	0xd2, 0x6b, 0xf4, 0x29, 0x2c, 0x21, 0x23, 0xfb, 0x83, 0x55, 0x4b, 0x7e, 0xb0, 0xaa, 0x7e, 0x62,
-	0x06, 0xff, 0x6a, 0x01, 0xbc, 0x0c, 0xbd, 0x4b, 0x1e, 0x5d, 0xa9, 0xe2, 0xfa, 0x1e, 0x5a, 0x99,
-	0xd1, 0x92, 0x98, 0x76, 0x58, 0xfc, 0xcf, 0xe9, 0x76, 0xf5, 0x42, 0xc5, 0x1c, 0x4a, 0x3b, 0x7f,
-	0xfa, 0xcf, 0x7f, 0xff, 0x5a, 0xbf, 0x47, 0xb6, 0xfa, 0x57, 0x4f, 0xfb, 0x33, 0xc1, 0x23, 0xf5,
-	0xb3, 0x28, 0x50, 0xdf, 0xb7, 0xd0, 0x30, 0x83, 0xf6, 0x7c, 0xdd, 0xe9, 0x42, 0x7e, 0x24, 0xaf,
-	0x52, 0x1c, 0x38, 0xdc, 0x53, 0xca, 0xbe, 0x87, 0x66, 0x82, 0x9e, 0x89, 0xe6, 0x22, 0xf2, 0x76,
-	0xdb, 0xe5, 0x05, 0xad, 0x7a, 0x1f, 0x55, 0xef, 0x52, 0x92, 0xa8, 0xc6, 0x39, 0xcf, 0x99, 0x4d,
-	0xc3, 0x17, 0xb5, 0x23, 0xe5, 0xb7, 0x19, 0x35, 0xef, 0xf6, 0xbb, 0x38, 0x94, 0x56, 0xf8, 0xcd,
-	0x8c, 0xb2, 0x08, 0x36, 0x0a, 0x73, 0x24, 0xd9, 0x4f, 0x43, 0x5b, 0x31, 0xa9, 0x76, 0x0f, 0xe6,
-	0x2d, 0x6b, 0x63, 0x87, 0x68, 0xac, 0x4b, 0xb7, 0x4b, 0xc6, 0x94, 0x98, 0x3a, 0xcc, 0x14, 0x36,
-	0x0a, 0x15, 0x40, 0xe6, 0x17, 0x57, 0x62, 0x6f, 0x4e, 0x73, 0xa6, 0x0f, 0xd1, 0x5e, 0x87, 0xde,
-	0x4f, 0xec, 0x65, 0xaa, 0x51, 0x99, 0xfb, 0x0e, 0x16, 0x4f, 0xd8, 0x64, 0xf2, 0xff, 0xd8, 0x68,
-	0xa3, 0x0d, 0x42, 0xd7, 0x12, 0x1b, 0x36, 0x9b, 0x4c, 0x94, 0xf2, 0x5b, 0x20, 0xe5, 0x31, 0x83,
-	0x1c, 0x66, 0xf4, 0x55, 0x4e, 0x20, 0x77, 0x5a, 0xa4, 0x68, 0x71, 0x8f, 0xee, 0x26, 0x16, 0x23,
-	0x76, 0x5d, 0x38, 0x18, 0x83, 0xf5, 0xfc, 0xec, 0x40, 0xf6, 0xd2, 0xbb, 0x29, 0x8f, 0x14, 0xdd,
-	0xb5, 0x63, 0x3b, 0x88, 0xb8, 0x49, 0xbf, 0x0a, 0x13, 0x6e, 0x6e, 0x9b, 0x32, 0xf1, 0x97, 0x1a,
-	0xce, 0x27, 0xe5, 0x76, 0x4f, 0x68, 0x6a, 0x6a, 0xde, 0x40, 0xd2, 0x7d, 0x54, 0x15, 0xf1, 0xdc,
-	0xb4, 0x40, 0xbf, 0x40, 0x27, 0x1e, 0xd3, 0x83, 0xac, 0x13, 0x65, 0x79, 0xe5, 0xcb, 0x10, 0x9a,
-	0xc9, 0x93, 0x49, 0x52, 0x04, 0xc5, 0xa7, 0x9d, 0xa4, 0xc4, 0x4a, 0xaf, 0x2b, 0x15, 0x25, 0x26,
-	0x8c, 0xcc, 0x8b, 0xda, 0xd1, 0x97, 0x35, 0x8d, 0x3d, 0x06, 0x63, 0xef, 0xae, 0xb3, 0x22, 0x1a,
-	0xd3, 0x3d, 0xb4, 0xb0, 0x43, 0xee, 0x67, 0x0f, 0x93, 0xe8, 0xe3, 0xd0, 0xca, 0xc0, 0xf1, 0xa7,
-	0xd2, 0xd1, 0x80, 0x5b, 0x05, 0x7a, 0x57, 0xa4, 0x7b, 0x06, 0xb8, 0x55, 0x98, 0x7e, 0xc0, 0x8a,
-	0x8e, 0xe1, 0x5b, 0xa7, 0xc5, 0x4f, 0xb9, 0xab, 0xed, 0x2c, 0xa0, 0xa7, 0xe6, 0x1e, 0xa3, 0xb9,
-	0x7d, 0xda, 0xce, 0x1e, 0x29, 0xab, 0xfc, 0x45, 0xed, 0x68, 0xf0, 0xf7, 0x06, 0xac, 0xbe, 0x74,
-	0xa6, 0x9e, 0x6f, 0x50, 0xdc, 0x06, 0x48, 0xa7, 0x14, 0x62, 0xae, 0xa4, 0x34, 0xed, 0x74, 0x3b,
-	0x15, 0x2b, 0x55, 0x30, 0xc2, 0x94, 0x72, 0x83, 0x23, 0x7d, 0x9f, 0x5f, 0xab, 0x83, 0x06, 0xb0,
-	0x96, 0x1b, 0x44, 0xc8, 0x03, 0xad, 0xad, 0x6a, 0xe0, 0xe9, 0xee, 0x55, 0x2f, 0x56, 0x1d, 0x33,
-	0x6f, 0x6d, 0x86, 0x1b, 0x94, 0x41, 0x17, 0x5a, 0x99, 0xc1, 0x24, 0xb9, 0xc0, 0xf2, 0x70, 0x93,
-	0x5c, 0x60, 0xc5, 0x1c, 0x43, 0x1f, 0xa1, 0xa9, 0x07, 0x74, 0xa7, 0x6c, 0x2a, 0x35, 0xb4, 0x51,
-	0x18, 0x69, 0x7e, 0x12, 0x78, 0x55, 0x4f, 0x41, 0x06, 0xfd, 0xe9, 0x7a, 0x6a, 0x50, 0x78, 0x2e,
-	0x22, 0xc8, 0xdf, 0x6a, 0xb0, 0x5f, 0x40, 0xa0, 0x6f, 0x3d, 0x39, 0x4e, 0x07, 0x12, 0xf2, 0x79,
-	0x35, 0x4e, 0x95, 0x66, 0xa6, 0x6e, 0xef, 0x6e, 0x41, 0xed, 0xcf, 0x31, 0xfa, 0xd3, 0xa3, 0x8f,
-	0x53, 0x7f, 0xe4, 0x3c, 0xfb, 0xca, 0xc9, 0x6b, 0x20, 0xe5, 0x67, 0xb2, 0xf9, 0xd5, 0x69, 0x40,
-	0x67, 0xfe, 0xd3, 0x1a, 0xfd, 0x05, 0x7a, 0xf0, 0x90, 0xec, 0x67, 0x22, 0x92, 0x48, 0x63, 0x4f,
-	0x47, 0x13, 0xdf, 0x01, 0xa4, 0x0f, 0x23, 0xf3, 0x0d, 0x76, 0xd2, 0xea, 0x2a, 0x3c, 0xa2, 0xe4,
-	0x1b, 0x6f, 0x6c, 0xc8, 0xd1, 0xea, 0x7e, 0x0f, 0x5b, 0xa5, 0x57, 0x10, 0xf2, 0x30, 0xa3, 0xaa,
-	0xea, 0x65, 0xa5, 0x7b, 0x38, 0x5f, 0x60, 0x7e, 0x26, 0x3b, 0x39, 0x49, 0x15, 0xd2, 0x2b, 0xd8,
-	0x28, 0x3c, 0x58, 0x27, 0x5d, 0xbf, 0xfa, 0x05, 0x3c, 0x49, 0xb2, 0x39, 0xef, 0xdc, 0xf4, 0x33,
-	0x34, 0x7b, 0x40, 0x3b, 0xa9, 0x59, 0x3b, 0x2f, 0xfa, 0xa2, 0x76, 0x34, 0x5a, 0xc6, 0x07, 0xb8,
-	0x67, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x90, 0xc0, 0xe8, 0x39, 0xfd, 0x17, 0x00, 0x00,
+	// 5416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x4b, 0x8c, 0x24, 0x47,
+	0x56, 0xca, 0xaa, 0xea, 0xfa, 0xbc, 0xea, 0xea, 0x4f, 0xce, 0x4c, 0x77, 0x75, 0x4d, 0xcf, 0x4c,
+	0x4f, 0xcc, 0xda, 0x1e, 0xdb, 0xeb, 0xe9, 0xf1, 0x98, 0xc5, 0xcb, 0xda, 0x3e, 0xb4, 0x67, 0xc6,
+	0x3d, 0x23, 0x8d, 0xbd, 0xad, 0xec, 0xb1, 0x8d, 0x58, 0xec, 0x22, 0x2b, 0x33, 0xba, 0x3a, 0x35,
+	0x59, 0x99, 0xe5, 0xcc, 0xac, 0xee, 0x2e, 0x2f, 0x6b, 0x60, 0x25, 0x0e, 0x20, 0x2e, 0xc0, 0x01,
+	0x21, 0x71, 0x81, 0x0b, 0x02, 0x09, 0xc4, 0x81, 0x0b, 0x12, 0x07, 0x24, 0x40, 0xe2, 0xce, 0x91,
+	0x13, 0x02, 0x71, 0x01, 0x24, 0x4e, 0x9c, 0xd1, 0x8b, 0x4f, 0x66, 0x44, 0x7e, 0xaa, 0x66, 0x6c,
+	0xb4, 0xda, 0xbd, 0xd5, 0x7b, 0xf1, 0x22, 0x5e, 0xc4, 0x8b, 0x17, 0x2f, 0x5e, 0xbc, 0xf7, 0x2a,
+	0xa1, 0x67, 0x4f, 0xbd, 0x61, 0x34, 0x75, 0xee, 0x4c, 0xa3, 0x30, 0x09, 0xcd, 0x95, 0x68, 0xea,
+	0x4c, 0x47, 0x83, 0xdd, 0x71, 0x18, 0x8e, 0x7d, 0xba, 0x6f, 0x4f, 0xbd, 0x7d, 0x3b, 0x08, 0xc2,
+	0xc4, 0x4e, 0xbc, 0x30, 0x88, 0x39, 0xd1, 0xe0, 0xad, 0xb1, 0x97, 0x9c, 0xce, 0x46, 0x77, 0x9c,
+	0x70, 0xb2, 0x1f, 0xd0, 0xd1, 0xcc, 0xb7, 0x63, 0x2f, 0xdc, 0x1f, 0x87, 0x6f, 0x08, 0x60, 0xdf,
+	0x09, 0x23, 0xba, 0x3f, 0x1d, 0xed, 0x8f, 0xfc, 0xd0, 0x79, 0xc6, 0x3b, 0x91, 0xc7, 0xb0, 0x71,
+	0x3c, 0x1b, 0xc5, 0x4e, 0xe4, 0x8d, 0xa8, 0x45, 0xbf, 0x98, 0xd1, 0x38, 0x31, 0x2f, 0xc3, 0x4a,
+	0x12, 0x4e, 0x3d, 0xa7, 0x6f, 0xec, 0xd5, 0x6f, 0x77, 0x2c, 0x0e, 0x98, 0x37, 0xa0, 0x7b, 0x12,
+	0x85, 0x93, 0xe1, 0x29, 0xf5, 0xc6, 0xa7, 0x49, 0xbf, 0xb6, 0x67, 0xdc, 0x6e, 0x58, 0x80, 0xa8,
+	0x47, 0x0c, 0x43, 0xee, 0xc1, 0xe0, 0x88, 0x06, 0xae, 0x17, 0x8c, 0x9f, 0x46, 0x76, 0x10, 0xdb,
+	0x0e, 0x9b, 0x9c, 0x32, 0xa8, 0xef, 0x4d, 0xbc, 0xa4, 0x6f, 0xec, 0x19, 0xb7, 0x7b, 0x16, 0x07,
+	0xc8, 0x17, 0x70, 0xb5, 0xb4, 0x4f, 0x3c, 0x0d, 0x83, 0x98, 0x9a, 0xef, 0xc2, 0x6a, 0xa2, 0xe0,
+	0xd9, 0x84, 0xba, 0xf7, 0xfa, 0x77, 0x98, 0x38, 0xee, 0xc8, 0x9e, 0x17, 0x92, 0xde, 0xd2, 0xa8,
+	0xf9, 0x3a, 0x12, 0xdb, 0x67, 0x73, 0xed, 0x59, 0x1c, 0x20, 0xdf, 0x85, 0xdd, 0x0f, 0xfc, 0x59,
+	0x7c, 0xaa, 0x30, 0x3c, 0x0a, 0x43, 0x3f, 0xe5, 0xd9, 0x87, 0x96, 0x1b, 0x85, 0xd3, 0x29, 0x75,
+	0xc5, 0x54, 0x25, 0x48, 0x6e, 0xc3, 0xda, 0x31, 0x4d, 0x1e, 0x51, 0xdb, 0x95, 0x8b, 0xda, 0x82,
+	0xa6, 0x10, 0x87, 0xc1, 0xc4, 0x21, 0x20, 0xf2, 0x1e, 0xac, 0xa7, 0x94, 0x62, 0x58, 0x13, 0x1a,
+	0xa7, 0x76, 0x7c, 0xca, 0x08, 0x3b, 0x16, 0xfb, 0xad, 0x74, 0xaf, 0x69, 0xdd, 0x5f, 0x81, 0xf5,
+	0x27, 0xe1, 0xf8, 0x09, 0x3d, 0xa3, 0xbe, 0x2a, 0x3e, 0x84, 0x45, 0x7f, 0x0e, 0x90, 0xdb, 0xb0,
+	0x91, 0x11, 0x0a, 0x46, 0x55, 0x94, 0x6b, 0xf7, 0xc3, 0xe0, 0xc4, 0x1b, 0xa7, 0x74, 0x5b, 0xd0,
+	0x74, 0x18, 0x46, 0x10, 0x0a, 0x88, 0xbc, 0x0d, 0x5b, 0xf7, 0x4f, 0xed, 0x60, 0x4c, 0x3f, 0xa2,
+	0xc9, 0x79, 0x18, 0x3d, 0x7b, 0xfc, 0x40, 0xce, 0xe1, 0x1a, 0x40, 0xc0, 0x71, 0x43, 0x4f, 0x0a,
+	0xa7, 0x23, 0x30, 0x8f, 0x5d, 0xf2, 0x26, 0x6c, 0x17, 0x3a, 0x66, 0xbc, 0x22, 0x1a, 0xcf, 0x7c,
+	0x2e, 0xa7, 0xb6, 0x25, 0x20, 0xf2, 0x2e, 0x98, 0x47, 0x94, 0x46, 0xc7, 0xa8, 0x99, 0xd9, 0xae,
+	0xbf, 0x0c, 0x2b, 0x53, 0x4a, 0x23, 0xb9, 0xdd, 0x1b, 0xe9, 0x76, 0x0b, 0x4a, 0x8b, 0x37, 0x93,
+	0xbf, 0xaf, 0x41, 0x27, 0x45, 0x9a, 0x6b, 0x50, 0x13, 0xb3, 0xea, 0x58, 0x35, 0xcf, 0x45, 0x39,
+	0xc4, 0xd8, 0xc0, 0x64, 0xbb, 0x62, 0x71, 0xc0, 0x7c, 0x15, 0x36, 0xbc, 0xe0, 0xcc, 0xf6, 0x3d,
+	0x77, 0x38, 0xa1, 0x71, 0x6c, 0x8f, 0x69, 0xdc, 0xaf, 0xb3, 0x95, 0xac, 0x0b, 0xfc, 0x87, 0x02,
+	0x6d, 0xbe, 0x04, 0x6b, 0xb3, 0x98, 0xfa, 0x34, 0x8e, 0x87, 0xec, 0xc4, 0xc4, 0xfd, 0x06, 0x23,
+	0xec, 0x09, 0xec, 0xfb, 0x0c, 0x69, 0x0e, 0xa0, 0x9d, 0x78, 0x13, 0x1a, 0xce, 0x92, 0xb8, 0xbf,
+	0xc2, 0x08, 0x52, 0xd8, 0xdc, 0x87, 0x4b, 0xec, 0x98, 0x39, 0xa1, 0x3f, 0x3c, 0xf3, 0x42, 0x9f,
+	0x9f, 0xd7, 0x7e, 0x93, 0x91, 0x99, 0xb2, 0xe9, 0x93, 0xb4, 0xc5, 0xbc, 0x09, 0xab, 0x23, 0x3b,
+	0x08, 0xa8, 0x3b, 0x9c, 0x05, 0x89, 0xe7, 0xf7, 0x5b, 0x7b, 0xc6, 0xed, 0xba, 0xd5, 0xe5, 0xb8,
+	0x8f, 0x11, 0x85, 0x2b, 0xf0, 0xed, 0x38, 0x19, 0x4e, 0xbc, 0x78, 0x44, 0x4f, 0xed, 0x33, 0x2f,
+	0x8c, 0xfa, 0x6d, 0xb6, 0xea, 0x75, 0xc4, 0x7f, 0x98, 0xa1, 0xcd, 0x5b, 0xd0, 0x63, 0xa4, 0x11,
+	0x9d, 0x86, 0x51, 0x42, 0xdd, 0x7e, 0x87, 0x0d, 0xb7, 0x8a, 0x48, 0x4b, 0xe0, 0xc8, 0x3b, 0xb0,
+	0xc9, 0x84, 0x98, 0xd8, 0xc9, 0xf3, 0x6d, 0x01, 0x23, 0x14, 0x5b, 0xf0, 0x3b, 0x75, 0xe8, 0xa4,
+	0xc8, 0xc2, 0x16, 0xf4, 0xa1, 0x65, 0xbb, 0x6e, 0x44, 0xe3, 0x98, 0x6d, 0x42, 0xc7, 0x92, 0x20,
+	0xca, 0xd6, 0xf1, 0x3d, 0x1a, 0x24, 0xc3, 0x33, 0x1a, 0xc5, 0x5e, 0x18, 0xb0, 0x4d, 0xe8, 0x58,
+	0x3d, 0x8e, 0xfd, 0x84, 0x23, 0x51, 0x7e, 0x4e, 0x18, 0x04, 0x94, 0x9d, 0xd2, 0xa1, 0x3b, 0x8b,
+	0x98, 0x98, 0xd8, 0x3e, 0xd4, 0x2d, 0x33, 0x6b, 0x7a, 0x20, 0x5a, 0xd0, 0x48, 0x9d, 0x52, 0xdb,
+	0x95, 0x46, 0x6a, 0x85, 0x1b, 0x29, 0x44, 0x71, 0x23, 0x65, 0x5e, 0x85, 0x0e, 0x27, 0xc0, 0xb3,
+	0xd8, 0x64, 0x3c, 0xdb, 0xac, 0x19, 0xcf, 0x63, 0x1f, 0x5a, 0xbe, 0x9d, 0xd0, 0xc0, 0x99, 0x0b,
+	0xc1, 0x4b, 0xd0, 0xdc, 0x81, 0xf6, 0x68, 0x9e, 0xd0, 0x78, 0xe8, 0x05, 0x4c, 0xd8, 0x75, 0xab,
+	0xc5, 0xe0, 0xc7, 0x01, 0x8e, 0xc8, 0x9b, 0xc2, 0x59, 0x22, 0x04, 0xcc, 0x69, 0xbf, 0x3f, 0x4b,
+	0x50, 0x8e, 0x5c, 0x09, 0x61, 0xcf, 0x28, 0x57, 0x65, 0xd6, 0x8c, 0x4a, 0x14, 0xce, 0x92, 0x51,
+	0x38, 0x0b, 0xdc, 0x7e, 0x97, 0x1d, 0x91, 0x14, 0xc6, 0x0d, 0xcf, 0x94, 0x48, 0x48, 0x6b, 0x95,
+	0xab, 0x6c, 0xaa, 0x41, 0x1c, 0x4d, 0x7e, 0x19, 0xd6, 0x0e, 0x5c, 0x17, 0x47, 0x97, 0x67, 0x56,
+	0xd9, 0x02, 0x43, 0xdf, 0x82, 0x2d, 0x68, 0xc6, 0x78, 0x81, 0x38, 0x6c, 0x6f, 0xda, 0x96, 0x80,
+	0xb0, 0x47, 0x12, 0xcd, 0x62, 0x54, 0x97, 0x3a, 0x6b, 0x90, 0x20, 0xb9, 0x05, 0x9b, 0x16, 0x9d,
+	0x84, 0x67, 0x54, 0x65, 0x90, 0xdb, 0x73, 0xf2, 0x6d, 0x30, 0xb9, 0x15, 0xe0, 0x44, 0x4b, 0x0c,
+	0xc0, 0x2f, 0xc0, 0xfa, 0xe3, 0xa3, 0x0f, 0x3c, 0x3f, 0xc9, 0x06, 0x34, 0xa1, 0xe1, 0x78, 0x6e,
+	0x24, 0x0d, 0x25, 0xfe, 0x46, 0x9c, 0x4b, 0x83, 0xb9, 0x98, 0x29, 0xfb, 0x4d, 0xde, 0x85, 0x8d,
+	0xac, 0x6b, 0x66, 0xfb, 0x6c, 0xdf, 0x0f, 0xcf, 0xe5, 0xcd, 0xc5, 0x00, 0xa5, 0x37, 0x22, 0x65,
+	0xef, 0x1e, 0x4e, 0x30, 0xd3, 0xf8, 0xd7, 0x75, 0x8d, 0xbf, 0x22, 0x76, 0x8a, 0x1b, 0xcd, 0x59,
+	0x44, 0xb9, 0x54, 0x85, 0xda, 0xff, 0xb6, 0x01, 0x6b, 0x7a, 0xcb, 0x0b, 0xe8, 0x7e, 0x26, 0xf8,
+	0x7a, 0x95, 0xe0, 0x1b, 0x9a, 0xe0, 0xcd, 0x5d, 0xe8, 0x08, 0x5d, 0xa7, 0x2e, 0xd3, 0xe9, 0xb6,
+	0x95, 0x21, 0xc8, 0x7d, 0xd8, 0x7e, 0x1a, 0xd9, 0x0e, 0x55, 0x2e, 0x34, 0xe5, 0xd6, 0x60, 0xa6,
+	0x4b, 0xde, 0x05, 0x0c, 0x48, 0xaf, 0xa2, 0x5a, 0x76, 0x15, 0x91, 0xff, 0x36, 0xa0, 0x5f, 0x1c,
+	0x25, 0xb3, 0x06, 0x71, 0x42, 0xa7, 0x79, 0x6b, 0xc0, 0xe8, 0x8f, 0x13, 0x3a, 0xb5, 0x78, 0x33,
+	0x9e, 0x92, 0xb1, 0x1d, 0x0f, 0x67, 0x31, 0x75, 0xe5, 0xa2, 0xc7, 0x76, 0xfc, 0x71, 0x4c, 0x5d,
+	0x3c, 0x98, 0xf4, 0x82, 0x3a, 0xb3, 0x84, 0x0e, 0x69, 0x14, 0x89, 0xd3, 0x0e, 0x02, 0xf5, 0x30,
+	0x8a, 0xcc, 0x37, 0xa1, 0x8b, 0x72, 0xa0, 0x43, 0xd7, 0x3b, 0x39, 0x41, 0x53, 0xab, 0x72, 0x42,
+	0xf3, 0x42, 0x1f, 0x78, 0x27, 0x27, 0x16, 0xc4, 0xf2, 0x67, 0x6c, 0x7e, 0x0b, 0x9a, 0xf4, 0x8c,
+	0x06, 0xcc, 0xee, 0x22, 0xf5, 0xaa, 0xa0, 0x7e, 0x88, 0x48, 0x4b, 0xb4, 0x65, 0x32, 0x68, 0x2a,
+	0x32, 0x20, 0x7f, 0x6c, 0x40, 0x27, 0x9d, 0x3f, 0x1e, 0x3f, 0x27, 0x0c, 0x92, 0xc8, 0x76, 0x12,
+	0x21, 0xaa, 0x14, 0xc6, 0x8d, 0x0d, 0xa7, 0x62, 0x39, 0xb5, 0x70, 0x8a, 0xd2, 0xf3, 0xbd, 0x80,
+	0x8a, 0x5b, 0x83, 0xfd, 0x36, 0x37, 0xa0, 0x3e, 0xb6, 0xf9, 0xfd, 0xd0, 0xb0, 0xf0, 0x27, 0x62,
+	0x9e, 0xd1, 0x39, 0xdb, 0xac, 0x8e, 0x85, 0x3f, 0x71, 0x1e, 0x67, 0xb6, 0x3f, 0xa3, 0x72, 0x1e,
+	0x0c, 0x40, 0xce, 0x27, 0xb3, 0x80, 0x89, 0x9b, 0xd9, 0x9c, 0x8e, 0x95, 0xc2, 0x64, 0x0e, 0x9b,
+	0x8a, 0x6f, 0x26, 0xf6, 0x62, 0x07, 0xda, 0x93, 0x78, 0x3c, 0x4c, 0xe6, 0x53, 0x2a, 0x4f, 0xf4,
+	0x24, 0x1e, 0x3f, 0x9d, 0x4f, 0x99, 0x8b, 0xe1, 0xda, 0x89, 0x2d, 0xf7, 0x15, 0x7f, 0x2b, 0x2e,
+	0x46, 0x5d, 0x75, 0x31, 0xf0, 0x2e, 0x67, 0x82, 0xe0, 0x86, 0xb0, 0xc1, 0x7a, 0x74, 0x18, 0x06,
+	0x2d, 0x21, 0xf9, 0x0f, 0x03, 0x36, 0x3e, 0xa2, 0xe7, 0xec, 0x8a, 0x5b, 0xe8, 0xc2, 0xdc, 0x80,
+	0xee, 0xd4, 0x8e, 0xd0, 0x90, 0x2b, 0x2a, 0x05, 0x1c, 0xf5, 0x48, 0xf7, 0x71, 0xf4, 0x09, 0xec,
+	0x42, 0x07, 0xaf, 0xc9, 0x38, 0xb1, 0x27, 0x53, 0x61, 0xd0, 0x33, 0x04, 0xdf, 0x10, 0x2f, 0x18,
+	0xd9, 0x31, 0x15, 0x32, 0x4c, 0x61, 0x14, 0xe4, 0xc4, 0x0b, 0x68, 0x24, 0x05, 0xc9, 0x00, 0x94,
+	0x4b, 0x72, 0x31, 0x74, 0xc2, 0x59, 0x90, 0x30, 0x41, 0xf6, 0xac, 0x56, 0x72, 0x71, 0x1f, 0x41,
+	0x1c, 0x2c, 0xa2, 0x67, 0x94, 0xdd, 0x80, 0x6d, 0x6e, 0x5c, 0x25, 0x4c, 0xfe, 0xcd, 0x80, 0xcd,
+	0x82, 0x1f, 0x59, 0xba, 0x52, 0x13, 0x1a, 0xe8, 0xec, 0x4a, 0xe9, 0xe2, 0x6f, 0xd4, 0x8d, 0x24,
+	0x14, 0xca, 0x5c, 0x4b, 0xc2, 0x6c, 0x8f, 0x1b, 0xea, 0x1e, 0x5f, 0x86, 0x95, 0x20, 0x0c, 0x1c,
+	0x2a, 0xae, 0x23, 0x0e, 0xe8, 0x02, 0x68, 0xe6, 0x05, 0x60, 0x42, 0x83, 0x6d, 0x31, 0xd7, 0x09,
+	0xf6, 0x1b, 0x6f, 0x1a, 0x3c, 0x5e, 0xd3, 0xc8, 0x73, 0xa8, 0xb8, 0xf2, 0xf1, 0xbc, 0x1d, 0x21,
+	0x2c, 0x1b, 0xb9, 0x8f, 0xdd, 0x49, 0x1b, 0x9f, 0x20, 0x4c, 0x4c, 0xd8, 0xf8, 0x28, 0x0c, 0x8e,
+	0xec, 0xc8, 0x9e, 0x48, 0x87, 0x9c, 0xfc, 0xab, 0x01, 0x6b, 0x8f, 0xa8, 0xed, 0x27, 0xa7, 0xe9,
+	0xb2, 0x51, 0xd5, 0x9f, 0x09, 0x0b, 0x5d, 0x0b, 0x9f, 0xa1, 0x45, 0x8a, 0xa8, 0x1d, 0xa3, 0xcb,
+	0xc2, 0x6d, 0xa7, 0x04, 0xd1, 0x4f, 0x71, 0x47, 0x43, 0xfb, 0xcc, 0xf6, 0x7c, 0x7b, 0xe4, 0x53,
+	0x61, 0xc9, 0xba, 0xee, 0xe8, 0x40, 0xa2, 0xb0, 0x73, 0x3c, 0x0f, 0x1c, 0x2f, 0x18, 0x4b, 0x73,
+	0x26, 0x40, 0xd4, 0x3d, 0x34, 0xa3, 0x62, 0xb3, 0xb8, 0xcf, 0xd4, 0x41, 0x0c, 0xdf, 0xae, 0x2d,
+	0x68, 0x4e, 0xbc, 0x00, 0xfb, 0x35, 0xb9, 0x7d, 0xe4, 0x90, 0xa2, 0x49, 0x2d, 0x4d, 0x93, 0x70,
+	0xe7, 0x6d, 0xcf, 0x1f, 0xda, 0x63, 0x2a, 0xef, 0x66, 0x84, 0x0f, 0xc6, 0x94, 0xfc, 0x59, 0x1d,
+	0x17, 0xee, 0xd2, 0xc7, 0xc1, 0x49, 0xa8, 0xae, 0x52, 0xb3, 0xd4, 0x3b, 0xd0, 0x76, 0x4e, 0x6d,
+	0x2f, 0x40, 0xa7, 0x96, 0xbf, 0x14, 0x5a, 0x0c, 0x7e, 0xcc, 0x8c, 0xb8, 0xea, 0x9f, 0xf4, 0x2c,
+	0x09, 0xe6, 0xd6, 0xd0, 0xc8, 0xaf, 0x81, 0xc0, 0x2a, 0xae, 0xf6, 0x34, 0x0a, 0x03, 0xef, 0xcb,
+	0xd4, 0x68, 0x6b, 0x38, 0x3c, 0x3a, 0xa3, 0x99, 0xf3, 0x8c, 0x26, 0xc3, 0xd8, 0xfb, 0x92, 0x9b,
+	0x85, 0x15, 0x0b, 0x38, 0xea, 0xd8, 0xfb, 0x92, 0x9a, 0xb7, 0x61, 0x23, 0xa2, 0xbe, 0x3d, 0x1f,
+	0x3a, 0xb6, 0x73, 0x4a, 0x39, 0x55, 0x8b, 0x51, 0xad, 0x31, 0xfc, 0x7d, 0x44, 0x33, 0xca, 0xd7,
+	0x60, 0x33, 0x4e, 0x22, 0x6a, 0x4f, 0x86, 0x71, 0x12, 0x46, 0x82, 0xb4, 0xcd, 0x48, 0xd7, 0x79,
+	0xc3, 0x31, 0xe2, 0x19, 0xed, 0xdb, 0xd0, 0xd7, 0x68, 0xe9, 0x45, 0x42, 0x03, 0x97, 0x77, 0xe9,
+	0xb0, 0x2e, 0x57, 0x94, 0x2e, 0x0f, 0x59, 0x2b, 0xeb, 0x58, 0xe6, 0x87, 0x00, 0x77, 0x3c, 0x73,
+	0x7e, 0x88, 0x79, 0x0f, 0xba, 0x51, 0x88, 0xb6, 0x3e, 0x61, 0xda, 0xd1, 0x65, 0xe6, 0x79, 0x53,
+	0x98, 0x67, 0x0b, 0x5b, 0x9e, 0x62, 0x83, 0x05, 0x51, 0xfa, 0x9b, 0x7c, 0x05, 0x03, 0x34, 0xf3,
+	0x5e, 0x9c, 0x78, 0x4e, 0x5c, 0xd8, 0xb4, 0x2d, 0x68, 0x32, 0xdc, 0x03, 0xf9, 0x5a, 0xe1, 0x10,
+	0xe2, 0x1f, 0x69, 0x4f, 0x28, 0x0e, 0xe1, 0xf9, 0x41, 0xf3, 0x23, 0xce, 0x26, 0xfb, 0x8d, 0x27,
+	0xee, 0x48, 0xee, 0x90, 0xdc, 0xb2, 0x14, 0x41, 0x7e, 0x1e, 0x20, 0x9b, 0xd9, 0xe2, 0xeb, 0xbc,
+	0xae, 0x5c, 0xe7, 0xe4, 0x37, 0x6b, 0x70, 0xe9, 0x90, 0x26, 0x1f, 0xd1, 0x11, 0xbb, 0xa5, 0x54,
+	0x43, 0x9d, 0xaa, 0x95, 0xa1, 0xab, 0x15, 0x1e, 0x6e, 0xdb, 0xf3, 0xa5, 0x29, 0xc1, 0xdf, 0x9a,
+	0xc5, 0xab, 0xe7, 0x2c, 0xde, 0x12, 0x65, 0xbb, 0x0a, 0x1d, 0x2f, 0x1e, 0x8a, 0x33, 0xc3, 0x35,
+	0xad, 0xed, 0xc5, 0x1f, 0x32, 0xb8, 0x74, 0xd7, 0x9a, 0xe5, 0xbb, 0x96, 0x57, 0xda, 0x56, 0x89,
+	0xd2, 0x2a, 0x27, 0x82, 0x5b, 0x20, 0x09, 0x92, 0xbb, 0xb0, 0x71, 0xe0, 0xb0, 0x19, 0x66, 0x4e,
+	0xd5, 0x2e, 0x74, 0x84, 0x98, 0x68, 0x2c, 0x7c, 0xb2, 0x0c, 0x41, 0x7e, 0x05, 0xb6, 0x0e, 0x69,
+	0x22, 0x3a, 0x09, 0xe1, 0x2d, 0xf3, 0x5a, 0xd3, 0xdb, 0xbc, 0xa6, 0x7a, 0x34, 0x15, 0x97, 0x0c,
+	0xb1, 0x61, 0xbb, 0xc0, 0x21, 0x7b, 0xe6, 0x8f, 0x6c, 0xdf, 0x46, 0xb3, 0x2c, 0x58, 0x08, 0x30,
+	0x33, 0xd7, 0x82, 0x05, 0x03, 0x2a, 0x59, 0xfc, 0x1c, 0x98, 0x87, 0x34, 0x79, 0x30, 0x0f, 0xec,
+	0x38, 0x99, 0xa7, 0xa3, 0x5f, 0x07, 0x70, 0xa9, 0x4f, 0xc7, 0x76, 0x42, 0xd3, 0x95, 0x2b, 0x18,
+	0xf2, 0x5d, 0xe8, 0x63, 0x2f, 0x81, 0xf8, 0x24, 0x4c, 0x98, 0x2b, 0xca, 0x17, 0xbf, 0x0b, 0x9d,
+	0x94, 0x52, 0xcc, 0x2d, 0x43, 0x90, 0xb7, 0x60, 0xa7, 0xa4, 0x67, 0x76, 0x4a, 0xce, 0x18, 0x46,
+	0xb0, 0x14, 0x10, 0xf9, 0xcf, 0x3a, 0x98, 0x25, 0xee, 0xa1, 0xbc, 0xd2, 0x8c, 0xc2, 0x95, 0x56,
+	0x2b, 0x5e, 0x69, 0xf5, 0xd2, 0x2b, 0xad, 0xa1, 0x5e, 0x69, 0xda, 0x05, 0xb5, 0xb2, 0xe8, 0x82,
+	0x6a, 0xea, 0x17, 0x94, 0x79, 0x4f, 0x71, 0xc0, 0x5a, 0xec, 0xa9, 0xb4, 0x95, 0x39, 0xe0, 0x0c,
+	0x2d, 0xe6, 0xac, 0x38, 0x66, 0xdf, 0x81, 0x8e, 0x63, 0x07, 0xae, 0xe7, 0xda, 0x09, 0x37, 0x76,
+	0xdd, 0x7b, 0xdb, 0xb2, 0x93, 0xc4, 0xcb, 0x5e, 0x19, 0x25, 0xb2, 0x92, 0xd2, 0xec, 0x77, 0x34,
+	0x56, 0x52, 0xa8, 0x29, 0x2b, 0x49, 0x97, 0x69, 0x1d, 0xa8, 0x5a, 0xd7, 0x87, 0xd6, 0x34, 0x0a,
+	0x4f, 0x3c, 0x66, 0xe1, 0xd8, 0x0d, 0x27, 0x40, 0xf3, 0x1e, 0x34, 0xc3, 0xc8, 0x76, 0x7c, 0xca,
+	0x1e, 0x6a, 0xdd, 0x7b, 0x03, 0xc1, 0xe1, 0xfb, 0x0c, 0x79, 0x10, 0xc4, 0xe7, 0xe9, 0x7b, 0xc7,
+	0x12, 0x94, 0xe6, 0x5d, 0x58, 0x71, 0x6c, 0xdf, 0x8f, 0xfb, 0xbd, 0xbd, 0xba, 0xd2, 0x45, 0xae,
+	0xff, 0xbe, 0xed, 0xcb, 0x60, 0x90, 0xc5, 0x09, 0x15, 0x95, 0x5c, 0xd3, 0x54, 0xf2, 0x1c, 0x2e,
+	0x95, 0xf4, 0x5a, 0xe8, 0xe4, 0xaa, 0x6e, 0x68, 0x4d, 0x77, 0x43, 0x51, 0x4b, 0xec, 0x68, 0x1c,
+	0x4b, 0x53, 0x8a, 0xbf, 0xcb, 0x1d, 0x1d, 0xf2, 0xe7, 0x06, 0xac, 0xe7, 0xf6, 0x0b, 0x27, 0x19,
+	0x87, 0xb3, 0x28, 0x3d, 0x66, 0x02, 0xc2, 0xdb, 0x8f, 0xff, 0xe2, 0xae, 0x2c, 0x67, 0x0a, 0x1c,
+	0xc5, 0xbc, 0x59, 0x75, 0x4a, 0xf5, 0x8a, 0x29, 0x35, 0xf4, 0x29, 0xd9, 0xee, 0xc4, 0x0b, 0x84,
+	0xe2, 0x71, 0x00, 0xf7, 0x68, 0x36, 0x1d, 0x47, 0xb6, 0x4b, 0x85, 0x37, 0x21, 0x41, 0x32, 0x82,
+	0x8d, 0xbc, 0x9a, 0xe0, 0x64, 0xf9, 0x09, 0x91, 0x93, 0xe5, 0x10, 0x1e, 0x67, 0x27, 0x9c, 0x4c,
+	0xbc, 0x38, 0x96, 0x02, 0xea, 0x59, 0x0a, 0x86, 0x2d, 0xd2, 0x1b, 0xa3, 0x4f, 0x5a, 0x17, 0x8b,
+	0x64, 0x10, 0xf9, 0x0a, 0xd6, 0x73, 0x4a, 0x55, 0xc9, 0x42, 0x3b, 0xf5, 0xb5, 0xdc, 0xa9, 0x37,
+	0xbf, 0xa3, 0xd9, 0x93, 0xba, 0xf6, 0x44, 0x95, 0x1c, 0x3e, 0x65, 0xbb, 0xaf, 0x99, 0x99, 0x43,
+	0xb8, 0x54, 0xa2, 0x72, 0xdc, 0xaf, 0x63, 0x3f, 0xa5, 0xed, 0x8b, 0x94, 0xd9, 0x31, 0x52, 0x31,
+	0x05, 0x01, 0x91, 0x0f, 0x60, 0x4d, 0x67, 0xb3, 0xd8, 0x4a, 0xe1, 0x38, 0xe7, 0xd9, 0xb5, 0xdc,
+	0xb3, 0x04, 0x44, 0x3e, 0x83, 0x9d, 0x63, 0x1a, 0xb8, 0x96, 0x7d, 0x5e, 0x6e, 0x8e, 0xd8, 0xfb,
+	0x05, 0x47, 0x5b, 0x15, 0xef, 0x97, 0x0d, 0xa8, 0x47, 0xf6, 0xb9, 0x98, 0x0d, 0xfe, 0x44, 0xbd,
+	0xa0, 0x81, 0x13, 0xa2, 0xc7, 0x2e, 0xf5, 0x42, 0xc2, 0x24, 0x81, 0x6d, 0x1c, 0xbe, 0xec, 0x0d,
+	0xbb, 0x05, 0xcd, 0xe4, 0x42, 0x71, 0xea, 0x05, 0x84, 0xf7, 0xa3, 0x3c, 0x05, 0x43, 0xfd, 0xc1,
+	0xbe, 0x2e, 0xf1, 0x07, 0xd9, 0xc3, 0x5d, 0x04, 0x31, 0xea, 0x5a, 0x10, 0xe3, 0x75, 0xb8, 0x72,
+	0x48, 0x13, 0xf6, 0x56, 0x7a, 0x7f, 0x8e, 0x9e, 0x86, 0xb2, 0xa0, 0xfc, 0x33, 0x82, 0x3c, 0x86,
+	0xab, 0x87, 0x34, 0x51, 0x66, 0xb8, 0xb4, 0x0b, 0xf2, 0x3d, 0xf1, 0xa8, 0xef, 0x4a, 0xd7, 0x43,
+	0x40, 0x24, 0x80, 0x75, 0xc9, 0x77, 0x49, 0xf7, 0xb2, 0x28, 0xb3, 0x32, 0x6c, 0x5d, 0x1d, 0xd6,
+	0xdc, 0x86, 0x56, 0x72, 0x31, 0x9c, 0x84, 0xae, 0x3c, 0xdd, 0xcd, 0xe4, 0xe2, 0xc3, 0xd0, 0xa5,
+	0xe4, 0x0f, 0xea, 0xd0, 0xfb, 0xd9, 0x7a, 0x11, 0xa6, 0x0e, 0x58, 0x4b, 0x77, 0xc0, 0xae, 0x01,
+	0x8f, 0x23, 0x0c, 0xa3, 0x30, 0x4c, 0x84, 0x23, 0xd3, 0x61, 0x18, 0x2b, 0x0c, 0xf9, 0x8b, 0xe2,
+	0x22, 0xe6, 0x8d, 0xfc, 0x29, 0xd5, 0x4a, 0x2e, 0x62, 0xd6, 0x84, 0x71, 0x0c, 0x16, 0x57, 0xe0,
+	0xad, 0xfc, 0x3e, 0x00, 0x8e, 0xca, 0xfa, 0x0a, 0x4f, 0xad, 0xab, 0xbf, 0x43, 0xdf, 0xc9, 0x65,
+	0x33, 0x56, 0xf7, 0xea, 0xca, 0x9d, 0xc5, 0x24, 0xab, 0x6a, 0xae, 0x46, 0x8c, 0xd7, 0x67, 0x72,
+	0xc1, 0x44, 0x4a, 0xf9, 0x15, 0xd1, 0xb1, 0xda, 0xc9, 0xc5, 0x23, 0x06, 0x93, 0xff, 0x35, 0x60,
+	0x23, 0xdf, 0xff, 0xa7, 0xf4, 0x11, 0x2b, 0x0f, 0x79, 0x5b, 0x09, 0x52, 0x68, 0x7e, 0x43, 0x67,
+	0x91, 0xdf, 0x00, 0xb9, 0x87, 0xed, 0x6d, 0xb1, 0xee, 0x07, 0xb3, 0xc9, 0x54, 0x09, 0x7a, 0x71,
+	0xf1, 0x1b, 0x3c, 0xf0, 0xcf, 0x00, 0xf2, 0x0a, 0x6c, 0x2a, 0x94, 0x99, 0xfe, 0xa6, 0x16, 0x47,
+	0x4c, 0x86, 0xfc, 0x63, 0x1d, 0x06, 0x9a, 0x01, 0x71, 0xa8, 0x37, 0x4d, 0x16, 0xaa, 0x7c, 0x1f,
+	0xa4, 0x66, 0xe5, 0x1f, 0x90, 0x52, 0xde, 0xf5, 0x82, 0xbc, 0x1b, 0x45, 0x79, 0xaf, 0x94, 0xca,
+	0xbb, 0x59, 0x29, 0xef, 0x56, 0x95, 0xbc, 0xdb, 0x25, 0xf2, 0xee, 0x54, 0xc9, 0x1b, 0x16, 0xc9,
+	0xbb, 0x9b, 0xf3, 0xd3, 0xca, 0xac, 0xe5, 0x6a, 0xb9, 0xb5, 0x7c, 0x19, 0x1a, 0x7e, 0x38, 0x96,
+	0xee, 0x8c, 0x99, 0x73, 0x67, 0x9e, 0x84, 0x63, 0x8b, 0xb5, 0xe7, 0x03, 0x7f, 0x6b, 0xcf, 0x11,
+	0xf8, 0xbb, 0x05, 0x3d, 0x25, 0x98, 0x18, 0x46, 0xfd, 0x75, 0x36, 0x85, 0xd5, 0x2c, 0x9c, 0x18,
+	0x46, 0x24, 0x84, 0x4e, 0xda, 0x7b, 0xa1, 0xef, 0x23, 0x42, 0x75, 0xb5, 0x2c, 0x54, 0xb7, 0x03,
+	0xed, 0xd0, 0x17, 0x39, 0x02, 0xbe, 0x73, 0xad, 0xd0, 0xe7, 0x29, 0x82, 0x1d, 0x68, 0x07, 0xf4,
+	0x5c, 0x8d, 0x9a, 0xb5, 0x02, 0x7a, 0x8e, 0x4d, 0xe4, 0x2d, 0xd8, 0xfc, 0x88, 0x9e, 0x8b, 0xc7,
+	0x86, 0x54, 0xc6, 0xeb, 0x00, 0x53, 0x3b, 0x8e, 0xa7, 0xa7, 0x11, 0x1a, 0x2e, 0x43, 0x1a, 0x43,
+	0x89, 0x21, 0x77, 0xc0, 0x54, 0x3b, 0x65, 0x8f, 0x93, 0xf2, 0xf7, 0x0f, 0x39, 0x82, 0xcb, 0x1f,
+	0x07, 0xa8, 0xc7, 0x39, 0x3e, 0x95, 0x3d, 0x72, 0x33, 0xa8, 0x15, 0x66, 0xb0, 0x0f, 0x57, 0x72,
+	0x23, 0x2e, 0x89, 0xd9, 0xdf, 0x01, 0xf3, 0xc9, 0x0b, 0x4c, 0x80, 0xbc, 0x01, 0x97, 0x9e, 0xbc,
+	0xc0, 0xf0, 0x6f, 0xc0, 0xf6, 0xb1, 0x37, 0x0e, 0xca, 0xee, 0xf0, 0x12, 0x07, 0x81, 0xfc, 0x1a,
+	0xec, 0xe5, 0xae, 0xfc, 0xa3, 0x74, 0x6d, 0x72, 0x6e, 0xef, 0x40, 0x57, 0xb1, 0xa5, 0xac, 0x7b,
+	0xf7, 0xde, 0x4e, 0x16, 0xc5, 0xce, 0x39, 0x22, 0x96, 0x4a, 0xbd, 0x54, 0x7e, 0x6f, 0xc3, 0xcd,
+	0x05, 0x13, 0xa8, 0xb6, 0x1a, 0x64, 0x1f, 0x36, 0x0e, 0xc5, 0xa1, 0x4b, 0xe9, 0xb4, 0x93, 0x69,
+	0xe8, 0x27, 0x93, 0xfc, 0x9d, 0x01, 0x97, 0x1e, 0xc6, 0x89, 0x37, 0xb1, 0x13, 0x7a, 0x68, 0x67,
+	0xaf, 0xbe, 0x9b, 0xb0, 0x4a, 0x05, 0x7a, 0x88, 0x61, 0x68, 0xde, 0xaf, 0x4b, 0x33, 0x52, 0xf3,
+	0x6e, 0xf6, 0x54, 0xa9, 0xb1, 0x03, 0x26, 0xdf, 0x3c, 0x6c, 0x06, 0xac, 0xe1, 0x61, 0x90, 0x44,
+	0xf3, 0xec, 0x09, 0xa3, 0x3b, 0x3b, 0x1d, 0xb9, 0x3d, 0xf9, 0x40, 0x7e, 0xa3, 0x10, 0xc8, 0xd7,
+	0xec, 0xc7, 0x4a, 0xce, 0x5e, 0xff, 0xb5, 0x01, 0xab, 0xfc, 0x4d, 0x52, 0xaa, 0x05, 0x19, 0x9b,
+	0xfc, 0x9a, 0x6a, 0xc5, 0x35, 0x2d, 0x4d, 0x29, 0x28, 0x8b, 0x6e, 0x3c, 0xf7, 0xa2, 0xb5, 0xcc,
+	0xa1, 0x80, 0xc8, 0x6f, 0x18, 0xb0, 0x9e, 0xeb, 0xf4, 0xb5, 0x9f, 0x53, 0x3c, 0x9f, 0x50, 0x4f,
+	0xf3, 0x09, 0xc5, 0xdc, 0x41, 0x7a, 0x81, 0x89, 0xab, 0xd6, 0x11, 0xf1, 0xa9, 0xb5, 0x87, 0xdc,
+	0xcd, 0x90, 0xb2, 0xcb, 0xf2, 0x1f, 0x46, 0x75, 0xfe, 0x83, 0xbc, 0x09, 0x2b, 0x0c, 0xa1, 0x96,
+	0x75, 0x18, 0x59, 0x59, 0x47, 0x49, 0xd2, 0x80, 0xfc, 0x93, 0x01, 0x5d, 0xc5, 0x50, 0x2f, 0x4e,
+	0x22, 0xb2, 0x61, 0x52, 0xd7, 0x94, 0x43, 0xe9, 0xa8, 0xf5, 0x6c, 0x54, 0xe1, 0x57, 0x2a, 0x96,
+	0xb3, 0xc9, 0xfd, 0x97, 0x5c, 0x2e, 0x62, 0x25, 0x97, 0x8b, 0x60, 0x39, 0x71, 0xde, 0xcc, 0xb7,
+	0x86, 0x5f, 0x88, 0x5d, 0x4e, 0xc0, 0x50, 0xfc, 0x41, 0x83, 0x99, 0x49, 0x19, 0xb4, 0x92, 0x20,
+	0xf9, 0x4b, 0x03, 0xd6, 0x0e, 0x29, 0xae, 0x22, 0x8d, 0xaf, 0xe4, 0x0a, 0x59, 0x8c, 0x7c, 0x21,
+	0x0b, 0x73, 0xb5, 0x42, 0xbd, 0xce, 0xa5, 0x9d, 0x84, 0x19, 0x2b, 0x29, 0x8b, 0x7a, 0x95, 0x2c,
+	0x1a, 0x9a, 0x2c, 0xd2, 0xca, 0x97, 0x15, 0xa5, 0xf2, 0x05, 0xa9, 0x9d, 0x59, 0x14, 0x87, 0xd2,
+	0x69, 0x15, 0x10, 0x49, 0x60, 0x3d, 0x9d, 0x6f, 0x9a, 0x7e, 0xe3, 0x37, 0xa9, 0xb1, 0xe4, 0x26,
+	0xbd, 0x01, 0xdd, 0x80, 0x5e, 0x24, 0x43, 0x31, 0xae, 0x30, 0x55, 0x88, 0xba, 0xcf, 0x30, 0x5c,
+	0x4c, 0x61, 0x34, 0xce, 0x52, 0xbb, 0x02, 0x24, 0x7f, 0x65, 0xc0, 0xc6, 0x21, 0x4d, 0xa4, 0x82,
+	0xfd, 0x2c, 0x08, 0xea, 0x77, 0x0d, 0x80, 0xfb, 0xe8, 0x66, 0xbd, 0xa0, 0x76, 0xab, 0x7a, 0x58,
+	0x5f, 0xa0, 0x87, 0x8d, 0x65, 0x7a, 0xb8, 0x52, 0xd0, 0x43, 0xcc, 0xd8, 0x29, 0x52, 0x14, 0xdb,
+	0xf7, 0x6a, 0xee, 0x98, 0xca, 0x38, 0x78, 0x36, 0xf9, 0x34, 0x57, 0xf9, 0x0d, 0x76, 0xf0, 0x6f,
+	0x0d, 0x16, 0x52, 0x7c, 0x1a, 0x3e, 0xa3, 0xfc, 0xee, 0x3c, 0xa1, 0xd1, 0xff, 0xd3, 0x4e, 0xaa,
+	0x96, 0xae, 0x9e, 0xb3, 0x74, 0xca, 0x2e, 0x37, 0x0a, 0x91, 0xda, 0x17, 0xd8, 0xcd, 0x7f, 0x31,
+	0xa0, 0xa7, 0xcd, 0x7d, 0xa1, 0x7d, 0xfd, 0xfa, 0xcf, 0x18, 0x65, 0xf3, 0x57, 0x16, 0x6c, 0x7e,
+	0x73, 0xd9, 0xe6, 0xb7, 0x8a, 0x46, 0x08, 0x5f, 0x7e, 0xb8, 0x02, 0x7c, 0x6f, 0x8a, 0xd8, 0x38,
+	0x83, 0x1f, 0xbb, 0x58, 0x2f, 0xb0, 0x53, 0xb2, 0x39, 0x42, 0x41, 0xee, 0x41, 0x27, 0x91, 0x48,
+	0xa1, 0x23, 0x97, 0xa5, 0x73, 0xa2, 0xf6, 0xb0, 0x32, 0xb2, 0x6f, 0xa2, 0x29, 0xbf, 0xc8, 0x52,
+	0xbb, 0x85, 0xa2, 0x0b, 0x25, 0xa3, 0xcc, 0x7e, 0x57, 0xda, 0xf6, 0xca, 0x83, 0x8d, 0x05, 0x22,
+	0xca, 0xc8, 0xe5, 0xe9, 0x36, 0x72, 0x03, 0x7a, 0x3a, 0xef, 0x3c, 0xc1, 0xaf, 0xd7, 0xe0, 0x0a,
+	0xa7, 0xe0, 0x85, 0x24, 0x99, 0xa0, 0xf6, 0xa1, 0x29, 0x2a, 0xb1, 0x0c, 0xed, 0xe9, 0x9c, 0xcf,
+	0x54, 0x5b, 0x82, 0xac, 0x50, 0x3f, 0x58, 0x7b, 0xa1, 0xfa, 0xc1, 0xbb, 0xe9, 0xc1, 0xad, 0x6b,
+	0xfd, 0x0a, 0x49, 0xf9, 0xf4, 0xfc, 0x4a, 0x4b, 0xdd, 0x58, 0x62, 0xa9, 0xaf, 0x03, 0x84, 0x67,
+	0x34, 0x3a, 0xf1, 0xc3, 0xf3, 0x34, 0x39, 0xa8, 0x60, 0xb0, 0x94, 0xee, 0xe3, 0xc0, 0x0b, 0xe2,
+	0xc4, 0xf6, 0xfd, 0x9c, 0x38, 0xab, 0xdc, 0xe6, 0x3f, 0x35, 0xe0, 0x86, 0x1e, 0x58, 0x8a, 0xdf,
+	0x9f, 0x8b, 0xb7, 0xd8, 0xf2, 0x47, 0xc2, 0xb2, 0xe2, 0x4e, 0xdd, 0x40, 0xd4, 0x73, 0x06, 0x22,
+	0x3d, 0xea, 0x8d, 0xf2, 0xa3, 0xbe, 0xa2, 0x1d, 0xf5, 0xff, 0x32, 0xc0, 0x14, 0x13, 0xfb, 0xe9,
+	0x0f, 0x57, 0xe8, 0x66, 0xa1, 0xbd, 0xcc, 0x2c, 0x74, 0x8a, 0x77, 0xc2, 0x1f, 0x19, 0xb0, 0x57,
+	0xbd, 0x31, 0x62, 0x57, 0xdf, 0x2b, 0x2d, 0x74, 0x95, 0x4f, 0x94, 0xa2, 0xb4, 0x72, 0x9a, 0xfa,
+	0x0d, 0xac, 0xc1, 0xaf, 0xb2, 0x24, 0x1c, 0xb3, 0x33, 0xef, 0xf3, 0x04, 0xd8, 0xf3, 0xe4, 0x0b,
+	0xaa, 0xab, 0x9b, 0xd2, 0x54, 0x49, 0xbd, 0x3c, 0x41, 0xd7, 0xd0, 0x1c, 0xeb, 0x1f, 0xc2, 0x76,
+	0x81, 0x7b, 0xf6, 0x64, 0x0a, 0xec, 0x49, 0x6a, 0x92, 0xf0, 0x37, 0x0e, 0x13, 0xcf, 0x27, 0xa3,
+	0x50, 0xa6, 0x4e, 0x05, 0x84, 0x53, 0x75, 0xa9, 0xe3, 0x4d, 0x6c, 0x5f, 0x56, 0x73, 0xa6, 0xb0,
+	0x9a, 0xe8, 0x6b, 0x68, 0x89, 0x3e, 0xf2, 0xa3, 0x8c, 0xf9, 0xa3, 0xd0, 0x47, 0x53, 0x10, 0xff,
+	0x24, 0xd7, 0xee, 0x40, 0xbf, 0xc8, 0xfe, 0x6b, 0x2c, 0x9e, 0x1d, 0x4d, 0x7e, 0xef, 0xc8, 0x50,
+	0x6e, 0x5b, 0x5c, 0x3c, 0xe8, 0xfd, 0x63, 0x6c, 0x5a, 0x5a, 0xa0, 0x83, 0x91, 0xb7, 0xfc, 0xbd,
+	0xfe, 0x39, 0x6c, 0xe5, 0xbb, 0x2c, 0x88, 0x7d, 0xdd, 0x85, 0x8e, 0x7c, 0xda, 0x48, 0xfb, 0x2a,
+	0xed, 0xde, 0xc1, 0xc8, 0xfb, 0x40, 0x34, 0x59, 0x19, 0x11, 0xf9, 0x1c, 0xba, 0x4a, 0x4b, 0xe9,
+	0x52, 0x6f, 0x8a, 0xfc, 0x0e, 0x1f, 0xaf, 0x97, 0x8d, 0x77, 0x10, 0x8d, 0x45, 0xba, 0x07, 0x93,
+	0x6f, 0xf6, 0x5c, 0x29, 0x3e, 0x91, 0x20, 0xb9, 0x0b, 0x4d, 0x4e, 0x59, 0x3a, 0xb4, 0x3c, 0xe4,
+	0xb5, 0xec, 0x90, 0x93, 0xaf, 0xe0, 0xca, 0x27, 0x34, 0xf2, 0x4e, 0xe6, 0xf9, 0xe4, 0xd5, 0xe2,
+	0xea, 0x49, 0x9e, 0xd6, 0xaa, 0x2d, 0x4a, 0x6b, 0xd5, 0x0b, 0x69, 0xad, 0x92, 0xd4, 0x15, 0xf9,
+	0x1f, 0x03, 0x76, 0x25, 0x6b, 0x36, 0x11, 0xcf, 0xb1, 0xb5, 0xc0, 0xc7, 0x00, 0xda, 0x67, 0x0c,
+	0x2f, 0x8a, 0xd2, 0xdb, 0x56, 0x0a, 0xe3, 0xf6, 0x3b, 0xa1, 0x4b, 0xd5, 0x68, 0x7b, 0x1b, 0x11,
+	0x32, 0xd6, 0x2e, 0xa6, 0x59, 0x5f, 0x34, 0xcd, 0x46, 0xe5, 0x34, 0x57, 0xb2, 0x69, 0xa2, 0x9f,
+	0xe2, 0x7b, 0xa3, 0xc8, 0x8e, 0x3c, 0x8a, 0x35, 0xcc, 0xaa, 0x9f, 0xf2, 0xc4, 0x0b, 0x9e, 0x51,
+	0xf7, 0x09, 0x6b, 0x9d, 0x5b, 0x19, 0x59, 0x55, 0xd1, 0x0e, 0x79, 0x0f, 0x7a, 0x5a, 0x9f, 0xd2,
+	0xbd, 0xaa, 0x3c, 0x69, 0xe4, 0x6f, 0x6a, 0xcc, 0xa1, 0xba, 0x8f, 0xd2, 0x09, 0xe2, 0x59, 0xac,
+	0xe7, 0xf6, 0xaf, 0x01, 0xb8, 0x3c, 0x21, 0x2f, 0x8b, 0x2f, 0xea, 0x56, 0x47, 0x60, 0x78, 0x55,
+	0x8f, 0x00, 0x64, 0x2d, 0x87, 0x00, 0x51, 0xce, 0xd3, 0x28, 0x9c, 0x86, 0x71, 0x9a, 0xc9, 0x4b,
+	0xe1, 0x25, 0xe9, 0x89, 0x5b, 0xd0, 0x63, 0x16, 0x38, 0xed, 0xce, 0x05, 0xb7, 0x8a, 0xc8, 0x23,
+	0x39, 0xc4, 0x4b, 0xb0, 0xc6, 0x88, 0xf2, 0x77, 0x10, 0xeb, 0xfa, 0x34, 0x1d, 0xeb, 0x35, 0x58,
+	0xc1, 0xbc, 0x7d, 0xdc, 0x6f, 0x69, 0x32, 0x56, 0x73, 0xfe, 0xb1, 0xc5, 0x49, 0xf4, 0xda, 0x8f,
+	0x76, 0xae, 0xf6, 0x23, 0xcd, 0x8b, 0x74, 0x94, 0xbc, 0x08, 0xb9, 0x0f, 0x3d, 0x6d, 0xa8, 0x25,
+	0xa9, 0xbc, 0xcb, 0x72, 0x36, 0xa2, 0x1c, 0x82, 0x01, 0xe4, 0xf7, 0x6a, 0xb0, 0x79, 0x3c, 0x0f,
+	0x9c, 0x42, 0x51, 0x85, 0xac, 0xf9, 0x32, 0xf4, 0x9a, 0x2f, 0xac, 0xc6, 0x4f, 0xb0, 0x42, 0x4b,
+	0x8c, 0xc2, 0x00, 0xf3, 0x15, 0x58, 0x8f, 0x13, 0x3b, 0x4a, 0xbc, 0x60, 0xac, 0xfb, 0x16, 0x6b,
+	0x12, 0x2d, 0x3c, 0x0c, 0xac, 0x17, 0x9f, 0x45, 0x3c, 0xab, 0xa4, 0xda, 0xd2, 0x9e, 0xc0, 0x66,
+	0x64, 0xa7, 0xde, 0xf8, 0x94, 0xc6, 0x89, 0xfe, 0x48, 0xeb, 0x09, 0xac, 0x20, 0xbb, 0x05, 0x3d,
+	0x37, 0x3c, 0x0f, 0xfc, 0xd0, 0x76, 0x87, 0x91, 0x9d, 0xf0, 0x18, 0xbb, 0x61, 0xad, 0x4a, 0xa4,
+	0x65, 0x27, 0xec, 0x88, 0xb0, 0x33, 0x36, 0xe7, 0x24, 0x2d, 0x46, 0x02, 0x1c, 0xc5, 0x08, 0x36,
+	0xa0, 0x4e, 0x45, 0x22, 0xa3, 0x6e, 0xe1, 0xcf, 0x7b, 0xff, 0x70, 0x15, 0xe0, 0x60, 0xea, 0x1d,
+	0xd3, 0xe8, 0x0c, 0x23, 0xe9, 0x9f, 0x41, 0x57, 0x29, 0x0c, 0x32, 0x53, 0x6f, 0x35, 0x57, 0x89,
+	0x37, 0x90, 0xa9, 0xfe, 0x92, 0x2a, 0x22, 0xb2, 0xf3, 0xe3, 0x7f, 0xfe, 0xf7, 0xdf, 0xaf, 0x5d,
+	0x32, 0x37, 0xf7, 0xcf, 0xde, 0xdc, 0x9f, 0xc5, 0x34, 0xc2, 0xbf, 0xf6, 0xb0, 0x50, 0xb8, 0xf9,
+	0x18, 0x9a, 0xbc, 0x7e, 0xaf, 0x7a, 0x64, 0x99, 0x22, 0xd6, 0xeb, 0xfc, 0xc8, 0x3a, 0x1b, 0xb4,
+	0x63, 0xb6, 0xf6, 0x4f, 0xf9, 0x00, 0x87, 0xb0, 0x62, 0x51, 0xdb, 0x9d, 0xbf, 0xf0, 0x48, 0x6b,
+	0x6c, 0xa4, 0xb6, 0xd9, 0xdc, 0x8f, 0x58, 0xff, 0x4f, 0xa1, 0x2d, 0x4b, 0xb7, 0xaa, 0xc7, 0xca,
+	0x1a, 0xf4, 0x22, 0xaf, 0xb2, 0xc5, 0x86, 0x2e, 0xf5, 0x70, 0xb0, 0xcf, 0xa0, 0x93, 0xa6, 0x6f,
+	0x4c, 0x2d, 0x65, 0xa6, 0xa4, 0x7e, 0x06, 0xfd, 0x62, 0x83, 0x18, 0xfa, 0x1a, 0x1b, 0x7a, 0x9b,
+	0x98, 0xe9, 0xd0, 0xec, 0x72, 0x76, 0x67, 0x93, 0xe9, 0xf7, 0x8c, 0xd7, 0x70, 0xde, 0xb2, 0x78,
+	0x69, 0xf9, 0xbc, 0xf3, 0x65, 0x4e, 0x25, 0xf3, 0xb6, 0xe5, 0x60, 0x11, 0x0b, 0xe7, 0xa8, 0x15,
+	0x48, 0xe6, 0xb5, 0x6c, 0xbb, 0x4b, 0x6a, 0x9f, 0x06, 0xd7, 0xab, 0x9a, 0x05, 0xb3, 0x3d, 0xc6,
+	0x6c, 0x40, 0xae, 0x14, 0x98, 0x21, 0x19, 0x2e, 0x66, 0x02, 0xeb, 0xb9, 0x88, 0xb4, 0x59, 0x1d,
+	0xec, 0x4e, 0xf9, 0x55, 0x24, 0xce, 0xc9, 0x0d, 0xc6, 0x6f, 0x87, 0x5c, 0x4e, 0xf9, 0x29, 0xae,
+	0x27, 0xb2, 0x3b, 0x82, 0x06, 0x86, 0x74, 0x17, 0xf1, 0xb8, 0x94, 0xd6, 0xe5, 0x64, 0xa1, 0x5f,
+	0xd2, 0x67, 0x03, 0x9b, 0xa4, 0x97, 0x0e, 0x8c, 0x65, 0x2d, 0x38, 0xe2, 0x97, 0x60, 0x16, 0xab,
+	0x04, 0xcc, 0x3d, 0x65, 0xa2, 0xa5, 0x05, 0x04, 0x4b, 0x97, 0x42, 0x18, 0xc7, 0x5d, 0xb2, 0x9d,
+	0x72, 0x8c, 0xec, 0xf3, 0xdc, 0x6a, 0x3e, 0x81, 0xb6, 0x4c, 0xaa, 0x9b, 0x5b, 0xd9, 0x56, 0xa8,
+	0x59, 0xf6, 0xc1, 0x65, 0x55, 0xcd, 0xd2, 0xd1, 0x77, 0xd9, 0xe8, 0x5b, 0x24, 0xd3, 0x82, 0xb1,
+	0xe8, 0x87, 0xe3, 0xda, 0x2c, 0x0e, 0xa9, 0x14, 0x09, 0x98, 0xbb, 0xb9, 0xd1, 0xb5, 0x42, 0x80,
+	0x41, 0xef, 0x8e, 0x13, 0x46, 0x54, 0x32, 0x29, 0x99, 0xfa, 0x58, 0xeb, 0x86, 0x2c, 0x7e, 0xcb,
+	0x60, 0xce, 0x5e, 0x31, 0x79, 0x69, 0x92, 0x8c, 0x55, 0x55, 0xe5, 0xc1, 0xe0, 0x66, 0xd9, 0xf6,
+	0x69, 0xb9, 0x4f, 0xf2, 0x2a, 0x9b, 0xc4, 0x2d, 0x72, 0x5d, 0x9d, 0x44, 0x91, 0x1e, 0xe7, 0x32,
+	0x84, 0x4e, 0xfa, 0x4c, 0x4e, 0x4f, 0x54, 0xfe, 0x9f, 0x86, 0x83, 0xca, 0x17, 0x75, 0xc9, 0x79,
+	0x8d, 0x25, 0xcd, 0xf7, 0x8c, 0xd7, 0xee, 0x1a, 0xe6, 0xa1, 0x52, 0x1c, 0x2f, 0xdf, 0xff, 0xcf,
+	0x61, 0x72, 0x72, 0x91, 0x82, 0xbb, 0x86, 0xf9, 0x01, 0xac, 0xa7, 0x03, 0xf1, 0xc8, 0xdd, 0xd7,
+	0x98, 0xef, 0x5d, 0xc3, 0x7c, 0x0c, 0x66, 0x8a, 0x4e, 0x23, 0x0b, 0xd5, 0x33, 0xaa, 0x0c, 0x42,
+	0xdc, 0x35, 0xc4, 0xc5, 0x21, 0x93, 0x43, 0xcb, 0x57, 0x95, 0x4f, 0x23, 0x49, 0x55, 0x34, 0x2f,
+	0xab, 0x1b, 0x95, 0x8e, 0x47, 0xa1, 0xab, 0xa4, 0x91, 0x16, 0x9d, 0x5b, 0x79, 0x33, 0x95, 0x64,
+	0x9d, 0x4a, 0xec, 0x82, 0x92, 0x9c, 0x41, 0x15, 0xf8, 0x82, 0x99, 0x3e, 0x2e, 0x52, 0xa1, 0xf2,
+	0xcf, 0xa3, 0x87, 0x57, 0xd4, 0x2c, 0x46, 0xc6, 0xee, 0x16, 0x63, 0x77, 0x8d, 0xf4, 0xd5, 0x25,
+	0xa9, 0x83, 0x23, 0xcb, 0x8f, 0xa1, 0x25, 0x82, 0xe7, 0xe6, 0x95, 0x8c, 0x95, 0x12, 0xfc, 0x1f,
+	0x6c, 0xe5, 0xd1, 0x62, 0xf8, 0xab, 0x6c, 0xf8, 0x2b, 0x64, 0x43, 0x1d, 0x1e, 0x29, 0x70, 0xd8,
+	0xcf, 0xa0, 0x93, 0xae, 0x24, 0xdd, 0x8d, 0x7c, 0xb8, 0x7c, 0xd0, 0x2f, 0x36, 0x54, 0x2a, 0x73,
+	0x3a, 0x77, 0x1c, 0xfe, 0x47, 0xb0, 0x29, 0x1f, 0x82, 0x4f, 0xb3, 0x00, 0x9f, 0x22, 0xaa, 0xb2,
+	0x98, 0xee, 0x60, 0xaf, 0x9a, 0x40, 0xb0, 0x7d, 0x89, 0xb1, 0xbd, 0x41, 0x06, 0xda, 0x71, 0xd5,
+	0x68, 0x91, 0xfd, 0x1f, 0x8a, 0xc8, 0x71, 0x59, 0x80, 0xc2, 0x7c, 0xb9, 0x74, 0xc7, 0x0a, 0xa1,
+	0xa5, 0xc1, 0x2b, 0x4b, 0xe9, 0xc4, 0xa4, 0xbe, 0xcd, 0x26, 0xf5, 0x32, 0xb9, 0x59, 0x61, 0x43,
+	0xb2, 0x2e, 0x42, 0xf2, 0x69, 0x44, 0xd1, 0x54, 0x0e, 0xb1, 0x16, 0x41, 0x1c, 0xf4, 0x8b, 0x0d,
+	0x95, 0x92, 0x0f, 0x24, 0x0d, 0x0e, 0xef, 0xb3, 0xac, 0x87, 0x16, 0x6c, 0x34, 0xa5, 0x71, 0xd7,
+	0x59, 0xec, 0x6a, 0xd8, 0x5c, 0x60, 0x92, 0x7c, 0x8b, 0xb1, 0xb9, 0x4e, 0x76, 0xd4, 0x45, 0x69,
+	0xa4, 0x9c, 0xdb, 0x7a, 0x2e, 0xaa, 0x57, 0xc1, 0x4c, 0xde, 0x63, 0x15, 0x31, 0xc0, 0x92, 0xb3,
+	0x30, 0xd3, 0x29, 0x91, 0xdb, 0x8c, 0x1d, 0x3f, 0x35, 0xb4, 0xa2, 0x7a, 0x1e, 0x25, 0x01, 0x9f,
+	0xc1, 0xf5, 0xaa, 0xe6, 0x45, 0x47, 0x50, 0xa5, 0x44, 0xb6, 0x73, 0x26, 0x52, 0x2d, 0xaa, 0x61,
+	0xe6, 0x07, 0xce, 0x45, 0x5b, 0x06, 0x37, 0x2a, 0xdb, 0x17, 0xc9, 0x57, 0x23, 0xe5, 0x06, 0x67,
+	0x4d, 0x0f, 0x5c, 0xa8, 0x57, 0x6c, 0x31, 0x04, 0x32, 0xb8, 0x56, 0xd1, 0x5a, 0xe9, 0x2d, 0x8c,
+	0x35, 0x42, 0x64, 0x79, 0x0e, 0x6b, 0x7a, 0xe4, 0x20, 0x65, 0x59, 0x1a, 0x50, 0x18, 0xdc, 0xca,
+	0x85, 0x84, 0xcb, 0x5e, 0xfb, 0x25, 0x8c, 0xcf, 0xb4, 0xc1, 0xc4, 0x5d, 0xbf, 0xad, 0xcc, 0x5b,
+	0x1d, 0x67, 0xc9, 0xaa, 0x9f, 0x6b, 0x0a, 0xaf, 0xb3, 0x29, 0xbc, 0x44, 0xf6, 0xca, 0xd6, 0xae,
+	0xf6, 0xc0, 0xb9, 0x84, 0xb0, 0x59, 0x78, 0x8b, 0x57, 0x5f, 0x5a, 0x7b, 0xda, 0xec, 0x4a, 0x9e,
+	0xef, 0xf2, 0x66, 0x31, 0xb3, 0xf5, 0x3b, 0xfa, 0xd8, 0x9f, 0xc1, 0xea, 0x21, 0x4d, 0xd2, 0xe7,
+	0xe7, 0xf2, 0x4b, 0xb6, 0xf0, 0x52, 0x25, 0x03, 0xc6, 0xe3, 0xb2, 0xa9, 0xf8, 0x17, 0x92, 0xe6,
+	0xde, 0x5f, 0x5c, 0x82, 0xd5, 0x03, 0xac, 0x1e, 0x96, 0x0f, 0x39, 0x07, 0x20, 0x2b, 0xd2, 0x31,
+	0x15, 0x6b, 0xa3, 0xd7, 0xc0, 0x0c, 0x76, 0x4a, 0x5a, 0xca, 0xbc, 0x76, 0x56, 0x9a, 0x2c, 0xdd,
+	0x76, 0xb4, 0x48, 0x5c, 0x8a, 0x3d, 0xad, 0x0e, 0xc7, 0xbc, 0x9a, 0x5a, 0x81, 0x62, 0xbd, 0xcf,
+	0x60, 0xb7, 0xbc, 0xb1, 0xec, 0xa4, 0xea, 0xdc, 0x66, 0x81, 0xf4, 0x48, 0xc7, 0xd0, 0x55, 0xea,
+	0x72, 0x52, 0x37, 0xa0, 0x58, 0xdb, 0x33, 0x18, 0x94, 0x35, 0x09, 0x56, 0x37, 0x19, 0xab, 0xab,
+	0x64, 0xab, 0xc8, 0x2a, 0x63, 0xb4, 0x9e, 0xab, 0xe8, 0x79, 0xae, 0xf7, 0x48, 0x79, 0x11, 0x90,
+	0x7c, 0x6c, 0x91, 0xb5, 0x8c, 0x21, 0x56, 0x5b, 0x23, 0xa3, 0x3f, 0x31, 0xe0, 0x5a, 0xce, 0xf7,
+	0xff, 0xd4, 0x4b, 0x4e, 0xb3, 0x7a, 0x1c, 0xf3, 0x95, 0xf2, 0x17, 0x42, 0xa1, 0x64, 0x68, 0x70,
+	0x7b, 0x39, 0xa1, 0x98, 0xcf, 0x1d, 0x36, 0x9f, 0xdb, 0xe4, 0x56, 0x36, 0x9f, 0xa4, 0x8a, 0x3f,
+	0x37, 0x19, 0x66, 0xf1, 0x7f, 0x4e, 0xd5, 0x2a, 0x7c, 0x53, 0xa9, 0x84, 0x2b, 0xff, 0x6f, 0x94,
+	0xbc, 0xe7, 0xcd, 0x6b, 0x8a, 0x44, 0x52, 0xea, 0xfd, 0x40, 0x90, 0x9b, 0x3f, 0x00, 0xc8, 0xfe,
+	0xa9, 0x52, 0xcd, 0x70, 0x27, 0x3b, 0x9f, 0xb9, 0x7f, 0xb5, 0xe8, 0xef, 0x5c, 0xce, 0x48, 0x46,
+	0xce, 0x7e, 0xc8, 0x6c, 0x80, 0xfe, 0xb7, 0x14, 0xd5, 0x87, 0x29, 0xfd, 0xab, 0xcb, 0x60, 0xaf,
+	0x9a, 0xa0, 0x5a, 0x93, 0x5d, 0x8d, 0x12, 0x45, 0x7a, 0x06, 0xeb, 0xb9, 0x2f, 0x4f, 0xa4, 0x57,
+	0x5d, 0xf9, 0xa7, 0x2c, 0x06, 0xd7, 0xab, 0x9a, 0xcb, 0x2e, 0x1c, 0xce, 0xd6, 0xd1, 0x49, 0xf9,
+	0x3b, 0x75, 0x23, 0xff, 0x9f, 0xe9, 0xf4, 0xae, 0xab, 0xf8, 0x4b, 0xf6, 0xe0, 0x46, 0x65, 0x7b,
+	0x99, 0xd7, 0x96, 0xea, 0x93, 0x46, 0xcb, 0xdf, 0x93, 0xbd, 0x43, 0x9a, 0x64, 0x5f, 0xcf, 0x58,
+	0xbe, 0xa1, 0xc5, 0x2f, 0x6d, 0xe8, 0xef, 0x04, 0xce, 0x6b, 0x9a, 0x8d, 0xf8, 0x39, 0x33, 0xb3,
+	0xd9, 0xe7, 0x1d, 0x9e, 0xe3, 0x2d, 0x93, 0xfb, 0x8e, 0x84, 0x74, 0xab, 0xcd, 0x4b, 0x39, 0x06,
+	0x6c, 0xbc, 0x5f, 0x82, 0x96, 0xf8, 0x5a, 0x41, 0xea, 0xad, 0xeb, 0x5f, 0x2f, 0x18, 0xec, 0x68,
+	0xdb, 0x74, 0x44, 0xab, 0x3c, 0xbb, 0x6c, 0xe4, 0x7d, 0xdb, 0x75, 0x51, 0x3c, 0x0e, 0x40, 0xf6,
+	0xad, 0x82, 0xd4, 0x64, 0x17, 0x3e, 0x5f, 0xb0, 0x88, 0x43, 0x89, 0xc9, 0x66, 0x1c, 0x78, 0x69,
+	0x11, 0x32, 0xb1, 0x58, 0xac, 0x00, 0x3b, 0x2d, 0x10, 0xce, 0x65, 0x45, 0x38, 0x99, 0x60, 0xb6,
+	0xd9, 0xe0, 0x9b, 0xe6, 0xba, 0x3e, 0x78, 0x6c, 0xda, 0xd0, 0x3d, 0x70, 0x5d, 0xf9, 0x65, 0x83,
+	0x34, 0x04, 0x91, 0xfb, 0x4a, 0xc2, 0x60, 0xbb, 0x80, 0xaf, 0xb6, 0xc7, 0xde, 0x94, 0xd3, 0x48,
+	0xd9, 0x8c, 0x61, 0x8d, 0x0b, 0xe2, 0xeb, 0x73, 0x29, 0x39, 0x1f, 0x29, 0x97, 0x4c, 0x3e, 0x3f,
+	0x60, 0xef, 0xd8, 0x94, 0xcb, 0xd2, 0x77, 0x6c, 0x81, 0x8d, 0x76, 0x4b, 0xeb, 0x6c, 0xcc, 0x1f,
+	0x1b, 0x2c, 0x4f, 0x55, 0xf2, 0xf9, 0x20, 0xf3, 0x66, 0xee, 0x6d, 0x5d, 0xfc, 0x1c, 0xd1, 0x80,
+	0x2c, 0x22, 0xa9, 0x16, 0xe5, 0x34, 0x0c, 0xfd, 0xfd, 0x29, 0xef, 0xc3, 0x9d, 0xec, 0xcb, 0x65,
+	0x1f, 0x13, 0xaa, 0x5e, 0xaa, 0xf4, 0xbe, 0x16, 0x7d, 0x82, 0x48, 0x7f, 0x5a, 0x2b, 0x8c, 0x4f,
+	0xb0, 0x13, 0x0f, 0x52, 0xb5, 0xc4, 0xf7, 0x85, 0xd2, 0x93, 0xa3, 0x7f, 0x99, 0x68, 0xb0, 0x95,
+	0x47, 0x97, 0x05, 0xa9, 0xf8, 0xd0, 0x31, 0x27, 0xe1, 0xcf, 0xad, 0xee, 0x31, 0x4d, 0xe4, 0x27,
+	0x85, 0x52, 0xb5, 0xc8, 0x7d, 0x8c, 0x68, 0xb0, 0x5d, 0xc0, 0x57, 0x1f, 0x4a, 0x5f, 0xd0, 0xf0,
+	0xe7, 0x79, 0x87, 0x7b, 0x7d, 0x27, 0xde, 0x78, 0x79, 0xa8, 0x59, 0xff, 0x5e, 0x91, 0x0c, 0x17,
+	0x9a, 0x1b, 0xd9, 0xd8, 0xfc, 0x8b, 0x45, 0xa3, 0x26, 0xfb, 0x23, 0xeb, 0x5b, 0xff, 0x37, 0x00,
+	0xdc, 0x92, 0x39, 0xad, 0x35, 0x4b, 0x00, 0x00,
 }
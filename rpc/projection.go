@@ -0,0 +1,194 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// tx modes of GetBlock
+const (
+	TxModeFull   = "full"
+	TxModeHashes = "hashes"
+	TxModeCount  = "count"
+)
+
+// errors
+var (
+	ErrUnknownField       = errors.New("unknown field in fields")
+	ErrInvalidTxMode      = errors.New("tx_mode must be full, hashes or count")
+	ErrBlockNotFound      = errors.New("block not found")
+	ErrBlockHashAndHeight = errors.New("only one of hash and height can be given")
+)
+
+// projection is the set of the fields of a response asked for, all if empty.
+type projection map[string]bool
+
+// newProjection return the projection of the fields of the message, by their proto or json names.
+func newProjection(msg interface{}, fields []string) (projection, error) {
+	names := fieldNames(msg)
+	p := make(projection)
+	for _, v := range fields {
+		name, ok := names[v]
+		if !ok {
+			return nil, ErrUnknownField
+		}
+		p[name] = true
+	}
+	return p, nil
+}
+
+// has return true if the field of the proto name is asked for.
+func (p projection) has(name string) bool {
+	return len(p) == 0 || p[name]
+}
+
+// apply clear the fields of the message not asked for.
+func (p projection) apply(msg interface{}) {
+	if len(p) == 0 {
+		return
+	}
+	v := reflect.ValueOf(msg).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := protoName(t.Field(i))
+		if len(name) > 0 && !p[name] {
+			v.Field(i).Set(reflect.Zero(t.Field(i).Type))
+		}
+	}
+}
+
+// fieldNames return the proto names of the fields of the message, keyed by their proto and json names.
+func fieldNames(msg interface{}) map[string]string {
+	names := make(map[string]string)
+	t := reflect.TypeOf(msg).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("protobuf")
+		name := protoName(t.Field(i))
+		if len(name) == 0 {
+			continue
+		}
+		names[name] = name
+		for _, v := range strings.Split(tag, ",") {
+			if strings.HasPrefix(v, "json=") {
+				names[strings.TrimPrefix(v, "json=")] = name
+			}
+		}
+	}
+	return names
+}
+
+func protoName(field reflect.StructField) string {
+	for _, v := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(v, "name=") {
+			return strings.TrimPrefix(v, "name=")
+		}
+	}
+	return ""
+}
+
+// GetBlock return the block of the hash, or of the canonical chain at the height, with only the fields
+// and the transactions asked for.
+func (s *APIService) GetBlock(ctx context.Context, req *rpcpb.GetBlockRequest) (*rpcpb.BlockResponse, error) {
+	logging.VLog().WithFields(logrus.Fields{
+		"hash":   req.Hash,
+		"height": req.Height,
+		"mode":   req.TxMode,
+		"api":    "/v1/user/getBlock",
+	}).Info("Rpc request.")
+
+	resp := &rpcpb.BlockResponse{}
+	p, err := newProjection(resp, req.Fields)
+	if err != nil {
+		return nil, err
+	}
+	mode := strings.ToLower(req.TxMode)
+	if len(mode) == 0 {
+		mode = TxModeFull
+	}
+	if mode != TxModeFull && mode != TxModeHashes && mode != TxModeCount {
+		return nil, ErrInvalidTxMode
+	}
+	if len(req.Hash) > 0 && req.Height > 0 {
+		return nil, ErrBlockHashAndHeight
+	}
+
+	bc := s.server.Neblet().BlockChain()
+	var block *core.Block
+	if len(req.Hash) > 0 {
+		blockHash, err := byteutils.FromHex(req.Hash)
+		if err != nil {
+			return nil, err
+		}
+		block = bc.GetBlock(blockHash)
+	} else {
+		block = bc.GetBlockOnCanonicalChainByHeight(req.Height)
+	}
+	if block == nil {
+		return nil, ErrBlockNotFound
+	}
+
+	resp.Hash = block.Hash().String()
+	resp.ParentHash = block.ParentHash().String()
+	resp.Height = block.Height()
+	resp.Timestamp = block.Timestamp()
+	resp.Coinbase = block.Coinbase().String()
+	if miner := block.Miner(); miner != nil {
+		resp.Miner = miner.String()
+	}
+	resp.ChainId = block.ChainID()
+	resp.StateRoot = block.StateRoot().String()
+	resp.TxsRoot = block.TxsRoot().String()
+	resp.EventsRoot = block.EventsRoot().String()
+	resp.TxCount = uint32(len(block.Transactions()))
+	switch {
+	case mode == TxModeFull && p.has("transactions"):
+		resp.Transactions = []*rpcpb.BlockTransaction{}
+		for _, tx := range block.Transactions() {
+			resp.Transactions = append(resp.Transactions, &rpcpb.BlockTransaction{
+				Hash:      tx.Hash().String(),
+				From:      tx.From().String(),
+				To:        tx.To().String(),
+				Value:     tx.Value().String(),
+				Nonce:     tx.Nonce(),
+				Timestamp: tx.Timestamp(),
+				Type:      tx.Type(),
+				Data:      byteutils.Hex(tx.Data()),
+				GasPrice:  tx.GasPrice().String(),
+				GasLimit:  tx.GasLimit().String(),
+			})
+		}
+	case mode == TxModeHashes && p.has("tx_hashes"):
+		resp.TxHashes = []string{}
+		for _, tx := range block.Transactions() {
+			resp.TxHashes = append(resp.TxHashes, tx.Hash().String())
+		}
+	}
+	p.apply(resp)
+	return resp, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestProjection(t *testing.T) {
+	// the fields are named by their proto or json names.
+	p, err := newProjection(&rpcpb.BlockResponse{}, []string{"parent_hash", "txCount"})
+	assert.Nil(t, err)
+	assert.True(t, p.has("parent_hash"))
+	assert.True(t, p.has("tx_count"))
+	assert.False(t, p.has("hash"))
+	_, err = newProjection(&rpcpb.BlockResponse{}, []string{"ParentHash"})
+	assert.Equal(t, ErrUnknownField, err)
+
+	resp := &rpcpb.BlockResponse{Hash: "a", ParentHash: "b", TxCount: 1, TxHashes: []string{"c"}}
+	p.apply(resp)
+	assert.Equal(t, &rpcpb.BlockResponse{ParentHash: "b", TxCount: 1}, resp)
+
+	// all the fields if none is asked for.
+	p, err = newProjection(&rpcpb.BlockResponse{}, nil)
+	assert.Nil(t, err)
+	assert.True(t, p.has("hash"))
+	resp = &rpcpb.BlockResponse{Hash: "a", ParentHash: "b"}
+	p.apply(resp)
+	assert.Equal(t, &rpcpb.BlockResponse{Hash: "a", ParentHash: "b"}, resp)
+}
+
+func TestAPIService_GetBlock(t *testing.T) {
+	key := secp256k1.GeneratePrivateKey()
+	bc := mockTxChain(t, key)
+	pubdata, _ := key.PublicKey().Encoded()
+	from, _ := core.NewAddressFromPublicKey(pubdata)
+	pubdata, _ = secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	to, _ := core.NewAddressFromPublicKey(pubdata)
+	tx := core.NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, util.NewUint128FromInt(200000))
+	block := mockTxBlock(t, bc, key, tx)
+	api := &APIService{server: &mockServer{neb: &chainNeb{chain: bc}}}
+
+	// the whole block and its transactions.
+	resp, err := api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Height: block.Height()})
+	assert.Nil(t, err)
+	assert.Equal(t, block.Hash().String(), resp.Hash)
+	assert.Equal(t, block.ParentHash().String(), resp.ParentHash)
+	assert.Equal(t, block.StateRoot().String(), resp.StateRoot)
+	assert.Equal(t, uint32(1), resp.TxCount)
+	assert.Equal(t, 1, len(resp.Transactions))
+	assert.Equal(t, tx.Hash().String(), resp.Transactions[0].Hash)
+	assert.Equal(t, to.String(), resp.Transactions[0].To)
+	assert.Nil(t, resp.TxHashes)
+
+	// the hashes of the transactions, by the hash of the block.
+	resp, err = api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Hash: block.Hash().String(), TxMode: "Hashes"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{tx.Hash().String()}, resp.TxHashes)
+	assert.Nil(t, resp.Transactions)
+
+	// only the fields asked for.
+	resp, err = api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Height: block.Height(), Fields: []string{"height", "txCount"}, TxMode: TxModeCount})
+	assert.Nil(t, err)
+	assert.Equal(t, &rpcpb.BlockResponse{Height: block.Height(), TxCount: 1}, resp)
+	resp, err = api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Height: block.Height(), Fields: []string{"hash", "tx_hashes"}, TxMode: TxModeHashes})
+	assert.Nil(t, err)
+	assert.Equal(t, &rpcpb.BlockResponse{Hash: block.Hash().String(), TxHashes: []string{tx.Hash().String()}}, resp)
+	resp, err = api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Height: block.Height(), Fields: []string{"hash"}})
+	assert.Nil(t, err)
+	assert.Equal(t, &rpcpb.BlockResponse{Hash: block.Hash().String()}, resp)
+
+	_, err = api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Height: block.Height(), Fields: []string{"size"}})
+	assert.Equal(t, ErrUnknownField, err)
+	_, err = api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Height: block.Height(), TxMode: "receipts"})
+	assert.Equal(t, ErrInvalidTxMode, err)
+	_, err = api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Hash: block.Hash().String(), Height: block.Height()})
+	assert.Equal(t, ErrBlockHashAndHeight, err)
+	_, err = api.GetBlock(context.Background(), &rpcpb.GetBlockRequest{Height: block.Height() + 1})
+	assert.Equal(t, ErrBlockNotFound, err)
+}
+
+func TestAPIService_GetTransactionReceipt(t *testing.T) {
+	key := secp256k1.GeneratePrivateKey()
+	bc := mockTxChain(t, key)
+	pubdata, _ := key.PublicKey().Encoded()
+	from, _ := core.NewAddressFromPublicKey(pubdata)
+	pubdata, _ = secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	to, _ := core.NewAddressFromPublicKey(pubdata)
+	tx := core.NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, util.NewUint128FromInt(200000))
+	mockTxBlock(t, bc, key, tx)
+	api := &APIService{server: &mockServer{neb: &chainNeb{chain: bc}}}
+
+	receipt, err := api.GetTransactionReceipt(context.Background(), &rpcpb.GetTransactionByHashRequest{Hash: tx.Hash().String()})
+	assert.Nil(t, err)
+	assert.Equal(t, tx.Hash().String(), receipt.Hash)
+	assert.Equal(t, from.String(), receipt.From)
+	assert.Equal(t, to.String(), receipt.To)
+	assert.Equal(t, uint64(1), receipt.Nonce)
+
+	receipt, err = api.GetTransactionReceipt(context.Background(), &rpcpb.GetTransactionByHashRequest{Hash: tx.Hash().String(), Fields: []string{"hash", "gasPrice"}})
+	assert.Nil(t, err)
+	assert.Equal(t, &rpcpb.TransactionReceiptResponse{Hash: tx.Hash().String(), GasPrice: tx.GasPrice().String()}, receipt)
+
+	_, err = api.GetTransactionReceipt(context.Background(), &rpcpb.GetTransactionByHashRequest{Hash: tx.Hash().String(), Fields: []string{"receipt"}})
+	assert.Equal(t, ErrUnknownField, err)
+}
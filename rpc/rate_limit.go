@@ -0,0 +1,191 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// const
+const (
+	// APIKeyHeader is the header of the api key of a request.
+	APIKeyHeader = "X-Api-Key"
+
+	// RateLimitIdleTimeout is the duration after which an idle client is forgotten, its bucket is full again anyway.
+	RateLimitIdleTimeout = time.Minute
+)
+
+// errors
+var (
+	ErrRateLimited        = errors.New("rate limit exceeded")
+	ErrInvalidAPIKey      = errors.New("invalid api key")
+	ErrInvalidAPIKeyLimit = errors.New("api key must be key[:rate[:burst]]")
+)
+
+var (
+	rateLimitIPRejectedCounter  = metrics.GetOrRegisterCounter("neb.rpc.ratelimit.ip.rejected", nil)
+	rateLimitKeyRejectedCounter = metrics.GetOrRegisterCounter("neb.rpc.ratelimit.key.rejected", nil)
+	invalidAPIKeyCounter        = metrics.GetOrRegisterCounter("neb.rpc.ratelimit.key.invalid", nil)
+)
+
+// Limit is a rate of requests per second, with a burst of requests allowed at once, unlimited if the rate is 0.
+type Limit struct {
+	Rate  int
+	Burst int
+}
+
+// RateLimiter gives each client a bucket of Burst tokens refilled at Rate tokens per second,
+// a request takes a token and is refused if the bucket is empty.
+type RateLimiter struct {
+	mu         sync.Mutex
+	buckets    map[string]*bucket
+	lastExpire time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter return a new RateLimiter.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets:    make(map[string]*bucket),
+		lastExpire: time.Now(),
+	}
+}
+
+// Allow take a token of the client, return false and the time until the next token if its bucket is empty.
+func (l *RateLimiter) Allow(client string, limit Limit) (bool, time.Duration) {
+	if limit.Rate == 0 {
+		return true, 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastExpire) > RateLimitIdleTimeout {
+		l.expire(now)
+	}
+	rate, burst := float64(limit.Rate), float64(limit.Burst)
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		l.buckets[client] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// expire forget the clients idle for longer than RateLimitIdleTimeout.
+func (l *RateLimiter) expire(now time.Time) {
+	for client, b := range l.buckets {
+		if now.Sub(b.last) > RateLimitIdleTimeout {
+			delete(l.buckets, client)
+		}
+	}
+	l.lastExpire = now
+}
+
+// parseAPIKeys parse the api keys of the config, "key" for the ip limit, or "key:rate:burst" for its own one.
+func parseAPIKeys(keys []string, ipLimit Limit) (map[string]Limit, error) {
+	limits := make(map[string]Limit)
+	for _, v := range keys {
+		fields := strings.Split(v, ":")
+		if len(fields) > 3 || len(fields[0]) == 0 {
+			return nil, ErrInvalidAPIKeyLimit
+		}
+		limit := ipLimit
+		if len(fields) > 1 {
+			rate, err := strconv.ParseUint(fields[1], 10, 32)
+			if err != nil {
+				return nil, ErrInvalidAPIKeyLimit
+			}
+			limit = Limit{Rate: int(rate), Burst: int(rate)}
+		}
+		if len(fields) > 2 {
+			burst, err := strconv.ParseUint(fields[2], 10, 32)
+			if err != nil || burst == 0 {
+				return nil, ErrInvalidAPIKeyLimit
+			}
+			limit.Burst = int(burst)
+		}
+		limits[fields[0]] = limit
+	}
+	return limits, nil
+}
+
+// newIPLimit return the limit of the clients by ip of the config, the burst being the rate if not set.
+func newIPLimit(config *nebletpb.RPCConfig) Limit {
+	limit := Limit{Rate: int(config.IpRateLimit), Burst: int(config.IpRateBurst)}
+	if limit.Burst == 0 {
+		limit.Burst = limit.Rate
+	}
+	return limit
+}
+
+// rateLimitHandler refuse the requests of the clients over their limit with 429 Too Many Requests,
+// the clients being told apart by their api key, or by their ip without one.
+func rateLimitHandler(h http.Handler, limiter *RateLimiter, ipLimit Limit, apiKeys map[string]Limit) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			client  string
+			limit   Limit
+			counter metrics.Counter
+		)
+		if key := r.Header.Get(APIKeyHeader); len(key) > 0 {
+			var ok bool
+			if limit, ok = apiKeys[key]; !ok {
+				invalidAPIKeyCounter.Inc(1)
+				writeHTTPError(w, http.StatusUnauthorized, ErrInvalidAPIKey)
+				return
+			}
+			client, counter = "key/"+key, rateLimitKeyRejectedCounter
+		} else {
+			host, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				host = r.RemoteAddr
+			}
+			client, limit, counter = "ip/"+host, ipLimit, rateLimitIPRejectedCounter
+		}
+
+		if ok, wait := limiter.Allow(client, limit); !ok {
+			counter.Inc(1)
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			writeHTTPError(w, http.StatusTooManyRequests, ErrRateLimited)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
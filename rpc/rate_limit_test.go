@@ -0,0 +1,120 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Refill(t *testing.T) {
+	limiter := NewRateLimiter()
+	limit := Limit{Rate: 20, Burst: 2}
+
+	for i := 0; i < limit.Burst; i++ {
+		ok, _ := limiter.Allow("a", limit)
+		assert.True(t, ok)
+	}
+	ok, wait := limiter.Allow("a", limit)
+	assert.False(t, ok)
+	assert.True(t, wait > 0 && wait <= time.Second/time.Duration(limit.Rate))
+
+	// the other clients have their own buckets.
+	ok, _ = limiter.Allow("b", limit)
+	assert.True(t, ok)
+
+	time.Sleep(wait + 10*time.Millisecond)
+	ok, _ = limiter.Allow("a", limit)
+	assert.True(t, ok)
+	ok, _ = limiter.Allow("a", limit)
+	assert.False(t, ok)
+
+	// no limit without a rate.
+	for i := 0; i < 10; i++ {
+		ok, _ = limiter.Allow("c", Limit{})
+		assert.True(t, ok)
+	}
+}
+
+func TestParseAPIKeys(t *testing.T) {
+	ipLimit := Limit{Rate: 10, Burst: 20}
+	keys, err := parseAPIKeys([]string{"a", "b:5", "c:5:50"}, ipLimit)
+	assert.Nil(t, err)
+	assert.Equal(t, keys["a"], ipLimit)
+	assert.Equal(t, keys["b"], Limit{Rate: 5, Burst: 5})
+	assert.Equal(t, keys["c"], Limit{Rate: 5, Burst: 50})
+
+	for _, v := range []string{"", ":5", "a:b", "a:5:0", "a:5:5:5"} {
+		_, err := parseAPIKeys([]string{v}, ipLimit)
+		assert.Equal(t, err, ErrInvalidAPIKeyLimit, v)
+	}
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	handler := rateLimitHandler(h, NewRateLimiter(), Limit{Rate: 1, Burst: 1}, map[string]Limit{"key": {Rate: 1, Burst: 2}})
+
+	request := func(remoteAddr, key string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "/v1/user/nebstate", nil)
+		r.RemoteAddr = remoteAddr
+		if len(key) > 0 {
+			r.Header.Set(APIKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(t, request("1.2.3.4:1000", "").Code, http.StatusOK)
+	// the ports of an ip share its bucket.
+	w := request("1.2.3.4:1001", "")
+	assert.Equal(t, w.Code, http.StatusTooManyRequests)
+	assert.Equal(t, w.Header().Get("Retry-After"), "1")
+	assert.Equal(t, request("1.2.3.5:1000", "").Code, http.StatusOK)
+
+	// the api key has its own limit, whatever the ip.
+	assert.Equal(t, request("1.2.3.4:1000", "key").Code, http.StatusOK)
+	assert.Equal(t, request("1.2.3.6:1000", "key").Code, http.StatusOK)
+	assert.Equal(t, request("1.2.3.6:1000", "key").Code, http.StatusTooManyRequests)
+
+	w = request("1.2.3.7:1000", "unknown")
+	assert.Equal(t, w.Code, http.StatusUnauthorized)
+	assert.Equal(t, strings.TrimSpace(w.Body.String()), `{"error":"`+ErrInvalidAPIKey.Error()+`"}`)
+}
+
+func TestRateLimitHandler_Batch(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	// each request of a batch takes a token of the client.
+	handler := batchHandler(rateLimitHandler(h, NewRateLimiter(), Limit{Rate: 1, Burst: 2}, nil), 10, 1)
+
+	w := postBatch(handler, `[{"url":"/a"},{"url":"/b"},{"url":"/c"}]`)
+	assert.Equal(t, w.Code, http.StatusOK)
+	var resps []*BatchResponse
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resps))
+	assert.Equal(t, resps[0].Status, http.StatusOK)
+	assert.Equal(t, resps[1].Status, http.StatusOK)
+	assert.Equal(t, resps[2].Status, http.StatusTooManyRequests)
+	assert.Equal(t, string(resps[2].Error), `{"error":"`+ErrRateLimited.Error()+`"}`)
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+)
+
+// const
+const (
+	// RawTransactionPath is the path of sendRawTransaction.
+	RawTransactionPath = "/v1/user/rawtransaction"
+
+	// RawTxEncodingHex is the hex string of the transaction bytes, with or without 0x.
+	RawTxEncodingHex = "hex"
+	// RawTxEncodingBase64 is the base64 string of the transaction bytes, standard or url, padded or not.
+	RawTxEncodingBase64 = "base64"
+	// RawTxEncodingProto is the protobuf bytes of the transaction.
+	RawTxEncodingProto = "proto"
+
+	// maxRawTxBodySize is the largest protobuf body of a raw transaction, 1M.
+	maxRawTxBodySize = 1024 * 1024
+)
+
+// errors
+var (
+	ErrEmptyRawTx           = errors.New("raw transaction is empty")
+	ErrRawTxBothData        = errors.New("only one of data and raw can be given")
+	ErrInvalidRawTxEncoding = errors.New("raw transaction encoding must be hex, base64 or proto")
+	ErrInvalidRawTxHex      = errors.New("raw transaction is not a valid hex string")
+	ErrInvalidRawTxBase64   = errors.New("raw transaction is not a valid base64 string")
+	ErrInvalidRawTxProto    = errors.New("raw transaction is not a valid protobuf transaction")
+	ErrUndecodableRawTx     = errors.New("raw transaction is neither hex, base64 nor protobuf")
+)
+
+// rawTxMediaTypes are the content types of the protobuf bodies posted to RawTransactionPath.
+var rawTxMediaTypes = map[string]bool{
+	"application/octet-stream": true,
+	"application/x-protobuf":   true,
+	"application/protobuf":     true,
+}
+
+// decodeRawTransaction return the transaction of the request, given as protobuf bytes in data,
+// or as a hex or base64 string in raw or data. The encoding is detected if not given.
+func decodeRawTransaction(req *rpcpb.SendRawTransactionRequest) (*core.Transaction, error) {
+	encoding := strings.ToLower(strings.TrimSpace(req.Encoding))
+	if encoding != "" && encoding != RawTxEncodingHex && encoding != RawTxEncodingBase64 && encoding != RawTxEncodingProto {
+		return nil, ErrInvalidRawTxEncoding
+	}
+	if len(req.Data) > 0 && len(req.Raw) > 0 {
+		return nil, ErrRawTxBothData
+	}
+
+	if len(req.Data) > 0 {
+		switch encoding {
+		case "", RawTxEncodingProto:
+			return unmarshalRawTransaction(req.Data)
+		default:
+			// the clients of grpc can't always tell bytes from strings.
+			return decodeRawString(string(req.Data), encoding)
+		}
+	}
+	if len(strings.TrimSpace(req.Raw)) == 0 {
+		return nil, ErrEmptyRawTx
+	}
+	if encoding == RawTxEncodingProto {
+		return nil, ErrInvalidRawTxEncoding
+	}
+	return decodeRawString(req.Raw, encoding)
+}
+
+// decodeRawString decode the hex or base64 string of the transaction. If the encoding isn't given,
+// hex is tried first, as a hex string is valid base64 too, then base64.
+func decodeRawString(raw string, encoding string) (*core.Transaction, error) {
+	raw = strings.TrimSpace(raw)
+	switch encoding {
+	case RawTxEncodingHex:
+		data, err := decodeRawHex(raw)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshalRawTransaction(data)
+	case RawTxEncodingBase64:
+		data, err := decodeRawBase64(raw)
+		if err != nil {
+			return nil, err
+		}
+		return unmarshalRawTransaction(data)
+	}
+
+	if data, err := decodeRawHex(raw); err == nil {
+		if tx, err := unmarshalRawTransaction(data); err == nil {
+			return tx, nil
+		}
+	}
+	if data, err := decodeRawBase64(raw); err == nil {
+		if tx, err := unmarshalRawTransaction(data); err == nil {
+			return tx, nil
+		}
+	}
+	return nil, ErrUndecodableRawTx
+}
+
+func decodeRawHex(raw string) ([]byte, error) {
+	if strings.HasPrefix(raw, "0x") || strings.HasPrefix(raw, "0X") {
+		raw = raw[2:]
+	}
+	data, err := hex.DecodeString(raw)
+	if err != nil || len(data) == 0 {
+		return nil, ErrInvalidRawTxHex
+	}
+	return data, nil
+}
+
+func decodeRawBase64(raw string) ([]byte, error) {
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if data, err := enc.DecodeString(raw); err == nil && len(data) > 0 {
+			return data, nil
+		}
+	}
+	return nil, ErrInvalidRawTxBase64
+}
+
+// unmarshalRawTransaction return the transaction of the protobuf bytes, its hash and signature are verified
+// when it's pushed to the pool.
+func unmarshalRawTransaction(data []byte) (*core.Transaction, error) {
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(data, pbTx); err != nil {
+		return nil, ErrInvalidRawTxProto
+	}
+	tx := new(core.Transaction)
+	if err := tx.FromProto(pbTx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// rawTransactionHandler turn the protobuf bodies posted to RawTransactionPath into the json of the gateway,
+// so the signed bytes can be sent as they are.
+func rawTransactionHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mediaType := strings.ToLower(strings.TrimSpace(strings.Split(r.Header.Get("Content-Type"), ";")[0]))
+		if r.URL.Path != RawTransactionPath || r.Method != "POST" || !rawTxMediaTypes[mediaType] {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxRawTxBodySize+1))
+		if err != nil {
+			writeHTTPError(w, http.StatusBadRequest, err)
+			return
+		}
+		if len(data) > maxRawTxBodySize {
+			writeHTTPError(w, http.StatusRequestEntityTooLarge, ErrInvalidRawTxProto)
+			return
+		}
+		if len(data) == 0 {
+			writeHTTPError(w, http.StatusBadRequest, ErrEmptyRawTx)
+			return
+		}
+		body, _ := json.Marshal(map[string]string{"data": base64.StdEncoding.EncodeToString(data), "encoding": RawTxEncodingProto})
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		r.Header.Set("Content-Type", "application/json")
+		h.ServeHTTP(w, r)
+	})
+}
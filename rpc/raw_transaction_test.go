@@ -0,0 +1,145 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockRawTransaction return a transaction and its protobuf bytes.
+func mockRawTransaction(t *testing.T) (*core.Transaction, []byte) {
+	pubdata, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	from, _ := core.NewAddressFromPublicKey(pubdata)
+	pubdata, _ = secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+	to, _ := core.NewAddressFromPublicKey(pubdata)
+	tx := core.NewTransaction(100, from, to, util.NewUint128FromInt(10), 3, core.TxPayloadBinaryType, []byte("data"), core.TransactionGasPrice, core.TransactionMaxGas)
+	pbTx, err := tx.ToProto()
+	assert.Nil(t, err)
+	data, err := proto.Marshal(pbTx)
+	assert.Nil(t, err)
+	return tx, data
+}
+
+func TestDecodeRawTransaction(t *testing.T) {
+	tx, data := mockRawTransaction(t)
+
+	tests := []struct {
+		name string
+		req  *rpcpb.SendRawTransactionRequest
+		err  error
+	}{
+		{"proto", &rpcpb.SendRawTransactionRequest{Data: data}, nil},
+		{"proto encoding", &rpcpb.SendRawTransactionRequest{Data: data, Encoding: "Proto"}, nil},
+		{"hex data", &rpcpb.SendRawTransactionRequest{Data: []byte(hex.EncodeToString(data)), Encoding: "hex"}, nil},
+		{"hex", &rpcpb.SendRawTransactionRequest{Raw: hex.EncodeToString(data)}, nil},
+		{"hex 0x", &rpcpb.SendRawTransactionRequest{Raw: "0x" + hex.EncodeToString(data), Encoding: "hex"}, nil},
+		{"base64", &rpcpb.SendRawTransactionRequest{Raw: base64.StdEncoding.EncodeToString(data)}, nil},
+		{"base64 url", &rpcpb.SendRawTransactionRequest{Raw: base64.URLEncoding.EncodeToString(data), Encoding: "base64"}, nil},
+		{"base64 raw url", &rpcpb.SendRawTransactionRequest{Raw: " " + base64.RawURLEncoding.EncodeToString(data) + "\n"}, nil},
+		{"empty", &rpcpb.SendRawTransactionRequest{}, ErrEmptyRawTx},
+		{"blank", &rpcpb.SendRawTransactionRequest{Raw: "  "}, ErrEmptyRawTx},
+		{"both", &rpcpb.SendRawTransactionRequest{Data: data, Raw: hex.EncodeToString(data)}, ErrRawTxBothData},
+		{"unknown encoding", &rpcpb.SendRawTransactionRequest{Raw: hex.EncodeToString(data), Encoding: "rlp"}, ErrInvalidRawTxEncoding},
+		{"proto string", &rpcpb.SendRawTransactionRequest{Raw: hex.EncodeToString(data), Encoding: "proto"}, ErrInvalidRawTxEncoding},
+		{"invalid hex", &rpcpb.SendRawTransactionRequest{Raw: "0xzz", Encoding: "hex"}, ErrInvalidRawTxHex},
+		{"invalid base64", &rpcpb.SendRawTransactionRequest{Raw: "!!", Encoding: "base64"}, ErrInvalidRawTxBase64},
+		{"invalid proto", &rpcpb.SendRawTransactionRequest{Data: []byte{0xff, 0xff}}, ErrInvalidRawTxProto},
+		{"undecodable", &rpcpb.SendRawTransactionRequest{Raw: "not a transaction"}, ErrUndecodableRawTx},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := decodeRawTransaction(tt.req)
+			assert.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+			assert.Equal(t, tx.From().String(), decoded.From().String())
+			assert.Equal(t, tx.To().String(), decoded.To().String())
+			assert.Equal(t, tx.Nonce(), decoded.Nonce())
+			assert.Equal(t, tx.Value().String(), decoded.Value().String())
+			assert.Equal(t, tx.Data(), decoded.Data())
+		})
+	}
+}
+
+func TestRawTransactionHandler(t *testing.T) {
+	_, data := mockRawTransaction(t)
+	var received *http.Request
+	var body []byte
+	h := rawTransactionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+
+	// the protobuf body is turned into the json of the gateway.
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", RawTransactionPath, bytes.NewReader(data))
+	r.Header.Set("Content-Type", "application/x-protobuf; charset=binary")
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, int64(len(body)), received.ContentLength)
+	req := new(rpcpb.SendRawTransactionRequest)
+	assert.Nil(t, json.Unmarshal(body, req))
+	assert.Equal(t, data, req.Data)
+	assert.Equal(t, RawTxEncodingProto, req.Encoding)
+	_, err := decodeRawTransaction(req)
+	assert.Nil(t, err)
+
+	// the json and the other paths go through.
+	raw := `{"data":"` + base64.StdEncoding.EncodeToString(data) + `"}`
+	r = httptest.NewRequest("POST", RawTransactionPath, strings.NewReader(raw))
+	r.Header.Set("Content-Type", "application/json")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, raw, string(body))
+	r = httptest.NewRequest("POST", "/v1/user/call", bytes.NewReader(data))
+	r.Header.Set("Content-Type", "application/octet-stream")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, data, body)
+
+	received = nil
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest("POST", RawTransactionPath, nil)
+	r.Header.Set("Content-Type", "application/octet-stream")
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Nil(t, received)
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest("POST", RawTransactionPath, bytes.NewReader(make([]byte, maxRawTxBodySize+1)))
+	r.Header.Set("Content-Type", "application/octet-stream")
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Nil(t, received)
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"path"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// relayMethods work without the world state, the only ones a relay node serves. A method added
+// to the services is refused by a relay until it's listed here.
+var relayMethods = map[string]bool{
+	// api
+	"GetNebState":        true,
+	"Health":             true,
+	"Ready":              true,
+	"NodeInfo":           true,
+	"BlockDump":          true,
+	"Accounts":           true,
+	"SendRawTransaction": true,
+	"GetBlock":           true,
+	"GetBlockByHash":     true,
+	"SubscribeNewBlock":  true,
+	"SubscribePendingTx": true,
+	"GetGasPrice":        true,
+	"NewFilter":          true,
+	"GetFilterChanges":   true,
+	"UninstallFilter":    true,
+	"GetSyncState":       true,
+
+	// admin
+	"NewAccount":             true,
+	"UnlockAccount":          true,
+	"LockAccount":            true,
+	"SignTransaction":        true,
+	"StatisticsNodeInfo":     true,
+	"ChangeNetworkID":        true,
+	"GetPeerScores":          true,
+	"GetPeerStats":           true,
+	"AddPeer":                true,
+	"RemovePeer":             true,
+	"GetPeers":               true,
+	"AddIPFilter":            true,
+	"RemoveIPFilter":         true,
+	"GetIPFilter":            true,
+	"GetPendingTransactions": true,
+	"FlushTransactionPool":   true,
+	"SetHead":                true,
+	"SetLogLevel":            true,
+	"GetConfig":              true,
+}
+
+// relayRefused return whether the method is refused on the node, a relay keeping no world state.
+func relayRefused(neblet Neblet, fullMethod string) bool {
+	return !relayMethods[path.Base(fullMethod)] && neblet.BlockChain().RelayMode()
+}
+
+// relayInterceptor refuse the unary methods needing the world state on a relay node.
+func relayInterceptor(neblet Neblet) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if relayRefused(neblet, info.FullMethod) {
+			return nil, core.ErrRelayNoState
+		}
+		return handler(ctx, req)
+	}
+}
+
+// relayStreamInterceptor refuse the streams needing the world state on a relay node, e.g. the events.
+func relayStreamInterceptor(neblet Neblet) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if relayRefused(neblet, info.FullMethod) {
+			return core.ErrRelayNoState
+		}
+		return handler(srv, ss)
+	}
+}
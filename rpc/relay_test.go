@@ -0,0 +1,76 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestRelayInterceptor(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	bc, err := core.NewBlockChain(&mockNeb{genesis: mockGenesisConf(), storage: stor, emitter: core.NewEventEmitter(1024)})
+	assert.Nil(t, err)
+	neb := &chainNeb{chain: bc}
+
+	unary := relayInterceptor(neb)
+	call := func(method string) error {
+		info := &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/" + method}
+		_, err := unary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+	stream := relayStreamInterceptor(neb)
+	subscribe := func(method string) error {
+		info := &grpc.StreamServerInfo{FullMethod: "/rpcpb.ApiService/" + method, IsServerStream: true}
+		return stream(nil, nil, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return nil
+		})
+	}
+
+	// a full node serves them all.
+	assert.Nil(t, call("GetAccountState"))
+	assert.Nil(t, subscribe("SubscribeEvents"))
+
+	assert.Nil(t, bc.SetRelayMode(nil))
+	assert.Nil(t, call("GetBlock"))
+	assert.Nil(t, call("SendRawTransaction"))
+	assert.Nil(t, subscribe("SubscribeNewBlock"))
+	assert.Nil(t, subscribe("SubscribePendingTx"))
+	assert.Equal(t, core.ErrRelayNoState, call("GetAccountState"))
+	assert.Equal(t, core.ErrRelayNoState, call("Call"))
+	assert.Equal(t, core.ErrRelayNoState, subscribe("Subscribe"))
+	assert.Equal(t, core.ErrRelayNoState, subscribe("SubscribeEvents"))
+	// a method not known to work without the state is refused.
+	assert.Equal(t, core.ErrRelayNoState, call("GetSomethingNew"))
+
+	// the filters of the events and logs, emitted by the execution, are refused too.
+	filters := newFilterManager(neb, 0, 0)
+	_, err = filters.install("client", &rpcpb.NewFilterRequest{Type: FilterTypeLog})
+	assert.Equal(t, core.ErrRelayNoState, err)
+	_, err = filters.install("client", &rpcpb.NewFilterRequest{Type: FilterTypeEvent, Topics: []string{"chain.transactionResult"}})
+	assert.Equal(t, core.ErrRelayNoState, err)
+}
@@ -20,9 +20,11 @@ package rpc
 
 import (
 	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	nsync "github.com/nebulasio/go-nebulas/sync"
 )
 
 // Neblet interface breaks cycle import dependency and hides unused services.
@@ -32,6 +34,8 @@ type Neblet interface {
 	AccountManager() *account.Manager
 	NetManager() p2p.Manager
 	EventEmitter() *core.EventEmitter
+	Consensus() consensus.Consensus
+	SyncManager() *nsync.Manager
 }
 
 // Server server interface for api & management etc.
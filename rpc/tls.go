@@ -0,0 +1,130 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"crypto/tls"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// TLSReloadInterval is how often the certificate files are checked for changes.
+const TLSReloadInterval = 10 * time.Second
+
+// errors
+var (
+	ErrInvalidTLSConfig = errors.New("tls_cert and tls_key must be given together")
+)
+
+// certReloader keep the certificate of the files, reloaded when they change,
+// so a renewed certificate is served without restarting the node.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+	checked time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certReloader) load() error {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.cert, r.modTime = &cert, modTime
+	return nil
+}
+
+func (r *certReloader) lastModified() (time.Time, error) {
+	var last time.Time
+	for _, v := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(v)
+		if err != nil {
+			return last, err
+		}
+		if info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+	return last, nil
+}
+
+// GetCertificate return the certificate, reloaded if the files changed. The old one is kept
+// if the new files are invalid, e.g. while they are being written.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checked) < TLSReloadInterval {
+		return r.cert, nil
+	}
+	r.checked = time.Now()
+	if modTime, err := r.lastModified(); err != nil || !modTime.After(r.modTime) {
+		return r.cert, nil
+	}
+	if err := r.load(); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"cert": r.certFile,
+			"key":  r.keyFile,
+			"err":  err,
+		}).Warn("Failed to reload the tls certificate, keep the old one.")
+		return r.cert, nil
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"cert": r.certFile,
+	}).Info("Reloaded the tls certificate.")
+	return r.cert, nil
+}
+
+// newTLSConfig return the tls config of the gateway, nil if it's served in plain http.
+func newTLSConfig(config *nebletpb.RPCConfig) (*tls.Config, error) {
+	if len(config.TlsCert) == 0 && len(config.TlsKey) == 0 {
+		return nil, nil
+	}
+	if len(config.TlsCert) == 0 || len(config.TlsKey) == 0 {
+		return nil, ErrInvalidTLSConfig
+	}
+	reloader, err := newCertReloader(config.TlsCert, config.TlsKey)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}, nil
+}
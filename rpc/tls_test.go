@@ -0,0 +1,127 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestCert write a self-signed certificate of the name and its key to the files.
+func writeTestCert(t *testing.T, certFile, keyFile, name string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+}
+
+// servedName return the common name of the certificate served by the tls config.
+func servedName(t *testing.T, config *tls.Config) string {
+	cert, err := config.GetCertificate(&tls.ClientHelloInfo{})
+	assert.Nil(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.Nil(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+
+	config, err := newTLSConfig(&nebletpb.RPCConfig{})
+	assert.Nil(t, err)
+	assert.Nil(t, config)
+	_, err = newTLSConfig(&nebletpb.RPCConfig{TlsCert: certFile})
+	assert.Equal(t, ErrInvalidTLSConfig, err)
+	_, err = newTLSConfig(&nebletpb.RPCConfig{TlsCert: certFile, TlsKey: keyFile})
+	assert.NotNil(t, err)
+
+	writeTestCert(t, certFile, keyFile, "a.nebulas.io")
+	config, err = newTLSConfig(&nebletpb.RPCConfig{TlsCert: certFile, TlsKey: keyFile})
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+	assert.Equal(t, "a.nebulas.io", servedName(t, config))
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeTestCert(t, certFile, keyFile, "a.nebulas.io")
+	r, err := newCertReloader(certFile, keyFile)
+	assert.Nil(t, err)
+	config := &tls.Config{GetCertificate: r.GetCertificate}
+	assert.Equal(t, "a.nebulas.io", servedName(t, config))
+
+	// the renewed files are only checked every TLSReloadInterval.
+	writeTestCert(t, certFile, keyFile, "b.nebulas.io")
+	later := time.Now().Add(time.Minute)
+	assert.Nil(t, os.Chtimes(certFile, later, later))
+	assert.Nil(t, os.Chtimes(keyFile, later, later))
+	assert.Equal(t, "a.nebulas.io", servedName(t, config))
+	r.checked = time.Now().Add(-TLSReloadInterval)
+	assert.Equal(t, "b.nebulas.io", servedName(t, config))
+
+	// the files being written are invalid, the old certificate is kept until they are whole.
+	assert.Nil(t, ioutil.WriteFile(keyFile, []byte("partial"), 0600))
+	later = later.Add(time.Minute)
+	assert.Nil(t, os.Chtimes(keyFile, later, later))
+	r.checked = time.Now().Add(-TLSReloadInterval)
+	assert.Equal(t, "b.nebulas.io", servedName(t, config))
+	writeTestCert(t, certFile, keyFile, "c.nebulas.io")
+	later = later.Add(time.Minute)
+	assert.Nil(t, os.Chtimes(certFile, later, later))
+	r.checked = time.Now().Add(-TLSReloadInterval)
+	assert.Equal(t, "c.nebulas.io", servedName(t, config))
+
+	// the files removed, the certificate is kept.
+	assert.Nil(t, os.Remove(certFile))
+	r.checked = time.Now().Add(-TLSReloadInterval)
+	assert.Equal(t, "c.nebulas.io", servedName(t, config))
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// api versions
+const (
+	APIVersion1 = "v1"
+	APIVersion2 = "v2"
+
+	// APIVersionHeader tells the version of the api serving the response.
+	APIVersionHeader = "X-Api-Version"
+)
+
+// errors
+var (
+	ErrUnsupportedAPIVersion = errors.New("api version not supported, use v1 or v2")
+	ErrInvalidSunset         = errors.New("v1_sunset must be an http date, e.g. Sat, 01 Aug 2026 00:00:00 GMT")
+)
+
+// newGatewayMuxes return the muxes of the v1 and v2 apis. They serve the same methods, the v2 one
+// with the breaking improvements of the responses: all the fields are returned, even if zero or empty,
+// e.g. the empty execute_error of a receipt or the empty next_cursor of the last page.
+func newGatewayMuxes() (*runtime.ServeMux, *runtime.ServeMux) {
+	v1 := runtime.NewServeMux(runtime.WithForwardResponseOption(healthStatus))
+	v2 := runtime.NewServeMux(
+		runtime.WithForwardResponseOption(healthStatus),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+	)
+	return v1, v2
+}
+
+// versionHandler serve the requests of the /v2/ paths by the v2 handler, as the same /v1/ ones,
+// and the others by the v1 handler. The v1 responses link their v2 successor, and tell the v1 api
+// is deprecated if its sunset is given.
+func versionHandler(v1 http.Handler, v2 http.Handler, sunset string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		switch {
+		case strings.HasPrefix(path, "/"+APIVersion2+"/"):
+			u := *r.URL
+			u.Path = "/" + APIVersion1 + strings.TrimPrefix(path, "/"+APIVersion2)
+			r2 := new(http.Request)
+			*r2 = *r
+			r2.URL = &u
+			w.Header().Set(APIVersionHeader, APIVersion2)
+			v2.ServeHTTP(w, r2)
+		case strings.HasPrefix(path, "/"+APIVersion1+"/"):
+			w.Header().Set(APIVersionHeader, APIVersion1)
+			w.Header().Set("Link", "</"+APIVersion2+strings.TrimPrefix(path, "/"+APIVersion1)+`>; rel="successor-version"`)
+			if len(sunset) > 0 {
+				w.Header().Set("Deprecation", "true")
+				w.Header().Set("Sunset", sunset)
+			}
+			v1.ServeHTTP(w, r)
+		case isVersionedPath(path):
+			writeHTTPError(w, http.StatusNotFound, ErrUnsupportedAPIVersion)
+		default:
+			v1.ServeHTTP(w, r)
+		}
+	})
+}
+
+// isVersionedPath return true if the path starts with a version, e.g. /v3/.
+func isVersionedPath(path string) bool {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	if len(parts) < 2 || len(parts[0]) < 2 || parts[0][0] != 'v' {
+		return false
+	}
+	for _, c := range parts[0][1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// v1Sunset return the sunset of the v1 api in the config, none if empty.
+func v1Sunset(sunset string) (string, error) {
+	if len(sunset) == 0 {
+		return "", nil
+	}
+	t, err := http.ParseTime(sunset)
+	if err != nil {
+		return "", ErrInvalidSunset
+	}
+	return t.UTC().Format(http.TimeFormat), nil
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionHandler(t *testing.T) {
+	var served, path string
+	handler := func(version string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served, path = version, r.URL.Path
+		})
+	}
+	request := func(h http.Handler, url string) *httptest.ResponseRecorder {
+		served, path = "", ""
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+		return w
+	}
+	h := versionHandler(handler(APIVersion1), handler(APIVersion2), "")
+
+	// the v2 paths are served by the v2 handler as the v1 ones.
+	w := request(h, "/v2/user/nebstate?height=1")
+	assert.Equal(t, APIVersion2, served)
+	assert.Equal(t, "/v1/user/nebstate", path)
+	assert.Equal(t, APIVersion2, w.Header().Get(APIVersionHeader))
+	assert.Empty(t, w.Header().Get("Link"))
+	assert.Empty(t, w.Header().Get("Deprecation"))
+
+	// the v1 responses link their successor, and aren't deprecated until a sunset is given.
+	w = request(h, "/v1/user/nebstate")
+	assert.Equal(t, APIVersion1, served)
+	assert.Equal(t, "/v1/user/nebstate", path)
+	assert.Equal(t, APIVersion1, w.Header().Get(APIVersionHeader))
+	assert.Equal(t, `</v2/user/nebstate>; rel="successor-version"`, w.Header().Get("Link"))
+	assert.Empty(t, w.Header().Get("Deprecation"))
+	assert.Empty(t, w.Header().Get("Sunset"))
+
+	sunset, err := v1Sunset("Sat, 01 Aug 2026 00:00:00 GMT")
+	assert.Nil(t, err)
+	h = versionHandler(handler(APIVersion1), handler(APIVersion2), sunset)
+	w = request(h, "/v1/admin/accounts")
+	assert.Equal(t, APIVersion1, served)
+	assert.Equal(t, "true", w.Header().Get("Deprecation"))
+	assert.Equal(t, "Sat, 01 Aug 2026 00:00:00 GMT", w.Header().Get("Sunset"))
+	w = request(h, "/v2/admin/accounts")
+	assert.Empty(t, w.Header().Get("Deprecation"))
+	assert.Empty(t, w.Header().Get("Sunset"))
+
+	// the other versions aren't served, the unversioned paths are left to v1, e.g. the probes.
+	w = request(h, "/v3/user/nebstate")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Empty(t, served)
+	request(h, "/health")
+	assert.Equal(t, APIVersion1, served)
+	assert.Equal(t, "/health", path)
+	request(h, "/version/1")
+	assert.Equal(t, APIVersion1, served)
+}
+
+func TestIsVersionedPath(t *testing.T) {
+	assert.True(t, isVersionedPath("/v1/user/nebstate"))
+	assert.True(t, isVersionedPath("/v10/user"))
+	assert.False(t, isVersionedPath("/v/user"))
+	assert.False(t, isVersionedPath("/v1"))
+	assert.False(t, isVersionedPath("/vx/user"))
+	assert.False(t, isVersionedPath("/version/1"))
+	assert.False(t, isVersionedPath("/health"))
+}
+
+func TestV1Sunset(t *testing.T) {
+	sunset, err := v1Sunset("")
+	assert.Nil(t, err)
+	assert.Empty(t, sunset)
+
+	// the date is normalized to the http format in GMT.
+	sunset, err = v1Sunset("Saturday, 01-Aug-26 00:00:00 GMT")
+	assert.Nil(t, err)
+	assert.Equal(t, "Sat, 01 Aug 2026 00:00:00 GMT", sunset)
+
+	_, err = v1Sunset("2026-08-01")
+	assert.Equal(t, ErrInvalidSunset, err)
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// OverlayStorage reads through to the base storage, and keeps the changes in memory,
+// which are discarded with it.
+type OverlayStorage struct {
+	base    Storage
+	data    *sync.Map
+	deleted *sync.Map
+}
+
+// NewOverlayStorage init a storage over the base
+func NewOverlayStorage(base Storage) *OverlayStorage {
+	return &OverlayStorage{
+		base:    base,
+		data:    new(sync.Map),
+		deleted: new(sync.Map),
+	}
+}
+
+// Get return value to the key in Storage
+func (db *OverlayStorage) Get(key []byte) ([]byte, error) {
+	hex := byteutils.Hex(key)
+	if entry, ok := db.data.Load(hex); ok {
+		return entry.([]byte), nil
+	}
+	if _, ok := db.deleted.Load(hex); ok {
+		return nil, ErrKeyNotFound
+	}
+	return db.base.Get(key)
+}
+
+// Put put the key-value entry to Storage
+func (db *OverlayStorage) Put(key []byte, value []byte) error {
+	hex := byteutils.Hex(key)
+	db.deleted.Delete(hex)
+	db.data.Store(hex, value)
+	return nil
+}
+
+// Del delete the key in Storage.
+func (db *OverlayStorage) Del(key []byte) error {
+	hex := byteutils.Hex(key)
+	db.data.Delete(hex)
+	db.deleted.Store(hex, true)
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlayStorage(t *testing.T) {
+	base, _ := NewMemoryStorage()
+	base.Put([]byte("key1"), []byte("value1"))
+	base.Put([]byte("key2"), []byte("value2"))
+
+	db := NewOverlayStorage(base)
+	value, err := db.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	assert.Nil(t, db.Put([]byte("key1"), []byte("changed")))
+	assert.Nil(t, db.Del([]byte("key2")))
+	assert.Nil(t, db.Put([]byte("key3"), []byte("value3")))
+
+	value, _ = db.Get([]byte("key1"))
+	assert.Equal(t, []byte("changed"), value)
+	_, err = db.Get([]byte("key2"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	value, _ = db.Get([]byte("key3"))
+	assert.Equal(t, []byte("value3"), value)
+
+	// the base is unchanged.
+	value, _ = base.Get([]byte("key1"))
+	assert.Equal(t, []byte("value1"), value)
+	value, _ = base.Get([]byte("key2"))
+	assert.Equal(t, []byte("value2"), value)
+	_, err = base.Get([]byte("key3"))
+	assert.Equal(t, ErrKeyNotFound, err)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// ChunkRequest asks a peer for count blocks of its canonical chain from the height start.
+type ChunkRequest struct {
+	from  string
+	id    uint64
+	start uint64
+	count uint64
+}
+
+// ChunkBlocks is the blocks answering a ChunkRequest.
+type ChunkBlocks struct {
+	from   string
+	id     uint64
+	start  uint64
+	blocks []*core.Block
+}
+
+// NewChunkRequest return new ChunkRequest.
+func NewChunkRequest(from string, id uint64, start uint64, count uint64) *ChunkRequest {
+	return &ChunkRequest{from: from, id: id, start: start, count: count}
+}
+
+// NewChunkBlocks return new ChunkBlocks.
+func NewChunkBlocks(from string, id uint64, start uint64, blocks []*core.Block) *ChunkBlocks {
+	return &ChunkBlocks{from: from, id: id, start: start, blocks: blocks}
+}
+
+// ToProto converts domain ChunkRequest into proto ChunkRequest
+func (req *ChunkRequest) ToProto() (proto.Message, error) {
+	return &corepb.ChunkRequest{
+		From:  req.from,
+		Id:    req.id,
+		Start: req.start,
+		Count: req.count,
+	}, nil
+}
+
+// FromProto converts proto ChunkRequest to domain ChunkRequest
+func (req *ChunkRequest) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.ChunkRequest); ok {
+		req.from = msg.From
+		req.id = msg.Id
+		req.start = msg.Start
+		req.count = msg.Count
+		return nil
+	}
+	return errors.New("Pb Message cannot be converted into ChunkRequest")
+}
+
+// Blocks return blocks.
+func (cb *ChunkBlocks) Blocks() []*core.Block {
+	return cb.blocks
+}
+
+// ToProto converts domain ChunkBlocks into proto ChunkBlocks
+func (cb *ChunkBlocks) ToProto() (proto.Message, error) {
+	var result []*corepb.Block
+	for _, v := range cb.blocks {
+		block, err := v.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		if block, ok := block.(*corepb.Block); ok {
+			result = append(result, block)
+		} else {
+			return nil, errors.New("Pb Message cannot be converted into Block")
+		}
+	}
+	return &corepb.ChunkBlocks{
+		From:   cb.from,
+		Id:     cb.id,
+		Start:  cb.start,
+		Blocks: result,
+	}, nil
+}
+
+// FromProto converts proto ChunkBlocks to domain ChunkBlocks
+func (cb *ChunkBlocks) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.ChunkBlocks); ok {
+		cb.from = msg.From
+		cb.id = msg.Id
+		cb.start = msg.Start
+		for _, v := range msg.Blocks {
+			block := new(core.Block)
+			if err := block.FromProto(v); err != nil {
+				return err
+			}
+			cb.blocks = append(cb.blocks, block)
+		}
+		return nil
+	}
+	return errors.New("Pb Message cannot be converted into ChunkBlocks")
+}
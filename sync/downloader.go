@@ -0,0 +1,438 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// ChunkSize is the number of blocks requested in a chunk.
+	ChunkSize = 128
+	// MaxChunkSize is the most blocks served for a chunk request.
+	MaxChunkSize = 512
+	// ChunkedSyncDistance is how far behind the peers the node downloads the blocks in chunks.
+	ChunkedSyncDistance = 2 * ChunkSize
+	// ChunkWindow is the number of chunks downloaded ahead of the first one not verified yet.
+	ChunkWindow = 32
+	// MaxChunksPerPeer is the number of chunks requested from a peer at once.
+	MaxChunksPerPeer = 2
+	// ChunkTimeout is the time a peer has to deliver a chunk before it is reassigned.
+	ChunkTimeout = 20 * time.Second
+	// SlowChunkFactor is how many times the average delivery time the first chunk not verified
+	// waits for before it is requested from another peer too.
+	SlowChunkFactor = 3
+	// MaxChunkFailures is the number of failures after which a peer is not requested chunks any more.
+	MaxChunkFailures = 3
+)
+
+// errors
+var (
+	ErrNoChunkPeers = errors.New("no peer to download the chunks from")
+)
+
+// Metrics of the chunked download
+var (
+	chunkDeliveredCounter  = metrics.GetOrRegisterCounter("neb.sync.chunk.delivered", nil)
+	chunkFailedCounter     = metrics.GetOrRegisterCounter("neb.sync.chunk.failed", nil)
+	chunkReassignedCounter = metrics.GetOrRegisterCounter("neb.sync.chunk.reassigned", nil)
+)
+
+// chunk is a range of blocks downloaded from a peer.
+type chunk struct {
+	start uint64
+	count uint64
+	// key: the peers the chunk is requested from, value: when.
+	requests map[string]time.Time
+	blocks   []*core.Block
+	from     string
+}
+
+// chunkPeer is the download performance of a peer.
+type chunkPeer struct {
+	id        string
+	inflight  int
+	delivered int
+	failures  int
+	// the moving average of the time the peer takes to deliver a chunk.
+	latency time.Duration
+}
+
+func (p *chunkPeer) available() bool {
+	return p.failures < MaxChunkFailures && p.inflight < MaxChunksPerPeer
+}
+
+// better prefer the peers failing less, less busy and delivering faster,
+// the peers not measured yet first to measure them.
+func (p *chunkPeer) better(o *chunkPeer) bool {
+	if p.failures != o.failures {
+		return p.failures < o.failures
+	}
+	if p.inflight != o.inflight {
+		return p.inflight < o.inflight
+	}
+	return p.latency < o.latency
+}
+
+// downloader download the blocks after a tail in chunks requested from several peers at once,
+// and push them to the block pool in order, each chunk linked to the blocks before it.
+type downloader struct {
+	m      *Manager
+	id     uint64
+	parent *core.Block
+	chunks []*chunk
+	// the first chunk not verified.
+	next  int
+	peers map[string]*chunkPeer
+}
+
+func newDownloader(m *Manager, id uint64, tail *core.Block, target uint64, peers []string) *downloader {
+	d := &downloader{
+		m:      m,
+		id:     id,
+		parent: tail,
+		peers:  make(map[string]*chunkPeer),
+	}
+	for start := tail.Height() + 1; start <= target; start += ChunkSize {
+		count := uint64(ChunkSize)
+		if start+count-1 > target {
+			count = target - start + 1
+		}
+		d.chunks = append(d.chunks, &chunk{start: start, count: count, requests: make(map[string]time.Time)})
+	}
+	for _, id := range peers {
+		d.peers[id] = &chunkPeer{id: id}
+	}
+	return d
+}
+
+// run download all the chunks, return the last block pushed,
+// and ErrNoChunkPeers if no peer is left to download the rest from.
+func (d *downloader) run() (*core.Block, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for d.next < len(d.chunks) {
+		if !d.assign() {
+			return d.parent, ErrNoChunkPeers
+		}
+		select {
+		case cb := <-d.m.receiveChunkCh:
+			d.deliver(cb)
+			d.verify()
+		case <-ticker.C:
+			d.expire()
+		}
+	}
+	return d.parent, nil
+}
+
+// assign request the chunks in the window from the best available peers, and the first chunk not verified
+// from another peer too if it is slow, return false if no chunk is in flight any more.
+func (d *downloader) assign() bool {
+	inflight := false
+	for i := d.next; i < len(d.chunks) && i < d.next+ChunkWindow; i++ {
+		c := d.chunks[i]
+		if c.blocks != nil {
+			continue
+		}
+		if len(c.requests) == 0 || (i == d.next && d.slow(c)) {
+			if p := d.bestPeer(c); p != nil {
+				if len(c.requests) > 0 {
+					chunkReassignedCounter.Inc(1)
+				}
+				d.request(c, p)
+			}
+		}
+		if len(c.requests) > 0 {
+			inflight = true
+		}
+	}
+	return inflight
+}
+
+// slow return whether the chunk has waited for its only request longer than the peers usually take.
+func (d *downloader) slow(c *chunk) bool {
+	if len(c.requests) != 1 {
+		return false
+	}
+	var total time.Duration
+	count := 0
+	for _, p := range d.peers {
+		if p.delivered > 0 {
+			total += p.latency
+			count++
+		}
+	}
+	if count == 0 {
+		return false
+	}
+	for _, t := range c.requests {
+		return time.Since(t) > SlowChunkFactor*total/time.Duration(count)
+	}
+	return false
+}
+
+func (d *downloader) bestPeer(c *chunk) *chunkPeer {
+	var best *chunkPeer
+	for id, p := range d.peers {
+		if _, ok := c.requests[id]; ok || !p.available() {
+			continue
+		}
+		if best == nil || p.better(best) {
+			best = p
+		}
+	}
+	return best
+}
+
+func (d *downloader) request(c *chunk, p *chunkPeer) {
+	if err := d.m.sendBlocksRequest(net.MessageTypeGetChunk, d.id, p.id, c.start, c.count); err != nil {
+		// the peer can't be asked, it doesn't support the chunks or is gone.
+		logging.VLog().WithFields(logrus.Fields{
+			"peer": p.id,
+			"err":  err,
+		}).Debug("Failed to request a chunk.")
+		p.failures = MaxChunkFailures
+		return
+	}
+	c.requests[p.id] = time.Now()
+	p.inflight++
+}
+
+// deliver check the chunk received is the one requested and its blocks are linked.
+func (d *downloader) deliver(cb *ChunkBlocks) {
+	if cb.id != d.id {
+		return
+	}
+	var c *chunk
+	for i := d.next; i < len(d.chunks); i++ {
+		if d.chunks[i].start == cb.start {
+			c = d.chunks[i]
+			break
+		}
+	}
+	if c == nil {
+		return
+	}
+	requested, ok := c.requests[cb.from]
+	if !ok {
+		return
+	}
+	p := d.peers[cb.from]
+	delete(c.requests, cb.from)
+	p.inflight--
+
+	if !validChunk(c, cb.blocks) {
+		logging.VLog().WithFields(logrus.Fields{
+			"peer":  cb.from,
+			"start": c.start,
+			"count": len(cb.blocks),
+		}).Debug("Received an invalid chunk.")
+		d.fail(p, p2p.InvalidMessage)
+		return
+	}
+
+	elapsed := time.Since(requested)
+	if p.latency == 0 {
+		p.latency = elapsed
+	} else {
+		p.latency = (3*p.latency + elapsed) / 4
+	}
+	p.delivered++
+	if c.blocks != nil {
+		return
+	}
+	chunkDeliveredCounter.Inc(1)
+	d.m.progress.downloaded(len(cb.blocks))
+	c.blocks = cb.blocks
+	c.from = cb.from
+	// the other requests of the chunk are not waited for any more.
+	for id := range c.requests {
+		d.peers[id].inflight--
+		delete(c.requests, id)
+	}
+}
+
+func validChunk(c *chunk, blocks []*core.Block) bool {
+	if uint64(len(blocks)) != c.count {
+		return false
+	}
+	for i, block := range blocks {
+		if block.Height() != c.start+uint64(i) {
+			return false
+		}
+		if i > 0 && !block.ParentHash().Equals(blocks[i-1].Hash()) {
+			return false
+		}
+	}
+	return true
+}
+
+// verify push the chunks received to the block pool in order, a chunk not linked to the blocks before it
+// or refused by the pool is requested again from another peer.
+func (d *downloader) verify() {
+	for d.next < len(d.chunks) && d.chunks[d.next].blocks != nil {
+		c := d.chunks[d.next]
+		if !c.blocks[0].ParentHash().Equals(d.parent.Hash()) {
+			d.retry(c, p2p.UselessBlock)
+			return
+		}
+		for i, block := range c.blocks {
+			err := d.m.syncRelayDynasty(d.parent, block, []string{c.from})
+			if err == nil {
+				err = d.m.blockChain.BlockPool().Push(block)
+			}
+			if err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"block": block,
+					"peer":  c.from,
+					"err":   err,
+				}).Debug("Failed to push a downloaded block.")
+				// the blocks before are kept, the rest is requested again.
+				c.start += uint64(i)
+				c.count -= uint64(i)
+				d.retry(c, p2p.InvalidMessage)
+				return
+			}
+			d.parent = block
+			d.m.progress.verified(block.Height())
+		}
+		c.blocks = nil
+		d.next++
+	}
+}
+
+func (d *downloader) retry(c *chunk, misbehavior p2p.PeerMisbehavior) {
+	if p, ok := d.peers[c.from]; ok {
+		d.fail(p, misbehavior)
+	}
+	c.blocks = nil
+	c.from = ""
+	chunkReassignedCounter.Inc(1)
+}
+
+// expire give up the requests not answered in ChunkTimeout.
+func (d *downloader) expire() {
+	for i := d.next; i < len(d.chunks); i++ {
+		c := d.chunks[i]
+		for id, t := range c.requests {
+			if time.Since(t) > ChunkTimeout {
+				delete(c.requests, id)
+				d.peers[id].inflight--
+				d.fail(d.peers[id], p2p.Timeout)
+				chunkReassignedCounter.Inc(1)
+			}
+		}
+	}
+}
+
+func (d *downloader) fail(p *chunkPeer, misbehavior p2p.PeerMisbehavior) {
+	p.failures++
+	chunkFailedCounter.Inc(1)
+	d.m.ns.ReportPeer(p.id, misbehavior)
+}
+
+// downloadChunks download the blocks after the tail up to the target height from the peers in chunks,
+// return the new tail to continue the sync from.
+func (m *Manager) downloadChunks(tail *core.Block, target uint64, peers []string) *core.Block {
+	batch++
+	d := newDownloader(m, batch, tail, target, peers)
+	logging.VLog().WithFields(logrus.Fields{
+		"from":   tail.Height(),
+		"to":     target,
+		"chunks": len(d.chunks),
+		"peers":  peers,
+	}).Info("Started to download the blocks in chunks.")
+
+	newTail, err := d.run()
+	for _, p := range d.peers {
+		logging.VLog().WithFields(logrus.Fields{
+			"peer":      p.id,
+			"delivered": p.delivered,
+			"failures":  p.failures,
+			"latency":   p.latency,
+		}).Debug("Chunk download performance of the peer.")
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"tail": newTail.Height(),
+		"err":  err,
+	}).Info("Stopped downloading the blocks in chunks.")
+	return newTail
+}
+
+// handleGetChunk answer a chunk request with the blocks of the canonical chain.
+func (m *Manager) handleGetChunk(msg net.Message) {
+	req := new(ChunkRequest)
+	pbreq := new(corepb.ChunkRequest)
+	if err := pb.Unmarshal(msg.Data().([]byte), pbreq); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	if err := req.FromProto(pbreq); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	count := req.count
+	if count > MaxChunkSize {
+		count = MaxChunkSize
+	}
+	blocks := m.blockChain.FetchBlocksInCanonicalChain(req.start, int(count))
+	reply := NewChunkBlocks(m.ns.Node().ID(), req.id, req.start, blocks)
+	pbreply, err := reply.ToProto()
+	if err != nil {
+		return
+	}
+	data, err := pb.Marshal(pbreply)
+	if err != nil {
+		return
+	}
+	m.ns.SendMsg(net.MessageTypeChunk, data, msg.MessageFrom())
+}
+
+// handleChunk pass a chunk received to the download, it is dropped if no download waits for it.
+func (m *Manager) handleChunk(msg net.Message) {
+	cb := new(ChunkBlocks)
+	pbcb := new(corepb.ChunkBlocks)
+	if err := pb.Unmarshal(msg.Data().([]byte), pbcb); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	if err := cb.FromProto(pbcb); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	// the chunk is attributed to the peer sending it.
+	cb.from = msg.MessageFrom()
+	select {
+	case m.receiveChunkCh <- cb:
+	default:
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloader(t *testing.T) {
+	network := newTestNetwork(t)
+	local := network.newNode(t, "local")
+	seed := network.newNode(t, "seed")
+	blocks := seed.extend(t, 2*ChunkSize+10, 1)
+	good := network.newNode(t, "good")
+	good.copyChain(t, blocks)
+	truncating := network.newNode(t, "truncating")
+	truncating.copyChain(t, blocks)
+	truncating.truncate = true
+	mute := network.newNode(t, "mute")
+	mute.copyChain(t, blocks)
+	mute.mute = true
+
+	genesis := local.chain.TailBlock()
+	target := blocks[len(blocks)-1]
+	d := newDownloader(local.manager, 1, genesis, target.Height(), []string{"seed", "good", "truncating", "mute", "gone"})
+	assert.Equal(t, 3, len(d.chunks))
+	assert.Equal(t, uint64(10), d.chunks[2].count)
+
+	// the chunks of the peers not answering or failing are requested from the others.
+	tail, err := d.run()
+	assert.Nil(t, err)
+	assert.Equal(t, target.Hash(), tail.Hash())
+	for _, block := range blocks {
+		assert.NotNil(t, local.chain.GetBlock(block.Hash()))
+	}
+	assert.Equal(t, MaxChunkFailures, d.peers["gone"].failures)
+	assert.True(t, d.peers["truncating"].failures > 0)
+	assert.Contains(t, local.reports["truncating"], p2p.InvalidMessage)
+	assert.Equal(t, 0, d.peers["seed"].failures)
+	assert.Equal(t, 0, d.peers["good"].failures)
+	assert.True(t, d.peers["seed"].delivered+d.peers["good"].delivered >= len(d.chunks))
+	assert.Equal(t, 0, d.peers["mute"].delivered)
+
+	// no peer is left to download from.
+	other := network.newNode(t, "other")
+	d = newDownloader(other.manager, 2, other.chain.TailBlock(), target.Height(), []string{"truncating", "gone"})
+	tail, err = d.run()
+	assert.Equal(t, ErrNoChunkPeers, err)
+	assert.Equal(t, genesis.Hash(), tail.Hash())
+}
+
+func TestDownloader_Expire(t *testing.T) {
+	network := newTestNetwork(t)
+	local := network.newNode(t, "local")
+	mute := network.newNode(t, "mute")
+	blocks := mute.extend(t, 5, 1)
+	mute.mute = true
+
+	d := newDownloader(local.manager, 1, local.chain.TailBlock(), blocks[len(blocks)-1].Height(), []string{"mute"})
+	assert.True(t, d.assign())
+	c := d.chunks[0]
+	assert.Equal(t, 1, len(c.requests))
+	assert.Equal(t, 1, d.peers["mute"].inflight)
+
+	// a request not answered in time is given up, and the peer penalized.
+	d.expire()
+	assert.Equal(t, 1, len(c.requests))
+	c.requests["mute"] = time.Now().Add(-ChunkTimeout - time.Second)
+	d.expire()
+	assert.Equal(t, 0, len(c.requests))
+	assert.Equal(t, 0, d.peers["mute"].inflight)
+	assert.Equal(t, 1, d.peers["mute"].failures)
+	assert.Equal(t, []p2p.PeerMisbehavior{p2p.Timeout}, local.reports["mute"])
+
+	// the chunk is requested again until the peer failed too many times.
+	for i := 1; i < MaxChunkFailures; i++ {
+		assert.True(t, d.assign())
+		c.requests["mute"] = time.Now().Add(-ChunkTimeout - time.Second)
+		d.expire()
+	}
+	assert.False(t, d.assign())
+}
+
+func TestDownloader_Verify(t *testing.T) {
+	network := newTestNetwork(t)
+	local := network.newNode(t, "local")
+	seed := network.newNode(t, "seed")
+	blocks := seed.extend(t, 5, 1)
+	fork := network.newNode(t, "fork")
+	forked := fork.extend(t, 5, 2)
+
+	d := newDownloader(local.manager, 1, local.chain.TailBlock(), blocks[len(blocks)-1].Height(), []string{"seed", "fork"})
+	c := d.chunks[0]
+
+	// a chunk not linked to the blocks before it is given up, and requested again.
+	c.blocks, c.from = forked[1:], "fork"
+	d.verify()
+	assert.Nil(t, c.blocks)
+	assert.Equal(t, 0, d.next)
+	assert.Equal(t, 1, d.peers["fork"].failures)
+	assert.Equal(t, []p2p.PeerMisbehavior{p2p.UselessBlock}, local.reports["fork"])
+
+	// the blocks are pushed in order once linked.
+	c.blocks, c.from = blocks, "seed"
+	d.verify()
+	assert.Equal(t, 1, d.next)
+	assert.Equal(t, blocks[len(blocks)-1].Hash(), d.parent.Hash())
+	assert.NotNil(t, local.chain.GetBlock(d.parent.Hash()))
+}
@@ -0,0 +1,380 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// sync modes
+const (
+	SyncModeFull = "full"
+	SyncModeFast = "fast"
+)
+
+// const
+const (
+	// MinFastSyncDistance is how far behind the peers the node syncs fast, it replays the blocks when closer.
+	MinFastSyncDistance = 1024
+	// MaxHeadersPerRequest is the most headers requested from or served to a peer at once.
+	MaxHeadersPerRequest = 512
+	// HeadersTimeout is the time a peer has to deliver the headers or the block requested.
+	HeadersTimeout = 15 * time.Second
+)
+
+// errors
+var (
+	ErrInvalidSyncMode    = errors.New("invalid sync mode, expect full or fast")
+	ErrInvalidCheckpoint  = errors.New("invalid checkpoint, expect height:hash")
+	ErrCheckpointMismatch = errors.New("the chain doesn't pass the checkpoint")
+	ErrPivotNotFound      = errors.New("no peer delivered the pivot block")
+	ErrFastSyncCheckpoint = errors.New("fast sync needs at least one trusted checkpoint")
+	ErrNoPivotCheckpoint  = errors.New("no trusted checkpoint after the tail reached by the peers")
+)
+
+// ParseCheckpoints parse the checkpoints of the config, as "height:hash".
+func ParseCheckpoints(checkpoints []string) (map[uint64]byteutils.Hash, error) {
+	result := make(map[uint64]byteutils.Hash)
+	for _, v := range checkpoints {
+		parts := strings.Split(v, ":")
+		if len(parts) != 2 {
+			return nil, ErrInvalidCheckpoint
+		}
+		height, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, ErrInvalidCheckpoint
+		}
+		hash, err := byteutils.FromHex(parts[1])
+		if err != nil || len(hash) == 0 {
+			return nil, ErrInvalidCheckpoint
+		}
+		result[height] = hash
+	}
+	return result, nil
+}
+
+// SetSyncMode set how the node syncs when it is far behind its peers: SyncModeFull replays every block,
+// SyncModeFast downloads the headers up to a recent block, checked against the checkpoints, then the state
+// of that block, and replays the blocks after it only. The fast sync needs a trusted checkpoint.
+func (m *Manager) SetSyncMode(mode string, checkpoints []string) error {
+	cps, err := ParseCheckpoints(checkpoints)
+	if err != nil {
+		return err
+	}
+	switch mode {
+	case "", SyncModeFull:
+		m.fastSync = false
+	case SyncModeFast:
+		if len(cps) == 0 {
+			return ErrFastSyncCheckpoint
+		}
+		m.fastSync = true
+	default:
+		return ErrInvalidSyncMode
+	}
+	m.checkpoints = cps
+	return nil
+}
+
+// pivotCheckpoint return the highest checkpoint after the tail the peers have reached, the block whose state is
+// downloaded, so the state is the one of a trusted block.
+func (m *Manager) pivotCheckpoint(tail *core.Block, target uint64) (uint64, error) {
+	pivot := uint64(0)
+	for height := range m.checkpoints {
+		if height > tail.Height() && height <= target && height > pivot {
+			pivot = height
+		}
+	}
+	if pivot == 0 {
+		return 0, ErrNoPivotCheckpoint
+	}
+	return pivot, nil
+}
+
+// syncFast download the headers from the tail up to the pivot, the last checkpoint below the target, then the pivot
+// block and its state, and make it the tail. If it fails, the fast sync is given up for the full sync, and the tail is returned.
+func (m *Manager) syncFast(tail *core.Block, target uint64, peers []string) *core.Block {
+	pivot, err := m.pivotCheckpoint(tail, target)
+	logging.VLog().WithFields(logrus.Fields{
+		"tail":  tail.Height(),
+		"pivot": pivot,
+		"peers": peers,
+	}).Info("Started to sync fast.")
+
+	m.progress.setStage(StageHeaders)
+	var block *core.Block
+	if err == nil {
+		block, err = m.fetchPivot(tail, pivot, peers)
+	}
+	if err == nil {
+		m.progress.setStage(StageState)
+		err = m.SyncState(block, peers)
+	}
+	if err == nil {
+		err = m.blockChain.InstallSyncedBlock(block)
+	}
+	// the fast sync is done once, the blocks after the pivot are replayed.
+	m.fastSync = false
+	m.progress.setStage(StageBlocks)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to sync fast, fall back to the full sync.")
+		return tail
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"pivot": block,
+	}).Info("Synced fast.")
+	return m.blockChain.TailBlock()
+}
+
+// fetchPivot download the headers after the tail up to the pivot, then the pivot block matching its header.
+func (m *Manager) fetchPivot(tail *core.Block, pivot uint64, peers []string) (*core.Block, error) {
+	header, err := m.downloadHeaders(tail, pivot, peers)
+	if err != nil {
+		return nil, err
+	}
+	batch++
+	id := batch
+	for _, pid := range peers {
+		if err := m.sendBlocksRequest(net.MessageTypeGetChunk, id, pid, pivot, 1); err != nil {
+			continue
+		}
+		select {
+		case cb := <-m.receiveChunkCh:
+			if cb.id != id || cb.from != pid || len(cb.blocks) != 1 {
+				continue
+			}
+			block := cb.blocks[0]
+			if err := m.verifyPivot(block, header); err != nil {
+				m.ns.ReportPeer(pid, p2p.InvalidMessage)
+				continue
+			}
+			return block, nil
+		case <-time.After(HeadersTimeout):
+			m.ns.ReportPeer(pid, p2p.Timeout)
+		}
+	}
+	return nil, ErrPivotNotFound
+}
+
+// verifyPivot check the block is the one of the header, and its transactions are the ones it was hashed with.
+func (m *Manager) verifyPivot(block *core.Block, header *core.Header) error {
+	if !block.Hash().Equals(header.Hash()) {
+		return core.ErrInvalidBlockHash
+	}
+	if !core.HashBlock(block).Equals(block.Hash()) {
+		return core.ErrInvalidBlockHash
+	}
+	for _, tx := range block.Transactions() {
+		if err := tx.VerifyIntegrity(m.blockChain.ChainID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// downloadHeaders download the headers after the tail up to the height from the peers in turn, each signed by
+// the proposer of its slot in the dynasty inherited from the one before it and matching the checkpoints,
+// and return the last one.
+func (m *Manager) downloadHeaders(tail *core.Block, height uint64, peers []string) (*core.Header, error) {
+	batch++
+	id := batch
+	verifier := core.NewHeaderVerifier(tail)
+	next := tail.Height() + 1
+	var last *core.Header
+
+	failures := make(map[string]int)
+	for i := 0; next <= height; i++ {
+		pid := ""
+		for j := 0; j < len(peers); j++ {
+			if p := peers[(i+j)%len(peers)]; failures[p] < MaxChunkFailures {
+				pid = p
+				break
+			}
+		}
+		if pid == "" {
+			return nil, ErrNoChunkPeers
+		}
+
+		count := height - next + 1
+		if count > MaxHeadersPerRequest {
+			count = MaxHeadersPerRequest
+		}
+		if err := m.sendBlocksRequest(net.MessageTypeGetHeaders, id, pid, next, count); err != nil {
+			failures[pid] = MaxChunkFailures
+			continue
+		}
+
+		var headers []*core.Header
+		select {
+		case hs := <-m.receiveHeadersCh:
+			if hs.id != id || hs.from != pid || hs.start != next {
+				failures[pid]++
+				continue
+			}
+			headers = hs.headers
+		case <-time.After(HeadersTimeout):
+			failures[pid]++
+			m.ns.ReportPeer(pid, p2p.Timeout)
+			continue
+		}
+
+		if uint64(len(headers)) != count {
+			failures[pid]++
+			m.ns.ReportPeer(pid, p2p.UselessBlock)
+			continue
+		}
+		start, valid := *verifier, true
+		for k, header := range headers {
+			if err := m.syncDynasty(verifier, header, peers); err != nil {
+				return nil, err
+			}
+			// a header skipping a dynasty is refused too, the sync falls back to the full one if all peers serve it.
+			if err := verifier.Verify(header); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"height": next + uint64(k),
+					"peer":   pid,
+					"err":    err,
+				}).Debug("Received an invalid header.")
+				valid = false
+				break
+			}
+			if checkpoint, ok := m.checkpoints[next+uint64(k)]; ok && !checkpoint.Equals(header.Hash()) {
+				logging.VLog().WithFields(logrus.Fields{
+					"height": next + uint64(k),
+					"expect": checkpoint,
+					"actual": header.Hash(),
+					"peer":   pid,
+				}).Error("The chain doesn't pass the checkpoint.")
+				return nil, ErrCheckpointMismatch
+			}
+		}
+		if !valid {
+			// the headers before the invalid one are requested again too.
+			*verifier = start
+			failures[pid]++
+			m.ns.ReportPeer(pid, p2p.InvalidMessage)
+			continue
+		}
+		last = headers[len(headers)-1]
+		next += count
+	}
+	return last, nil
+}
+
+// syncDynasty download the dynasty proposing the header if it's not in the storage yet. Its root is the one
+// of the verified parent, so a peer can't make the header verified with a dynasty of its own.
+func (m *Manager) syncDynasty(verifier *core.HeaderVerifier, header *core.Header, peers []string) error {
+	root, err := verifier.DynastyRoot(header)
+	if err != nil || len(root) == 0 {
+		// the header is refused by the verifier.
+		return nil
+	}
+	if _, err := m.blockChain.Storage().Get(root); err == nil {
+		return nil
+	}
+	syncer := trie.NewSyncer(m.blockChain.Storage())
+	syncer.AddRoot(root, nil)
+	return m.syncTries(syncer, peers)
+}
+
+// syncRelayDynasty download the dynasty proposing the block on a relay, which keeps no state to elect the
+// dynasties, so the block can be verified against it when it's linked to its parent.
+func (m *Manager) syncRelayDynasty(parent *core.Block, block *core.Block, peers []string) error {
+	if !m.blockChain.RelayMode() || parent == nil {
+		return nil
+	}
+	return m.syncDynasty(core.NewHeaderVerifier(parent), core.NewHeader(block), peers)
+}
+
+func (m *Manager) sendBlocksRequest(msgName string, id uint64, pid string, start uint64, count uint64) error {
+	req := NewChunkRequest(m.ns.Node().ID(), id, start, count)
+	msg, _ := req.ToProto()
+	data, err := pb.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return m.ns.SendMsg(msgName, data, pid)
+}
+
+// handleGetHeaders answer a headers request with the headers of the canonical chain.
+func (m *Manager) handleGetHeaders(msg net.Message) {
+	req := new(ChunkRequest)
+	pbreq := new(corepb.ChunkRequest)
+	if err := pb.Unmarshal(msg.Data().([]byte), pbreq); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	if err := req.FromProto(pbreq); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	count := req.count
+	if count > MaxHeadersPerRequest {
+		count = MaxHeadersPerRequest
+	}
+	var headers []*core.Header
+	for _, block := range m.blockChain.FetchBlocksInCanonicalChain(req.start, int(count)) {
+		headers = append(headers, core.NewHeader(block))
+	}
+	reply := NewHeaders(m.ns.Node().ID(), req.id, req.start, headers)
+	pbreply, err := reply.ToProto()
+	if err != nil {
+		return
+	}
+	data, err := pb.Marshal(pbreply)
+	if err != nil {
+		return
+	}
+	m.ns.SendMsg(net.MessageTypeHeaders, data, msg.MessageFrom())
+}
+
+// handleHeaders pass the headers received to the fast sync, they are dropped if no sync waits for them.
+func (m *Manager) handleHeaders(msg net.Message) {
+	hs := new(Headers)
+	pbhs := new(corepb.Headers)
+	if err := pb.Unmarshal(msg.Data().([]byte), pbhs); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	if err := hs.FromProto(pbhs); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	// the headers are attributed to the peer sending them.
+	hs.from = msg.MessageFrom()
+	select {
+	case m.receiveHeadersCh <- hs:
+	default:
+	}
+}
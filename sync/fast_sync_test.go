@@ -0,0 +1,110 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSyncMode(t *testing.T) {
+	network := newTestNetwork(t)
+	m := network.newNode(t, "local").manager
+
+	assert.Equal(t, ErrInvalidSyncMode, m.SetSyncMode("light", nil))
+	assert.Equal(t, ErrFastSyncCheckpoint, m.SetSyncMode(SyncModeFast, nil))
+	assert.Equal(t, ErrInvalidCheckpoint, m.SetSyncMode(SyncModeFast, []string{"10"}))
+	assert.Equal(t, ErrInvalidCheckpoint, m.SetSyncMode(SyncModeFast, []string{"ten:aa"}))
+	assert.Equal(t, ErrInvalidCheckpoint, m.SetSyncMode(SyncModeFast, []string{"10:"}))
+	assert.False(t, m.fastSync)
+
+	assert.Nil(t, m.SetSyncMode(SyncModeFast, []string{"10:aa", "20:bb", "30:cc"}))
+	assert.True(t, m.fastSync)
+	tail := m.blockChain.TailBlock()
+	pivot, err := m.pivotCheckpoint(tail, 25)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(20), pivot)
+	_, err = m.pivotCheckpoint(tail, 5)
+	assert.Equal(t, ErrNoPivotCheckpoint, err)
+
+	assert.Nil(t, m.SetSyncMode(SyncModeFull, nil))
+	assert.False(t, m.fastSync)
+}
+
+func TestSyncFast(t *testing.T) {
+	network := newTestNetwork(t)
+	seed := network.newNode(t, "seed")
+	blocks := seed.extend(t, 20, 1)
+	target := blocks[len(blocks)-1]
+	pivot := blocks[14]
+	// the impostor serves a chain of the same height, signed by a key out of the dynasty.
+	impostor := network.newNode(t, "impostor")
+	impostor.impostor = secp256k1.GeneratePrivateKey()
+	impostor.extend(t, 20, 1)
+
+	// the headers and the pivot of the impostor are refused, the ones of the seed are verified.
+	local := network.newNode(t, "local")
+	genesis := local.chain.TailBlock()
+	assert.Nil(t, local.manager.SetSyncMode(SyncModeFast, []string{fmt.Sprintf("%d:%s", pivot.Height(), pivot.Hash())}))
+	tail := local.manager.syncFast(genesis, target.Height(), []string{"impostor", "seed"})
+	assert.Equal(t, pivot.Hash(), tail.Hash())
+	assert.Equal(t, pivot.Hash(), local.chain.TailBlock().Hash())
+	_, err := local.chain.Storage().Get(pivot.StateRoot())
+	assert.Nil(t, err)
+	assert.False(t, local.manager.fastSync)
+	assert.Contains(t, local.reports["impostor"], p2p.InvalidMessage)
+	assert.NotContains(t, local.reports["seed"], p2p.InvalidMessage)
+
+	// the chain of the peers doesn't pass the checkpoint, the full sync continues from the tail.
+	other := network.newNode(t, "other")
+	assert.Nil(t, other.manager.SetSyncMode(SyncModeFast, []string{fmt.Sprintf("%d:%s", pivot.Height(), blocks[13].Hash())}))
+	tail = other.manager.syncFast(genesis, target.Height(), []string{"seed"})
+	assert.Equal(t, genesis.Hash(), tail.Hash())
+	assert.Equal(t, genesis.Hash(), other.chain.TailBlock().Hash())
+	assert.False(t, other.manager.fastSync)
+}
+
+func TestVerifyPivot(t *testing.T) {
+	network := newTestNetwork(t)
+	seed := network.newNode(t, "seed")
+	blocks := seed.extend(t, 5, 1)
+	pivot := blocks[3]
+	header := core.NewHeader(pivot)
+	m := network.newNode(t, "local").manager
+
+	assert.Nil(t, m.verifyPivot(pivot, header))
+	assert.Equal(t, core.ErrInvalidBlockHash, m.verifyPivot(blocks[2], header))
+
+	// the block claims the hash of the header, its content doesn't match it.
+	msg, err := pivot.ToProto()
+	assert.Nil(t, err)
+	pbBlock := proto.Clone(msg).(*corepb.Block)
+	pbBlock.Header.Timestamp++
+	tampered := new(core.Block)
+	assert.Nil(t, tampered.FromProto(pbBlock))
+	assert.Equal(t, header.Hash(), tampered.Hash())
+	assert.Equal(t, core.ErrInvalidBlockHash, m.verifyPivot(tampered, header))
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// Headers is the block headers answering a headers request, a ChunkRequest.
+type Headers struct {
+	from    string
+	id      uint64
+	start   uint64
+	headers []*core.Header
+}
+
+// NewHeaders return new Headers.
+func NewHeaders(from string, id uint64, start uint64, headers []*core.Header) *Headers {
+	return &Headers{from: from, id: id, start: start, headers: headers}
+}
+
+// ToProto converts domain Headers into proto Headers
+func (hs *Headers) ToProto() (proto.Message, error) {
+	var result []*corepb.SignedHeader
+	for _, v := range hs.headers {
+		header, err := v.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		if header, ok := header.(*corepb.SignedHeader); ok {
+			result = append(result, header)
+		} else {
+			return nil, errors.New("Pb Message cannot be converted into SignedHeader")
+		}
+	}
+	return &corepb.Headers{
+		From:    hs.from,
+		Id:      hs.id,
+		Start:   hs.start,
+		Headers: result,
+	}, nil
+}
+
+// FromProto converts proto Headers to domain Headers
+func (hs *Headers) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.Headers); ok {
+		hs.from = msg.From
+		hs.id = msg.Id
+		hs.start = msg.Start
+		for _, v := range msg.Headers {
+			header := new(core.Header)
+			if err := header.FromProto(v); err != nil {
+				return err
+			}
+			hs.headers = append(hs.headers, header)
+		}
+		return nil
+	}
+	return errors.New("Pb Message cannot be converted into Headers")
+}
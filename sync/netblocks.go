@@ -31,6 +31,8 @@ type NetBlocks struct {
 	from   string
 	batch  uint64
 	blocks []*core.Block
+	// the height of the sender's tail, 0 if unknown.
+	tailHeight uint64
 }
 
 // NetBlock structure
@@ -62,6 +64,11 @@ func (nbs *NetBlocks) Batch() uint64 {
 	return nbs.batch
 }
 
+// TailHeight return the height of the sender's tail.
+func (nbs *NetBlocks) TailHeight() uint64 {
+	return nbs.tailHeight
+}
+
 // ToProto converts domain Blocks into proto Blocks
 func (nbs *NetBlocks) ToProto() (proto.Message, error) {
 	var result []*corepb.Block
@@ -77,9 +84,10 @@ func (nbs *NetBlocks) ToProto() (proto.Message, error) {
 		}
 	}
 	return &corepb.NetBlocks{
-		From:   nbs.from,
-		Batch:  nbs.batch,
-		Blocks: result,
+		From:       nbs.from,
+		Batch:      nbs.batch,
+		Blocks:     result,
+		TailHeight: nbs.tailHeight,
 	}, nil
 }
 
@@ -88,6 +96,7 @@ func (nbs *NetBlocks) FromProto(msg proto.Message) error {
 	if msg, ok := msg.(*corepb.NetBlocks); ok {
 		nbs.from = msg.From
 		nbs.batch = msg.Batch
+		nbs.tailHeight = msg.TailHeight
 		for _, v := range msg.Blocks {
 			block := new(core.Block)
 			if err := block.FromProto(v); err != nil {
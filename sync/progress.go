@@ -0,0 +1,147 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	gosync "sync"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// sync stages
+const (
+	StageIdle    = "idle"
+	StageBlocks  = "blocks"
+	StageHeaders = "headers"
+	StageState   = "state"
+)
+
+var (
+	syncStartingHeightGauge = metrics.GetOrRegisterGauge("neb.sync.progress.starting", nil)
+	syncCurrentHeightGauge  = metrics.GetOrRegisterGauge("neb.sync.progress.current", nil)
+	syncHighestHeightGauge  = metrics.GetOrRegisterGauge("neb.sync.progress.highest", nil)
+	syncDownloadedMeter     = metrics.GetOrRegisterMeter("neb.sync.progress.downloaded", nil)
+	syncVerifiedMeter       = metrics.GetOrRegisterMeter("neb.sync.progress.verified", nil)
+)
+
+// Progress is the state of the sync.
+type Progress struct {
+	Syncing        bool
+	Stage          string
+	StartingHeight uint64
+	CurrentHeight  uint64
+	HighestHeight  uint64
+	// blocks downloaded and verified per second over the last minute.
+	DownloadRate float64
+	VerifyRate   float64
+	// the time left to reach the highest height at the verify rate, 0 if unknown.
+	ETA time.Duration
+}
+
+// progress tracks the sync, it is updated by the sync loop and read by the rpc.
+type progress struct {
+	mu             gosync.RWMutex
+	syncing        bool
+	stage          string
+	startingHeight uint64
+	highestHeight  uint64
+}
+
+func newProgress() *progress {
+	return &progress{stage: StageIdle}
+}
+
+func (p *progress) start(height uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.syncing = true
+	p.stage = StageBlocks
+	p.startingHeight = height
+	if p.highestHeight < height {
+		p.highestHeight = height
+	}
+	syncStartingHeightGauge.Update(int64(height))
+	syncCurrentHeightGauge.Update(int64(height))
+	syncHighestHeightGauge.Update(int64(p.highestHeight))
+}
+
+func (p *progress) finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.syncing = false
+	p.stage = StageIdle
+}
+
+func (p *progress) setStage(stage string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stage = stage
+}
+
+// observe record a height reached by a peer.
+func (p *progress) observe(height uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if height > p.highestHeight {
+		p.highestHeight = height
+		syncHighestHeightGauge.Update(int64(height))
+	}
+}
+
+func (p *progress) downloaded(count int) {
+	syncDownloadedMeter.Mark(int64(count))
+}
+
+func (p *progress) verified(height uint64) {
+	syncVerifiedMeter.Mark(1)
+	syncCurrentHeightGauge.Update(int64(height))
+}
+
+func (p *progress) snapshot(current uint64) *Progress {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	result := &Progress{
+		Syncing:        p.syncing,
+		Stage:          p.stage,
+		StartingHeight: p.startingHeight,
+		CurrentHeight:  current,
+		HighestHeight:  p.highestHeight,
+		DownloadRate:   syncDownloadedMeter.Rate1(),
+		VerifyRate:     syncVerifiedMeter.Rate1(),
+	}
+	if result.HighestHeight < current {
+		result.HighestHeight = current
+	}
+	if p.syncing && result.VerifyRate > 0 {
+		left := float64(result.HighestHeight - current)
+		result.ETA = time.Duration(left / result.VerifyRate * float64(time.Second))
+	}
+	return result
+}
+
+// Progress return the state of the sync.
+func (m *Manager) Progress() *Progress {
+	return m.progress.snapshot(m.blockChain.TailBlock().Height())
+}
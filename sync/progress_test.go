@@ -0,0 +1,87 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress(t *testing.T) {
+	p := newProgress()
+	s := p.snapshot(1)
+	assert.False(t, s.Syncing)
+	assert.Equal(t, StageIdle, s.Stage)
+	assert.Equal(t, uint64(1), s.HighestHeight)
+
+	p.start(10)
+	p.observe(100)
+	p.observe(50)
+	p.setStage(StageHeaders)
+	s = p.snapshot(20)
+	assert.True(t, s.Syncing)
+	assert.Equal(t, StageHeaders, s.Stage)
+	assert.Equal(t, uint64(10), s.StartingHeight)
+	assert.Equal(t, uint64(20), s.CurrentHeight)
+	assert.Equal(t, uint64(100), s.HighestHeight)
+	assert.Equal(t, int64(10), syncStartingHeightGauge.Value())
+	assert.Equal(t, int64(100), syncHighestHeightGauge.Value())
+
+	p.verified(21)
+	assert.Equal(t, int64(21), syncCurrentHeightGauge.Value())
+
+	// the current height is never reported above the highest one.
+	s = p.snapshot(120)
+	assert.Equal(t, uint64(120), s.HighestHeight)
+
+	p.finish()
+	s = p.snapshot(120)
+	assert.False(t, s.Syncing)
+	assert.Equal(t, StageIdle, s.Stage)
+	assert.Equal(t, int64(0), int64(s.ETA))
+}
+
+func TestManager_Progress(t *testing.T) {
+	network := newTestNetwork(t)
+	seed := network.newNode(t, "seed")
+	blocks := seed.extend(t, 10, 1)
+	target := blocks[len(blocks)-1]
+
+	local := network.newNode(t, "local")
+	genesis := local.chain.TailBlock()
+	local.manager.progress.start(genesis.Height())
+	local.manager.progress.observe(target.Height())
+	s := local.manager.Progress()
+	assert.True(t, s.Syncing)
+	assert.Equal(t, genesis.Height(), s.CurrentHeight)
+	assert.Equal(t, target.Height(), s.HighestHeight)
+
+	// the blocks verified by the download are reported as they are pushed.
+	tail := local.manager.downloadChunks(genesis, target.Height(), []string{"seed"})
+	assert.Equal(t, target.Hash(), tail.Hash())
+	assert.Equal(t, int64(target.Height()), syncCurrentHeightGauge.Value())
+
+	assert.Nil(t, local.chain.SetTailBlock(local.chain.GetBlock(tail.Hash())))
+	local.manager.progress.finish()
+	s = local.manager.Progress()
+	assert.False(t, s.Syncing)
+	assert.Equal(t, target.Height(), s.CurrentHeight)
+	assert.Equal(t, target.Height(), s.HighestHeight)
+}
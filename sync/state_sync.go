@@ -0,0 +1,225 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// MaxTrieNodesPerRequest is the most trie nodes requested from or served to a peer at once.
+	MaxTrieNodesPerRequest = 384
+	// StateRequestTimeout is the time a peer has to deliver the trie nodes requested.
+	StateRequestTimeout = 10 * time.Second
+	// MaxStateRequestFailures is the number of failures after which a peer is not requested trie nodes any more.
+	MaxStateRequestFailures = 3
+)
+
+// errors
+var (
+	ErrNoStatePeers = errors.New("no peer to download the state from")
+)
+
+// Metrics of the state sync
+var (
+	stateNodesCounter  = metrics.GetOrRegisterCounter("neb.sync.state.nodes", nil)
+	stateFailedCounter = metrics.GetOrRegisterCounter("neb.sync.state.failed", nil)
+)
+
+// statePeer is a peer the trie nodes are downloaded from.
+type statePeer struct {
+	id        string
+	hashes    [][]byte
+	sent      time.Time
+	delivered int
+	failures  int
+}
+
+// SyncState download the world state of the block from the peers node by node, each node verified by its hash,
+// so the node can start from the block without replaying the transactions before it.
+func (m *Manager) SyncState(block *core.Block, peers []string) error {
+	syncer := trie.NewSyncer(m.blockChain.Storage())
+	core.AddStateTries(syncer, block)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block": block,
+		"peers": peers,
+	}).Info("Started to download the state.")
+
+	if err := m.syncTries(syncer, peers); err != nil {
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block": block,
+		"nodes": syncer.Synced(),
+	}).Info("Downloaded the state.")
+	return nil
+}
+
+// syncTries download the nodes of the tries scheduled in the syncer from the peers.
+func (m *Manager) syncTries(syncer *trie.Syncer, peers []string) error {
+	batch++
+	id := batch
+	sps := make(map[string]*statePeer)
+	for _, pid := range peers {
+		sps[pid] = &statePeer{id: pid}
+	}
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for !syncer.Done() {
+		inflight := false
+		for _, p := range sps {
+			if p.hashes == nil && p.failures < MaxStateRequestFailures {
+				hashes := syncer.Missing(MaxTrieNodesPerRequest)
+				if len(hashes) == 0 {
+					break
+				}
+				if err := m.requestTrieNodes(id, p.id, hashes); err != nil {
+					syncer.Retry(hashes)
+					p.failures = MaxStateRequestFailures
+					continue
+				}
+				p.hashes = hashes
+				p.sent = time.Now()
+			}
+			if p.hashes != nil {
+				inflight = true
+			}
+		}
+		if !inflight {
+			return ErrNoStatePeers
+		}
+
+		select {
+		case tn := <-m.receiveTrieNodesCh:
+			p, ok := sps[tn.from]
+			if !ok || tn.id != id || p.hashes == nil {
+				continue
+			}
+			delivered := 0
+			for _, data := range tn.nodes {
+				if _, err := syncer.Process(data); err != nil {
+					logging.VLog().WithFields(logrus.Fields{
+						"peer": p.id,
+						"err":  err,
+					}).Debug("Received an invalid trie node.")
+					m.ns.ReportPeer(p.id, p2p.InvalidMessage)
+					p.failures++
+					stateFailedCounter.Inc(1)
+					break
+				}
+				delivered++
+			}
+			stateNodesCounter.Inc(int64(delivered))
+			// the nodes not delivered are requested again.
+			syncer.Retry(p.hashes)
+			p.hashes = nil
+			p.delivered += delivered
+			if delivered == 0 {
+				p.failures++
+			}
+		case <-ticker.C:
+			for _, p := range sps {
+				if p.hashes != nil && time.Since(p.sent) > StateRequestTimeout {
+					syncer.Retry(p.hashes)
+					p.hashes = nil
+					p.failures++
+					stateFailedCounter.Inc(1)
+					m.ns.ReportPeer(p.id, p2p.Timeout)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Manager) requestTrieNodes(id uint64, pid string, hashes [][]byte) error {
+	req := NewTrieNodesRequest(m.ns.Node().ID(), id, hashes)
+	msg, _ := req.ToProto()
+	data, err := pb.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return m.ns.SendMsg(net.MessageTypeGetTrieNodes, data, pid)
+}
+
+// handleGetTrieNodes answer a request with the trie nodes found in the storage.
+func (m *Manager) handleGetTrieNodes(msg net.Message) {
+	req := new(TrieNodesRequest)
+	pbreq := new(corepb.TrieNodesRequest)
+	if err := pb.Unmarshal(msg.Data().([]byte), pbreq); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	if err := req.FromProto(pbreq); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	hashes := req.hashes
+	if len(hashes) > MaxTrieNodesPerRequest {
+		hashes = hashes[:MaxTrieNodesPerRequest]
+	}
+	var nodes [][]byte
+	for _, h := range hashes {
+		if data, err := m.blockChain.Storage().Get(h); err == nil {
+			nodes = append(nodes, data)
+		}
+	}
+	reply := NewTrieNodes(m.ns.Node().ID(), req.id, nodes)
+	pbreply, _ := reply.ToProto()
+	data, err := pb.Marshal(pbreply)
+	if err != nil {
+		return
+	}
+	m.ns.SendMsg(net.MessageTypeTrieNodes, data, msg.MessageFrom())
+}
+
+// handleTrieNodes pass the trie nodes received to the state sync, they are dropped if no sync waits for them.
+func (m *Manager) handleTrieNodes(msg net.Message) {
+	tn := new(TrieNodes)
+	pbtn := new(corepb.TrieNodes)
+	if err := pb.Unmarshal(msg.Data().([]byte), pbtn); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	if err := tn.FromProto(pbtn); err != nil {
+		m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
+		return
+	}
+	// the nodes are attributed to the peer sending them.
+	tn.from = msg.MessageFrom()
+	select {
+	case m.receiveTrieNodesCh <- tn:
+	default:
+	}
+}
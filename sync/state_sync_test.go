@@ -0,0 +1,55 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncState(t *testing.T) {
+	network := newTestNetwork(t)
+	seed := network.newNode(t, "seed")
+	blocks := seed.extend(t, 10, 1)
+	pivot := blocks[len(blocks)-1]
+	network.newNode(t, "empty")
+	liar := network.newNode(t, "liar")
+	liar.copyChain(t, blocks)
+	liar.lying = true
+
+	local := network.newNode(t, "local")
+	_, err := local.chain.Storage().Get(pivot.StateRoot())
+	assert.NotNil(t, err)
+
+	// the nodes not found or not matching their hash are requested from the other peers.
+	assert.Nil(t, local.manager.SyncState(pivot, []string{"empty", "liar", "seed"}))
+	for _, root := range [][]byte{pivot.StateRoot(), pivot.DposContext().MintCntRoot} {
+		_, err := local.chain.Storage().Get(root)
+		assert.Nil(t, err)
+	}
+	assert.Contains(t, local.reports["liar"], p2p.InvalidMessage)
+	assert.NotContains(t, local.reports["seed"], p2p.InvalidMessage)
+
+	// no peer has the state.
+	other := network.newNode(t, "other")
+	assert.Equal(t, ErrNoStatePeers, other.manager.SyncState(pivot, []string{"empty", "liar", "gone"}))
+	assert.Contains(t, other.reports["liar"], p2p.InvalidMessage)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -55,6 +56,14 @@ type Manager struct {
 	curTail                *core.Block
 	canSyncWithBlockListCh chan bool
 	goParentSyncCh         chan bool
+	receiveChunkMsgCh      chan net.Message
+	receiveChunkCh         chan *ChunkBlocks
+	receiveStateMsgCh      chan net.Message
+	receiveTrieNodesCh     chan *TrieNodes
+	receiveHeadersCh       chan *Headers
+	fastSync               bool
+	checkpoints            map[uint64]byteutils.Hash
+	progress               *progress
 }
 
 // NewManager new sync manager
@@ -72,9 +81,19 @@ func NewManager(blockChain *core.BlockChain, consensus consensus.Consensus, ns p
 		blockChain.TailBlock(),
 		make(chan bool, 1),
 		make(chan bool, 1),
+		make(chan net.Message, 128),
+		make(chan *ChunkBlocks, 128),
+		make(chan net.Message, 128),
+		make(chan *TrieNodes, 128),
+		make(chan *Headers, 128),
+		false,
+		make(map[uint64]byteutils.Hash),
+		newProgress(),
 	}
 	m.RegisterSyncBlockInNetwork(ns)
 	m.RegisterSyncReplyInNetwork(ns)
+	m.RegisterChunkInNetwork(ns)
+	m.RegisterStateInNetwork(ns)
 	return m
 }
 
@@ -88,6 +107,23 @@ func (m *Manager) RegisterSyncReplyInNetwork(nm p2p.Manager) {
 	nm.Register(net.NewSubscriber(m, m.receiveSyncReplyCh, net.MessageTypeSyncReply))
 }
 
+// RegisterChunkInNetwork register message subscriber in network.
+func (m *Manager) RegisterChunkInNetwork(nm p2p.Manager) {
+	nm.Register(net.NewSubscriber(m, m.receiveChunkMsgCh, net.MessageTypeGetChunk, net.MessageTypeChunk,
+		net.MessageTypeGetHeaders, net.MessageTypeHeaders))
+	p2p.RegisterMessageVersion(net.MessageTypeGetChunk, 2)
+	p2p.RegisterMessageVersion(net.MessageTypeChunk, 2)
+	p2p.RegisterMessageVersion(net.MessageTypeGetHeaders, 2)
+	p2p.RegisterMessageVersion(net.MessageTypeHeaders, 2)
+}
+
+// RegisterStateInNetwork register message subscriber in network.
+func (m *Manager) RegisterStateInNetwork(nm p2p.Manager) {
+	nm.Register(net.NewSubscriber(m, m.receiveStateMsgCh, net.MessageTypeGetTrieNodes, net.MessageTypeTrieNodes))
+	p2p.RegisterMessageVersion(net.MessageTypeGetTrieNodes, 2)
+	p2p.RegisterMessageVersion(net.MessageTypeTrieNodes, 2)
+}
+
 // Start start sync service
 /*
 1. send my tail to remote peers and then find the common ancestor
@@ -105,6 +141,7 @@ func (m *Manager) Start() {
 	m.startMsgHandle()
 	if len(m.ns.Node().Config().BootNodes) > 0 {
 		m.ns.Node().SetSynchronizing(true)
+		m.progress.start(m.blockChain.TailBlock().Height())
 		m.startSync()
 		m.curTail = m.blockChain.TailBlock()
 	} else {
@@ -130,6 +167,7 @@ func (m *Manager) loop() {
 				m.ns.Node().SetSynchronizing(false)
 			}
 			m.consensus.SetCanMining(true)
+			m.progress.finish()
 			logging.VLog().Info("sync finish.")
 		case <-m.syncCh:
 			if m.curTail == nil {
@@ -205,10 +243,12 @@ func (m *Manager) startMsgHandle() {
 				pbblock := new(corepb.NetBlock)
 				if err := pb.Unmarshal(msg.Data().([]byte), pbblock); err != nil {
 					logging.VLog().Error("StartMsgHandle.receiveTailCh: unmarshal data occurs error, ", err)
+					m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
 					continue
 				}
 				if err := tail.FromProto(pbblock); err != nil {
 					logging.VLog().Error("StartMsgHandle.receiveTailCh: get block from proto occurs error: ", err)
+					m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
 					continue
 				}
 
@@ -231,6 +271,7 @@ func (m *Manager) startMsgHandle() {
 				}
 				subsequentBlocks = append(subsequentBlocks, ancestor)
 				blocks := NewNetBlocks(key, tail.batch, subsequentBlocks)
+				blocks.tailHeight = m.blockChain.TailBlock().Height()
 				logging.VLog().WithFields(logrus.Fields{
 					"from":   blocks.from,
 					"batch":  blocks.batch,
@@ -247,15 +288,18 @@ func (m *Manager) startMsgHandle() {
 				pbblocks := new(corepb.NetBlocks)
 				if err := pb.Unmarshal(msg.Data().([]byte), pbblocks); err != nil {
 					logging.VLog().Error("StartMsgHandle.receiveSyncReplyCh: unmarshal data occurs error, ", err)
+					m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
 					continue
 				}
 				if err := data.FromProto(pbblocks); err != nil {
 					logging.VLog().Error("StartMsgHandle.receiveSyncReplyCh: get blocks from proto occurs error: ", err)
+					m.ns.ReportPeer(msg.MessageFrom(), p2p.InvalidMessage)
 					continue
 				}
 				if data.batch < batch {
 					continue
 				}
+				m.progress.observe(data.tailHeight)
 				blocks := data.Blocks()
 
 				if len(blocks) == 0 {
@@ -278,6 +322,26 @@ func (m *Manager) startMsgHandle() {
 					continue
 				}
 
+			case msg := <-m.receiveChunkMsgCh:
+				switch msg.MessageType() {
+				case net.MessageTypeGetChunk:
+					m.handleGetChunk(msg)
+				case net.MessageTypeChunk:
+					m.handleChunk(msg)
+				case net.MessageTypeGetHeaders:
+					m.handleGetHeaders(msg)
+				case net.MessageTypeHeaders:
+					m.handleHeaders(msg)
+				}
+
+			case msg := <-m.receiveStateMsgCh:
+				switch msg.MessageType() {
+				case net.MessageTypeGetTrieNodes:
+					m.handleGetTrieNodes(msg)
+				case net.MessageTypeTrieNodes:
+					m.handleTrieNodes(msg)
+				}
+
 			}
 		}
 	})()
@@ -322,7 +386,11 @@ func (m *Manager) doSyncBlocksWithCommonAncestor(addrsArray []string) {
 		}
 		// suppose root[i] is a legal block
 		if count >= len(addrsArray) {
-			if err := m.blockChain.BlockPool().Push(root[i]); err != nil {
+			err := m.syncRelayDynasty(m.blockChain.GetBlock(root[i].ParentHash()), root[i], addrsArray)
+			if err == nil {
+				err = m.blockChain.BlockPool().Push(root[i])
+			}
+			if err != nil {
 				for k := range m.cacheList {
 					delete(m.cacheList, k)
 				}
@@ -332,6 +400,8 @@ func (m *Manager) doSyncBlocksWithCommonAncestor(addrsArray []string) {
 				m.syncCh <- true
 				return
 			}
+			m.progress.downloaded(1)
+			m.progress.verified(root[i].Height())
 			tail = root[i]
 		}
 	}
@@ -343,12 +413,18 @@ func (m *Manager) doSyncBlocksWithCommonAncestor(addrsArray []string) {
 			syncContinue = true
 		}
 	}
+	target := m.chunkTarget(addrsArray)
 
 	if syncContinue {
 		for k := range m.cacheList {
 			delete(m.cacheList, k)
 		}
 		m.curTail = tail
+		if tail != nil && m.fastSync && target >= tail.Height()+MinFastSyncDistance {
+			m.curTail = m.syncFast(tail, target, addrsArray)
+		} else if tail != nil && target >= tail.Height()+ChunkedSyncDistance {
+			m.curTail = m.downloadChunks(tail, target, addrsArray)
+		}
 		m.syncCh <- true
 	} else { // sync finish
 		for k := range m.cacheList {
@@ -358,6 +434,19 @@ func (m *Manager) doSyncBlocksWithCommonAncestor(addrsArray []string) {
 	}
 }
 
+// chunkTarget return the height all the peers agreeing on the common ancestor have reached,
+// 0 if any of them doesn't tell its height.
+func (m *Manager) chunkTarget(addrsArray []string) uint64 {
+	var target uint64
+	for i, addrs := range addrsArray {
+		height := m.cacheList[addrs].tailHeight
+		if i == 0 || height < target {
+			target = height
+		}
+	}
+	return target
+}
+
 // find blocks who have the common ancestor
 func (m *Manager) findBlocksWithCommonAncestor() []string {
 	tempList := make(map[string]int)
@@ -0,0 +1,287 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+var errUnknownPeer = errors.New("unknown peer")
+
+type testNeb struct {
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *core.EventEmitter
+}
+
+func (n *testNeb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *testNeb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *testNeb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *testNeb) StartSync() {}
+
+// testConsensus accepts the blocks, their proposers are checked by the header verifier of the sync.
+type testConsensus struct{}
+
+func (c testConsensus) FastVerifyBlock(block *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c testConsensus) VerifyBlock(block *core.Block, parent *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+type testMessage struct {
+	msgType string
+	data    []byte
+	from    string
+}
+
+func (msg *testMessage) MessageType() string {
+	return msg.msgType
+}
+
+func (msg *testMessage) Data() interface{} {
+	return msg.data
+}
+
+func (msg *testMessage) MessageFrom() string {
+	return msg.from
+}
+
+// testNetwork connects the sync managers of the nodes, the messages are handled as soon as they are sent.
+type testNetwork struct {
+	genesis *corepb.Genesis
+	// key: the address of a member of the dynasty, value: its key.
+	keys  map[string]keystore.PrivateKey
+	nodes map[string]*testNode
+}
+
+func newTestNetwork(t *testing.T) *testNetwork {
+	network := &testNetwork{
+		genesis: &corepb.Genesis{
+			Meta:         &corepb.GenesisMeta{ChainId: 100},
+			Consensus:    &corepb.GenesisConsensus{Dpos: &corepb.GenesisConsensusDpos{}},
+			RandomHeight: 1,
+		},
+		keys:  make(map[string]keystore.PrivateKey),
+		nodes: make(map[string]*testNode),
+	}
+	for i := 0; i < core.DynastySize; i++ {
+		key := secp256k1.GeneratePrivateKey()
+		pub, err := key.PublicKey().Encoded()
+		assert.Nil(t, err)
+		addr, err := core.NewAddressFromPublicKey(pub)
+		assert.Nil(t, err)
+		network.keys[addr.String()] = key
+		network.genesis.Consensus.Dpos.Dynasty = append(network.genesis.Consensus.Dpos.Dynasty, addr.String())
+	}
+	return network
+}
+
+// testNode is a node of the test network, it implements the p2p.Manager of its sync manager.
+type testNode struct {
+	id      string
+	network *testNetwork
+	chain   *core.BlockChain
+	manager *Manager
+	// the node doesn't answer the requests.
+	mute bool
+	// the node answers the chunk requests with a block less than requested.
+	truncate bool
+	// the node answers the trie nodes requests with garbage.
+	lying bool
+	// the blocks of the node are signed by this key instead of the proposers' ones.
+	impostor keystore.PrivateKey
+	// key: the peer, value: the misbehaviors the node reported.
+	reports map[string][]p2p.PeerMisbehavior
+}
+
+func (network *testNetwork) newNode(t *testing.T, id string) *testNode {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	chain, err := core.NewBlockChain(&testNeb{genesis: network.genesis, storage: stor, emitter: core.NewEventEmitter(1024)})
+	assert.Nil(t, err)
+	chain.SetConsensusHandler(testConsensus{})
+
+	node := &testNode{
+		id:      id,
+		network: network,
+		chain:   chain,
+		reports: make(map[string][]p2p.PeerMisbehavior),
+	}
+	chain.BlockPool().RegisterInNetwork(node)
+	node.manager = NewManager(chain, nil, node)
+	network.nodes[id] = node
+	return node
+}
+
+// extend mint count blocks after the tail, the first one offset slots after it and the others in the next slots,
+// each signed by the proposer of its slot, and make the last one the tail.
+func (n *testNode) extend(t *testing.T, count int, offset int64) []*core.Block {
+	parent := n.chain.TailBlock()
+	var blocks []*core.Block
+	for i := 0; i < count; i++ {
+		elapsed := core.BlockInterval
+		if i == 0 {
+			elapsed *= offset
+		}
+		context, err := parent.NextDynastyContext(elapsed)
+		assert.Nil(t, err)
+		proposer, err := core.AddressParseFromBytes(context.Proposer)
+		assert.Nil(t, err)
+		key := n.network.keys[proposer.String()]
+		if n.impostor != nil {
+			key = n.impostor
+		}
+
+		block, err := core.NewBlock(n.chain.ChainID(), proposer, parent)
+		assert.Nil(t, err)
+		assert.Nil(t, block.LoadDynastyContext(context))
+		block.SetMiner(proposer)
+		assert.Nil(t, block.SignRandom(key))
+		assert.Nil(t, block.Seal())
+		signature, err := crypto.NewSignature(keystore.SECP256K1)
+		assert.Nil(t, err)
+		assert.Nil(t, signature.InitSign(key))
+		assert.Nil(t, block.Sign(signature))
+		assert.Nil(t, n.chain.BlockPool().Push(block))
+
+		blocks = append(blocks, block)
+		parent = block
+	}
+	assert.Nil(t, n.chain.SetTailBlock(n.chain.GetBlock(parent.Hash())))
+	return blocks
+}
+
+// copyChain push the blocks of another node, and make the last one the tail.
+func (n *testNode) copyChain(t *testing.T, blocks []*core.Block) {
+	for _, block := range blocks {
+		assert.Nil(t, n.chain.BlockPool().Push(block))
+	}
+	assert.Nil(t, n.chain.SetTailBlock(n.chain.GetBlock(blocks[len(blocks)-1].Hash())))
+}
+
+func (n *testNode) Start() error { return nil }
+func (n *testNode) Stop()        {}
+
+func (n *testNode) Node() *p2p.Node { return &p2p.Node{} }
+
+func (n *testNode) Sync(net.Serializable) error            { return nil }
+func (n *testNode) SendSyncReply(string, net.Serializable) {}
+
+func (n *testNode) Register(...*net.Subscriber)   {}
+func (n *testNode) Deregister(...*net.Subscriber) {}
+
+func (n *testNode) Broadcast(string, net.Serializable) {}
+func (n *testNode) Relay(string, net.Serializable)     {}
+
+// SendMsg pass the message to the handler of the target node, which answers at once.
+func (n *testNode) SendMsg(msgType string, data []byte, target string) error {
+	peer, ok := n.network.nodes[target]
+	if !ok {
+		return errUnknownPeer
+	}
+	if peer.mute {
+		return nil
+	}
+	msg := &testMessage{msgType: msgType, data: data, from: n.id}
+	switch msgType {
+	case net.MessageTypeGetChunk:
+		if peer.truncate {
+			msg.data = truncateRequest(data)
+		}
+		peer.manager.handleGetChunk(msg)
+	case net.MessageTypeChunk:
+		peer.manager.handleChunk(msg)
+	case net.MessageTypeGetHeaders:
+		peer.manager.handleGetHeaders(msg)
+	case net.MessageTypeHeaders:
+		peer.manager.handleHeaders(msg)
+	case net.MessageTypeGetTrieNodes:
+		if peer.lying {
+			return peer.lie(msg)
+		}
+		peer.manager.handleGetTrieNodes(msg)
+	case net.MessageTypeTrieNodes:
+		peer.manager.handleTrieNodes(msg)
+	}
+	return nil
+}
+
+// truncateRequest ask a block less than the chunk request.
+func truncateRequest(data []byte) []byte {
+	req := new(corepb.ChunkRequest)
+	if err := pb.Unmarshal(data, req); err != nil {
+		return data
+	}
+	req.Count--
+	data, _ = pb.Marshal(req)
+	return data
+}
+
+// lie answer a trie nodes request with nodes not matching the hashes.
+func (n *testNode) lie(msg *testMessage) error {
+	req := new(corepb.TrieNodesRequest)
+	if err := pb.Unmarshal(msg.data, req); err != nil {
+		return err
+	}
+	var nodes [][]byte
+	for _, h := range req.Hashes {
+		nodes = append(nodes, append([]byte("garbage"), h...))
+	}
+	reply, _ := NewTrieNodes(n.id, req.Id, nodes).ToProto()
+	data, err := pb.Marshal(reply)
+	if err != nil {
+		return err
+	}
+	return n.SendMsg(net.MessageTypeTrieNodes, data, msg.from)
+}
+
+func (n *testNode) BroadcastNetworkID([]byte) {}
+
+func (n *testNode) BuildData([]byte, string) []byte { return nil }
+
+func (n *testNode) ReportPeer(id string, misbehavior p2p.PeerMisbehavior) {
+	n.reports[id] = append(n.reports[id], misbehavior)
+}
+
+func (n *testNode) UpdatePeerHead(string, uint64, string) {}
@@ -0,0 +1,90 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// TrieNodesRequest asks a peer for the trie nodes of the hashes.
+type TrieNodesRequest struct {
+	from   string
+	id     uint64
+	hashes [][]byte
+}
+
+// TrieNodes is the trie nodes answering a TrieNodesRequest, the ones the peer has.
+type TrieNodes struct {
+	from  string
+	id    uint64
+	nodes [][]byte
+}
+
+// NewTrieNodesRequest return new TrieNodesRequest.
+func NewTrieNodesRequest(from string, id uint64, hashes [][]byte) *TrieNodesRequest {
+	return &TrieNodesRequest{from: from, id: id, hashes: hashes}
+}
+
+// NewTrieNodes return new TrieNodes.
+func NewTrieNodes(from string, id uint64, nodes [][]byte) *TrieNodes {
+	return &TrieNodes{from: from, id: id, nodes: nodes}
+}
+
+// ToProto converts domain TrieNodesRequest into proto TrieNodesRequest
+func (req *TrieNodesRequest) ToProto() (proto.Message, error) {
+	return &corepb.TrieNodesRequest{
+		From:   req.from,
+		Id:     req.id,
+		Hashes: req.hashes,
+	}, nil
+}
+
+// FromProto converts proto TrieNodesRequest to domain TrieNodesRequest
+func (req *TrieNodesRequest) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.TrieNodesRequest); ok {
+		req.from = msg.From
+		req.id = msg.Id
+		req.hashes = msg.Hashes
+		return nil
+	}
+	return errors.New("Pb Message cannot be converted into TrieNodesRequest")
+}
+
+// ToProto converts domain TrieNodes into proto TrieNodes
+func (tn *TrieNodes) ToProto() (proto.Message, error) {
+	return &corepb.TrieNodes{
+		From:  tn.from,
+		Id:    tn.id,
+		Nodes: tn.nodes,
+	}, nil
+}
+
+// FromProto converts proto TrieNodes to domain TrieNodes
+func (tn *TrieNodes) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.TrieNodes); ok {
+		tn.from = msg.From
+		tn.id = msg.Id
+		tn.nodes = msg.Nodes
+		return nil
+	}
+	return errors.New("Pb Message cannot be converted into TrieNodes")
+}
@@ -19,6 +19,7 @@
 package logging
 
 import (
+	"errors"
 	"os"
 
 	"github.com/sirupsen/logrus"
@@ -34,6 +35,9 @@ const (
 	DebugLevel = "debug"
 )
 
+// ErrInvalidLevel is returned for an unknown log level.
+var ErrInvalidLevel = errors.New("invalid log level, must be one of panic, fatal, error, warn, info and debug")
+
 type emptyWriter struct{}
 
 func (ew emptyWriter) Write(p []byte) (int, error) {
@@ -78,6 +82,22 @@ func convertLevel(level string) logrus.Level {
 	}
 }
 
+// SetLevel change the level of the verbose logger at runtime.
+func SetLevel(level string) error {
+	switch level {
+	case PanicLevel, FatalLevel, ErrorLevel, WarnLevel, InfoLevel, DebugLevel:
+	default:
+		return ErrInvalidLevel
+	}
+	VLog().Level = convertLevel(level)
+	return nil
+}
+
+// Level return the level of the verbose logger.
+func Level() string {
+	return VLog().Level.String()
+}
+
 // Init loggers
 func Init(path string, level string) {
 	clog = logrus.New()